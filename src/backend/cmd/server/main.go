@@ -28,6 +28,7 @@ import (
 	"backend/internal/logger"
 	"backend/internal/repository/postgres"
 	"backend/internal/service"
+	"backend/internal/taxonomy"
 )
 
 func main() {
@@ -81,14 +82,44 @@ func run(log logger.Logger) error {
 	testimonialRepo := postgres.NewTestimonialRepository(db)
 	skillValidationRepo := postgres.NewSkillValidationRepository(db)
 	expValidationRepo := postgres.NewExperienceValidationRepository(db)
+	eduValidationRepo := postgres.NewEducationValidationRepository(db)
+	companyAliasRepo := postgres.NewCompanyAliasRepository(db)
+	companyRepo := postgres.NewCompanyRepository(db)
+	skillRepo := postgres.NewSkillRepository(db)
+	credentialDocRepo := postgres.NewCredentialDocumentRepository(db)
+	inconsistencyRepo := postgres.NewProfileInconsistencyRepository(db)
+	changeRepo := postgres.NewProfileChangeRepository(db)
+	linkRepo := postgres.NewProfileLinkRepository(db)
+	certificationRepo := postgres.NewProfileCertificationRepository(db)
+	languageRepo := postgres.NewProfileLanguageRepository(db)
+	projectRepo := postgres.NewProfileProjectRepository(db)
+	publicationRepo := postgres.NewProfilePublicationRepository(db)
+	awardRepo := postgres.NewProfileAwardRepository(db)
+	jobDescriptionRepo := postgres.NewJobDescriptionRepository(db)
+	profileVariantRepo := postgres.NewProfileVariantRepository(db)
+	writingSuggestionRepo := postgres.NewWritingSuggestionRepository(db)
+	coverLetterRepo := postgres.NewCoverLetterRepository(db)
+	evidenceEmbeddingRepo := postgres.NewEvidenceEmbeddingRepository(db)
+
+	// Seed the bundled skill taxonomy; profile skills reference its rows
+	if seedErr := taxonomy.Seed(context.Background(), skillRepo); seedErr != nil {
+		return seedErr
+	}
+
+	// Parse the dates of entries stored before dates were parsed
+	if backfilled, backfillErr := service.BackfillProfileDates(context.Background(), profileExpRepo, profileEduRepo); backfillErr != nil {
+		log.Warning("Failed to backfill profile dates", logger.Feature("profile"), logger.Err(backfillErr))
+	} else if backfilled > 0 {
+		log.Info("Backfilled profile dates", logger.Feature("profile"), logger.Int("entries", backfilled))
+	}
 
 	// Ensure demo user exists (development convenience)
 	if seedErr := ensureDemoUser(context.Background(), userRepo, log); seedErr != nil {
 		log.Warning("Failed to ensure demo user exists", logger.Feature("seed"), logger.Err(seedErr))
 	}
 
-	// Create LLM extractor with provider registry for per-operation chains
-	extractor, extractHandler, btTracing := createLLMExtractor(cfg, log)
+	// Create LLM extractor and profile writer with provider registry for per-operation chains
+	extractor, profileWriter, extractHandler, btTracing := createLLMExtractor(cfg, log)
 	if btTracing != nil {
 		defer func() {
 			if shutdownErr := btTracing.Shutdown(context.Background()); shutdownErr != nil {
@@ -97,11 +128,24 @@ func run(log logger.Logger) error {
 		}()
 	}
 
+	// Create the embedder for semantic evidence matching and search (nil if not configured)
+	embedder := createEmbedder(cfg, log)
+	var embeddingSvc *service.EmbeddingService
+	if embedder != nil {
+		embeddingSvc = service.NewEmbeddingService(embedder, evidenceEmbeddingRepo, profileExpRepo, profileSkillRepo, testimonialRepo, skillValidationRepo)
+	}
+
 	// Create job queue workers
 	workers := river.NewWorkers()
 
 	// Create shared materialization service
-	materializationSvc := service.NewMaterializationService(db, profileRepo, profileExpRepo, profileEduRepo, profileSkillRepo, authorRepo, testimonialRepo, skillValidationRepo, expValidationRepo)
+	materializationSvc := service.NewMaterializationService(db, profileRepo, profileExpRepo, profileEduRepo, profileSkillRepo, authorRepo, testimonialRepo, skillValidationRepo, expValidationRepo, eduValidationRepo, companyAliasRepo, companyRepo, skillRepo, inconsistencyRepo, changeRepo, linkRepo, certificationRepo, languageRepo, projectRepo, publicationRepo, awardRepo, embeddingSvc)
+
+	// The certification expiry check runs periodically and needs no LLM
+	river.AddWorker(workers, job.NewCertificationExpiryWorker(service.NewCertificationExpiryChecker(certificationRepo), log))
+
+	// Evidence embedding jobs are enqueued after materialization and profile edits; without an embedder they are skipped
+	river.AddWorker(workers, job.NewEvidenceEmbeddingWorker(profileRepo, embeddingSvc, log))
 
 	// Register processing workers only if LLM is configured
 	if extractor != nil {
@@ -111,7 +155,7 @@ func run(log logger.Logger) error {
 		river.AddWorker(workers, job.NewReferenceLetterProcessingWorker(refLetterRepo, fileRepo, profileRepo, profileSkillRepo, authorRepo, testimonialRepo, skillValidationRepo, fileStorage, extractor, log))
 		log.Info("Reference letter processing worker registered", logger.Feature("jobs"))
 
-		river.AddWorker(workers, job.NewDocumentProcessingWorker(resumeRepo, refLetterRepo, fileRepo, profileRepo, profileSkillRepo, fileStorage, extractor, log))
+		river.AddWorker(workers, job.NewDocumentProcessingWorker(resumeRepo, refLetterRepo, credentialDocRepo, fileRepo, profileRepo, profileSkillRepo, fileStorage, extractor, log))
 		log.Info("Unified document processing worker registered", logger.Feature("jobs"))
 
 		river.AddWorker(workers, job.NewDocumentDetectionWorker(fileRepo, fileStorage, extractor, log))
@@ -157,7 +201,7 @@ func run(log logger.Logger) error {
 	r.Post("/api/extract", extractHandler.ServeHTTP)
 
 	// GraphQL API
-	r.Handle("/graphql", graphql.NewHandler(userRepo, fileRepo, refLetterRepo, resumeRepo, profileRepo, profileExpRepo, profileEduRepo, profileSkillRepo, authorRepo, testimonialRepo, skillValidationRepo, expValidationRepo, fileStorage, queueClient, extractor, materializationSvc, log))
+	r.Handle("/graphql", graphql.NewHandler(db, userRepo, fileRepo, refLetterRepo, resumeRepo, profileRepo, profileExpRepo, profileEduRepo, profileSkillRepo, authorRepo, testimonialRepo, skillValidationRepo, expValidationRepo, eduValidationRepo, companyAliasRepo, companyRepo, skillRepo, credentialDocRepo, inconsistencyRepo, changeRepo, linkRepo, certificationRepo, languageRepo, projectRepo, publicationRepo, awardRepo, jobDescriptionRepo, profileVariantRepo, writingSuggestionRepo, coverLetterRepo, fileStorage, queueClient, extractor, profileWriter, materializationSvc, embeddingSvc, time.Duration(cfg.Queue.CertificationExpiryWindowDays)*24*time.Hour, log))
 	r.Get("/playground", graphql.NewPlaygroundHandler("/graphql").ServeHTTP)
 
 	addr := fmt.Sprintf(":%d", cfg.Server.Port)
@@ -272,9 +316,35 @@ func createProviderRegistry(cfg *config.Config, log logger.Logger) (*llm.Provide
 	return registry, providerNames, btTracing
 }
 
-// createLLMExtractor creates the document extractor with per-operation provider chains.
-// Returns the extractor (nil if no providers available), the HTTP handler, and the Braintrust tracing instance.
-func createLLMExtractor(cfg *config.Config, log logger.Logger) (*llm.DocumentExtractor, http.Handler, *llm.BraintrustTracing) {
+// createEmbedder creates the embedder configured by EMBEDDING_MODEL.
+// Returns nil if the configured provider is unknown or lacks an API key.
+func createEmbedder(cfg *config.Config, log logger.Logger) domain.Embedder {
+	provider, model := cfg.LLM.ParseEmbeddingModel()
+
+	var embedder domain.Embedder
+	switch provider {
+	case "openai":
+		if cfg.OpenAI.APIKey == "" {
+			log.Warning("Evidence embeddings disabled (no OpenAI API key configured)", logger.Feature("embeddings"))
+			return nil
+		}
+		embedder = llm.NewOpenAIEmbedder(llm.OpenAIEmbedderConfig{APIKey: cfg.OpenAI.APIKey, Model: model, MatchThreshold: cfg.LLM.EmbeddingMatchThreshold})
+	case "local":
+		embedder = llm.NewLocalEmbedder(llm.LocalEmbedderConfig{BaseURL: cfg.LLM.LocalEmbeddingURL, Model: model, MatchThreshold: cfg.LLM.EmbeddingMatchThreshold})
+	case "fake":
+		embedder = llm.NewFakeEmbedder(0)
+	default:
+		log.Warning("Evidence embeddings disabled (unknown provider)", logger.Feature("embeddings"), logger.String("provider", provider))
+		return nil
+	}
+
+	log.Info("Evidence embeddings enabled", logger.Feature("embeddings"), logger.String("model", embedder.Name()))
+	return embedder
+}
+
+// createLLMExtractor creates the document extractor and profile writer with per-operation provider chains.
+// Returns the extractor and writer (nil if no providers available), the HTTP handler, and the Braintrust tracing instance.
+func createLLMExtractor(cfg *config.Config, log logger.Logger) (*llm.DocumentExtractor, domain.ProfileWriter, http.Handler, *llm.BraintrustTracing) {
 	registry, providerNames, btTracing := createProviderRegistry(cfg, log)
 
 	// Parse per-use-case model configuration
@@ -282,6 +352,8 @@ func createLLMExtractor(cfg *config.Config, log logger.Logger) (*llm.DocumentExt
 	resumeProvider, resumeModel := cfg.LLM.ParseResumeExtractionModel()
 	refProvider, refModel := cfg.LLM.ParseReferenceExtractionModel()
 	detProvider, detModel := cfg.LLM.ParseDetectionModel()
+	writingProvider, writingModel := cfg.LLM.ParseWritingModel()
+	coverLetterProvider, coverLetterModel := cfg.LLM.ParseCoverLetterModel()
 
 	// Determine a default provider from the document extraction chain config.
 	// This serves as the fallback when a chain references an unregistered provider.
@@ -298,7 +370,7 @@ func createLLMExtractor(cfg *config.Config, log logger.Logger) (*llm.DocumentExt
 
 	if defaultProvider == nil {
 		log.Warning("LLM extraction disabled (no API key configured)", logger.Feature("llm"))
-		return nil, handler.NewExtractUnavailableHandler(), btTracing
+		return nil, nil, handler.NewExtractUnavailableHandler(), btTracing
 	}
 
 	// Configure provider chains for each operation
@@ -306,6 +378,8 @@ func createLLMExtractor(cfg *config.Config, log logger.Logger) (*llm.DocumentExt
 	resumeChain := llm.ProviderChain{{Provider: resumeProvider, Model: resumeModel}}
 	refChain := llm.ProviderChain{{Provider: refProvider, Model: refModel}}
 	detChain := llm.ProviderChain{{Provider: detProvider, Model: detModel}}
+	writingChain := llm.ProviderChain{{Provider: writingProvider, Model: writingModel}}
+	coverLetterChain := llm.ProviderChain{{Provider: coverLetterProvider, Model: coverLetterModel}}
 
 	// Validate that configured chains reference registered providers
 	for _, check := range []struct {
@@ -316,6 +390,8 @@ func createLLMExtractor(cfg *config.Config, log logger.Logger) (*llm.DocumentExt
 		{"Resume extraction", resumeProvider},
 		{"Reference extraction", refProvider},
 		{"Detection", detProvider},
+		{"Writing", writingProvider},
+		{"Cover letter", coverLetterProvider},
 	} {
 		if _, ok := registry.Get(check.provider); !ok {
 			log.Warning(check.name+" chain references unregistered provider — will fall back to default",
@@ -333,6 +409,8 @@ func createLLMExtractor(cfg *config.Config, log logger.Logger) (*llm.DocumentExt
 		logger.String("resume_extraction", fmt.Sprintf("%s/%s", resumeProvider, resumeModel)),
 		logger.String("reference_extraction", fmt.Sprintf("%s/%s", refProvider, refModel)),
 		logger.String("detection", fmt.Sprintf("%s/%s", detProvider, detModel)),
+		logger.String("writing", fmt.Sprintf("%s/%s", writingProvider, writingModel)),
+		logger.String("cover_letter", fmt.Sprintf("%s/%s", coverLetterProvider, coverLetterModel)),
 	)
 
 	extractor := llm.NewDocumentExtractor(defaultProvider, llm.DocumentExtractorConfig{
@@ -343,10 +421,16 @@ func createLLMExtractor(cfg *config.Config, log logger.Logger) (*llm.DocumentExt
 		DetectionChain:           detChain,
 		Logger:                   log,
 	})
+	writer := llm.NewProfileWriter(defaultProvider, llm.ProfileWriterConfig{
+		ProviderRegistry: registry,
+		WritingChain:     writingChain,
+		CoverLetterChain: coverLetterChain,
+		Logger:           log,
+	})
 	extractHandler := handler.NewExtractHandler(extractor, log)
 	log.Info("LLM extraction enabled", logger.Feature("llm"), logger.String("providers", fmt.Sprintf("%v", providerNames)))
 
-	return extractor, extractHandler, btTracing
+	return extractor, writer, extractHandler, btTracing
 }
 
 // ensureDemoUser creates the demo user if it doesn't exist.
@@ -49,6 +49,32 @@ type LLMConfig struct {
 	// Detection is a simple classification task that doesn't require expensive models.
 	// Haiku is also suitable: "anthropic/claude-haiku-4-5-20251001"
 	DetectionModel string
+
+	// WritingModel specifies the provider and model for drafting profile text such as
+	// summaries, headlines and experience highlights.
+	// Format: "provider/model" (e.g., "anthropic/claude-sonnet-4-5-20250929").
+	// Defaults to "anthropic" (provider default model) if not specified.
+	WritingModel string
+
+	// CoverLetterModel specifies the provider and model for drafting cover letters.
+	// Format: "provider/model" (e.g., "anthropic/claude-sonnet-4-5-20250929").
+	// Defaults to "anthropic" (provider default model) if not specified.
+	CoverLetterModel string
+
+	// EmbeddingModel specifies the provider and model for embedding profile evidence.
+	// Format: "provider/model" (e.g., "local/nomic-embed-text").
+	// Providers are "openai", "local" (an Ollama-compatible server) and "fake" (deterministic,
+	// for development without an API key). Defaults to "openai/text-embedding-3-small".
+	EmbeddingModel string
+
+	// LocalEmbeddingURL is the base URL of the server used by the "local" embedding provider.
+	// Defaults to http://localhost:11434 if not specified.
+	LocalEmbeddingURL string
+
+	// EmbeddingMatchThreshold is the cosine similarity from which a skill mention in a
+	// reference letter validates a profile skill. 0, the default, uses the threshold of the
+	// embedding model.
+	EmbeddingMatchThreshold float64
 }
 
 // ParseDocumentExtractionModel parses the DocumentExtractionModel into provider and model parts.
@@ -75,6 +101,24 @@ func (c *LLMConfig) ParseDetectionModel() (provider, model string) {
 	return parseModelConfig(c.DetectionModel, "openai", "gpt-4o-mini")
 }
 
+// ParseWritingModel parses the WritingModel into provider and model parts.
+// Returns (provider, model). If not set, defaults to ("anthropic", "").
+func (c *LLMConfig) ParseWritingModel() (provider, model string) {
+	return parseModelConfig(c.WritingModel, "anthropic", "")
+}
+
+// ParseCoverLetterModel parses the CoverLetterModel into provider and model parts.
+// Returns (provider, model). If not set, defaults to ("anthropic", "").
+func (c *LLMConfig) ParseCoverLetterModel() (provider, model string) {
+	return parseModelConfig(c.CoverLetterModel, "anthropic", "")
+}
+
+// ParseEmbeddingModel parses the EmbeddingModel into provider and model parts.
+// Returns (provider, model). If not set, defaults to ("openai", "text-embedding-3-small").
+func (c *LLMConfig) ParseEmbeddingModel() (provider, model string) {
+	return parseModelConfig(c.EmbeddingModel, "openai", "text-embedding-3-small")
+}
+
 // parseModelConfig parses a "provider/model" string into its parts.
 // If the value is empty, returns the provided defaults.
 // If no "/" is present, treats the whole string as provider with empty model.
@@ -139,6 +183,9 @@ type ServerConfig struct {
 // QueueConfig holds River job queue settings.
 type QueueConfig struct {
 	MaxWorkers int
+	// CertificationExpiryWindowDays is how many days ahead a certification counts as
+	// expiring, both for the periodic expiry check and the expiringCertifications query.
+	CertificationExpiryWindowDays int
 }
 
 // AnthropicConfig holds Anthropic API settings.
@@ -191,6 +238,16 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("invalid QUEUE_MAX_WORKERS: %w", err)
 	}
 
+	certExpiryWindowDays, err := getEnvInt("CERTIFICATION_EXPIRY_WINDOW_DAYS", 90)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CERTIFICATION_EXPIRY_WINDOW_DAYS: %w", err)
+	}
+
+	embeddingMatchThreshold, err := getEnvFloat("EMBEDDING_MATCH_THRESHOLD", 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid EMBEDDING_MATCH_THRESHOLD: %w", err)
+	}
+
 	// Environment determines database name: credfolio_dev or credfolio_test
 	env := getEnv("CREDFOLIO_ENV", "dev")
 	dbName := "credfolio_" + env
@@ -223,13 +280,19 @@ func Load() (*Config, error) {
 			IdleTimeout:  120 * time.Second,
 		},
 		Queue: QueueConfig{
-			MaxWorkers: queueMaxWorkers,
+			MaxWorkers:                    queueMaxWorkers,
+			CertificationExpiryWindowDays: certExpiryWindowDays,
 		},
 		LLM: LLMConfig{
 			DocumentExtractionModel:  os.Getenv("DOCUMENT_EXTRACTION_MODEL"),
 			ResumeExtractionModel:    os.Getenv("RESUME_EXTRACTION_MODEL"),
 			ReferenceExtractionModel: os.Getenv("REFERENCE_EXTRACTION_MODEL"),
 			DetectionModel:           os.Getenv("DETECTION_MODEL"),
+			WritingModel:             os.Getenv("WRITING_MODEL"),
+			CoverLetterModel:         os.Getenv("COVER_LETTER_MODEL"),
+			EmbeddingModel:           os.Getenv("EMBEDDING_MODEL"),
+			LocalEmbeddingURL:        os.Getenv("LOCAL_EMBEDDING_URL"),
+			EmbeddingMatchThreshold:  embeddingMatchThreshold,
 		},
 		Anthropic: AnthropicConfig{
 			APIKey: os.Getenv("ANTHROPIC_API_KEY"),
@@ -290,6 +353,15 @@ func getEnvInt(key string, defaultValue int) (int, error) {
 	return strconv.Atoi(value)
 }
 
+// getEnvFloat returns the environment variable as a float64 or a default.
+func getEnvFloat(key string, defaultValue float64) (float64, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
 // getEnvBool returns the environment variable as a bool or a default.
 func getEnvBool(key string, defaultValue bool) (bool, error) {
 	value := os.Getenv(key)
@@ -411,6 +411,84 @@ func TestParseReferenceExtractionModel(t *testing.T) {
 	}
 }
 
+func TestParseWritingModel(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        string
+		wantProvider string
+		wantModel    string
+	}{
+		{"empty defaults to anthropic", "", "anthropic", ""},
+		{"provider/model", "openai/gpt-4o", "openai", "gpt-4o"},
+		{"provider only", "openai", "openai", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := LLMConfig{WritingModel: tt.value}
+			provider, model := cfg.ParseWritingModel()
+			if provider != tt.wantProvider {
+				t.Errorf("provider = %q, want %q", provider, tt.wantProvider)
+			}
+			if model != tt.wantModel {
+				t.Errorf("model = %q, want %q", model, tt.wantModel)
+			}
+		})
+	}
+}
+
+func TestParseCoverLetterModel(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        string
+		wantProvider string
+		wantModel    string
+	}{
+		{"empty defaults to anthropic", "", "anthropic", ""},
+		{"provider/model", "openai/gpt-4o", "openai", "gpt-4o"},
+		{"provider only", "openai", "openai", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := LLMConfig{CoverLetterModel: tt.value}
+			provider, model := cfg.ParseCoverLetterModel()
+			if provider != tt.wantProvider {
+				t.Errorf("provider = %q, want %q", provider, tt.wantProvider)
+			}
+			if model != tt.wantModel {
+				t.Errorf("model = %q, want %q", model, tt.wantModel)
+			}
+		})
+	}
+}
+
+func TestParseEmbeddingModel(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        string
+		wantProvider string
+		wantModel    string
+	}{
+		{"empty defaults to openai", "", "openai", "text-embedding-3-small"},
+		{"provider/model", "local/nomic-embed-text", "local", "nomic-embed-text"},
+		{"provider only", "fake", "fake", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := LLMConfig{EmbeddingModel: tt.value}
+			provider, model := cfg.ParseEmbeddingModel()
+			if provider != tt.wantProvider {
+				t.Errorf("provider = %q, want %q", provider, tt.wantProvider)
+			}
+			if model != tt.wantModel {
+				t.Errorf("model = %q, want %q", model, tt.wantModel)
+			}
+		})
+	}
+}
+
 func TestLoad_LLMDefaults(t *testing.T) {
 	clearEnv(t)
 
@@ -453,6 +531,32 @@ func TestLoad_LLMOverrides(t *testing.T) {
 	}
 }
 
+func TestLoad_EmbeddingMatchThreshold(t *testing.T) {
+	clearEnv(t)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.LLM.EmbeddingMatchThreshold != 0 {
+		t.Errorf("LLM.EmbeddingMatchThreshold = %v, want 0", cfg.LLM.EmbeddingMatchThreshold)
+	}
+
+	t.Setenv("EMBEDDING_MATCH_THRESHOLD", "0.65")
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.LLM.EmbeddingMatchThreshold != 0.65 {
+		t.Errorf("LLM.EmbeddingMatchThreshold = %v, want 0.65", cfg.LLM.EmbeddingMatchThreshold)
+	}
+
+	t.Setenv("EMBEDDING_MATCH_THRESHOLD", "high")
+	if _, err := Load(); err == nil {
+		t.Error("expected an error for an invalid threshold")
+	}
+}
+
 // clearEnv clears all config-related environment variables for test isolation.
 func clearEnv(t *testing.T) {
 	t.Helper()
@@ -474,6 +578,11 @@ func clearEnv(t *testing.T) {
 		"DOCUMENT_EXTRACTION_MODEL",
 		"RESUME_EXTRACTION_MODEL",
 		"REFERENCE_EXTRACTION_MODEL",
+		"WRITING_MODEL",
+		"COVER_LETTER_MODEL",
+		"EMBEDDING_MODEL",
+		"LOCAL_EMBEDDING_URL",
+		"EMBEDDING_MATCH_THRESHOLD",
 		"LLM_PROVIDER",
 	}
 
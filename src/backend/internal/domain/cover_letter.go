@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// CoverLetterJob is the job a cover letter is written for.
+type CoverLetterJob struct {
+	Title   *string
+	Company *string
+	Text    string
+}
+
+// CoverLetterQuote is a passage of a cover letter quoted from a testimonial. Text is a
+// verbatim excerpt of the testimonial's quote.
+type CoverLetterQuote struct {
+	TestimonialID uuid.UUID `json:"testimonialId"`
+	Text          string    `json:"text"`
+}
+
+// CoverLetterDraft is the output of a cover letter request. Citations are the facts the
+// letter draws on and Quotes the testimonial passages it quotes, as the LLM reports them;
+// neither is verified against the stored testimonials yet.
+type CoverLetterDraft struct {
+	Body      string
+	Citations []WritingCitation
+	Quotes    []CoverLetterQuote
+	Metadata  ExtractionMetadata
+}
+
+// CoverLetterVersionSource is how a cover letter version came about.
+type CoverLetterVersionSource string
+
+// Cover letter version sources.
+const (
+	CoverLetterVersionGenerated CoverLetterVersionSource = "generated"
+	CoverLetterVersionEdited    CoverLetterVersionSource = "edited"
+)
+
+// CoverLetter is a cover letter written from a profile for a job description. Its text
+// lives in versions: the generated draft is version 1 and each edit adds the next, so
+// earlier wording is never lost.
+type CoverLetter struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:cover_letters,alias:cl"`
+
+	ID               uuid.UUID  `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID        uuid.UUID  `bun:"profile_id,notnull,type:uuid"`
+	JobDescriptionID *uuid.UUID `bun:"job_description_id,type:uuid"`
+	Title            string     `bun:"title,notnull"`
+	LatestVersion    int        `bun:"latest_version,notnull"`
+	CreatedAt        time.Time  `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt        time.Time  `bun:"updated_at,notnull,default:current_timestamp"`
+
+	// Relations
+	Profile        *Profile        `bun:"rel:belongs-to,join:profile_id=id"`
+	JobDescription *JobDescription `bun:"rel:belongs-to,join:job_description_id=id"`
+}
+
+// CoverLetterVersion is one version of a cover letter's text. Quotes are the testimonial
+// passages the text quotes, each verified against the stored testimonial when the version
+// was saved.
+type CoverLetterVersion struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:cover_letter_versions,alias:clv"`
+
+	ID            uuid.UUID                `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	CoverLetterID uuid.UUID                `bun:"cover_letter_id,notnull,type:uuid"`
+	Version       int                      `bun:"version,notnull"`
+	Body          string                   `bun:"body,notnull"`
+	Citations     []WritingCitation        `bun:"citations,type:jsonb,notnull"`
+	Quotes        []CoverLetterQuote       `bun:"quotes,type:jsonb,notnull"`
+	Source        CoverLetterVersionSource `bun:"source,notnull"`
+	ModelVersion  string                   `bun:"model_version,notnull,default:''"`
+	CreatedAt     time.Time                `bun:"created_at,notnull,default:current_timestamp"`
+
+	// Relations
+	CoverLetter *CoverLetter `bun:"rel:belongs-to,join:cover_letter_id=id"`
+}
+
+// CoverLetterRepository defines operations for cover letter persistence.
+type CoverLetterRepository interface {
+	// Create persists a new cover letter with its first version.
+	Create(ctx context.Context, letter *CoverLetter, version *CoverLetterVersion) error
+
+	// GetByID retrieves a cover letter by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*CoverLetter, error)
+
+	// GetByProfileID retrieves all cover letters of a profile, newest first.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*CoverLetter, error)
+
+	// AddVersion persists the next version of a cover letter and makes it the latest.
+	AddVersion(ctx context.Context, letter *CoverLetter, version *CoverLetterVersion) error
+
+	// GetVersions retrieves all versions of a cover letter, newest first.
+	GetVersions(ctx context.Context, coverLetterID uuid.UUID) ([]*CoverLetterVersion, error)
+
+	// GetVersion retrieves one version of a cover letter.
+	GetVersion(ctx context.Context, coverLetterID uuid.UUID, version int) (*CoverLetterVersion, error)
+
+	// Delete removes a cover letter and its versions.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,117 @@
+// Package domain contains the core business entities and repository interfaces.
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// CredentialDocumentType identifies which dedicated extractor handles a credential document.
+type CredentialDocumentType string
+
+// Credential document type constants.
+const (
+	CredentialDocumentTypeCertificate       CredentialDocumentType = "certificate"
+	CredentialDocumentTypeTranscript        CredentialDocumentType = "transcript"
+	CredentialDocumentTypePerformanceReview CredentialDocumentType = "performance_review"
+)
+
+// CredentialDocumentStatus represents the processing status of a credential document.
+type CredentialDocumentStatus string
+
+// Credential document status constants.
+const (
+	CredentialDocumentStatusPending    CredentialDocumentStatus = "pending"
+	CredentialDocumentStatusProcessing CredentialDocumentStatus = "processing"
+	CredentialDocumentStatusCompleted  CredentialDocumentStatus = "completed"
+	CredentialDocumentStatusFailed     CredentialDocumentStatus = "failed"
+)
+
+// CredentialDocument is an uploaded certificate/license, academic transcript/diploma or
+// performance review. Unlike reference letters these documents are issued by an institution
+// or employer and therefore carry more verifiable weight as evidence.
+type CredentialDocument struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:credential_documents,alias:cd"`
+
+	ID            uuid.UUID                `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	UserID        uuid.UUID                `bun:"user_id,notnull,type:uuid"`
+	FileID        uuid.UUID                `bun:"file_id,notnull,type:uuid"`
+	DocumentType  CredentialDocumentType   `bun:"document_type,notnull"`
+	Status        CredentialDocumentStatus `bun:"status,notnull,default:'pending'"`
+	ExtractedData json.RawMessage          `bun:"extracted_data,type:jsonb"`
+	ErrorMessage  *string                  `bun:"error_message"`
+	CreatedAt     time.Time                `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt     time.Time                `bun:"updated_at,notnull,default:current_timestamp"`
+
+	// Relations
+	User *User `bun:"rel:belongs-to,join:user_id=id"`
+	File *File `bun:"rel:belongs-to,join:file_id=id"`
+}
+
+// CredentialDocumentRepository defines operations for credential document persistence.
+type CredentialDocumentRepository interface {
+	// Create persists a new credential document.
+	Create(ctx context.Context, doc *CredentialDocument) error
+
+	// GetByID retrieves a credential document by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*CredentialDocument, error)
+
+	// GetByUserID retrieves all credential documents belonging to a user.
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*CredentialDocument, error)
+
+	// Update persists changes to an existing credential document.
+	Update(ctx context.Context, doc *CredentialDocument) error
+
+	// Delete removes a credential document by its ID.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// ExtractedCertificateData is the structured data extracted from a certificate or license.
+type ExtractedCertificateData struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
+	Name            string             `json:"name"`
+	Issuer          string             `json:"issuer"`
+	HolderName      *string            `json:"holderName,omitempty"`
+	CredentialID    *string            `json:"credentialId,omitempty"`
+	VerificationURL *string            `json:"verificationUrl,omitempty"`
+	IssueDate       *string            `json:"issueDate,omitempty"`
+	ExpiryDate      *string            `json:"expiryDate,omitempty"`
+	Skills          []string           `json:"skills"`
+	Metadata        ExtractionMetadata `json:"metadata"`
+}
+
+// ExtractedCourse is a single course line from an academic transcript.
+type ExtractedCourse struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
+	Name    string  `json:"name"`
+	Grade   *string `json:"grade,omitempty"`
+	Credits *string `json:"credits,omitempty"`
+}
+
+// ExtractedTranscriptData is the structured data extracted from an academic transcript or diploma.
+type ExtractedTranscriptData struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
+	HolderName     *string            `json:"holderName,omitempty"`
+	Institution    string             `json:"institution"`
+	Degree         *string            `json:"degree,omitempty"`
+	Field          *string            `json:"field,omitempty"`
+	StartDate      *string            `json:"startDate,omitempty"`
+	GraduationDate *string            `json:"graduationDate,omitempty"`
+	GPA            *string            `json:"gpa,omitempty"`
+	Honors         *string            `json:"honors,omitempty"`
+	Courses        []ExtractedCourse  `json:"courses"`
+	Metadata       ExtractionMetadata `json:"metadata"`
+}
+
+// ExtractedPerformanceReviewData is the structured data extracted from a performance review.
+// The reviewer is treated like a letter author; review statements become testimonials.
+type ExtractedPerformanceReviewData struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
+	Reviewer     ExtractedAuthor        `json:"reviewer"`
+	Company      *string                `json:"company,omitempty"`
+	Role         *string                `json:"role,omitempty"`
+	ReviewPeriod *string                `json:"reviewPeriod,omitempty"`
+	Rating       *string                `json:"rating,omitempty"`
+	Testimonials []ExtractedTestimonial `json:"testimonials"`
+	Metadata     ExtractionMetadata     `json:"metadata"`
+}
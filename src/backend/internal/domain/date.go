@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// DatePrecision records how much of a date its original text specified.
+type DatePrecision string
+
+// Date precision constants.
+const (
+	DatePrecisionYear    DatePrecision = "year"    // "2019"
+	DatePrecisionSeason  DatePrecision = "season"  // "Spring 2019", "Q3 2019", "WS 2019/20"
+	DatePrecisionMonth   DatePrecision = "month"   // "03/2019", "März 2019", "2019-03-15"
+	DatePrecisionPresent DatePrecision = "present" // "Present", "heute"; an ongoing end
+)
+
+// PartialDate is a free-text date parsed to the precision its text gives. Month is the
+// first month of a season and zero for a bare year; Year is zero for a present date.
+// The zero value means the text was missing or could not be parsed.
+type PartialDate struct {
+	Year      int           `bun:"year,nullzero"`
+	Month     int           `bun:"month,nullzero"`
+	Precision DatePrecision `bun:"precision,nullzero"`
+}
+
+// IsZero reports whether the date is unknown.
+func (d PartialDate) IsZero() bool {
+	return d.Precision == ""
+}
+
+// IsPresent reports whether the date stands for "now", as in an ongoing role.
+func (d PartialDate) IsPresent() bool {
+	return d.Precision == DatePrecisionPresent
+}
+
+// FirstMonth returns the month index (see MonthIndex) at which the date begins: January
+// for a bare year and the first month of a season. ok is false for unknown and present dates.
+func (d PartialDate) FirstMonth() (int, bool) {
+	switch d.Precision {
+	case DatePrecisionYear:
+		return MonthIndex(d.Year, 1), true
+	case DatePrecisionSeason, DatePrecisionMonth:
+		return MonthIndex(d.Year, d.Month), true
+	}
+	return 0, false
+}
+
+// LastMonth returns the month index at which the date ends: December for a bare year and
+// the last month of a season. ok is false for unknown and present dates.
+func (d PartialDate) LastMonth() (int, bool) {
+	switch d.Precision {
+	case DatePrecisionYear:
+		return MonthIndex(d.Year, 12), true
+	case DatePrecisionSeason:
+		return MonthIndex(d.Year, d.Month) + 2, true
+	case DatePrecisionMonth:
+		return MonthIndex(d.Year, d.Month), true
+	}
+	return 0, false
+}
+
+// MonthIndex numbers months consecutively so that spans can be subtracted.
+func MonthIndex(year, month int) int {
+	return year*12 + month - 1
+}
+
+// MonthStart returns the first day of the month with the given index.
+func MonthStart(index int) time.Time {
+	return time.Date(index/12, time.Month(index%12+1), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// DateRange is the span of a dated profile entry, such as a role or a degree.
+// An ongoing range runs until now whatever its End says.
+type DateRange struct {
+	Start   PartialDate
+	End     PartialDate
+	Ongoing bool
+}
+
+// Months returns the first and last month the range covers, counting a bare year in full.
+// ok is false when the start, or the end of a range that is not ongoing, is unknown.
+func (r DateRange) Months(now time.Time) (first, last int, ok bool) {
+	first, ok = r.Start.FirstMonth()
+	if !ok {
+		return 0, 0, false
+	}
+	last, ok = r.LastMonth(now)
+	return first, last, ok
+}
+
+// LastMonth returns the month the range ends in, which is the current month for an
+// ongoing range. ok is false when the end is unknown.
+func (r DateRange) LastMonth(now time.Time) (int, bool) {
+	if r.Ongoing || r.End.IsPresent() {
+		return MonthIndex(now.Year(), int(now.Month())), true
+	}
+	return r.End.LastMonth()
+}
+
+// DurationMonths returns the number of months the range covers, counting both ends.
+func (r DateRange) DurationMonths(now time.Time) (int, bool) {
+	first, last, ok := r.Months(now)
+	if !ok || last < first {
+		return 0, false
+	}
+	return last - first + 1, true
+}
+
+// EndsBeforeStart reports whether both ends are known and the end falls before the start.
+// Dates are compared at the coarser precision, so "Mar 2019" to "2019" is accepted.
+func (r DateRange) EndsBeforeStart() bool {
+	if r.Ongoing {
+		return false
+	}
+	first, ok := r.Start.FirstMonth()
+	if !ok {
+		return false
+	}
+	last, ok := r.End.LastMonth()
+	return ok && last < first
+}
+
+func isBlank(s *string) bool {
+	return s == nil || strings.TrimSpace(*s) == ""
+}
@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"backend/internal/domain"
+)
+
+func TestDateRangeDurationMonths(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	year := func(y int) domain.PartialDate {
+		return domain.PartialDate{Year: y, Precision: domain.DatePrecisionYear}
+	}
+	month := func(y, m int) domain.PartialDate {
+		return domain.PartialDate{Year: y, Month: m, Precision: domain.DatePrecisionMonth}
+	}
+	season := func(y, m int) domain.PartialDate {
+		return domain.PartialDate{Year: y, Month: m, Precision: domain.DatePrecisionSeason}
+	}
+
+	tests := []struct {
+		name     string
+		r        domain.DateRange
+		expected int
+		ok       bool
+	}{
+		{"months", domain.DateRange{Start: month(2019, 3), End: month(2020, 2)}, 12, true},
+		{"same month", domain.DateRange{Start: month(2019, 3), End: month(2019, 3)}, 1, true},
+		{"bare years count in full", domain.DateRange{Start: year(2018), End: year(2020)}, 36, true},
+		{"season end covers the season", domain.DateRange{Start: month(2019, 1), End: season(2019, 3)}, 5, true},
+		{"present end", domain.DateRange{Start: month(2026, 1), End: domain.PartialDate{Precision: domain.DatePrecisionPresent}}, 10, true},
+		{"ongoing", domain.DateRange{Start: month(2025, 11), Ongoing: true}, 12, true},
+		{"unknown start", domain.DateRange{End: month(2020, 1)}, 0, false},
+		{"unknown end", domain.DateRange{Start: month(2020, 1)}, 0, false},
+		{"end before start", domain.DateRange{Start: month(2020, 1), End: month(2019, 1)}, 0, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := tc.r.DurationMonths(now)
+			if got != tc.expected || ok != tc.ok {
+				t.Errorf("DurationMonths = (%d, %v), want (%d, %v)", got, ok, tc.expected, tc.ok)
+			}
+		})
+	}
+}
+
+func TestDateRangeEndsBeforeStart(t *testing.T) {
+	year := func(y int) domain.PartialDate {
+		return domain.PartialDate{Year: y, Precision: domain.DatePrecisionYear}
+	}
+	month := func(y, m int) domain.PartialDate {
+		return domain.PartialDate{Year: y, Month: m, Precision: domain.DatePrecisionMonth}
+	}
+
+	tests := []struct {
+		name     string
+		r        domain.DateRange
+		expected bool
+	}{
+		{"end before start", domain.DateRange{Start: month(2020, 3), End: month(2020, 2)}, true},
+		{"end year before start year", domain.DateRange{Start: year(2020), End: year(2019)}, true},
+		{"same month", domain.DateRange{Start: month(2020, 3), End: month(2020, 3)}, false},
+		{"coarser end in the same year", domain.DateRange{Start: month(2019, 3), End: year(2019)}, false},
+		{"coarser start in the same year", domain.DateRange{Start: year(2019), End: month(2019, 1)}, false},
+		{"ongoing", domain.DateRange{Start: month(2020, 3), End: month(2019, 1), Ongoing: true}, false},
+		{"present end", domain.DateRange{Start: month(2020, 3), End: domain.PartialDate{Precision: domain.DatePrecisionPresent}}, false},
+		{"unknown end", domain.DateRange{Start: month(2020, 3)}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.r.EndsBeforeStart(); got != tc.expected {
+				t.Errorf("EndsBeforeStart = %v, want %v", got, tc.expected)
+			}
+		})
+	}
+}
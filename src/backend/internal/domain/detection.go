@@ -6,10 +6,13 @@ type DocumentTypeHint string
 
 // Document type hint constants.
 const (
-	DocumentTypeResume          DocumentTypeHint = "resume"
-	DocumentTypeReferenceLetter DocumentTypeHint = "reference_letter"
-	DocumentTypeHybrid          DocumentTypeHint = "hybrid"
-	DocumentTypeUnknown         DocumentTypeHint = "unknown"
+	DocumentTypeResume            DocumentTypeHint = "resume"
+	DocumentTypeReferenceLetter   DocumentTypeHint = "reference_letter"
+	DocumentTypeHybrid            DocumentTypeHint = "hybrid"
+	DocumentTypeCertificate       DocumentTypeHint = "certificate"
+	DocumentTypeTranscript        DocumentTypeHint = "transcript"
+	DocumentTypePerformanceReview DocumentTypeHint = "performance_review"
+	DocumentTypeUnknown           DocumentTypeHint = "unknown"
 )
 
 // DocumentDetectionResult contains the lightweight classification of a document's content.
@@ -30,6 +33,7 @@ type DocumentDetectionResult struct { //nolint:govet // Field order prioritizes
 	// Summary is a brief description of what was found in the document.
 	Summary string `json:"summary"`
 
-	// DocumentTypeHint classifies the document as "resume", "reference_letter", "hybrid", or "unknown".
+	// DocumentTypeHint classifies the document as "resume", "reference_letter", "hybrid",
+	// "certificate", "transcript", "performance_review", or "unknown".
 	DocumentTypeHint DocumentTypeHint `json:"documentTypeHint"`
 }
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Embedder turns text into vectors whose cosine similarity reflects how close the texts
+// are in meaning. It is the embedding counterpart of LLMProvider.
+type Embedder interface {
+	// Embed returns one vector per text, in the order given. All vectors an embedder
+	// returns have the same length.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+
+	// Name identifies the embedder and its model (e.g., "openai/text-embedding-3-small").
+	// Vectors from embedders with different names are not comparable.
+	Name() string
+
+	// MatchThreshold is the cosine similarity from which the model's vectors of two short
+	// texts are taken to mean the same thing. Models spread similarities differently, so
+	// each has its own.
+	MatchThreshold() float64
+}
+
+// Vector is an embedding stored in a pgvector column. It is written and read in
+// pgvector's text format, e.g. "[0.1,-0.2,0.3]".
+type Vector []float32
+
+// Value implements driver.Valuer.
+func (v Vector) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	parts := make([]string, len(v))
+	for i, x := range v {
+		parts[i] = strconv.FormatFloat(float64(x), 'f', -1, 32)
+	}
+	return "[" + strings.Join(parts, ",") + "]", nil
+}
+
+// Scan implements sql.Scanner.
+func (v *Vector) Scan(src any) error {
+	var text string
+	switch s := src.(type) {
+	case nil:
+		*v = nil
+		return nil
+	case string:
+		text = s
+	case []byte:
+		text = string(s)
+	default:
+		return fmt.Errorf("cannot scan %T into Vector", src)
+	}
+
+	text = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(text), "["), "]")
+	if text == "" {
+		*v = Vector{}
+		return nil
+	}
+	parts := strings.Split(text, ",")
+	vector := make(Vector, len(parts))
+	for i, part := range parts {
+		x, err := strconv.ParseFloat(strings.TrimSpace(part), 32)
+		if err != nil {
+			return fmt.Errorf("invalid vector component %q: %w", part, err)
+		}
+		vector[i] = float32(x)
+	}
+	*v = vector
+	return nil
+}
+
+// EvidenceEmbedding is the embedding of one piece of a profile's evidence: a testimonial,
+// the quote validating a skill, a skill name, or an experience's description and highlights.
+// ContentHash is the SHA-256
+// of Content, so unchanged evidence is not embedded again.
+type EvidenceEmbedding struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:evidence_embeddings,alias:ee"`
+
+	ID          uuid.UUID    `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID   uuid.UUID    `bun:"profile_id,notnull,type:uuid"`
+	SourceKind  EvidenceKind `bun:"source_kind,notnull"`
+	SourceID    uuid.UUID    `bun:"source_id,notnull,type:uuid"`
+	Content     string       `bun:"content,notnull"`
+	ContentHash string       `bun:"content_hash,notnull"`
+	Model       string       `bun:"model,notnull"`
+	Embedding   Vector       `bun:"embedding,notnull,type:vector"`
+	CreatedAt   time.Time    `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt   time.Time    `bun:"updated_at,notnull,default:current_timestamp"`
+}
+
+// EvidenceMatch is a piece of evidence found by a semantic search, with the cosine
+// similarity of its embedding to the query's.
+type EvidenceMatch struct {
+	Embedding *EvidenceEmbedding
+	Score     float64
+}
+
+// EvidenceEmbeddingRepository defines operations for evidence embedding persistence.
+type EvidenceEmbeddingRepository interface {
+	// GetByProfileID retrieves all embeddings of a profile's evidence.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*EvidenceEmbedding, error)
+
+	// Upsert saves embeddings, replacing the one stored for the same source.
+	Upsert(ctx context.Context, embeddings []*EvidenceEmbedding) error
+
+	// Delete removes embeddings by ID.
+	Delete(ctx context.Context, ids []uuid.UUID) error
+
+	// Search returns up to limit of the profile's evidence embeddings made by model, most
+	// similar to vector first. Skill name embeddings are not evidence and are left out.
+	Search(ctx context.Context, profileID uuid.UUID, model string, vector Vector, limit int) ([]*EvidenceMatch, error)
+}
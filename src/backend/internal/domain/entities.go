@@ -100,11 +100,44 @@ type Author struct { //nolint:govet // Field ordering prioritizes readability ov
 	Company     *string    `bun:"company"`
 	LinkedInURL *string    `bun:"linkedin_url"`
 	ImageID     *uuid.UUID `bun:"image_id,type:uuid"`
+	CompanyID   *uuid.UUID `bun:"company_id,type:uuid"`
 	CreatedAt   time.Time  `bun:"created_at,notnull,default:current_timestamp"`
 	UpdatedAt   time.Time  `bun:"updated_at,notnull,default:current_timestamp"`
 
 	// Relations
-	Image *File `bun:"rel:belongs-to,join:image_id=id"`
+	Image         *File    `bun:"rel:belongs-to,join:image_id=id"`
+	CompanyEntity *Company `bun:"rel:belongs-to,join:company_id=id"`
+}
+
+// Company is an employer or organization on a profile. Experiences and authors link to it,
+// so everything known about one company can be gathered without comparing free-text names.
+// Other names the company is known by are CompanyAlias rows whose canonical name is its name.
+type Company struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:companies,alias:c"`
+
+	ID        uuid.UUID  `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID uuid.UUID  `bun:"profile_id,notnull,type:uuid"`
+	Name      string     `bun:"name,notnull"`
+	Website   *string    `bun:"website"`
+	LogoID    *uuid.UUID `bun:"logo_id,type:uuid"`
+	CreatedAt time.Time  `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt time.Time  `bun:"updated_at,notnull,default:current_timestamp"`
+
+	// Relations
+	Logo *File `bun:"rel:belongs-to,join:logo_id=id"`
+}
+
+// CompanyAlias records that two company names refer to the same company, e.g. a former
+// name or a trade name. Company matching resolves Alias to CanonicalName before comparing.
+type CompanyAlias struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:company_aliases,alias:ca"`
+
+	ID            uuid.UUID `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID     uuid.UUID `bun:"profile_id,notnull,type:uuid"`
+	Alias         string    `bun:"alias,notnull"`
+	CanonicalName string    `bun:"canonical_name,notnull"`
+	CreatedAt     time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt     time.Time `bun:"updated_at,notnull,default:current_timestamp"`
 }
 
 // TestimonialRelationship represents the relationship between the author and the candidate.
@@ -112,33 +145,40 @@ type TestimonialRelationship string
 
 // Testimonial relationship constants.
 const (
-	TestimonialRelationshipManager      TestimonialRelationship = "manager"
-	TestimonialRelationshipPeer         TestimonialRelationship = "peer"
-	TestimonialRelationshipDirectReport TestimonialRelationship = "direct_report"
-	TestimonialRelationshipClient       TestimonialRelationship = "client"
-	TestimonialRelationshipOther        TestimonialRelationship = "other"
+	TestimonialRelationshipManager           TestimonialRelationship = "manager"
+	TestimonialRelationshipPeer              TestimonialRelationship = "peer"
+	TestimonialRelationshipDirectReport      TestimonialRelationship = "direct_report"
+	TestimonialRelationshipClient            TestimonialRelationship = "client"
+	TestimonialRelationshipMentor            TestimonialRelationship = "mentor"
+	TestimonialRelationshipProfessor         TestimonialRelationship = "professor"
+	TestimonialRelationshipColleague         TestimonialRelationship = "colleague"
+	TestimonialRelationshipPerformanceReview TestimonialRelationship = "performance_review"
+	TestimonialRelationshipOther             TestimonialRelationship = "other"
 )
 
-// Testimonial represents a quote from a reference letter displayed on the profile.
+// Testimonial represents a quote from a reference letter or performance review displayed on the profile.
+// Exactly one of ReferenceLetterID or CredentialDocumentID identifies the source document.
 type Testimonial struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
 	bun.BaseModel `bun:"table:testimonials,alias:t"`
 
-	ID                uuid.UUID               `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
-	ProfileID         uuid.UUID               `bun:"profile_id,notnull,type:uuid"`
-	ReferenceLetterID uuid.UUID               `bun:"reference_letter_id,notnull,type:uuid"`
-	AuthorID          *uuid.UUID              `bun:"author_id,type:uuid"`
-	Quote             string                  `bun:"quote,notnull"`
-	AuthorName        *string                 `bun:"author_name"`
-	AuthorTitle       *string                 `bun:"author_title"`
-	AuthorCompany     *string                 `bun:"author_company"`
-	Relationship      TestimonialRelationship `bun:"relationship,notnull,default:'other'"`
-	SkillsMentioned   []string                `bun:"skills_mentioned,array"`
-	CreatedAt         time.Time               `bun:"created_at,notnull,default:current_timestamp"`
-	UpdatedAt         time.Time               `bun:"updated_at,notnull,default:current_timestamp"`
+	ID                   uuid.UUID               `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID            uuid.UUID               `bun:"profile_id,notnull,type:uuid"`
+	ReferenceLetterID    uuid.UUID               `bun:"reference_letter_id,nullzero,type:uuid"`
+	CredentialDocumentID *uuid.UUID              `bun:"credential_document_id,type:uuid"`
+	AuthorID             *uuid.UUID              `bun:"author_id,type:uuid"`
+	Quote                string                  `bun:"quote,notnull"`
+	AuthorName           *string                 `bun:"author_name"`
+	AuthorTitle          *string                 `bun:"author_title"`
+	AuthorCompany        *string                 `bun:"author_company"`
+	Relationship         TestimonialRelationship `bun:"relationship,notnull,default:'other'"`
+	SkillsMentioned      []string                `bun:"skills_mentioned,array"`
+	CreatedAt            time.Time               `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt            time.Time               `bun:"updated_at,notnull,default:current_timestamp"`
 
 	// Relations
-	ReferenceLetter *ReferenceLetter `bun:"rel:belongs-to,join:reference_letter_id=id"`
-	Author          *Author          `bun:"rel:belongs-to,join:author_id=id"`
+	ReferenceLetter    *ReferenceLetter    `bun:"rel:belongs-to,join:reference_letter_id=id"`
+	CredentialDocument *CredentialDocument `bun:"rel:belongs-to,join:credential_document_id=id"`
+	Author             *Author             `bun:"rel:belongs-to,join:author_id=id"`
 }
 
 // SkillValidation links a profile skill to a reference letter that validates it.
@@ -171,3 +211,59 @@ type ExperienceValidation struct { //nolint:govet // Field ordering prioritizes
 	// Relations
 	ReferenceLetter *ReferenceLetter `bun:"rel:belongs-to,join:reference_letter_id=id"`
 }
+
+// EducationValidation links a profile education entry to a reference letter that validates it,
+// typically one written by a professor or mentor from that institution.
+type EducationValidation struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:education_validations,alias:edv"`
+
+	ID                 uuid.UUID `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileEducationID uuid.UUID `bun:"profile_education_id,notnull,type:uuid"`
+	ReferenceLetterID  uuid.UUID `bun:"reference_letter_id,notnull,type:uuid"`
+	QuoteSnippet       *string   `bun:"quote_snippet"`
+	CreatedAt          time.Time `bun:"created_at,notnull,default:current_timestamp"`
+
+	// Relations
+	ReferenceLetter *ReferenceLetter `bun:"rel:belongs-to,join:reference_letter_id=id"`
+}
+
+// InconsistencyField names the experience field a reference letter contradicts.
+type InconsistencyField string
+
+// Inconsistency field constants.
+const (
+	InconsistencyFieldTitle     InconsistencyField = "title"
+	InconsistencyFieldStartDate InconsistencyField = "start_date"
+	InconsistencyFieldEndDate   InconsistencyField = "end_date"
+)
+
+// InconsistencyStatus tracks whether the candidate has dealt with an inconsistency.
+type InconsistencyStatus string
+
+// Inconsistency status constants.
+const (
+	InconsistencyStatusOpen      InconsistencyStatus = "open"
+	InconsistencyStatusResolved  InconsistencyStatus = "resolved"
+	InconsistencyStatusDismissed InconsistencyStatus = "dismissed"
+)
+
+// ProfileInconsistency records a discrepancy between a profile experience and what a
+// reference letter says about the same role, such as a different title or tenure.
+// ResumeValue and LetterValue hold the conflicting values as written.
+type ProfileInconsistency struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:profile_inconsistencies,alias:pi"`
+
+	ID                  uuid.UUID           `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID           uuid.UUID           `bun:"profile_id,notnull,type:uuid"`
+	ProfileExperienceID uuid.UUID           `bun:"profile_experience_id,notnull,type:uuid"`
+	ReferenceLetterID   uuid.UUID           `bun:"reference_letter_id,notnull,type:uuid"`
+	Field               InconsistencyField  `bun:"field,notnull"`
+	ResumeValue         string              `bun:"resume_value,notnull"`
+	LetterValue         string              `bun:"letter_value,notnull"`
+	Quote               *string             `bun:"quote"`
+	Status              InconsistencyStatus `bun:"status,notnull,default:'open'"`
+	ResolutionNote      *string             `bun:"resolution_note"`
+	ResolvedAt          *time.Time          `bun:"resolved_at"`
+	CreatedAt           time.Time           `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt           time.Time           `bun:"updated_at,notnull,default:current_timestamp"`
+}
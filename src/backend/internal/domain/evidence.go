@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// EvidenceKind is the kind of record a piece of evidence about a profile comes from.
+type EvidenceKind string
+
+// Evidence kinds.
+const (
+	EvidenceTestimonial          EvidenceKind = "testimonial"
+	EvidenceSkillValidation      EvidenceKind = "skill_validation"
+	EvidenceExperienceValidation EvidenceKind = "experience_validation"
+	EvidenceExperience           EvidenceKind = "experience"
+
+	// EvidenceSkillName is the name of a profile skill. Its embedding is kept for matching
+	// skill mentions by meaning; it is not evidence and searches do not return it.
+	EvidenceSkillName EvidenceKind = "skill_name"
+)
+
+// Evidence is a quote from a reference letter backing a profile: a testimonial, or the
+// passage validating a skill or an experience. Evidence of kind EvidenceExperience is
+// instead the profile's own description and highlights of an experience. ID is the ID of
+// that record, and Context says who wrote the quote or what it validates, e.g.
+// "validates the skill Kubernetes".
+type Evidence struct {
+	ID      uuid.UUID
+	Kind    EvidenceKind
+	Quote   string
+	Context string
+}
+
+// EvidenceCitation points an answer to the evidence it rests on. Quote is the exact
+// passage of the evidence's quote the answer relies on.
+type EvidenceCitation struct {
+	Kind  EvidenceKind
+	ID    uuid.UUID
+	Quote string
+}
+
+// ProfileAnswer is an answer to a question about a profile. Supported is false when the
+// evidence does not answer the question, in which case the answer says so and cites nothing.
+type ProfileAnswer struct {
+	Answer    string
+	Supported bool
+	Citations []EvidenceCitation
+	Metadata  ExtractionMetadata
+}
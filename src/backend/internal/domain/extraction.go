@@ -10,10 +10,17 @@ import "time"
 //   - MINOR: Significant prompt improvements or new instructions
 //   - PATCH: Clarifications, typo fixes, minor wording changes
 const (
-	ResumeExtractionPromptVersion    = "v1.1.0" // Changed: summary extraction-only (no synthesis)
-	LetterExtractionPromptVersion    = "v1.2.0" // Changed: allow unknown authors for German-style letters
-	DocumentDetectionPromptVersion   = "v1.0.0" // Unchanged
-	DocumentExtractionPromptVersion  = "v1.0.0" // Unchanged
+	ResumeExtractionPromptVersion            = "v1.3.0" // Changed: experience highlights with quantified flag
+	LetterExtractionPromptVersion            = "v1.2.0" // Changed: allow unknown authors for German-style letters
+	DocumentDetectionPromptVersion           = "v1.1.0" // Changed: certificate, transcript and performance review types
+	DocumentExtractionPromptVersion          = "v1.0.0" // Unchanged
+	CertificateExtractionPromptVersion       = "v1.0.0" // Initial version
+	TranscriptExtractionPromptVersion        = "v1.0.0" // Initial version
+	PerformanceReviewExtractionPromptVersion = "v1.0.0" // Initial version
+	JobDescriptionExtractionPromptVersion    = "v1.0.0" // Initial version
+	ProfileWritingPromptVersion              = "v1.0.0" // Initial version
+	CoverLetterPromptVersion                 = "v1.0.0" // Initial version
+	ProfileQAPromptVersion                   = "v1.1.0" // Changed: candidate's own experience descriptions as evidence
 )
 
 // AuthorRelationship represents the relationship type between letter author and candidate.
@@ -63,10 +70,13 @@ type ExtractedSkillMention struct { //nolint:govet // Field ordering prioritizes
 }
 
 // ExtractedExperienceMention represents a reference to a role/company in the letter.
-type ExtractedExperienceMention struct {
-	Company string `json:"company"`
-	Role    string `json:"role"`
-	Quote   string `json:"quote"`
+// StartDate and EndDate are the tenure as the letter states it, if it does.
+type ExtractedExperienceMention struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
+	Company   string  `json:"company"`
+	Role      string  `json:"role"`
+	Quote     string  `json:"quote"`
+	StartDate *string `json:"startDate,omitempty"`
+	EndDate   *string `json:"endDate,omitempty"`
 }
 
 // ExtractionMetadata contains information about the extraction process.
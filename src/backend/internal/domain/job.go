@@ -26,6 +26,10 @@ type UnifiedDocumentProcessingRequest struct { //nolint:govet // Field ordering
 	// and stores results in the linked entity.
 	ResumeID          *uuid.UUID
 	ReferenceLetterID *uuid.UUID
+
+	// CredentialDocumentIDs lists certificate, transcript and performance review records.
+	// Each record's DocumentType selects the dedicated extractor.
+	CredentialDocumentIDs []uuid.UUID
 }
 
 // DocumentDetectionRequest contains the data needed to enqueue a document detection job.
@@ -36,6 +40,11 @@ type DocumentDetectionRequest struct { //nolint:govet // Field ordering prioriti
 	ContentType string
 }
 
+// EvidenceEmbeddingRequest contains the data needed to enqueue an evidence embedding job.
+type EvidenceEmbeddingRequest struct {
+	UserID uuid.UUID
+}
+
 // JobEnqueuer defines the interface for enqueueing background jobs.
 type JobEnqueuer interface {
 	// EnqueueDocumentProcessing adds a document processing job to the queue.
@@ -45,10 +54,14 @@ type JobEnqueuer interface {
 	EnqueueResumeProcessing(ctx context.Context, req ResumeProcessingRequest) error
 
 	// EnqueueUnifiedDocumentProcessing adds a unified document processing job to the queue.
-	// The unified worker extracts text once and runs the selected extractors (resume, letter, or both).
+	// The unified worker extracts text once and runs the selected extractors (resume, letter, credentials, or any combination).
 	EnqueueUnifiedDocumentProcessing(ctx context.Context, req UnifiedDocumentProcessingRequest) error
 
 	// EnqueueDocumentDetection adds a document detection job to the queue.
 	// The worker extracts text and runs lightweight content classification.
 	EnqueueDocumentDetection(ctx context.Context, req DocumentDetectionRequest) error
+
+	// EnqueueEvidenceEmbedding adds a job embedding the evidence of the user's profile,
+	// to run after materialization or an edit.
+	EnqueueEvidenceEmbedding(ctx context.Context, req EvidenceEmbeddingRequest) error
 }
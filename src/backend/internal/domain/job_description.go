@@ -0,0 +1,92 @@
+// Package domain contains the core business entities and repository interfaces.
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// JobSeniority is the seniority level a job description asks for.
+type JobSeniority string
+
+// Job seniority constants, from least to most senior.
+const (
+	JobSeniorityIntern    JobSeniority = "intern"
+	JobSeniorityJunior    JobSeniority = "junior"
+	JobSeniorityMid       JobSeniority = "mid"
+	JobSenioritySenior    JobSeniority = "senior"
+	JobSeniorityLead      JobSeniority = "lead"
+	JobSeniorityPrincipal JobSeniority = "principal"
+	JobSeniorityExecutive JobSeniority = "executive"
+)
+
+// jobSeniorityYears is the years of experience a seniority level usually takes.
+var jobSeniorityYears = map[JobSeniority]float64{
+	JobSeniorityIntern:    0,
+	JobSeniorityJunior:    0,
+	JobSeniorityMid:       2,
+	JobSenioritySenior:    5,
+	JobSeniorityLead:      7,
+	JobSeniorityPrincipal: 10,
+	JobSeniorityExecutive: 10,
+}
+
+// IsValid reports whether s is one of the known seniority levels.
+func (s JobSeniority) IsValid() bool {
+	_, ok := jobSeniorityYears[s]
+	return ok
+}
+
+// TypicalYears returns the years of experience the seniority level usually takes.
+func (s JobSeniority) TypicalYears() float64 {
+	return jobSeniorityYears[s]
+}
+
+// ExtractedJobDescriptionData is the structured data extracted from a job description.
+type ExtractedJobDescriptionData struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
+	Title              *string            `json:"title,omitempty"`
+	Company            *string            `json:"company,omitempty"`
+	Seniority          *JobSeniority      `json:"seniority,omitempty"`
+	MinYearsExperience *int               `json:"minYearsExperience,omitempty"`
+	Domain             *string            `json:"domain,omitempty"`
+	RequiredSkills     []string           `json:"requiredSkills"`
+	PreferredSkills    []string           `json:"preferredSkills"`
+	Metadata           ExtractionMetadata `json:"metadata"`
+}
+
+// JobDescription is a job description a user matched their profile against, saved with
+// the requirements extracted from it so it can be matched again without another extraction.
+type JobDescription struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:job_descriptions,alias:jd"`
+
+	ID            uuid.UUID       `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	UserID        uuid.UUID       `bun:"user_id,notnull,type:uuid"`
+	Title         *string         `bun:"title"`
+	Company       *string         `bun:"company"`
+	Text          string          `bun:"text,notnull"`
+	ExtractedData json.RawMessage `bun:"extracted_data,type:jsonb"`
+	CreatedAt     time.Time       `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt     time.Time       `bun:"updated_at,notnull,default:current_timestamp"`
+
+	// Relations
+	User *User `bun:"rel:belongs-to,join:user_id=id"`
+}
+
+// JobDescriptionRepository defines operations for saved job description persistence.
+type JobDescriptionRepository interface {
+	// Create persists a new job description.
+	Create(ctx context.Context, jd *JobDescription) error
+
+	// GetByID retrieves a job description by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*JobDescription, error)
+
+	// GetByUserID retrieves all job descriptions saved by a user, newest first.
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*JobDescription, error)
+
+	// Delete removes a job description by its ID.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -184,6 +184,19 @@ type DocumentExtractor interface {
 	// distinguish between mentions of existing skills (for validation) and newly discovered skills.
 	ExtractLetterData(ctx context.Context, text string, profileSkills []ProfileSkillContext) (*ExtractedLetterData, error)
 
+	// ExtractCertificateData extracts structured certificate/license data from text using LLM.
+	ExtractCertificateData(ctx context.Context, text string) (*ExtractedCertificateData, error)
+
+	// ExtractTranscriptData extracts structured academic transcript/diploma data from text using LLM.
+	ExtractTranscriptData(ctx context.Context, text string) (*ExtractedTranscriptData, error)
+
+	// ExtractPerformanceReviewData extracts reviewer details and review statements from a performance review.
+	ExtractPerformanceReviewData(ctx context.Context, text string) (*ExtractedPerformanceReviewData, error)
+
+	// ExtractJobDescriptionData extracts the title, seniority, domain and required and preferred
+	// skills of a job description from its text.
+	ExtractJobDescriptionData(ctx context.Context, text string) (*ExtractedJobDescriptionData, error)
+
 	// DetectDocumentContent performs lightweight classification of a document's content.
 	// It quickly identifies whether the document contains career information, testimonials, or both,
 	// without running full extraction. This is significantly faster and cheaper than full extraction.
@@ -4,6 +4,7 @@ package domain
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,9 +17,12 @@ type ExperienceSource string
 
 // Experience source constants.
 const (
-	ExperienceSourceManual            ExperienceSource = "manual"
-	ExperienceSourceResumeExtracted   ExperienceSource = "resume_extracted"
-	ExperienceSourceLetterDiscovered  ExperienceSource = "letter_discovered"
+	ExperienceSourceManual           ExperienceSource = "manual"
+	ExperienceSourceResumeExtracted  ExperienceSource = "resume_extracted"
+	ExperienceSourceLetterDiscovered ExperienceSource = "letter_discovered"
+	// ExperienceSourceCredentialDocument marks entries imported from an uploaded credential
+	// document such as a certificate.
+	ExperienceSourceCredentialDocument ExperienceSource = "credential_document"
 )
 
 // Profile represents a user's profile containing manually editable data.
@@ -32,6 +36,7 @@ type Profile struct { //nolint:govet // Field ordering prioritizes readability o
 
 	// Header fields (user-editable, override resume extraction if set)
 	Name     *string `bun:"name"`
+	Headline *string `bun:"headline"`
 	Email    *string `bun:"email"`
 	Phone    *string `bun:"phone"`
 	Location *string `bun:"location"`
@@ -41,65 +46,132 @@ type Profile struct { //nolint:govet // Field ordering prioritizes readability o
 	ProfilePhotoFileID *uuid.UUID `bun:"profile_photo_file_id,type:uuid"`
 
 	// Relations
-	ProfilePhotoFile *File `bun:"rel:belongs-to,join:profile_photo_file_id=id"`
-	User        *User                `bun:"rel:belongs-to,join:user_id=id"`
-	Experiences []*ProfileExperience `bun:"rel:has-many,join:id=profile_id"`
-	Educations  []*ProfileEducation  `bun:"rel:has-many,join:id=profile_id"`
-	Skills      []*ProfileSkill      `bun:"rel:has-many,join:id=profile_id"`
+	ProfilePhotoFile *File                `bun:"rel:belongs-to,join:profile_photo_file_id=id"`
+	User             *User                `bun:"rel:belongs-to,join:user_id=id"`
+	Experiences      []*ProfileExperience `bun:"rel:has-many,join:id=profile_id"`
+	Educations       []*ProfileEducation  `bun:"rel:has-many,join:id=profile_id"`
+	Skills           []*ProfileSkill      `bun:"rel:has-many,join:id=profile_id"`
 }
 
 // ProfileExperience represents a work experience entry in a user's profile.
+// StartDate and EndDate keep the dates as written; Start and End hold them parsed.
 type ProfileExperience struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
 	bun.BaseModel `bun:"table:profile_experiences,alias:pe"`
 
-	ID             uuid.UUID        `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
-	ProfileID      uuid.UUID        `bun:"profile_id,notnull,type:uuid"`
-	Company        string           `bun:"company,notnull"`
-	Title          string           `bun:"title,notnull"`
-	Location       *string          `bun:"location"`
-	StartDate      *string          `bun:"start_date"`
-	EndDate        *string          `bun:"end_date"`
-	IsCurrent      bool             `bun:"is_current,notnull,default:false"`
-	Description    *string          `bun:"description"`
-	Highlights     pq.StringArray   `bun:"highlights,type:text[],array"`
-	DisplayOrder   int              `bun:"display_order,notnull,default:0"`
-	Source         ExperienceSource `bun:"source,notnull,default:'manual'"`
-	SourceResumeID *uuid.UUID       `bun:"source_resume_id,type:uuid"`
-	OriginalData   json.RawMessage  `bun:"original_data,type:jsonb"`
-	CreatedAt      time.Time        `bun:"created_at,notnull,default:current_timestamp"`
-	UpdatedAt      time.Time        `bun:"updated_at,notnull,default:current_timestamp"`
+	ID                      uuid.UUID        `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID               uuid.UUID        `bun:"profile_id,notnull,type:uuid"`
+	Company                 string           `bun:"company,notnull"`
+	CompanyID               *uuid.UUID       `bun:"company_id,type:uuid"`
+	Title                   string           `bun:"title,notnull"`
+	Location                *string          `bun:"location"`
+	StartDate               *string          `bun:"start_date"`
+	EndDate                 *string          `bun:"end_date"`
+	Start                   PartialDate      `bun:"embed:start_"`
+	End                     PartialDate      `bun:"embed:end_"`
+	IsCurrent               bool             `bun:"is_current,notnull,default:false"`
+	Description             *string          `bun:"description"`
+	Highlights              pq.StringArray   `bun:"highlights,type:text[],array"`
+	DisplayOrder            int              `bun:"display_order,notnull,default:0"`
+	GapNote                 *string          `bun:"gap_note"` // the candidate's explanation of the break after this role
+	Source                  ExperienceSource `bun:"source,notnull,default:'manual'"`
+	SourceResumeID          *uuid.UUID       `bun:"source_resume_id,type:uuid"`
+	SourceReferenceLetterID *uuid.UUID       `bun:"source_reference_letter_id,type:uuid"`
+	OriginalData            json.RawMessage  `bun:"original_data,type:jsonb"`
+	CreatedAt               time.Time        `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt               time.Time        `bun:"updated_at,notnull,default:current_timestamp"`
 
 	// Relations
-	Profile      *Profile `bun:"rel:belongs-to,join:profile_id=id"`
-	SourceResume *Resume  `bun:"rel:belongs-to,join:source_resume_id=id"`
+	Profile               *Profile         `bun:"rel:belongs-to,join:profile_id=id"`
+	SourceResume          *Resume          `bun:"rel:belongs-to,join:source_resume_id=id"`
+	SourceReferenceLetter *ReferenceLetter `bun:"rel:belongs-to,join:source_reference_letter_id=id"`
+	CompanyEntity         *Company         `bun:"rel:belongs-to,join:company_id=id"`
+}
+
+// Dates returns the span of the experience. A current role, and one with a start date but
+// no end date, is ongoing.
+func (e *ProfileExperience) Dates() DateRange {
+	return DateRange{Start: e.Start, End: e.End, Ongoing: e.IsCurrent || (isBlank(e.EndDate) && !e.Start.IsZero())}
+}
+
+// Absorb folds a duplicate of the experience into it. Highlights are combined without
+// repeats, the longer description is kept, and fields the experience lacks are taken from
+// the duplicate.
+func (e *ProfileExperience) Absorb(dup *ProfileExperience) {
+	seen := make(map[string]bool, len(e.Highlights))
+	for _, h := range e.Highlights {
+		seen[strings.ToLower(strings.TrimSpace(h))] = true
+	}
+	for _, h := range dup.Highlights {
+		key := strings.ToLower(strings.TrimSpace(h))
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		e.Highlights = append(e.Highlights, h)
+	}
+
+	if !isBlank(dup.Description) && (isBlank(e.Description) || len([]rune(strings.TrimSpace(*dup.Description))) > len([]rune(strings.TrimSpace(*e.Description)))) {
+		e.Description = dup.Description
+	}
+	if isBlank(e.Location) {
+		e.Location = dup.Location
+	}
+	if e.CompanyID == nil {
+		e.CompanyID = dup.CompanyID
+	}
+	if isBlank(e.StartDate) && !isBlank(dup.StartDate) {
+		e.StartDate, e.Start = dup.StartDate, dup.Start
+	}
+	if isBlank(e.EndDate) && !e.IsCurrent && (!isBlank(dup.EndDate) || dup.IsCurrent) {
+		e.EndDate, e.End, e.IsCurrent = dup.EndDate, dup.End, dup.IsCurrent
+	}
+	if e.SourceResumeID == nil {
+		e.SourceResumeID = dup.SourceResumeID
+	}
+	if e.SourceReferenceLetterID == nil {
+		e.SourceReferenceLetterID = dup.SourceReferenceLetterID
+	}
+	if e.OriginalData == nil {
+		e.OriginalData = dup.OriginalData
+	}
 }
 
 // ProfileEducation represents an education entry in a user's profile.
+// StartDate and EndDate keep the dates as written; Start and End hold them parsed.
 type ProfileEducation struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
 	bun.BaseModel `bun:"table:profile_education,alias:ped"`
 
-	ID             uuid.UUID        `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
-	ProfileID      uuid.UUID        `bun:"profile_id,notnull,type:uuid"`
-	Institution    string           `bun:"institution,notnull"`
-	Degree         string           `bun:"degree,notnull"`
-	Field          *string          `bun:"field"`
-	StartDate      *string          `bun:"start_date"`
-	EndDate        *string          `bun:"end_date"`
-	IsCurrent      bool             `bun:"is_current,notnull,default:false"`
-	Description    *string          `bun:"description"`
-	GPA            *string          `bun:"gpa"`
-	DisplayOrder   int              `bun:"display_order,notnull,default:0"`
-	Source         ExperienceSource `bun:"source,notnull,default:'manual'"`
-	SourceResumeID *uuid.UUID       `bun:"source_resume_id,type:uuid"`
-	OriginalData   json.RawMessage  `bun:"original_data,type:jsonb"`
-	CreatedAt      time.Time        `bun:"created_at,notnull,default:current_timestamp"`
-	UpdatedAt      time.Time        `bun:"updated_at,notnull,default:current_timestamp"`
+	ID                   uuid.UUID        `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID            uuid.UUID        `bun:"profile_id,notnull,type:uuid"`
+	Institution          string           `bun:"institution,notnull"`
+	Degree               string           `bun:"degree,notnull"`
+	Field                *string          `bun:"field"`
+	StartDate            *string          `bun:"start_date"`
+	EndDate              *string          `bun:"end_date"`
+	Start                PartialDate      `bun:"embed:start_"`
+	End                  PartialDate      `bun:"embed:end_"`
+	IsCurrent            bool             `bun:"is_current,notnull,default:false"`
+	Description          *string          `bun:"description"`
+	GPA                  *string          `bun:"gpa"`
+	VerifiedByDocumentID *uuid.UUID       `bun:"verified_by_document_id,type:uuid"`
+	DisplayOrder         int              `bun:"display_order,notnull,default:0"`
+	Source               ExperienceSource `bun:"source,notnull,default:'manual'"`
+	SourceResumeID       *uuid.UUID       `bun:"source_resume_id,type:uuid"`
+	OriginalData         json.RawMessage  `bun:"original_data,type:jsonb"`
+	CreatedAt            time.Time        `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt            time.Time        `bun:"updated_at,notnull,default:current_timestamp"`
 
 	// Relations
 	Profile      *Profile `bun:"rel:belongs-to,join:profile_id=id"`
 	SourceResume *Resume  `bun:"rel:belongs-to,join:source_resume_id=id"`
 }
 
+// Dates returns the span of the education entry. A current entry, and one with a start
+// date but no end date, is ongoing.
+func (e *ProfileEducation) Dates() DateRange {
+	return DateRange{Start: e.Start, End: e.End, Ongoing: e.IsCurrent || (isBlank(e.EndDate) && !e.Start.IsZero())}
+}
+
 // ProfileRepository defines operations for profile persistence.
 type ProfileRepository interface {
 	// Create persists a new profile.
@@ -119,6 +191,18 @@ type ProfileRepository interface {
 
 	// Delete removes a profile by its ID.
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ReorderItems sets the display order of the profile's experiences, education entries
+	// and skills in one transaction. It fails without changes if an ID is not on the profile.
+	ReorderItems(ctx context.Context, profileID uuid.UUID, order *ProfileItemOrder) error
+}
+
+// ProfileItemOrder lists profile item IDs in their new display order. A nil list leaves
+// that kind of item unchanged.
+type ProfileItemOrder struct {
+	ExperienceIDs []uuid.UUID
+	EducationIDs  []uuid.UUID
+	SkillIDs      []uuid.UUID
 }
 
 // ProfileExperienceRepository defines operations for profile experience persistence.
@@ -141,8 +225,17 @@ type ProfileExperienceRepository interface {
 	// GetNextDisplayOrder returns the next display order value for a profile.
 	GetNextDisplayOrder(ctx context.Context, profileID uuid.UUID) (int, error)
 
+	// GetWithUnparsedDates retrieves the experiences of all profiles with a start or end
+	// date that has no parsed counterpart, such as rows stored before dates were parsed.
+	GetWithUnparsedDates(ctx context.Context) ([]*ProfileExperience, error)
+
 	// DeleteBySourceResumeID removes all experiences extracted from a specific resume.
 	DeleteBySourceResumeID(ctx context.Context, sourceResumeID uuid.UUID) error
+
+	// Merge folds the source experiences into the target in one transaction (see
+	// ProfileExperience.Absorb): their validations, inconsistencies and writing suggestions
+	// move to the target before the sources are deleted. Sources on other profiles are ignored.
+	Merge(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*ProfileExperience, error)
 }
 
 // ProfileEducationRepository defines operations for profile education persistence.
@@ -165,6 +258,10 @@ type ProfileEducationRepository interface {
 	// GetNextDisplayOrder returns the next display order value for a profile.
 	GetNextDisplayOrder(ctx context.Context, profileID uuid.UUID) (int, error)
 
+	// GetWithUnparsedDates retrieves the education entries of all profiles with a start or
+	// end date that has no parsed counterpart, like ProfileExperienceRepository's.
+	GetWithUnparsedDates(ctx context.Context) ([]*ProfileEducation, error)
+
 	// DeleteBySourceResumeID removes all education entries extracted from a specific resume.
 	DeleteBySourceResumeID(ctx context.Context, sourceResumeID uuid.UUID) error
 }
@@ -174,12 +271,15 @@ type SkillSource string
 
 // Skill source constants.
 const (
-	SkillSourceManual          SkillSource = "manual"
-	SkillSourceResumeExtracted SkillSource = "resume_extracted"
+	SkillSourceManual           SkillSource = "manual"
+	SkillSourceResumeExtracted  SkillSource = "resume_extracted"
 	SkillSourceLetterDiscovered SkillSource = "letter_discovered"
 )
 
 // ProfileSkill represents a skill entry in a user's profile.
+// Aliases holds the names of skills merged into this one. YearsOfExperience, LastUsed and
+// EvidenceStrength are derived from the rest of the profile and maintained by the
+// skill stats service; they are not edited directly.
 type ProfileSkill struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
 	bun.BaseModel `bun:"table:profile_skills,alias:ps"`
 
@@ -187,12 +287,17 @@ type ProfileSkill struct { //nolint:govet // Field ordering prioritizes readabil
 	ProfileID               uuid.UUID        `bun:"profile_id,notnull,type:uuid"`
 	Name                    string           `bun:"name,notnull"`
 	NormalizedName          string           `bun:"normalized_name,notnull"`
+	CanonicalSkillID        *uuid.UUID       `bun:"canonical_skill_id,type:uuid"`
+	Aliases                 []string         `bun:"aliases,array"`
 	Category                string           `bun:"category,notnull,default:'TECHNICAL'"`
 	DisplayOrder            int              `bun:"display_order,notnull,default:0"`
 	Source                  ExperienceSource `bun:"source,notnull,default:'manual'"`
 	SourceResumeID          *uuid.UUID       `bun:"source_resume_id,type:uuid"`
 	SourceReferenceLetterID *uuid.UUID       `bun:"source_reference_letter_id,type:uuid"`
 	OriginalData            json.RawMessage  `bun:"original_data,type:jsonb"`
+	YearsOfExperience       float64          `bun:"years_of_experience,notnull,default:0"`
+	LastUsed                *time.Time       `bun:"last_used,type:date"`
+	EvidenceStrength        float64          `bun:"evidence_strength,notnull,default:0"`
 	CreatedAt               time.Time        `bun:"created_at,notnull,default:current_timestamp"`
 	UpdatedAt               time.Time        `bun:"updated_at,notnull,default:current_timestamp"`
 
@@ -200,6 +305,72 @@ type ProfileSkill struct { //nolint:govet // Field ordering prioritizes readabil
 	Profile               *Profile         `bun:"rel:belongs-to,join:profile_id=id"`
 	SourceResume          *Resume          `bun:"rel:belongs-to,join:source_resume_id=id"`
 	SourceReferenceLetter *ReferenceLetter `bun:"rel:belongs-to,join:source_reference_letter_id=id"`
+	CanonicalSkill        *Skill           `bun:"rel:belongs-to,join:canonical_skill_id=id"`
+}
+
+// AddAliases appends names to the skill's aliases, skipping blanks, the skill's own name
+// and aliases it already has. Names are compared case-insensitively.
+func (s *ProfileSkill) AddAliases(names ...string) {
+	seen := map[string]bool{strings.ToLower(strings.TrimSpace(s.Name)): true}
+	for _, alias := range s.Aliases {
+		seen[strings.ToLower(alias)] = true
+	}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		key := strings.ToLower(name)
+		if name == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		s.Aliases = append(s.Aliases, name)
+	}
+}
+
+// Skill is a canonical skill in the skill taxonomy. Profile skills link to one, so that
+// "k8s" and "Kubernetes" are recognized as the same skill. Skills with a nil ProfileID
+// come from the bundled taxonomy; the others are extensions defined for one profile.
+type Skill struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:skills,alias:sk"`
+
+	ID        uuid.UUID  `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID *uuid.UUID `bun:"profile_id,type:uuid"`
+	Slug      string     `bun:"slug,notnull"`
+	Name      string     `bun:"name,notnull"`
+	Category  string     `bun:"category,notnull,default:'TECHNICAL'"`
+	ParentID  *uuid.UUID `bun:"parent_id,type:uuid"`
+	Synonyms  []string   `bun:"synonyms,array"`
+	// Ambiguous marks a skill whose name is also a common English word, such as Go or
+	// Spring. Prose mentions it only when written exactly as one of its names.
+	Ambiguous bool      `bun:"ambiguous,notnull,default:false"`
+	CreatedAt time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt time.Time `bun:"updated_at,notnull,default:current_timestamp"`
+}
+
+// IsCustom reports whether the skill is a profile extension rather than a bundled one.
+func (s *Skill) IsCustom() bool {
+	return s.ProfileID != nil
+}
+
+// SkillRepository defines operations for canonical skill persistence.
+type SkillRepository interface {
+	// SeedGlobal upserts the bundled taxonomy by ID and links existing profile skills whose
+	// normalized name matches a bundled skill's name or synonym.
+	SeedGlobal(ctx context.Context, skills []*Skill) error
+
+	// Create persists a new profile extension skill.
+	Create(ctx context.Context, skill *Skill) error
+
+	// GetByID retrieves a skill by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*Skill, error)
+
+	// GetByProfileID retrieves the extension skills defined for a profile, ordered by name.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*Skill, error)
+
+	// Update persists changes to an existing skill.
+	Update(ctx context.Context, skill *Skill) error
+
+	// Delete removes a skill by its ID. Profile skills and child skills linked to it are unlinked.
+	Delete(ctx context.Context, id uuid.UUID) error
 }
 
 // ProfileSkillRepository defines operations for profile skill persistence.
@@ -228,4 +399,96 @@ type ProfileSkillRepository interface {
 
 	// DeleteBySourceResumeID removes all skills extracted from a specific resume.
 	DeleteBySourceResumeID(ctx context.Context, sourceResumeID uuid.UUID) error
+
+	// UpdateAll persists changes to several profile skills in one transaction.
+	UpdateAll(ctx context.Context, skills []*ProfileSkill) error
+
+	// UpdateStats persists only the derived fields (years of experience, last used and
+	// evidence strength) of the given skills, leaving UpdatedAt untouched.
+	UpdateStats(ctx context.Context, skills []*ProfileSkill) error
+
+	// Merge folds the source skills into the target in one transaction: their validations
+	// move to the target, their names become target aliases, and missing provenance is
+	// copied over before the sources are deleted. Sources on other profiles are ignored.
+	Merge(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*ProfileSkill, error)
+
+	// Split replaces a skill by the given parts in one transaction: the parts are created
+	// on the skill's profile, each gets a copy of every validation of the skill, and the
+	// skill is deleted.
+	Split(ctx context.Context, skillID uuid.UUID, parts []*ProfileSkill) error
+}
+
+// ProfileChangeEntity names the kind of profile item a change applies to.
+type ProfileChangeEntity string
+
+// Profile change entity constants.
+const (
+	ProfileChangeEntityProfile       ProfileChangeEntity = "profile"
+	ProfileChangeEntityExperience    ProfileChangeEntity = "experience"
+	ProfileChangeEntityEducation     ProfileChangeEntity = "education"
+	ProfileChangeEntitySkill         ProfileChangeEntity = "skill"
+	ProfileChangeEntityLink          ProfileChangeEntity = "link"
+	ProfileChangeEntityCertification ProfileChangeEntity = "certification"
+	ProfileChangeEntityLanguage      ProfileChangeEntity = "language"
+	ProfileChangeEntityProject       ProfileChangeEntity = "project"
+	ProfileChangeEntityPublication   ProfileChangeEntity = "publication"
+	ProfileChangeEntityAward         ProfileChangeEntity = "award"
+)
+
+// ProfileChangeAction is what a change did to its item.
+type ProfileChangeAction string
+
+// Profile change action constants.
+const (
+	ProfileChangeActionCreate ProfileChangeAction = "create"
+	ProfileChangeActionUpdate ProfileChangeAction = "update"
+	ProfileChangeActionDelete ProfileChangeAction = "delete"
+)
+
+// ProfileChangeSource records whether the user made a change or a document import did.
+type ProfileChangeSource string
+
+// Profile change source constants.
+const (
+	ProfileChangeSourceManual ProfileChangeSource = "manual"
+	ProfileChangeSourceImport ProfileChangeSource = "import"
+)
+
+// ProfileChange is an entry in a profile's append-only change log. An update records a
+// single field, with its old and new value as JSON; a create records the new item and a
+// delete the removed one, as a JSON object of their fields. EntityID is the profile's own
+// ID for changes to the profile header. RevertsChangeID is set on changes made by undoing
+// another change.
+type ProfileChange struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:profile_changes,alias:pc"`
+
+	ID              uuid.UUID           `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID       uuid.UUID           `bun:"profile_id,notnull,type:uuid"`
+	EntityType      ProfileChangeEntity `bun:"entity_type,notnull"`
+	EntityID        uuid.UUID           `bun:"entity_id,notnull,type:uuid"`
+	Action          ProfileChangeAction `bun:"action,notnull"`
+	Field           *string             `bun:"field"`
+	OldValue        json.RawMessage     `bun:"old_value,type:jsonb"`
+	NewValue        json.RawMessage     `bun:"new_value,type:jsonb"`
+	ActorID         *uuid.UUID          `bun:"actor_id,type:uuid"`
+	Source          ProfileChangeSource `bun:"source,notnull"`
+	RevertsChangeID *uuid.UUID          `bun:"reverts_change_id,type:uuid"`
+	CreatedAt       time.Time           `bun:"created_at,notnull,default:current_timestamp"`
+}
+
+// ProfileChangeRepository defines operations for the profile change log. Changes are only
+// ever appended.
+type ProfileChangeRepository interface {
+	// CreateAll appends changes to the log in one statement.
+	CreateAll(ctx context.Context, changes []*ProfileChange) error
+
+	// GetByID retrieves a change by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*ProfileChange, error)
+
+	// GetByProfileID retrieves all changes to a profile, newest first.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*ProfileChange, error)
+
+	// GetByRevertsChangeID retrieves the change made by undoing the given change, or nil if
+	// it has not been undone.
+	GetByRevertsChangeID(ctx context.Context, changeID uuid.UUID) (*ProfileChange, error)
 }
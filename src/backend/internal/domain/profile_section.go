@@ -0,0 +1,354 @@
+package domain
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/uptrace/bun"
+)
+
+// LinkKind identifies what a profile link points to.
+type LinkKind string
+
+// Link kind constants.
+const (
+	LinkKindGitHub    LinkKind = "github"
+	LinkKindLinkedIn  LinkKind = "linkedin"
+	LinkKindPortfolio LinkKind = "portfolio"
+	LinkKindWebsite   LinkKind = "website"
+	LinkKindOther     LinkKind = "other"
+)
+
+// IsValid reports whether the kind is one of the link kind constants.
+func (k LinkKind) IsValid() bool {
+	switch k {
+	case LinkKindGitHub, LinkKindLinkedIn, LinkKindPortfolio, LinkKindWebsite, LinkKindOther:
+		return true
+	}
+	return false
+}
+
+// NormalizeLinkURL trims a link address and adds "https://" when it has no scheme. It
+// reports false unless the result is an http or https URL with a host.
+func NormalizeLinkURL(raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw != "" && !strings.Contains(raw, "://") {
+		if u, err := url.Parse(raw); err == nil && u.Opaque != "" {
+			// Another scheme such as "mailto:".
+			return "", false
+		}
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", false
+	}
+	return raw, true
+}
+
+// LinkKindForURL tells GitHub and LinkedIn links apart by their host. Other links are
+// "other".
+func LinkKindForURL(raw string) LinkKind {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return LinkKindOther
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	switch {
+	case host == "github.com":
+		return LinkKindGitHub
+	case host == "linkedin.com" || strings.HasSuffix(host, ".linkedin.com"):
+		return LinkKindLinkedIn
+	default:
+		return LinkKindOther
+	}
+}
+
+// LanguageProficiency is a spoken language level on the Common European Framework of
+// Reference (CEFR), or native.
+type LanguageProficiency string
+
+// Language proficiency constants.
+const (
+	LanguageProficiencyA1     LanguageProficiency = "a1"
+	LanguageProficiencyA2     LanguageProficiency = "a2"
+	LanguageProficiencyB1     LanguageProficiency = "b1"
+	LanguageProficiencyB2     LanguageProficiency = "b2"
+	LanguageProficiencyC1     LanguageProficiency = "c1"
+	LanguageProficiencyC2     LanguageProficiency = "c2"
+	LanguageProficiencyNative LanguageProficiency = "native"
+)
+
+// IsValid reports whether the proficiency is one of the language proficiency constants.
+func (p LanguageProficiency) IsValid() bool {
+	switch p {
+	case LanguageProficiencyA1, LanguageProficiencyA2, LanguageProficiencyB1, LanguageProficiencyB2,
+		LanguageProficiencyC1, LanguageProficiencyC2, LanguageProficiencyNative:
+		return true
+	}
+	return false
+}
+
+// ProfileLink is a link on a user's profile, such as their GitHub or LinkedIn page.
+type ProfileLink struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:profile_links,alias:pl"`
+
+	ID             uuid.UUID        `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID      uuid.UUID        `bun:"profile_id,notnull,type:uuid"`
+	Kind           LinkKind         `bun:"kind,notnull,default:'other'"`
+	URL            string           `bun:"url,notnull"`
+	Label          *string          `bun:"label"`
+	DisplayOrder   int              `bun:"display_order,notnull,default:0"`
+	Source         ExperienceSource `bun:"source,notnull,default:'manual'"`
+	SourceResumeID *uuid.UUID       `bun:"source_resume_id,type:uuid"`
+	CreatedAt      time.Time        `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time        `bun:"updated_at,notnull,default:current_timestamp"`
+}
+
+// ProfileCertification is a certification or license on a user's profile. IssueDate and
+// ExpiryDate keep the dates as written; Expiry holds the expiry date parsed. A certification
+// imported from an uploaded certificate keeps that document and its file as its source.
+// ExpiringFlaggedAt is set by the periodic expiry check while the certification expires
+// within the configured window, or has expired.
+type ProfileCertification struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:profile_certifications,alias:pcert"`
+
+	ID                         uuid.UUID        `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID                  uuid.UUID        `bun:"profile_id,notnull,type:uuid"`
+	Name                       string           `bun:"name,notnull"`
+	Issuer                     *string          `bun:"issuer"`
+	CredentialID               *string          `bun:"credential_id"`
+	VerificationURL            *string          `bun:"verification_url"`
+	IssueDate                  *string          `bun:"issue_date"`
+	ExpiryDate                 *string          `bun:"expiry_date"`
+	Expiry                     PartialDate      `bun:"embed:expiry_"`
+	ExpiringFlaggedAt          *time.Time       `bun:"expiring_flagged_at"`
+	DisplayOrder               int              `bun:"display_order,notnull,default:0"`
+	Source                     ExperienceSource `bun:"source,notnull,default:'manual'"`
+	SourceResumeID             *uuid.UUID       `bun:"source_resume_id,type:uuid"`
+	SourceFileID               *uuid.UUID       `bun:"source_file_id,type:uuid"`
+	SourceCredentialDocumentID *uuid.UUID       `bun:"source_credential_document_id,type:uuid"`
+	CreatedAt                  time.Time        `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt                  time.Time        `bun:"updated_at,notnull,default:current_timestamp"`
+}
+
+// ProfileLanguage is a spoken language on a user's profile. Proficiency is nil when unknown.
+type ProfileLanguage struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:profile_languages,alias:plang"`
+
+	ID             uuid.UUID            `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID      uuid.UUID            `bun:"profile_id,notnull,type:uuid"`
+	Language       string               `bun:"language,notnull"`
+	Proficiency    *LanguageProficiency `bun:"proficiency"`
+	DisplayOrder   int                  `bun:"display_order,notnull,default:0"`
+	Source         ExperienceSource     `bun:"source,notnull,default:'manual'"`
+	SourceResumeID *uuid.UUID           `bun:"source_resume_id,type:uuid"`
+	CreatedAt      time.Time            `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time            `bun:"updated_at,notnull,default:current_timestamp"`
+}
+
+// ProfileProject is a personal, open source or client project on a user's profile.
+type ProfileProject struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:profile_projects,alias:pproj"`
+
+	ID             uuid.UUID        `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID      uuid.UUID        `bun:"profile_id,notnull,type:uuid"`
+	Name           string           `bun:"name,notnull"`
+	Role           *string          `bun:"role"`
+	Description    *string          `bun:"description"`
+	URL            *string          `bun:"url"`
+	StartDate      *string          `bun:"start_date"`
+	EndDate        *string          `bun:"end_date"`
+	Technologies   pq.StringArray   `bun:"technologies,type:text[],array"`
+	DisplayOrder   int              `bun:"display_order,notnull,default:0"`
+	Source         ExperienceSource `bun:"source,notnull,default:'manual'"`
+	SourceResumeID *uuid.UUID       `bun:"source_resume_id,type:uuid"`
+	CreatedAt      time.Time        `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time        `bun:"updated_at,notnull,default:current_timestamp"`
+}
+
+// ProfilePublication is a paper, article or book on a user's profile.
+type ProfilePublication struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:profile_publications,alias:ppub"`
+
+	ID             uuid.UUID        `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID      uuid.UUID        `bun:"profile_id,notnull,type:uuid"`
+	Title          string           `bun:"title,notnull"`
+	Publisher      *string          `bun:"publisher"`
+	Date           *string          `bun:"date"`
+	URL            *string          `bun:"url"`
+	Description    *string          `bun:"description"`
+	DisplayOrder   int              `bun:"display_order,notnull,default:0"`
+	Source         ExperienceSource `bun:"source,notnull,default:'manual'"`
+	SourceResumeID *uuid.UUID       `bun:"source_resume_id,type:uuid"`
+	CreatedAt      time.Time        `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time        `bun:"updated_at,notnull,default:current_timestamp"`
+}
+
+// ProfileAward is an award or honor on a user's profile.
+type ProfileAward struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:profile_awards,alias:paw"`
+
+	ID             uuid.UUID        `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID      uuid.UUID        `bun:"profile_id,notnull,type:uuid"`
+	Title          string           `bun:"title,notnull"`
+	Issuer         *string          `bun:"issuer"`
+	Date           *string          `bun:"date"`
+	Description    *string          `bun:"description"`
+	DisplayOrder   int              `bun:"display_order,notnull,default:0"`
+	Source         ExperienceSource `bun:"source,notnull,default:'manual'"`
+	SourceResumeID *uuid.UUID       `bun:"source_resume_id,type:uuid"`
+	CreatedAt      time.Time        `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time        `bun:"updated_at,notnull,default:current_timestamp"`
+}
+
+// ProfileLinkRepository defines operations for profile link persistence.
+type ProfileLinkRepository interface {
+	// Create persists a new profile link.
+	Create(ctx context.Context, link *ProfileLink) error
+
+	// GetByID retrieves a profile link by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*ProfileLink, error)
+
+	// GetByProfileID retrieves all links of a profile, ordered by display order.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*ProfileLink, error)
+
+	// Update persists changes to an existing profile link.
+	Update(ctx context.Context, link *ProfileLink) error
+
+	// Delete removes a profile link by its ID.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// GetNextDisplayOrder returns the next display order value for a profile.
+	GetNextDisplayOrder(ctx context.Context, profileID uuid.UUID) (int, error)
+
+	// DeleteBySourceResumeID removes all links extracted from a specific resume.
+	DeleteBySourceResumeID(ctx context.Context, sourceResumeID uuid.UUID) error
+}
+
+// ProfileCertificationRepository defines operations for profile certification persistence.
+type ProfileCertificationRepository interface {
+	// Create persists a new profile certification.
+	Create(ctx context.Context, certification *ProfileCertification) error
+
+	// GetByID retrieves a profile certification by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*ProfileCertification, error)
+
+	// GetByProfileID retrieves all certifications of a profile, ordered by display order.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*ProfileCertification, error)
+
+	// Update persists changes to an existing profile certification.
+	Update(ctx context.Context, certification *ProfileCertification) error
+
+	// Delete removes a profile certification by its ID.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// GetNextDisplayOrder returns the next display order value for a profile.
+	GetNextDisplayOrder(ctx context.Context, profileID uuid.UUID) (int, error)
+
+	// DeleteBySourceResumeID removes all certifications extracted from a specific resume.
+	DeleteBySourceResumeID(ctx context.Context, sourceResumeID uuid.UUID) error
+
+	// GetWithExpiryDate retrieves the certifications of all profiles that state an expiry date.
+	GetWithExpiryDate(ctx context.Context) ([]*ProfileCertification, error)
+}
+
+// ProfileLanguageRepository defines operations for profile language persistence.
+type ProfileLanguageRepository interface {
+	// Create persists a new profile language.
+	Create(ctx context.Context, language *ProfileLanguage) error
+
+	// GetByID retrieves a profile language by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*ProfileLanguage, error)
+
+	// GetByProfileID retrieves all languages of a profile, ordered by display order.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*ProfileLanguage, error)
+
+	// Update persists changes to an existing profile language.
+	Update(ctx context.Context, language *ProfileLanguage) error
+
+	// Delete removes a profile language by its ID.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// GetNextDisplayOrder returns the next display order value for a profile.
+	GetNextDisplayOrder(ctx context.Context, profileID uuid.UUID) (int, error)
+
+	// DeleteBySourceResumeID removes all languages extracted from a specific resume.
+	DeleteBySourceResumeID(ctx context.Context, sourceResumeID uuid.UUID) error
+}
+
+// ProfileProjectRepository defines operations for profile project persistence.
+type ProfileProjectRepository interface {
+	// Create persists a new profile project.
+	Create(ctx context.Context, project *ProfileProject) error
+
+	// GetByID retrieves a profile project by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*ProfileProject, error)
+
+	// GetByProfileID retrieves all projects of a profile, ordered by display order.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*ProfileProject, error)
+
+	// Update persists changes to an existing profile project.
+	Update(ctx context.Context, project *ProfileProject) error
+
+	// Delete removes a profile project by its ID.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// GetNextDisplayOrder returns the next display order value for a profile.
+	GetNextDisplayOrder(ctx context.Context, profileID uuid.UUID) (int, error)
+
+	// DeleteBySourceResumeID removes all projects extracted from a specific resume.
+	DeleteBySourceResumeID(ctx context.Context, sourceResumeID uuid.UUID) error
+}
+
+// ProfilePublicationRepository defines operations for profile publication persistence.
+type ProfilePublicationRepository interface {
+	// Create persists a new profile publication.
+	Create(ctx context.Context, publication *ProfilePublication) error
+
+	// GetByID retrieves a profile publication by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*ProfilePublication, error)
+
+	// GetByProfileID retrieves all publications of a profile, ordered by display order.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*ProfilePublication, error)
+
+	// Update persists changes to an existing profile publication.
+	Update(ctx context.Context, publication *ProfilePublication) error
+
+	// Delete removes a profile publication by its ID.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// GetNextDisplayOrder returns the next display order value for a profile.
+	GetNextDisplayOrder(ctx context.Context, profileID uuid.UUID) (int, error)
+
+	// DeleteBySourceResumeID removes all publications extracted from a specific resume.
+	DeleteBySourceResumeID(ctx context.Context, sourceResumeID uuid.UUID) error
+}
+
+// ProfileAwardRepository defines operations for profile award persistence.
+type ProfileAwardRepository interface {
+	// Create persists a new profile award.
+	Create(ctx context.Context, award *ProfileAward) error
+
+	// GetByID retrieves a profile award by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*ProfileAward, error)
+
+	// GetByProfileID retrieves all awards of a profile, ordered by display order.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*ProfileAward, error)
+
+	// Update persists changes to an existing profile award.
+	Update(ctx context.Context, award *ProfileAward) error
+
+	// Delete removes a profile award by its ID.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// GetNextDisplayOrder returns the next display order value for a profile.
+	GetNextDisplayOrder(ctx context.Context, profileID uuid.UUID) (int, error)
+
+	// DeleteBySourceResumeID removes all awards extracted from a specific resume.
+	DeleteBySourceResumeID(ctx context.Context, sourceResumeID uuid.UUID) error
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ProfileVariant is a tailored version of a profile, e.g. for one target role. It stores
+// rules applied to the base profile rather than copies of its entries, so edits to the
+// profile show up in every variant. A variant with a share token can be viewed by anyone
+// holding the token.
+type ProfileVariant struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:profile_variants,alias:pvar"`
+
+	ID         uuid.UUID           `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID  uuid.UUID           `bun:"profile_id,notnull,type:uuid"`
+	Name       string              `bun:"name,notnull"`
+	TargetRole *string             `bun:"target_role"`
+	Summary    *string             `bun:"summary"`
+	Rules      ProfileVariantRules `bun:"rules,type:jsonb,notnull"`
+	ShareToken *string             `bun:"share_token"`
+	CreatedAt  time.Time           `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt  time.Time           `bun:"updated_at,notnull,default:current_timestamp"`
+
+	// Relations
+	Profile *Profile `bun:"rel:belongs-to,join:profile_id=id"`
+}
+
+// ProfileVariantRules are the inclusion, ordering and override rules of a variant. Item
+// IDs may refer to any experience, education entry, skill, link, certification, language,
+// project, publication or award of the profile; IDs of items since deleted are ignored.
+type ProfileVariantRules struct {
+	// HiddenItemIDs are the items left out of the variant.
+	HiddenItemIDs []uuid.UUID `json:"hiddenItemIds,omitempty"`
+	// ItemOrder lists items shown first within their section, in this order. The other
+	// items follow in the profile's order.
+	ItemOrder []uuid.UUID `json:"itemOrder,omitempty"`
+	// TestimonialIDs are the testimonials shown, in this order. All are shown when empty.
+	TestimonialIDs []uuid.UUID `json:"testimonialIds,omitempty"`
+	// ExperienceOverrides replace the description or highlights of experiences.
+	ExperienceOverrides []ExperienceOverride `json:"experienceOverrides,omitempty"`
+}
+
+// ExperienceOverride replaces the description or highlights of an experience in a
+// variant. A nil field keeps the profile's value.
+type ExperienceOverride struct {
+	ExperienceID uuid.UUID `json:"experienceId"`
+	Description  *string   `json:"description,omitempty"`
+	Highlights   []string  `json:"highlights"`
+}
+
+// Hides reports whether the variant leaves out the item.
+func (v *ProfileVariant) Hides(id uuid.UUID) bool {
+	for _, hidden := range v.Rules.HiddenItemIDs {
+		if hidden == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Rank returns the position of the item in the variant's order, and false if the variant
+// does not order it.
+func (v *ProfileVariant) Rank(id uuid.UUID) (int, bool) {
+	for i, ordered := range v.Rules.ItemOrder {
+		if ordered == id {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// ExperienceOverride returns the override of an experience, nil if it has none.
+func (v *ProfileVariant) ExperienceOverride(experienceID uuid.UUID) *ExperienceOverride {
+	for i := range v.Rules.ExperienceOverrides {
+		if v.Rules.ExperienceOverrides[i].ExperienceID == experienceID {
+			return &v.Rules.ExperienceOverrides[i]
+		}
+	}
+	return nil
+}
+
+// ProfileVariantRepository defines operations for profile variant persistence.
+type ProfileVariantRepository interface {
+	// Create persists a new profile variant.
+	Create(ctx context.Context, variant *ProfileVariant) error
+
+	// GetByID retrieves a profile variant by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*ProfileVariant, error)
+
+	// GetByProfileID retrieves all variants of a profile, oldest first.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*ProfileVariant, error)
+
+	// GetByShareToken retrieves the variant shared with a token.
+	GetByShareToken(ctx context.Context, token string) (*ProfileVariant, error)
+
+	// Update updates an existing profile variant.
+	Update(ctx context.Context, variant *ProfileVariant) error
+
+	// Delete removes a profile variant by its ID.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
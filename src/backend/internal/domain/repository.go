@@ -90,6 +90,48 @@ type AuthorRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 }
 
+// CompanyRepository defines operations for company persistence.
+type CompanyRepository interface {
+	// Create persists a new company.
+	Create(ctx context.Context, company *Company) error
+
+	// GetByID retrieves a company by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*Company, error)
+
+	// GetByProfileID retrieves all companies for a profile, ordered by name.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*Company, error)
+
+	// Update persists changes to an existing company.
+	Update(ctx context.Context, company *Company) error
+
+	// Delete removes a company by its ID. Linked experiences and authors are unlinked.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Merge folds the source companies into the target: their experiences and authors are
+	// relinked to the target, their names become company aliases of the target's name, aliases
+	// of their names are re-pointed to it, and they are deleted. Sources must belong to the
+	// target's profile. Returns the updated target.
+	Merge(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*Company, error)
+}
+
+// CompanyAliasRepository defines operations for company alias persistence.
+type CompanyAliasRepository interface {
+	// Create persists a new company alias.
+	Create(ctx context.Context, alias *CompanyAlias) error
+
+	// GetByID retrieves a company alias by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*CompanyAlias, error)
+
+	// GetByProfileID retrieves all company aliases for a profile, ordered by alias.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*CompanyAlias, error)
+
+	// Update persists changes to an existing company alias.
+	Update(ctx context.Context, alias *CompanyAlias) error
+
+	// Delete removes a company alias by its ID.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
 // TestimonialRepository defines operations for testimonial persistence.
 type TestimonialRepository interface {
 	// Create persists a new testimonial.
@@ -109,6 +151,9 @@ type TestimonialRepository interface {
 
 	// DeleteByReferenceLetterID removes all testimonials from a reference letter.
 	DeleteByReferenceLetterID(ctx context.Context, referenceLetterID uuid.UUID) error
+
+	// DeleteByCredentialDocumentID removes all testimonials from a credential document.
+	DeleteByCredentialDocumentID(ctx context.Context, credentialDocumentID uuid.UUID) error
 }
 
 // SkillValidationRepository defines operations for skill validation persistence.
@@ -161,3 +206,43 @@ type ExperienceValidationRepository interface {
 	// CountByProfileExperienceID returns the number of validations for an experience.
 	CountByProfileExperienceID(ctx context.Context, profileExperienceID uuid.UUID) (int, error)
 }
+
+// EducationValidationRepository defines operations for education validation persistence.
+type EducationValidationRepository interface {
+	// Create persists a new education validation.
+	Create(ctx context.Context, validation *EducationValidation) error
+
+	// GetByID retrieves an education validation by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*EducationValidation, error)
+
+	// GetByProfileEducationID retrieves all validations for a specific education entry.
+	GetByProfileEducationID(ctx context.Context, profileEducationID uuid.UUID) ([]*EducationValidation, error)
+
+	// GetByReferenceLetterID retrieves all education validations from a reference letter.
+	GetByReferenceLetterID(ctx context.Context, referenceLetterID uuid.UUID) ([]*EducationValidation, error)
+
+	// Delete removes an education validation by its ID.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// DeleteByReferenceLetterID removes all education validations from a reference letter.
+	DeleteByReferenceLetterID(ctx context.Context, referenceLetterID uuid.UUID) error
+
+	// CountByProfileEducationID returns the number of validations for an education entry.
+	CountByProfileEducationID(ctx context.Context, profileEducationID uuid.UUID) (int, error)
+}
+
+// ProfileInconsistencyRepository defines operations for profile inconsistency persistence.
+type ProfileInconsistencyRepository interface {
+	// Create persists a new inconsistency. A letter contradicts each field of an experience
+	// at most once, so recording the same one again returns a duplicate error.
+	Create(ctx context.Context, inconsistency *ProfileInconsistency) error
+
+	// GetByID retrieves an inconsistency by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*ProfileInconsistency, error)
+
+	// GetByProfileID retrieves all inconsistencies for a profile, newest first.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*ProfileInconsistency, error)
+
+	// Update persists changes to an existing inconsistency.
+	Update(ctx context.Context, inconsistency *ProfileInconsistency) error
+}
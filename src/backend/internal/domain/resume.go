@@ -41,13 +41,21 @@ type Resume struct { //nolint:govet // Field ordering prioritizes readability ov
 
 // WorkExperience represents a single work experience entry from a resume.
 type WorkExperience struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
-	Company     string  `json:"company"`
-	Title       string  `json:"title"`
-	Location    *string `json:"location,omitempty"`
-	StartDate   *string `json:"startDate,omitempty"`
-	EndDate     *string `json:"endDate,omitempty"`
-	IsCurrent   bool    `json:"isCurrent"`
-	Description *string `json:"description,omitempty"`
+	Company     string      `json:"company"`
+	Title       string      `json:"title"`
+	Location    *string     `json:"location,omitempty"`
+	StartDate   *string     `json:"startDate,omitempty"`
+	EndDate     *string     `json:"endDate,omitempty"`
+	IsCurrent   bool        `json:"isCurrent"`
+	Description *string     `json:"description,omitempty"`
+	Highlights  []Highlight `json:"highlights,omitempty"`
+}
+
+// Highlight is a bullet point of a work experience, such as a key achievement. Quantified
+// is set when it states a measurable result: a number, percentage or amount of money.
+type Highlight struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
+	Text       string `json:"text"`
+	Quantified bool   `json:"quantified"`
 }
 
 // Education represents a single education entry from a resume.
@@ -61,18 +69,78 @@ type Education struct { //nolint:govet // Field ordering prioritizes JSON serial
 	Achievements *string `json:"achievements,omitempty"`
 }
 
+// ResumeLink represents a profile link from a resume, such as a GitHub or LinkedIn URL.
+type ResumeLink struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
+	Kind  LinkKind `json:"kind"`
+	URL   string   `json:"url"`
+	Label *string  `json:"label,omitempty"`
+}
+
+// Certification represents a certification or license listed on a resume.
+type Certification struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
+	Name            string  `json:"name"`
+	Issuer          *string `json:"issuer,omitempty"`
+	CredentialID    *string `json:"credentialId,omitempty"`
+	VerificationURL *string `json:"verificationUrl,omitempty"`
+	IssueDate       *string `json:"issueDate,omitempty"`
+	ExpiryDate      *string `json:"expiryDate,omitempty"`
+}
+
+// SpokenLanguage represents a spoken language listed on a resume, with its CEFR level if
+// stated.
+type SpokenLanguage struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
+	Language    string               `json:"language"`
+	Proficiency *LanguageProficiency `json:"proficiency,omitempty"`
+}
+
+// Project represents a project listed on a resume.
+type Project struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
+	Name         string   `json:"name"`
+	Role         *string  `json:"role,omitempty"`
+	Description  *string  `json:"description,omitempty"`
+	URL          *string  `json:"url,omitempty"`
+	StartDate    *string  `json:"startDate,omitempty"`
+	EndDate      *string  `json:"endDate,omitempty"`
+	Technologies []string `json:"technologies,omitempty"`
+}
+
+// Publication represents a publication listed on a resume.
+type Publication struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
+	Title       string  `json:"title"`
+	Publisher   *string `json:"publisher,omitempty"`
+	Date        *string `json:"date,omitempty"`
+	URL         *string `json:"url,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// Award represents an award or honor listed on a resume.
+type Award struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
+	Title       string  `json:"title"`
+	Issuer      *string `json:"issuer,omitempty"`
+	Date        *string `json:"date,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
 // ResumeExtractedData is the complete extracted data from a resume.
+// Resumes extracted before links, certifications, languages, projects, publications and
+// awards were supported have none.
 type ResumeExtractedData struct { //nolint:govet // Field ordering prioritizes JSON serialization over memory alignment
-	Name        string           `json:"name"`
-	Email       *string          `json:"email,omitempty"`
-	Phone       *string          `json:"phone,omitempty"`
-	Location    *string          `json:"location,omitempty"`
-	Summary     *string          `json:"summary,omitempty"`
-	Experience  []WorkExperience `json:"experience"`
-	Education   []Education      `json:"education"`
-	Skills      []string         `json:"skills"`
-	ExtractedAt time.Time        `json:"extractedAt"`
-	Confidence  float64          `json:"confidence"`
+	Name           string           `json:"name"`
+	Email          *string          `json:"email,omitempty"`
+	Phone          *string          `json:"phone,omitempty"`
+	Location       *string          `json:"location,omitempty"`
+	Summary        *string          `json:"summary,omitempty"`
+	Experience     []WorkExperience `json:"experience"`
+	Education      []Education      `json:"education"`
+	Skills         []string         `json:"skills"`
+	Links          []ResumeLink     `json:"links,omitempty"`
+	Certifications []Certification  `json:"certifications,omitempty"`
+	Languages      []SpokenLanguage `json:"languages,omitempty"`
+	Projects       []Project        `json:"projects,omitempty"`
+	Publications   []Publication    `json:"publications,omitempty"`
+	Awards         []Award          `json:"awards,omitempty"`
+	ExtractedAt    time.Time        `json:"extractedAt"`
+	Confidence     float64          `json:"confidence"`
 }
 
 // ResumeRepository defines operations for resume persistence.
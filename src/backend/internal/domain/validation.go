@@ -11,6 +11,7 @@ var (
 	ErrInvalidCharacter = errors.New("field contains invalid characters")
 	ErrEmptyRequired    = errors.New("required field is empty")
 	ErrInvalidAuthor    = errors.New("invalid author name")
+	ErrUnsupportedClaim = errors.New("claim not supported by the profile")
 )
 
 // ValidationError wraps field-specific validation failures.
@@ -34,4 +35,7 @@ func (e *ValidationError) Unwrap() error {
 type ExtractedDataValidator interface {
 	ValidateResumeData(data *ResumeExtractedData) error
 	ValidateLetterData(data *ExtractedLetterData) error
+	ValidateCertificateData(data *ExtractedCertificateData) error
+	ValidateTranscriptData(data *ExtractedTranscriptData) error
+	ValidatePerformanceReviewData(data *ExtractedPerformanceReviewData) error
 }
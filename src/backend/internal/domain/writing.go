@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// WritingFactKind is the kind of profile entity a writing fact is taken from.
+type WritingFactKind string
+
+// Writing fact kinds.
+const (
+	WritingFactExperience  WritingFactKind = "experience"
+	WritingFactSkill       WritingFactKind = "skill"
+	WritingFactTestimonial WritingFactKind = "testimonial"
+)
+
+// WritingFact is one fact about a profile that drafts may draw on, rendered as plain text.
+// ID is the ID of the experience, skill or testimonial it is taken from.
+type WritingFact struct {
+	ID   uuid.UUID
+	Kind WritingFactKind
+	Text string
+}
+
+// WritingCitation points a draft back to a fact it draws on.
+type WritingCitation struct {
+	Kind WritingFactKind `json:"kind"`
+	ID   uuid.UUID       `json:"id"`
+}
+
+// WritingDraft is one piece of text drafted from a profile's facts, with the facts it cites.
+type WritingDraft struct {
+	Text      string            `json:"text"`
+	Citations []WritingCitation `json:"citations"`
+}
+
+// WritingDrafts is the output of a profile writer request.
+type WritingDrafts struct {
+	Drafts   []WritingDraft
+	Metadata ExtractionMetadata
+}
+
+// ProfileWriter drafts profile text and answers questions about a profile using LLM.
+// Drafts draw only on the facts they are given and cite every fact they use; answers draw
+// only on the evidence they are given.
+type ProfileWriter interface {
+	// DraftSummary drafts a professional summary of the facts.
+	DraftSummary(ctx context.Context, facts []WritingFact) (*WritingDrafts, error)
+
+	// RewriteHighlights rewrites the highlights of an experience in achievement-oriented
+	// form, one draft per highlight. The experience is one of the facts.
+	RewriteHighlights(ctx context.Context, experience WritingFact, facts []WritingFact) (*WritingDrafts, error)
+
+	// SuggestHeadlines drafts a few alternative one-line headlines from the facts.
+	SuggestHeadlines(ctx context.Context, facts []WritingFact) (*WritingDrafts, error)
+
+	// DraftCoverLetter drafts a cover letter for the job from the facts, quoting the
+	// testimonial facts.
+	DraftCoverLetter(ctx context.Context, job CoverLetterJob, facts []WritingFact) (*CoverLetterDraft, error)
+
+	// AnswerQuestion answers a question about the candidate from the evidence, quoting the
+	// evidence it rests on, or says that the evidence does not answer it.
+	AnswerQuestion(ctx context.Context, question string, evidence []Evidence) (*ProfileAnswer, error)
+}
+
+// WritingSuggestionKind is the part of a profile a writing suggestion rewrites.
+type WritingSuggestionKind string
+
+// Writing suggestion kinds.
+const (
+	WritingSuggestionSummary    WritingSuggestionKind = "summary"
+	WritingSuggestionHighlights WritingSuggestionKind = "highlights"
+	WritingSuggestionHeadline   WritingSuggestionKind = "headline"
+)
+
+// WritingSuggestionStatus tracks whether a writing suggestion was applied.
+type WritingSuggestionStatus string
+
+// Writing suggestion statuses.
+const (
+	WritingSuggestionPending   WritingSuggestionStatus = "pending"
+	WritingSuggestionAccepted  WritingSuggestionStatus = "accepted"
+	WritingSuggestionDismissed WritingSuggestionStatus = "dismissed"
+)
+
+// WritingSuggestion is drafted profile text awaiting the user's decision. It changes
+// nothing until accepted. A summary or headline suggestion holds one draft; a highlights
+// suggestion holds the rewritten highlights of ExperienceID, one draft each.
+type WritingSuggestion struct { //nolint:govet // Field ordering prioritizes readability over memory alignment
+	bun.BaseModel `bun:"table:writing_suggestions,alias:wsug"`
+
+	ID           uuid.UUID               `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProfileID    uuid.UUID               `bun:"profile_id,notnull,type:uuid"`
+	ExperienceID *uuid.UUID              `bun:"experience_id,type:uuid"`
+	Kind         WritingSuggestionKind   `bun:"kind,notnull"`
+	Drafts       []WritingDraft          `bun:"drafts,type:jsonb,notnull"`
+	Status       WritingSuggestionStatus `bun:"status,notnull,default:'pending'"`
+	ModelVersion string                  `bun:"model_version,notnull,default:''"`
+	DecidedAt    *time.Time              `bun:"decided_at"`
+	CreatedAt    time.Time               `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt    time.Time               `bun:"updated_at,notnull,default:current_timestamp"`
+
+	// Relations
+	Profile    *Profile           `bun:"rel:belongs-to,join:profile_id=id"`
+	Experience *ProfileExperience `bun:"rel:belongs-to,join:experience_id=id"`
+}
+
+// Texts returns the text of each draft.
+func (s *WritingSuggestion) Texts() []string {
+	texts := make([]string, len(s.Drafts))
+	for i, draft := range s.Drafts {
+		texts[i] = draft.Text
+	}
+	return texts
+}
+
+// WritingSuggestionRepository defines operations for writing suggestion persistence.
+type WritingSuggestionRepository interface {
+	// Create persists a new writing suggestion.
+	Create(ctx context.Context, suggestion *WritingSuggestion) error
+
+	// GetByID retrieves a writing suggestion by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*WritingSuggestion, error)
+
+	// GetByProfileID retrieves all writing suggestions for a profile, newest first.
+	GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*WritingSuggestion, error)
+
+	// Update persists changes to an existing writing suggestion.
+	Update(ctx context.Context, suggestion *WritingSuggestion) error
+}
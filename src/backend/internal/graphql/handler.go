@@ -4,10 +4,12 @@ package graphql
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/uptrace/bun"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"backend/internal/domain"
@@ -19,6 +21,7 @@ import (
 
 // NewHandler creates a new GraphQL HTTP handler with the given repositories.
 func NewHandler(
+	db *bun.DB,
 	userRepo domain.UserRepository,
 	fileRepo domain.FileRepository,
 	refLetterRepo domain.ReferenceLetterRepository,
@@ -31,15 +34,35 @@ func NewHandler(
 	testimonialRepo domain.TestimonialRepository,
 	skillValidationRepo domain.SkillValidationRepository,
 	expValidationRepo domain.ExperienceValidationRepository,
+	eduValidationRepo domain.EducationValidationRepository,
+	companyAliasRepo domain.CompanyAliasRepository,
+	companyRepo domain.CompanyRepository,
+	skillRepo domain.SkillRepository,
+	credentialDocRepo domain.CredentialDocumentRepository,
+	inconsistencyRepo domain.ProfileInconsistencyRepository,
+	changeRepo domain.ProfileChangeRepository,
+	linkRepo domain.ProfileLinkRepository,
+	certificationRepo domain.ProfileCertificationRepository,
+	languageRepo domain.ProfileLanguageRepository,
+	projectRepo domain.ProfileProjectRepository,
+	publicationRepo domain.ProfilePublicationRepository,
+	awardRepo domain.ProfileAwardRepository,
+	jobDescriptionRepo domain.JobDescriptionRepository,
+	profileVariantRepo domain.ProfileVariantRepository,
+	writingSuggestionRepo domain.WritingSuggestionRepository,
+	coverLetterRepo domain.CoverLetterRepository,
 	storage domain.Storage,
 	jobEnqueuer domain.JobEnqueuer,
 	documentExtractor domain.DocumentExtractor,
+	profileWriter domain.ProfileWriter,
 	materializationSvc *service.MaterializationService,
+	embeddingSvc *service.EmbeddingService,
+	certExpiryWindow time.Duration,
 	log logger.Logger,
 ) http.Handler {
 	srv := handler.NewDefaultServer(
 		generated.NewExecutableSchema(generated.Config{
-			Resolvers: resolver.NewResolver(userRepo, fileRepo, refLetterRepo, resumeRepo, profileRepo, profileExpRepo, profileEduRepo, profileSkillRepo, authorRepo, testimonialRepo, skillValidationRepo, expValidationRepo, storage, jobEnqueuer, documentExtractor, materializationSvc, log),
+			Resolvers: resolver.NewResolver(db, userRepo, fileRepo, refLetterRepo, resumeRepo, profileRepo, profileExpRepo, profileEduRepo, profileSkillRepo, authorRepo, testimonialRepo, skillValidationRepo, expValidationRepo, eduValidationRepo, companyAliasRepo, companyRepo, skillRepo, credentialDocRepo, inconsistencyRepo, changeRepo, linkRepo, certificationRepo, languageRepo, projectRepo, publicationRepo, awardRepo, jobDescriptionRepo, profileVariantRepo, writingSuggestionRepo, coverLetterRepo, storage, jobEnqueuer, documentExtractor, profileWriter, materializationSvc, embeddingSvc, certExpiryWindow, log),
 		}),
 	)
 
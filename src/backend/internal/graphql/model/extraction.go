@@ -48,9 +48,11 @@ type ExtractedSkillMention struct { //nolint:govet // Field ordering prioritizes
 
 // ExtractedExperienceMention is the GraphQL model for an experience mention.
 type ExtractedExperienceMention struct {
-	Company string `json:"company"`
-	Role    string `json:"role"`
-	Quote   string `json:"quote"`
+	Company   string  `json:"company"`
+	Role      string  `json:"role"`
+	Quote     string  `json:"quote"`
+	StartDate *string `json:"startDate,omitempty"`
+	EndDate   *string `json:"endDate,omitempty"`
 }
 
 // ExtractionMetadata is the GraphQL model for extraction metadata.
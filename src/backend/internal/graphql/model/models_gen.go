@@ -16,6 +16,41 @@ type ApplyValidationsResponse interface {
 	IsApplyValidationsResponse()
 }
 
+// Union type for award create/update result.
+type AwardResponse interface {
+	IsAwardResponse()
+}
+
+// Union type for bulk skill update result.
+type BulkUpdateSkillsResponse interface {
+	IsBulkUpdateSkillsResponse()
+}
+
+// Union type for canonical skill create/update result.
+type CanonicalSkillResponse interface {
+	IsCanonicalSkillResponse()
+}
+
+// Union type for certification create/update result.
+type CertificationResponse interface {
+	IsCertificationResponse()
+}
+
+// Union type for company alias create/update result.
+type CompanyAliasResponse interface {
+	IsCompanyAliasResponse()
+}
+
+// Union type for company update/merge result.
+type CompanyResponse interface {
+	IsCompanyResponse()
+}
+
+// Union type for cover letter results.
+type CoverLetterResponse interface {
+	IsCoverLetterResponse()
+}
+
 // Union type for profile photo deletion result.
 type DeleteProfilePhotoResponse interface {
 	IsDeleteProfilePhotoResponse()
@@ -36,6 +71,26 @@ type ImportDocumentResultsResponse interface {
 	IsImportDocumentResultsResponse()
 }
 
+// Union type for resolve/dismiss results.
+type InconsistencyResponse interface {
+	IsInconsistencyResponse()
+}
+
+// Union type for language create/update result.
+type LanguageResponse interface {
+	IsLanguageResponse()
+}
+
+// Union type for link create/update result.
+type LinkResponse interface {
+	IsLinkResponse()
+}
+
+// Union type for job description match results.
+type MatchJobDescriptionResponse interface {
+	IsMatchJobDescriptionResponse()
+}
+
 // Union type for process document result.
 type ProcessDocumentResponse interface {
 	IsProcessDocumentResponse()
@@ -46,11 +101,46 @@ type ProfileHeaderResponse interface {
 	IsProfileHeaderResponse()
 }
 
+// Union type for profile variant results.
+type ProfileVariantResponse interface {
+	IsProfileVariantResponse()
+}
+
+// Union type for project create/update result.
+type ProjectResponse interface {
+	IsProjectResponse()
+}
+
+// Union type for publication create/update result.
+type PublicationResponse interface {
+	IsPublicationResponse()
+}
+
+// Union type for reorder result.
+type ReorderProfileItemsResponse interface {
+	IsReorderProfileItemsResponse()
+}
+
+// Union type for revert-to-extracted results.
+type RevertToExtractedResponse interface {
+	IsRevertToExtractedResponse()
+}
+
 // Union type for skill create/update result.
 type SkillResponse interface {
 	IsSkillResponse()
 }
 
+// Union type for the skill split result.
+type SplitSkillResponse interface {
+	IsSplitSkillResponse()
+}
+
+// Union type for undo results.
+type UndoChangeResponse interface {
+	IsUndoChangeResponse()
+}
+
 // Union type for author image upload result.
 type UploadAuthorImageResponse interface {
 	IsUploadAuthorImageResponse()
@@ -76,6 +166,16 @@ type UploadResumeResponse interface {
 	IsUploadResumeResponse()
 }
 
+// Union type for accept and dismiss results.
+type WritingSuggestionResponse interface {
+	IsWritingSuggestionResponse()
+}
+
+// Union type for drafting results.
+type WritingSuggestionsResponse interface {
+	IsWritingSuggestionsResponse()
+}
+
 // Counts of items applied from a reference letter.
 type AppliedCount struct {
 	// Number of skill validations applied.
@@ -145,6 +245,352 @@ type Author struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 	// All testimonials from this author.
 	Testimonials []*Testimonial `json:"testimonials"`
+	// The company entity this author is linked to.
+	LinkedCompany *Company `json:"linkedCompany,omitempty"`
+}
+
+// Result of a successful award operation.
+type AwardResult struct {
+	// The created or updated award.
+	Award *ProfileAward `json:"award"`
+}
+
+func (AwardResult) IsAwardResponse() {}
+
+// Error returned when award validation fails.
+type AwardValidationError struct {
+	// Error message describing the validation failure.
+	Message string `json:"message"`
+	// The field that failed validation.
+	Field *string `json:"field,omitempty"`
+}
+
+func (AwardValidationError) IsAwardResponse() {}
+
+// One skill's changes in a bulk update. Omitted fields are left unchanged.
+type BulkUpdateSkillInput struct {
+	// The skill ID to update.
+	ID string `json:"id"`
+	// Skill category.
+	Category *domain.SkillCategory `json:"category,omitempty"`
+	// Display order for sorting.
+	DisplayOrder *int `json:"displayOrder,omitempty"`
+}
+
+// Result of a successful bulk skill update.
+type BulkUpdateSkillsResult struct {
+	// The updated skills, in input order.
+	Skills []*ProfileSkill `json:"skills"`
+}
+
+func (BulkUpdateSkillsResult) IsBulkUpdateSkillsResponse() {}
+
+// A canonical skill in the skill taxonomy. Profile skills link to one, so synonyms and
+// abbreviations such as "k8s" and "Kubernetes" are recognized as the same skill.
+type CanonicalSkill struct {
+	// Unique identifier for the canonical skill.
+	ID string `json:"id"`
+	// Stable identifier derived from the name, e.g. "kubernetes".
+	Slug string `json:"slug"`
+	// Canonical display name.
+	Name string `json:"name"`
+	// Skill category.
+	Category domain.SkillCategory `json:"category"`
+	// Other names and abbreviations for the skill.
+	Synonyms []string `json:"synonyms"`
+	// The broader skill this one belongs to, e.g. JavaScript for React.
+	Parent *CanonicalSkill `json:"parent,omitempty"`
+	// All broader skills, nearest first, e.g. JavaScript then Frontend Development for React.
+	Ancestors []*CanonicalSkill `json:"ancestors"`
+	// Whether the skill was defined for this profile rather than bundled with the taxonomy.
+	Custom bool `json:"custom"`
+}
+
+// Result of a successful canonical skill operation.
+type CanonicalSkillResult struct {
+	// The created or updated canonical skill.
+	Skill *CanonicalSkill `json:"skill"`
+}
+
+func (CanonicalSkillResult) IsCanonicalSkillResponse() {}
+
+// Error returned when canonical skill validation fails.
+type CanonicalSkillValidationError struct {
+	// Error message describing the validation failure.
+	Message string `json:"message"`
+	// The field that failed validation.
+	Field *string `json:"field,omitempty"`
+}
+
+func (CanonicalSkillValidationError) IsCanonicalSkillResponse() {}
+
+// A break between roles.
+type CareerGap struct {
+	// First month without a role (YYYY-MM).
+	StartMonth string `json:"startMonth"`
+	// Last month without a role (YYYY-MM), the current month if the gap is ongoing.
+	EndMonth string `json:"endMonth"`
+	// Length of the gap in months.
+	Months int `json:"months"`
+	// The role that ended before the gap.
+	PreviousRole *ProfileExperience `json:"previousRole"`
+	// The role that started after the gap; null if the gap runs until now.
+	NextRole *ProfileExperience `json:"nextRole,omitempty"`
+	// Whether the gap lies entirely within education entries.
+	DuringEducation bool `json:"duringEducation"`
+	// The candidate's explanation of the gap, kept on the previous role.
+	Note *string `json:"note,omitempty"`
+}
+
+// A profile's experience and education in chronological order, with gaps, overlaps and tenure figures.
+// Entries without readable dates are listed but left out of the figures.
+type CareerTimeline struct {
+	// Experience and education, most recent first; undated entries last.
+	Entries []*CareerTimelineEntry `json:"entries"`
+	// Breaks between roles at least as long as the requested threshold.
+	Gaps []*CareerGap `json:"gaps"`
+	// Roles that ran together for more than a month.
+	Overlaps []*RoleOverlap `json:"overlaps"`
+	// Months covered by roles, counting overlapping roles once.
+	TotalExperienceMonths int `json:"totalExperienceMonths"`
+	// Average length of a role in months.
+	AverageTenureMonths float64 `json:"averageTenureMonths"`
+	// The most recently started ongoing role, if any.
+	CurrentRole *ProfileExperience `json:"currentRole,omitempty"`
+	// Months in the current role, 0 if there is none.
+	CurrentRoleMonths int `json:"currentRoleMonths"`
+}
+
+// An experience or education entry on a career timeline. Exactly one of experience and education is set.
+type CareerTimelineEntry struct {
+	Experience *ProfileExperience `json:"experience,omitempty"`
+	Education  *ProfileEducation  `json:"education,omitempty"`
+	// First month covered (YYYY-MM), null if the start date could not be read.
+	StartMonth *string `json:"startMonth,omitempty"`
+	// Last month covered (YYYY-MM), the current month if ongoing; null if the end date could not be read.
+	EndMonth *string `json:"endMonth,omitempty"`
+	// Whether the entry runs until now.
+	Ongoing bool `json:"ongoing"`
+	// Length in months, counting both ends; null if the dates are unknown.
+	DurationMonths *int `json:"durationMonths,omitempty"`
+}
+
+// Result of a successful certification operation.
+type CertificationResult struct {
+	// The created or updated certification.
+	Certification *ProfileCertification `json:"certification"`
+}
+
+func (CertificationResult) IsCertificationResponse() {}
+
+// Error returned when certification validation fails.
+type CertificationValidationError struct {
+	// Error message describing the validation failure.
+	Message string `json:"message"`
+	// The field that failed validation.
+	Field *string `json:"field,omitempty"`
+}
+
+func (CertificationValidationError) IsCertificationResponse() {}
+
+// A company on a profile. Experiences and testimonial authors at the same company link to
+// one Company, so everything known about a company is available without comparing names.
+type Company struct {
+	// Unique identifier for the company.
+	ID string `json:"id"`
+	// Display name of the company.
+	Name string `json:"name"`
+	// Other names the company is known by, e.g. names of companies merged into it: the company
+	// aliases whose canonical name is this company's name.
+	Aliases []string `json:"aliases"`
+	// Company website URL.
+	Website *string `json:"website,omitempty"`
+	// The company's logo file, if one was set.
+	Logo *File `json:"logo,omitempty"`
+	// Experiences on the profile at this company.
+	Experiences []*ProfileExperience `json:"experiences"`
+	// Testimonial authors who work or worked at this company.
+	Authors []*Author `json:"authors"`
+	// Testimonials written by authors at this company.
+	Testimonials []*Testimonial `json:"testimonials"`
+	// When the company was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// When the company was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// A user-maintained equivalence between two company names, e.g. a former name or a
+// trade name. Company matching treats the alias as the canonical company when
+// validating experiences and deduplicating authors.
+type CompanyAlias struct {
+	// Unique identifier for the alias.
+	ID string `json:"id"`
+	// The alternative company name (e.g. 'Facebook').
+	Alias string `json:"alias"`
+	// The company the alias refers to (e.g. 'Meta Platforms').
+	CanonicalName string `json:"canonicalName"`
+	// When the alias was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// When the alias was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Result of a successful company alias operation.
+type CompanyAliasResult struct {
+	// The created or updated alias.
+	CompanyAlias *CompanyAlias `json:"companyAlias"`
+}
+
+func (CompanyAliasResult) IsCompanyAliasResponse() {}
+
+// Error returned when company alias validation fails.
+type CompanyAliasValidationError struct {
+	// Error message describing the validation failure.
+	Message string `json:"message"`
+	// The field that failed validation.
+	Field *string `json:"field,omitempty"`
+}
+
+func (CompanyAliasValidationError) IsCompanyAliasResponse() {}
+
+// Result of a successful company operation.
+type CompanyResult struct {
+	// The updated or merged company.
+	Company *Company `json:"company"`
+}
+
+func (CompanyResult) IsCompanyResponse() {}
+
+// Error returned when company validation fails.
+type CompanyValidationError struct {
+	// Error message describing the validation failure.
+	Message string `json:"message"`
+	// The field that failed validation.
+	Field *string `json:"field,omitempty"`
+}
+
+func (CompanyValidationError) IsCompanyResponse() {}
+
+// A cover letter written from a profile for a job description.
+type CoverLetter struct {
+	// Unique identifier for the cover letter.
+	ID string `json:"id"`
+	// Title naming the job the letter is written for.
+	Title string `json:"title"`
+	// The job description the letter is written for. Null if it was deleted.
+	JobDescription *JobDescription `json:"jobDescription,omitempty"`
+	// The latest version of the letter.
+	LatestVersion *CoverLetterVersion `json:"latestVersion"`
+	// All versions of the letter, newest first.
+	Versions []*CoverLetterVersion `json:"versions"`
+	// When the letter was generated.
+	CreatedAt time.Time `json:"createdAt"`
+	// When the letter was last edited.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// A version of a cover letter rendered as a file.
+type CoverLetterExport struct {
+	// Suggested file name, e.g. "backend-engineer-at-acme-v2.txt".
+	Filename string `json:"filename"`
+	// MIME type of the content.
+	ContentType string `json:"contentType"`
+	// The file content.
+	Content string `json:"content"`
+}
+
+// A passage of a cover letter quoted from a testimonial.
+type CoverLetterQuote struct {
+	// ID of the testimonial the passage is taken from.
+	TestimonialID string `json:"testimonialId"`
+	// The quoted passage, verbatim from the testimonial's quote.
+	Text string `json:"text"`
+}
+
+// Result of generating or editing a cover letter.
+type CoverLetterResult struct {
+	// The cover letter.
+	CoverLetter *CoverLetter `json:"coverLetter"`
+}
+
+func (CoverLetterResult) IsCoverLetterResponse() {}
+
+// Error returned when a cover letter cannot be generated or edited.
+type CoverLetterValidationError struct {
+	// Error message describing the validation failure.
+	Message string `json:"message"`
+	// Field that caused the error.
+	Field *string `json:"field,omitempty"`
+}
+
+func (CoverLetterValidationError) IsCoverLetterResponse() {}
+
+// One version of a cover letter's text. Versions are never changed; an edit adds a version.
+type CoverLetterVersion struct {
+	// Unique identifier for the version.
+	ID string `json:"id"`
+	// Version number, starting at 1 for the generated draft.
+	Version int `json:"version"`
+	// The letter text, paragraphs separated by blank lines.
+	Body string `json:"body"`
+	// The experiences, skills and testimonials the letter draws on.
+	Citations []*WritingCitation `json:"citations"`
+	// The testimonial passages the letter quotes, each verified against the stored quote.
+	Quotes []*CoverLetterQuote `json:"quotes"`
+	// Whether the version was generated or edited.
+	Source CoverLetterVersionSource `json:"source"`
+	// The model that drafted the text, empty for edits.
+	ModelVersion string `json:"modelVersion"`
+	// When the version was saved.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Input for creating an award.
+type CreateAwardInput struct {
+	// Award title (required).
+	Title string `json:"title"`
+	// Awarding organization.
+	Issuer *string `json:"issuer,omitempty"`
+	// Date received.
+	Date *string `json:"date,omitempty"`
+	// Short description.
+	Description *string `json:"description,omitempty"`
+}
+
+// Input for adding a skill to a profile's taxonomy.
+type CreateCanonicalSkillInput struct {
+	// Canonical display name (required).
+	Name string `json:"name"`
+	// Skill category (required).
+	Category domain.SkillCategory `json:"category"`
+	// ID of the broader skill this one belongs to.
+	ParentID *string `json:"parentId,omitempty"`
+	// Other names and abbreviations for the skill.
+	Synonyms []string `json:"synonyms,omitempty"`
+}
+
+// Input for creating a certification.
+type CreateCertificationInput struct {
+	// Certification name (required).
+	Name string `json:"name"`
+	// Issuing organization.
+	Issuer *string `json:"issuer,omitempty"`
+	// Credential or license number.
+	CredentialID *string `json:"credentialId,omitempty"`
+	// URL where the credential can be verified.
+	VerificationURL *string `json:"verificationUrl,omitempty"`
+	// Issue date (e.g., 'Mar 2022').
+	IssueDate *string `json:"issueDate,omitempty"`
+	// Expiry date (e.g., 'Mar 2025').
+	ExpiryDate *string `json:"expiryDate,omitempty"`
+}
+
+// Input for creating a company alias.
+type CreateCompanyAliasInput struct {
+	// The alternative company name (required).
+	Alias string `json:"alias"`
+	// The company the alias refers to (required).
+	CanonicalName string `json:"canonicalName"`
 }
 
 // Input for creating a new education entry.
@@ -187,6 +633,74 @@ type CreateExperienceInput struct {
 	Highlights []string `json:"highlights,omitempty"`
 }
 
+// Input for creating a language.
+type CreateLanguageInput struct {
+	// Language name (required).
+	Language string `json:"language"`
+	// Proficiency level.
+	Proficiency *LanguageProficiency `json:"proficiency,omitempty"`
+}
+
+// Input for creating a link.
+type CreateLinkInput struct {
+	// The URL (required).
+	URL string `json:"url"`
+	// What the link points to; inferred from the URL if omitted.
+	Kind *LinkKind `json:"kind,omitempty"`
+	// Label to show instead of the URL.
+	Label *string `json:"label,omitempty"`
+}
+
+// Input for creating a profile variant.
+type CreateProfileVariantInput struct {
+	// Name of the variant (required).
+	Name string `json:"name"`
+	// The role the variant is tailored to.
+	TargetRole *string `json:"targetRole,omitempty"`
+	// Summary to show instead of the profile's.
+	Summary *string `json:"summary,omitempty"`
+	// Items to leave out.
+	HiddenItemIds []string `json:"hiddenItemIds,omitempty"`
+	// Items to show first within their section, in this order.
+	ItemOrder []string `json:"itemOrder,omitempty"`
+	// Testimonials to show, in this order; all when omitted.
+	TestimonialIds []string `json:"testimonialIds,omitempty"`
+	// Experience descriptions and highlights to override.
+	ExperienceOverrides []*ExperienceOverrideInput `json:"experienceOverrides,omitempty"`
+}
+
+// Input for creating a project.
+type CreateProjectInput struct {
+	// Project name (required).
+	Name string `json:"name"`
+	// The user's role in the project.
+	Role *string `json:"role,omitempty"`
+	// Project description.
+	Description *string `json:"description,omitempty"`
+	// Project URL.
+	URL *string `json:"url,omitempty"`
+	// Start date (e.g., 'Jan 2020', '2020').
+	StartDate *string `json:"startDate,omitempty"`
+	// End date (e.g., 'Dec 2023', 'Present').
+	EndDate *string `json:"endDate,omitempty"`
+	// Technologies used.
+	Technologies []string `json:"technologies,omitempty"`
+}
+
+// Input for creating a publication.
+type CreatePublicationInput struct {
+	// Publication title (required).
+	Title string `json:"title"`
+	// Publisher, journal or venue.
+	Publisher *string `json:"publisher,omitempty"`
+	// Publication date.
+	Date *string `json:"date,omitempty"`
+	// Publication URL.
+	URL *string `json:"url,omitempty"`
+	// Short description.
+	Description *string `json:"description,omitempty"`
+}
+
 // Input for creating a new skill.
 type CreateSkillInput struct {
 	// Skill name (required).
@@ -195,6 +709,53 @@ type CreateSkillInput struct {
 	Category domain.SkillCategory `json:"category"`
 }
 
+// An uploaded certificate, transcript or performance review.
+// These documents are issued by an institution or employer and carry more
+// verifiable weight than reference letters.
+type CredentialDocument struct {
+	ID string `json:"id"`
+	// Which kind of credential this document is.
+	DocumentType CredentialDocumentType `json:"documentType"`
+	// Processing status of the document.
+	Status CredentialDocumentStatus `json:"status"`
+	// Error message, set when status is FAILED.
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+	// Extracted data, set when documentType is CERTIFICATE and status is COMPLETED.
+	CertificateData *ExtractedCertificateData `json:"certificateData,omitempty"`
+	// Extracted data, set when documentType is TRANSCRIPT and status is COMPLETED.
+	TranscriptData *ExtractedTranscriptData `json:"transcriptData,omitempty"`
+	// Extracted data, set when documentType is PERFORMANCE_REVIEW and status is COMPLETED.
+	PerformanceReviewData *ExtractedPerformanceReviewData `json:"performanceReviewData,omitempty"`
+	CreatedAt             time.Time                       `json:"createdAt"`
+	UpdatedAt             time.Time                       `json:"updatedAt"`
+	User                  *User                           `json:"user"`
+	File                  *File                           `json:"file,omitempty"`
+}
+
+// One term of a credibility score: the score is the sum of value × weight over its factors.
+type CredibilityFactor struct {
+	// The factor.
+	Name CredibilityFactorName `json:"name"`
+	// The factor's value, from 0 to 1.
+	Value float64 `json:"value"`
+	// The factor's weight in the score.
+	Weight float64 `json:"weight"`
+	// The factor's share of the score (value × weight).
+	Contribution float64 `json:"contribution"`
+	// What the value is based on, e.g. "3 authors at 2 companies".
+	Detail string `json:"detail"`
+}
+
+// A credibility score from 0 to 1 with its factor breakdown.
+type CredibilityScore struct {
+	// The score, from 0 (no evidence) to 1.
+	Score float64 `json:"score"`
+	// Number of validations or testimonials the score is based on.
+	EvidenceCount int `json:"evidenceCount"`
+	// The factors making up the score.
+	Factors []*CredibilityFactor `json:"factors"`
+}
+
 // Result of deleting a profile photo.
 type DeleteProfilePhotoResult struct {
 	// Whether the deletion was successful.
@@ -265,6 +826,8 @@ type DocumentProcessingStatus struct {
 	Resume *Resume `json:"resume,omitempty"`
 	// Reference letter processing status (null if letter extraction was not requested).
 	ReferenceLetter *ReferenceLetter `json:"referenceLetter,omitempty"`
+	// Credential document processing status (empty if none were requested).
+	CredentialDocuments []*CredentialDocument `json:"credentialDocuments"`
 	// Overall status: true when all requested extractions are complete (COMPLETED or FAILED).
 	AllComplete bool `json:"allComplete"`
 }
@@ -285,6 +848,21 @@ func (DuplicateFileDetected) IsUploadFileResponse() {}
 
 func (DuplicateFileDetected) IsUploadResumeResponse() {}
 
+// Experiences or education entries that look like the same entry listed more than once.
+// Exactly one of experiences and educations is non-empty.
+type DuplicateSuggestion struct {
+	// The kind of entries: EXPERIENCE or EDUCATION.
+	EntityType ProfileChangeEntity `json:"entityType"`
+	// The duplicate experiences, in display order.
+	Experiences []*ProfileExperience `json:"experiences"`
+	// The duplicate education entries, in display order.
+	Educations []*ProfileEducation `json:"educations"`
+	// The entry with the most detail, suggested as the one to keep.
+	SuggestedTargetID string `json:"suggestedTargetId"`
+	// How the entries agree.
+	Reasons []DuplicateReason `json:"reasons"`
+}
+
 // Result of a successful education operation.
 type EducationResult struct {
 	// The created or updated education entry.
@@ -293,6 +871,21 @@ type EducationResult struct {
 
 func (EducationResult) IsEducationResponse() {}
 
+// A validation record linking an education entry to a reference letter,
+// e.g. a letter from a professor at that institution.
+type EducationValidation struct {
+	// Unique identifier for the validation.
+	ID string `json:"id"`
+	// The education entry being validated.
+	Education *ProfileEducation `json:"education"`
+	// The reference letter providing the validation.
+	ReferenceLetter *ReferenceLetter `json:"referenceLetter"`
+	// Quote snippet from the reference letter supporting this education entry.
+	QuoteSnippet *string `json:"quoteSnippet,omitempty"`
+	// When the validation was created.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
 // Error returned when education validation fails.
 type EducationValidationError struct {
 	// Error message describing the validation failure.
@@ -303,6 +896,60 @@ type EducationValidationError struct {
 
 func (EducationValidationError) IsEducationResponse() {}
 
+// A passage of a profile's evidence an answer relies on.
+type EvidenceCitation struct {
+	Kind EvidenceKind `json:"kind"`
+	// ID of the Testimonial, SkillValidation or ExperienceValidation quoted.
+	SourceID string `json:"sourceId"`
+	// The passage, verbatim from the stored evidence.
+	Quote string `json:"quote"`
+}
+
+// A piece of a profile's evidence found by meaning rather than exact words.
+type EvidenceSearchResult struct {
+	Kind EvidenceKind `json:"kind"`
+	// ID of the Testimonial, SkillValidation or ProfileExperience the text comes from.
+	SourceID string `json:"sourceId"`
+	// The evidence text as embedded.
+	Text string `json:"text"`
+	// Cosine similarity to the query, from -1 to 1; higher is closer.
+	Score float64 `json:"score"`
+}
+
+// Credibility of a profile experience, from the reference letters that validate it.
+type ExperienceCredibility struct {
+	Experience  *ProfileExperience `json:"experience"`
+	Credibility *CredibilityScore  `json:"credibility"`
+}
+
+// A bullet point of a work experience.
+type ExperienceHighlight struct {
+	// The bullet text.
+	Text string `json:"text"`
+	// Whether it states a measurable result: a number, percentage or amount of money.
+	Quantified bool `json:"quantified"`
+}
+
+// Replaces the description or highlights of an experience in a profile variant.
+type ExperienceOverride struct {
+	// The experience overridden.
+	ExperienceID string `json:"experienceId"`
+	// Description shown instead of the experience's, if set.
+	Description *string `json:"description,omitempty"`
+	// Highlights shown instead of the experience's, if set.
+	Highlights []string `json:"highlights,omitempty"`
+}
+
+// Replaces the description or highlights of an experience in a profile variant.
+type ExperienceOverrideInput struct {
+	// The experience to override.
+	ExperienceID string `json:"experienceId"`
+	// Description to show instead of the experience's.
+	Description *string `json:"description,omitempty"`
+	// Highlights to show instead of the experience's.
+	Highlights []string `json:"highlights,omitempty"`
+}
+
 // Result of a successful experience operation.
 type ExperienceResult struct {
 	// The created or updated experience.
@@ -343,6 +990,66 @@ type ExperienceValidationInput struct {
 	QuoteSnippet string `json:"quoteSnippet"`
 }
 
+// An award or honor extracted from a resume.
+type ExtractedAward struct {
+	// Award title.
+	Title string `json:"title"`
+	// Awarding organization.
+	Issuer *string `json:"issuer,omitempty"`
+	// Date received.
+	Date *string `json:"date,omitempty"`
+	// Short description.
+	Description *string `json:"description,omitempty"`
+}
+
+// Structured data extracted from a certificate or license.
+type ExtractedCertificateData struct {
+	// Name of the certification or license.
+	Name string `json:"name"`
+	// Organization that issued the credential.
+	Issuer string `json:"issuer"`
+	// Name of the person the credential was issued to.
+	HolderName *string `json:"holderName,omitempty"`
+	// Credential, certificate or license number.
+	CredentialID *string `json:"credentialId,omitempty"`
+	// URL to verify the credential.
+	VerificationURL *string `json:"verificationUrl,omitempty"`
+	// Issue date (YYYY-MM-DD).
+	IssueDate *string `json:"issueDate,omitempty"`
+	// Expiry date (YYYY-MM-DD), null if the credential does not expire.
+	ExpiryDate *string `json:"expiryDate,omitempty"`
+	// Skills the credential attests to.
+	Skills []string `json:"skills"`
+	// Metadata about the extraction process.
+	Metadata *ExtractionMetadata `json:"metadata"`
+}
+
+// A certification or license extracted from a resume.
+type ExtractedCertification struct {
+	// Certification name.
+	Name string `json:"name"`
+	// Issuing organization.
+	Issuer *string `json:"issuer,omitempty"`
+	// Credential or license number.
+	CredentialID *string `json:"credentialId,omitempty"`
+	// URL where the credential can be verified.
+	VerificationURL *string `json:"verificationUrl,omitempty"`
+	// Issue date.
+	IssueDate *string `json:"issueDate,omitempty"`
+	// Expiry date.
+	ExpiryDate *string `json:"expiryDate,omitempty"`
+}
+
+// A course listed on an academic transcript.
+type ExtractedCourse struct {
+	// Course name.
+	Name string `json:"name"`
+	// Grade achieved.
+	Grade *string `json:"grade,omitempty"`
+	// Credits or ECTS points.
+	Credits *string `json:"credits,omitempty"`
+}
+
 // An education entry extracted from a resume.
 type ExtractedEducation struct {
 	// Institution name.
@@ -361,31 +1068,141 @@ type ExtractedEducation struct {
 	Achievements *string `json:"achievements,omitempty"`
 }
 
-// A work experience entry extracted from a resume.
-type ExtractedWorkExperience struct {
-	// Company or organization name.
-	Company string `json:"company"`
-	// Job title or role.
-	Title string `json:"title"`
-	// Location of the role.
-	Location *string `json:"location,omitempty"`
-	// Start date (YYYY-MM format).
-	StartDate *string `json:"startDate,omitempty"`
-	// End date (YYYY-MM format), null if current.
-	EndDate *string `json:"endDate,omitempty"`
-	// Whether this is the current position.
-	IsCurrent bool `json:"isCurrent"`
-	// Role description or achievements.
-	Description *string `json:"description,omitempty"`
+// A spoken language extracted from a resume.
+type ExtractedLanguage struct {
+	// Language name.
+	Language string `json:"language"`
+	// Proficiency level, if stated.
+	Proficiency *LanguageProficiency `json:"proficiency,omitempty"`
 }
 
-// An uploaded file stored in object storage.
-type File struct {
-	ID          string `json:"id"`
-	Filename    string `json:"filename"`
-	ContentType string `json:"contentType"`
-	SizeBytes   int    `json:"sizeBytes"`
-	StorageKey  string `json:"storageKey"`
+// A link extracted from a resume.
+type ExtractedLink struct {
+	// What the link points to.
+	Kind LinkKind `json:"kind"`
+	// The URL.
+	URL string `json:"url"`
+	// Label shown on the resume, if any.
+	Label *string `json:"label,omitempty"`
+}
+
+// Structured data extracted from a performance review.
+type ExtractedPerformanceReviewData struct {
+	// The reviewer who wrote the review.
+	Reviewer *ExtractedAuthor `json:"reviewer"`
+	// Employer the review was written at.
+	Company *string `json:"company,omitempty"`
+	// Candidate's role during the review period.
+	Role *string `json:"role,omitempty"`
+	// Period covered by the review.
+	ReviewPeriod *string `json:"reviewPeriod,omitempty"`
+	// Overall rating as stated.
+	Rating *string `json:"rating,omitempty"`
+	// Review statements suitable for display as testimonials.
+	Testimonials []*ExtractedTestimonial `json:"testimonials"`
+	// Metadata about the extraction process.
+	Metadata *ExtractionMetadata `json:"metadata"`
+}
+
+// A project extracted from a resume.
+type ExtractedProject struct {
+	// Project name.
+	Name string `json:"name"`
+	// The candidate's role in the project.
+	Role *string `json:"role,omitempty"`
+	// Project description.
+	Description *string `json:"description,omitempty"`
+	// Project URL.
+	URL *string `json:"url,omitempty"`
+	// Start date.
+	StartDate *string `json:"startDate,omitempty"`
+	// End date.
+	EndDate *string `json:"endDate,omitempty"`
+	// Technologies used.
+	Technologies []string `json:"technologies"`
+}
+
+// A publication extracted from a resume.
+type ExtractedPublication struct {
+	// Publication title.
+	Title string `json:"title"`
+	// Publisher, journal or venue.
+	Publisher *string `json:"publisher,omitempty"`
+	// Publication date.
+	Date *string `json:"date,omitempty"`
+	// Publication URL.
+	URL *string `json:"url,omitempty"`
+	// Short description.
+	Description *string `json:"description,omitempty"`
+}
+
+// Structured data extracted from an academic transcript or diploma.
+type ExtractedTranscriptData struct {
+	// Name of the student.
+	HolderName *string `json:"holderName,omitempty"`
+	// Institution name.
+	Institution string `json:"institution"`
+	// Degree conferred.
+	Degree *string `json:"degree,omitempty"`
+	// Field of study.
+	Field *string `json:"field,omitempty"`
+	// Start of studies (YYYY-MM-DD).
+	StartDate *string `json:"startDate,omitempty"`
+	// Date the degree was conferred (YYYY-MM-DD).
+	GraduationDate *string `json:"graduationDate,omitempty"`
+	// Overall grade or GPA as stated.
+	Gpa *string `json:"gpa,omitempty"`
+	// Honors or distinctions.
+	Honors *string `json:"honors,omitempty"`
+	// Courses listed on the transcript.
+	Courses []*ExtractedCourse `json:"courses"`
+	// Metadata about the extraction process.
+	Metadata *ExtractionMetadata `json:"metadata"`
+}
+
+// A work experience entry extracted from a resume.
+type ExtractedWorkExperience struct {
+	// Company or organization name.
+	Company string `json:"company"`
+	// Job title or role.
+	Title string `json:"title"`
+	// Location of the role.
+	Location *string `json:"location,omitempty"`
+	// Start date (YYYY-MM format).
+	StartDate *string `json:"startDate,omitempty"`
+	// End date (YYYY-MM format), null if current.
+	EndDate *string `json:"endDate,omitempty"`
+	// Whether this is the current position.
+	IsCurrent bool `json:"isCurrent"`
+	// Prose describing the role, without its bullet points.
+	Description *string `json:"description,omitempty"`
+	// Bullet points of the role, such as key achievements.
+	Highlights []*ExperienceHighlight `json:"highlights"`
+}
+
+// Where the current value of one field of an experience, education entry or skill came from.
+type FieldProvenance struct {
+	// Field name, as on the item's type.
+	Field string `json:"field"`
+	// RESUME_EXTRACTED or LETTER_DISCOVERED while the field holds the value taken from the source
+	// document, MANUAL once the user changed it or when the item was entered manually.
+	Origin ExperienceSource `json:"origin"`
+	// The resume the item was extracted from.
+	SourceResumeID *string `json:"sourceResumeId,omitempty"`
+	// The reference letter the item was discovered in.
+	SourceReferenceLetterID *string `json:"sourceReferenceLetterId,omitempty"`
+	// The value taken from the source document, as JSON. Null when the item was entered manually
+	// or its extracted values were not kept; the field is then attributed to the item's source.
+	ExtractedValue *string `json:"extractedValue,omitempty"`
+}
+
+// An uploaded file stored in object storage.
+type File struct {
+	ID          string `json:"id"`
+	Filename    string `json:"filename"`
+	ContentType string `json:"contentType"`
+	SizeBytes   int    `json:"sizeBytes"`
+	StorageKey  string `json:"storageKey"`
 	// SHA-256 hash of the file content for duplicate detection.
 	ContentHash *string `json:"contentHash,omitempty"`
 	// Presigned URL for downloading the file. Expires after a short time.
@@ -438,6 +1255,18 @@ type ImportDocumentResultsInput struct {
 	SelectedTestimonialIndices []int `json:"selectedTestimonialIndices,omitempty"`
 	// Discovered skills to import from reference letter with per-skill category. Null = import all.
 	SelectedDiscoveredSkills []*SelectedDiscoveredSkillInput `json:"selectedDiscoveredSkills,omitempty"`
+	// Indices of reference letter experience mentions to import. Null = import all.
+	// Mentions of a company not yet on the profile become LETTER_DISCOVERED experiences;
+	// mentions of a known company validate the existing experience instead.
+	SelectedExperienceMentionIndices []int `json:"selectedExperienceMentionIndices,omitempty"`
+	// Credential documents to import. Transcripts verify education, performance reviews add testimonials.
+	CredentialDocumentIds []string `json:"credentialDocumentIds,omitempty"`
+	// How the resume is imported. REPLACE recreates the entries extracted from this resume; MERGE
+	// applies the accepted changes of previewResumeMerge and ignores the resume selections above.
+	ResumeImportMode *ResumeImportMode `json:"resumeImportMode,omitempty"`
+	// IDs of the previewResumeMerge changes to apply in MERGE mode; the others are rejected.
+	// Null = apply only the additions; updates and removals must be accepted by ID.
+	AcceptedMergeChangeIds []string `json:"acceptedMergeChangeIds,omitempty"`
 }
 
 // Result of importing document results into profile tables.
@@ -452,16 +1281,162 @@ func (ImportDocumentResultsResult) IsImportDocumentResultsResponse() {}
 
 // Counts of items imported into the profile.
 type ImportedCount struct {
-	// Number of work experiences materialized.
+	// Number of work experiences materialized, including ones discovered in reference letters.
 	Experiences int `json:"experiences"`
 	// Number of education entries materialized.
 	Educations int `json:"educations"`
 	// Number of skills materialized.
 	Skills int `json:"skills"`
-	// Number of testimonials materialized from reference letters.
+	// Number of links materialized.
+	Links int `json:"links"`
+	// Number of certifications materialized.
+	Certifications int `json:"certifications"`
+	// Number of spoken languages materialized.
+	Languages int `json:"languages"`
+	// Number of projects materialized.
+	Projects int `json:"projects"`
+	// Number of publications materialized.
+	Publications int `json:"publications"`
+	// Number of awards materialized.
+	Awards int `json:"awards"`
+	// Number of testimonials materialized from reference letters and performance reviews.
 	Testimonials int `json:"testimonials"`
+	// Number of education entries verified by transcripts.
+	VerifiedEducations int `json:"verifiedEducations"`
+	// Number of profile entries removed by a resume merge.
+	Removed int `json:"removed"`
+}
+
+// Result of a successful resolve or dismiss.
+type InconsistencyResult struct {
+	// The updated inconsistency.
+	Inconsistency *ProfileInconsistency `json:"inconsistency"`
+}
+
+func (InconsistencyResult) IsInconsistencyResponse() {}
+
+// Error returned when an inconsistency cannot be resolved or dismissed.
+type InconsistencyValidationError struct {
+	// Error message describing the validation failure.
+	Message string `json:"message"`
+	// The field that failed validation.
+	Field *string `json:"field,omitempty"`
+}
+
+func (InconsistencyValidationError) IsInconsistencyResponse() {}
+
+// A job description saved by a user, with the requirements extracted from it.
+type JobDescription struct {
+	ID string `json:"id"`
+	// Job title, if the description states one.
+	Title *string `json:"title,omitempty"`
+	// Hiring company, if the description states one.
+	Company *string `json:"company,omitempty"`
+	// The job description as pasted.
+	Text string `json:"text"`
+	// Seniority level the job asks for.
+	Seniority *JobSeniority `json:"seniority,omitempty"`
+	// Minimum years of experience the job asks for.
+	MinYearsExperience *int `json:"minYearsExperience,omitempty"`
+	// Industry or domain of the job, e.g. "Fintech".
+	Domain *string `json:"domain,omitempty"`
+	// Skills the job requires.
+	RequiredSkills []string `json:"requiredSkills"`
+	// Skills the job prefers but does not require.
+	PreferredSkills []string  `json:"preferredSkills"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+// How well a profile fits a job description.
+type JobMatch struct {
+	// The job description matched against.
+	JobDescription *JobDescription `json:"jobDescription"`
+	// Fit score from 0 to 1: the weighted share of the requirements met.
+	Score float64 `json:"score"`
+	// Years of experience on the profile, overlapping roles counted once.
+	YearsOfExperience float64 `json:"yearsOfExperience"`
+	// Requirements the profile meets.
+	Matched []*JobRequirementMatch `json:"matched"`
+	// Requirements the profile meets partly or not at all.
+	Gaps []*JobRequirementMatch `json:"gaps"`
+}
+
+// Result of matching a profile against a job description.
+type JobMatchResult struct {
+	// The match.
+	Match *JobMatch `json:"match"`
+}
+
+func (JobMatchResult) IsMatchJobDescriptionResponse() {}
+
+// Error returned when a job description cannot be matched.
+type JobMatchValidationError struct {
+	// Error message.
+	Message string `json:"message"`
+	// Field that caused the error.
+	Field *string `json:"field,omitempty"`
+}
+
+func (JobMatchValidationError) IsMatchJobDescriptionResponse() {}
+
+// One requirement of a job description and the evidence on the profile that meets it.
+type JobRequirementMatch struct {
+	// The kind of requirement.
+	Kind JobRequirementKind `json:"kind"`
+	// The requirement as stated, e.g. "Kubernetes" or "Senior level, 5+ years of experience".
+	Requirement string `json:"requirement"`
+	// How well the profile meets the requirement.
+	Status JobRequirementStatus `json:"status"`
+	// Share of the requirement met, from 0 to 1. Skills backed by a reference letter earn full credit.
+	Credit float64 `json:"credit"`
+	// What the status is based on, e.g. "Validated by 2 reference letters, used in 3 roles".
+	Detail string `json:"detail"`
+	// The matching profile skill, for skill and domain requirements.
+	Skill *ProfileSkill `json:"skill,omitempty"`
+	// Number of reference letter validations of the matching skill.
+	ValidationCount int `json:"validationCount"`
+	// Experiences mentioning the skill or domain; for seniority, the dated roles counted.
+	Experiences []*ProfileExperience `json:"experiences"`
+	// Testimonials whose quotes back the skill.
+	Testimonials []*Testimonial `json:"testimonials"`
+}
+
+// Result of a successful language operation.
+type LanguageResult struct {
+	// The created or updated language.
+	Language *ProfileLanguage `json:"language"`
+}
+
+func (LanguageResult) IsLanguageResponse() {}
+
+// Error returned when language validation fails.
+type LanguageValidationError struct {
+	// Error message describing the validation failure.
+	Message string `json:"message"`
+	// The field that failed validation.
+	Field *string `json:"field,omitempty"`
+}
+
+func (LanguageValidationError) IsLanguageResponse() {}
+
+// Result of a successful link operation.
+type LinkResult struct {
+	// The created or updated link.
+	Link *ProfileLink `json:"link"`
+}
+
+func (LinkResult) IsLinkResponse() {}
+
+// Error returned when link validation fails.
+type LinkValidationError struct {
+	// Error message describing the validation failure.
+	Message string `json:"message"`
+	// The field that failed validation.
+	Field *string `json:"field,omitempty"`
 }
 
+func (LinkValidationError) IsLinkResponse() {}
+
 type Mutation struct {
 }
 
@@ -475,6 +1450,16 @@ type NewSkillInput struct {
 	QuoteContext *string `json:"quoteContext,omitempty"`
 }
 
+// A free-text date parsed to the precision its text gives.
+type PartialDate struct {
+	// Year, absent for a present date.
+	Year *int `json:"year,omitempty"`
+	// Month (1-12); the first month of a season, absent for a bare year or present date.
+	Month *int `json:"month,omitempty"`
+	// How much of the date was given.
+	Precision DatePrecision `json:"precision"`
+}
+
 // Error returned when process document validation fails.
 type ProcessDocumentError struct {
 	// Error message describing the validation failure.
@@ -494,6 +1479,12 @@ type ProcessDocumentInput struct {
 	ExtractCareerInfo bool `json:"extractCareerInfo"`
 	// Whether to extract testimonial/reference letter content from the document.
 	ExtractTestimonial bool `json:"extractTestimonial"`
+	// Whether to extract certificate/license data from the document.
+	ExtractCertificate *bool `json:"extractCertificate,omitempty"`
+	// Whether to extract academic transcript/diploma data from the document.
+	ExtractTranscript *bool `json:"extractTranscript,omitempty"`
+	// Whether to extract performance review statements from the document.
+	ExtractPerformanceReview *bool `json:"extractPerformanceReview,omitempty"`
 }
 
 // IDs of the entities created for tracking extraction progress.
@@ -502,6 +1493,8 @@ type ProcessDocumentResult struct {
 	ResumeID *string `json:"resumeId,omitempty"`
 	// Reference letter ID created for testimonial extraction (null if not requested).
 	ReferenceLetterID *string `json:"referenceLetterID,omitempty"`
+	// Credential document IDs created for certificate, transcript or performance review extraction.
+	CredentialDocumentIds []string `json:"credentialDocumentIds"`
 }
 
 func (ProcessDocumentResult) IsProcessDocumentResponse() {}
@@ -513,6 +1506,8 @@ type Profile struct {
 	User *User `json:"user"`
 	// User-edited name (overrides resume extraction if set).
 	Name *string `json:"name,omitempty"`
+	// One-line headline shown under the name.
+	Headline *string `json:"headline,omitempty"`
 	// User-edited email (overrides resume extraction if set).
 	Email *string `json:"email,omitempty"`
 	// User-edited phone (overrides resume extraction if set).
@@ -528,11 +1523,124 @@ type Profile struct {
 	// Education entries.
 	Educations []*ProfileEducation `json:"educations"`
 	// Skill entries.
-	Skills    []*ProfileSkill `json:"skills"`
+	Skills []*ProfileSkill `json:"skills"`
+	// Links such as GitHub, LinkedIn or a portfolio.
+	Links []*ProfileLink `json:"links"`
+	// Certifications and licenses.
+	Certifications []*ProfileCertification `json:"certifications"`
+	// Spoken languages.
+	Languages []*ProfileLanguage `json:"languages"`
+	// Projects.
+	Projects []*ProfileProject `json:"projects"`
+	// Publications.
+	Publications []*ProfilePublication `json:"publications"`
+	// Awards and honors.
+	Awards    []*ProfileAward `json:"awards"`
 	CreatedAt time.Time       `json:"createdAt"`
 	UpdatedAt time.Time       `json:"updatedAt"`
 }
 
+// An answer to a question about a profile, drawn only from its evidence.
+type ProfileAnswer struct {
+	// The question as asked.
+	Question string `json:"question"`
+	// The answer. Says so when the profile has no evidence that answers the question.
+	Answer string `json:"answer"`
+	// Whether the answer is backed by at least one verified citation.
+	Supported bool `json:"supported"`
+	// The evidence the answer cites. Empty when unsupported.
+	Citations []*EvidenceCitation `json:"citations"`
+	// The model that wrote the answer, empty when no model was asked.
+	ModelVersion string `json:"modelVersion"`
+}
+
+// An award or honor in a user's profile.
+type ProfileAward struct {
+	ID string `json:"id"`
+	// Award title.
+	Title string `json:"title"`
+	// Awarding organization.
+	Issuer *string `json:"issuer,omitempty"`
+	// Date received.
+	Date *string `json:"date,omitempty"`
+	// Short description.
+	Description *string `json:"description,omitempty"`
+	// Display order for sorting.
+	DisplayOrder int `json:"displayOrder"`
+	// Source of this award.
+	Source    ExperienceSource `json:"source"`
+	CreatedAt time.Time        `json:"createdAt"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+// A certification or license in a user's profile.
+type ProfileCertification struct {
+	ID string `json:"id"`
+	// Certification name.
+	Name string `json:"name"`
+	// Issuing organization.
+	Issuer *string `json:"issuer,omitempty"`
+	// Credential or license number.
+	CredentialID *string `json:"credentialId,omitempty"`
+	// URL where the credential can be verified.
+	VerificationURL *string `json:"verificationUrl,omitempty"`
+	// Issue date (e.g., 'Mar 2022').
+	IssueDate *string `json:"issueDate,omitempty"`
+	// Expiry date (e.g., 'Mar 2025').
+	ExpiryDate *string `json:"expiryDate,omitempty"`
+	// Expiry date as parsed from expiryDate; null if it could not be read.
+	ParsedExpiryDate *PartialDate `json:"parsedExpiryDate,omitempty"`
+	// Whether the last month the certification is valid in is over.
+	Expired bool `json:"expired"`
+	// When the periodic expiry check found the certification expiring; null unless it expires within the configured window.
+	ExpiringFlaggedAt *time.Time `json:"expiringFlaggedAt,omitempty"`
+	// Display order for sorting.
+	DisplayOrder int `json:"displayOrder"`
+	// Source of this certification.
+	Source ExperienceSource `json:"source"`
+	// The uploaded certificate this certification was imported from, if any.
+	SourceFile *File     `json:"sourceFile,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// An entry in a profile's append-only change log. An update records one field; a create or
+// delete records the whole item as a JSON object of its fields.
+type ProfileChange struct {
+	// Unique identifier for the change.
+	ID string `json:"id"`
+	// The kind of item changed.
+	EntityType ProfileChangeEntity `json:"entityType"`
+	// ID of the changed item; the profile's own ID for header changes.
+	EntityID string `json:"entityId"`
+	// What the change did.
+	Action ProfileChangeAction `json:"action"`
+	// The updated field, e.g. "summary" or "title". Null for creates and deletes.
+	Field *string `json:"field,omitempty"`
+	// The value before the change, as JSON. Null for creates.
+	OldValue *string `json:"oldValue,omitempty"`
+	// The value after the change, as JSON. Null for deletes.
+	NewValue *string `json:"newValue,omitempty"`
+	// The user who made the change, or who imported the document that made it.
+	ActorID *string `json:"actorId,omitempty"`
+	// Whether the user or an import made the change.
+	Source ProfileChangeSource `json:"source"`
+	// The change this one undid, if it was made by undoing another change.
+	RevertsChangeID *string `json:"revertsChangeId,omitempty"`
+	// When the change was made.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Credibility of a profile as a whole and of each of its skills and experiences.
+type ProfileCredibility struct {
+	// Score of the profile from all of its testimonials, including coverage.
+	Profile *CredibilityScore `json:"profile"`
+	// Scores of the profile's skills.
+	Skills []*SkillCredibility `json:"skills"`
+	// Scores of the profile's experiences.
+	Experiences []*ExperienceCredibility `json:"experiences"`
+}
+
 // An education entry in a user's profile.
 type ProfileEducation struct {
 	// Unique identifier for the education entry.
@@ -547,8 +1655,14 @@ type ProfileEducation struct {
 	StartDate *string `json:"startDate,omitempty"`
 	// End date (e.g., 'Dec 2023', 'Present').
 	EndDate *string `json:"endDate,omitempty"`
+	// Start date as parsed from startDate; null if it could not be read.
+	ParsedStartDate *PartialDate `json:"parsedStartDate,omitempty"`
+	// End date as parsed from endDate; null if it could not be read.
+	ParsedEndDate *PartialDate `json:"parsedEndDate,omitempty"`
 	// Whether currently studying here.
 	IsCurrent bool `json:"isCurrent"`
+	// Length in months, counting both ends and running to now if ongoing; null if the dates are unknown.
+	DurationMonths *int `json:"durationMonths,omitempty"`
 	// Description or achievements.
 	Description *string `json:"description,omitempty"`
 	// GPA if applicable.
@@ -556,9 +1670,15 @@ type ProfileEducation struct {
 	// Display order for sorting.
 	DisplayOrder int `json:"displayOrder"`
 	// Source of this education entry.
-	Source    ExperienceSource `json:"source"`
-	CreatedAt time.Time        `json:"createdAt"`
-	UpdatedAt time.Time        `json:"updatedAt"`
+	Source ExperienceSource `json:"source"`
+	// Transcript or diploma that verifies this education entry, if any.
+	VerificationDocument *CredentialDocument `json:"verificationDocument,omitempty"`
+	// Number of reference letters validating this education entry.
+	ValidationCount int `json:"validationCount"`
+	// Where the current value of each field came from.
+	Provenance []*FieldProvenance `json:"provenance"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	UpdatedAt  time.Time          `json:"updatedAt"`
 }
 
 // A work experience entry in a user's profile.
@@ -575,20 +1695,46 @@ type ProfileExperience struct {
 	StartDate *string `json:"startDate,omitempty"`
 	// End date (e.g., 'Dec 2023', 'Present').
 	EndDate *string `json:"endDate,omitempty"`
+	// Start date as parsed from startDate; null if it could not be read.
+	ParsedStartDate *PartialDate `json:"parsedStartDate,omitempty"`
+	// End date as parsed from endDate; null if it could not be read.
+	ParsedEndDate *PartialDate `json:"parsedEndDate,omitempty"`
 	// Whether this is the current job.
 	IsCurrent bool `json:"isCurrent"`
+	// Length in months, counting both ends and running to now if ongoing; null if the dates are unknown.
+	DurationMonths *int `json:"durationMonths,omitempty"`
 	// Job description or responsibilities.
 	Description *string `json:"description,omitempty"`
 	// Key achievements or highlights (bullet points).
 	Highlights []string `json:"highlights"`
+	// The highlights, each flagged when it states a measurable result.
+	HighlightDetails []*ExperienceHighlight `json:"highlightDetails"`
+	// The candidate's explanation of the career gap after this role, if any.
+	GapNote *string `json:"gapNote,omitempty"`
 	// Display order for sorting.
 	DisplayOrder int `json:"displayOrder"`
 	// Source of this experience entry.
 	Source ExperienceSource `json:"source"`
 	// Number of reference letters validating this experience.
-	ValidationCount int       `json:"validationCount"`
-	CreatedAt       time.Time `json:"createdAt"`
-	UpdatedAt       time.Time `json:"updatedAt"`
+	ValidationCount int `json:"validationCount"`
+	// The reference letter this experience was discovered from (if source is LETTER_DISCOVERED).
+	SourceReferenceLetter *ReferenceLetter `json:"sourceReferenceLetter,omitempty"`
+	// The company entity this experience is linked to.
+	LinkedCompany *Company `json:"linkedCompany,omitempty"`
+	// Where the current value of each field came from.
+	Provenance []*FieldProvenance `json:"provenance"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	UpdatedAt  time.Time          `json:"updatedAt"`
+}
+
+// A profile variant rendered as a file.
+type ProfileExport struct {
+	// Suggested file name, e.g. "jane-doe-platform-engineering-roles.txt".
+	Filename string `json:"filename"`
+	// MIME type of the content.
+	ContentType string `json:"contentType"`
+	// The file content.
+	Content string `json:"content"`
 }
 
 // Result of a successful profile header update.
@@ -611,6 +1757,112 @@ func (ProfileHeaderValidationError) IsProfileHeaderResponse() {}
 
 func (ProfileHeaderValidationError) IsDeleteProfilePhotoResponse() {}
 
+// A discrepancy between what a reference letter says about a role and the profile's
+// experience entry, e.g. a letter calling the candidate "Senior Engineer 2018–2020"
+// where the resume says "Lead Engineer 2017–2021".
+type ProfileInconsistency struct {
+	// Unique identifier for the inconsistency.
+	ID string `json:"id"`
+	// The experience entry the letter contradicts.
+	Experience *ProfileExperience `json:"experience"`
+	// The reference letter making the contradicting statement.
+	ReferenceLetter *ReferenceLetter `json:"referenceLetter"`
+	// The contradicted field.
+	Field InconsistencyField `json:"field"`
+	// The field's value on the profile when the discrepancy was found.
+	ResumeValue string `json:"resumeValue"`
+	// The value the letter gives.
+	LetterValue string `json:"letterValue"`
+	// Quote from the letter making the statement.
+	Quote *string `json:"quote,omitempty"`
+	// Review status.
+	Status InconsistencyStatus `json:"status"`
+	// The user's note on how the discrepancy was settled.
+	ResolutionNote *string `json:"resolutionNote,omitempty"`
+	// When the inconsistency was resolved or dismissed.
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+	// When the inconsistency was found.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// A spoken language in a user's profile.
+type ProfileLanguage struct {
+	ID string `json:"id"`
+	// Language name.
+	Language string `json:"language"`
+	// Proficiency level, if known.
+	Proficiency *LanguageProficiency `json:"proficiency,omitempty"`
+	// Display order for sorting.
+	DisplayOrder int `json:"displayOrder"`
+	// Source of this language.
+	Source    ExperienceSource `json:"source"`
+	CreatedAt time.Time        `json:"createdAt"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+// A link in a user's profile, such as their GitHub or LinkedIn page.
+type ProfileLink struct {
+	ID string `json:"id"`
+	// What the link points to.
+	Kind LinkKind `json:"kind"`
+	// The URL.
+	URL string `json:"url"`
+	// Label to show instead of the URL.
+	Label *string `json:"label,omitempty"`
+	// Display order for sorting.
+	DisplayOrder int `json:"displayOrder"`
+	// Source of this link.
+	Source    ExperienceSource `json:"source"`
+	CreatedAt time.Time        `json:"createdAt"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+// A personal, open source or client project in a user's profile.
+type ProfileProject struct {
+	ID string `json:"id"`
+	// Project name.
+	Name string `json:"name"`
+	// The user's role in the project.
+	Role *string `json:"role,omitempty"`
+	// Project description.
+	Description *string `json:"description,omitempty"`
+	// Project URL.
+	URL *string `json:"url,omitempty"`
+	// Start date (e.g., 'Jan 2020', '2020').
+	StartDate *string `json:"startDate,omitempty"`
+	// End date (e.g., 'Dec 2023', 'Present').
+	EndDate *string `json:"endDate,omitempty"`
+	// Technologies used.
+	Technologies []string `json:"technologies"`
+	// Display order for sorting.
+	DisplayOrder int `json:"displayOrder"`
+	// Source of this project.
+	Source    ExperienceSource `json:"source"`
+	CreatedAt time.Time        `json:"createdAt"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+// A paper, article or book in a user's profile.
+type ProfilePublication struct {
+	ID string `json:"id"`
+	// Publication title.
+	Title string `json:"title"`
+	// Publisher, journal or venue.
+	Publisher *string `json:"publisher,omitempty"`
+	// Publication date.
+	Date *string `json:"date,omitempty"`
+	// Publication URL.
+	URL *string `json:"url,omitempty"`
+	// Short description.
+	Description *string `json:"description,omitempty"`
+	// Display order for sorting.
+	DisplayOrder int `json:"displayOrder"`
+	// Source of this publication.
+	Source    ExperienceSource `json:"source"`
+	CreatedAt time.Time        `json:"createdAt"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
 // A skill entry in a user's profile.
 type ProfileSkill struct {
 	// Unique identifier for the skill.
@@ -629,10 +1881,127 @@ type ProfileSkill struct {
 	ValidationCount int `json:"validationCount"`
 	// The reference letter this skill was discovered from (if source is reference letter).
 	SourceReferenceLetter *ReferenceLetter `json:"sourceReferenceLetter,omitempty"`
-	CreatedAt             time.Time        `json:"createdAt"`
-	UpdatedAt             time.Time        `json:"updatedAt"`
+	// The canonical skill this entry refers to, if the skill taxonomy knows it.
+	CanonicalSkill *CanonicalSkill `json:"canonicalSkill,omitempty"`
+	// Names of skills merged into this one.
+	Aliases []string `json:"aliases"`
+	// Years of work experience with this skill, counting overlapping roles once. Derived from the
+	// experiences whose title, description or highlights mention the skill, or whose colleagues'
+	// testimonials do.
+	YearsOfExperience float64 `json:"yearsOfExperience"`
+	// Month the skill was last used (YYYY-MM), from the latest experience that used it.
+	LastUsed *string `json:"lastUsed,omitempty"`
+	// How strongly reference letters back this skill, from 0 to 1. Grows with the number of
+	// validating letters, weighted by the author's relationship, and with the mix of relationships.
+	EvidenceStrength float64 `json:"evidenceStrength"`
+	// Where the current value of each field came from.
+	Provenance []*FieldProvenance `json:"provenance"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	UpdatedAt  time.Time          `json:"updatedAt"`
+}
+
+// A profile as it was at a point in time, reconstructed from its change log.
+type ProfileSnapshot struct {
+	// The point in time the snapshot shows.
+	At       time.Time `json:"at"`
+	Name     *string   `json:"name,omitempty"`
+	Headline *string   `json:"headline,omitempty"`
+	Email    *string   `json:"email,omitempty"`
+	Phone    *string   `json:"phone,omitempty"`
+	Location *string   `json:"location,omitempty"`
+	Summary  *string   `json:"summary,omitempty"`
+	// Work experience entries; entries deleted since are listed after the current ones.
+	Experiences []*ProfileExperience `json:"experiences"`
+	// Education entries; entries deleted since are listed after the current ones.
+	Educations []*ProfileEducation `json:"educations"`
+	// Skills; skills deleted since are listed after the current ones.
+	Skills []*ProfileSkill `json:"skills"`
 }
 
+// A tailored version of a profile, e.g. for one target role. The variant stores rules, not
+// copies: it hides, reorders and overrides entries of the base profile, so edits to the
+// profile show up in the variant.
+type ProfileVariant struct {
+	ID string `json:"id"`
+	// Name of the variant, e.g. "Platform engineering roles".
+	Name string `json:"name"`
+	// The role the variant is tailored to.
+	TargetRole *string `json:"targetRole,omitempty"`
+	// Summary shown instead of the profile's, if set.
+	Summary *string `json:"summary,omitempty"`
+	// Items left out of the variant: experiences, education entries, skills, links,
+	// certifications, languages, projects, publications or awards.
+	HiddenItemIds []string `json:"hiddenItemIds"`
+	// Items shown first within their section, in this order; the others follow in the profile's order.
+	ItemOrder []string `json:"itemOrder"`
+	// Testimonials shown, in this order. All testimonials are shown when empty.
+	TestimonialIds []string `json:"testimonialIds"`
+	// Overridden experience descriptions and highlights.
+	ExperienceOverrides []*ExperienceOverride `json:"experienceOverrides"`
+	// Token for viewing the variant with sharedProfileVariant; null when not shared.
+	ShareToken *string `json:"shareToken,omitempty"`
+	// The profile with the variant's rules applied.
+	Profile *Profile `json:"profile"`
+	// The testimonials the variant shows.
+	Testimonials []*Testimonial `json:"testimonials"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	UpdatedAt    time.Time      `json:"updatedAt"`
+}
+
+// Result of creating or updating a profile variant.
+type ProfileVariantResult struct {
+	// The variant.
+	Variant *ProfileVariant `json:"variant"`
+}
+
+func (ProfileVariantResult) IsProfileVariantResponse() {}
+
+// Error returned when a profile variant cannot be saved.
+type ProfileVariantValidationError struct {
+	// Error message.
+	Message string `json:"message"`
+	// Field that caused the error.
+	Field *string `json:"field,omitempty"`
+}
+
+func (ProfileVariantValidationError) IsProfileVariantResponse() {}
+
+// Result of a successful project operation.
+type ProjectResult struct {
+	// The created or updated project.
+	Project *ProfileProject `json:"project"`
+}
+
+func (ProjectResult) IsProjectResponse() {}
+
+// Error returned when project validation fails.
+type ProjectValidationError struct {
+	// Error message describing the validation failure.
+	Message string `json:"message"`
+	// The field that failed validation.
+	Field *string `json:"field,omitempty"`
+}
+
+func (ProjectValidationError) IsProjectResponse() {}
+
+// Result of a successful publication operation.
+type PublicationResult struct {
+	// The created or updated publication.
+	Publication *ProfilePublication `json:"publication"`
+}
+
+func (PublicationResult) IsPublicationResponse() {}
+
+// Error returned when publication validation fails.
+type PublicationValidationError struct {
+	// Error message describing the validation failure.
+	Message string `json:"message"`
+	// The field that failed validation.
+	Field *string `json:"field,omitempty"`
+}
+
+func (PublicationValidationError) IsPublicationResponse() {}
+
 type Query struct {
 }
 
@@ -654,6 +2023,36 @@ type ReferenceLetter struct {
 	File          *File                 `json:"file,omitempty"`
 }
 
+// New display order for a profile's items. Each list holds every item of its kind in the
+// new order; omit a list to leave that kind of item unchanged.
+type ReorderProfileItemsInput struct {
+	// Experience IDs in display order.
+	ExperienceIds []string `json:"experienceIds,omitempty"`
+	// Education IDs in display order.
+	EducationIds []string `json:"educationIds,omitempty"`
+	// Skill IDs in display order.
+	SkillIds []string `json:"skillIds,omitempty"`
+}
+
+// Result of a successful reorder, with every item in its new order.
+type ReorderProfileItemsResult struct {
+	Experiences []*ProfileExperience `json:"experiences"`
+	Educations  []*ProfileEducation  `json:"educations"`
+	Skills      []*ProfileSkill      `json:"skills"`
+}
+
+func (ReorderProfileItemsResult) IsReorderProfileItemsResponse() {}
+
+// Error returned when a reorder is rejected.
+type ReorderProfileItemsValidationError struct {
+	// Error message describing the validation failure.
+	Message string `json:"message"`
+	// The field that failed validation.
+	Field *string `json:"field,omitempty"`
+}
+
+func (ReorderProfileItemsValidationError) IsReorderProfileItemsResponse() {}
+
 // An uploaded resume with extracted profile data.
 type Resume struct {
 	ID string `json:"id"`
@@ -689,12 +2088,96 @@ type ResumeExtractedData struct {
 	Educations []*ExtractedEducation `json:"educations"`
 	// Skill names.
 	Skills []string `json:"skills"`
+	// Links such as GitHub, LinkedIn or a portfolio.
+	Links []*ExtractedLink `json:"links"`
+	// Certifications and licenses.
+	Certifications []*ExtractedCertification `json:"certifications"`
+	// Spoken languages.
+	Languages []*ExtractedLanguage `json:"languages"`
+	// Projects.
+	Projects []*ExtractedProject `json:"projects"`
+	// Publications.
+	Publications []*ExtractedPublication `json:"publications"`
+	// Awards and honors.
+	Awards []*ExtractedAward `json:"awards"`
 	// When the extraction was performed.
 	ExtractedAt time.Time `json:"extractedAt"`
 	// Overall confidence score (0.0 to 1.0).
 	Confidence float64 `json:"confidence"`
 }
 
+// A change proposed by merging a resume into the profile, applied or rejected as a whole.
+type ResumeMergeChange struct {
+	// Identifies the change in acceptedMergeChangeIds.
+	ID     string            `json:"id"`
+	Action MergeChangeAction `json:"action"`
+	// The kind of entry changed: EXPERIENCE, EDUCATION or SKILL.
+	EntityType ProfileChangeEntity `json:"entityType"`
+	// The profile entry updated or removed. Null for additions.
+	EntityID *string `json:"entityId,omitempty"`
+	// Index of the entry in the resume's experience, education or skills list. Null for removals.
+	ExtractedIndex *int `json:"extractedIndex,omitempty"`
+	// Short description of the entry, e.g. "Software Engineer at Acme".
+	Label string `json:"label"`
+	// The fields an update changes. Empty for additions and removals.
+	FieldChanges []*ResumeMergeFieldChange `json:"fieldChanges"`
+}
+
+// A field whose value on the resume differs from the profile's.
+type ResumeMergeFieldChange struct {
+	// Field name, as on the item's type.
+	Field string `json:"field"`
+	// The profile's current value, as JSON.
+	CurrentValue *string `json:"currentValue,omitempty"`
+	// The value on the resume, as JSON.
+	ExtractedValue string `json:"extractedValue"`
+	// Whether the user changed the current value after import, or entered the entry by hand.
+	ManuallyEdited bool `json:"manuallyEdited"`
+}
+
+// The changes merging a resume into the profile would make. Experiences are matched by company,
+// title and dates, education by institution, degree and dates, and skills by canonical skill.
+type ResumeMergePreview struct {
+	ResumeID string               `json:"resumeId"`
+	Changes  []*ResumeMergeChange `json:"changes"`
+	// Number of profile entries the resume lists unchanged.
+	UnchangedCount int `json:"unchangedCount"`
+}
+
+// Result of reverting an item to its extracted values. Exactly one of experience, education and
+// skill is set.
+type RevertToExtractedResult struct {
+	Experience *ProfileExperience `json:"experience,omitempty"`
+	Education  *ProfileEducation  `json:"education,omitempty"`
+	Skill      *ProfileSkill      `json:"skill,omitempty"`
+}
+
+func (RevertToExtractedResult) IsRevertToExtractedResponse() {}
+
+// Error returned when an item cannot be reverted to its extracted values.
+type RevertToExtractedValidationError struct {
+	// Error message.
+	Message string `json:"message"`
+	// Field that caused the error.
+	Field *string `json:"field,omitempty"`
+}
+
+func (RevertToExtractedValidationError) IsRevertToExtractedResponse() {}
+
+// A span during which two roles both ran.
+type RoleOverlap struct {
+	// The role that started first.
+	First *ProfileExperience `json:"first"`
+	// The role that started later.
+	Second *ProfileExperience `json:"second"`
+	// First month of the overlap (YYYY-MM).
+	StartMonth string `json:"startMonth"`
+	// Last month of the overlap (YYYY-MM).
+	EndMonth string `json:"endMonth"`
+	// Length of the overlap in months.
+	Months int `json:"months"`
+}
+
 // Input for a discovered skill selected for import, carrying both name and category.
 type SelectedDiscoveredSkillInput struct {
 	// The skill name.
@@ -703,6 +2186,12 @@ type SelectedDiscoveredSkillInput struct {
 	Category domain.SkillCategory `json:"category"`
 }
 
+// Credibility of a profile skill, from the reference letters that validate it.
+type SkillCredibility struct {
+	Skill       *ProfileSkill     `json:"skill"`
+	Credibility *CredibilityScore `json:"credibility"`
+}
+
 // Result of a successful skill operation.
 type SkillResult struct {
 	// The created or updated skill.
@@ -737,6 +2226,10 @@ type SkillValidationError struct {
 
 func (SkillValidationError) IsSkillResponse() {}
 
+func (SkillValidationError) IsBulkUpdateSkillsResponse() {}
+
+func (SkillValidationError) IsSplitSkillResponse() {}
+
 // Input for applying a skill validation from a reference letter.
 type SkillValidationInput struct {
 	// The profile skill ID to validate.
@@ -745,6 +2238,14 @@ type SkillValidationInput struct {
 	QuoteSnippet string `json:"quoteSnippet"`
 }
 
+// Result of splitting a skill.
+type SplitSkillResult struct {
+	// The new skills, in the order of their names.
+	Skills []*ProfileSkill `json:"skills"`
+}
+
+func (SplitSkillResult) IsSplitSkillResponse() {}
+
 // A testimonial quote from a reference letter displayed on the profile.
 type Testimonial struct {
 	// Unique identifier for the testimonial.
@@ -763,6 +2264,8 @@ type Testimonial struct {
 	Relationship TestimonialRelationship `json:"relationship"`
 	// The reference letter this testimonial was extracted from.
 	ReferenceLetter *ReferenceLetter `json:"referenceLetter,omitempty"`
+	// The performance review this testimonial was extracted from.
+	CredentialDocument *CredentialDocument `json:"credentialDocument,omitempty"`
 	// When the testimonial was created.
 	CreatedAt time.Time `json:"createdAt"`
 	// Skills validated by this testimonial's reference letter.
@@ -777,6 +2280,24 @@ type TestimonialInput struct {
 	SkillsMentioned []string `json:"skillsMentioned"`
 }
 
+// Result of a successful undo.
+type UndoChangeResult struct {
+	// The changes made by undoing; empty if the item already had the old value.
+	Changes []*ProfileChange `json:"changes"`
+}
+
+func (UndoChangeResult) IsUndoChangeResponse() {}
+
+// Error returned when a change cannot be undone.
+type UndoChangeValidationError struct {
+	// Error message describing the validation failure.
+	Message string `json:"message"`
+	// The field that failed validation.
+	Field *string `json:"field,omitempty"`
+}
+
+func (UndoChangeValidationError) IsUndoChangeResponse() {}
+
 // Input for updating an author's information.
 type UpdateAuthorInput struct {
 	// Updated name of the author.
@@ -791,6 +2312,66 @@ type UpdateAuthorInput struct {
 	ImageID *string `json:"imageId,omitempty"`
 }
 
+// Input for updating an existing award.
+type UpdateAwardInput struct {
+	// Award title.
+	Title *string `json:"title,omitempty"`
+	// Awarding organization.
+	Issuer *string `json:"issuer,omitempty"`
+	// Date received.
+	Date *string `json:"date,omitempty"`
+	// Short description.
+	Description *string `json:"description,omitempty"`
+}
+
+// Input for updating a profile's taxonomy skill.
+type UpdateCanonicalSkillInput struct {
+	// Updated display name.
+	Name *string `json:"name,omitempty"`
+	// Updated category.
+	Category *domain.SkillCategory `json:"category,omitempty"`
+	// ID of the broader skill this one belongs to (empty string to clear).
+	ParentID *string `json:"parentId,omitempty"`
+	// Replacement list of other names and abbreviations.
+	Synonyms []string `json:"synonyms,omitempty"`
+}
+
+// Input for updating an existing certification.
+type UpdateCertificationInput struct {
+	// Certification name.
+	Name *string `json:"name,omitempty"`
+	// Issuing organization.
+	Issuer *string `json:"issuer,omitempty"`
+	// Credential or license number.
+	CredentialID *string `json:"credentialId,omitempty"`
+	// URL where the credential can be verified.
+	VerificationURL *string `json:"verificationUrl,omitempty"`
+	// Issue date (e.g., 'Mar 2022').
+	IssueDate *string `json:"issueDate,omitempty"`
+	// Expiry date (e.g., 'Mar 2025').
+	ExpiryDate *string `json:"expiryDate,omitempty"`
+}
+
+// Input for updating a company alias.
+type UpdateCompanyAliasInput struct {
+	// The alternative company name.
+	Alias *string `json:"alias,omitempty"`
+	// The company the alias refers to.
+	CanonicalName *string `json:"canonicalName,omitempty"`
+}
+
+// Input for updating a company.
+type UpdateCompanyInput struct {
+	// Updated display name.
+	Name *string `json:"name,omitempty"`
+	// Replacement list of other names for the company, stored as company aliases.
+	Aliases []string `json:"aliases,omitempty"`
+	// Updated website URL (empty string to clear).
+	Website *string `json:"website,omitempty"`
+	// ID of an uploaded image file to use as the logo (empty string to clear).
+	LogoID *string `json:"logoId,omitempty"`
+}
+
 // Input for updating an existing education entry.
 type UpdateEducationInput struct {
 	// Institution name.
@@ -831,10 +2412,30 @@ type UpdateExperienceInput struct {
 	Highlights []string `json:"highlights,omitempty"`
 }
 
+// Input for updating an existing language.
+type UpdateLanguageInput struct {
+	// Language name.
+	Language *string `json:"language,omitempty"`
+	// Proficiency level.
+	Proficiency *LanguageProficiency `json:"proficiency,omitempty"`
+}
+
+// Input for updating an existing link.
+type UpdateLinkInput struct {
+	// The URL.
+	URL *string `json:"url,omitempty"`
+	// What the link points to.
+	Kind *LinkKind `json:"kind,omitempty"`
+	// Label to show instead of the URL.
+	Label *string `json:"label,omitempty"`
+}
+
 // Input for updating profile header fields.
 type UpdateProfileHeaderInput struct {
 	// Name to display.
 	Name *string `json:"name,omitempty"`
+	// One-line headline shown under the name.
+	Headline *string `json:"headline,omitempty"`
 	// Email address.
 	Email *string `json:"email,omitempty"`
 	// Phone number.
@@ -845,6 +2446,57 @@ type UpdateProfileHeaderInput struct {
 	Summary *string `json:"summary,omitempty"`
 }
 
+// Input for updating a profile variant. Only updates fields that are provided; lists replace
+// the variant's lists.
+type UpdateProfileVariantInput struct {
+	// Name of the variant.
+	Name *string `json:"name,omitempty"`
+	// The role the variant is tailored to.
+	TargetRole *string `json:"targetRole,omitempty"`
+	// Summary to show instead of the profile's. An empty string shows the profile's summary again.
+	Summary *string `json:"summary,omitempty"`
+	// Items to leave out.
+	HiddenItemIds []string `json:"hiddenItemIds,omitempty"`
+	// Items to show first within their section, in this order.
+	ItemOrder []string `json:"itemOrder,omitempty"`
+	// Testimonials to show, in this order; all when empty.
+	TestimonialIds []string `json:"testimonialIds,omitempty"`
+	// Experience descriptions and highlights to override.
+	ExperienceOverrides []*ExperienceOverrideInput `json:"experienceOverrides,omitempty"`
+}
+
+// Input for updating an existing project.
+type UpdateProjectInput struct {
+	// Project name.
+	Name *string `json:"name,omitempty"`
+	// The user's role in the project.
+	Role *string `json:"role,omitempty"`
+	// Project description.
+	Description *string `json:"description,omitempty"`
+	// Project URL.
+	URL *string `json:"url,omitempty"`
+	// Start date (e.g., 'Jan 2020', '2020').
+	StartDate *string `json:"startDate,omitempty"`
+	// End date (e.g., 'Dec 2023', 'Present').
+	EndDate *string `json:"endDate,omitempty"`
+	// Technologies used.
+	Technologies []string `json:"technologies,omitempty"`
+}
+
+// Input for updating an existing publication.
+type UpdatePublicationInput struct {
+	// Publication title.
+	Title *string `json:"title,omitempty"`
+	// Publisher, journal or venue.
+	Publisher *string `json:"publisher,omitempty"`
+	// Publication date.
+	Date *string `json:"date,omitempty"`
+	// Publication URL.
+	URL *string `json:"url,omitempty"`
+	// Short description.
+	Description *string `json:"description,omitempty"`
+}
+
 // Input for updating an existing skill.
 type UpdateSkillInput struct {
 	// Skill name.
@@ -898,64 +2550,1384 @@ type UploadResumeResult struct {
 	Resume *Resume `json:"resume"`
 }
 
-func (UploadResumeResult) IsUploadResumeResponse() {}
+func (UploadResumeResult) IsUploadResumeResponse() {}
+
+// A user account in the system.
+type User struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	Name      *string   `json:"name,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Error returned when profile text cannot be drafted or a suggestion cannot be settled.
+type WritingAssistantValidationError struct {
+	// Error message describing the validation failure.
+	Message string `json:"message"`
+	// Field that caused the error.
+	Field *string `json:"field,omitempty"`
+}
+
+func (WritingAssistantValidationError) IsWritingSuggestionsResponse() {}
+
+func (WritingAssistantValidationError) IsWritingSuggestionResponse() {}
+
+// A profile entity a draft draws on.
+type WritingCitation struct {
+	// The kind of entity.
+	Kind WritingSourceKind `json:"kind"`
+	// ID of the experience, skill or testimonial.
+	SourceID string `json:"sourceId"`
+}
+
+// One piece of drafted text and the profile entities it is grounded in.
+type WritingDraft struct {
+	// The drafted text.
+	Text string `json:"text"`
+	// The experiences, skills and testimonials the text draws on.
+	Citations []*WritingCitation `json:"citations"`
+}
+
+// Drafted profile text awaiting the user's decision. Nothing on the profile changes until it
+// is accepted. A summary or headline suggestion holds one draft; a highlights suggestion holds
+// the rewritten highlights of its experience, one draft each.
+type WritingSuggestion struct {
+	// Unique identifier for the suggestion.
+	ID string `json:"id"`
+	// The part of the profile the suggestion rewrites.
+	Kind WritingSuggestionKind `json:"kind"`
+	// The experience whose highlights are rewritten, for highlights suggestions.
+	Experience *ProfileExperience `json:"experience,omitempty"`
+	// The drafted text.
+	Drafts []*WritingDraft `json:"drafts"`
+	// Whether the suggestion was applied.
+	Status WritingSuggestionStatus `json:"status"`
+	// The model that drafted the text.
+	ModelVersion string `json:"modelVersion"`
+	// When the suggestion was accepted or dismissed.
+	DecidedAt *time.Time `json:"decidedAt,omitempty"`
+	// When the suggestion was drafted.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Result of accepting or dismissing a writing suggestion.
+type WritingSuggestionResult struct {
+	// The updated suggestion.
+	Suggestion *WritingSuggestion `json:"suggestion"`
+}
+
+func (WritingSuggestionResult) IsWritingSuggestionResponse() {}
+
+// Result of drafting profile text.
+type WritingSuggestionsResult struct {
+	// The new suggestions, one per alternative.
+	Suggestions []*WritingSuggestion `json:"suggestions"`
+}
+
+func (WritingSuggestionsResult) IsWritingSuggestionsResponse() {}
+
+// File format a cover letter is exported to.
+type CoverLetterExportFormat string
+
+const (
+	// Plain text.
+	CoverLetterExportFormatText CoverLetterExportFormat = "TEXT"
+	// A standalone HTML document.
+	CoverLetterExportFormatHTML CoverLetterExportFormat = "HTML"
+)
+
+var AllCoverLetterExportFormat = []CoverLetterExportFormat{
+	CoverLetterExportFormatText,
+	CoverLetterExportFormatHTML,
+}
+
+func (e CoverLetterExportFormat) IsValid() bool {
+	switch e {
+	case CoverLetterExportFormatText, CoverLetterExportFormatHTML:
+		return true
+	}
+	return false
+}
+
+func (e CoverLetterExportFormat) String() string {
+	return string(e)
+}
+
+func (e *CoverLetterExportFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CoverLetterExportFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CoverLetterExportFormat", str)
+	}
+	return nil
+}
+
+func (e CoverLetterExportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CoverLetterExportFormat) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CoverLetterExportFormat) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// How a cover letter version came about.
+type CoverLetterVersionSource string
+
+const (
+	// Drafted from the profile and the job description.
+	CoverLetterVersionSourceGenerated CoverLetterVersionSource = "GENERATED"
+	// Written by the user as an edit of the previous version.
+	CoverLetterVersionSourceEdited CoverLetterVersionSource = "EDITED"
+)
+
+var AllCoverLetterVersionSource = []CoverLetterVersionSource{
+	CoverLetterVersionSourceGenerated,
+	CoverLetterVersionSourceEdited,
+}
+
+func (e CoverLetterVersionSource) IsValid() bool {
+	switch e {
+	case CoverLetterVersionSourceGenerated, CoverLetterVersionSourceEdited:
+		return true
+	}
+	return false
+}
+
+func (e CoverLetterVersionSource) String() string {
+	return string(e)
+}
+
+func (e *CoverLetterVersionSource) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CoverLetterVersionSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CoverLetterVersionSource", str)
+	}
+	return nil
+}
+
+func (e CoverLetterVersionSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CoverLetterVersionSource) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CoverLetterVersionSource) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Processing status of a credential document.
+type CredentialDocumentStatus string
+
+const (
+	CredentialDocumentStatusPending    CredentialDocumentStatus = "PENDING"
+	CredentialDocumentStatusProcessing CredentialDocumentStatus = "PROCESSING"
+	CredentialDocumentStatusCompleted  CredentialDocumentStatus = "COMPLETED"
+	CredentialDocumentStatusFailed     CredentialDocumentStatus = "FAILED"
+)
+
+var AllCredentialDocumentStatus = []CredentialDocumentStatus{
+	CredentialDocumentStatusPending,
+	CredentialDocumentStatusProcessing,
+	CredentialDocumentStatusCompleted,
+	CredentialDocumentStatusFailed,
+}
+
+func (e CredentialDocumentStatus) IsValid() bool {
+	switch e {
+	case CredentialDocumentStatusPending, CredentialDocumentStatusProcessing, CredentialDocumentStatusCompleted, CredentialDocumentStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e CredentialDocumentStatus) String() string {
+	return string(e)
+}
+
+func (e *CredentialDocumentStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CredentialDocumentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CredentialDocumentStatus", str)
+	}
+	return nil
+}
+
+func (e CredentialDocumentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CredentialDocumentStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CredentialDocumentStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Type of a credential document, selecting its dedicated extractor.
+type CredentialDocumentType string
+
+const (
+	CredentialDocumentTypeCertificate       CredentialDocumentType = "CERTIFICATE"
+	CredentialDocumentTypeTranscript        CredentialDocumentType = "TRANSCRIPT"
+	CredentialDocumentTypePerformanceReview CredentialDocumentType = "PERFORMANCE_REVIEW"
+)
+
+var AllCredentialDocumentType = []CredentialDocumentType{
+	CredentialDocumentTypeCertificate,
+	CredentialDocumentTypeTranscript,
+	CredentialDocumentTypePerformanceReview,
+}
+
+func (e CredentialDocumentType) IsValid() bool {
+	switch e {
+	case CredentialDocumentTypeCertificate, CredentialDocumentTypeTranscript, CredentialDocumentTypePerformanceReview:
+		return true
+	}
+	return false
+}
+
+func (e CredentialDocumentType) String() string {
+	return string(e)
+}
+
+func (e *CredentialDocumentType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CredentialDocumentType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CredentialDocumentType", str)
+	}
+	return nil
+}
+
+func (e CredentialDocumentType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CredentialDocumentType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CredentialDocumentType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// A factor of a credibility score. Each factor has a value from 0 to 1.
+type CredibilityFactorName string
+
+const (
+	// Mean weight of the authors' relationships to the candidate (manager > mentor > peer > other).
+	CredibilityFactorNameRelationship CredibilityFactorName = "RELATIONSHIP"
+	// How many distinct authors and companies the evidence comes from.
+	CredibilityFactorNameAuthorDiversity CredibilityFactorName = "AUTHOR_DIVERSITY"
+	// How recently the letters were written; a letter's weight halves every three years.
+	CredibilityFactorNameRecency CredibilityFactorName = "RECENCY"
+	// How many quotes can be found word for word in the letter text.
+	CredibilityFactorNameGrounding CredibilityFactorName = "GROUNDING"
+	// Share of skills and experiences with at least one validation (profile score only).
+	CredibilityFactorNameCoverage CredibilityFactorName = "COVERAGE"
+)
+
+var AllCredibilityFactorName = []CredibilityFactorName{
+	CredibilityFactorNameRelationship,
+	CredibilityFactorNameAuthorDiversity,
+	CredibilityFactorNameRecency,
+	CredibilityFactorNameGrounding,
+	CredibilityFactorNameCoverage,
+}
+
+func (e CredibilityFactorName) IsValid() bool {
+	switch e {
+	case CredibilityFactorNameRelationship, CredibilityFactorNameAuthorDiversity, CredibilityFactorNameRecency, CredibilityFactorNameGrounding, CredibilityFactorNameCoverage:
+		return true
+	}
+	return false
+}
+
+func (e CredibilityFactorName) String() string {
+	return string(e)
+}
+
+func (e *CredibilityFactorName) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CredibilityFactorName(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CredibilityFactorName", str)
+	}
+	return nil
+}
+
+func (e CredibilityFactorName) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CredibilityFactorName) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CredibilityFactorName) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// How much of a date its original text specified.
+type DatePrecision string
+
+const (
+	// A bare year, e.g. '2019'.
+	DatePrecisionYear DatePrecision = "YEAR"
+	// A season, semester or quarter, e.g. 'Spring 2019'.
+	DatePrecisionSeason DatePrecision = "SEASON"
+	// A month, e.g. '03/2019' or 'März 2019'.
+	DatePrecisionMonth DatePrecision = "MONTH"
+	// Now, e.g. 'Present' or 'heute'.
+	DatePrecisionPresent DatePrecision = "PRESENT"
+)
+
+var AllDatePrecision = []DatePrecision{
+	DatePrecisionYear,
+	DatePrecisionSeason,
+	DatePrecisionMonth,
+	DatePrecisionPresent,
+}
+
+func (e DatePrecision) IsValid() bool {
+	switch e {
+	case DatePrecisionYear, DatePrecisionSeason, DatePrecisionMonth, DatePrecisionPresent:
+		return true
+	}
+	return false
+}
+
+func (e DatePrecision) String() string {
+	return string(e)
+}
+
+func (e *DatePrecision) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DatePrecision(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DatePrecision", str)
+	}
+	return nil
+}
+
+func (e DatePrecision) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DatePrecision) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DatePrecision) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Status of asynchronous document detection.
+type DetectionStatus string
+
+const (
+	DetectionStatusPending    DetectionStatus = "PENDING"
+	DetectionStatusProcessing DetectionStatus = "PROCESSING"
+	DetectionStatusCompleted  DetectionStatus = "COMPLETED"
+	DetectionStatusFailed     DetectionStatus = "FAILED"
+)
+
+var AllDetectionStatus = []DetectionStatus{
+	DetectionStatusPending,
+	DetectionStatusProcessing,
+	DetectionStatusCompleted,
+	DetectionStatusFailed,
+}
+
+func (e DetectionStatus) IsValid() bool {
+	switch e {
+	case DetectionStatusPending, DetectionStatusProcessing, DetectionStatusCompleted, DetectionStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e DetectionStatus) String() string {
+	return string(e)
+}
+
+func (e *DetectionStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DetectionStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DetectionStatus", str)
+	}
+	return nil
+}
+
+func (e DetectionStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DetectionStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DetectionStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// The type of feedback being reported about document processing.
+type DocumentFeedbackType string
+
+const (
+	// Feedback about incorrect document type detection.
+	DocumentFeedbackTypeDetectionCorrection DocumentFeedbackType = "DETECTION_CORRECTION"
+	// Feedback about extraction quality issues.
+	DocumentFeedbackTypeExtractionQuality DocumentFeedbackType = "EXTRACTION_QUALITY"
+)
+
+var AllDocumentFeedbackType = []DocumentFeedbackType{
+	DocumentFeedbackTypeDetectionCorrection,
+	DocumentFeedbackTypeExtractionQuality,
+}
+
+func (e DocumentFeedbackType) IsValid() bool {
+	switch e {
+	case DocumentFeedbackTypeDetectionCorrection, DocumentFeedbackTypeExtractionQuality:
+		return true
+	}
+	return false
+}
+
+func (e DocumentFeedbackType) String() string {
+	return string(e)
+}
+
+func (e *DocumentFeedbackType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DocumentFeedbackType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DocumentFeedbackType", str)
+	}
+	return nil
+}
+
+func (e DocumentFeedbackType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DocumentFeedbackType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DocumentFeedbackType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Hint about the document type from lightweight detection.
+type DocumentTypeHint string
+
+const (
+	DocumentTypeHintResume          DocumentTypeHint = "RESUME"
+	DocumentTypeHintReferenceLetter DocumentTypeHint = "REFERENCE_LETTER"
+	DocumentTypeHintHybrid          DocumentTypeHint = "HYBRID"
+	// A professional certificate, certification or license.
+	DocumentTypeHintCertificate DocumentTypeHint = "CERTIFICATE"
+	// An academic transcript, diploma or degree certificate.
+	DocumentTypeHintTranscript DocumentTypeHint = "TRANSCRIPT"
+	// A performance review written by an employer.
+	DocumentTypeHintPerformanceReview DocumentTypeHint = "PERFORMANCE_REVIEW"
+	DocumentTypeHintUnknown           DocumentTypeHint = "UNKNOWN"
+)
+
+var AllDocumentTypeHint = []DocumentTypeHint{
+	DocumentTypeHintResume,
+	DocumentTypeHintReferenceLetter,
+	DocumentTypeHintHybrid,
+	DocumentTypeHintCertificate,
+	DocumentTypeHintTranscript,
+	DocumentTypeHintPerformanceReview,
+	DocumentTypeHintUnknown,
+}
+
+func (e DocumentTypeHint) IsValid() bool {
+	switch e {
+	case DocumentTypeHintResume, DocumentTypeHintReferenceLetter, DocumentTypeHintHybrid, DocumentTypeHintCertificate, DocumentTypeHintTranscript, DocumentTypeHintPerformanceReview, DocumentTypeHintUnknown:
+		return true
+	}
+	return false
+}
+
+func (e DocumentTypeHint) String() string {
+	return string(e)
+}
+
+func (e *DocumentTypeHint) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DocumentTypeHint(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DocumentTypeHint", str)
+	}
+	return nil
+}
+
+func (e DocumentTypeHint) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DocumentTypeHint) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DocumentTypeHint) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// How the entries of a duplicate suggestion agree.
+type DuplicateReason string
+
+const (
+	// The experiences are at the same company, allowing for aliases and legal suffixes.
+	DuplicateReasonSameCompany DuplicateReason = "SAME_COMPANY"
+	// The company names differ only by typos, e.g. "Stripe" and "Strive". They may be different
+	// companies, so their experiences are not linked to one company unless merged.
+	DuplicateReasonSimilarCompany DuplicateReason = "SIMILAR_COMPANY"
+	// The education entries are at the same institution.
+	DuplicateReasonSameInstitution DuplicateReason = "SAME_INSTITUTION"
+	// The job titles agree, e.g. "Sr. Engineer" and "Senior Engineer".
+	DuplicateReasonSimilarTitle DuplicateReason = "SIMILAR_TITLE"
+	// The degrees agree.
+	DuplicateReasonSimilarDegree DuplicateReason = "SIMILAR_DEGREE"
+	// The start dates are known and can be the same month.
+	DuplicateReasonSameStartDate DuplicateReason = "SAME_START_DATE"
+	// The date ranges overlap.
+	DuplicateReasonOverlappingDates DuplicateReason = "OVERLAPPING_DATES"
+)
+
+var AllDuplicateReason = []DuplicateReason{
+	DuplicateReasonSameCompany,
+	DuplicateReasonSimilarCompany,
+	DuplicateReasonSameInstitution,
+	DuplicateReasonSimilarTitle,
+	DuplicateReasonSimilarDegree,
+	DuplicateReasonSameStartDate,
+	DuplicateReasonOverlappingDates,
+}
+
+func (e DuplicateReason) IsValid() bool {
+	switch e {
+	case DuplicateReasonSameCompany, DuplicateReasonSimilarCompany, DuplicateReasonSameInstitution, DuplicateReasonSimilarTitle, DuplicateReasonSimilarDegree, DuplicateReasonSameStartDate, DuplicateReasonOverlappingDates:
+		return true
+	}
+	return false
+}
+
+func (e DuplicateReason) String() string {
+	return string(e)
+}
+
+func (e *DuplicateReason) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DuplicateReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DuplicateReason", str)
+	}
+	return nil
+}
+
+func (e DuplicateReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DuplicateReason) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DuplicateReason) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// The kind of evidence an answer or search result about a profile comes from.
+type EvidenceKind string
+
+const (
+	// A testimonial quoted from a reference letter or performance review.
+	EvidenceKindTestimonial EvidenceKind = "TESTIMONIAL"
+	// A reference letter passage validating a skill.
+	EvidenceKindSkillValidation EvidenceKind = "SKILL_VALIDATION"
+	// A reference letter passage validating an experience.
+	EvidenceKindExperienceValidation EvidenceKind = "EXPERIENCE_VALIDATION"
+	// The description and highlights of a profile experience, one per line.
+	EvidenceKindExperience EvidenceKind = "EXPERIENCE"
+)
+
+var AllEvidenceKind = []EvidenceKind{
+	EvidenceKindTestimonial,
+	EvidenceKindSkillValidation,
+	EvidenceKindExperienceValidation,
+	EvidenceKindExperience,
+}
+
+func (e EvidenceKind) IsValid() bool {
+	switch e {
+	case EvidenceKindTestimonial, EvidenceKindSkillValidation, EvidenceKindExperienceValidation, EvidenceKindExperience:
+		return true
+	}
+	return false
+}
+
+func (e EvidenceKind) String() string {
+	return string(e)
+}
+
+func (e *EvidenceKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EvidenceKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EvidenceKind", str)
+	}
+	return nil
+}
+
+func (e EvidenceKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EvidenceKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EvidenceKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Source of a profile experience entry.
+type ExperienceSource string
+
+const (
+	// Manually entered by user.
+	ExperienceSourceManual ExperienceSource = "MANUAL"
+	// Extracted from an uploaded resume.
+	ExperienceSourceResumeExtracted ExperienceSource = "RESUME_EXTRACTED"
+	// Discovered in a reference letter.
+	ExperienceSourceLetterDiscovered ExperienceSource = "LETTER_DISCOVERED"
+	// Imported from an uploaded credential document such as a certificate.
+	ExperienceSourceCredentialDocument ExperienceSource = "CREDENTIAL_DOCUMENT"
+)
+
+var AllExperienceSource = []ExperienceSource{
+	ExperienceSourceManual,
+	ExperienceSourceResumeExtracted,
+	ExperienceSourceLetterDiscovered,
+	ExperienceSourceCredentialDocument,
+}
+
+func (e ExperienceSource) IsValid() bool {
+	switch e {
+	case ExperienceSourceManual, ExperienceSourceResumeExtracted, ExperienceSourceLetterDiscovered, ExperienceSourceCredentialDocument:
+		return true
+	}
+	return false
+}
+
+func (e ExperienceSource) String() string {
+	return string(e)
+}
+
+func (e *ExperienceSource) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ExperienceSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ExperienceSource", str)
+	}
+	return nil
+}
+
+func (e ExperienceSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ExperienceSource) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ExperienceSource) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// The experience field a reference letter contradicts.
+type InconsistencyField string
+
+const (
+	// The job title.
+	InconsistencyFieldTitle InconsistencyField = "TITLE"
+	// When the role started.
+	InconsistencyFieldStartDate InconsistencyField = "START_DATE"
+	// When the role ended.
+	InconsistencyFieldEndDate InconsistencyField = "END_DATE"
+)
+
+var AllInconsistencyField = []InconsistencyField{
+	InconsistencyFieldTitle,
+	InconsistencyFieldStartDate,
+	InconsistencyFieldEndDate,
+}
+
+func (e InconsistencyField) IsValid() bool {
+	switch e {
+	case InconsistencyFieldTitle, InconsistencyFieldStartDate, InconsistencyFieldEndDate:
+		return true
+	}
+	return false
+}
+
+func (e InconsistencyField) String() string {
+	return string(e)
+}
+
+func (e *InconsistencyField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = InconsistencyField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid InconsistencyField", str)
+	}
+	return nil
+}
+
+func (e InconsistencyField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *InconsistencyField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e InconsistencyField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Review status of an inconsistency.
+type InconsistencyStatus string
+
+const (
+	// Not yet reviewed.
+	InconsistencyStatusOpen InconsistencyStatus = "OPEN"
+	// Reviewed and settled, possibly by adopting the letter's value.
+	InconsistencyStatusResolved InconsistencyStatus = "RESOLVED"
+	// Reviewed and judged not to be a real contradiction.
+	InconsistencyStatusDismissed InconsistencyStatus = "DISMISSED"
+)
+
+var AllInconsistencyStatus = []InconsistencyStatus{
+	InconsistencyStatusOpen,
+	InconsistencyStatusResolved,
+	InconsistencyStatusDismissed,
+}
+
+func (e InconsistencyStatus) IsValid() bool {
+	switch e {
+	case InconsistencyStatusOpen, InconsistencyStatusResolved, InconsistencyStatusDismissed:
+		return true
+	}
+	return false
+}
+
+func (e InconsistencyStatus) String() string {
+	return string(e)
+}
+
+func (e *InconsistencyStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = InconsistencyStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid InconsistencyStatus", str)
+	}
+	return nil
+}
+
+func (e InconsistencyStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *InconsistencyStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e InconsistencyStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Kind of requirement a job description states.
+type JobRequirementKind string
+
+const (
+	// A skill the job requires.
+	JobRequirementKindRequiredSkill JobRequirementKind = "REQUIRED_SKILL"
+	// A skill the job prefers but does not require.
+	JobRequirementKindPreferredSkill JobRequirementKind = "PREFERRED_SKILL"
+	// The seniority level or years of experience the job asks for.
+	JobRequirementKindSeniority JobRequirementKind = "SENIORITY"
+	// The industry or domain of the job.
+	JobRequirementKindDomain JobRequirementKind = "DOMAIN"
+)
+
+var AllJobRequirementKind = []JobRequirementKind{
+	JobRequirementKindRequiredSkill,
+	JobRequirementKindPreferredSkill,
+	JobRequirementKindSeniority,
+	JobRequirementKindDomain,
+}
+
+func (e JobRequirementKind) IsValid() bool {
+	switch e {
+	case JobRequirementKindRequiredSkill, JobRequirementKindPreferredSkill, JobRequirementKindSeniority, JobRequirementKindDomain:
+		return true
+	}
+	return false
+}
+
+func (e JobRequirementKind) String() string {
+	return string(e)
+}
+
+func (e *JobRequirementKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = JobRequirementKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid JobRequirementKind", str)
+	}
+	return nil
+}
+
+func (e JobRequirementKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *JobRequirementKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e JobRequirementKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// How well a profile meets a job requirement.
+type JobRequirementStatus string
+
+const (
+	// The profile meets the requirement.
+	JobRequirementStatusMatched JobRequirementStatus = "MATCHED"
+	// The profile meets part of the requirement, e.g. fewer years of experience than asked for.
+	JobRequirementStatusPartial JobRequirementStatus = "PARTIAL"
+	// Nothing on the profile meets the requirement.
+	JobRequirementStatusMissing JobRequirementStatus = "MISSING"
+)
+
+var AllJobRequirementStatus = []JobRequirementStatus{
+	JobRequirementStatusMatched,
+	JobRequirementStatusPartial,
+	JobRequirementStatusMissing,
+}
+
+func (e JobRequirementStatus) IsValid() bool {
+	switch e {
+	case JobRequirementStatusMatched, JobRequirementStatusPartial, JobRequirementStatusMissing:
+		return true
+	}
+	return false
+}
+
+func (e JobRequirementStatus) String() string {
+	return string(e)
+}
+
+func (e *JobRequirementStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = JobRequirementStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid JobRequirementStatus", str)
+	}
+	return nil
+}
+
+func (e JobRequirementStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *JobRequirementStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e JobRequirementStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Seniority level a job description asks for.
+type JobSeniority string
+
+const (
+	JobSeniorityIntern    JobSeniority = "INTERN"
+	JobSeniorityJunior    JobSeniority = "JUNIOR"
+	JobSeniorityMid       JobSeniority = "MID"
+	JobSenioritySenior    JobSeniority = "SENIOR"
+	JobSeniorityLead      JobSeniority = "LEAD"
+	JobSeniorityPrincipal JobSeniority = "PRINCIPAL"
+	JobSeniorityExecutive JobSeniority = "EXECUTIVE"
+)
+
+var AllJobSeniority = []JobSeniority{
+	JobSeniorityIntern,
+	JobSeniorityJunior,
+	JobSeniorityMid,
+	JobSenioritySenior,
+	JobSeniorityLead,
+	JobSeniorityPrincipal,
+	JobSeniorityExecutive,
+}
+
+func (e JobSeniority) IsValid() bool {
+	switch e {
+	case JobSeniorityIntern, JobSeniorityJunior, JobSeniorityMid, JobSenioritySenior, JobSeniorityLead, JobSeniorityPrincipal, JobSeniorityExecutive:
+		return true
+	}
+	return false
+}
+
+func (e JobSeniority) String() string {
+	return string(e)
+}
+
+func (e *JobSeniority) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = JobSeniority(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid JobSeniority", str)
+	}
+	return nil
+}
+
+func (e JobSeniority) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *JobSeniority) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e JobSeniority) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Spoken language proficiency on the Common European Framework of Reference (CEFR) scale, or native.
+type LanguageProficiency string
+
+const (
+	LanguageProficiencyA1     LanguageProficiency = "A1"
+	LanguageProficiencyA2     LanguageProficiency = "A2"
+	LanguageProficiencyB1     LanguageProficiency = "B1"
+	LanguageProficiencyB2     LanguageProficiency = "B2"
+	LanguageProficiencyC1     LanguageProficiency = "C1"
+	LanguageProficiencyC2     LanguageProficiency = "C2"
+	LanguageProficiencyNative LanguageProficiency = "NATIVE"
+)
+
+var AllLanguageProficiency = []LanguageProficiency{
+	LanguageProficiencyA1,
+	LanguageProficiencyA2,
+	LanguageProficiencyB1,
+	LanguageProficiencyB2,
+	LanguageProficiencyC1,
+	LanguageProficiencyC2,
+	LanguageProficiencyNative,
+}
+
+func (e LanguageProficiency) IsValid() bool {
+	switch e {
+	case LanguageProficiencyA1, LanguageProficiencyA2, LanguageProficiencyB1, LanguageProficiencyB2, LanguageProficiencyC1, LanguageProficiencyC2, LanguageProficiencyNative:
+		return true
+	}
+	return false
+}
+
+func (e LanguageProficiency) String() string {
+	return string(e)
+}
+
+func (e *LanguageProficiency) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LanguageProficiency(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LanguageProficiency", str)
+	}
+	return nil
+}
+
+func (e LanguageProficiency) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LanguageProficiency) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LanguageProficiency) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// What a profile link points to.
+type LinkKind string
+
+const (
+	LinkKindGithub    LinkKind = "GITHUB"
+	LinkKindLinkedin  LinkKind = "LINKEDIN"
+	LinkKindPortfolio LinkKind = "PORTFOLIO"
+	LinkKindWebsite   LinkKind = "WEBSITE"
+	LinkKindOther     LinkKind = "OTHER"
+)
+
+var AllLinkKind = []LinkKind{
+	LinkKindGithub,
+	LinkKindLinkedin,
+	LinkKindPortfolio,
+	LinkKindWebsite,
+	LinkKindOther,
+}
+
+func (e LinkKind) IsValid() bool {
+	switch e {
+	case LinkKindGithub, LinkKindLinkedin, LinkKindPortfolio, LinkKindWebsite, LinkKindOther:
+		return true
+	}
+	return false
+}
+
+func (e LinkKind) String() string {
+	return string(e)
+}
+
+func (e *LinkKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LinkKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LinkKind", str)
+	}
+	return nil
+}
+
+func (e LinkKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LinkKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LinkKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// What a resume merge change does to the profile.
+type MergeChangeAction string
+
+const (
+	// Create an entry the profile does not have yet.
+	MergeChangeActionAdd MergeChangeAction = "ADD"
+	// Set fields of a matching profile entry to the resume's values.
+	MergeChangeActionUpdate MergeChangeAction = "UPDATE"
+	// Delete a profile entry imported from a resume that the new resume no longer lists.
+	MergeChangeActionRemove MergeChangeAction = "REMOVE"
+)
+
+var AllMergeChangeAction = []MergeChangeAction{
+	MergeChangeActionAdd,
+	MergeChangeActionUpdate,
+	MergeChangeActionRemove,
+}
+
+func (e MergeChangeAction) IsValid() bool {
+	switch e {
+	case MergeChangeActionAdd, MergeChangeActionUpdate, MergeChangeActionRemove:
+		return true
+	}
+	return false
+}
+
+func (e MergeChangeAction) String() string {
+	return string(e)
+}
+
+func (e *MergeChangeAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MergeChangeAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MergeChangeAction", str)
+	}
+	return nil
+}
+
+func (e MergeChangeAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
 
-// A user account in the system.
-type User struct {
-	ID        string    `json:"id"`
-	Email     string    `json:"email"`
-	Name      *string   `json:"name,omitempty"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+func (e *MergeChangeAction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
 }
 
-// Status of asynchronous document detection.
-type DetectionStatus string
+func (e MergeChangeAction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// What a change did to its item.
+type ProfileChangeAction string
 
 const (
-	DetectionStatusPending    DetectionStatus = "PENDING"
-	DetectionStatusProcessing DetectionStatus = "PROCESSING"
-	DetectionStatusCompleted  DetectionStatus = "COMPLETED"
-	DetectionStatusFailed     DetectionStatus = "FAILED"
+	ProfileChangeActionCreate ProfileChangeAction = "CREATE"
+	ProfileChangeActionUpdate ProfileChangeAction = "UPDATE"
+	ProfileChangeActionDelete ProfileChangeAction = "DELETE"
 )
 
-var AllDetectionStatus = []DetectionStatus{
-	DetectionStatusPending,
-	DetectionStatusProcessing,
-	DetectionStatusCompleted,
-	DetectionStatusFailed,
+var AllProfileChangeAction = []ProfileChangeAction{
+	ProfileChangeActionCreate,
+	ProfileChangeActionUpdate,
+	ProfileChangeActionDelete,
 }
 
-func (e DetectionStatus) IsValid() bool {
+func (e ProfileChangeAction) IsValid() bool {
 	switch e {
-	case DetectionStatusPending, DetectionStatusProcessing, DetectionStatusCompleted, DetectionStatusFailed:
+	case ProfileChangeActionCreate, ProfileChangeActionUpdate, ProfileChangeActionDelete:
 		return true
 	}
 	return false
 }
 
-func (e DetectionStatus) String() string {
+func (e ProfileChangeAction) String() string {
 	return string(e)
 }
 
-func (e *DetectionStatus) UnmarshalGQL(v any) error {
+func (e *ProfileChangeAction) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = DetectionStatus(str)
+	*e = ProfileChangeAction(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid DetectionStatus", str)
+		return fmt.Errorf("%s is not a valid ProfileChangeAction", str)
 	}
 	return nil
 }
 
-func (e DetectionStatus) MarshalGQL(w io.Writer) {
+func (e ProfileChangeAction) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *DetectionStatus) UnmarshalJSON(b []byte) error {
+func (e *ProfileChangeAction) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -963,57 +3935,81 @@ func (e *DetectionStatus) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e DetectionStatus) MarshalJSON() ([]byte, error) {
+func (e ProfileChangeAction) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// The type of feedback being reported about document processing.
-type DocumentFeedbackType string
+// The kind of profile item a change applies to.
+type ProfileChangeEntity string
 
 const (
-	// Feedback about incorrect document type detection.
-	DocumentFeedbackTypeDetectionCorrection DocumentFeedbackType = "DETECTION_CORRECTION"
-	// Feedback about extraction quality issues.
-	DocumentFeedbackTypeExtractionQuality DocumentFeedbackType = "EXTRACTION_QUALITY"
+	// The profile header (name, contact details, summary).
+	ProfileChangeEntityProfile ProfileChangeEntity = "PROFILE"
+	// A work experience entry.
+	ProfileChangeEntityExperience ProfileChangeEntity = "EXPERIENCE"
+	// An education entry.
+	ProfileChangeEntityEducation ProfileChangeEntity = "EDUCATION"
+	// A skill.
+	ProfileChangeEntitySkill ProfileChangeEntity = "SKILL"
+	// A link.
+	ProfileChangeEntityLink ProfileChangeEntity = "LINK"
+	// A certification.
+	ProfileChangeEntityCertification ProfileChangeEntity = "CERTIFICATION"
+	// A spoken language.
+	ProfileChangeEntityLanguage ProfileChangeEntity = "LANGUAGE"
+	// A project.
+	ProfileChangeEntityProject ProfileChangeEntity = "PROJECT"
+	// A publication.
+	ProfileChangeEntityPublication ProfileChangeEntity = "PUBLICATION"
+	// An award.
+	ProfileChangeEntityAward ProfileChangeEntity = "AWARD"
 )
 
-var AllDocumentFeedbackType = []DocumentFeedbackType{
-	DocumentFeedbackTypeDetectionCorrection,
-	DocumentFeedbackTypeExtractionQuality,
+var AllProfileChangeEntity = []ProfileChangeEntity{
+	ProfileChangeEntityProfile,
+	ProfileChangeEntityExperience,
+	ProfileChangeEntityEducation,
+	ProfileChangeEntitySkill,
+	ProfileChangeEntityLink,
+	ProfileChangeEntityCertification,
+	ProfileChangeEntityLanguage,
+	ProfileChangeEntityProject,
+	ProfileChangeEntityPublication,
+	ProfileChangeEntityAward,
 }
 
-func (e DocumentFeedbackType) IsValid() bool {
+func (e ProfileChangeEntity) IsValid() bool {
 	switch e {
-	case DocumentFeedbackTypeDetectionCorrection, DocumentFeedbackTypeExtractionQuality:
+	case ProfileChangeEntityProfile, ProfileChangeEntityExperience, ProfileChangeEntityEducation, ProfileChangeEntitySkill, ProfileChangeEntityLink, ProfileChangeEntityCertification, ProfileChangeEntityLanguage, ProfileChangeEntityProject, ProfileChangeEntityPublication, ProfileChangeEntityAward:
 		return true
 	}
 	return false
 }
 
-func (e DocumentFeedbackType) String() string {
+func (e ProfileChangeEntity) String() string {
 	return string(e)
 }
 
-func (e *DocumentFeedbackType) UnmarshalGQL(v any) error {
+func (e *ProfileChangeEntity) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = DocumentFeedbackType(str)
+	*e = ProfileChangeEntity(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid DocumentFeedbackType", str)
+		return fmt.Errorf("%s is not a valid ProfileChangeEntity", str)
 	}
 	return nil
 }
 
-func (e DocumentFeedbackType) MarshalGQL(w io.Writer) {
+func (e ProfileChangeEntity) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *DocumentFeedbackType) UnmarshalJSON(b []byte) error {
+func (e *ProfileChangeEntity) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1021,59 +4017,55 @@ func (e *DocumentFeedbackType) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e DocumentFeedbackType) MarshalJSON() ([]byte, error) {
+func (e ProfileChangeEntity) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Hint about the document type from lightweight detection.
-type DocumentTypeHint string
+// Who made a change: the user, or a document import.
+type ProfileChangeSource string
 
 const (
-	DocumentTypeHintResume          DocumentTypeHint = "RESUME"
-	DocumentTypeHintReferenceLetter DocumentTypeHint = "REFERENCE_LETTER"
-	DocumentTypeHintHybrid          DocumentTypeHint = "HYBRID"
-	DocumentTypeHintUnknown         DocumentTypeHint = "UNKNOWN"
+	ProfileChangeSourceManual ProfileChangeSource = "MANUAL"
+	ProfileChangeSourceImport ProfileChangeSource = "IMPORT"
 )
 
-var AllDocumentTypeHint = []DocumentTypeHint{
-	DocumentTypeHintResume,
-	DocumentTypeHintReferenceLetter,
-	DocumentTypeHintHybrid,
-	DocumentTypeHintUnknown,
+var AllProfileChangeSource = []ProfileChangeSource{
+	ProfileChangeSourceManual,
+	ProfileChangeSourceImport,
 }
 
-func (e DocumentTypeHint) IsValid() bool {
+func (e ProfileChangeSource) IsValid() bool {
 	switch e {
-	case DocumentTypeHintResume, DocumentTypeHintReferenceLetter, DocumentTypeHintHybrid, DocumentTypeHintUnknown:
+	case ProfileChangeSourceManual, ProfileChangeSourceImport:
 		return true
 	}
 	return false
 }
 
-func (e DocumentTypeHint) String() string {
+func (e ProfileChangeSource) String() string {
 	return string(e)
 }
 
-func (e *DocumentTypeHint) UnmarshalGQL(v any) error {
+func (e *ProfileChangeSource) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = DocumentTypeHint(str)
+	*e = ProfileChangeSource(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid DocumentTypeHint", str)
+		return fmt.Errorf("%s is not a valid ProfileChangeSource", str)
 	}
 	return nil
 }
 
-func (e DocumentTypeHint) MarshalGQL(w io.Writer) {
+func (e ProfileChangeSource) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *DocumentTypeHint) UnmarshalJSON(b []byte) error {
+func (e *ProfileChangeSource) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1081,60 +4073,57 @@ func (e *DocumentTypeHint) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e DocumentTypeHint) MarshalJSON() ([]byte, error) {
+func (e ProfileChangeSource) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Source of a profile experience entry.
-type ExperienceSource string
+// File format a profile variant is exported to.
+type ProfileExportFormat string
 
 const (
-	// Manually entered by user.
-	ExperienceSourceManual ExperienceSource = "MANUAL"
-	// Extracted from an uploaded resume.
-	ExperienceSourceResumeExtracted ExperienceSource = "RESUME_EXTRACTED"
-	// Discovered in a reference letter.
-	ExperienceSourceLetterDiscovered ExperienceSource = "LETTER_DISCOVERED"
+	// Plain text.
+	ProfileExportFormatText ProfileExportFormat = "TEXT"
+	// A standalone HTML document.
+	ProfileExportFormatHTML ProfileExportFormat = "HTML"
 )
 
-var AllExperienceSource = []ExperienceSource{
-	ExperienceSourceManual,
-	ExperienceSourceResumeExtracted,
-	ExperienceSourceLetterDiscovered,
+var AllProfileExportFormat = []ProfileExportFormat{
+	ProfileExportFormatText,
+	ProfileExportFormatHTML,
 }
 
-func (e ExperienceSource) IsValid() bool {
+func (e ProfileExportFormat) IsValid() bool {
 	switch e {
-	case ExperienceSourceManual, ExperienceSourceResumeExtracted, ExperienceSourceLetterDiscovered:
+	case ProfileExportFormatText, ProfileExportFormatHTML:
 		return true
 	}
 	return false
 }
 
-func (e ExperienceSource) String() string {
+func (e ProfileExportFormat) String() string {
 	return string(e)
 }
 
-func (e *ExperienceSource) UnmarshalGQL(v any) error {
+func (e *ProfileExportFormat) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = ExperienceSource(str)
+	*e = ProfileExportFormat(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ExperienceSource", str)
+		return fmt.Errorf("%s is not a valid ProfileExportFormat", str)
 	}
 	return nil
 }
 
-func (e ExperienceSource) MarshalGQL(w io.Writer) {
+func (e ProfileExportFormat) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *ExperienceSource) UnmarshalJSON(b []byte) error {
+func (e *ProfileExportFormat) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1142,7 +4131,7 @@ func (e *ExperienceSource) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e ExperienceSource) MarshalJSON() ([]byte, error) {
+func (e ProfileExportFormat) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
@@ -1210,6 +4199,64 @@ func (e ReferenceLetterStatus) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// How importDocumentResults imports a resume into the profile.
+type ResumeImportMode string
+
+const (
+	// Delete the entries previously imported from this resume and create them again.
+	ResumeImportModeReplace ResumeImportMode = "REPLACE"
+	// Apply the accepted changes of the diff between the resume and the current profile.
+	ResumeImportModeMerge ResumeImportMode = "MERGE"
+)
+
+var AllResumeImportMode = []ResumeImportMode{
+	ResumeImportModeReplace,
+	ResumeImportModeMerge,
+}
+
+func (e ResumeImportMode) IsValid() bool {
+	switch e {
+	case ResumeImportModeReplace, ResumeImportModeMerge:
+		return true
+	}
+	return false
+}
+
+func (e ResumeImportMode) String() string {
+	return string(e)
+}
+
+func (e *ResumeImportMode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ResumeImportMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ResumeImportMode", str)
+	}
+	return nil
+}
+
+func (e ResumeImportMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ResumeImportMode) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ResumeImportMode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Processing status of a resume.
 type ResumeStatus string
 
@@ -1278,7 +4325,14 @@ const (
 	TestimonialRelationshipPeer         TestimonialRelationship = "PEER"
 	TestimonialRelationshipDirectReport TestimonialRelationship = "DIRECT_REPORT"
 	TestimonialRelationshipClient       TestimonialRelationship = "CLIENT"
-	TestimonialRelationshipOther        TestimonialRelationship = "OTHER"
+	TestimonialRelationshipMentor       TestimonialRelationship = "MENTOR"
+	// Academic supervisor or teacher.
+	TestimonialRelationshipProfessor TestimonialRelationship = "PROFESSOR"
+	// Someone at the same organization who did not work alongside the candidate as a peer.
+	TestimonialRelationshipColleague TestimonialRelationship = "COLLEAGUE"
+	// Statement from a performance review written by an employer.
+	TestimonialRelationshipPerformanceReview TestimonialRelationship = "PERFORMANCE_REVIEW"
+	TestimonialRelationshipOther             TestimonialRelationship = "OTHER"
 )
 
 var AllTestimonialRelationship = []TestimonialRelationship{
@@ -1286,12 +4340,16 @@ var AllTestimonialRelationship = []TestimonialRelationship{
 	TestimonialRelationshipPeer,
 	TestimonialRelationshipDirectReport,
 	TestimonialRelationshipClient,
+	TestimonialRelationshipMentor,
+	TestimonialRelationshipProfessor,
+	TestimonialRelationshipColleague,
+	TestimonialRelationshipPerformanceReview,
 	TestimonialRelationshipOther,
 }
 
 func (e TestimonialRelationship) IsValid() bool {
 	switch e {
-	case TestimonialRelationshipManager, TestimonialRelationshipPeer, TestimonialRelationshipDirectReport, TestimonialRelationshipClient, TestimonialRelationshipOther:
+	case TestimonialRelationshipManager, TestimonialRelationshipPeer, TestimonialRelationshipDirectReport, TestimonialRelationshipClient, TestimonialRelationshipMentor, TestimonialRelationshipProfessor, TestimonialRelationshipColleague, TestimonialRelationshipPerformanceReview, TestimonialRelationshipOther:
 		return true
 	}
 	return false
@@ -1331,3 +4389,183 @@ func (e TestimonialRelationship) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+// The kind of profile entity a draft cites.
+type WritingSourceKind string
+
+const (
+	WritingSourceKindExperience  WritingSourceKind = "EXPERIENCE"
+	WritingSourceKindSkill       WritingSourceKind = "SKILL"
+	WritingSourceKindTestimonial WritingSourceKind = "TESTIMONIAL"
+)
+
+var AllWritingSourceKind = []WritingSourceKind{
+	WritingSourceKindExperience,
+	WritingSourceKindSkill,
+	WritingSourceKindTestimonial,
+}
+
+func (e WritingSourceKind) IsValid() bool {
+	switch e {
+	case WritingSourceKindExperience, WritingSourceKindSkill, WritingSourceKindTestimonial:
+		return true
+	}
+	return false
+}
+
+func (e WritingSourceKind) String() string {
+	return string(e)
+}
+
+func (e *WritingSourceKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WritingSourceKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WritingSourceKind", str)
+	}
+	return nil
+}
+
+func (e WritingSourceKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WritingSourceKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WritingSourceKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// The part of a profile a writing suggestion rewrites.
+type WritingSuggestionKind string
+
+const (
+	// The professional summary.
+	WritingSuggestionKindSummary WritingSuggestionKind = "SUMMARY"
+	// The highlights of one experience.
+	WritingSuggestionKindHighlights WritingSuggestionKind = "HIGHLIGHTS"
+	// The one-line headline.
+	WritingSuggestionKindHeadline WritingSuggestionKind = "HEADLINE"
+)
+
+var AllWritingSuggestionKind = []WritingSuggestionKind{
+	WritingSuggestionKindSummary,
+	WritingSuggestionKindHighlights,
+	WritingSuggestionKindHeadline,
+}
+
+func (e WritingSuggestionKind) IsValid() bool {
+	switch e {
+	case WritingSuggestionKindSummary, WritingSuggestionKindHighlights, WritingSuggestionKindHeadline:
+		return true
+	}
+	return false
+}
+
+func (e WritingSuggestionKind) String() string {
+	return string(e)
+}
+
+func (e *WritingSuggestionKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WritingSuggestionKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WritingSuggestionKind", str)
+	}
+	return nil
+}
+
+func (e WritingSuggestionKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WritingSuggestionKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WritingSuggestionKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Whether a writing suggestion was applied.
+type WritingSuggestionStatus string
+
+const (
+	// Awaiting the user's decision.
+	WritingSuggestionStatusPending WritingSuggestionStatus = "PENDING"
+	// Applied to the profile.
+	WritingSuggestionStatusAccepted WritingSuggestionStatus = "ACCEPTED"
+	// Discarded without changes.
+	WritingSuggestionStatusDismissed WritingSuggestionStatus = "DISMISSED"
+)
+
+var AllWritingSuggestionStatus = []WritingSuggestionStatus{
+	WritingSuggestionStatusPending,
+	WritingSuggestionStatusAccepted,
+	WritingSuggestionStatusDismissed,
+}
+
+func (e WritingSuggestionStatus) IsValid() bool {
+	switch e {
+	case WritingSuggestionStatusPending, WritingSuggestionStatusAccepted, WritingSuggestionStatusDismissed:
+		return true
+	}
+	return false
+}
+
+func (e WritingSuggestionStatus) String() string {
+	return string(e)
+}
+
+func (e *WritingSuggestionStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WritingSuggestionStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WritingSuggestionStatus", str)
+	}
+	return nil
+}
+
+func (e WritingSuggestionStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WritingSuggestionStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WritingSuggestionStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -2,11 +2,19 @@ package resolver
 
 import (
 	"encoding/json"
+	"fmt"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
+
+	"github.com/google/uuid"
 
 	"backend/internal/domain"
 	"backend/internal/graphql/model"
+	"backend/internal/normalize"
+	"backend/internal/service"
+	"backend/internal/taxonomy"
 )
 
 // jsonNull is the string representation of a JSON null value.
@@ -51,9 +59,141 @@ func isValidPhone(phone string) bool {
 	return phoneRegex.MatchString(phone)
 }
 
-// normalizeSkillName produces a lowercase, trimmed version of a skill name for deduplication.
-func normalizeSkillName(name string) string {
-	return strings.ToLower(strings.TrimSpace(name))
+// setSkillName sets a profile skill's name together with the normalized name and canonical
+// skill the taxonomy derives from it.
+func setSkillName(tax *taxonomy.Taxonomy, skill *domain.ProfileSkill, name string) {
+	skill.Name = name
+	skill.NormalizedName = tax.Normalize(name)
+	skill.CanonicalSkillID = service.CanonicalSkillID(tax, name)
+}
+
+// cleanSkillSynonyms trims synonyms and drops blanks, repeats and restatements of the name.
+func cleanSkillSynonyms(name string, synonyms []string) []string {
+	seen := map[string]bool{taxonomy.Key(name): true}
+	result := make([]string, 0, len(synonyms))
+	for _, synonym := range synonyms {
+		synonym = strings.TrimSpace(synonym)
+		key := taxonomy.Key(synonym)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, synonym)
+	}
+	return result
+}
+
+// validateCanonicalSkillNames checks that a profile's taxonomy skill has a name and that
+// neither the name nor its synonyms already belong to another of the profile's skills.
+// It returns nil when the names are valid.
+func validateCanonicalSkillNames(tax *taxonomy.Taxonomy, skillID uuid.UUID, name string, synonyms []string) *model.CanonicalSkillValidationError {
+	if taxonomy.Slug(name) == "" {
+		return &model.CanonicalSkillValidationError{
+			Message: "skill name is required",
+			Field:   stringPtr("name"),
+		}
+	}
+	if existing := tax.Resolve(name); existing != nil && existing.IsCustom() && existing.ID != skillID {
+		return &model.CanonicalSkillValidationError{
+			Message: fmt.Sprintf("skill already exists as %q", existing.Name),
+			Field:   stringPtr("name"),
+		}
+	}
+	for _, synonym := range synonyms {
+		if existing := tax.Resolve(synonym); existing != nil && existing.IsCustom() && existing.ID != skillID {
+			return &model.CanonicalSkillValidationError{
+				Message: fmt.Sprintf("synonym %q already belongs to %q", synonym, existing.Name),
+				Field:   stringPtr("synonyms"),
+			}
+		}
+	}
+	return nil
+}
+
+// validateCanonicalSkillParent resolves a parent skill ID against the taxonomy and rejects
+// parents that would put the skill inside its own hierarchy.
+func validateCanonicalSkillParent(tax *taxonomy.Taxonomy, skillID uuid.UUID, parentID string) (*domain.Skill, *model.CanonicalSkillValidationError) {
+	id, err := uuid.Parse(parentID)
+	if err != nil {
+		return nil, &model.CanonicalSkillValidationError{
+			Message: "invalid parent ID format",
+			Field:   stringPtr("parentId"),
+		}
+	}
+	parent := tax.Get(id)
+	if parent == nil {
+		return nil, &model.CanonicalSkillValidationError{
+			Message: "parent skill not found",
+			Field:   stringPtr("parentId"),
+		}
+	}
+	if parent.ID == skillID {
+		return nil, &model.CanonicalSkillValidationError{
+			Message: "a skill cannot be its own parent",
+			Field:   stringPtr("parentId"),
+		}
+	}
+	for _, ancestor := range tax.Ancestors(parent) {
+		if ancestor.ID == skillID {
+			return nil, &model.CanonicalSkillValidationError{
+				Message: "parent skill is a narrower skill of this one",
+				Field:   stringPtr("parentId"),
+			}
+		}
+	}
+	return parent, nil
+}
+
+// parseItemOrder parses a requested display order and checks that it lists each of the
+// profile's current items exactly once. It returns nil when no order was requested.
+func parseItemOrder(ids []string, current []uuid.UUID) ([]uuid.UUID, error) {
+	if ids == nil {
+		return nil, nil
+	}
+	if len(ids) != len(current) {
+		return nil, fmt.Errorf("expected %d IDs, got %d", len(current), len(ids))
+	}
+	remaining := make(map[uuid.UUID]bool, len(current))
+	for _, id := range current {
+		remaining[id] = true
+	}
+	order := make([]uuid.UUID, len(ids))
+	for i, raw := range ids {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID format: %s", raw)
+		}
+		if !remaining[id] {
+			return nil, fmt.Errorf("item %s is not on the profile or is listed twice", raw)
+		}
+		delete(remaining, id)
+		order[i] = id
+	}
+	return order, nil
+}
+
+// validateCompanyAlias checks that both names of a company alias are present and that the
+// alias does not simply restate the canonical name. It returns nil when the pair is valid.
+func validateCompanyAlias(alias, canonicalName string) *model.CompanyAliasValidationError {
+	if alias == "" {
+		return &model.CompanyAliasValidationError{
+			Message: "alias is required",
+			Field:   stringPtr("alias"),
+		}
+	}
+	if canonicalName == "" {
+		return &model.CompanyAliasValidationError{
+			Message: "canonical name is required",
+			Field:   stringPtr("canonicalName"),
+		}
+	}
+	if normalize.CompanyKey(alias) == normalize.CompanyKey(canonicalName) {
+		return &model.CompanyAliasValidationError{
+			Message: "alias must differ from the canonical name",
+			Field:   stringPtr("alias"),
+		}
+	}
+	return nil
 }
 
 // toGraphQLUser converts a domain User to a GraphQL User model.
@@ -104,6 +244,35 @@ func toGraphQLAuthorWithImage(a *domain.Author, imageURL *string) *model.Author
 	}
 }
 
+// toGraphQLCompany converts a domain Company to a GraphQL Company model.
+// The logo and the related experiences, authors and testimonials are loaded by field resolvers.
+func toGraphQLCompany(c *domain.Company) *model.Company {
+	if c == nil {
+		return nil
+	}
+	return &model.Company{
+		ID:        c.ID.String(),
+		Name:      c.Name,
+		Website:   c.Website,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+// toGraphQLCompanyAlias converts a domain CompanyAlias to a GraphQL CompanyAlias model.
+func toGraphQLCompanyAlias(a *domain.CompanyAlias) *model.CompanyAlias {
+	if a == nil {
+		return nil
+	}
+	return &model.CompanyAlias{
+		ID:            a.ID.String(),
+		Alias:         a.Alias,
+		CanonicalName: a.CanonicalName,
+		CreatedAt:     a.CreatedAt,
+		UpdatedAt:     a.UpdatedAt,
+	}
+}
+
 // toGraphQLFile converts a domain File to a GraphQL File model.
 // If user is provided, it will be set on the result.
 func toGraphQLFile(f *domain.File, user *model.User) *model.File {
@@ -254,9 +423,11 @@ func toGraphQLExtractedExperienceMentions(mentions []domain.ExtractedExperienceM
 	result := make([]*model.ExtractedExperienceMention, len(mentions))
 	for i, m := range mentions {
 		result[i] = &model.ExtractedExperienceMention{
-			Company: m.Company,
-			Role:    m.Role,
-			Quote:   m.Quote,
+			Company:   m.Company,
+			Role:      m.Role,
+			Quote:     m.Quote,
+			StartDate: m.StartDate,
+			EndDate:   m.EndDate,
 		}
 	}
 	return result
@@ -274,6 +445,137 @@ func toGraphQLExtractionMetadata(m *domain.ExtractionMetadata) *model.Extraction
 	}
 }
 
+// toGraphQLCredentialDocument converts a domain CredentialDocument to a GraphQL CredentialDocument model.
+// Only the extracted data field matching the document type is populated.
+// The user and file relations must be provided separately.
+func toGraphQLCredentialDocument(cd *domain.CredentialDocument, user *model.User, file *model.File) *model.CredentialDocument {
+	if cd == nil {
+		return nil
+	}
+
+	var status model.CredentialDocumentStatus
+	switch cd.Status {
+	case domain.CredentialDocumentStatusPending:
+		status = model.CredentialDocumentStatusPending
+	case domain.CredentialDocumentStatusProcessing:
+		status = model.CredentialDocumentStatusProcessing
+	case domain.CredentialDocumentStatusCompleted:
+		status = model.CredentialDocumentStatusCompleted
+	case domain.CredentialDocumentStatusFailed:
+		status = model.CredentialDocumentStatusFailed
+	default:
+		status = model.CredentialDocumentStatusPending
+	}
+
+	result := &model.CredentialDocument{
+		ID:           cd.ID.String(),
+		Status:       status,
+		ErrorMessage: cd.ErrorMessage,
+		CreatedAt:    cd.CreatedAt,
+		UpdatedAt:    cd.UpdatedAt,
+		User:         user,
+		File:         file,
+	}
+
+	hasData := len(cd.ExtractedData) > 0 && string(cd.ExtractedData) != jsonNull
+
+	switch cd.DocumentType {
+	case domain.CredentialDocumentTypeCertificate:
+		result.DocumentType = model.CredentialDocumentTypeCertificate
+		if hasData {
+			result.CertificateData = toGraphQLExtractedCertificateData(cd.ExtractedData)
+		}
+	case domain.CredentialDocumentTypeTranscript:
+		result.DocumentType = model.CredentialDocumentTypeTranscript
+		if hasData {
+			result.TranscriptData = toGraphQLExtractedTranscriptData(cd.ExtractedData)
+		}
+	case domain.CredentialDocumentTypePerformanceReview:
+		result.DocumentType = model.CredentialDocumentTypePerformanceReview
+		if hasData {
+			result.PerformanceReviewData = toGraphQLExtractedPerformanceReviewData(cd.ExtractedData)
+		}
+	}
+
+	return result
+}
+
+// toGraphQLExtractedCertificateData converts JSON raw message to typed ExtractedCertificateData.
+func toGraphQLExtractedCertificateData(raw json.RawMessage) *model.ExtractedCertificateData {
+	var data domain.ExtractedCertificateData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		// Return nil for graceful degradation on parse errors
+		return nil
+	}
+
+	skills := data.Skills
+	if skills == nil {
+		skills = []string{}
+	}
+
+	return &model.ExtractedCertificateData{
+		Name:            data.Name,
+		Issuer:          data.Issuer,
+		HolderName:      data.HolderName,
+		CredentialID:    data.CredentialID,
+		VerificationURL: data.VerificationURL,
+		IssueDate:       data.IssueDate,
+		ExpiryDate:      data.ExpiryDate,
+		Skills:          skills,
+		Metadata:        toGraphQLExtractionMetadata(&data.Metadata),
+	}
+}
+
+// toGraphQLExtractedTranscriptData converts JSON raw message to typed ExtractedTranscriptData.
+func toGraphQLExtractedTranscriptData(raw json.RawMessage) *model.ExtractedTranscriptData {
+	var data domain.ExtractedTranscriptData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		// Return nil for graceful degradation on parse errors
+		return nil
+	}
+
+	courses := make([]*model.ExtractedCourse, len(data.Courses))
+	for i, c := range data.Courses {
+		courses[i] = &model.ExtractedCourse{
+			Name:    c.Name,
+			Grade:   c.Grade,
+			Credits: c.Credits,
+		}
+	}
+
+	return &model.ExtractedTranscriptData{
+		HolderName:     data.HolderName,
+		Institution:    data.Institution,
+		Degree:         data.Degree,
+		Field:          data.Field,
+		StartDate:      data.StartDate,
+		GraduationDate: data.GraduationDate,
+		Gpa:            data.GPA,
+		Honors:         data.Honors,
+		Courses:        courses,
+		Metadata:       toGraphQLExtractionMetadata(&data.Metadata),
+	}
+}
+
+// toGraphQLExtractedPerformanceReviewData converts JSON raw message to typed ExtractedPerformanceReviewData.
+func toGraphQLExtractedPerformanceReviewData(raw json.RawMessage) *model.ExtractedPerformanceReviewData {
+	var data domain.ExtractedPerformanceReviewData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		// Return nil for graceful degradation on parse errors
+		return nil
+	}
+
+	return &model.ExtractedPerformanceReviewData{
+		Reviewer:     toGraphQLExtractedAuthor(&data.Reviewer),
+		Company:      data.Company,
+		Role:         data.Role,
+		ReviewPeriod: data.ReviewPeriod,
+		Rating:       data.Rating,
+		Testimonials: toGraphQLExtractedTestimonials(data.Testimonials),
+		Metadata:     toGraphQLExtractionMetadata(&data.Metadata),
+	}
+}
+
 // toGraphQLResume converts a domain Resume to a GraphQL Resume model.
 // The user and file relations must be provided separately.
 func toGraphQLResume(r *domain.Resume, user *model.User, file *model.File) *model.Resume {
@@ -331,6 +633,10 @@ func toGraphQLResumeExtractedData(raw json.RawMessage) *model.ResumeExtractedDat
 			EndDate:     exp.EndDate,
 			IsCurrent:   exp.IsCurrent,
 			Description: exp.Description,
+			Highlights:  make([]*model.ExperienceHighlight, len(exp.Highlights)),
+		}
+		for j, h := range exp.Highlights {
+			experiences[i].Highlights[j] = &model.ExperienceHighlight{Text: h.Text, Quantified: h.Quantified}
 		}
 	}
 
@@ -348,22 +654,100 @@ func toGraphQLResumeExtractedData(raw json.RawMessage) *model.ResumeExtractedDat
 		}
 	}
 
+	links := make([]*model.ExtractedLink, len(data.Links))
+	for i, l := range data.Links {
+		kind := l.Kind
+		if !kind.IsValid() {
+			kind = domain.LinkKindOther
+		}
+		links[i] = &model.ExtractedLink{
+			Kind:  model.LinkKind(strings.ToUpper(string(kind))),
+			URL:   l.URL,
+			Label: l.Label,
+		}
+	}
+
+	certifications := make([]*model.ExtractedCertification, len(data.Certifications))
+	for i, c := range data.Certifications {
+		certifications[i] = &model.ExtractedCertification{
+			Name:            c.Name,
+			Issuer:          c.Issuer,
+			CredentialID:    c.CredentialID,
+			VerificationURL: c.VerificationURL,
+			IssueDate:       c.IssueDate,
+			ExpiryDate:      c.ExpiryDate,
+		}
+	}
+
+	languages := make([]*model.ExtractedLanguage, len(data.Languages))
+	for i, l := range data.Languages {
+		languages[i] = &model.ExtractedLanguage{
+			Language:    l.Language,
+			Proficiency: toGraphQLLanguageProficiency(l.Proficiency),
+		}
+	}
+
+	projects := make([]*model.ExtractedProject, len(data.Projects))
+	for i, p := range data.Projects {
+		technologies := p.Technologies
+		if technologies == nil {
+			technologies = []string{}
+		}
+		projects[i] = &model.ExtractedProject{
+			Name:         p.Name,
+			Role:         p.Role,
+			Description:  p.Description,
+			URL:          p.URL,
+			StartDate:    p.StartDate,
+			EndDate:      p.EndDate,
+			Technologies: technologies,
+		}
+	}
+
+	publications := make([]*model.ExtractedPublication, len(data.Publications))
+	for i, p := range data.Publications {
+		publications[i] = &model.ExtractedPublication{
+			Title:       p.Title,
+			Publisher:   p.Publisher,
+			Date:        p.Date,
+			URL:         p.URL,
+			Description: p.Description,
+		}
+	}
+
+	awards := make([]*model.ExtractedAward, len(data.Awards))
+	for i, a := range data.Awards {
+		awards[i] = &model.ExtractedAward{
+			Title:       a.Title,
+			Issuer:      a.Issuer,
+			Date:        a.Date,
+			Description: a.Description,
+		}
+	}
+
 	return &model.ResumeExtractedData{
-		Name:        data.Name,
-		Email:       data.Email,
-		Phone:       data.Phone,
-		Location:    data.Location,
-		Summary:     data.Summary,
-		Experiences: experiences,
-		Educations:  educations,
-		Skills:      data.Skills,
-		ExtractedAt: data.ExtractedAt,
-		Confidence:  data.Confidence,
+		Name:           data.Name,
+		Email:          data.Email,
+		Phone:          data.Phone,
+		Location:       data.Location,
+		Summary:        data.Summary,
+		Experiences:    experiences,
+		Educations:     educations,
+		Skills:         data.Skills,
+		Links:          links,
+		Certifications: certifications,
+		Languages:      languages,
+		Projects:       projects,
+		Publications:   publications,
+		Awards:         awards,
+		ExtractedAt:    data.ExtractedAt,
+		Confidence:     data.Confidence,
 	}
 }
 
 // toGraphQLProfile converts a domain Profile to a GraphQL Profile model.
-// The user, experiences, educations, skills, and photoURL must be provided separately.
+// The user, experiences, educations, skills, and photoURL must be provided separately;
+// the remaining sections start empty and are filled by loadProfileSections.
 func toGraphQLProfile(p *domain.Profile, user *model.User, experiences []*model.ProfileExperience, educations []*model.ProfileEducation, skills []*model.ProfileSkill, photoURL *string) *model.Profile {
 	if p == nil {
 		return nil
@@ -372,6 +756,7 @@ func toGraphQLProfile(p *domain.Profile, user *model.User, experiences []*model.
 		ID:              p.ID.String(),
 		User:            user,
 		Name:            p.Name,
+		Headline:        p.Headline,
 		Email:           p.Email,
 		Phone:           p.Phone,
 		Location:        p.Location,
@@ -380,6 +765,12 @@ func toGraphQLProfile(p *domain.Profile, user *model.User, experiences []*model.
 		Experiences:     experiences,
 		Educations:      educations,
 		Skills:          skills,
+		Links:           []*model.ProfileLink{},
+		Certifications:  []*model.ProfileCertification{},
+		Languages:       []*model.ProfileLanguage{},
+		Projects:        []*model.ProfileProject{},
+		Publications:    []*model.ProfilePublication{},
+		Awards:          []*model.ProfileAward{},
 		CreatedAt:       p.CreatedAt,
 		UpdatedAt:       p.UpdatedAt,
 	}
@@ -396,6 +787,7 @@ func domainProfileToGQL(p *domain.Profile, photoURL *string) *model.Profile {
 	return &model.Profile{
 		ID:              p.ID.String(),
 		Name:            p.Name,
+		Headline:        p.Headline,
 		Email:           p.Email,
 		Phone:           p.Phone,
 		Location:        p.Location,
@@ -404,6 +796,12 @@ func domainProfileToGQL(p *domain.Profile, photoURL *string) *model.Profile {
 		Experiences:     []*model.ProfileExperience{},
 		Educations:      []*model.ProfileEducation{},
 		Skills:          []*model.ProfileSkill{},
+		Links:           []*model.ProfileLink{},
+		Certifications:  []*model.ProfileCertification{},
+		Languages:       []*model.ProfileLanguage{},
+		Projects:        []*model.ProfileProject{},
+		Publications:    []*model.ProfilePublication{},
+		Awards:          []*model.ProfileAward{},
 		CreatedAt:       p.CreatedAt,
 		UpdatedAt:       p.UpdatedAt,
 	}
@@ -433,20 +831,34 @@ func toGraphQLProfileExperience(e *domain.ProfileExperience) *model.ProfileExper
 	copy(highlights, e.Highlights)
 
 	return &model.ProfileExperience{
-		ID:           e.ID.String(),
-		Company:      e.Company,
-		Title:        e.Title,
-		Location:     e.Location,
-		StartDate:    e.StartDate,
-		EndDate:      e.EndDate,
-		IsCurrent:    e.IsCurrent,
-		Description:  e.Description,
-		Highlights:   highlights,
-		DisplayOrder: e.DisplayOrder,
-		Source:       source,
-		CreatedAt:    e.CreatedAt,
-		UpdatedAt:    e.UpdatedAt,
+		ID:               e.ID.String(),
+		Company:          e.Company,
+		Title:            e.Title,
+		Location:         e.Location,
+		StartDate:        e.StartDate,
+		EndDate:          e.EndDate,
+		ParsedStartDate:  toGraphQLPartialDate(e.Start),
+		ParsedEndDate:    toGraphQLPartialDate(e.End),
+		IsCurrent:        e.IsCurrent,
+		DurationMonths:   durationMonths(e.Dates()),
+		Description:      e.Description,
+		Highlights:       highlights,
+		HighlightDetails: toGraphQLExperienceHighlights(highlights),
+		GapNote:          e.GapNote,
+		DisplayOrder:     e.DisplayOrder,
+		Source:           source,
+		CreatedAt:        e.CreatedAt,
+		UpdatedAt:        e.UpdatedAt,
+	}
+}
+
+// toGraphQLExperienceHighlights flags the highlights that state a measurable result.
+func toGraphQLExperienceHighlights(highlights []string) []*model.ExperienceHighlight {
+	details := make([]*model.ExperienceHighlight, len(highlights))
+	for i, h := range highlights {
+		details[i] = &model.ExperienceHighlight{Text: h, Quantified: normalize.IsQuantified(h)}
 	}
+	return details
 }
 
 // stringPtr returns a pointer to a string.
@@ -454,6 +866,47 @@ func stringPtr(s string) *string {
 	return &s
 }
 
+// derefString returns the string a pointer points to, or "" for nil.
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// toGraphQLPartialDate converts a parsed domain date to its GraphQL model, nil if unknown.
+func toGraphQLPartialDate(d domain.PartialDate) *model.PartialDate {
+	if d.IsZero() {
+		return nil
+	}
+	date := &model.PartialDate{}
+	switch d.Precision {
+	case domain.DatePrecisionYear:
+		date.Precision = model.DatePrecisionYear
+	case domain.DatePrecisionSeason:
+		date.Precision = model.DatePrecisionSeason
+	case domain.DatePrecisionMonth:
+		date.Precision = model.DatePrecisionMonth
+	case domain.DatePrecisionPresent:
+		date.Precision = model.DatePrecisionPresent
+		return date
+	}
+	date.Year = &d.Year
+	if d.Month != 0 {
+		date.Month = &d.Month
+	}
+	return date
+}
+
+// durationMonths returns the length of a date range as of now, nil if its dates are unknown.
+func durationMonths(r domain.DateRange) *int {
+	months, ok := r.DurationMonths(time.Now())
+	if !ok {
+		return nil
+	}
+	return &months
+}
+
 // toGraphQLProfileExperiences converts a slice of domain ProfileExperience to GraphQL models.
 func toGraphQLProfileExperiences(experiences []*domain.ProfileExperience) []*model.ProfileExperience {
 	if len(experiences) == 0 {
@@ -486,19 +939,22 @@ func toGraphQLProfileEducation(e *domain.ProfileEducation) *model.ProfileEducati
 	}
 
 	return &model.ProfileEducation{
-		ID:           e.ID.String(),
-		Institution:  e.Institution,
-		Degree:       e.Degree,
-		Field:        e.Field,
-		StartDate:    e.StartDate,
-		EndDate:      e.EndDate,
-		IsCurrent:    e.IsCurrent,
-		Description:  e.Description,
-		Gpa:          e.GPA,
-		DisplayOrder: e.DisplayOrder,
-		Source:        source,
-		CreatedAt:    e.CreatedAt,
-		UpdatedAt:    e.UpdatedAt,
+		ID:              e.ID.String(),
+		Institution:     e.Institution,
+		Degree:          e.Degree,
+		Field:           e.Field,
+		StartDate:       e.StartDate,
+		EndDate:         e.EndDate,
+		ParsedStartDate: toGraphQLPartialDate(e.Start),
+		ParsedEndDate:   toGraphQLPartialDate(e.End),
+		IsCurrent:       e.IsCurrent,
+		DurationMonths:  durationMonths(e.Dates()),
+		Description:     e.Description,
+		Gpa:             e.GPA,
+		DisplayOrder:    e.DisplayOrder,
+		Source:          source,
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
 	}
 }
 
@@ -514,6 +970,555 @@ func toGraphQLProfileEducations(educations []*domain.ProfileEducation) []*model.
 	return result
 }
 
+// toGraphQLExperienceSource converts a domain entry source to its GraphQL enum.
+func toGraphQLExperienceSource(s domain.ExperienceSource) model.ExperienceSource {
+	switch s {
+	case domain.ExperienceSourceResumeExtracted:
+		return model.ExperienceSourceResumeExtracted
+	case domain.ExperienceSourceLetterDiscovered:
+		return model.ExperienceSourceLetterDiscovered
+	case domain.ExperienceSourceCredentialDocument:
+		return model.ExperienceSourceCredentialDocument
+	default:
+		return model.ExperienceSourceManual
+	}
+}
+
+// toGraphQLLanguageProficiency converts a domain language proficiency to its GraphQL enum.
+func toGraphQLLanguageProficiency(p *domain.LanguageProficiency) *model.LanguageProficiency {
+	if p == nil {
+		return nil
+	}
+	proficiency := model.LanguageProficiency(strings.ToUpper(string(*p)))
+	return &proficiency
+}
+
+// toGraphQLProfileLinks converts profile links to GraphQL models.
+func toGraphQLProfileLinks(links []*domain.ProfileLink) []*model.ProfileLink {
+	result := make([]*model.ProfileLink, len(links))
+	for i, l := range links {
+		result[i] = toGraphQLProfileLink(l)
+	}
+	return result
+}
+
+// toGraphQLProfileLink converts a profile link to its GraphQL model.
+func toGraphQLProfileLink(l *domain.ProfileLink) *model.ProfileLink {
+	return &model.ProfileLink{
+		ID:           l.ID.String(),
+		Kind:         model.LinkKind(strings.ToUpper(string(l.Kind))),
+		URL:          l.URL,
+		Label:        l.Label,
+		DisplayOrder: l.DisplayOrder,
+		Source:       toGraphQLExperienceSource(l.Source),
+		CreatedAt:    l.CreatedAt,
+		UpdatedAt:    l.UpdatedAt,
+	}
+}
+
+// toGraphQLProfileCertifications converts profile certifications to GraphQL models.
+func toGraphQLProfileCertifications(certifications []*domain.ProfileCertification) []*model.ProfileCertification {
+	result := make([]*model.ProfileCertification, len(certifications))
+	for i, c := range certifications {
+		result[i] = toGraphQLProfileCertification(c)
+	}
+	return result
+}
+
+// toGraphQLProfileCertification converts a profile certification to its GraphQL model.
+func toGraphQLProfileCertification(c *domain.ProfileCertification) *model.ProfileCertification {
+	return &model.ProfileCertification{
+		ID:                c.ID.String(),
+		Name:              c.Name,
+		Issuer:            c.Issuer,
+		CredentialID:      c.CredentialID,
+		VerificationURL:   c.VerificationURL,
+		IssueDate:         c.IssueDate,
+		ExpiryDate:        c.ExpiryDate,
+		ParsedExpiryDate:  toGraphQLPartialDate(c.Expiry),
+		Expired:           service.CertificationExpired(c, time.Now()),
+		ExpiringFlaggedAt: c.ExpiringFlaggedAt,
+		DisplayOrder:      c.DisplayOrder,
+		Source:            toGraphQLExperienceSource(c.Source),
+		CreatedAt:         c.CreatedAt,
+		UpdatedAt:         c.UpdatedAt,
+	}
+}
+
+// toGraphQLProfileLanguages converts profile languages to GraphQL models.
+func toGraphQLProfileLanguages(languages []*domain.ProfileLanguage) []*model.ProfileLanguage {
+	result := make([]*model.ProfileLanguage, len(languages))
+	for i, l := range languages {
+		result[i] = toGraphQLProfileLanguage(l)
+	}
+	return result
+}
+
+// toGraphQLProfileLanguage converts a profile language to its GraphQL model.
+func toGraphQLProfileLanguage(l *domain.ProfileLanguage) *model.ProfileLanguage {
+	return &model.ProfileLanguage{
+		ID:           l.ID.String(),
+		Language:     l.Language,
+		Proficiency:  toGraphQLLanguageProficiency(l.Proficiency),
+		DisplayOrder: l.DisplayOrder,
+		Source:       toGraphQLExperienceSource(l.Source),
+		CreatedAt:    l.CreatedAt,
+		UpdatedAt:    l.UpdatedAt,
+	}
+}
+
+// toGraphQLProfileProjects converts profile projects to GraphQL models.
+func toGraphQLProfileProjects(projects []*domain.ProfileProject) []*model.ProfileProject {
+	result := make([]*model.ProfileProject, len(projects))
+	for i, p := range projects {
+		result[i] = toGraphQLProfileProject(p)
+	}
+	return result
+}
+
+// toGraphQLProfileProject converts a profile project to its GraphQL model.
+func toGraphQLProfileProject(p *domain.ProfileProject) *model.ProfileProject {
+	technologies := make([]string, len(p.Technologies))
+	copy(technologies, p.Technologies)
+	return &model.ProfileProject{
+		ID:           p.ID.String(),
+		Name:         p.Name,
+		Role:         p.Role,
+		Description:  p.Description,
+		URL:          p.URL,
+		StartDate:    p.StartDate,
+		EndDate:      p.EndDate,
+		Technologies: technologies,
+		DisplayOrder: p.DisplayOrder,
+		Source:       toGraphQLExperienceSource(p.Source),
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
+
+// toGraphQLProfilePublications converts profile publications to GraphQL models.
+func toGraphQLProfilePublications(publications []*domain.ProfilePublication) []*model.ProfilePublication {
+	result := make([]*model.ProfilePublication, len(publications))
+	for i, p := range publications {
+		result[i] = toGraphQLProfilePublication(p)
+	}
+	return result
+}
+
+// toGraphQLProfilePublication converts a profile publication to its GraphQL model.
+func toGraphQLProfilePublication(p *domain.ProfilePublication) *model.ProfilePublication {
+	return &model.ProfilePublication{
+		ID:           p.ID.String(),
+		Title:        p.Title,
+		Publisher:    p.Publisher,
+		Date:         p.Date,
+		URL:          p.URL,
+		Description:  p.Description,
+		DisplayOrder: p.DisplayOrder,
+		Source:       toGraphQLExperienceSource(p.Source),
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
+
+// toGraphQLProfileAwards converts profile awards to GraphQL models.
+func toGraphQLProfileAwards(awards []*domain.ProfileAward) []*model.ProfileAward {
+	result := make([]*model.ProfileAward, len(awards))
+	for i, a := range awards {
+		result[i] = toGraphQLProfileAward(a)
+	}
+	return result
+}
+
+// toGraphQLProfileAward converts a profile award to its GraphQL model.
+func toGraphQLProfileAward(a *domain.ProfileAward) *model.ProfileAward {
+	return &model.ProfileAward{
+		ID:           a.ID.String(),
+		Title:        a.Title,
+		Issuer:       a.Issuer,
+		Date:         a.Date,
+		Description:  a.Description,
+		DisplayOrder: a.DisplayOrder,
+		Source:       toGraphQLExperienceSource(a.Source),
+		CreatedAt:    a.CreatedAt,
+		UpdatedAt:    a.UpdatedAt,
+	}
+}
+
+// toGraphQLProfileCredibility converts credibility scores to their GraphQL model.
+func toGraphQLProfileCredibility(c *service.ProfileCredibility) *model.ProfileCredibility {
+	result := &model.ProfileCredibility{
+		Profile:     toGraphQLCredibilityScore(c.Profile),
+		Skills:      make([]*model.SkillCredibility, len(c.Skills)),
+		Experiences: make([]*model.ExperienceCredibility, len(c.Experiences)),
+	}
+	for i, s := range c.Skills {
+		result.Skills[i] = &model.SkillCredibility{
+			Skill:       toGraphQLProfileSkill(s.Skill),
+			Credibility: toGraphQLCredibilityScore(s.CredibilityScore),
+		}
+	}
+	for i, e := range c.Experiences {
+		result.Experiences[i] = &model.ExperienceCredibility{
+			Experience:  toGraphQLProfileExperience(e.Experience),
+			Credibility: toGraphQLCredibilityScore(e.CredibilityScore),
+		}
+	}
+	return result
+}
+
+func toGraphQLCredibilityScore(s service.CredibilityScore) *model.CredibilityScore {
+	factors := make([]*model.CredibilityFactor, len(s.Factors))
+	for i, f := range s.Factors {
+		factors[i] = &model.CredibilityFactor{
+			Name:         model.CredibilityFactorName(strings.ToUpper(string(f.Name))),
+			Value:        f.Value,
+			Weight:       f.Weight,
+			Contribution: f.Contribution(),
+			Detail:       f.Detail,
+		}
+	}
+	return &model.CredibilityScore{
+		Score:         s.Score,
+		EvidenceCount: s.EvidenceCount,
+		Factors:       factors,
+	}
+}
+
+// toGraphQLProfileVariant converts a domain ProfileVariant to its GraphQL model. The
+// resolved profile and testimonials are filled by field resolvers.
+func toGraphQLProfileVariant(v *domain.ProfileVariant) *model.ProfileVariant {
+	overrides := make([]*model.ExperienceOverride, len(v.Rules.ExperienceOverrides))
+	for i, o := range v.Rules.ExperienceOverrides {
+		overrides[i] = &model.ExperienceOverride{
+			ExperienceID: o.ExperienceID.String(),
+			Description:  o.Description,
+			Highlights:   o.Highlights,
+		}
+	}
+	return &model.ProfileVariant{
+		ID:                  v.ID.String(),
+		Name:                v.Name,
+		TargetRole:          v.TargetRole,
+		Summary:             v.Summary,
+		HiddenItemIds:       uuidStrings(v.Rules.HiddenItemIDs),
+		ItemOrder:           uuidStrings(v.Rules.ItemOrder),
+		TestimonialIds:      uuidStrings(v.Rules.TestimonialIDs),
+		ExperienceOverrides: overrides,
+		ShareToken:          v.ShareToken,
+		CreatedAt:           v.CreatedAt,
+		UpdatedAt:           v.UpdatedAt,
+	}
+}
+
+func toGraphQLProfileVariants(variants []*domain.ProfileVariant) []*model.ProfileVariant {
+	result := make([]*model.ProfileVariant, len(variants))
+	for i, v := range variants {
+		result[i] = toGraphQLProfileVariant(v)
+	}
+	return result
+}
+
+func uuidStrings(ids []uuid.UUID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result
+}
+
+// toGraphQLJobDescription converts a saved job description to its GraphQL model, with the
+// requirements decoded from its extracted data.
+func toGraphQLJobDescription(jd *domain.JobDescription) *model.JobDescription {
+	var data domain.ExtractedJobDescriptionData
+	// Leave the requirements empty for graceful degradation on parse errors
+	_ = json.Unmarshal(jd.ExtractedData, &data)
+
+	result := &model.JobDescription{
+		ID:                 jd.ID.String(),
+		Title:              jd.Title,
+		Company:            jd.Company,
+		Text:               jd.Text,
+		MinYearsExperience: data.MinYearsExperience,
+		Domain:             data.Domain,
+		RequiredSkills:     data.RequiredSkills,
+		PreferredSkills:    data.PreferredSkills,
+		CreatedAt:          jd.CreatedAt,
+	}
+	if data.Seniority != nil {
+		seniority := model.JobSeniority(strings.ToUpper(string(*data.Seniority)))
+		result.Seniority = &seniority
+	}
+	if result.RequiredSkills == nil {
+		result.RequiredSkills = []string{}
+	}
+	if result.PreferredSkills == nil {
+		result.PreferredSkills = []string{}
+	}
+	return result
+}
+
+func toGraphQLJobDescriptions(jds []*domain.JobDescription) []*model.JobDescription {
+	result := make([]*model.JobDescription, len(jds))
+	for i, jd := range jds {
+		result[i] = toGraphQLJobDescription(jd)
+	}
+	return result
+}
+
+func toGraphQLJobMatch(m *service.JobMatch) *model.JobMatch {
+	return &model.JobMatch{
+		JobDescription:    toGraphQLJobDescription(m.JobDescription),
+		Score:             m.Score,
+		YearsOfExperience: m.YearsOfExperience,
+		Matched:           toGraphQLJobRequirementMatches(m.Matched),
+		Gaps:              toGraphQLJobRequirementMatches(m.Gaps),
+	}
+}
+
+func toGraphQLJobRequirementMatches(reqs []*service.JobRequirementMatch) []*model.JobRequirementMatch {
+	result := make([]*model.JobRequirementMatch, len(reqs))
+	for i, req := range reqs {
+		testimonials := make([]*model.Testimonial, len(req.Testimonials))
+		for j, t := range req.Testimonials {
+			testimonials[j] = toGraphQLTestimonial(t, nil, nil)
+		}
+		result[i] = &model.JobRequirementMatch{
+			Kind:            model.JobRequirementKind(strings.ToUpper(string(req.Kind))),
+			Requirement:     req.Requirement,
+			Status:          model.JobRequirementStatus(strings.ToUpper(string(req.Status))),
+			Credit:          req.Credit,
+			Detail:          req.Detail,
+			Skill:           toGraphQLProfileSkill(req.Skill),
+			ValidationCount: req.ValidationCount,
+			Experiences:     toGraphQLProfileExperiences(req.Experiences),
+			Testimonials:    testimonials,
+		}
+	}
+	return result
+}
+
+// toGraphQLProfileInconsistency converts a domain ProfileInconsistency to its GraphQL model.
+// The experience and reference letter are resolved by field resolvers.
+func toGraphQLProfileInconsistency(i *domain.ProfileInconsistency) *model.ProfileInconsistency {
+	return &model.ProfileInconsistency{
+		ID:             i.ID.String(),
+		Field:          model.InconsistencyField(strings.ToUpper(string(i.Field))),
+		ResumeValue:    i.ResumeValue,
+		LetterValue:    i.LetterValue,
+		Quote:          i.Quote,
+		Status:         model.InconsistencyStatus(strings.ToUpper(string(i.Status))),
+		ResolutionNote: i.ResolutionNote,
+		ResolvedAt:     i.ResolvedAt,
+		CreatedAt:      i.CreatedAt,
+	}
+}
+
+// toGraphQLWritingSuggestion converts a domain writing suggestion to its GraphQL model.
+// The experience is resolved by a field resolver.
+func toGraphQLWritingSuggestion(s *domain.WritingSuggestion) *model.WritingSuggestion {
+	drafts := make([]*model.WritingDraft, len(s.Drafts))
+	for i, d := range s.Drafts {
+		drafts[i] = &model.WritingDraft{Text: d.Text, Citations: toGraphQLWritingCitations(d.Citations)}
+	}
+	return &model.WritingSuggestion{
+		ID:           s.ID.String(),
+		Kind:         model.WritingSuggestionKind(strings.ToUpper(string(s.Kind))),
+		Drafts:       drafts,
+		Status:       model.WritingSuggestionStatus(strings.ToUpper(string(s.Status))),
+		ModelVersion: s.ModelVersion,
+		DecidedAt:    s.DecidedAt,
+		CreatedAt:    s.CreatedAt,
+	}
+}
+
+// toGraphQLWritingSuggestions converts domain writing suggestions to GraphQL models.
+func toGraphQLWritingSuggestions(suggestions []*domain.WritingSuggestion) []*model.WritingSuggestion {
+	result := make([]*model.WritingSuggestion, len(suggestions))
+	for i, s := range suggestions {
+		result[i] = toGraphQLWritingSuggestion(s)
+	}
+	return result
+}
+
+// toGraphQLProfileChange converts a profile change log entry to its GraphQL model.
+func toGraphQLProfileChange(c *domain.ProfileChange) *model.ProfileChange {
+	result := &model.ProfileChange{
+		ID:         c.ID.String(),
+		EntityType: model.ProfileChangeEntity(strings.ToUpper(string(c.EntityType))),
+		EntityID:   c.EntityID.String(),
+		Action:     model.ProfileChangeAction(strings.ToUpper(string(c.Action))),
+		Field:      c.Field,
+		Source:     model.ProfileChangeSource(strings.ToUpper(string(c.Source))),
+		CreatedAt:  c.CreatedAt,
+	}
+	if len(c.OldValue) > 0 {
+		result.OldValue = stringPtr(string(c.OldValue))
+	}
+	if len(c.NewValue) > 0 {
+		result.NewValue = stringPtr(string(c.NewValue))
+	}
+	if c.ActorID != nil {
+		result.ActorID = stringPtr(c.ActorID.String())
+	}
+	if c.RevertsChangeID != nil {
+		result.RevertsChangeID = stringPtr(c.RevertsChangeID.String())
+	}
+	return result
+}
+
+// toGraphQLProfileChanges converts a list of profile changes to their GraphQL models.
+func toGraphQLProfileChanges(changes []*domain.ProfileChange) []*model.ProfileChange {
+	result := make([]*model.ProfileChange, len(changes))
+	for i, c := range changes {
+		result[i] = toGraphQLProfileChange(c)
+	}
+	return result
+}
+
+// toGraphQLResumeMergePreview converts a resume merge diff to its GraphQL model.
+func toGraphQLResumeMergePreview(diff *service.ResumeMergeDiff) *model.ResumeMergePreview {
+	changes := make([]*model.ResumeMergeChange, len(diff.Changes))
+	for i, c := range diff.Changes {
+		changes[i] = &model.ResumeMergeChange{
+			ID:             c.ID,
+			Action:         model.MergeChangeAction(strings.ToUpper(string(c.Action))),
+			EntityType:     model.ProfileChangeEntity(strings.ToUpper(string(c.EntityType))),
+			ExtractedIndex: c.ExtractedIndex,
+			Label:          c.Label,
+			FieldChanges:   make([]*model.ResumeMergeFieldChange, len(c.Fields)),
+		}
+		if c.EntityID != nil {
+			changes[i].EntityID = stringPtr(c.EntityID.String())
+		}
+		for j, f := range c.Fields {
+			changes[i].FieldChanges[j] = &model.ResumeMergeFieldChange{
+				Field:          f.Field,
+				ExtractedValue: string(f.ExtractedValue),
+				ManuallyEdited: f.ManuallyEdited,
+			}
+			if len(f.CurrentValue) > 0 {
+				changes[i].FieldChanges[j].CurrentValue = stringPtr(string(f.CurrentValue))
+			}
+		}
+	}
+	return &model.ResumeMergePreview{
+		ResumeID:       diff.ResumeID.String(),
+		Changes:        changes,
+		UnchangedCount: diff.Unchanged,
+	}
+}
+
+// toGraphQLFieldProvenance converts the provenance of an item's fields to GraphQL models.
+func toGraphQLFieldProvenance(provenance []service.FieldProvenance) []*model.FieldProvenance {
+	result := make([]*model.FieldProvenance, len(provenance))
+	for i, p := range provenance {
+		result[i] = &model.FieldProvenance{
+			Field:  p.Field,
+			Origin: model.ExperienceSource(strings.ToUpper(string(p.Origin))),
+		}
+		if p.SourceResumeID != nil {
+			result[i].SourceResumeID = stringPtr(p.SourceResumeID.String())
+		}
+		if p.SourceReferenceLetterID != nil {
+			result[i].SourceReferenceLetterID = stringPtr(p.SourceReferenceLetterID.String())
+		}
+		if len(p.ExtractedValue) > 0 {
+			result[i].ExtractedValue = stringPtr(string(p.ExtractedValue))
+		}
+	}
+	return result
+}
+
+// toGraphQLProfileSnapshot converts a reconstructed profile to its GraphQL model.
+func toGraphQLProfileSnapshot(s *service.ProfileSnapshot) *model.ProfileSnapshot {
+	return &model.ProfileSnapshot{
+		At:          s.At,
+		Name:        s.Profile.Name,
+		Headline:    s.Profile.Headline,
+		Email:       s.Profile.Email,
+		Phone:       s.Profile.Phone,
+		Location:    s.Profile.Location,
+		Summary:     s.Profile.Summary,
+		Experiences: toGraphQLProfileExperiences(s.Experiences),
+		Educations:  toGraphQLProfileEducations(s.Educations),
+		Skills:      toGraphQLProfileSkills(s.Skills),
+	}
+}
+
+// toGraphQLDuplicateSuggestions converts duplicate clusters to their GraphQL model.
+func toGraphQLDuplicateSuggestions(clusters []*service.DuplicateCluster) []*model.DuplicateSuggestion {
+	result := make([]*model.DuplicateSuggestion, len(clusters))
+	for i, c := range clusters {
+		suggestion := &model.DuplicateSuggestion{
+			EntityType:        model.ProfileChangeEntity(strings.ToUpper(string(c.EntityType))),
+			Experiences:       toGraphQLProfileExperiences(c.Experiences),
+			Educations:        toGraphQLProfileEducations(c.Educations),
+			SuggestedTargetID: c.Target.String(),
+			Reasons:           make([]model.DuplicateReason, len(c.Reasons)),
+		}
+		for j, reason := range c.Reasons {
+			suggestion.Reasons[j] = model.DuplicateReason(strings.ToUpper(string(reason)))
+		}
+		result[i] = suggestion
+	}
+	return result
+}
+
+// toGraphQLCareerTimeline converts a career timeline to its GraphQL model.
+func toGraphQLCareerTimeline(t *service.CareerTimeline, now time.Time) *model.CareerTimeline {
+	result := &model.CareerTimeline{
+		Entries:               make([]*model.CareerTimelineEntry, len(t.Entries)),
+		Gaps:                  make([]*model.CareerGap, len(t.Gaps)),
+		Overlaps:              make([]*model.RoleOverlap, len(t.Overlaps)),
+		TotalExperienceMonths: t.TotalExperienceMonths,
+		AverageTenureMonths:   t.AverageTenureMonths,
+		CurrentRole:           toGraphQLProfileExperience(t.CurrentRole),
+		CurrentRoleMonths:     t.CurrentRoleMonths,
+	}
+	for i, e := range t.Entries {
+		entry := &model.CareerTimelineEntry{
+			Experience:     toGraphQLProfileExperience(e.Experience),
+			Education:      toGraphQLProfileEducation(e.Education),
+			Ongoing:        e.Dates.Ongoing || e.Dates.End.IsPresent(),
+			DurationMonths: durationMonths(e.Dates),
+		}
+		if first, ok := e.Dates.Start.FirstMonth(); ok {
+			entry.StartMonth = stringPtr(formatMonth(first))
+		}
+		if last, ok := e.Dates.LastMonth(now); ok {
+			entry.EndMonth = stringPtr(formatMonth(last))
+		}
+		result.Entries[i] = entry
+	}
+	for i, g := range t.Gaps {
+		result.Gaps[i] = &model.CareerGap{
+			StartMonth:      formatMonth(g.StartMonth),
+			EndMonth:        formatMonth(g.EndMonth),
+			Months:          g.Months(),
+			PreviousRole:    toGraphQLProfileExperience(g.Previous),
+			NextRole:        toGraphQLProfileExperience(g.Next),
+			DuringEducation: g.DuringEducation,
+			Note:            g.Previous.GapNote,
+		}
+	}
+	for i, o := range t.Overlaps {
+		result.Overlaps[i] = &model.RoleOverlap{
+			First:      toGraphQLProfileExperience(o.First),
+			Second:     toGraphQLProfileExperience(o.Second),
+			StartMonth: formatMonth(o.StartMonth),
+			EndMonth:   formatMonth(o.EndMonth),
+			Months:     o.Months(),
+		}
+	}
+	return result
+}
+
+// formatMonth formats a month index (see domain.MonthIndex) as YYYY-MM.
+func formatMonth(index int) string {
+	return domain.MonthStart(index).Format("2006-01")
+}
+
 // toGraphQLProfileSkill converts a domain ProfileSkill to a GraphQL ProfileSkill model.
 func toGraphQLProfileSkill(s *domain.ProfileSkill) *model.ProfileSkill {
 	if s == nil {
@@ -533,15 +1538,30 @@ func toGraphQLProfileSkill(s *domain.ProfileSkill) *model.ProfileSkill {
 		source = model.ExperienceSourceManual
 	}
 
+	aliases := s.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+
+	var lastUsed *string
+	if s.LastUsed != nil {
+		month := s.LastUsed.Format("2006-01")
+		lastUsed = &month
+	}
+
 	return &model.ProfileSkill{
-		ID:             s.ID.String(),
-		Name:           s.Name,
-		NormalizedName: s.NormalizedName,
-		Category:       domain.SkillCategory(strings.ToUpper(s.Category)),
-		DisplayOrder:   s.DisplayOrder,
-		Source:         source,
-		CreatedAt:      s.CreatedAt,
-		UpdatedAt:      s.UpdatedAt,
+		ID:                s.ID.String(),
+		Name:              s.Name,
+		NormalizedName:    s.NormalizedName,
+		Category:          domain.SkillCategory(strings.ToUpper(s.Category)),
+		DisplayOrder:      s.DisplayOrder,
+		Source:            source,
+		Aliases:           aliases,
+		YearsOfExperience: s.YearsOfExperience,
+		LastUsed:          lastUsed,
+		EvidenceStrength:  s.EvidenceStrength,
+		CreatedAt:         s.CreatedAt,
+		UpdatedAt:         s.UpdatedAt,
 	}
 }
 
@@ -557,17 +1577,67 @@ func toGraphQLProfileSkills(skills []*domain.ProfileSkill) []*model.ProfileSkill
 	return result
 }
 
+// toGraphQLCanonicalSkill converts a taxonomy skill to its GraphQL model, including its
+// parent chain as resolved by the taxonomy.
+func toGraphQLCanonicalSkill(tax *taxonomy.Taxonomy, s *domain.Skill) *model.CanonicalSkill {
+	if s == nil {
+		return nil
+	}
+	chain := append([]*domain.Skill{s}, tax.Ancestors(s)...)
+	nodes := make([]*model.CanonicalSkill, len(chain))
+	for i := len(chain) - 1; i >= 0; i-- {
+		skill := chain[i]
+		synonyms := skill.Synonyms
+		if synonyms == nil {
+			synonyms = []string{}
+		}
+		node := &model.CanonicalSkill{
+			ID:        skill.ID.String(),
+			Slug:      skill.Slug,
+			Name:      skill.Name,
+			Category:  domain.SkillCategory(strings.ToUpper(skill.Category)),
+			Synonyms:  synonyms,
+			Ancestors: nodes[i+1:],
+			Custom:    skill.IsCustom(),
+		}
+		if i+1 < len(nodes) {
+			node.Parent = nodes[i+1]
+		}
+		nodes[i] = node
+	}
+	return nodes[0]
+}
+
+// toGraphQLCanonicalSkills converts every skill in a taxonomy, ordered by name.
+func toGraphQLCanonicalSkills(tax *taxonomy.Taxonomy) []*model.CanonicalSkill {
+	skills := append([]*domain.Skill(nil), tax.Skills()...)
+	sort.Slice(skills, func(i, j int) bool {
+		return strings.ToLower(skills[i].Name) < strings.ToLower(skills[j].Name)
+	})
+	result := make([]*model.CanonicalSkill, len(skills))
+	for i, s := range skills {
+		result[i] = toGraphQLCanonicalSkill(tax, s)
+	}
+	return result
+}
+
 // mapAuthorToTestimonialRelationship maps an AuthorRelationship to a TestimonialRelationship.
 func mapAuthorToTestimonialRelationship(ar domain.AuthorRelationship) domain.TestimonialRelationship {
 	switch ar {
 	case domain.AuthorRelationshipManager:
 		return domain.TestimonialRelationshipManager
-	case domain.AuthorRelationshipPeer, domain.AuthorRelationshipColleague:
+	case domain.AuthorRelationshipPeer:
 		return domain.TestimonialRelationshipPeer
+	case domain.AuthorRelationshipColleague:
+		return domain.TestimonialRelationshipColleague
 	case domain.AuthorRelationshipDirectReport:
 		return domain.TestimonialRelationshipDirectReport
 	case domain.AuthorRelationshipClient:
 		return domain.TestimonialRelationshipClient
+	case domain.AuthorRelationshipMentor:
+		return domain.TestimonialRelationshipMentor
+	case domain.AuthorRelationshipProfessor:
+		return domain.TestimonialRelationshipProfessor
 	default:
 		return domain.TestimonialRelationshipOther
 	}
@@ -591,6 +1661,14 @@ func toGraphQLTestimonial(t *domain.Testimonial, referenceLetter *model.Referenc
 		relationship = model.TestimonialRelationshipDirectReport
 	case domain.TestimonialRelationshipClient:
 		relationship = model.TestimonialRelationshipClient
+	case domain.TestimonialRelationshipMentor:
+		relationship = model.TestimonialRelationshipMentor
+	case domain.TestimonialRelationshipProfessor:
+		relationship = model.TestimonialRelationshipProfessor
+	case domain.TestimonialRelationshipColleague:
+		relationship = model.TestimonialRelationshipColleague
+	case domain.TestimonialRelationshipPerformanceReview:
+		relationship = model.TestimonialRelationshipPerformanceReview
 	default:
 		relationship = model.TestimonialRelationshipOther
 	}
@@ -680,3 +1758,89 @@ func filterSkillsByMentioned(skills []*model.ProfileSkill, skillsMentioned []str
 	}
 	return filtered
 }
+
+func toGraphQLWritingCitations(citations []domain.WritingCitation) []*model.WritingCitation {
+	result := make([]*model.WritingCitation, len(citations))
+	for i, c := range citations {
+		result[i] = &model.WritingCitation{
+			Kind:     model.WritingSourceKind(strings.ToUpper(string(c.Kind))),
+			SourceID: c.ID.String(),
+		}
+	}
+	return result
+}
+
+// toGraphQLCoverLetter converts a domain CoverLetter to its GraphQL model. The job
+// description and versions are loaded by field resolvers.
+func toGraphQLCoverLetter(l *domain.CoverLetter) *model.CoverLetter {
+	return &model.CoverLetter{
+		ID:        l.ID.String(),
+		Title:     l.Title,
+		CreatedAt: l.CreatedAt,
+		UpdatedAt: l.UpdatedAt,
+	}
+}
+
+func toGraphQLCoverLetters(letters []*domain.CoverLetter) []*model.CoverLetter {
+	result := make([]*model.CoverLetter, len(letters))
+	for i, l := range letters {
+		result[i] = toGraphQLCoverLetter(l)
+	}
+	return result
+}
+
+func toGraphQLCoverLetterVersion(v *domain.CoverLetterVersion) *model.CoverLetterVersion {
+	quotes := make([]*model.CoverLetterQuote, len(v.Quotes))
+	for i, q := range v.Quotes {
+		quotes[i] = &model.CoverLetterQuote{TestimonialID: q.TestimonialID.String(), Text: q.Text}
+	}
+	return &model.CoverLetterVersion{
+		ID:           v.ID.String(),
+		Version:      v.Version,
+		Body:         v.Body,
+		Citations:    toGraphQLWritingCitations(v.Citations),
+		Quotes:       quotes,
+		Source:       model.CoverLetterVersionSource(strings.ToUpper(string(v.Source))),
+		ModelVersion: v.ModelVersion,
+		CreatedAt:    v.CreatedAt,
+	}
+}
+
+func toGraphQLCoverLetterVersions(versions []*domain.CoverLetterVersion) []*model.CoverLetterVersion {
+	result := make([]*model.CoverLetterVersion, len(versions))
+	for i, v := range versions {
+		result[i] = toGraphQLCoverLetterVersion(v)
+	}
+	return result
+}
+
+func toGraphQLProfileAnswer(question string, a *domain.ProfileAnswer) *model.ProfileAnswer {
+	citations := make([]*model.EvidenceCitation, len(a.Citations))
+	for i, c := range a.Citations {
+		citations[i] = &model.EvidenceCitation{
+			Kind:     model.EvidenceKind(strings.ToUpper(string(c.Kind))),
+			SourceID: c.ID.String(),
+			Quote:    c.Quote,
+		}
+	}
+	return &model.ProfileAnswer{
+		Question:     question,
+		Answer:       a.Answer,
+		Supported:    a.Supported,
+		Citations:    citations,
+		ModelVersion: a.Metadata.ModelVersion,
+	}
+}
+
+func toGraphQLEvidenceSearchResults(matches []*domain.EvidenceMatch) []*model.EvidenceSearchResult {
+	result := make([]*model.EvidenceSearchResult, len(matches))
+	for i, m := range matches {
+		result[i] = &model.EvidenceSearchResult{
+			Kind:     model.EvidenceKind(strings.ToUpper(string(m.Embedding.SourceKind))),
+			SourceID: m.Embedding.SourceID.String(),
+			Text:     m.Embedding.Content,
+			Score:    m.Score,
+		}
+	}
+	return result
+}
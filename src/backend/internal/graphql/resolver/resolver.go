@@ -3,12 +3,21 @@ package resolver
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
 	"backend/internal/domain"
 	"backend/internal/logger"
 	"backend/internal/service"
+	"backend/internal/taxonomy"
 
 	model "backend/internal/graphql/model"
 )
@@ -33,15 +42,47 @@ type Resolver struct {
 	testimonialRepo       domain.TestimonialRepository
 	skillValidationRepo   domain.SkillValidationRepository
 	expValidationRepo     domain.ExperienceValidationRepository
+	eduValidationRepo     domain.EducationValidationRepository
+	companyAliasRepo      domain.CompanyAliasRepository
+	companyRepo           domain.CompanyRepository
+	skillRepo             domain.SkillRepository
+	credentialDocRepo     domain.CredentialDocumentRepository
+	inconsistencyRepo     domain.ProfileInconsistencyRepository
+	changeRepo            domain.ProfileChangeRepository
+	linkRepo              domain.ProfileLinkRepository
+	certificationRepo     domain.ProfileCertificationRepository
+	languageRepo          domain.ProfileLanguageRepository
+	projectRepo           domain.ProfileProjectRepository
+	publicationRepo       domain.ProfilePublicationRepository
+	awardRepo             domain.ProfileAwardRepository
+	jobDescriptionRepo    domain.JobDescriptionRepository
+	profileVariantRepo    domain.ProfileVariantRepository
+	writingSuggestionRepo domain.WritingSuggestionRepository
+	coverLetterRepo       domain.CoverLetterRepository
 	storage               domain.Storage
 	jobEnqueuer           domain.JobEnqueuer
 	documentExtractor     domain.DocumentExtractor
+	profileWriter         domain.ProfileWriter
 	materializationSvc    *service.MaterializationService
+	embeddingSvc          *service.EmbeddingService
+	skillStats            *service.SkillStatsService
+	credibility           *service.CredibilityService
+	history               *service.HistoryService
+	dedup                 *service.DedupService
+	jobMatch              *service.JobMatchService
+	writing               *service.WritingAssistantService
+	coverLetters          *service.CoverLetterService
+	profileQA             *service.ProfileQAService
+	certExpiryWindow      time.Duration
 	log                   logger.Logger
 }
 
 // NewResolver creates a new Resolver with the given repositories.
+// The db parameter is optional (can be nil) for testing with mocks; it makes undoing
+// profile changes transactional. certExpiryWindow is how far ahead a certification's
+// expiry counts as expiring.
 func NewResolver(
+	db *bun.DB,
 	userRepo domain.UserRepository,
 	fileRepo domain.FileRepository,
 	refLetterRepo domain.ReferenceLetterRepository,
@@ -54,12 +95,33 @@ func NewResolver(
 	testimonialRepo domain.TestimonialRepository,
 	skillValidationRepo domain.SkillValidationRepository,
 	expValidationRepo domain.ExperienceValidationRepository,
+	eduValidationRepo domain.EducationValidationRepository,
+	companyAliasRepo domain.CompanyAliasRepository,
+	companyRepo domain.CompanyRepository,
+	skillRepo domain.SkillRepository,
+	credentialDocRepo domain.CredentialDocumentRepository,
+	inconsistencyRepo domain.ProfileInconsistencyRepository,
+	changeRepo domain.ProfileChangeRepository,
+	linkRepo domain.ProfileLinkRepository,
+	certificationRepo domain.ProfileCertificationRepository,
+	languageRepo domain.ProfileLanguageRepository,
+	projectRepo domain.ProfileProjectRepository,
+	publicationRepo domain.ProfilePublicationRepository,
+	awardRepo domain.ProfileAwardRepository,
+	jobDescriptionRepo domain.JobDescriptionRepository,
+	profileVariantRepo domain.ProfileVariantRepository,
+	writingSuggestionRepo domain.WritingSuggestionRepository,
+	coverLetterRepo domain.CoverLetterRepository,
 	storage domain.Storage,
 	jobEnqueuer domain.JobEnqueuer,
 	documentExtractor domain.DocumentExtractor,
+	profileWriter domain.ProfileWriter,
 	materializationSvc *service.MaterializationService,
+	embeddingSvc *service.EmbeddingService,
+	certExpiryWindow time.Duration,
 	log logger.Logger,
 ) *Resolver {
+	jobMatch := service.NewJobMatchService(documentExtractor, jobDescriptionRepo, profileSkillRepo, profileExpRepo, testimonialRepo, skillValidationRepo, skillRepo)
 	return &Resolver{
 		userRepo:              userRepo,
 		fileRepo:              fileRepo,
@@ -73,10 +135,38 @@ func NewResolver(
 		testimonialRepo:       testimonialRepo,
 		skillValidationRepo:   skillValidationRepo,
 		expValidationRepo:     expValidationRepo,
+		eduValidationRepo:     eduValidationRepo,
+		companyAliasRepo:      companyAliasRepo,
+		companyRepo:           companyRepo,
+		skillRepo:             skillRepo,
+		credentialDocRepo:     credentialDocRepo,
+		inconsistencyRepo:     inconsistencyRepo,
+		changeRepo:            changeRepo,
+		linkRepo:              linkRepo,
+		certificationRepo:     certificationRepo,
+		languageRepo:          languageRepo,
+		projectRepo:           projectRepo,
+		publicationRepo:       publicationRepo,
+		awardRepo:             awardRepo,
+		jobDescriptionRepo:    jobDescriptionRepo,
+		profileVariantRepo:    profileVariantRepo,
+		writingSuggestionRepo: writingSuggestionRepo,
+		coverLetterRepo:       coverLetterRepo,
 		storage:               storage,
 		jobEnqueuer:           jobEnqueuer,
 		documentExtractor:     documentExtractor,
+		profileWriter:         profileWriter,
 		materializationSvc:    materializationSvc,
+		embeddingSvc:          embeddingSvc,
+		skillStats:            service.NewSkillStatsService(profileExpRepo, profileSkillRepo, testimonialRepo, authorRepo, skillValidationRepo, skillRepo),
+		credibility:           service.NewCredibilityService(profileRepo, profileSkillRepo, profileExpRepo, refLetterRepo, testimonialRepo, authorRepo, skillValidationRepo, expValidationRepo),
+		history:               service.NewHistoryService(db, profileRepo, profileExpRepo, profileEduRepo, profileSkillRepo, skillRepo, companyAliasRepo, companyRepo, changeRepo, linkRepo, certificationRepo, languageRepo, projectRepo, publicationRepo, awardRepo),
+		dedup:                 service.NewDedupService(profileExpRepo, profileEduRepo, companyAliasRepo),
+		jobMatch:              jobMatch,
+		writing:               service.NewWritingAssistantService(profileWriter, profileExpRepo, profileSkillRepo, testimonialRepo, writingSuggestionRepo),
+		coverLetters:          service.NewCoverLetterService(profileWriter, jobMatch, profileExpRepo, profileSkillRepo, testimonialRepo, coverLetterRepo),
+		profileQA:             service.NewProfileQAService(profileWriter, embeddingSvc, profileSkillRepo, profileExpRepo, testimonialRepo, skillValidationRepo, expValidationRepo),
+		certExpiryWindow:      certExpiryWindow,
 		log:                   log,
 	}
 }
@@ -118,5 +208,870 @@ func (r *queryResolver) loadProfileData(ctx context.Context, profile *domain.Pro
 		}
 	}
 
-	return toGraphQLProfile(profile, gqlUser, gqlExperiences, gqlEducations, gqlSkills, photoURL), nil
+	gqlProfile := toGraphQLProfile(profile, gqlUser, gqlExperiences, gqlEducations, gqlSkills, photoURL)
+	if err := r.loadProfileSections(ctx, profile.ID, gqlProfile); err != nil {
+		return nil, err
+	}
+	return gqlProfile, nil
+}
+
+// loadProfileSections fills the profile's links, certifications, languages, projects,
+// publications and awards.
+func (r *Resolver) loadProfileSections(ctx context.Context, profileID uuid.UUID, profile *model.Profile) error {
+	links, err := r.linkRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("failed to get links for profile: %w", err)
+	}
+	certifications, err := r.certificationRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("failed to get certifications for profile: %w", err)
+	}
+	languages, err := r.languageRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("failed to get languages for profile: %w", err)
+	}
+	projects, err := r.projectRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("failed to get projects for profile: %w", err)
+	}
+	publications, err := r.publicationRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("failed to get publications for profile: %w", err)
+	}
+	awards, err := r.awardRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("failed to get awards for profile: %w", err)
+	}
+
+	profile.Links = toGraphQLProfileLinks(links)
+	profile.Certifications = toGraphQLProfileCertifications(certifications)
+	profile.Languages = toGraphQLProfileLanguages(languages)
+	profile.Projects = toGraphQLProfileProjects(projects)
+	profile.Publications = toGraphQLProfilePublications(publications)
+	profile.Awards = toGraphQLProfileAwards(awards)
+	return nil
+}
+
+// loadProfileVariant returns the profile a variant resolves to: the current base profile
+// with the variant's hidden items left out, its ordered items first and its summary and
+// experience overrides applied. Returns nil if the base profile no longer exists.
+func (r *Resolver) loadProfileVariant(ctx context.Context, variant *domain.ProfileVariant) (*model.Profile, error) {
+	base, err := r.profileRepo.GetByID(ctx, variant.ProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	if base == nil {
+		return nil, nil
+	}
+	profile, err := (&queryResolver{r}).loadProfileData(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+
+	if variant.Summary != nil {
+		profile.Summary = variant.Summary
+	}
+	for _, exp := range profile.Experiences {
+		id, err := uuid.Parse(exp.ID)
+		if err != nil {
+			continue
+		}
+		if o := variant.ExperienceOverride(id); o != nil {
+			if o.Description != nil {
+				exp.Description = o.Description
+			}
+			if o.Highlights != nil {
+				exp.Highlights = o.Highlights
+				exp.HighlightDetails = toGraphQLExperienceHighlights(o.Highlights)
+			}
+		}
+	}
+	profile.Experiences = applyVariant(variant, profile.Experiences, func(e *model.ProfileExperience) string { return e.ID })
+	profile.Educations = applyVariant(variant, profile.Educations, func(e *model.ProfileEducation) string { return e.ID })
+	profile.Skills = applyVariant(variant, profile.Skills, func(s *model.ProfileSkill) string { return s.ID })
+	profile.Links = applyVariant(variant, profile.Links, func(l *model.ProfileLink) string { return l.ID })
+	profile.Certifications = applyVariant(variant, profile.Certifications, func(c *model.ProfileCertification) string { return c.ID })
+	profile.Languages = applyVariant(variant, profile.Languages, func(l *model.ProfileLanguage) string { return l.ID })
+	profile.Projects = applyVariant(variant, profile.Projects, func(p *model.ProfileProject) string { return p.ID })
+	profile.Publications = applyVariant(variant, profile.Publications, func(p *model.ProfilePublication) string { return p.ID })
+	profile.Awards = applyVariant(variant, profile.Awards, func(a *model.ProfileAward) string { return a.ID })
+	return profile, nil
+}
+
+// variantTestimonials returns the testimonials a variant shows: those it selects, in its
+// order, or all of the profile's when it selects none.
+func (r *Resolver) variantTestimonials(ctx context.Context, variant *domain.ProfileVariant) ([]*model.Testimonial, error) {
+	testimonials, err := (&queryResolver{r}).Testimonials(ctx, variant.ProfileID.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(variant.Rules.TestimonialIDs) == 0 {
+		return testimonials, nil
+	}
+
+	byID := make(map[string]*model.Testimonial, len(testimonials))
+	for _, t := range testimonials {
+		byID[t.ID] = t
+	}
+	selected := make([]*model.Testimonial, 0, len(variant.Rules.TestimonialIDs))
+	for _, id := range variant.Rules.TestimonialIDs {
+		if t, ok := byID[id.String()]; ok {
+			selected = append(selected, t)
+		}
+	}
+	return selected, nil
+}
+
+// variantExportDocument lays out a profile a variant resolves to and the testimonials it
+// shows as a document to export, one section per non-empty part of the profile.
+func variantExportDocument(variant *domain.ProfileVariant, profile *model.Profile, testimonials []*model.Testimonial) *service.ExportDocument {
+	title := variant.Name
+	if profile.Name != nil && *profile.Name != "" {
+		title = *profile.Name + " – " + variant.Name
+	}
+	doc := &service.ExportDocument{Title: title}
+	add := func(heading string, paragraphs []string) {
+		if len(paragraphs) > 0 {
+			doc.Sections = append(doc.Sections, service.ExportSection{Heading: heading, Paragraphs: paragraphs})
+		}
+	}
+
+	header := exportLines(
+		derefString(profile.Name),
+		derefString(profile.Headline),
+		strings.Join(exportLines(derefString(profile.Email), derefString(profile.Phone), derefString(profile.Location)), " · "),
+	)
+	if len(header) > 0 {
+		add("", []string{strings.Join(header, "\n")})
+	}
+	if profile.Summary != nil && strings.TrimSpace(*profile.Summary) != "" {
+		add("Summary", []string{strings.TrimSpace(*profile.Summary)})
+	}
+
+	var paragraphs []string
+	for _, e := range profile.Experiences {
+		lines := exportLines(e.Title+", "+e.Company, exportDates(e.StartDate, e.EndDate, e.IsCurrent), derefString(e.Location), derefString(e.Description))
+		for _, h := range e.Highlights {
+			lines = append(lines, "- "+h)
+		}
+		paragraphs = append(paragraphs, strings.Join(lines, "\n"))
+	}
+	add("Experience", paragraphs)
+
+	paragraphs = nil
+	for _, e := range profile.Educations {
+		degree := e.Degree
+		if e.Field != nil && *e.Field != "" {
+			degree += " in " + *e.Field
+		}
+		paragraphs = append(paragraphs, strings.Join(exportLines(degree+", "+e.Institution, exportDates(e.StartDate, e.EndDate, e.IsCurrent), derefString(e.Description)), "\n"))
+	}
+	add("Education", paragraphs)
+
+	if len(profile.Skills) > 0 {
+		names := make([]string, len(profile.Skills))
+		for i, skill := range profile.Skills {
+			names[i] = skill.Name
+		}
+		add("Skills", []string{strings.Join(names, ", ")})
+	}
+
+	paragraphs = nil
+	for _, p := range profile.Projects {
+		name := p.Name
+		if p.Role != nil && *p.Role != "" {
+			name += ", " + *p.Role
+		}
+		lines := exportLines(name, exportDates(p.StartDate, p.EndDate, false), derefString(p.URL), derefString(p.Description))
+		if len(p.Technologies) > 0 {
+			lines = append(lines, "Technologies: "+strings.Join(p.Technologies, ", "))
+		}
+		paragraphs = append(paragraphs, strings.Join(lines, "\n"))
+	}
+	add("Projects", paragraphs)
+
+	paragraphs = nil
+	for _, c := range profile.Certifications {
+		paragraphs = append(paragraphs, strings.Join(exportLines(c.Name, derefString(c.Issuer), exportDates(c.IssueDate, c.ExpiryDate, false), derefString(c.VerificationURL)), "\n"))
+	}
+	add("Certifications", paragraphs)
+
+	paragraphs = nil
+	for _, l := range profile.Languages {
+		line := l.Language
+		if l.Proficiency != nil {
+			line += " (" + strings.ToLower(string(*l.Proficiency)) + ")"
+		}
+		paragraphs = append(paragraphs, line)
+	}
+	add("Languages", paragraphs)
+
+	paragraphs = nil
+	for _, p := range profile.Publications {
+		paragraphs = append(paragraphs, strings.Join(exportLines(p.Title, derefString(p.Publisher), derefString(p.Date), derefString(p.URL), derefString(p.Description)), "\n"))
+	}
+	add("Publications", paragraphs)
+
+	paragraphs = nil
+	for _, a := range profile.Awards {
+		paragraphs = append(paragraphs, strings.Join(exportLines(a.Title, derefString(a.Issuer), derefString(a.Date), derefString(a.Description)), "\n"))
+	}
+	add("Awards", paragraphs)
+
+	paragraphs = nil
+	for _, l := range profile.Links {
+		paragraphs = append(paragraphs, strings.Join(exportLines(derefString(l.Label), l.URL), ": "))
+	}
+	add("Links", paragraphs)
+
+	paragraphs = nil
+	for _, t := range testimonials {
+		author := exportLines(t.AuthorName, derefString(t.AuthorTitle), derefString(t.AuthorCompany))
+		paragraphs = append(paragraphs, "“"+strings.TrimSpace(t.Quote)+"”\n— "+strings.Join(author, ", "))
+	}
+	add("Testimonials", paragraphs)
+	return doc
+}
+
+// exportLines returns the non-blank lines, trimmed.
+func exportLines(lines ...string) []string {
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+// exportDates formats a date range as written, e.g. "2019 – Present".
+func exportDates(start, end *string, current bool) string {
+	to := derefString(end)
+	if current && to == "" {
+		to = "Present"
+	}
+	return strings.Join(exportLines(derefString(start), to), " – ")
+}
+
+// loadVariant fetches a profile variant by its GraphQL ID for a field resolver.
+func (r *Resolver) loadVariant(ctx context.Context, id string) (*domain.ProfileVariant, error) {
+	variantID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid variant ID: %w", err)
+	}
+	variant, err := r.profileVariantRepo.GetByID(ctx, variantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile variant: %w", err)
+	}
+	if variant == nil {
+		return nil, fmt.Errorf("profile variant not found")
+	}
+	return variant, nil
+}
+
+// applyVariant leaves out the items a variant hides and moves those it orders to the front,
+// in its order. The other items keep their order.
+func applyVariant[T any](variant *domain.ProfileVariant, items []*T, idOf func(*T) string) []*T {
+	type ranked struct {
+		item    *T
+		rank    int
+		ordered bool
+	}
+	kept := make([]ranked, 0, len(items))
+	for _, item := range items {
+		id, err := uuid.Parse(idOf(item))
+		if err != nil {
+			kept = append(kept, ranked{item: item})
+			continue
+		}
+		if variant.Hides(id) {
+			continue
+		}
+		rank, ordered := variant.Rank(id)
+		kept = append(kept, ranked{item, rank, ordered})
+	}
+	sort.SliceStable(kept, func(i, j int) bool {
+		if kept[i].ordered != kept[j].ordered {
+			return kept[i].ordered
+		}
+		return kept[i].ordered && kept[i].rank < kept[j].rank
+	})
+	result := make([]*T, len(kept))
+	for i, k := range kept {
+		result[i] = k.item
+	}
+	return result
+}
+
+// profileVariantRules parses the rules of a variant input into rules. Nil lists are left
+// unchanged. Testimonials and overridden experiences must belong to the profile; hidden and
+// ordered items are not checked, since the profile may drop them later anyway. For invalid
+// input it returns a validation error instead.
+func (r *Resolver) profileVariantRules(
+	ctx context.Context,
+	profileID uuid.UUID,
+	hiddenItemIDs, itemOrder, testimonialIDs []string,
+	overrides []*model.ExperienceOverrideInput,
+	rules *domain.ProfileVariantRules,
+) (*model.ProfileVariantValidationError, error) {
+	invalid := func(field string) *model.ProfileVariantValidationError {
+		return &model.ProfileVariantValidationError{Message: "invalid ID format", Field: stringPtr(field)}
+	}
+	if hiddenItemIDs != nil {
+		ids, ok := parseUUIDs(hiddenItemIDs)
+		if !ok {
+			return invalid("hiddenItemIds"), nil
+		}
+		rules.HiddenItemIDs = ids
+	}
+	if itemOrder != nil {
+		ids, ok := parseUUIDs(itemOrder)
+		if !ok {
+			return invalid("itemOrder"), nil
+		}
+		rules.ItemOrder = ids
+	}
+	if testimonialIDs != nil {
+		ids, ok := parseUUIDs(testimonialIDs)
+		if !ok {
+			return invalid("testimonialIds"), nil
+		}
+		for _, id := range ids {
+			t, err := r.testimonialRepo.GetByID(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get testimonial: %w", err)
+			}
+			if t == nil || t.ProfileID != profileID {
+				return &model.ProfileVariantValidationError{Message: "testimonial not found", Field: stringPtr("testimonialIds")}, nil
+			}
+		}
+		rules.TestimonialIDs = ids
+	}
+	if overrides != nil {
+		rules.ExperienceOverrides = make([]domain.ExperienceOverride, 0, len(overrides))
+		for _, o := range overrides {
+			id, err := uuid.Parse(o.ExperienceID)
+			if err != nil {
+				return invalid("experienceOverrides"), nil
+			}
+			exp, err := r.profileExpRepo.GetByID(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get experience: %w", err)
+			}
+			if exp == nil || exp.ProfileID != profileID {
+				return &model.ProfileVariantValidationError{Message: "experience not found", Field: stringPtr("experienceOverrides")}, nil
+			}
+			rules.ExperienceOverrides = append(rules.ExperienceOverrides, domain.ExperienceOverride{
+				ExperienceID: id,
+				Description:  o.Description,
+				Highlights:   o.Highlights,
+			})
+		}
+	}
+	return nil, nil
+}
+
+// parseUUIDs parses IDs, dropping duplicates. It returns false if any ID is malformed.
+func parseUUIDs(ids []string) ([]uuid.UUID, bool) {
+	result := make([]uuid.UUID, 0, len(ids))
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, s := range ids {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return nil, false
+		}
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result, true
+}
+
+// newShareToken returns a random, URL-safe token for sharing a profile variant.
+func newShareToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate share token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// sectionOwnerProfile returns the profile a new link, certification, language, project,
+// publication or award of the user is added to, creating it if needed. For a malformed or
+// unknown user ID it returns a validation message instead.
+func (r *Resolver) sectionOwnerProfile(ctx context.Context, userID string) (*domain.Profile, string, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, "invalid user ID format", nil
+	}
+	user, err := r.userRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to verify user: %w", err)
+	}
+	if user == nil {
+		return nil, "user not found", nil
+	}
+	profile, err := r.profileRepo.GetOrCreateByUserID(ctx, uid)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get or create profile: %w", err)
+	}
+	return profile, "", nil
+}
+
+// loadCredentialDocument fetches a credential document with its user and file relations.
+// Returns nil if the document does not exist.
+func (r *Resolver) loadCredentialDocument(ctx context.Context, id uuid.UUID) (*model.CredentialDocument, error) {
+	doc, err := r.credentialDocRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credential document: %w", err)
+	}
+	if doc == nil {
+		return nil, nil
+	}
+
+	user, err := r.userRepo.GetByID(ctx, doc.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user for credential document: %w", err)
+	}
+	gqlUser := toGraphQLUser(user)
+
+	file, err := r.fileRepo.GetByID(ctx, doc.FileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file for credential document: %w", err)
+	}
+
+	return toGraphQLCredentialDocument(doc, gqlUser, toGraphQLFile(file, gqlUser)), nil
+}
+
+// linkCompany returns the ID of the profile's Company entity for a free-text company name,
+// creating the company if needed. Returns nil for a blank name.
+func (r *Resolver) linkCompany(ctx context.Context, profileID uuid.UUID, name string) (*uuid.UUID, error) {
+	matcher, err := service.LoadCompanyMatcher(ctx, r.companyAliasRepo, profileID)
+	if err != nil {
+		return nil, err
+	}
+	return service.LinkCompany(ctx, r.companyRepo, matcher, profileID, name)
+}
+
+// skillTaxonomy returns the skill taxonomy extended with the profile's own skills.
+func (r *Resolver) skillTaxonomy(ctx context.Context, profileID uuid.UUID) (*taxonomy.Taxonomy, error) {
+	return service.LoadSkillTaxonomy(ctx, r.skillRepo, profileID)
+}
+
+// recomputeSkillStats refreshes the computed fields of the profile's skills after a change
+// to its experiences, skills or testimonials, and copies the new values into skills about
+// to be returned. A failure is logged rather than failing the mutation; the next change
+// recomputes them again.
+func (r *Resolver) recomputeSkillStats(ctx context.Context, profileID uuid.UUID, skills ...*domain.ProfileSkill) {
+	fresh, err := r.skillStats.Recompute(ctx, profileID)
+	if err != nil {
+		r.log.Warning("Failed to recompute skill stats",
+			logger.Feature("profile"),
+			logger.String("profile_id", profileID.String()),
+			logger.Err(err),
+		)
+		return
+	}
+	byID := make(map[uuid.UUID]*domain.ProfileSkill, len(fresh))
+	for _, skill := range fresh {
+		byID[skill.ID] = skill
+	}
+	for _, skill := range skills {
+		if f := byID[skill.ID]; f != nil {
+			skill.YearsOfExperience = f.YearsOfExperience
+			skill.LastUsed = f.LastUsed
+			skill.EvidenceStrength = f.EvidenceStrength
+		}
+	}
+}
+
+// recordChanges appends the user's edits to the profile's change log and queues embedding
+// the edited evidence. A failure is logged rather than failing the mutation, whose changes
+// are already saved.
+func (r *Resolver) recordChanges(ctx context.Context, profileID uuid.UUID, changes []*domain.ProfileChange) {
+	if err := r.history.RecordEdits(ctx, profileID, changes); err != nil {
+		r.log.Warning("Failed to record profile changes",
+			logger.Feature("profile"),
+			logger.String("profile_id", profileID.String()),
+			logger.Err(err),
+		)
+	}
+	if len(changes) > 0 {
+		r.queueEvidenceEmbedding(ctx, profileID)
+	}
+}
+
+// queueEvidenceEmbedding queues bringing the embeddings of the profile's evidence and skill
+// names up to date after an edit, so that search and skill matching do not use stale
+// vectors until the next import. Nothing is queued without an embedding service. A failure
+// is logged rather than failing the mutation.
+func (r *Resolver) queueEvidenceEmbedding(ctx context.Context, profileID uuid.UUID) {
+	if r.embeddingSvc == nil {
+		return
+	}
+	profile, err := r.profileRepo.GetByID(ctx, profileID)
+	if err == nil && profile != nil {
+		err = r.jobEnqueuer.EnqueueEvidenceEmbedding(ctx, domain.EvidenceEmbeddingRequest{UserID: profile.UserID})
+	}
+	if err != nil {
+		r.log.Warning("Failed to enqueue evidence embedding",
+			logger.Feature("profile"),
+			logger.String("profile_id", profileID.String()),
+			logger.Err(err),
+		)
+	}
+}
+
+// authorImageURL returns a presigned URL for the author's image, or nil if the author has
+// no image or the URL cannot be generated.
+func (r *Resolver) authorImageURL(ctx context.Context, author *domain.Author) *string {
+	if author.ImageID == nil {
+		return nil
+	}
+	file, err := r.fileRepo.GetByID(ctx, *author.ImageID)
+	if err != nil || file == nil {
+		return nil
+	}
+	url, err := r.storage.GetPresignedURL(ctx, file.StorageKey, time.Hour)
+	if err != nil {
+		return nil
+	}
+	return &url
+}
+
+// loadCompany fetches a company by its GraphQL ID. Returns nil if it does not exist.
+func (r *Resolver) loadCompany(ctx context.Context, id string) (*domain.Company, error) {
+	companyID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid company ID: %w", err)
+	}
+	company, err := r.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company: %w", err)
+	}
+	return company, nil
+}
+
+// settleInconsistency marks an open inconsistency resolved or dismissed with an optional
+// note. With applyLetterValue, the letter's value first replaces the experience's title
+// or date, and the profile's skill stats are recomputed.
+func (r *Resolver) settleInconsistency(ctx context.Context, id string, status domain.InconsistencyStatus, applyLetterValue bool, note *string) (model.InconsistencyResponse, error) {
+	inconsistencyID, err := uuid.Parse(id)
+	if err != nil {
+		r.log.Warning("Invalid inconsistency ID format",
+			logger.Feature("profile"),
+			logger.String("inconsistency_id", id),
+		)
+		return &model.InconsistencyValidationError{
+			Message: "invalid inconsistency ID format",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	inconsistency, err := r.inconsistencyRepo.GetByID(ctx, inconsistencyID)
+	if err != nil {
+		r.log.Error("Failed to get profile inconsistency",
+			logger.Feature("profile"),
+			logger.String("inconsistency_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get profile inconsistency: %w", err)
+	}
+	if inconsistency == nil {
+		return &model.InconsistencyValidationError{
+			Message: "inconsistency not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+	if inconsistency.Status != domain.InconsistencyStatusOpen {
+		return &model.InconsistencyValidationError{
+			Message: fmt.Sprintf("inconsistency is already %s", inconsistency.Status),
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	if applyLetterValue {
+		if validationErr, applyErr := r.applyLetterValue(ctx, inconsistency); validationErr != nil || applyErr != nil {
+			return validationErr, applyErr
+		}
+	}
+
+	now := time.Now()
+	inconsistency.Status = status
+	inconsistency.ResolvedAt = &now
+	if note != nil && strings.TrimSpace(*note) != "" {
+		trimmed := strings.TrimSpace(*note)
+		inconsistency.ResolutionNote = &trimmed
+	}
+	if err := r.inconsistencyRepo.Update(ctx, inconsistency); err != nil {
+		r.log.Error("Failed to update profile inconsistency",
+			logger.Feature("profile"),
+			logger.String("inconsistency_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update profile inconsistency: %w", err)
+	}
+
+	r.log.Info("Profile inconsistency settled",
+		logger.Feature("profile"),
+		logger.String("inconsistency_id", id),
+		logger.String("status", string(status)),
+	)
+
+	return &model.InconsistencyResult{
+		Inconsistency: toGraphQLProfileInconsistency(inconsistency),
+	}, nil
+}
+
+// applyLetterValue updates the contradicted field of the inconsistency's experience to the
+// letter's value. A letter's end date ends an ongoing role.
+func (r *Resolver) applyLetterValue(ctx context.Context, inconsistency *domain.ProfileInconsistency) (model.InconsistencyResponse, error) {
+	experience, err := r.profileExpRepo.GetByID(ctx, inconsistency.ProfileExperienceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile experience: %w", err)
+	}
+	if experience == nil {
+		return &model.InconsistencyValidationError{
+			Message: "experience no longer exists",
+			Field:   stringPtr("applyLetterValue"),
+		}, nil
+	}
+
+	before := *experience
+	value := inconsistency.LetterValue
+	switch inconsistency.Field {
+	case domain.InconsistencyFieldTitle:
+		experience.Title = value
+	case domain.InconsistencyFieldStartDate:
+		experience.StartDate = &value
+	case domain.InconsistencyFieldEndDate:
+		experience.EndDate = &value
+		experience.IsCurrent = false
+	}
+	if err := service.SetExperienceDates(experience); err != nil {
+		return &model.InconsistencyValidationError{
+			Message: "end date cannot be before start date",
+			Field:   stringPtr("applyLetterValue"),
+		}, nil
+	}
+
+	if err := r.profileExpRepo.Update(ctx, experience); err != nil {
+		r.log.Error("Failed to update experience",
+			logger.Feature("profile"),
+			logger.String("experience_id", experience.ID.String()),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update experience: %w", err)
+	}
+
+	r.recordChanges(ctx, experience.ProfileID, service.ExperienceChanges(&before, experience))
+	r.recomputeSkillStats(ctx, experience.ProfileID)
+	return nil, nil
+}
+
+// writingProfile parses the ID of a profile to draft text for and checks that the profile
+// exists and the writing assistant is available.
+func (r *Resolver) writingProfile(ctx context.Context, profileID string) (uuid.UUID, model.WritingSuggestionsResponse, error) {
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return uuid.Nil, &model.WritingAssistantValidationError{
+			Message: "invalid profile ID format",
+			Field:   stringPtr("profileId"),
+		}, nil
+	}
+	if r.profileWriter == nil {
+		return uuid.Nil, &model.WritingAssistantValidationError{
+			Message: "writing assistant is not available",
+			Field:   stringPtr("profileId"),
+		}, nil
+	}
+
+	profile, err := r.profileRepo.GetByID(ctx, pid)
+	if err != nil {
+		return uuid.Nil, nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	if profile == nil {
+		return uuid.Nil, &model.WritingAssistantValidationError{
+			Message: "profile not found",
+			Field:   stringPtr("profileId"),
+		}, nil
+	}
+	return pid, nil, nil
+}
+
+// writingSuggestionsResponse turns the outcome of a writing assistant request into its
+// GraphQL response. Validation errors, such as every draft making claims the profile
+// does not support, are returned to the client.
+func (r *Resolver) writingSuggestionsResponse(suggestions []*domain.WritingSuggestion, err error, profileID string) (model.WritingSuggestionsResponse, error) {
+	if err != nil {
+		var validationErr *domain.ValidationError
+		if errors.As(err, &validationErr) {
+			return &model.WritingAssistantValidationError{
+				Message: validationErr.Message,
+				Field:   stringPtr(validationErr.Field),
+			}, nil
+		}
+		r.log.Error("Failed to draft profile text",
+			logger.Feature("writing"),
+			logger.String("profile_id", profileID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to draft profile text: %w", err)
+	}
+
+	r.log.Info("Writing suggestions drafted",
+		logger.Feature("writing"),
+		logger.String("profile_id", profileID),
+		logger.Int("count", len(suggestions)),
+	)
+	return &model.WritingSuggestionsResult{Suggestions: toGraphQLWritingSuggestions(suggestions)}, nil
+}
+
+// coverLetterByID retrieves a cover letter by its ID string. It returns nil if not found.
+func (r *Resolver) coverLetterByID(ctx context.Context, id string) (*domain.CoverLetter, error) {
+	letterID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cover letter ID: %w", err)
+	}
+
+	letter, err := r.coverLetterRepo.GetByID(ctx, letterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cover letter: %w", err)
+	}
+	return letter, nil
+}
+
+// settleWritingSuggestion accepts or dismisses a pending writing suggestion.
+func (r *Resolver) settleWritingSuggestion(ctx context.Context, id string, status domain.WritingSuggestionStatus) (model.WritingSuggestionResponse, error) {
+	suggestionID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.WritingAssistantValidationError{
+			Message: "invalid suggestion ID format",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	suggestion, err := r.writingSuggestionRepo.GetByID(ctx, suggestionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get writing suggestion: %w", err)
+	}
+	if suggestion == nil {
+		return &model.WritingAssistantValidationError{
+			Message: "suggestion not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+	if suggestion.Status != domain.WritingSuggestionPending {
+		return &model.WritingAssistantValidationError{
+			Message: fmt.Sprintf("suggestion is already %s", suggestion.Status),
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	if status == domain.WritingSuggestionAccepted {
+		if validationErr, applyErr := r.applyWritingSuggestion(ctx, suggestion); validationErr != nil || applyErr != nil {
+			return validationErr, applyErr
+		}
+		if err := r.dismissRivalSuggestions(ctx, suggestion); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	suggestion.Status = status
+	suggestion.DecidedAt = &now
+	if err := r.writingSuggestionRepo.Update(ctx, suggestion); err != nil {
+		r.log.Error("Failed to update writing suggestion",
+			logger.Feature("writing"),
+			logger.String("suggestion_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update writing suggestion: %w", err)
+	}
+
+	r.log.Info("Writing suggestion settled",
+		logger.Feature("writing"),
+		logger.String("suggestion_id", id),
+		logger.String("status", string(status)),
+	)
+
+	return &model.WritingSuggestionResult{Suggestion: toGraphQLWritingSuggestion(suggestion)}, nil
+}
+
+// applyWritingSuggestion writes the drafted text of a suggestion to the profile or, for
+// highlights, to the experience, and records the edit in the profile's history.
+func (r *Resolver) applyWritingSuggestion(ctx context.Context, suggestion *domain.WritingSuggestion) (model.WritingSuggestionResponse, error) {
+	if suggestion.Kind == domain.WritingSuggestionHighlights {
+		if suggestion.ExperienceID == nil {
+			return nil, fmt.Errorf("highlights suggestion %s has no experience", suggestion.ID)
+		}
+		experience, err := r.profileExpRepo.GetByID(ctx, *suggestion.ExperienceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get profile experience: %w", err)
+		}
+		if experience == nil {
+			return &model.WritingAssistantValidationError{
+				Message: "experience no longer exists",
+				Field:   stringPtr("id"),
+			}, nil
+		}
+
+		before := *experience
+		experience.Highlights = suggestion.Texts()
+		if err := r.profileExpRepo.Update(ctx, experience); err != nil {
+			return nil, fmt.Errorf("failed to update experience: %w", err)
+		}
+		r.recordChanges(ctx, experience.ProfileID, service.ExperienceChanges(&before, experience))
+		r.recomputeSkillStats(ctx, experience.ProfileID)
+		return nil, nil
+	}
+
+	profile, err := r.profileRepo.GetByID(ctx, suggestion.ProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	if profile == nil {
+		return &model.WritingAssistantValidationError{
+			Message: "profile no longer exists",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	before := *profile
+	text := suggestion.Drafts[0].Text
+	if suggestion.Kind == domain.WritingSuggestionHeadline {
+		profile.Headline = &text
+	} else {
+		profile.Summary = &text
+	}
+	if err := r.profileRepo.Update(ctx, profile); err != nil {
+		return nil, fmt.Errorf("failed to update profile: %w", err)
+	}
+	r.recordChanges(ctx, profile.ID, service.ProfileHeaderChanges(&before, profile))
+	return nil, nil
+}
+
+// dismissRivalSuggestions dismisses the other pending suggestions for the same summary,
+// headline or experience as an accepted one.
+func (r *Resolver) dismissRivalSuggestions(ctx context.Context, accepted *domain.WritingSuggestion) error {
+	suggestions, err := r.writingSuggestionRepo.GetByProfileID(ctx, accepted.ProfileID)
+	if err != nil {
+		return fmt.Errorf("failed to get writing suggestions: %w", err)
+	}
+	now := time.Now()
+	for _, s := range suggestions {
+		if s.ID == accepted.ID || s.Status != domain.WritingSuggestionPending || s.Kind != accepted.Kind {
+			continue
+		}
+		if s.Kind == domain.WritingSuggestionHighlights && (s.ExperienceID == nil || accepted.ExperienceID == nil || *s.ExperienceID != *accepted.ExperienceID) {
+			continue
+		}
+		s.Status = domain.WritingSuggestionDismissed
+		s.DecidedAt = &now
+		if err := r.writingSuggestionRepo.Update(ctx, s); err != nil {
+			return fmt.Errorf("failed to dismiss writing suggestion: %w", err)
+		}
+	}
+	return nil
 }
@@ -11,10 +11,14 @@ import (
 	"backend/internal/graphql/model"
 	"backend/internal/logger"
 	"backend/internal/service"
+	"backend/internal/taxonomy"
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
@@ -22,6 +26,279 @@ import (
 	"github.com/google/uuid"
 )
 
+// LinkedCompany is the resolver for the linkedCompany field.
+func (r *authorResolver) LinkedCompany(ctx context.Context, obj *model.Author) (*model.Company, error) {
+	authorID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid author ID: %w", err)
+	}
+
+	author, err := r.authorRepo.GetByID(ctx, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get author: %w", err)
+	}
+	if author == nil || author.CompanyID == nil {
+		return nil, nil
+	}
+
+	company, err := r.companyRepo.GetByID(ctx, *author.CompanyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company: %w", err)
+	}
+	return toGraphQLCompany(company), nil
+}
+
+// Aliases is the resolver for the aliases field.
+func (r *companyResolver) Aliases(ctx context.Context, obj *model.Company) ([]string, error) {
+	company, err := r.loadCompany(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if company == nil {
+		return []string{}, nil
+	}
+
+	aliases, err := r.companyAliasRepo.GetByProfileID(ctx, company.ProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company aliases: %w", err)
+	}
+	return service.CompanyAliasNames(aliases, company), nil
+}
+
+// Logo is the resolver for the logo field.
+func (r *companyResolver) Logo(ctx context.Context, obj *model.Company) (*model.File, error) {
+	company, err := r.loadCompany(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if company == nil || company.LogoID == nil {
+		return nil, nil
+	}
+
+	file, err := r.fileRepo.GetByID(ctx, *company.LogoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logo file: %w", err)
+	}
+	if file == nil {
+		return nil, nil
+	}
+
+	user, err := r.userRepo.GetByID(ctx, file.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user for logo file: %w", err)
+	}
+	return toGraphQLFile(file, toGraphQLUser(user)), nil
+}
+
+// Experiences is the resolver for the experiences field.
+func (r *companyResolver) Experiences(ctx context.Context, obj *model.Company) ([]*model.ProfileExperience, error) {
+	company, err := r.loadCompany(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if company == nil {
+		return []*model.ProfileExperience{}, nil
+	}
+
+	experiences, err := r.profileExpRepo.GetByProfileID(ctx, company.ProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experiences: %w", err)
+	}
+
+	var linked []*domain.ProfileExperience
+	for _, e := range experiences {
+		if e.CompanyID != nil && *e.CompanyID == company.ID {
+			linked = append(linked, e)
+		}
+	}
+	return toGraphQLProfileExperiences(linked), nil
+}
+
+// Authors is the resolver for the authors field.
+func (r *companyResolver) Authors(ctx context.Context, obj *model.Company) ([]*model.Author, error) {
+	company, err := r.loadCompany(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if company == nil {
+		return []*model.Author{}, nil
+	}
+
+	authors, err := r.authorRepo.GetByProfileID(ctx, company.ProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authors: %w", err)
+	}
+
+	result := []*model.Author{}
+	for _, a := range authors {
+		if a.CompanyID != nil && *a.CompanyID == company.ID {
+			result = append(result, toGraphQLAuthorWithImage(a, r.authorImageURL(ctx, a)))
+		}
+	}
+	return result, nil
+}
+
+// Testimonials is the resolver for the testimonials field.
+func (r *companyResolver) Testimonials(ctx context.Context, obj *model.Company) ([]*model.Testimonial, error) {
+	company, err := r.loadCompany(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if company == nil {
+		return []*model.Testimonial{}, nil
+	}
+
+	authors, err := r.authorRepo.GetByProfileID(ctx, company.ProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authors: %w", err)
+	}
+	authorsAtCompany := make(map[uuid.UUID]*domain.Author)
+	for _, a := range authors {
+		if a.CompanyID != nil && *a.CompanyID == company.ID {
+			authorsAtCompany[a.ID] = a
+		}
+	}
+	if len(authorsAtCompany) == 0 {
+		return []*model.Testimonial{}, nil
+	}
+
+	testimonials, err := r.testimonialRepo.GetByProfileID(ctx, company.ProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get testimonials: %w", err)
+	}
+
+	var linked []*domain.Testimonial
+	for _, t := range testimonials {
+		if t.AuthorID == nil {
+			continue
+		}
+		if author, ok := authorsAtCompany[*t.AuthorID]; ok {
+			t.Author = author
+			linked = append(linked, t)
+		}
+	}
+	return toGraphQLTestimonials(linked, nil), nil
+}
+
+// JobDescription is the resolver for the jobDescription field.
+func (r *coverLetterResolver) JobDescription(ctx context.Context, obj *model.CoverLetter) (*model.JobDescription, error) {
+	letter, err := r.coverLetterByID(ctx, obj.ID)
+	if err != nil || letter == nil || letter.JobDescriptionID == nil {
+		return nil, err
+	}
+
+	jd, err := r.jobDescriptionRepo.GetByID(ctx, *letter.JobDescriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job description: %w", err)
+	}
+	if jd == nil {
+		return nil, nil
+	}
+	return toGraphQLJobDescription(jd), nil
+}
+
+// LatestVersion is the resolver for the latestVersion field.
+func (r *coverLetterResolver) LatestVersion(ctx context.Context, obj *model.CoverLetter) (*model.CoverLetterVersion, error) {
+	letter, err := r.coverLetterByID(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if letter == nil {
+		return nil, fmt.Errorf("cover letter not found: %s", obj.ID)
+	}
+
+	version, err := r.coverLetterRepo.GetVersion(ctx, letter.ID, letter.LatestVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cover letter version: %w", err)
+	}
+	if version == nil {
+		return nil, fmt.Errorf("cover letter %s has no version %d", obj.ID, letter.LatestVersion)
+	}
+	return toGraphQLCoverLetterVersion(version), nil
+}
+
+// Versions is the resolver for the versions field.
+func (r *coverLetterResolver) Versions(ctx context.Context, obj *model.CoverLetter) ([]*model.CoverLetterVersion, error) {
+	letterID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cover letter ID: %w", err)
+	}
+
+	versions, err := r.coverLetterRepo.GetVersions(ctx, letterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cover letter versions: %w", err)
+	}
+	return toGraphQLCoverLetterVersions(versions), nil
+}
+
+// Education is the resolver for the education field.
+func (r *educationValidationResolver) Education(ctx context.Context, obj *model.EducationValidation) (*model.ProfileEducation, error) {
+	validationID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validation ID: %w", err)
+	}
+
+	validation, err := r.eduValidationRepo.GetByID(ctx, validationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get education validation: %w", err)
+	}
+	if validation == nil {
+		return nil, nil
+	}
+
+	education, err := r.profileEduRepo.GetByID(ctx, validation.ProfileEducationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile education: %w", err)
+	}
+	if education == nil {
+		return nil, nil
+	}
+
+	return toGraphQLProfileEducation(education), nil
+}
+
+// ReferenceLetter is the resolver for the referenceLetter field.
+func (r *educationValidationResolver) ReferenceLetter(ctx context.Context, obj *model.EducationValidation) (*model.ReferenceLetter, error) {
+	validationID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validation ID: %w", err)
+	}
+
+	validation, err := r.eduValidationRepo.GetByID(ctx, validationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get education validation: %w", err)
+	}
+	if validation == nil {
+		return nil, nil
+	}
+
+	refLetter, err := r.refLetterRepo.GetByID(ctx, validation.ReferenceLetterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reference letter: %w", err)
+	}
+	if refLetter == nil {
+		return nil, nil
+	}
+
+	// Get the user for the reference letter
+	user, err := r.userRepo.GetByID(ctx, refLetter.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	gqlUser := toGraphQLUser(user)
+
+	// Get the file if present
+	var gqlFile *model.File
+	if refLetter.FileID != nil {
+		file, err := r.fileRepo.GetByID(ctx, *refLetter.FileID)
+		if err == nil && file != nil {
+			gqlFile = toGraphQLFile(file, gqlUser)
+		}
+	}
+
+	return toGraphQLReferenceLetter(refLetter, gqlUser, gqlFile), nil
+}
+
 // Experience is the resolver for the experience field.
 func (r *experienceValidationResolver) Experience(ctx context.Context, obj *model.ExperienceValidation) (*model.ProfileExperience, error) {
 	validationID, err := uuid.Parse(obj.ID)
@@ -711,10 +988,22 @@ func (r *mutationResolver) ProcessDocument(ctx context.Context, userID string, i
 		logger.Bool("extract_testimonial", input.ExtractTestimonial),
 	)
 
+	// Collect the requested credential extractions, each handled by a dedicated extractor
+	var credentialTypes []domain.CredentialDocumentType
+	if input.ExtractCertificate != nil && *input.ExtractCertificate {
+		credentialTypes = append(credentialTypes, domain.CredentialDocumentTypeCertificate)
+	}
+	if input.ExtractTranscript != nil && *input.ExtractTranscript {
+		credentialTypes = append(credentialTypes, domain.CredentialDocumentTypeTranscript)
+	}
+	if input.ExtractPerformanceReview != nil && *input.ExtractPerformanceReview {
+		credentialTypes = append(credentialTypes, domain.CredentialDocumentTypePerformanceReview)
+	}
+
 	// Validate at least one extraction type is requested
-	if !input.ExtractCareerInfo && !input.ExtractTestimonial {
+	if !input.ExtractCareerInfo && !input.ExtractTestimonial && len(credentialTypes) == 0 {
 		return &model.ProcessDocumentError{
-			Message: "at least one of extractCareerInfo or extractTestimonial must be true",
+			Message: "at least one of extractCareerInfo, extractTestimonial, extractCertificate, extractTranscript or extractPerformanceReview must be true",
 		}, nil
 	}
 
@@ -766,9 +1055,12 @@ func (r *mutationResolver) ProcessDocument(ctx context.Context, userID string, i
 		}, nil
 	}
 
-	result := &model.ProcessDocumentResult{}
+	result := &model.ProcessDocumentResult{
+		CredentialDocumentIds: []string{},
+	}
 	var resumeID *uuid.UUID
 	var refLetterID *uuid.UUID
+	var credentialDocIDs []uuid.UUID
 
 	// Create resume record if career info extraction requested
 	if input.ExtractCareerInfo {
@@ -802,14 +1094,31 @@ func (r *mutationResolver) ProcessDocument(ctx context.Context, userID string, i
 		refLetterID = &refLetter.ID
 	}
 
+	// Create a credential document record per requested credential extraction
+	for _, docType := range credentialTypes {
+		doc := &domain.CredentialDocument{
+			ID:           uuid.New(),
+			UserID:       uid,
+			FileID:       fileID,
+			DocumentType: docType,
+			Status:       domain.CredentialDocumentStatusPending,
+		}
+		if err := r.credentialDocRepo.Create(ctx, doc); err != nil {
+			return nil, fmt.Errorf("failed to create credential document record: %w", err)
+		}
+		result.CredentialDocumentIds = append(result.CredentialDocumentIds, doc.ID.String())
+		credentialDocIDs = append(credentialDocIDs, doc.ID)
+	}
+
 	// Enqueue the unified processing job
 	if err := r.jobEnqueuer.EnqueueUnifiedDocumentProcessing(ctx, domain.UnifiedDocumentProcessingRequest{
-		StorageKey:        file.StorageKey,
-		FileID:            fileID,
-		ContentType:       file.ContentType,
-		UserID:            uid,
-		ResumeID:          resumeID,
-		ReferenceLetterID: refLetterID,
+		StorageKey:            file.StorageKey,
+		FileID:                fileID,
+		ContentType:           file.ContentType,
+		UserID:                uid,
+		ResumeID:              resumeID,
+		ReferenceLetterID:     refLetterID,
+		CredentialDocumentIDs: credentialDocIDs,
 	}); err != nil {
 		return nil, fmt.Errorf("failed to enqueue document processing: %w", err)
 	}
@@ -831,9 +1140,9 @@ func (r *mutationResolver) ImportDocumentResults(ctx context.Context, userID str
 	)
 
 	// Validate at least one ID is provided
-	if input.ResumeID == nil && input.ReferenceLetterID == nil {
+	if input.ResumeID == nil && input.ReferenceLetterID == nil && len(input.CredentialDocumentIds) == 0 {
 		return &model.ImportDocumentResultsError{
-			Message: "at least one of resumeId or referenceLetterID must be provided",
+			Message: "at least one of resumeId, referenceLetterID or credentialDocumentIds must be provided",
 		}, nil
 	}
 
@@ -903,26 +1212,48 @@ func (r *mutationResolver) ImportDocumentResults(ctx context.Context, userID str
 			return nil, fmt.Errorf("failed to parse extracted resume data: %w", err)
 		}
 
-		// Apply item-level selection filters (nil = import all)
-		if input.SelectedExperienceIndices != nil {
-			extractedData.Experience = service.FilterByIndices(extractedData.Experience, input.SelectedExperienceIndices)
-		}
-		if input.SelectedEducationIndices != nil {
-			extractedData.Education = service.FilterByIndices(extractedData.Education, input.SelectedEducationIndices)
-		}
-		if input.SelectedSkills != nil {
-			extractedData.Skills = service.FilterSkillsByName(extractedData.Skills, input.SelectedSkills)
-		}
+		var matResult *service.MaterializationResult
+		if input.ResumeImportMode != nil && *input.ResumeImportMode == model.ResumeImportModeMerge {
+			// Merge the accepted changes into the profile (nil = accept all)
+			matResult, err = r.materializationSvc.MergeResumeData(ctx, resumeID, uid, &extractedData, input.AcceptedMergeChangeIds)
+			if errors.Is(err, service.ErrUnknownMergeChange) {
+				return &model.ImportDocumentResultsError{
+					Message: err.Error(),
+					Field:   stringPtr("acceptedMergeChangeIds"),
+				}, nil
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to merge resume data: %w", err)
+			}
+		} else {
+			// Apply item-level selection filters (nil = import all)
+			if input.SelectedExperienceIndices != nil {
+				extractedData.Experience = service.FilterByIndices(extractedData.Experience, input.SelectedExperienceIndices)
+			}
+			if input.SelectedEducationIndices != nil {
+				extractedData.Education = service.FilterByIndices(extractedData.Education, input.SelectedEducationIndices)
+			}
+			if input.SelectedSkills != nil {
+				extractedData.Skills = service.FilterSkillsByName(extractedData.Skills, input.SelectedSkills)
+			}
 
-		// Materialize into profile tables
-		matResult, err := r.materializationSvc.MaterializeResumeData(ctx, resumeID, uid, &extractedData)
-		if err != nil {
-			return nil, fmt.Errorf("failed to materialize resume data: %w", err)
+			// Materialize into profile tables
+			matResult, err = r.materializationSvc.MaterializeResumeData(ctx, resumeID, uid, &extractedData)
+			if err != nil {
+				return nil, fmt.Errorf("failed to materialize resume data: %w", err)
+			}
 		}
 
 		imported.Experiences = matResult.Experiences
 		imported.Educations = matResult.Educations
 		imported.Skills = matResult.Skills
+		imported.Removed = matResult.Removed
+		imported.Links = matResult.Links
+		imported.Certifications = matResult.Certifications
+		imported.Languages = matResult.Languages
+		imported.Projects = matResult.Projects
+		imported.Publications = matResult.Publications
+		imported.Awards = matResult.Awards
 
 		r.log.Info("Resume data materialized",
 			logger.Feature("document-processing"),
@@ -930,6 +1261,7 @@ func (r *mutationResolver) ImportDocumentResults(ctx context.Context, userID str
 			logger.Int("experiences", matResult.Experiences),
 			logger.Int("educations", matResult.Educations),
 			logger.Int("skills", matResult.Skills),
+			logger.Int("removed", matResult.Removed),
 		)
 	}
 
@@ -987,6 +1319,12 @@ func (r *mutationResolver) ImportDocumentResults(ctx context.Context, userID str
 				}
 				extractedData.DiscoveredSkills = service.FilterDiscoveredSkillsWithCategory(extractedData.DiscoveredSkills, selected)
 			}
+			// Cross-referencing sees every experience mention; the selection only controls
+			// which unmatched mentions are imported as new experiences
+			xrefData := extractedData
+			if input.SelectedExperienceMentionIndices != nil {
+				extractedData.ExperienceMentions = service.FilterByIndices(extractedData.ExperienceMentions, input.SelectedExperienceMentionIndices)
+			}
 
 			// Materialize into profile tables
 			matResult, matErr := r.materializationSvc.MaterializeReferenceLetterData(ctx, refLetterID, uid, &extractedData)
@@ -995,19 +1333,97 @@ func (r *mutationResolver) ImportDocumentResults(ctx context.Context, userID str
 			}
 
 			imported.Testimonials = matResult.Testimonials
+			imported.Experiences += matResult.Experiences
 
 			// Save for cross-referencing with resume skills/experiences
 			refLetterIDForXRef = &refLetterID
-			refLetterDataForXRef = &extractedData
+			refLetterDataForXRef = &xrefData
 
 			r.log.Info("Reference letter data materialized",
 				logger.Feature("document-processing"),
 				logger.String("reference_letter_id", refLetterID.String()),
 				logger.Int("testimonials", matResult.Testimonials),
+				logger.Int("discovered_experiences", matResult.Experiences),
 			)
 		}
 	}
 
+	// Materialize credential documents after the resume so transcripts can verify its education entries
+	for _, rawID := range input.CredentialDocumentIds {
+		docID, parseErr := uuid.Parse(rawID)
+		if parseErr != nil {
+			return &model.ImportDocumentResultsError{
+				Message: "invalid credential document ID format",
+				Field:   stringPtr("credentialDocumentIds"),
+			}, nil
+		}
+
+		doc, getErr := r.credentialDocRepo.GetByID(ctx, docID)
+		if getErr != nil {
+			return nil, fmt.Errorf("failed to get credential document: %w", getErr)
+		}
+		if doc == nil {
+			return &model.ImportDocumentResultsError{
+				Message: "credential document not found",
+				Field:   stringPtr("credentialDocumentIds"),
+			}, nil
+		}
+		if doc.UserID != uid {
+			return &model.ImportDocumentResultsError{
+				Message: "credential document does not belong to user",
+				Field:   stringPtr("credentialDocumentIds"),
+			}, nil
+		}
+		if doc.Status != domain.CredentialDocumentStatusCompleted {
+			return &model.ImportDocumentResultsError{
+				Message: fmt.Sprintf("credential document is not ready for import (status: %s)", doc.Status),
+				Field:   stringPtr("credentialDocumentIds"),
+			}, nil
+		}
+		if len(doc.ExtractedData) == 0 || string(doc.ExtractedData) == jsonNull {
+			continue
+		}
+
+		switch doc.DocumentType {
+		case domain.CredentialDocumentTypeTranscript:
+			var extractedData domain.ExtractedTranscriptData
+			if jsonErr := json.Unmarshal(doc.ExtractedData, &extractedData); jsonErr != nil {
+				return nil, fmt.Errorf("failed to parse extracted transcript data: %w", jsonErr)
+			}
+			matResult, matErr := r.materializationSvc.MaterializeTranscriptData(ctx, docID, uid, &extractedData)
+			if matErr != nil {
+				return nil, fmt.Errorf("failed to materialize transcript data: %w", matErr)
+			}
+			imported.VerifiedEducations += matResult.VerifiedEducations
+		case domain.CredentialDocumentTypePerformanceReview:
+			var extractedData domain.ExtractedPerformanceReviewData
+			if jsonErr := json.Unmarshal(doc.ExtractedData, &extractedData); jsonErr != nil {
+				return nil, fmt.Errorf("failed to parse extracted performance review data: %w", jsonErr)
+			}
+			matResult, matErr := r.materializationSvc.MaterializePerformanceReviewData(ctx, docID, uid, &extractedData)
+			if matErr != nil {
+				return nil, fmt.Errorf("failed to materialize performance review data: %w", matErr)
+			}
+			imported.Testimonials += matResult.Testimonials
+		case domain.CredentialDocumentTypeCertificate:
+			var extractedData domain.ExtractedCertificateData
+			if jsonErr := json.Unmarshal(doc.ExtractedData, &extractedData); jsonErr != nil {
+				return nil, fmt.Errorf("failed to parse extracted certificate data: %w", jsonErr)
+			}
+			matResult, matErr := r.materializationSvc.MaterializeCertificateData(ctx, docID, doc.FileID, uid, &extractedData)
+			if matErr != nil {
+				return nil, fmt.Errorf("failed to materialize certificate data: %w", matErr)
+			}
+			imported.Certifications += matResult.Certifications
+		}
+
+		r.log.Info("Credential document materialized",
+			logger.Feature("document-processing"),
+			logger.String("credential_document_id", docID.String()),
+			logger.String("document_type", string(doc.DocumentType)),
+		)
+	}
+
 	// Get or create the profile to return
 	profile, err := r.profileRepo.GetOrCreateByUserID(ctx, uid)
 	if err != nil {
@@ -1028,10 +1444,21 @@ func (r *mutationResolver) ImportDocumentResults(ctx context.Context, userID str
 				logger.Feature("document-processing"),
 				logger.Int("skill_validations", xrefResult.SkillValidations),
 				logger.Int("experience_validations", xrefResult.ExperienceValidations),
+				logger.Int("education_validations", xrefResult.EducationValidations),
+				logger.Int("inconsistencies", xrefResult.Inconsistencies),
 			)
 		}
 	}
 
+	// Embed the imported evidence for semantic search
+	if enqueueErr := r.jobEnqueuer.EnqueueEvidenceEmbedding(ctx, domain.EvidenceEmbeddingRequest{UserID: uid}); enqueueErr != nil {
+		r.log.Error("Failed to enqueue evidence embedding",
+			logger.Feature("document-processing"),
+			logger.Err(enqueueErr),
+		)
+		// Non-fatal — the import itself succeeded
+	}
+
 	// Build profile response with current data
 	gqlProfile := domainProfileToGQL(profile, nil)
 
@@ -1151,9 +1578,13 @@ func (r *mutationResolver) UpdateProfileHeader(ctx context.Context, userID strin
 	}
 
 	// Update only fields that are provided
+	before := *profile
 	if input.Name != nil {
 		profile.Name = input.Name
 	}
+	if input.Headline != nil {
+		profile.Headline = input.Headline
+	}
 	if input.Email != nil {
 		profile.Email = input.Email
 	}
@@ -1177,6 +1608,8 @@ func (r *mutationResolver) UpdateProfileHeader(ctx context.Context, userID strin
 		return nil, fmt.Errorf("failed to update profile: %w", err)
 	}
 
+	r.recordChanges(ctx, profile.ID, service.ProfileHeaderChanges(&before, profile))
+
 	r.log.Info("Profile header updated successfully",
 		logger.Feature("profile"),
 		logger.String("profile_id", profile.ID.String()),
@@ -1542,17 +1975,6 @@ func (r *mutationResolver) CreateExperience(ctx context.Context, userID string,
 		return nil, fmt.Errorf("failed to get or create profile: %w", err)
 	}
 
-	// Get next display order
-	displayOrder, err := r.profileExpRepo.GetNextDisplayOrder(ctx, profile.ID)
-	if err != nil {
-		r.log.Error("Failed to get next display order",
-			logger.Feature("profile"),
-			logger.String("profile_id", profile.ID.String()),
-			logger.Err(err),
-		)
-		return nil, fmt.Errorf("failed to get next display order: %w", err)
-	}
-
 	// Convert highlights
 	var highlights []string
 	if input.Highlights != nil {
@@ -1561,18 +1983,47 @@ func (r *mutationResolver) CreateExperience(ctx context.Context, userID string,
 
 	// Create experience
 	experience := &domain.ProfileExperience{
-		ID:           uuid.New(),
-		ProfileID:    profile.ID,
-		Company:      input.Company,
-		Title:        input.Title,
-		Location:     input.Location,
-		StartDate:    input.StartDate,
-		EndDate:      input.EndDate,
-		IsCurrent:    input.IsCurrent,
-		Description:  input.Description,
-		Highlights:   highlights,
-		DisplayOrder: displayOrder,
-		Source:       domain.ExperienceSourceManual,
+		ID:          uuid.New(),
+		ProfileID:   profile.ID,
+		Company:     input.Company,
+		Title:       input.Title,
+		Location:    input.Location,
+		StartDate:   input.StartDate,
+		EndDate:     input.EndDate,
+		IsCurrent:   input.IsCurrent,
+		Description: input.Description,
+		Highlights:  highlights,
+		Source:      domain.ExperienceSourceManual,
+	}
+	if err := service.SetExperienceDates(experience); err != nil {
+		r.log.Warning("Experience ends before it starts",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+		)
+		return &model.ExperienceValidationError{
+			Message: "end date cannot be before start date",
+			Field:   stringPtr("endDate"),
+		}, nil
+	}
+
+	// Link the experience to its company entity
+	experience.CompanyID, err = r.linkCompany(ctx, profile.ID, input.Company)
+	if err != nil {
+		r.log.Error("Failed to link company",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to link company: %w", err)
+	}
+
+	if err := service.PlaceExperiences(ctx, r.profileExpRepo, []*domain.ProfileExperience{experience}); err != nil {
+		r.log.Error("Failed to place experience",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to place experience: %w", err)
 	}
 
 	if err := r.profileExpRepo.Create(ctx, experience); err != nil {
@@ -1584,6 +2035,9 @@ func (r *mutationResolver) CreateExperience(ctx context.Context, userID string,
 		return nil, fmt.Errorf("failed to create experience: %w", err)
 	}
 
+	r.recordChanges(ctx, profile.ID, service.ExperienceChanges(nil, experience))
+	r.recomputeSkillStats(ctx, profile.ID)
+
 	r.log.Info("Work experience created",
 		logger.Feature("profile"),
 		logger.String("user_id", userID),
@@ -1637,7 +2091,20 @@ func (r *mutationResolver) UpdateExperience(ctx context.Context, id string, inpu
 	}
 
 	// Update fields if provided
+	before := *experience
 	if input.Company != nil {
+		if *input.Company != experience.Company || experience.CompanyID == nil {
+			companyID, err := r.linkCompany(ctx, experience.ProfileID, *input.Company)
+			if err != nil {
+				r.log.Error("Failed to link company",
+					logger.Feature("profile"),
+					logger.String("experience_id", id),
+					logger.Err(err),
+				)
+				return nil, fmt.Errorf("failed to link company: %w", err)
+			}
+			experience.CompanyID = companyID
+		}
 		experience.Company = *input.Company
 	}
 	if input.Title != nil {
@@ -1661,6 +2128,21 @@ func (r *mutationResolver) UpdateExperience(ctx context.Context, id string, inpu
 	if input.Highlights != nil {
 		experience.Highlights = input.Highlights
 	}
+	if err := service.SetExperienceDates(experience); err != nil {
+		r.log.Warning("Experience ends before it starts",
+			logger.Feature("profile"),
+			logger.String("experience_id", id),
+		)
+		return &model.ExperienceValidationError{
+			Message: "end date cannot be before start date",
+			Field:   stringPtr("endDate"),
+		}, nil
+	}
+	if experience.Dates() != before.Dates() {
+		if err := service.PlaceExperiences(ctx, r.profileExpRepo, []*domain.ProfileExperience{experience}); err != nil {
+			return nil, fmt.Errorf("failed to place experience: %w", err)
+		}
+	}
 
 	if err := r.profileExpRepo.Update(ctx, experience); err != nil {
 		r.log.Error("Failed to update experience",
@@ -1671,6 +2153,9 @@ func (r *mutationResolver) UpdateExperience(ctx context.Context, id string, inpu
 		return nil, fmt.Errorf("failed to update experience: %w", err)
 	}
 
+	r.recordChanges(ctx, experience.ProfileID, service.ExperienceChanges(&before, experience))
+	r.recomputeSkillStats(ctx, experience.ProfileID)
+
 	r.log.Info("Work experience updated",
 		logger.Feature("profile"),
 		logger.String("experience_id", id),
@@ -1732,6 +2217,9 @@ func (r *mutationResolver) DeleteExperience(ctx context.Context, id string) (*mo
 		return nil, fmt.Errorf("failed to delete experience: %w", err)
 	}
 
+	r.recordChanges(ctx, experience.ProfileID, service.ExperienceChanges(experience, nil))
+	r.recomputeSkillStats(ctx, experience.ProfileID)
+
 	r.log.Info("Work experience deleted",
 		logger.Feature("profile"),
 		logger.String("experience_id", id),
@@ -1743,6 +2231,146 @@ func (r *mutationResolver) DeleteExperience(ctx context.Context, id string) (*mo
 	}, nil
 }
 
+// MergeExperiences is the resolver for the mergeExperiences field.
+func (r *mutationResolver) MergeExperiences(ctx context.Context, targetID string, sourceIds []string) (model.ExperienceResponse, error) {
+	r.log.Info("Merging experiences",
+		logger.Feature("profile"),
+		logger.String("target_id", targetID),
+		logger.Int("source_count", len(sourceIds)),
+	)
+
+	tid, err := uuid.Parse(targetID)
+	if err != nil {
+		return &model.ExperienceValidationError{
+			Message: "invalid target experience ID format",
+			Field:   stringPtr("targetId"),
+		}, nil
+	}
+	if len(sourceIds) == 0 {
+		return &model.ExperienceValidationError{
+			Message: "at least one source experience is required",
+			Field:   stringPtr("sourceIds"),
+		}, nil
+	}
+
+	target, err := r.profileExpRepo.GetByID(ctx, tid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experience: %w", err)
+	}
+	if target == nil {
+		return &model.ExperienceValidationError{
+			Message: "target experience not found",
+			Field:   stringPtr("targetId"),
+		}, nil
+	}
+
+	before := *target
+	sources := make([]*domain.ProfileExperience, 0, len(sourceIds))
+	sourceIDs := make([]uuid.UUID, 0, len(sourceIds))
+	for _, sourceID := range sourceIds {
+		sid, err := uuid.Parse(sourceID)
+		if err != nil {
+			return &model.ExperienceValidationError{
+				Message: "invalid source experience ID format",
+				Field:   stringPtr("sourceIds"),
+			}, nil
+		}
+		if sid == tid {
+			return &model.ExperienceValidationError{
+				Message: "an experience cannot be merged into itself",
+				Field:   stringPtr("sourceIds"),
+			}, nil
+		}
+		if slices.Contains(sourceIDs, sid) {
+			return &model.ExperienceValidationError{
+				Message: "a source experience is listed more than once",
+				Field:   stringPtr("sourceIds"),
+			}, nil
+		}
+		source, err := r.profileExpRepo.GetByID(ctx, sid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get experience: %w", err)
+		}
+		if source == nil || source.ProfileID != target.ProfileID {
+			return &model.ExperienceValidationError{
+				Message: "source experience not found",
+				Field:   stringPtr("sourceIds"),
+			}, nil
+		}
+		copied := *source
+		sources = append(sources, &copied)
+		sourceIDs = append(sourceIDs, sid)
+	}
+
+	merged, err := r.profileExpRepo.Merge(ctx, tid, sourceIDs)
+	if err != nil {
+		r.log.Error("Failed to merge experiences",
+			logger.Feature("profile"),
+			logger.String("target_id", targetID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to merge experiences: %w", err)
+	}
+
+	changes := service.ExperienceChanges(&before, merged)
+	for _, source := range sources {
+		changes = append(changes, service.ExperienceChanges(source, nil)...)
+	}
+	r.recordChanges(ctx, merged.ProfileID, changes)
+	r.recomputeSkillStats(ctx, merged.ProfileID)
+
+	r.log.Info("Experiences merged",
+		logger.Feature("profile"),
+		logger.String("target_id", targetID),
+		logger.Int("source_count", len(sourceIDs)),
+	)
+
+	return &model.ExperienceResult{
+		Experience: toGraphQLProfileExperience(merged),
+	}, nil
+}
+
+// SetCareerGapNote is the resolver for the setCareerGapNote field.
+func (r *mutationResolver) SetCareerGapNote(ctx context.Context, previousRoleID string, note *string) (model.ExperienceResponse, error) {
+	expID, err := uuid.Parse(previousRoleID)
+	if err != nil {
+		return &model.ExperienceValidationError{
+			Message: "invalid experience ID format",
+			Field:   stringPtr("previousRoleId"),
+		}, nil
+	}
+
+	experience, err := r.profileExpRepo.GetByID(ctx, expID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experience: %w", err)
+	}
+	if experience == nil {
+		return &model.ExperienceValidationError{
+			Message: "experience not found",
+			Field:   stringPtr("previousRoleId"),
+		}, nil
+	}
+
+	before := *experience
+	experience.GapNote = nil
+	if note != nil && strings.TrimSpace(*note) != "" {
+		experience.GapNote = stringPtr(strings.TrimSpace(*note))
+	}
+	if err := r.profileExpRepo.Update(ctx, experience); err != nil {
+		r.log.Error("Failed to set career gap note",
+			logger.Feature("profile"),
+			logger.String("experience_id", previousRoleID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update experience: %w", err)
+	}
+	r.recordChanges(ctx, experience.ProfileID, service.ExperienceChanges(&before, experience))
+
+	return &model.ExperienceResult{
+		Experience: toGraphQLProfileExperience(experience),
+	}, nil
+}
+
 // CreateEducation is the resolver for the createEducation field.
 func (r *mutationResolver) CreateEducation(ctx context.Context, userID string, input model.CreateEducationInput) (model.EducationResponse, error) {
 	r.log.Info("Creating education entry",
@@ -1795,31 +2423,38 @@ func (r *mutationResolver) CreateEducation(ctx context.Context, userID string, i
 		return nil, fmt.Errorf("failed to get or create profile: %w", err)
 	}
 
-	// Get next display order
-	displayOrder, err := r.profileEduRepo.GetNextDisplayOrder(ctx, profile.ID)
-	if err != nil {
-		r.log.Error("Failed to get next display order",
+	// Create education entry
+	education := &domain.ProfileEducation{
+		ID:          uuid.New(),
+		ProfileID:   profile.ID,
+		Institution: input.Institution,
+		Degree:      input.Degree,
+		Field:       input.Field,
+		StartDate:   input.StartDate,
+		EndDate:     input.EndDate,
+		IsCurrent:   input.IsCurrent,
+		Description: input.Description,
+		GPA:         input.Gpa,
+		Source:      domain.ExperienceSourceManual,
+	}
+	if err := service.SetEducationDates(education); err != nil {
+		r.log.Warning("Education ends before it starts",
 			logger.Feature("profile"),
 			logger.String("profile_id", profile.ID.String()),
-			logger.Err(err),
 		)
-		return nil, fmt.Errorf("failed to get next display order: %w", err)
+		return &model.EducationValidationError{
+			Message: "end date cannot be before start date",
+			Field:   stringPtr("endDate"),
+		}, nil
 	}
 
-	// Create education entry
-	education := &domain.ProfileEducation{
-		ID:           uuid.New(),
-		ProfileID:    profile.ID,
-		Institution:  input.Institution,
-		Degree:       input.Degree,
-		Field:        input.Field,
-		StartDate:    input.StartDate,
-		EndDate:      input.EndDate,
-		IsCurrent:    input.IsCurrent,
-		Description:  input.Description,
-		GPA:          input.Gpa,
-		DisplayOrder: displayOrder,
-		Source:       domain.ExperienceSourceManual,
+	if err := service.PlaceEducations(ctx, r.profileEduRepo, []*domain.ProfileEducation{education}); err != nil {
+		r.log.Error("Failed to place education",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to place education: %w", err)
 	}
 
 	if err := r.profileEduRepo.Create(ctx, education); err != nil {
@@ -1831,6 +2466,8 @@ func (r *mutationResolver) CreateEducation(ctx context.Context, userID string, i
 		return nil, fmt.Errorf("failed to create education: %w", err)
 	}
 
+	r.recordChanges(ctx, profile.ID, service.EducationChanges(nil, education))
+
 	r.log.Info("Education entry created",
 		logger.Feature("profile"),
 		logger.String("user_id", userID),
@@ -1884,6 +2521,7 @@ func (r *mutationResolver) UpdateEducation(ctx context.Context, id string, input
 	}
 
 	// Update fields if provided
+	before := *education
 	if input.Institution != nil {
 		education.Institution = *input.Institution
 	}
@@ -1908,6 +2546,21 @@ func (r *mutationResolver) UpdateEducation(ctx context.Context, id string, input
 	if input.Gpa != nil {
 		education.GPA = input.Gpa
 	}
+	if err := service.SetEducationDates(education); err != nil {
+		r.log.Warning("Education ends before it starts",
+			logger.Feature("profile"),
+			logger.String("education_id", id),
+		)
+		return &model.EducationValidationError{
+			Message: "end date cannot be before start date",
+			Field:   stringPtr("endDate"),
+		}, nil
+	}
+	if education.Dates() != before.Dates() {
+		if err := service.PlaceEducations(ctx, r.profileEduRepo, []*domain.ProfileEducation{education}); err != nil {
+			return nil, fmt.Errorf("failed to place education: %w", err)
+		}
+	}
 
 	if err := r.profileEduRepo.Update(ctx, education); err != nil {
 		r.log.Error("Failed to update education",
@@ -1918,6 +2571,8 @@ func (r *mutationResolver) UpdateEducation(ctx context.Context, id string, input
 		return nil, fmt.Errorf("failed to update education: %w", err)
 	}
 
+	r.recordChanges(ctx, education.ProfileID, service.EducationChanges(&before, education))
+
 	r.log.Info("Education entry updated",
 		logger.Feature("profile"),
 		logger.String("education_id", id),
@@ -1979,6 +2634,8 @@ func (r *mutationResolver) DeleteEducation(ctx context.Context, id string) (*mod
 		return nil, fmt.Errorf("failed to delete education: %w", err)
 	}
 
+	r.recordChanges(ctx, education.ProfileID, service.EducationChanges(education, nil))
+
 	r.log.Info("Education entry deleted",
 		logger.Feature("profile"),
 		logger.String("education_id", id),
@@ -1990,231 +2647,192 @@ func (r *mutationResolver) DeleteEducation(ctx context.Context, id string) (*mod
 	}, nil
 }
 
-// CreateSkill is the resolver for the createSkill field.
-func (r *mutationResolver) CreateSkill(ctx context.Context, userID string, input model.CreateSkillInput) (model.SkillResponse, error) {
-	r.log.Info("Creating skill",
+// CreateLink is the resolver for the createLink field.
+func (r *mutationResolver) CreateLink(ctx context.Context, userID string, input model.CreateLinkInput) (model.LinkResponse, error) {
+	r.log.Info("Creating profile link",
 		logger.Feature("profile"),
 		logger.String("user_id", userID),
 	)
 
-	// Parse and validate user ID
-	uid, err := uuid.Parse(userID)
-	if err != nil {
-		r.log.Warning("Invalid user ID format",
-			logger.Feature("profile"),
-			logger.String("user_id", userID),
-		)
-		return &model.SkillValidationError{
-			Message: "invalid user ID format",
-			Field:   stringPtr("userId"),
+	url, ok := domain.NormalizeLinkURL(input.URL)
+	if !ok {
+		return &model.LinkValidationError{
+			Message: "url must be an http or https address",
+			Field:   stringPtr("url"),
 		}, nil
 	}
-
-	// Validate skill name
-	if strings.TrimSpace(input.Name) == "" {
-		return &model.SkillValidationError{
-			Message: "skill name is required",
-			Field:   stringPtr("name"),
-		}, nil
+	kind := domain.LinkKindForURL(url)
+	if input.Kind != nil {
+		kind = domain.LinkKind(strings.ToLower(string(*input.Kind)))
 	}
 
-	// Verify user exists
-	user, err := r.userRepo.GetByID(ctx, uid)
+	profile, invalid, err := r.sectionOwnerProfile(ctx, userID)
 	if err != nil {
-		r.log.Error("Failed to verify user",
+		r.log.Error("Failed to get profile for link",
 			logger.Feature("profile"),
 			logger.String("user_id", userID),
 			logger.Err(err),
 		)
-		return nil, fmt.Errorf("failed to verify user: %w", err)
+		return nil, err
 	}
-	if user == nil {
-		r.log.Warning("User not found",
-			logger.Feature("profile"),
-			logger.String("user_id", userID),
-		)
-		return &model.SkillValidationError{
-			Message: "user not found",
+	if invalid != "" {
+		return &model.LinkValidationError{
+			Message: invalid,
 			Field:   stringPtr("userId"),
 		}, nil
 	}
 
-	// Get or create profile for user
-	profile, err := r.profileRepo.GetOrCreateByUserID(ctx, uid)
-	if err != nil {
-		r.log.Error("Failed to get or create profile",
-			logger.Feature("profile"),
-			logger.String("user_id", userID),
-			logger.Err(err),
-		)
-		return nil, fmt.Errorf("failed to get or create profile: %w", err)
-	}
-
-	// Get next display order
-	displayOrder, err := r.profileSkillRepo.GetNextDisplayOrder(ctx, profile.ID)
+	displayOrder, err := r.linkRepo.GetNextDisplayOrder(ctx, profile.ID)
 	if err != nil {
-		r.log.Error("Failed to get next display order",
-			logger.Feature("profile"),
-			logger.String("profile_id", profile.ID.String()),
-			logger.Err(err),
-		)
 		return nil, fmt.Errorf("failed to get next display order: %w", err)
 	}
 
-	// Create skill
-	skill := &domain.ProfileSkill{
-		ID:             uuid.New(),
-		ProfileID:      profile.ID,
-		Name:           input.Name,
-		NormalizedName: normalizeSkillName(input.Name),
-		Category:       strings.ToUpper(string(input.Category)),
-		DisplayOrder:   displayOrder,
-		Source:         domain.ExperienceSourceManual,
+	link := &domain.ProfileLink{
+		ID:           uuid.New(),
+		ProfileID:    profile.ID,
+		Kind:         kind,
+		URL:          url,
+		Label:        input.Label,
+		DisplayOrder: displayOrder,
+		Source:       domain.ExperienceSourceManual,
 	}
-
-	if err := r.profileSkillRepo.Create(ctx, skill); err != nil {
-		r.log.Error("Failed to create skill",
+	if err := r.linkRepo.Create(ctx, link); err != nil {
+		r.log.Error("Failed to create link",
 			logger.Feature("profile"),
 			logger.String("profile_id", profile.ID.String()),
 			logger.Err(err),
 		)
-		return nil, fmt.Errorf("failed to create skill: %w", err)
+		return nil, fmt.Errorf("failed to create link: %w", err)
 	}
 
-	r.log.Info("Skill created",
+	r.recordChanges(ctx, profile.ID, service.LinkChanges(nil, link))
+
+	r.log.Info("Profile link created",
 		logger.Feature("profile"),
 		logger.String("user_id", userID),
-		logger.String("skill_id", skill.ID.String()),
+		logger.String("link_id", link.ID.String()),
 	)
 
-	return &model.SkillResult{
-		Skill: toGraphQLProfileSkill(skill),
+	return &model.LinkResult{
+		Link: toGraphQLProfileLink(link),
 	}, nil
 }
 
-// UpdateSkill is the resolver for the updateSkill field.
-func (r *mutationResolver) UpdateSkill(ctx context.Context, id string, input model.UpdateSkillInput) (model.SkillResponse, error) {
-	r.log.Info("Updating skill",
+// UpdateLink is the resolver for the updateLink field.
+func (r *mutationResolver) UpdateLink(ctx context.Context, id string, input model.UpdateLinkInput) (model.LinkResponse, error) {
+	r.log.Info("Updating profile link",
 		logger.Feature("profile"),
-		logger.String("skill_id", id),
+		logger.String("link_id", id),
 	)
 
-	// Parse and validate skill ID
-	skillID, err := uuid.Parse(id)
+	linkID, err := uuid.Parse(id)
 	if err != nil {
-		r.log.Warning("Invalid skill ID format",
-			logger.Feature("profile"),
-			logger.String("skill_id", id),
-		)
-		return &model.SkillValidationError{
-			Message: "invalid skill ID format",
+		return &model.LinkValidationError{
+			Message: "invalid link ID format",
 			Field:   stringPtr("id"),
 		}, nil
 	}
 
-	// Get existing skill
-	skill, err := r.profileSkillRepo.GetByID(ctx, skillID)
+	link, err := r.linkRepo.GetByID(ctx, linkID)
 	if err != nil {
-		r.log.Error("Failed to get skill",
+		r.log.Error("Failed to get link",
 			logger.Feature("profile"),
-			logger.String("skill_id", id),
+			logger.String("link_id", id),
 			logger.Err(err),
 		)
-		return nil, fmt.Errorf("failed to get skill: %w", err)
+		return nil, fmt.Errorf("failed to get link: %w", err)
 	}
-	if skill == nil {
-		r.log.Warning("Skill not found",
-			logger.Feature("profile"),
-			logger.String("skill_id", id),
-		)
-		return &model.SkillValidationError{
-			Message: "skill not found",
+	if link == nil {
+		return &model.LinkValidationError{
+			Message: "link not found",
 			Field:   stringPtr("id"),
 		}, nil
 	}
 
-	// Update fields if provided
-	if input.Name != nil {
-		skill.Name = *input.Name
-		skill.NormalizedName = normalizeSkillName(*input.Name)
+	before := *link
+	if input.URL != nil {
+		url, ok := domain.NormalizeLinkURL(*input.URL)
+		if !ok {
+			return &model.LinkValidationError{
+				Message: "url must be an http or https address",
+				Field:   stringPtr("url"),
+			}, nil
+		}
+		link.URL = url
 	}
-	if input.Category != nil {
-		skill.Category = strings.ToUpper(string(*input.Category))
+	if input.Kind != nil {
+		link.Kind = domain.LinkKind(strings.ToLower(string(*input.Kind)))
+	}
+	if input.Label != nil {
+		link.Label = input.Label
 	}
 
-	if err := r.profileSkillRepo.Update(ctx, skill); err != nil {
-		r.log.Error("Failed to update skill",
+	if err := r.linkRepo.Update(ctx, link); err != nil {
+		r.log.Error("Failed to update link",
 			logger.Feature("profile"),
-			logger.String("skill_id", id),
+			logger.String("link_id", id),
 			logger.Err(err),
 		)
-		return nil, fmt.Errorf("failed to update skill: %w", err)
+		return nil, fmt.Errorf("failed to update link: %w", err)
 	}
 
-	r.log.Info("Skill updated",
+	r.recordChanges(ctx, link.ProfileID, service.LinkChanges(&before, link))
+
+	r.log.Info("Profile link updated",
 		logger.Feature("profile"),
-		logger.String("skill_id", id),
+		logger.String("link_id", id),
 	)
 
-	return &model.SkillResult{
-		Skill: toGraphQLProfileSkill(skill),
+	return &model.LinkResult{
+		Link: toGraphQLProfileLink(link),
 	}, nil
 }
 
-// DeleteSkill is the resolver for the deleteSkill field.
-func (r *mutationResolver) DeleteSkill(ctx context.Context, id string) (*model.DeleteResult, error) {
-	r.log.Info("Deleting skill",
+// DeleteLink is the resolver for the deleteLink field.
+func (r *mutationResolver) DeleteLink(ctx context.Context, id string) (*model.DeleteResult, error) {
+	r.log.Info("Deleting profile link",
 		logger.Feature("profile"),
-		logger.String("skill_id", id),
+		logger.String("link_id", id),
 	)
 
-	// Parse and validate skill ID
-	skillID, err := uuid.Parse(id)
+	linkID, err := uuid.Parse(id)
 	if err != nil {
-		r.log.Warning("Invalid skill ID format",
-			logger.Feature("profile"),
-			logger.String("skill_id", id),
-		)
 		return &model.DeleteResult{
 			Success:   false,
 			DeletedID: id,
 		}, nil
 	}
 
-	// Check if skill exists
-	skill, err := r.profileSkillRepo.GetByID(ctx, skillID)
+	link, err := r.linkRepo.GetByID(ctx, linkID)
 	if err != nil {
-		r.log.Error("Failed to get skill",
+		r.log.Error("Failed to get link",
 			logger.Feature("profile"),
-			logger.String("skill_id", id),
+			logger.String("link_id", id),
 			logger.Err(err),
 		)
-		return nil, fmt.Errorf("failed to get skill: %w", err)
+		return nil, fmt.Errorf("failed to get link: %w", err)
 	}
-	if skill == nil {
-		r.log.Warning("Skill not found",
-			logger.Feature("profile"),
-			logger.String("skill_id", id),
-		)
+	if link == nil {
 		return &model.DeleteResult{
 			Success:   false,
 			DeletedID: id,
 		}, nil
 	}
 
-	// Delete skill
-	if err := r.profileSkillRepo.Delete(ctx, skillID); err != nil {
-		r.log.Error("Failed to delete skill",
+	if err := r.linkRepo.Delete(ctx, linkID); err != nil {
+		r.log.Error("Failed to delete link",
 			logger.Feature("profile"),
-			logger.String("skill_id", id),
+			logger.String("link_id", id),
 			logger.Err(err),
 		)
-		return nil, fmt.Errorf("failed to delete skill: %w", err)
+		return nil, fmt.Errorf("failed to delete link: %w", err)
 	}
 
-	r.log.Info("Skill deleted",
+	r.recordChanges(ctx, link.ProfileID, service.LinkChanges(link, nil))
+
+	r.log.Info("Profile link deleted",
 		logger.Feature("profile"),
-		logger.String("skill_id", id),
+		logger.String("link_id", id),
 	)
 
 	return &model.DeleteResult{
@@ -2223,1281 +2841,5218 @@ func (r *mutationResolver) DeleteSkill(ctx context.Context, id string) (*model.D
 	}, nil
 }
 
-// ApplyReferenceLetterValidations is the resolver for the applyReferenceLetterValidations field.
-func (r *mutationResolver) ApplyReferenceLetterValidations(ctx context.Context, userID string, input model.ApplyValidationsInput) (model.ApplyValidationsResponse, error) {
-	r.log.Info("Applying reference letter validations",
-		logger.Feature("credibility"),
+// CreateCertification is the resolver for the createCertification field.
+func (r *mutationResolver) CreateCertification(ctx context.Context, userID string, input model.CreateCertificationInput) (model.CertificationResponse, error) {
+	r.log.Info("Creating profile certification",
+		logger.Feature("profile"),
 		logger.String("user_id", userID),
-		logger.String("reference_letter_id", input.ReferenceLetterID),
 	)
 
-	// Parse and validate user ID
-	uid, err := uuid.Parse(userID)
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return &model.CertificationValidationError{
+			Message: "name is required",
+			Field:   stringPtr("name"),
+		}, nil
+	}
+
+	profile, invalid, err := r.sectionOwnerProfile(ctx, userID)
 	if err != nil {
-		r.log.Warning("Invalid user ID format",
-			logger.Feature("credibility"),
+		r.log.Error("Failed to get profile for certification",
+			logger.Feature("profile"),
 			logger.String("user_id", userID),
+			logger.Err(err),
 		)
-		return &model.ApplyValidationsError{
-			Message: "invalid user ID format",
+		return nil, err
+	}
+	if invalid != "" {
+		return &model.CertificationValidationError{
+			Message: invalid,
 			Field:   stringPtr("userId"),
 		}, nil
 	}
 
-	// Parse and validate reference letter ID
-	refLetterID, err := uuid.Parse(input.ReferenceLetterID)
+	displayOrder, err := r.certificationRepo.GetNextDisplayOrder(ctx, profile.ID)
 	if err != nil {
-		r.log.Warning("Invalid reference letter ID format",
-			logger.Feature("credibility"),
-			logger.String("reference_letter_id", input.ReferenceLetterID),
+		return nil, fmt.Errorf("failed to get next display order: %w", err)
+	}
+
+	certification := &domain.ProfileCertification{
+		ID:              uuid.New(),
+		ProfileID:       profile.ID,
+		Name:            name,
+		Issuer:          input.Issuer,
+		CredentialID:    input.CredentialID,
+		VerificationURL: input.VerificationURL,
+		IssueDate:       input.IssueDate,
+		ExpiryDate:      input.ExpiryDate,
+		DisplayOrder:    displayOrder,
+		Source:          domain.ExperienceSourceManual,
+	}
+	if err := service.SetCertificationExpiry(certification); err != nil {
+		return &model.CertificationValidationError{
+			Message: "expiry date cannot be before issue date",
+			Field:   stringPtr("expiryDate"),
+		}, nil
+	}
+	if err := r.certificationRepo.Create(ctx, certification); err != nil {
+		r.log.Error("Failed to create certification",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
 		)
-		return &model.ApplyValidationsError{
-			Message: "invalid reference letter ID format",
-			Field:   stringPtr("referenceLetterID"),
+		return nil, fmt.Errorf("failed to create certification: %w", err)
+	}
+
+	r.recordChanges(ctx, profile.ID, service.CertificationChanges(nil, certification))
+
+	r.log.Info("Profile certification created",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+		logger.String("certification_id", certification.ID.String()),
+	)
+
+	return &model.CertificationResult{
+		Certification: toGraphQLProfileCertification(certification),
+	}, nil
+}
+
+// UpdateCertification is the resolver for the updateCertification field.
+func (r *mutationResolver) UpdateCertification(ctx context.Context, id string, input model.UpdateCertificationInput) (model.CertificationResponse, error) {
+	r.log.Info("Updating profile certification",
+		logger.Feature("profile"),
+		logger.String("certification_id", id),
+	)
+
+	certificationID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.CertificationValidationError{
+			Message: "invalid certification ID format",
+			Field:   stringPtr("id"),
 		}, nil
 	}
 
-	// Verify user exists
-	user, err := r.userRepo.GetByID(ctx, uid)
+	certification, err := r.certificationRepo.GetByID(ctx, certificationID)
 	if err != nil {
-		r.log.Error("Failed to verify user",
-			logger.Feature("credibility"),
-			logger.String("user_id", userID),
+		r.log.Error("Failed to get certification",
+			logger.Feature("profile"),
+			logger.String("certification_id", id),
 			logger.Err(err),
 		)
-		return nil, fmt.Errorf("failed to verify user: %w", err)
+		return nil, fmt.Errorf("failed to get certification: %w", err)
 	}
-	if user == nil {
-		r.log.Warning("User not found",
-			logger.Feature("credibility"),
-			logger.String("user_id", userID),
+	if certification == nil {
+		return &model.CertificationValidationError{
+			Message: "certification not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	before := *certification
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" {
+			return &model.CertificationValidationError{
+				Message: "name cannot be empty",
+				Field:   stringPtr("name"),
+			}, nil
+		}
+		certification.Name = name
+	}
+	if input.Issuer != nil {
+		certification.Issuer = input.Issuer
+	}
+	if input.CredentialID != nil {
+		certification.CredentialID = input.CredentialID
+	}
+	if input.VerificationURL != nil {
+		certification.VerificationURL = input.VerificationURL
+	}
+	if input.IssueDate != nil {
+		certification.IssueDate = input.IssueDate
+	}
+	if input.ExpiryDate != nil {
+		if certification.ExpiryDate == nil || *certification.ExpiryDate != *input.ExpiryDate {
+			// The next expiry check decides whether the new date is expiring
+			certification.ExpiringFlaggedAt = nil
+		}
+		certification.ExpiryDate = input.ExpiryDate
+	}
+	if err := service.SetCertificationExpiry(certification); err != nil {
+		return &model.CertificationValidationError{
+			Message: "expiry date cannot be before issue date",
+			Field:   stringPtr("expiryDate"),
+		}, nil
+	}
+
+	if err := r.certificationRepo.Update(ctx, certification); err != nil {
+		r.log.Error("Failed to update certification",
+			logger.Feature("profile"),
+			logger.String("certification_id", id),
+			logger.Err(err),
 		)
-		return &model.ApplyValidationsError{
-			Message: "user not found",
-			Field:   stringPtr("userId"),
+		return nil, fmt.Errorf("failed to update certification: %w", err)
+	}
+
+	r.recordChanges(ctx, certification.ProfileID, service.CertificationChanges(&before, certification))
+
+	r.log.Info("Profile certification updated",
+		logger.Feature("profile"),
+		logger.String("certification_id", id),
+	)
+
+	return &model.CertificationResult{
+		Certification: toGraphQLProfileCertification(certification),
+	}, nil
+}
+
+// DeleteCertification is the resolver for the deleteCertification field.
+func (r *mutationResolver) DeleteCertification(ctx context.Context, id string) (*model.DeleteResult, error) {
+	r.log.Info("Deleting profile certification",
+		logger.Feature("profile"),
+		logger.String("certification_id", id),
+	)
+
+	certificationID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
 		}, nil
 	}
 
-	// Get reference letter and verify it belongs to user
-	refLetter, err := r.refLetterRepo.GetByID(ctx, refLetterID)
+	certification, err := r.certificationRepo.GetByID(ctx, certificationID)
 	if err != nil {
-		r.log.Error("Failed to get reference letter",
-			logger.Feature("credibility"),
-			logger.String("reference_letter_id", input.ReferenceLetterID),
+		r.log.Error("Failed to get certification",
+			logger.Feature("profile"),
+			logger.String("certification_id", id),
 			logger.Err(err),
 		)
-		return nil, fmt.Errorf("failed to get reference letter: %w", err)
+		return nil, fmt.Errorf("failed to get certification: %w", err)
 	}
-	if refLetter == nil {
-		r.log.Warning("Reference letter not found",
-			logger.Feature("credibility"),
-			logger.String("reference_letter_id", input.ReferenceLetterID),
+	if certification == nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	if err := r.certificationRepo.Delete(ctx, certificationID); err != nil {
+		r.log.Error("Failed to delete certification",
+			logger.Feature("profile"),
+			logger.String("certification_id", id),
+			logger.Err(err),
 		)
-		return &model.ApplyValidationsError{
-			Message: "reference letter not found",
-			Field:   stringPtr("referenceLetterID"),
+		return nil, fmt.Errorf("failed to delete certification: %w", err)
+	}
+
+	r.recordChanges(ctx, certification.ProfileID, service.CertificationChanges(certification, nil))
+
+	r.log.Info("Profile certification deleted",
+		logger.Feature("profile"),
+		logger.String("certification_id", id),
+	)
+
+	return &model.DeleteResult{
+		Success:   true,
+		DeletedID: id,
+	}, nil
+}
+
+// CreateLanguage is the resolver for the createLanguage field.
+func (r *mutationResolver) CreateLanguage(ctx context.Context, userID string, input model.CreateLanguageInput) (model.LanguageResponse, error) {
+	r.log.Info("Creating profile language",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+	)
+
+	name := strings.TrimSpace(input.Language)
+	if name == "" {
+		return &model.LanguageValidationError{
+			Message: "language is required",
+			Field:   stringPtr("language"),
 		}, nil
 	}
-	if refLetter.UserID != uid {
-		r.log.Warning("Reference letter does not belong to user",
-			logger.Feature("credibility"),
+	var proficiency *domain.LanguageProficiency
+	if input.Proficiency != nil {
+		p := domain.LanguageProficiency(strings.ToLower(string(*input.Proficiency)))
+		proficiency = &p
+	}
+
+	profile, invalid, err := r.sectionOwnerProfile(ctx, userID)
+	if err != nil {
+		r.log.Error("Failed to get profile for language",
+			logger.Feature("profile"),
 			logger.String("user_id", userID),
-			logger.String("reference_letter_id", input.ReferenceLetterID),
+			logger.Err(err),
 		)
-		return &model.ApplyValidationsError{
-			Message: "reference letter does not belong to user",
-			Field:   stringPtr("referenceLetterID"),
+		return nil, err
+	}
+	if invalid != "" {
+		return &model.LanguageValidationError{
+			Message: invalid,
+			Field:   stringPtr("userId"),
 		}, nil
 	}
 
-	// Reference letter must be completed or applied for validations to be applied
-	// (allowing re-application of validations with different selections)
-	if refLetter.Status != domain.ReferenceLetterStatusCompleted && refLetter.Status != domain.ReferenceLetterStatusApplied {
-		r.log.Warning("Reference letter not completed",
-			logger.Feature("credibility"),
-			logger.String("reference_letter_id", input.ReferenceLetterID),
-			logger.String("status", string(refLetter.Status)),
+	displayOrder, err := r.languageRepo.GetNextDisplayOrder(ctx, profile.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get next display order: %w", err)
+	}
+
+	language := &domain.ProfileLanguage{
+		ID:           uuid.New(),
+		ProfileID:    profile.ID,
+		Language:     name,
+		Proficiency:  proficiency,
+		DisplayOrder: displayOrder,
+		Source:       domain.ExperienceSourceManual,
+	}
+	if err := r.languageRepo.Create(ctx, language); err != nil {
+		r.log.Error("Failed to create language",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
 		)
-		return &model.ApplyValidationsError{
-			Message: "reference letter extraction not completed",
-			Field:   stringPtr("referenceLetterID"),
+		return nil, fmt.Errorf("failed to create language: %w", err)
+	}
+
+	r.recordChanges(ctx, profile.ID, service.LanguageChanges(nil, language))
+
+	r.log.Info("Profile language created",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+		logger.String("language_id", language.ID.String()),
+	)
+
+	return &model.LanguageResult{
+		Language: toGraphQLProfileLanguage(language),
+	}, nil
+}
+
+// UpdateLanguage is the resolver for the updateLanguage field.
+func (r *mutationResolver) UpdateLanguage(ctx context.Context, id string, input model.UpdateLanguageInput) (model.LanguageResponse, error) {
+	r.log.Info("Updating profile language",
+		logger.Feature("profile"),
+		logger.String("language_id", id),
+	)
+
+	languageID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.LanguageValidationError{
+			Message: "invalid language ID format",
+			Field:   stringPtr("id"),
 		}, nil
 	}
 
-	// Parse extracted data to get author info for testimonials
-	var extractedData domain.ExtractedLetterData
-	if len(refLetter.ExtractedData) > 0 && string(refLetter.ExtractedData) != jsonNull {
-		if jsonErr := json.Unmarshal(refLetter.ExtractedData, &extractedData); jsonErr != nil {
-			r.log.Error("Failed to parse extracted data",
-				logger.Feature("credibility"),
-				logger.String("reference_letter_id", input.ReferenceLetterID),
-				logger.Err(jsonErr),
-			)
-			return &model.ApplyValidationsError{
-				Message: "failed to parse reference letter extracted data",
-				Field:   stringPtr("referenceLetterID"),
+	language, err := r.languageRepo.GetByID(ctx, languageID)
+	if err != nil {
+		r.log.Error("Failed to get language",
+			logger.Feature("profile"),
+			logger.String("language_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get language: %w", err)
+	}
+	if language == nil {
+		return &model.LanguageValidationError{
+			Message: "language not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	before := *language
+	if input.Language != nil {
+		name := strings.TrimSpace(*input.Language)
+		if name == "" {
+			return &model.LanguageValidationError{
+				Message: "language cannot be empty",
+				Field:   stringPtr("language"),
 			}, nil
 		}
+		language.Language = name
+	}
+	if input.Proficiency != nil {
+		p := domain.LanguageProficiency(strings.ToLower(string(*input.Proficiency)))
+		language.Proficiency = &p
 	}
 
-	// Get or create profile for user
-	profile, err := r.profileRepo.GetOrCreateByUserID(ctx, uid)
-	if err != nil {
-		r.log.Error("Failed to get or create profile",
-			logger.Feature("credibility"),
-			logger.String("user_id", userID),
+	if err := r.languageRepo.Update(ctx, language); err != nil {
+		r.log.Error("Failed to update language",
+			logger.Feature("profile"),
+			logger.String("language_id", id),
 			logger.Err(err),
 		)
-		return nil, fmt.Errorf("failed to get or create profile: %w", err)
+		return nil, fmt.Errorf("failed to update language: %w", err)
 	}
 
-	// Track applied counts
-	appliedCount := &model.AppliedCount{}
+	r.recordChanges(ctx, language.ProfileID, service.LanguageChanges(&before, language))
 
-	// Apply skill validations
-	for _, sv := range input.SkillValidations {
-		skillID, parseErr := uuid.Parse(sv.ProfileSkillID)
-		if parseErr != nil {
-			r.log.Warning("Invalid profile skill ID, skipping",
-				logger.Feature("credibility"),
-				logger.String("profile_skill_id", sv.ProfileSkillID),
-			)
-			continue
-		}
+	r.log.Info("Profile language updated",
+		logger.Feature("profile"),
+		logger.String("language_id", id),
+	)
 
-		// Verify skill exists
-		skill, skillErr := r.profileSkillRepo.GetByID(ctx, skillID)
-		if skillErr != nil {
-			r.log.Error("Failed to verify skill",
-				logger.Feature("credibility"),
-				logger.String("profile_skill_id", sv.ProfileSkillID),
+	return &model.LanguageResult{
+		Language: toGraphQLProfileLanguage(language),
+	}, nil
+}
+
+// DeleteLanguage is the resolver for the deleteLanguage field.
+func (r *mutationResolver) DeleteLanguage(ctx context.Context, id string) (*model.DeleteResult, error) {
+	r.log.Info("Deleting profile language",
+		logger.Feature("profile"),
+		logger.String("language_id", id),
+	)
+
+	languageID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	language, err := r.languageRepo.GetByID(ctx, languageID)
+	if err != nil {
+		r.log.Error("Failed to get language",
+			logger.Feature("profile"),
+			logger.String("language_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get language: %w", err)
+	}
+	if language == nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	if err := r.languageRepo.Delete(ctx, languageID); err != nil {
+		r.log.Error("Failed to delete language",
+			logger.Feature("profile"),
+			logger.String("language_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to delete language: %w", err)
+	}
+
+	r.recordChanges(ctx, language.ProfileID, service.LanguageChanges(language, nil))
+
+	r.log.Info("Profile language deleted",
+		logger.Feature("profile"),
+		logger.String("language_id", id),
+	)
+
+	return &model.DeleteResult{
+		Success:   true,
+		DeletedID: id,
+	}, nil
+}
+
+// CreateProject is the resolver for the createProject field.
+func (r *mutationResolver) CreateProject(ctx context.Context, userID string, input model.CreateProjectInput) (model.ProjectResponse, error) {
+	r.log.Info("Creating profile project",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+	)
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return &model.ProjectValidationError{
+			Message: "name is required",
+			Field:   stringPtr("name"),
+		}, nil
+	}
+
+	profile, invalid, err := r.sectionOwnerProfile(ctx, userID)
+	if err != nil {
+		r.log.Error("Failed to get profile for project",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+			logger.Err(err),
+		)
+		return nil, err
+	}
+	if invalid != "" {
+		return &model.ProjectValidationError{
+			Message: invalid,
+			Field:   stringPtr("userId"),
+		}, nil
+	}
+
+	displayOrder, err := r.projectRepo.GetNextDisplayOrder(ctx, profile.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get next display order: %w", err)
+	}
+
+	project := &domain.ProfileProject{
+		ID:           uuid.New(),
+		ProfileID:    profile.ID,
+		Name:         name,
+		Role:         input.Role,
+		Description:  input.Description,
+		URL:          input.URL,
+		StartDate:    input.StartDate,
+		EndDate:      input.EndDate,
+		Technologies: input.Technologies,
+		DisplayOrder: displayOrder,
+		Source:       domain.ExperienceSourceManual,
+	}
+	if err := r.projectRepo.Create(ctx, project); err != nil {
+		r.log.Error("Failed to create project",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+
+	r.recordChanges(ctx, profile.ID, service.ProjectChanges(nil, project))
+
+	r.log.Info("Profile project created",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+		logger.String("project_id", project.ID.String()),
+	)
+
+	return &model.ProjectResult{
+		Project: toGraphQLProfileProject(project),
+	}, nil
+}
+
+// UpdateProject is the resolver for the updateProject field.
+func (r *mutationResolver) UpdateProject(ctx context.Context, id string, input model.UpdateProjectInput) (model.ProjectResponse, error) {
+	r.log.Info("Updating profile project",
+		logger.Feature("profile"),
+		logger.String("project_id", id),
+	)
+
+	projectID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.ProjectValidationError{
+			Message: "invalid project ID format",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	project, err := r.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		r.log.Error("Failed to get project",
+			logger.Feature("profile"),
+			logger.String("project_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	if project == nil {
+		return &model.ProjectValidationError{
+			Message: "project not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	before := *project
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" {
+			return &model.ProjectValidationError{
+				Message: "name cannot be empty",
+				Field:   stringPtr("name"),
+			}, nil
+		}
+		project.Name = name
+	}
+	if input.Role != nil {
+		project.Role = input.Role
+	}
+	if input.Description != nil {
+		project.Description = input.Description
+	}
+	if input.URL != nil {
+		project.URL = input.URL
+	}
+	if input.StartDate != nil {
+		project.StartDate = input.StartDate
+	}
+	if input.EndDate != nil {
+		project.EndDate = input.EndDate
+	}
+	if input.Technologies != nil {
+		project.Technologies = input.Technologies
+	}
+
+	if err := r.projectRepo.Update(ctx, project); err != nil {
+		r.log.Error("Failed to update project",
+			logger.Feature("profile"),
+			logger.String("project_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update project: %w", err)
+	}
+
+	r.recordChanges(ctx, project.ProfileID, service.ProjectChanges(&before, project))
+
+	r.log.Info("Profile project updated",
+		logger.Feature("profile"),
+		logger.String("project_id", id),
+	)
+
+	return &model.ProjectResult{
+		Project: toGraphQLProfileProject(project),
+	}, nil
+}
+
+// DeleteProject is the resolver for the deleteProject field.
+func (r *mutationResolver) DeleteProject(ctx context.Context, id string) (*model.DeleteResult, error) {
+	r.log.Info("Deleting profile project",
+		logger.Feature("profile"),
+		logger.String("project_id", id),
+	)
+
+	projectID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	project, err := r.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		r.log.Error("Failed to get project",
+			logger.Feature("profile"),
+			logger.String("project_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	if project == nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	if err := r.projectRepo.Delete(ctx, projectID); err != nil {
+		r.log.Error("Failed to delete project",
+			logger.Feature("profile"),
+			logger.String("project_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to delete project: %w", err)
+	}
+
+	r.recordChanges(ctx, project.ProfileID, service.ProjectChanges(project, nil))
+
+	r.log.Info("Profile project deleted",
+		logger.Feature("profile"),
+		logger.String("project_id", id),
+	)
+
+	return &model.DeleteResult{
+		Success:   true,
+		DeletedID: id,
+	}, nil
+}
+
+// CreatePublication is the resolver for the createPublication field.
+func (r *mutationResolver) CreatePublication(ctx context.Context, userID string, input model.CreatePublicationInput) (model.PublicationResponse, error) {
+	r.log.Info("Creating profile publication",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+	)
+
+	name := strings.TrimSpace(input.Title)
+	if name == "" {
+		return &model.PublicationValidationError{
+			Message: "title is required",
+			Field:   stringPtr("title"),
+		}, nil
+	}
+
+	profile, invalid, err := r.sectionOwnerProfile(ctx, userID)
+	if err != nil {
+		r.log.Error("Failed to get profile for publication",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+			logger.Err(err),
+		)
+		return nil, err
+	}
+	if invalid != "" {
+		return &model.PublicationValidationError{
+			Message: invalid,
+			Field:   stringPtr("userId"),
+		}, nil
+	}
+
+	displayOrder, err := r.publicationRepo.GetNextDisplayOrder(ctx, profile.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get next display order: %w", err)
+	}
+
+	publication := &domain.ProfilePublication{
+		ID:           uuid.New(),
+		ProfileID:    profile.ID,
+		Title:        name,
+		Publisher:    input.Publisher,
+		Date:         input.Date,
+		URL:          input.URL,
+		Description:  input.Description,
+		DisplayOrder: displayOrder,
+		Source:       domain.ExperienceSourceManual,
+	}
+	if err := r.publicationRepo.Create(ctx, publication); err != nil {
+		r.log.Error("Failed to create publication",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to create publication: %w", err)
+	}
+
+	r.recordChanges(ctx, profile.ID, service.PublicationChanges(nil, publication))
+
+	r.log.Info("Profile publication created",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+		logger.String("publication_id", publication.ID.String()),
+	)
+
+	return &model.PublicationResult{
+		Publication: toGraphQLProfilePublication(publication),
+	}, nil
+}
+
+// UpdatePublication is the resolver for the updatePublication field.
+func (r *mutationResolver) UpdatePublication(ctx context.Context, id string, input model.UpdatePublicationInput) (model.PublicationResponse, error) {
+	r.log.Info("Updating profile publication",
+		logger.Feature("profile"),
+		logger.String("publication_id", id),
+	)
+
+	publicationID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.PublicationValidationError{
+			Message: "invalid publication ID format",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	publication, err := r.publicationRepo.GetByID(ctx, publicationID)
+	if err != nil {
+		r.log.Error("Failed to get publication",
+			logger.Feature("profile"),
+			logger.String("publication_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get publication: %w", err)
+	}
+	if publication == nil {
+		return &model.PublicationValidationError{
+			Message: "publication not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	before := *publication
+	if input.Title != nil {
+		name := strings.TrimSpace(*input.Title)
+		if name == "" {
+			return &model.PublicationValidationError{
+				Message: "title cannot be empty",
+				Field:   stringPtr("title"),
+			}, nil
+		}
+		publication.Title = name
+	}
+	if input.Publisher != nil {
+		publication.Publisher = input.Publisher
+	}
+	if input.Date != nil {
+		publication.Date = input.Date
+	}
+	if input.URL != nil {
+		publication.URL = input.URL
+	}
+	if input.Description != nil {
+		publication.Description = input.Description
+	}
+
+	if err := r.publicationRepo.Update(ctx, publication); err != nil {
+		r.log.Error("Failed to update publication",
+			logger.Feature("profile"),
+			logger.String("publication_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update publication: %w", err)
+	}
+
+	r.recordChanges(ctx, publication.ProfileID, service.PublicationChanges(&before, publication))
+
+	r.log.Info("Profile publication updated",
+		logger.Feature("profile"),
+		logger.String("publication_id", id),
+	)
+
+	return &model.PublicationResult{
+		Publication: toGraphQLProfilePublication(publication),
+	}, nil
+}
+
+// DeletePublication is the resolver for the deletePublication field.
+func (r *mutationResolver) DeletePublication(ctx context.Context, id string) (*model.DeleteResult, error) {
+	r.log.Info("Deleting profile publication",
+		logger.Feature("profile"),
+		logger.String("publication_id", id),
+	)
+
+	publicationID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	publication, err := r.publicationRepo.GetByID(ctx, publicationID)
+	if err != nil {
+		r.log.Error("Failed to get publication",
+			logger.Feature("profile"),
+			logger.String("publication_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get publication: %w", err)
+	}
+	if publication == nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	if err := r.publicationRepo.Delete(ctx, publicationID); err != nil {
+		r.log.Error("Failed to delete publication",
+			logger.Feature("profile"),
+			logger.String("publication_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to delete publication: %w", err)
+	}
+
+	r.recordChanges(ctx, publication.ProfileID, service.PublicationChanges(publication, nil))
+
+	r.log.Info("Profile publication deleted",
+		logger.Feature("profile"),
+		logger.String("publication_id", id),
+	)
+
+	return &model.DeleteResult{
+		Success:   true,
+		DeletedID: id,
+	}, nil
+}
+
+// CreateAward is the resolver for the createAward field.
+func (r *mutationResolver) CreateAward(ctx context.Context, userID string, input model.CreateAwardInput) (model.AwardResponse, error) {
+	r.log.Info("Creating profile award",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+	)
+
+	name := strings.TrimSpace(input.Title)
+	if name == "" {
+		return &model.AwardValidationError{
+			Message: "title is required",
+			Field:   stringPtr("title"),
+		}, nil
+	}
+
+	profile, invalid, err := r.sectionOwnerProfile(ctx, userID)
+	if err != nil {
+		r.log.Error("Failed to get profile for award",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+			logger.Err(err),
+		)
+		return nil, err
+	}
+	if invalid != "" {
+		return &model.AwardValidationError{
+			Message: invalid,
+			Field:   stringPtr("userId"),
+		}, nil
+	}
+
+	displayOrder, err := r.awardRepo.GetNextDisplayOrder(ctx, profile.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get next display order: %w", err)
+	}
+
+	award := &domain.ProfileAward{
+		ID:           uuid.New(),
+		ProfileID:    profile.ID,
+		Title:        name,
+		Issuer:       input.Issuer,
+		Date:         input.Date,
+		Description:  input.Description,
+		DisplayOrder: displayOrder,
+		Source:       domain.ExperienceSourceManual,
+	}
+	if err := r.awardRepo.Create(ctx, award); err != nil {
+		r.log.Error("Failed to create award",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to create award: %w", err)
+	}
+
+	r.recordChanges(ctx, profile.ID, service.AwardChanges(nil, award))
+
+	r.log.Info("Profile award created",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+		logger.String("award_id", award.ID.String()),
+	)
+
+	return &model.AwardResult{
+		Award: toGraphQLProfileAward(award),
+	}, nil
+}
+
+// UpdateAward is the resolver for the updateAward field.
+func (r *mutationResolver) UpdateAward(ctx context.Context, id string, input model.UpdateAwardInput) (model.AwardResponse, error) {
+	r.log.Info("Updating profile award",
+		logger.Feature("profile"),
+		logger.String("award_id", id),
+	)
+
+	awardID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.AwardValidationError{
+			Message: "invalid award ID format",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	award, err := r.awardRepo.GetByID(ctx, awardID)
+	if err != nil {
+		r.log.Error("Failed to get award",
+			logger.Feature("profile"),
+			logger.String("award_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get award: %w", err)
+	}
+	if award == nil {
+		return &model.AwardValidationError{
+			Message: "award not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	before := *award
+	if input.Title != nil {
+		name := strings.TrimSpace(*input.Title)
+		if name == "" {
+			return &model.AwardValidationError{
+				Message: "title cannot be empty",
+				Field:   stringPtr("title"),
+			}, nil
+		}
+		award.Title = name
+	}
+	if input.Issuer != nil {
+		award.Issuer = input.Issuer
+	}
+	if input.Date != nil {
+		award.Date = input.Date
+	}
+	if input.Description != nil {
+		award.Description = input.Description
+	}
+
+	if err := r.awardRepo.Update(ctx, award); err != nil {
+		r.log.Error("Failed to update award",
+			logger.Feature("profile"),
+			logger.String("award_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update award: %w", err)
+	}
+
+	r.recordChanges(ctx, award.ProfileID, service.AwardChanges(&before, award))
+
+	r.log.Info("Profile award updated",
+		logger.Feature("profile"),
+		logger.String("award_id", id),
+	)
+
+	return &model.AwardResult{
+		Award: toGraphQLProfileAward(award),
+	}, nil
+}
+
+// DeleteAward is the resolver for the deleteAward field.
+func (r *mutationResolver) DeleteAward(ctx context.Context, id string) (*model.DeleteResult, error) {
+	r.log.Info("Deleting profile award",
+		logger.Feature("profile"),
+		logger.String("award_id", id),
+	)
+
+	awardID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	award, err := r.awardRepo.GetByID(ctx, awardID)
+	if err != nil {
+		r.log.Error("Failed to get award",
+			logger.Feature("profile"),
+			logger.String("award_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get award: %w", err)
+	}
+	if award == nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	if err := r.awardRepo.Delete(ctx, awardID); err != nil {
+		r.log.Error("Failed to delete award",
+			logger.Feature("profile"),
+			logger.String("award_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to delete award: %w", err)
+	}
+
+	r.recordChanges(ctx, award.ProfileID, service.AwardChanges(award, nil))
+
+	r.log.Info("Profile award deleted",
+		logger.Feature("profile"),
+		logger.String("award_id", id),
+	)
+
+	return &model.DeleteResult{
+		Success:   true,
+		DeletedID: id,
+	}, nil
+}
+
+// CreateSkill is the resolver for the createSkill field.
+func (r *mutationResolver) CreateSkill(ctx context.Context, userID string, input model.CreateSkillInput) (model.SkillResponse, error) {
+	r.log.Info("Creating skill",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+	)
+
+	// Parse and validate user ID
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		r.log.Warning("Invalid user ID format",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+		)
+		return &model.SkillValidationError{
+			Message: "invalid user ID format",
+			Field:   stringPtr("userId"),
+		}, nil
+	}
+
+	// Validate skill name
+	if strings.TrimSpace(input.Name) == "" {
+		return &model.SkillValidationError{
+			Message: "skill name is required",
+			Field:   stringPtr("name"),
+		}, nil
+	}
+
+	// Verify user exists
+	user, err := r.userRepo.GetByID(ctx, uid)
+	if err != nil {
+		r.log.Error("Failed to verify user",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to verify user: %w", err)
+	}
+	if user == nil {
+		r.log.Warning("User not found",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+		)
+		return &model.SkillValidationError{
+			Message: "user not found",
+			Field:   stringPtr("userId"),
+		}, nil
+	}
+
+	// Get or create profile for user
+	profile, err := r.profileRepo.GetOrCreateByUserID(ctx, uid)
+	if err != nil {
+		r.log.Error("Failed to get or create profile",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get or create profile: %w", err)
+	}
+
+	// Get next display order
+	displayOrder, err := r.profileSkillRepo.GetNextDisplayOrder(ctx, profile.ID)
+	if err != nil {
+		r.log.Error("Failed to get next display order",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get next display order: %w", err)
+	}
+
+	tax, err := r.skillTaxonomy(ctx, profile.ID)
+	if err != nil {
+		r.log.Error("Failed to load skill taxonomy",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to load skill taxonomy: %w", err)
+	}
+
+	// Create skill
+	skill := &domain.ProfileSkill{
+		ID:           uuid.New(),
+		ProfileID:    profile.ID,
+		Category:     strings.ToUpper(string(input.Category)),
+		DisplayOrder: displayOrder,
+		Source:       domain.ExperienceSourceManual,
+	}
+	setSkillName(tax, skill, input.Name)
+
+	if err := r.profileSkillRepo.Create(ctx, skill); err != nil {
+		r.log.Error("Failed to create skill",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to create skill: %w", err)
+	}
+
+	r.recordChanges(ctx, profile.ID, service.SkillChanges(nil, skill))
+	r.recomputeSkillStats(ctx, profile.ID, skill)
+
+	r.log.Info("Skill created",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+		logger.String("skill_id", skill.ID.String()),
+	)
+
+	return &model.SkillResult{
+		Skill: toGraphQLProfileSkill(skill),
+	}, nil
+}
+
+// UpdateSkill is the resolver for the updateSkill field.
+func (r *mutationResolver) UpdateSkill(ctx context.Context, id string, input model.UpdateSkillInput) (model.SkillResponse, error) {
+	r.log.Info("Updating skill",
+		logger.Feature("profile"),
+		logger.String("skill_id", id),
+	)
+
+	// Parse and validate skill ID
+	skillID, err := uuid.Parse(id)
+	if err != nil {
+		r.log.Warning("Invalid skill ID format",
+			logger.Feature("profile"),
+			logger.String("skill_id", id),
+		)
+		return &model.SkillValidationError{
+			Message: "invalid skill ID format",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	// Get existing skill
+	skill, err := r.profileSkillRepo.GetByID(ctx, skillID)
+	if err != nil {
+		r.log.Error("Failed to get skill",
+			logger.Feature("profile"),
+			logger.String("skill_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get skill: %w", err)
+	}
+	if skill == nil {
+		r.log.Warning("Skill not found",
+			logger.Feature("profile"),
+			logger.String("skill_id", id),
+		)
+		return &model.SkillValidationError{
+			Message: "skill not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	// Update fields if provided
+	before := *skill
+	if input.Name != nil {
+		tax, taxErr := r.skillTaxonomy(ctx, skill.ProfileID)
+		if taxErr != nil {
+			r.log.Error("Failed to load skill taxonomy",
+				logger.Feature("profile"),
+				logger.String("profile_id", skill.ProfileID.String()),
+				logger.Err(taxErr),
+			)
+			return nil, fmt.Errorf("failed to load skill taxonomy: %w", taxErr)
+		}
+		setSkillName(tax, skill, *input.Name)
+	}
+	if input.Category != nil {
+		skill.Category = strings.ToUpper(string(*input.Category))
+	}
+
+	if err := r.profileSkillRepo.Update(ctx, skill); err != nil {
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique constraint") {
+			return &model.SkillValidationError{
+				Message: "a skill with this name already exists; merge the skills instead",
+				Field:   stringPtr("name"),
+			}, nil
+		}
+		r.log.Error("Failed to update skill",
+			logger.Feature("profile"),
+			logger.String("skill_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update skill: %w", err)
+	}
+
+	r.recordChanges(ctx, skill.ProfileID, service.SkillChanges(&before, skill))
+	r.recomputeSkillStats(ctx, skill.ProfileID, skill)
+
+	r.log.Info("Skill updated",
+		logger.Feature("profile"),
+		logger.String("skill_id", id),
+	)
+
+	return &model.SkillResult{
+		Skill: toGraphQLProfileSkill(skill),
+	}, nil
+}
+
+// DeleteSkill is the resolver for the deleteSkill field.
+func (r *mutationResolver) DeleteSkill(ctx context.Context, id string) (*model.DeleteResult, error) {
+	r.log.Info("Deleting skill",
+		logger.Feature("profile"),
+		logger.String("skill_id", id),
+	)
+
+	// Parse and validate skill ID
+	skillID, err := uuid.Parse(id)
+	if err != nil {
+		r.log.Warning("Invalid skill ID format",
+			logger.Feature("profile"),
+			logger.String("skill_id", id),
+		)
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	// Check if skill exists
+	skill, err := r.profileSkillRepo.GetByID(ctx, skillID)
+	if err != nil {
+		r.log.Error("Failed to get skill",
+			logger.Feature("profile"),
+			logger.String("skill_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get skill: %w", err)
+	}
+	if skill == nil {
+		r.log.Warning("Skill not found",
+			logger.Feature("profile"),
+			logger.String("skill_id", id),
+		)
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	// Delete skill
+	if err := r.profileSkillRepo.Delete(ctx, skillID); err != nil {
+		r.log.Error("Failed to delete skill",
+			logger.Feature("profile"),
+			logger.String("skill_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to delete skill: %w", err)
+	}
+
+	r.recordChanges(ctx, skill.ProfileID, service.SkillChanges(skill, nil))
+
+	r.log.Info("Skill deleted",
+		logger.Feature("profile"),
+		logger.String("skill_id", id),
+	)
+
+	return &model.DeleteResult{
+		Success:   true,
+		DeletedID: id,
+	}, nil
+}
+
+// MergeSkills is the resolver for the mergeSkills field.
+func (r *mutationResolver) MergeSkills(ctx context.Context, targetID string, sourceIds []string) (model.SkillResponse, error) {
+	r.log.Info("Merging skills",
+		logger.Feature("profile"),
+		logger.String("target_id", targetID),
+		logger.Int("source_count", len(sourceIds)),
+	)
+
+	tid, err := uuid.Parse(targetID)
+	if err != nil {
+		return &model.SkillValidationError{
+			Message: "invalid target skill ID format",
+			Field:   stringPtr("targetId"),
+		}, nil
+	}
+	if len(sourceIds) == 0 {
+		return &model.SkillValidationError{
+			Message: "at least one source skill is required",
+			Field:   stringPtr("sourceIds"),
+		}, nil
+	}
+
+	target, err := r.profileSkillRepo.GetByID(ctx, tid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skill: %w", err)
+	}
+	if target == nil {
+		return &model.SkillValidationError{
+			Message: "target skill not found",
+			Field:   stringPtr("targetId"),
+		}, nil
+	}
+
+	before := *target
+	sources := make([]*domain.ProfileSkill, 0, len(sourceIds))
+	sourceIDs := make([]uuid.UUID, 0, len(sourceIds))
+	for _, sourceID := range sourceIds {
+		sid, err := uuid.Parse(sourceID)
+		if err != nil {
+			return &model.SkillValidationError{
+				Message: "invalid source skill ID format",
+				Field:   stringPtr("sourceIds"),
+			}, nil
+		}
+		if sid == tid {
+			return &model.SkillValidationError{
+				Message: "a skill cannot be merged into itself",
+				Field:   stringPtr("sourceIds"),
+			}, nil
+		}
+		if slices.Contains(sourceIDs, sid) {
+			return &model.SkillValidationError{
+				Message: "a source skill is listed more than once",
+				Field:   stringPtr("sourceIds"),
+			}, nil
+		}
+		source, err := r.profileSkillRepo.GetByID(ctx, sid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get skill: %w", err)
+		}
+		if source == nil || source.ProfileID != target.ProfileID {
+			return &model.SkillValidationError{
+				Message: "source skill not found",
+				Field:   stringPtr("sourceIds"),
+			}, nil
+		}
+		sources = append(sources, source)
+		sourceIDs = append(sourceIDs, sid)
+	}
+
+	merged, err := r.profileSkillRepo.Merge(ctx, tid, sourceIDs)
+	if err != nil {
+		r.log.Error("Failed to merge skills",
+			logger.Feature("profile"),
+			logger.String("target_id", targetID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to merge skills: %w", err)
+	}
+
+	changes := service.SkillChanges(&before, merged)
+	for _, source := range sources {
+		changes = append(changes, service.SkillChanges(source, nil)...)
+	}
+	r.recordChanges(ctx, merged.ProfileID, changes)
+	r.recomputeSkillStats(ctx, merged.ProfileID, merged)
+
+	r.log.Info("Skills merged",
+		logger.Feature("profile"),
+		logger.String("target_id", targetID),
+		logger.Int("source_count", len(sourceIDs)),
+	)
+
+	return &model.SkillResult{
+		Skill: toGraphQLProfileSkill(merged),
+	}, nil
+}
+
+// SplitSkill is the resolver for the splitSkill field.
+func (r *mutationResolver) SplitSkill(ctx context.Context, id string, names []string) (model.SplitSkillResponse, error) {
+	r.log.Info("Splitting skill",
+		logger.Feature("profile"),
+		logger.String("skill_id", id),
+		logger.Int("name_count", len(names)),
+	)
+
+	skillID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.SkillValidationError{
+			Message: "invalid skill ID format",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+	if len(names) < 2 {
+		return &model.SkillValidationError{
+			Message: "at least two skill names are required",
+			Field:   stringPtr("names"),
+		}, nil
+	}
+
+	skill, err := r.profileSkillRepo.GetByID(ctx, skillID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skill: %w", err)
+	}
+	if skill == nil {
+		return &model.SkillValidationError{
+			Message: "skill not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	tax, err := r.skillTaxonomy(ctx, skill.ProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load skill taxonomy: %w", err)
+	}
+	existing, err := r.profileSkillRepo.GetByProfileID(ctx, skill.ProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile skills: %w", err)
+	}
+	taken := make(map[string]bool, len(existing))
+	for _, other := range existing {
+		if other.ID != skill.ID {
+			taken[other.NormalizedName] = true
+		}
+	}
+
+	// The new skills take the split skill's place, category and provenance
+	parts := make([]*domain.ProfileSkill, len(names))
+	for i, name := range names {
+		if strings.TrimSpace(name) == "" {
+			return &model.SkillValidationError{
+				Message: "skill name is required",
+				Field:   stringPtr("names"),
+			}, nil
+		}
+		part := &domain.ProfileSkill{
+			ID:                      uuid.New(),
+			ProfileID:               skill.ProfileID,
+			Category:                skill.Category,
+			DisplayOrder:            skill.DisplayOrder,
+			Source:                  skill.Source,
+			SourceResumeID:          skill.SourceResumeID,
+			SourceReferenceLetterID: skill.SourceReferenceLetterID,
+		}
+		setSkillName(tax, part, strings.TrimSpace(name))
+		if taken[part.NormalizedName] {
+			return &model.SkillValidationError{
+				Message: fmt.Sprintf("the profile already has the skill %q", part.Name),
+				Field:   stringPtr("names"),
+			}, nil
+		}
+		taken[part.NormalizedName] = true
+		parts[i] = part
+	}
+
+	if err := r.profileSkillRepo.Split(ctx, skillID, parts); err != nil {
+		r.log.Error("Failed to split skill",
+			logger.Feature("profile"),
+			logger.String("skill_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to split skill: %w", err)
+	}
+
+	changes := service.SkillChanges(skill, nil)
+	for _, part := range parts {
+		changes = append(changes, service.SkillChanges(nil, part)...)
+	}
+	r.recordChanges(ctx, skill.ProfileID, changes)
+	r.recomputeSkillStats(ctx, skill.ProfileID, parts...)
+
+	r.log.Info("Skill split",
+		logger.Feature("profile"),
+		logger.String("skill_id", id),
+		logger.Int("name_count", len(parts)),
+	)
+
+	return &model.SplitSkillResult{
+		Skills: toGraphQLProfileSkills(parts),
+	}, nil
+}
+
+// BulkUpdateSkills is the resolver for the bulkUpdateSkills field.
+func (r *mutationResolver) BulkUpdateSkills(ctx context.Context, input []*model.BulkUpdateSkillInput) (model.BulkUpdateSkillsResponse, error) {
+	r.log.Info("Bulk updating skills",
+		logger.Feature("profile"),
+		logger.Int("skill_count", len(input)),
+	)
+
+	if len(input) == 0 {
+		return &model.SkillValidationError{
+			Message: "at least one skill update is required",
+			Field:   stringPtr("input"),
+		}, nil
+	}
+
+	// Load and validate every skill before changing any of them
+	skills := make([]*domain.ProfileSkill, 0, len(input))
+	seen := make(map[uuid.UUID]bool, len(input))
+	for _, update := range input {
+		skillID, err := uuid.Parse(update.ID)
+		if err != nil {
+			return &model.SkillValidationError{
+				Message: "invalid skill ID format",
+				Field:   stringPtr("id"),
+			}, nil
+		}
+		if seen[skillID] {
+			return &model.SkillValidationError{
+				Message: "each skill can only be updated once",
+				Field:   stringPtr("id"),
+			}, nil
+		}
+		seen[skillID] = true
+
+		skill, err := r.profileSkillRepo.GetByID(ctx, skillID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get skill: %w", err)
+		}
+		if skill == nil || (len(skills) > 0 && skill.ProfileID != skills[0].ProfileID) {
+			return &model.SkillValidationError{
+				Message: "skill not found",
+				Field:   stringPtr("id"),
+			}, nil
+		}
+		if update.DisplayOrder != nil && *update.DisplayOrder < 0 {
+			return &model.SkillValidationError{
+				Message: "display order cannot be negative",
+				Field:   stringPtr("displayOrder"),
+			}, nil
+		}
+		skills = append(skills, skill)
+	}
+
+	before := make([]domain.ProfileSkill, len(skills))
+	for i, skill := range skills {
+		before[i] = *skill
+	}
+	for i, update := range input {
+		if update.Category != nil {
+			skills[i].Category = strings.ToUpper(string(*update.Category))
+		}
+		if update.DisplayOrder != nil {
+			skills[i].DisplayOrder = *update.DisplayOrder
+		}
+	}
+
+	if err := r.profileSkillRepo.UpdateAll(ctx, skills); err != nil {
+		r.log.Error("Failed to bulk update skills",
+			logger.Feature("profile"),
+			logger.String("profile_id", skills[0].ProfileID.String()),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update skills: %w", err)
+	}
+
+	var changes []*domain.ProfileChange
+	for i, skill := range skills {
+		changes = append(changes, service.SkillChanges(&before[i], skill)...)
+	}
+	r.recordChanges(ctx, skills[0].ProfileID, changes)
+
+	r.log.Info("Skills bulk updated",
+		logger.Feature("profile"),
+		logger.String("profile_id", skills[0].ProfileID.String()),
+		logger.Int("skill_count", len(skills)),
+	)
+
+	result := make([]*model.ProfileSkill, len(skills))
+	for i, skill := range skills {
+		result[i] = toGraphQLProfileSkill(skill)
+	}
+	return &model.BulkUpdateSkillsResult{
+		Skills: result,
+	}, nil
+}
+
+// ReorderProfileItems is the resolver for the reorderProfileItems field.
+func (r *mutationResolver) ReorderProfileItems(ctx context.Context, profileID string, input model.ReorderProfileItemsInput) (model.ReorderProfileItemsResponse, error) {
+	r.log.Info("Reordering profile items",
+		logger.Feature("profile"),
+		logger.String("profile_id", profileID),
+	)
+
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return &model.ReorderProfileItemsValidationError{
+			Message: "invalid profile ID format",
+			Field:   stringPtr("profileId"),
+		}, nil
+	}
+	profile, err := r.profileRepo.GetByID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	if profile == nil {
+		return &model.ReorderProfileItemsValidationError{
+			Message: "profile not found",
+			Field:   stringPtr("profileId"),
+		}, nil
+	}
+
+	experiences, err := r.profileExpRepo.GetByProfileID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experiences: %w", err)
+	}
+	educations, err := r.profileEduRepo.GetByProfileID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get education: %w", err)
+	}
+	skills, err := r.profileSkillRepo.GetByProfileID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skills: %w", err)
+	}
+
+	order := &domain.ProfileItemOrder{}
+	experienceIDs := make([]uuid.UUID, len(experiences))
+	for i, e := range experiences {
+		experienceIDs[i] = e.ID
+	}
+	if order.ExperienceIDs, err = parseItemOrder(input.ExperienceIds, experienceIDs); err != nil {
+		return &model.ReorderProfileItemsValidationError{
+			Message: err.Error(),
+			Field:   stringPtr("experienceIds"),
+		}, nil
+	}
+	educationIDs := make([]uuid.UUID, len(educations))
+	for i, e := range educations {
+		educationIDs[i] = e.ID
+	}
+	if order.EducationIDs, err = parseItemOrder(input.EducationIds, educationIDs); err != nil {
+		return &model.ReorderProfileItemsValidationError{
+			Message: err.Error(),
+			Field:   stringPtr("educationIds"),
+		}, nil
+	}
+	skillIDs := make([]uuid.UUID, len(skills))
+	for i, s := range skills {
+		skillIDs[i] = s.ID
+	}
+	if order.SkillIDs, err = parseItemOrder(input.SkillIds, skillIDs); err != nil {
+		return &model.ReorderProfileItemsValidationError{
+			Message: err.Error(),
+			Field:   stringPtr("skillIds"),
+		}, nil
+	}
+
+	if err := r.profileRepo.ReorderItems(ctx, pid, order); err != nil {
+		r.log.Error("Failed to reorder profile items",
+			logger.Feature("profile"),
+			logger.String("profile_id", profileID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to reorder profile items: %w", err)
+	}
+
+	r.log.Info("Profile items reordered",
+		logger.Feature("profile"),
+		logger.String("profile_id", profileID),
+	)
+
+	// Reload so every list reflects the committed order
+	experiences, err = r.profileExpRepo.GetByProfileID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experiences: %w", err)
+	}
+	educations, err = r.profileEduRepo.GetByProfileID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get education: %w", err)
+	}
+	skills, err = r.profileSkillRepo.GetByProfileID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skills: %w", err)
+	}
+
+	return &model.ReorderProfileItemsResult{
+		Experiences: toGraphQLProfileExperiences(experiences),
+		Educations:  toGraphQLProfileEducations(educations),
+		Skills:      toGraphQLProfileSkills(skills),
+	}, nil
+}
+
+// ApplyReferenceLetterValidations is the resolver for the applyReferenceLetterValidations field.
+func (r *mutationResolver) ApplyReferenceLetterValidations(ctx context.Context, userID string, input model.ApplyValidationsInput) (model.ApplyValidationsResponse, error) {
+	r.log.Info("Applying reference letter validations",
+		logger.Feature("credibility"),
+		logger.String("user_id", userID),
+		logger.String("reference_letter_id", input.ReferenceLetterID),
+	)
+
+	// Parse and validate user ID
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		r.log.Warning("Invalid user ID format",
+			logger.Feature("credibility"),
+			logger.String("user_id", userID),
+		)
+		return &model.ApplyValidationsError{
+			Message: "invalid user ID format",
+			Field:   stringPtr("userId"),
+		}, nil
+	}
+
+	// Parse and validate reference letter ID
+	refLetterID, err := uuid.Parse(input.ReferenceLetterID)
+	if err != nil {
+		r.log.Warning("Invalid reference letter ID format",
+			logger.Feature("credibility"),
+			logger.String("reference_letter_id", input.ReferenceLetterID),
+		)
+		return &model.ApplyValidationsError{
+			Message: "invalid reference letter ID format",
+			Field:   stringPtr("referenceLetterID"),
+		}, nil
+	}
+
+	// Verify user exists
+	user, err := r.userRepo.GetByID(ctx, uid)
+	if err != nil {
+		r.log.Error("Failed to verify user",
+			logger.Feature("credibility"),
+			logger.String("user_id", userID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to verify user: %w", err)
+	}
+	if user == nil {
+		r.log.Warning("User not found",
+			logger.Feature("credibility"),
+			logger.String("user_id", userID),
+		)
+		return &model.ApplyValidationsError{
+			Message: "user not found",
+			Field:   stringPtr("userId"),
+		}, nil
+	}
+
+	// Get reference letter and verify it belongs to user
+	refLetter, err := r.refLetterRepo.GetByID(ctx, refLetterID)
+	if err != nil {
+		r.log.Error("Failed to get reference letter",
+			logger.Feature("credibility"),
+			logger.String("reference_letter_id", input.ReferenceLetterID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get reference letter: %w", err)
+	}
+	if refLetter == nil {
+		r.log.Warning("Reference letter not found",
+			logger.Feature("credibility"),
+			logger.String("reference_letter_id", input.ReferenceLetterID),
+		)
+		return &model.ApplyValidationsError{
+			Message: "reference letter not found",
+			Field:   stringPtr("referenceLetterID"),
+		}, nil
+	}
+	if refLetter.UserID != uid {
+		r.log.Warning("Reference letter does not belong to user",
+			logger.Feature("credibility"),
+			logger.String("user_id", userID),
+			logger.String("reference_letter_id", input.ReferenceLetterID),
+		)
+		return &model.ApplyValidationsError{
+			Message: "reference letter does not belong to user",
+			Field:   stringPtr("referenceLetterID"),
+		}, nil
+	}
+
+	// Reference letter must be completed or applied for validations to be applied
+	// (allowing re-application of validations with different selections)
+	if refLetter.Status != domain.ReferenceLetterStatusCompleted && refLetter.Status != domain.ReferenceLetterStatusApplied {
+		r.log.Warning("Reference letter not completed",
+			logger.Feature("credibility"),
+			logger.String("reference_letter_id", input.ReferenceLetterID),
+			logger.String("status", string(refLetter.Status)),
+		)
+		return &model.ApplyValidationsError{
+			Message: "reference letter extraction not completed",
+			Field:   stringPtr("referenceLetterID"),
+		}, nil
+	}
+
+	// Parse extracted data to get author info for testimonials
+	var extractedData domain.ExtractedLetterData
+	if len(refLetter.ExtractedData) > 0 && string(refLetter.ExtractedData) != jsonNull {
+		if jsonErr := json.Unmarshal(refLetter.ExtractedData, &extractedData); jsonErr != nil {
+			r.log.Error("Failed to parse extracted data",
+				logger.Feature("credibility"),
+				logger.String("reference_letter_id", input.ReferenceLetterID),
+				logger.Err(jsonErr),
+			)
+			return &model.ApplyValidationsError{
+				Message: "failed to parse reference letter extracted data",
+				Field:   stringPtr("referenceLetterID"),
+			}, nil
+		}
+	}
+
+	// Get or create profile for user
+	profile, err := r.profileRepo.GetOrCreateByUserID(ctx, uid)
+	if err != nil {
+		r.log.Error("Failed to get or create profile",
+			logger.Feature("credibility"),
+			logger.String("user_id", userID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get or create profile: %w", err)
+	}
+
+	// Track applied counts
+	appliedCount := &model.AppliedCount{}
+
+	// Apply skill validations
+	for _, sv := range input.SkillValidations {
+		skillID, parseErr := uuid.Parse(sv.ProfileSkillID)
+		if parseErr != nil {
+			r.log.Warning("Invalid profile skill ID, skipping",
+				logger.Feature("credibility"),
+				logger.String("profile_skill_id", sv.ProfileSkillID),
+			)
+			continue
+		}
+
+		// Verify skill exists
+		skill, skillErr := r.profileSkillRepo.GetByID(ctx, skillID)
+		if skillErr != nil {
+			r.log.Error("Failed to verify skill",
+				logger.Feature("credibility"),
+				logger.String("profile_skill_id", sv.ProfileSkillID),
 				logger.Err(skillErr),
 			)
-			continue
+			continue
+		}
+		if skill == nil {
+			r.log.Warning("Skill not found, skipping",
+				logger.Feature("credibility"),
+				logger.String("profile_skill_id", sv.ProfileSkillID),
+			)
+			continue
+		}
+
+		// Create skill validation
+		validation := &domain.SkillValidation{
+			ID:                uuid.New(),
+			ProfileSkillID:    skillID,
+			ReferenceLetterID: refLetterID,
+			QuoteSnippet:      &sv.QuoteSnippet,
+		}
+
+		if createErr := r.skillValidationRepo.Create(ctx, validation); createErr != nil {
+			// Ignore duplicate constraint errors (upsert behavior)
+			if !strings.Contains(createErr.Error(), "duplicate") && !strings.Contains(createErr.Error(), "unique constraint") {
+				r.log.Error("Failed to create skill validation",
+					logger.Feature("credibility"),
+					logger.String("profile_skill_id", sv.ProfileSkillID),
+					logger.Err(createErr),
+				)
+			}
+			continue
+		}
+		appliedCount.SkillValidations++
+	}
+
+	// Apply experience validations
+	for _, ev := range input.ExperienceValidations {
+		expID, parseErr := uuid.Parse(ev.ProfileExperienceID)
+		if parseErr != nil {
+			r.log.Warning("Invalid profile experience ID, skipping",
+				logger.Feature("credibility"),
+				logger.String("profile_experience_id", ev.ProfileExperienceID),
+			)
+			continue
+		}
+
+		// Verify experience exists
+		exp, expErr := r.profileExpRepo.GetByID(ctx, expID)
+		if expErr != nil {
+			r.log.Error("Failed to verify experience",
+				logger.Feature("credibility"),
+				logger.String("profile_experience_id", ev.ProfileExperienceID),
+				logger.Err(expErr),
+			)
+			continue
+		}
+		if exp == nil {
+			r.log.Warning("Experience not found, skipping",
+				logger.Feature("credibility"),
+				logger.String("profile_experience_id", ev.ProfileExperienceID),
+			)
+			continue
+		}
+
+		// Create experience validation
+		validation := &domain.ExperienceValidation{
+			ID:                  uuid.New(),
+			ProfileExperienceID: expID,
+			ReferenceLetterID:   refLetterID,
+			QuoteSnippet:        &ev.QuoteSnippet,
+		}
+
+		if createErr := r.expValidationRepo.Create(ctx, validation); createErr != nil {
+			// Ignore duplicate constraint errors (upsert behavior)
+			if !strings.Contains(createErr.Error(), "duplicate") && !strings.Contains(createErr.Error(), "unique constraint") {
+				r.log.Error("Failed to create experience validation",
+					logger.Feature("credibility"),
+					logger.String("profile_experience_id", ev.ProfileExperienceID),
+					logger.Err(createErr),
+				)
+			}
+			continue
+		}
+		appliedCount.ExperienceValidations++
+	}
+
+	// Create testimonials
+	// First, find or create the Author entity for all testimonials from this reference letter
+	var author *domain.Author
+	if len(input.Testimonials) > 0 {
+		// Try to find existing author with same name and company
+		existingAuthor, findErr := r.authorRepo.FindByNameAndCompany(ctx, profile.ID, extractedData.Author.Name, extractedData.Author.Company)
+		if findErr != nil {
+			r.log.Error("Failed to find existing author",
+				logger.Feature("credibility"),
+				logger.String("profile_id", profile.ID.String()),
+				logger.Err(findErr),
+			)
+		}
+
+		if existingAuthor != nil {
+			author = existingAuthor
+		} else {
+			// Create new author
+			author = &domain.Author{
+				ProfileID: profile.ID,
+				Name:      extractedData.Author.Name,
+				Title:     extractedData.Author.Title,
+				Company:   extractedData.Author.Company,
+			}
+			if extractedData.Author.Company != nil {
+				companyID, linkErr := r.linkCompany(ctx, profile.ID, *extractedData.Author.Company)
+				if linkErr != nil {
+					r.log.Error("Failed to link author company",
+						logger.Feature("credibility"),
+						logger.String("profile_id", profile.ID.String()),
+						logger.Err(linkErr),
+					)
+				}
+				author.CompanyID = companyID
+			}
+			if createErr := r.authorRepo.Create(ctx, author); createErr != nil {
+				r.log.Error("Failed to create author",
+					logger.Feature("credibility"),
+					logger.String("profile_id", profile.ID.String()),
+					logger.Err(createErr),
+				)
+			}
+		}
+	}
+
+	for _, ti := range input.Testimonials {
+		// Map author relationship from extracted data to testimonial relationship
+		relationship := mapAuthorToTestimonialRelationship(extractedData.Author.Relationship)
+
+		testimonial := &domain.Testimonial{
+			ID:                uuid.New(),
+			ProfileID:         profile.ID,
+			ReferenceLetterID: refLetterID,
+			Quote:             ti.Quote,
+			Relationship:      relationship,
+			SkillsMentioned:   ti.SkillsMentioned,
+		}
+
+		// Link to author if created successfully
+		if author != nil {
+			testimonial.AuthorID = &author.ID
+		}
+
+		// Also populate legacy fields for backward compatibility
+		testimonial.AuthorName = &extractedData.Author.Name
+		testimonial.AuthorTitle = extractedData.Author.Title
+		testimonial.AuthorCompany = extractedData.Author.Company
+
+		if createErr := r.testimonialRepo.Create(ctx, testimonial); createErr != nil {
+			r.log.Error("Failed to create testimonial",
+				logger.Feature("credibility"),
+				logger.String("profile_id", profile.ID.String()),
+				logger.Err(createErr),
+			)
+			continue
+		}
+		appliedCount.Testimonials++
+	}
+
+	// Create new skills discovered in the reference letter
+	tax, taxErr := r.skillTaxonomy(ctx, profile.ID)
+	if taxErr != nil {
+		r.log.Warning("Failed to load profile skill taxonomy, using the bundled one",
+			logger.Feature("credibility"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(taxErr),
+		)
+		tax = taxonomy.Default()
+	}
+	for _, ns := range input.NewSkills {
+		// Get next display order
+		displayOrder, orderErr := r.profileSkillRepo.GetNextDisplayOrder(ctx, profile.ID)
+		if orderErr != nil {
+			r.log.Error("Failed to get next display order for skill",
+				logger.Feature("credibility"),
+				logger.String("profile_id", profile.ID.String()),
+				logger.Err(orderErr),
+			)
+			continue
+		}
+
+		skill := &domain.ProfileSkill{
+			ID:                      uuid.New(),
+			ProfileID:               profile.ID,
+			Category:                strings.ToUpper(string(ns.Category)),
+			DisplayOrder:            displayOrder,
+			Source:                  domain.ExperienceSourceLetterDiscovered,
+			SourceReferenceLetterID: &refLetterID,
+		}
+		setSkillName(tax, skill, ns.Name)
+		// Kept like an extracted skill so the skill's provenance can tell later edits apart
+		skill.OriginalData, _ = json.Marshal(domain.DiscoveredSkill{Skill: skill.Name, Category: domain.SkillCategory(skill.Category)})
+
+		if createErr := r.profileSkillRepo.Create(ctx, skill); createErr != nil {
+			// Ignore duplicate skills (same normalized name)
+			if !strings.Contains(createErr.Error(), "duplicate") && !strings.Contains(createErr.Error(), "unique constraint") {
+				r.log.Error("Failed to create new skill",
+					logger.Feature("credibility"),
+					logger.String("skill_name", ns.Name),
+					logger.Err(createErr),
+				)
+			}
+			continue
+		}
+		r.recordChanges(ctx, profile.ID, service.SkillChanges(nil, skill))
+
+		// If quoteContext provided, also create a skill validation for it
+		if ns.QuoteContext != nil && *ns.QuoteContext != "" {
+			validation := &domain.SkillValidation{
+				ID:                uuid.New(),
+				ProfileSkillID:    skill.ID,
+				ReferenceLetterID: refLetterID,
+				QuoteSnippet:      ns.QuoteContext,
+			}
+			if valErr := r.skillValidationRepo.Create(ctx, validation); valErr != nil {
+				r.log.Warning("Failed to create validation for new skill",
+					logger.Feature("credibility"),
+					logger.String("skill_id", skill.ID.String()),
+					logger.Err(valErr),
+				)
+			}
+		}
+		appliedCount.NewSkills++
+	}
+
+	// Update reference letter status to applied
+	refLetter.Status = domain.ReferenceLetterStatusApplied
+	if updateErr := r.refLetterRepo.Update(ctx, refLetter); updateErr != nil {
+		r.log.Error("Failed to update reference letter status",
+			logger.Feature("credibility"),
+			logger.String("reference_letter_id", input.ReferenceLetterID),
+			logger.Err(updateErr),
+		)
+		// Continue anyway - validations were applied successfully
+	}
+
+	r.recomputeSkillStats(ctx, profile.ID)
+	r.queueEvidenceEmbedding(ctx, profile.ID)
+
+	r.log.Info("Applied reference letter validations",
+		logger.Feature("credibility"),
+		logger.String("user_id", userID),
+		logger.String("reference_letter_id", input.ReferenceLetterID),
+		logger.Int("skill_validations", appliedCount.SkillValidations),
+		logger.Int("experience_validations", appliedCount.ExperienceValidations),
+		logger.Int("testimonials", appliedCount.Testimonials),
+		logger.Int("new_skills", appliedCount.NewSkills),
+	)
+
+	// Fetch file for reference letter (may be nil)
+	var file *model.File
+	if refLetter.FileID != nil {
+		fileEntity, fileErr := r.fileRepo.GetByID(ctx, *refLetter.FileID)
+		if fileErr == nil && fileEntity != nil {
+			file = toGraphQLFile(fileEntity, toGraphQLUser(user))
+		}
+	}
+
+	// Fetch profile relations for response
+	experiences, _ := r.profileExpRepo.GetByProfileID(ctx, profile.ID)
+	educations, _ := r.profileEduRepo.GetByProfileID(ctx, profile.ID)
+	skills, _ := r.profileSkillRepo.GetByProfileID(ctx, profile.ID)
+
+	// Get photo URL if present
+	var photoURL *string
+	if profile.ProfilePhotoFileID != nil {
+		photoFile, fileErr := r.fileRepo.GetByID(ctx, *profile.ProfilePhotoFileID)
+		if fileErr == nil && photoFile != nil {
+			url, urlErr := r.storage.GetPublicURL(ctx, photoFile.StorageKey, 24*time.Hour)
+			if urlErr == nil {
+				photoURL = &url
+			}
+		}
+	}
+
+	gqlProfile := toGraphQLProfile(profile, toGraphQLUser(user), toGraphQLProfileExperiences(experiences), toGraphQLProfileEducations(educations), toGraphQLProfileSkills(skills), photoURL)
+	_ = r.loadProfileSections(ctx, profile.ID, gqlProfile)
+
+	return &model.ApplyValidationsResult{
+		ReferenceLetter: toGraphQLReferenceLetter(refLetter, toGraphQLUser(user), file),
+		Profile:         gqlProfile,
+		AppliedCount:    appliedCount,
+	}, nil
+}
+
+// ResolveInconsistency is the resolver for the resolveInconsistency field.
+func (r *mutationResolver) ResolveInconsistency(ctx context.Context, id string, applyLetterValue *bool, note *string) (model.InconsistencyResponse, error) {
+	r.log.Info("Resolving profile inconsistency",
+		logger.Feature("profile"),
+		logger.String("inconsistency_id", id),
+	)
+	apply := applyLetterValue != nil && *applyLetterValue
+	return r.settleInconsistency(ctx, id, domain.InconsistencyStatusResolved, apply, note)
+}
+
+// DismissInconsistency is the resolver for the dismissInconsistency field.
+func (r *mutationResolver) DismissInconsistency(ctx context.Context, id string, note *string) (model.InconsistencyResponse, error) {
+	r.log.Info("Dismissing profile inconsistency",
+		logger.Feature("profile"),
+		logger.String("inconsistency_id", id),
+	)
+	return r.settleInconsistency(ctx, id, domain.InconsistencyStatusDismissed, false, note)
+}
+
+// UndoChange is the resolver for the undoChange field.
+func (r *mutationResolver) UndoChange(ctx context.Context, changeID string) (model.UndoChangeResponse, error) {
+	r.log.Info("Undoing profile change",
+		logger.Feature("profile"),
+		logger.String("change_id", changeID),
+	)
+
+	id, err := uuid.Parse(changeID)
+	if err != nil {
+		return &model.UndoChangeValidationError{
+			Message: "invalid change ID format",
+			Field:   stringPtr("changeId"),
+		}, nil
+	}
+
+	change, err := r.changeRepo.GetByID(ctx, id)
+	if err != nil {
+		r.log.Error("Failed to get profile change",
+			logger.Feature("profile"),
+			logger.String("change_id", changeID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get profile change: %w", err)
+	}
+	if change == nil {
+		return &model.UndoChangeValidationError{
+			Message: "change not found",
+			Field:   stringPtr("changeId"),
+		}, nil
+	}
+
+	reverts, err := r.history.Undo(ctx, change)
+	if err != nil {
+		var message string
+		switch {
+		case errors.Is(err, service.ErrChangeAlreadyUndone):
+			message = "change has already been undone"
+		case errors.Is(err, service.ErrChangedItemMissing):
+			message = "the changed item no longer exists"
+		case errors.Is(err, service.ErrDeletedItemExists):
+			message = "the deleted item has already been restored"
+		case errors.Is(err, service.ErrChangeSuperseded):
+			message = "the field has been changed again since; undo the later change first"
+		case errors.Is(err, service.ErrEndBeforeStart):
+			message = "end date cannot be before start date"
+		default:
+			r.log.Error("Failed to undo profile change",
+				logger.Feature("profile"),
+				logger.String("change_id", changeID),
+				logger.Err(err),
+			)
+			return nil, fmt.Errorf("failed to undo profile change: %w", err)
+		}
+		return &model.UndoChangeValidationError{
+			Message: message,
+			Field:   stringPtr("changeId"),
+		}, nil
+	}
+
+	r.recomputeSkillStats(ctx, change.ProfileID)
+	r.queueEvidenceEmbedding(ctx, change.ProfileID)
+
+	return &model.UndoChangeResult{Changes: toGraphQLProfileChanges(reverts)}, nil
+}
+
+// RevertToExtracted is the resolver for the revertToExtracted field.
+func (r *mutationResolver) RevertToExtracted(ctx context.Context, entityID string, fields []string) (model.RevertToExtractedResponse, error) {
+	r.log.Info("Reverting profile item to extracted values",
+		logger.Feature("profile"),
+		logger.String("entity_id", entityID),
+	)
+
+	id, err := uuid.Parse(entityID)
+	if err != nil {
+		return &model.RevertToExtractedValidationError{
+			Message: "invalid entity ID format",
+			Field:   stringPtr("entityId"),
+		}, nil
+	}
+
+	item, err := r.history.RevertToExtracted(ctx, id, fields)
+	if err != nil {
+		var message string
+		field := "entityId"
+		switch {
+		case errors.Is(err, service.ErrNoExtractedValues):
+			message = "item has no extracted values to revert to"
+		case errors.Is(err, service.ErrUnknownField):
+			message = err.Error()
+			field = "fields"
+		case errors.Is(err, service.ErrEndBeforeStart):
+			message = "end date cannot be before start date"
+			field = "fields"
+		default:
+			r.log.Error("Failed to revert profile item to extracted values",
+				logger.Feature("profile"),
+				logger.String("entity_id", entityID),
+				logger.Err(err),
+			)
+			return nil, fmt.Errorf("failed to revert to extracted values: %w", err)
+		}
+		return &model.RevertToExtractedValidationError{
+			Message: message,
+			Field:   stringPtr(field),
+		}, nil
+	}
+	if item == nil {
+		return &model.RevertToExtractedValidationError{
+			Message: "item not found",
+			Field:   stringPtr("entityId"),
+		}, nil
+	}
+
+	result := &model.RevertToExtractedResult{}
+	switch {
+	case item.Experience != nil:
+		r.recomputeSkillStats(ctx, item.Experience.ProfileID)
+		r.queueEvidenceEmbedding(ctx, item.Experience.ProfileID)
+		result.Experience = toGraphQLProfileExperience(item.Experience)
+	case item.Education != nil:
+		result.Education = toGraphQLProfileEducation(item.Education)
+	case item.Skill != nil:
+		r.recomputeSkillStats(ctx, item.Skill.ProfileID)
+		r.queueEvidenceEmbedding(ctx, item.Skill.ProfileID)
+		result.Skill = toGraphQLProfileSkill(item.Skill)
+	}
+	return result, nil
+}
+
+// UploadAuthorImage is the resolver for the uploadAuthorImage field.
+func (r *mutationResolver) UploadAuthorImage(ctx context.Context, authorID string, file graphql.Upload) (model.UploadAuthorImageResponse, error) {
+	r.log.Info("Author image upload started",
+		logger.Feature("profile"),
+		logger.String("author_id", authorID),
+		logger.String("filename", file.Filename),
+		logger.String("content_type", file.ContentType),
+		logger.Int64("size_bytes", file.Size),
+	)
+
+	// Parse and validate author ID
+	aid, err := uuid.Parse(authorID)
+	if err != nil {
+		r.log.Warning("Invalid author ID format",
+			logger.Feature("profile"),
+			logger.String("author_id", authorID),
+		)
+		return &model.FileValidationError{
+			Message: "invalid author ID format",
+			Field:   "authorId",
+		}, nil
+	}
+
+	// Get existing author to verify it exists
+	author, err := r.authorRepo.GetByID(ctx, aid)
+	if err != nil {
+		r.log.Error("Failed to get author",
+			logger.Feature("profile"),
+			logger.String("author_id", authorID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get author: %w", err)
+	}
+	if author == nil {
+		r.log.Warning("Author not found",
+			logger.Feature("profile"),
+			logger.String("author_id", authorID),
+		)
+		return &model.FileValidationError{
+			Message: "author not found",
+			Field:   "authorId",
+		}, nil
+	}
+
+	// Validate file type (images only)
+	allowedTypes := map[string]bool{
+		"image/jpeg": true,
+		"image/png":  true,
+		"image/gif":  true,
+		"image/webp": true,
+	}
+
+	if !allowedTypes[file.ContentType] {
+		r.log.Warning("File type not allowed for author image",
+			logger.Feature("profile"),
+			logger.String("author_id", authorID),
+			logger.String("content_type", file.ContentType),
+		)
+		return &model.FileValidationError{
+			Message: "file type not allowed: must be JPEG, PNG, GIF, or WebP",
+			Field:   "contentType",
+		}, nil
+	}
+
+	// Validate file size (max 5MB for photos)
+	const maxSize = 5 * 1024 * 1024
+	if file.Size > maxSize {
+		r.log.Warning("File too large for author image",
+			logger.Feature("profile"),
+			logger.String("author_id", authorID),
+			logger.Int64("size_bytes", file.Size),
+			logger.Int("max_bytes", maxSize),
+		)
+		return &model.FileValidationError{
+			Message: "file too large: maximum size is 5MB",
+			Field:   "size",
+		}, nil
+	}
+
+	// Delete old image file if exists
+	if author.ImageID != nil {
+		oldFile, err := r.fileRepo.GetByID(ctx, *author.ImageID)
+		if err == nil && oldFile != nil {
+			// Delete from storage (best effort)
+			_ = r.storage.Delete(ctx, oldFile.StorageKey)
+			// Delete file record (best effort)
+			_ = r.fileRepo.Delete(ctx, *author.ImageID)
+		}
+	}
+
+	// Generate storage key - use profile ID for organization
+	fileID := uuid.New()
+	storageKey := fmt.Sprintf("author-images/%s/%s/%s", author.ProfileID.String(), fileID.String(), file.Filename)
+
+	// Upload to storage
+	_, err = r.storage.Upload(ctx, storageKey, file.File, file.Size, file.ContentType)
+	if err != nil {
+		r.log.Error("Failed to upload author image to storage",
+			logger.Feature("profile"),
+			logger.String("author_id", authorID),
+			logger.String("storage_key", storageKey),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to upload author image to storage: %w", err)
+	}
+
+	// Get the profile's user ID for file record
+	profile, err := r.profileRepo.GetByID(ctx, author.ProfileID)
+	if err != nil {
+		r.log.Error("Failed to get profile for author",
+			logger.Feature("profile"),
+			logger.String("author_id", authorID),
+			logger.String("profile_id", author.ProfileID.String()),
+			logger.Err(err),
+		)
+		// Clean up uploaded file
+		_ = r.storage.Delete(ctx, storageKey)
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+
+	// Create file record
+	domainFile := &domain.File{
+		ID:          fileID,
+		UserID:      profile.UserID,
+		Filename:    file.Filename,
+		ContentType: file.ContentType,
+		SizeBytes:   file.Size,
+		StorageKey:  storageKey,
+	}
+
+	if err := r.fileRepo.Create(ctx, domainFile); err != nil {
+		r.log.Error("Failed to create file record",
+			logger.Feature("profile"),
+			logger.String("author_id", authorID),
+			logger.String("file_id", fileID.String()),
+			logger.Err(err),
+		)
+		// Attempt to clean up uploaded file
+		_ = r.storage.Delete(ctx, storageKey)
+		return nil, fmt.Errorf("failed to create file record: %w", err)
+	}
+
+	// Update author with new image ID
+	author.ImageID = &fileID
+	if err := r.authorRepo.Update(ctx, author); err != nil {
+		r.log.Error("Failed to update author with image",
+			logger.Feature("profile"),
+			logger.String("author_id", authorID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update author: %w", err)
+	}
+
+	// Generate presigned URL for the response
+	imageURL, err := r.storage.GetPresignedURL(ctx, storageKey, time.Hour)
+	if err != nil {
+		r.log.Warning("Failed to generate presigned URL for author image",
+			logger.Feature("profile"),
+			logger.String("author_id", authorID),
+			logger.Err(err),
+		)
+		// Continue without URL - not a fatal error
+	}
+
+	// Get user for file response
+	user, err := r.userRepo.GetByID(ctx, profile.UserID)
+	if err != nil {
+		r.log.Warning("Failed to get user for file response",
+			logger.Feature("profile"),
+			logger.String("user_id", profile.UserID.String()),
+			logger.Err(err),
+		)
+	}
+
+	r.log.Info("Author image uploaded successfully",
+		logger.Feature("profile"),
+		logger.String("author_id", authorID),
+		logger.String("file_id", fileID.String()),
+	)
+
+	return &model.UploadAuthorImageResult{
+		File:   toGraphQLFile(domainFile, toGraphQLUser(user)),
+		Author: toGraphQLAuthorWithImage(author, &imageURL),
+	}, nil
+}
+
+// UpdateAuthor is the resolver for the updateAuthor field.
+func (r *mutationResolver) UpdateAuthor(ctx context.Context, id string, input model.UpdateAuthorInput) (*model.Author, error) {
+	authorID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid author ID: %w", err)
+	}
+
+	// Get existing author
+	author, err := r.authorRepo.GetByID(ctx, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get author: %w", err)
+	}
+	if author == nil {
+		return nil, fmt.Errorf("author not found")
+	}
+
+	// Apply updates
+	if input.Name != nil {
+		author.Name = *input.Name
+	}
+	if input.Title != nil {
+		if *input.Title == "" {
+			author.Title = nil
+		} else {
+			author.Title = input.Title
+		}
+	}
+	if input.Company != nil {
+		if *input.Company == "" {
+			author.Company = nil
+			author.CompanyID = nil
+		} else {
+			companyID, err := r.linkCompany(ctx, author.ProfileID, *input.Company)
+			if err != nil {
+				return nil, fmt.Errorf("failed to link company: %w", err)
+			}
+			author.Company = input.Company
+			author.CompanyID = companyID
+		}
+	}
+	if input.LinkedInURL != nil {
+		if *input.LinkedInURL == "" {
+			author.LinkedInURL = nil
+		} else {
+			author.LinkedInURL = input.LinkedInURL
+		}
+	}
+	if input.ImageID != nil {
+		if *input.ImageID == "" {
+			// Clear the image
+			author.ImageID = nil
+		} else {
+			imageUUID, err := uuid.Parse(*input.ImageID)
+			if err != nil {
+				return nil, fmt.Errorf("invalid image ID: %w", err)
+			}
+			author.ImageID = &imageUUID
+		}
+	}
+
+	// Save updates
+	if err := r.authorRepo.Update(ctx, author); err != nil {
+		return nil, fmt.Errorf("failed to update author: %w", err)
+	}
+	r.recomputeSkillStats(ctx, author.ProfileID)
+
+	// Get image URL if author has an image
+	var imageURL *string
+	if author.ImageID != nil {
+		file, err := r.fileRepo.GetByID(ctx, *author.ImageID)
+		if err == nil && file != nil {
+			url, err := r.storage.GetPresignedURL(ctx, file.StorageKey, time.Hour)
+			if err == nil {
+				imageURL = &url
+			}
+		}
+	}
+
+	return toGraphQLAuthorWithImage(author, imageURL), nil
+}
+
+// UpdateCompany is the resolver for the updateCompany field.
+func (r *mutationResolver) UpdateCompany(ctx context.Context, id string, input model.UpdateCompanyInput) (model.CompanyResponse, error) {
+	r.log.Info("Updating company",
+		logger.Feature("profile"),
+		logger.String("company_id", id),
+	)
+
+	// Parse and validate company ID
+	companyID, err := uuid.Parse(id)
+	if err != nil {
+		r.log.Warning("Invalid company ID format",
+			logger.Feature("profile"),
+			logger.String("company_id", id),
+		)
+		return &model.CompanyValidationError{
+			Message: "invalid company ID format",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	// Get existing company
+	company, err := r.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		r.log.Error("Failed to get company",
+			logger.Feature("profile"),
+			logger.String("company_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get company: %w", err)
+	}
+	if company == nil {
+		r.log.Warning("Company not found",
+			logger.Feature("profile"),
+			logger.String("company_id", id),
+		)
+		return &model.CompanyValidationError{
+			Message: "company not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	// Update fields if provided
+	oldName := company.Name
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" {
+			return &model.CompanyValidationError{
+				Message: "company name is required",
+				Field:   stringPtr("name"),
+			}, nil
+		}
+		company.Name = name
+	}
+	if input.Website != nil {
+		if website := strings.TrimSpace(*input.Website); website == "" {
+			company.Website = nil
+		} else {
+			company.Website = &website
+		}
+	}
+	if input.LogoID != nil {
+		if *input.LogoID == "" {
+			company.LogoID = nil
+		} else {
+			logoID, err := uuid.Parse(*input.LogoID)
+			if err != nil {
+				return &model.CompanyValidationError{
+					Message: "invalid logo ID format",
+					Field:   stringPtr("logoId"),
+				}, nil
+			}
+			logo, err := r.fileRepo.GetByID(ctx, logoID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get logo file: %w", err)
+			}
+			if logo == nil {
+				return &model.CompanyValidationError{
+					Message: "logo file not found",
+					Field:   stringPtr("logoId"),
+				}, nil
+			}
+			company.LogoID = &logoID
+		}
+	}
+
+	if err := r.companyRepo.Update(ctx, company); err != nil {
+		r.log.Error("Failed to update company",
+			logger.Feature("profile"),
+			logger.String("company_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update company: %w", err)
+	}
+
+	if err := service.SyncCompanyAliases(ctx, r.companyAliasRepo, company, oldName, input.Aliases); err != nil {
+		r.log.Error("Failed to update company aliases",
+			logger.Feature("profile"),
+			logger.String("company_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update company aliases: %w", err)
+	}
+
+	r.log.Info("Company updated",
+		logger.Feature("profile"),
+		logger.String("company_id", id),
+	)
+
+	return &model.CompanyResult{
+		Company: toGraphQLCompany(company),
+	}, nil
+}
+
+// MergeCompanies is the resolver for the mergeCompanies field.
+func (r *mutationResolver) MergeCompanies(ctx context.Context, targetID string, sourceIds []string) (model.CompanyResponse, error) {
+	r.log.Info("Merging companies",
+		logger.Feature("profile"),
+		logger.String("target_id", targetID),
+		logger.Int("source_count", len(sourceIds)),
+	)
+
+	tid, err := uuid.Parse(targetID)
+	if err != nil {
+		return &model.CompanyValidationError{
+			Message: "invalid target company ID format",
+			Field:   stringPtr("targetId"),
+		}, nil
+	}
+	if len(sourceIds) == 0 {
+		return &model.CompanyValidationError{
+			Message: "at least one source company is required",
+			Field:   stringPtr("sourceIds"),
+		}, nil
+	}
+
+	target, err := r.companyRepo.GetByID(ctx, tid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company: %w", err)
+	}
+	if target == nil {
+		return &model.CompanyValidationError{
+			Message: "target company not found",
+			Field:   stringPtr("targetId"),
+		}, nil
+	}
+
+	sourceIDs := make([]uuid.UUID, 0, len(sourceIds))
+	for _, sourceID := range sourceIds {
+		sid, err := uuid.Parse(sourceID)
+		if err != nil {
+			return &model.CompanyValidationError{
+				Message: "invalid source company ID format",
+				Field:   stringPtr("sourceIds"),
+			}, nil
+		}
+		if sid == tid {
+			return &model.CompanyValidationError{
+				Message: "a company cannot be merged into itself",
+				Field:   stringPtr("sourceIds"),
+			}, nil
+		}
+		source, err := r.companyRepo.GetByID(ctx, sid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get company: %w", err)
+		}
+		if source == nil || source.ProfileID != target.ProfileID {
+			return &model.CompanyValidationError{
+				Message: "source company not found",
+				Field:   stringPtr("sourceIds"),
+			}, nil
+		}
+		sourceIDs = append(sourceIDs, sid)
+	}
+
+	merged, err := r.companyRepo.Merge(ctx, tid, sourceIDs)
+	if err != nil {
+		r.log.Error("Failed to merge companies",
+			logger.Feature("profile"),
+			logger.String("target_id", targetID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to merge companies: %w", err)
+	}
+
+	r.recomputeSkillStats(ctx, merged.ProfileID)
+
+	r.log.Info("Companies merged",
+		logger.Feature("profile"),
+		logger.String("target_id", targetID),
+		logger.Int("source_count", len(sourceIDs)),
+	)
+
+	return &model.CompanyResult{
+		Company: toGraphQLCompany(merged),
+	}, nil
+}
+
+// CreateCompanyAlias is the resolver for the createCompanyAlias field.
+func (r *mutationResolver) CreateCompanyAlias(ctx context.Context, userID string, input model.CreateCompanyAliasInput) (model.CompanyAliasResponse, error) {
+	r.log.Info("Creating company alias",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+	)
+
+	// Parse and validate user ID
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		r.log.Warning("Invalid user ID format",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+		)
+		return &model.CompanyAliasValidationError{
+			Message: "invalid user ID format",
+			Field:   stringPtr("userId"),
+		}, nil
+	}
+
+	alias := strings.TrimSpace(input.Alias)
+	canonicalName := strings.TrimSpace(input.CanonicalName)
+	if validationErr := validateCompanyAlias(alias, canonicalName); validationErr != nil {
+		return validationErr, nil
+	}
+
+	// Verify user exists
+	user, err := r.userRepo.GetByID(ctx, uid)
+	if err != nil {
+		r.log.Error("Failed to verify user",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to verify user: %w", err)
+	}
+	if user == nil {
+		r.log.Warning("User not found",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+		)
+		return &model.CompanyAliasValidationError{
+			Message: "user not found",
+			Field:   stringPtr("userId"),
+		}, nil
+	}
+
+	// Get or create profile for user
+	profile, err := r.profileRepo.GetOrCreateByUserID(ctx, uid)
+	if err != nil {
+		r.log.Error("Failed to get or create profile",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get or create profile: %w", err)
+	}
+
+	companyAlias := &domain.CompanyAlias{
+		ID:            uuid.New(),
+		ProfileID:     profile.ID,
+		Alias:         alias,
+		CanonicalName: canonicalName,
+	}
+
+	if err := r.companyAliasRepo.Create(ctx, companyAlias); err != nil {
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique constraint") {
+			return &model.CompanyAliasValidationError{
+				Message: "alias already exists",
+				Field:   stringPtr("alias"),
+			}, nil
+		}
+		r.log.Error("Failed to create company alias",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to create company alias: %w", err)
+	}
+
+	r.log.Info("Company alias created",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+		logger.String("company_alias_id", companyAlias.ID.String()),
+	)
+
+	return &model.CompanyAliasResult{
+		CompanyAlias: toGraphQLCompanyAlias(companyAlias),
+	}, nil
+}
+
+// UpdateCompanyAlias is the resolver for the updateCompanyAlias field.
+func (r *mutationResolver) UpdateCompanyAlias(ctx context.Context, id string, input model.UpdateCompanyAliasInput) (model.CompanyAliasResponse, error) {
+	r.log.Info("Updating company alias",
+		logger.Feature("profile"),
+		logger.String("company_alias_id", id),
+	)
+
+	// Parse and validate alias ID
+	aliasID, err := uuid.Parse(id)
+	if err != nil {
+		r.log.Warning("Invalid company alias ID format",
+			logger.Feature("profile"),
+			logger.String("company_alias_id", id),
+		)
+		return &model.CompanyAliasValidationError{
+			Message: "invalid company alias ID format",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	// Get existing alias
+	companyAlias, err := r.companyAliasRepo.GetByID(ctx, aliasID)
+	if err != nil {
+		r.log.Error("Failed to get company alias",
+			logger.Feature("profile"),
+			logger.String("company_alias_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get company alias: %w", err)
+	}
+	if companyAlias == nil {
+		r.log.Warning("Company alias not found",
+			logger.Feature("profile"),
+			logger.String("company_alias_id", id),
+		)
+		return &model.CompanyAliasValidationError{
+			Message: "company alias not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	// Update fields if provided
+	if input.Alias != nil {
+		companyAlias.Alias = strings.TrimSpace(*input.Alias)
+	}
+	if input.CanonicalName != nil {
+		companyAlias.CanonicalName = strings.TrimSpace(*input.CanonicalName)
+	}
+	if validationErr := validateCompanyAlias(companyAlias.Alias, companyAlias.CanonicalName); validationErr != nil {
+		return validationErr, nil
+	}
+
+	if err := r.companyAliasRepo.Update(ctx, companyAlias); err != nil {
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique constraint") {
+			return &model.CompanyAliasValidationError{
+				Message: "alias already exists",
+				Field:   stringPtr("alias"),
+			}, nil
+		}
+		r.log.Error("Failed to update company alias",
+			logger.Feature("profile"),
+			logger.String("company_alias_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update company alias: %w", err)
+	}
+
+	r.log.Info("Company alias updated",
+		logger.Feature("profile"),
+		logger.String("company_alias_id", id),
+	)
+
+	return &model.CompanyAliasResult{
+		CompanyAlias: toGraphQLCompanyAlias(companyAlias),
+	}, nil
+}
+
+// DeleteCompanyAlias is the resolver for the deleteCompanyAlias field.
+func (r *mutationResolver) DeleteCompanyAlias(ctx context.Context, id string) (*model.DeleteResult, error) {
+	r.log.Info("Deleting company alias",
+		logger.Feature("profile"),
+		logger.String("company_alias_id", id),
+	)
+
+	// Parse and validate alias ID
+	aliasID, err := uuid.Parse(id)
+	if err != nil {
+		r.log.Warning("Invalid company alias ID format",
+			logger.Feature("profile"),
+			logger.String("company_alias_id", id),
+		)
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	// Check if alias exists
+	companyAlias, err := r.companyAliasRepo.GetByID(ctx, aliasID)
+	if err != nil {
+		r.log.Error("Failed to get company alias",
+			logger.Feature("profile"),
+			logger.String("company_alias_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get company alias: %w", err)
+	}
+	if companyAlias == nil {
+		r.log.Warning("Company alias not found",
+			logger.Feature("profile"),
+			logger.String("company_alias_id", id),
+		)
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	if err := r.companyAliasRepo.Delete(ctx, aliasID); err != nil {
+		r.log.Error("Failed to delete company alias",
+			logger.Feature("profile"),
+			logger.String("company_alias_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to delete company alias: %w", err)
+	}
+
+	r.log.Info("Company alias deleted",
+		logger.Feature("profile"),
+		logger.String("company_alias_id", id),
+	)
+
+	return &model.DeleteResult{
+		Success:   true,
+		DeletedID: id,
+	}, nil
+}
+
+// CreateCanonicalSkill is the resolver for the createCanonicalSkill field.
+func (r *mutationResolver) CreateCanonicalSkill(ctx context.Context, userID string, input model.CreateCanonicalSkillInput) (model.CanonicalSkillResponse, error) {
+	r.log.Info("Creating canonical skill",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+	)
+
+	// Parse and validate user ID
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		r.log.Warning("Invalid user ID format",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+		)
+		return &model.CanonicalSkillValidationError{
+			Message: "invalid user ID format",
+			Field:   stringPtr("userId"),
+		}, nil
+	}
+
+	name := strings.TrimSpace(input.Name)
+
+	// Verify user exists
+	user, err := r.userRepo.GetByID(ctx, uid)
+	if err != nil {
+		r.log.Error("Failed to verify user",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to verify user: %w", err)
+	}
+	if user == nil {
+		r.log.Warning("User not found",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+		)
+		return &model.CanonicalSkillValidationError{
+			Message: "user not found",
+			Field:   stringPtr("userId"),
+		}, nil
+	}
+
+	// Get or create profile for user
+	profile, err := r.profileRepo.GetOrCreateByUserID(ctx, uid)
+	if err != nil {
+		r.log.Error("Failed to get or create profile",
+			logger.Feature("profile"),
+			logger.String("user_id", userID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get or create profile: %w", err)
+	}
+
+	tax, err := r.skillTaxonomy(ctx, profile.ID)
+	if err != nil {
+		r.log.Error("Failed to load skill taxonomy",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
+		)
+		return nil, err
+	}
+
+	skill := &domain.Skill{
+		ID:        uuid.New(),
+		ProfileID: &profile.ID,
+		Slug:      taxonomy.Slug(name),
+		Name:      name,
+		Category:  strings.ToUpper(string(input.Category)),
+		Synonyms:  cleanSkillSynonyms(name, input.Synonyms),
+	}
+	if validationErr := validateCanonicalSkillNames(tax, skill.ID, skill.Name, skill.Synonyms); validationErr != nil {
+		return validationErr, nil
+	}
+	if input.ParentID != nil && *input.ParentID != "" {
+		parent, validationErr := validateCanonicalSkillParent(tax, skill.ID, *input.ParentID)
+		if validationErr != nil {
+			return validationErr, nil
+		}
+		skill.ParentID = &parent.ID
+	}
+
+	if err := r.skillRepo.Create(ctx, skill); err != nil {
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique constraint") {
+			return &model.CanonicalSkillValidationError{
+				Message: "skill already exists",
+				Field:   stringPtr("name"),
+			}, nil
+		}
+		r.log.Error("Failed to create canonical skill",
+			logger.Feature("profile"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to create canonical skill: %w", err)
+	}
+
+	// Link existing profile skills that the new skill now covers
+	tax = tax.With(skill)
+	if err := service.RelinkProfileSkills(ctx, r.profileSkillRepo, tax, profile.ID); err != nil {
+		r.log.Warning("Failed to relink profile skills",
+			logger.Feature("profile"),
+			logger.String("canonical_skill_id", skill.ID.String()),
+			logger.Err(err),
+		)
+	}
+
+	r.recomputeSkillStats(ctx, profile.ID)
+
+	r.log.Info("Canonical skill created",
+		logger.Feature("profile"),
+		logger.String("user_id", userID),
+		logger.String("canonical_skill_id", skill.ID.String()),
+	)
+
+	return &model.CanonicalSkillResult{
+		Skill: toGraphQLCanonicalSkill(tax, skill),
+	}, nil
+}
+
+// UpdateCanonicalSkill is the resolver for the updateCanonicalSkill field.
+func (r *mutationResolver) UpdateCanonicalSkill(ctx context.Context, id string, input model.UpdateCanonicalSkillInput) (model.CanonicalSkillResponse, error) {
+	r.log.Info("Updating canonical skill",
+		logger.Feature("profile"),
+		logger.String("canonical_skill_id", id),
+	)
+
+	// Parse and validate skill ID
+	skillID, err := uuid.Parse(id)
+	if err != nil {
+		r.log.Warning("Invalid canonical skill ID format",
+			logger.Feature("profile"),
+			logger.String("canonical_skill_id", id),
+		)
+		return &model.CanonicalSkillValidationError{
+			Message: "invalid skill ID format",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	skill, err := r.skillRepo.GetByID(ctx, skillID)
+	if err != nil {
+		r.log.Error("Failed to get canonical skill",
+			logger.Feature("profile"),
+			logger.String("canonical_skill_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get canonical skill: %w", err)
+	}
+	if skill == nil {
+		r.log.Warning("Canonical skill not found",
+			logger.Feature("profile"),
+			logger.String("canonical_skill_id", id),
+		)
+		return &model.CanonicalSkillValidationError{
+			Message: "skill not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+	if !skill.IsCustom() {
+		return &model.CanonicalSkillValidationError{
+			Message: "bundled skills cannot be changed",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	tax, err := r.skillTaxonomy(ctx, *skill.ProfileID)
+	if err != nil {
+		r.log.Error("Failed to load skill taxonomy",
+			logger.Feature("profile"),
+			logger.String("canonical_skill_id", id),
+			logger.Err(err),
+		)
+		return nil, err
+	}
+
+	// Apply updates
+	if input.Name != nil {
+		skill.Name = strings.TrimSpace(*input.Name)
+		skill.Slug = taxonomy.Slug(skill.Name)
+	}
+	if input.Category != nil {
+		skill.Category = strings.ToUpper(string(*input.Category))
+	}
+	if input.ParentID != nil {
+		if *input.ParentID == "" {
+			skill.ParentID = nil
+		} else {
+			parent, validationErr := validateCanonicalSkillParent(tax, skill.ID, *input.ParentID)
+			if validationErr != nil {
+				return validationErr, nil
+			}
+			skill.ParentID = &parent.ID
+		}
+	}
+	if input.Synonyms != nil {
+		skill.Synonyms = input.Synonyms
+	}
+	skill.Synonyms = cleanSkillSynonyms(skill.Name, skill.Synonyms)
+	if validationErr := validateCanonicalSkillNames(tax, skill.ID, skill.Name, skill.Synonyms); validationErr != nil {
+		return validationErr, nil
+	}
+
+	if err := r.skillRepo.Update(ctx, skill); err != nil {
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique constraint") {
+			return &model.CanonicalSkillValidationError{
+				Message: "skill already exists",
+				Field:   stringPtr("name"),
+			}, nil
+		}
+		r.log.Error("Failed to update canonical skill",
+			logger.Feature("profile"),
+			logger.String("canonical_skill_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update canonical skill: %w", err)
+	}
+
+	// Renamed names and synonyms change which profile skills the skill covers
+	tax = tax.With(skill)
+	if err := service.RelinkProfileSkills(ctx, r.profileSkillRepo, tax, *skill.ProfileID); err != nil {
+		r.log.Warning("Failed to relink profile skills",
+			logger.Feature("profile"),
+			logger.String("canonical_skill_id", id),
+			logger.Err(err),
+		)
+	}
+
+	r.recomputeSkillStats(ctx, *skill.ProfileID)
+
+	r.log.Info("Canonical skill updated",
+		logger.Feature("profile"),
+		logger.String("canonical_skill_id", id),
+	)
+
+	return &model.CanonicalSkillResult{
+		Skill: toGraphQLCanonicalSkill(tax, skill),
+	}, nil
+}
+
+// DeleteCanonicalSkill is the resolver for the deleteCanonicalSkill field.
+func (r *mutationResolver) DeleteCanonicalSkill(ctx context.Context, id string) (*model.DeleteResult, error) {
+	r.log.Info("Deleting canonical skill",
+		logger.Feature("profile"),
+		logger.String("canonical_skill_id", id),
+	)
+
+	// Parse and validate skill ID
+	skillID, err := uuid.Parse(id)
+	if err != nil {
+		r.log.Warning("Invalid canonical skill ID format",
+			logger.Feature("profile"),
+			logger.String("canonical_skill_id", id),
+		)
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	// Only a profile's own skills can be deleted
+	skill, err := r.skillRepo.GetByID(ctx, skillID)
+	if err != nil {
+		r.log.Error("Failed to get canonical skill",
+			logger.Feature("profile"),
+			logger.String("canonical_skill_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get canonical skill: %w", err)
+	}
+	if skill == nil || !skill.IsCustom() {
+		r.log.Warning("Canonical skill not found or not deletable",
+			logger.Feature("profile"),
+			logger.String("canonical_skill_id", id),
+		)
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	if err := r.skillRepo.Delete(ctx, skillID); err != nil {
+		r.log.Error("Failed to delete canonical skill",
+			logger.Feature("profile"),
+			logger.String("canonical_skill_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to delete canonical skill: %w", err)
+	}
+
+	// Profile skills linked to the deleted skill may still match a bundled one
+	tax, err := r.skillTaxonomy(ctx, *skill.ProfileID)
+	if err == nil {
+		err = service.RelinkProfileSkills(ctx, r.profileSkillRepo, tax, *skill.ProfileID)
+	}
+	if err != nil {
+		r.log.Warning("Failed to relink profile skills",
+			logger.Feature("profile"),
+			logger.String("canonical_skill_id", id),
+			logger.Err(err),
+		)
+	}
+
+	r.recomputeSkillStats(ctx, *skill.ProfileID)
+
+	r.log.Info("Canonical skill deleted",
+		logger.Feature("profile"),
+		logger.String("canonical_skill_id", id),
+	)
+
+	return &model.DeleteResult{
+		Success:   true,
+		DeletedID: id,
+	}, nil
+}
+
+// DeleteTestimonial is the resolver for the deleteTestimonial field.
+func (r *mutationResolver) DeleteTestimonial(ctx context.Context, id string) (*model.DeleteResult, error) {
+	r.log.Info("Deleting testimonial",
+		logger.Feature("profile"),
+		logger.String("testimonial_id", id),
+	)
+
+	// Parse and validate testimonial ID
+	testimonialID, err := uuid.Parse(id)
+	if err != nil {
+		r.log.Warning("Invalid testimonial ID format",
+			logger.Feature("profile"),
+			logger.String("testimonial_id", id),
+		)
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	// Check if testimonial exists
+	testimonial, err := r.testimonialRepo.GetByID(ctx, testimonialID)
+	if err != nil {
+		r.log.Error("Failed to get testimonial",
+			logger.Feature("profile"),
+			logger.String("testimonial_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to get testimonial: %w", err)
+	}
+	if testimonial == nil {
+		r.log.Warning("Testimonial not found",
+			logger.Feature("profile"),
+			logger.String("testimonial_id", id),
+		)
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	// Delete testimonial
+	if err := r.testimonialRepo.Delete(ctx, testimonialID); err != nil {
+		r.log.Error("Failed to delete testimonial",
+			logger.Feature("profile"),
+			logger.String("testimonial_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to delete testimonial: %w", err)
+	}
+
+	r.recomputeSkillStats(ctx, testimonial.ProfileID)
+	r.queueEvidenceEmbedding(ctx, testimonial.ProfileID)
+
+	r.log.Info("Testimonial deleted",
+		logger.Feature("profile"),
+		logger.String("testimonial_id", id),
+	)
+
+	return &model.DeleteResult{
+		Success:   true,
+		DeletedID: id,
+	}, nil
+}
+
+// MatchJobDescription is the resolver for the matchJobDescription field.
+func (r *mutationResolver) MatchJobDescription(ctx context.Context, profileID string, jobDescriptionText string) (model.MatchJobDescriptionResponse, error) {
+	r.log.Info("Matching job description",
+		logger.Feature("job-match"),
+		logger.String("profile_id", profileID),
+	)
+
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return &model.JobMatchValidationError{
+			Message: "invalid profile ID format",
+			Field:   stringPtr("profileId"),
+		}, nil
+	}
+	if strings.TrimSpace(jobDescriptionText) == "" {
+		return &model.JobMatchValidationError{
+			Message: "job description text is required",
+			Field:   stringPtr("jobDescriptionText"),
+		}, nil
+	}
+	if r.documentExtractor == nil {
+		return &model.JobMatchValidationError{
+			Message: "job description extraction is not available",
+			Field:   stringPtr("jobDescriptionText"),
+		}, nil
+	}
+
+	profile, err := r.profileRepo.GetByID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	if profile == nil {
+		return &model.JobMatchValidationError{
+			Message: "profile not found",
+			Field:   stringPtr("profileId"),
+		}, nil
+	}
+
+	match, err := r.jobMatch.MatchText(ctx, profile, jobDescriptionText)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		if errors.As(err, &validationErr) {
+			return &model.JobMatchValidationError{
+				Message: validationErr.Message,
+				Field:   stringPtr("jobDescriptionText"),
+			}, nil
+		}
+		r.log.Error("Failed to match job description",
+			logger.Feature("job-match"),
+			logger.String("profile_id", profileID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to match job description: %w", err)
+	}
+
+	r.log.Info("Job description matched",
+		logger.Feature("job-match"),
+		logger.String("profile_id", profileID),
+		logger.String("job_description_id", match.JobDescription.ID.String()),
+		logger.Float64("score", match.Score),
+	)
+
+	return &model.JobMatchResult{Match: toGraphQLJobMatch(match)}, nil
+}
+
+// DeleteJobDescription is the resolver for the deleteJobDescription field.
+func (r *mutationResolver) DeleteJobDescription(ctx context.Context, id string) (*model.DeleteResult, error) {
+	jdID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	jd, err := r.jobDescriptionRepo.GetByID(ctx, jdID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job description: %w", err)
+	}
+	if jd == nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	if err := r.jobDescriptionRepo.Delete(ctx, jdID); err != nil {
+		return nil, fmt.Errorf("failed to delete job description: %w", err)
+	}
+
+	return &model.DeleteResult{
+		Success:   true,
+		DeletedID: id,
+	}, nil
+}
+
+// CreateProfileVariant is the resolver for the createProfileVariant field.
+func (r *mutationResolver) CreateProfileVariant(ctx context.Context, profileID string, input model.CreateProfileVariantInput) (model.ProfileVariantResponse, error) {
+	r.log.Info("Creating profile variant",
+		logger.Feature("profile"),
+		logger.String("profile_id", profileID),
+	)
+
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return &model.ProfileVariantValidationError{
+			Message: "invalid profile ID format",
+			Field:   stringPtr("profileId"),
+		}, nil
+	}
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return &model.ProfileVariantValidationError{
+			Message: "name is required",
+			Field:   stringPtr("name"),
+		}, nil
+	}
+
+	profile, err := r.profileRepo.GetByID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	if profile == nil {
+		return &model.ProfileVariantValidationError{
+			Message: "profile not found",
+			Field:   stringPtr("profileId"),
+		}, nil
+	}
+
+	variant := &domain.ProfileVariant{
+		ID:         uuid.New(),
+		ProfileID:  profile.ID,
+		Name:       name,
+		TargetRole: input.TargetRole,
+		Summary:    input.Summary,
+	}
+	invalid, err := r.profileVariantRules(ctx, profile.ID, input.HiddenItemIds, input.ItemOrder, input.TestimonialIds, input.ExperienceOverrides, &variant.Rules)
+	if err != nil {
+		return nil, err
+	}
+	if invalid != nil {
+		return invalid, nil
+	}
+
+	if err := r.profileVariantRepo.Create(ctx, variant); err != nil {
+		r.log.Error("Failed to create profile variant",
+			logger.Feature("profile"),
+			logger.String("profile_id", profileID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to create profile variant: %w", err)
+	}
+
+	r.log.Info("Profile variant created",
+		logger.Feature("profile"),
+		logger.String("profile_id", profileID),
+		logger.String("variant_id", variant.ID.String()),
+	)
+
+	return &model.ProfileVariantResult{
+		Variant: toGraphQLProfileVariant(variant),
+	}, nil
+}
+
+// UpdateProfileVariant is the resolver for the updateProfileVariant field.
+func (r *mutationResolver) UpdateProfileVariant(ctx context.Context, id string, input model.UpdateProfileVariantInput) (model.ProfileVariantResponse, error) {
+	r.log.Info("Updating profile variant",
+		logger.Feature("profile"),
+		logger.String("variant_id", id),
+	)
+
+	variantID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.ProfileVariantValidationError{
+			Message: "invalid variant ID format",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	variant, err := r.profileVariantRepo.GetByID(ctx, variantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile variant: %w", err)
+	}
+	if variant == nil {
+		return &model.ProfileVariantValidationError{
+			Message: "profile variant not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" {
+			return &model.ProfileVariantValidationError{
+				Message: "name cannot be empty",
+				Field:   stringPtr("name"),
+			}, nil
+		}
+		variant.Name = name
+	}
+	if input.TargetRole != nil {
+		variant.TargetRole = input.TargetRole
+	}
+	if input.Summary != nil {
+		variant.Summary = input.Summary
+		if strings.TrimSpace(*input.Summary) == "" {
+			variant.Summary = nil
+		}
+	}
+	invalid, err := r.profileVariantRules(ctx, variant.ProfileID, input.HiddenItemIds, input.ItemOrder, input.TestimonialIds, input.ExperienceOverrides, &variant.Rules)
+	if err != nil {
+		return nil, err
+	}
+	if invalid != nil {
+		return invalid, nil
+	}
+
+	if err := r.profileVariantRepo.Update(ctx, variant); err != nil {
+		r.log.Error("Failed to update profile variant",
+			logger.Feature("profile"),
+			logger.String("variant_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to update profile variant: %w", err)
+	}
+
+	return &model.ProfileVariantResult{
+		Variant: toGraphQLProfileVariant(variant),
+	}, nil
+}
+
+// DeleteProfileVariant is the resolver for the deleteProfileVariant field.
+func (r *mutationResolver) DeleteProfileVariant(ctx context.Context, id string) (*model.DeleteResult, error) {
+	variantID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	variant, err := r.profileVariantRepo.GetByID(ctx, variantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile variant: %w", err)
+	}
+	if variant == nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	if err := r.profileVariantRepo.Delete(ctx, variantID); err != nil {
+		return nil, fmt.Errorf("failed to delete profile variant: %w", err)
+	}
+
+	return &model.DeleteResult{
+		Success:   true,
+		DeletedID: id,
+	}, nil
+}
+
+// ShareProfileVariant is the resolver for the shareProfileVariant field.
+func (r *mutationResolver) ShareProfileVariant(ctx context.Context, id string, shared bool) (model.ProfileVariantResponse, error) {
+	variantID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.ProfileVariantValidationError{
+			Message: "invalid variant ID format",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	variant, err := r.profileVariantRepo.GetByID(ctx, variantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile variant: %w", err)
+	}
+	if variant == nil {
+		return &model.ProfileVariantValidationError{
+			Message: "profile variant not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	variant.ShareToken = nil
+	if shared {
+		token, err := newShareToken()
+		if err != nil {
+			return nil, err
+		}
+		variant.ShareToken = &token
+	}
+	if err := r.profileVariantRepo.Update(ctx, variant); err != nil {
+		return nil, fmt.Errorf("failed to update profile variant: %w", err)
+	}
+
+	r.log.Info("Profile variant sharing changed",
+		logger.Feature("profile"),
+		logger.String("variant_id", id),
+		logger.Bool("shared", shared),
+	)
+
+	return &model.ProfileVariantResult{
+		Variant: toGraphQLProfileVariant(variant),
+	}, nil
+}
+
+// DraftProfileSummary is the resolver for the draftProfileSummary field.
+func (r *mutationResolver) DraftProfileSummary(ctx context.Context, profileID string) (model.WritingSuggestionsResponse, error) {
+	r.log.Info("Drafting profile summary",
+		logger.Feature("writing"),
+		logger.String("profile_id", profileID),
+	)
+
+	pid, validationErr, err := r.writingProfile(ctx, profileID)
+	if validationErr != nil || err != nil {
+		return validationErr, err
+	}
+	suggestions, err := r.writing.DraftSummary(ctx, pid)
+	return r.writingSuggestionsResponse(suggestions, err, profileID)
+}
+
+// RewriteExperienceHighlights is the resolver for the rewriteExperienceHighlights field.
+func (r *mutationResolver) RewriteExperienceHighlights(ctx context.Context, experienceID string) (model.WritingSuggestionsResponse, error) {
+	r.log.Info("Rewriting experience highlights",
+		logger.Feature("writing"),
+		logger.String("experience_id", experienceID),
+	)
+
+	expID, err := uuid.Parse(experienceID)
+	if err != nil {
+		return &model.WritingAssistantValidationError{
+			Message: "invalid experience ID format",
+			Field:   stringPtr("experienceId"),
+		}, nil
+	}
+	if r.profileWriter == nil {
+		return &model.WritingAssistantValidationError{
+			Message: "writing assistant is not available",
+			Field:   stringPtr("experienceId"),
+		}, nil
+	}
+
+	experience, err := r.profileExpRepo.GetByID(ctx, expID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile experience: %w", err)
+	}
+	if experience == nil {
+		return &model.WritingAssistantValidationError{
+			Message: "experience not found",
+			Field:   stringPtr("experienceId"),
+		}, nil
+	}
+
+	suggestions, err := r.writing.RewriteHighlights(ctx, experience)
+	return r.writingSuggestionsResponse(suggestions, err, experience.ProfileID.String())
+}
+
+// SuggestProfileHeadline is the resolver for the suggestProfileHeadline field.
+func (r *mutationResolver) SuggestProfileHeadline(ctx context.Context, profileID string) (model.WritingSuggestionsResponse, error) {
+	r.log.Info("Suggesting profile headlines",
+		logger.Feature("writing"),
+		logger.String("profile_id", profileID),
+	)
+
+	pid, validationErr, err := r.writingProfile(ctx, profileID)
+	if validationErr != nil || err != nil {
+		return validationErr, err
+	}
+	suggestions, err := r.writing.SuggestHeadlines(ctx, pid)
+	return r.writingSuggestionsResponse(suggestions, err, profileID)
+}
+
+// AcceptWritingSuggestion is the resolver for the acceptWritingSuggestion field.
+func (r *mutationResolver) AcceptWritingSuggestion(ctx context.Context, id string) (model.WritingSuggestionResponse, error) {
+	r.log.Info("Accepting writing suggestion",
+		logger.Feature("writing"),
+		logger.String("suggestion_id", id),
+	)
+	return r.settleWritingSuggestion(ctx, id, domain.WritingSuggestionAccepted)
+}
+
+// DismissWritingSuggestion is the resolver for the dismissWritingSuggestion field.
+func (r *mutationResolver) DismissWritingSuggestion(ctx context.Context, id string) (model.WritingSuggestionResponse, error) {
+	r.log.Info("Dismissing writing suggestion",
+		logger.Feature("writing"),
+		logger.String("suggestion_id", id),
+	)
+	return r.settleWritingSuggestion(ctx, id, domain.WritingSuggestionDismissed)
+}
+
+// GenerateCoverLetter is the resolver for the generateCoverLetter field.
+func (r *mutationResolver) GenerateCoverLetter(ctx context.Context, profileID string, jobDescriptionText string) (model.CoverLetterResponse, error) {
+	r.log.Info("Generating cover letter",
+		logger.Feature("cover-letter"),
+		logger.String("profile_id", profileID),
+	)
+
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return &model.CoverLetterValidationError{
+			Message: "invalid profile ID format",
+			Field:   stringPtr("profileId"),
+		}, nil
+	}
+	if strings.TrimSpace(jobDescriptionText) == "" {
+		return &model.CoverLetterValidationError{
+			Message: "job description text is required",
+			Field:   stringPtr("jobDescriptionText"),
+		}, nil
+	}
+	if r.profileWriter == nil || r.documentExtractor == nil {
+		return &model.CoverLetterValidationError{
+			Message: "cover letter writing is not available",
+			Field:   stringPtr("jobDescriptionText"),
+		}, nil
+	}
+
+	profile, err := r.profileRepo.GetByID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	if profile == nil {
+		return &model.CoverLetterValidationError{
+			Message: "profile not found",
+			Field:   stringPtr("profileId"),
+		}, nil
+	}
+
+	letter, err := r.coverLetters.Generate(ctx, profile, jobDescriptionText)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		if errors.As(err, &validationErr) {
+			return &model.CoverLetterValidationError{
+				Message: validationErr.Message,
+				Field:   stringPtr(validationErr.Field),
+			}, nil
 		}
-		if skill == nil {
-			r.log.Warning("Skill not found, skipping",
-				logger.Feature("credibility"),
-				logger.String("profile_skill_id", sv.ProfileSkillID),
-			)
-			continue
+		r.log.Error("Failed to generate cover letter",
+			logger.Feature("cover-letter"),
+			logger.String("profile_id", profileID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to generate cover letter: %w", err)
+	}
+
+	r.log.Info("Cover letter generated",
+		logger.Feature("cover-letter"),
+		logger.String("profile_id", profileID),
+		logger.String("cover_letter_id", letter.ID.String()),
+	)
+
+	return &model.CoverLetterResult{CoverLetter: toGraphQLCoverLetter(letter)}, nil
+}
+
+// EditCoverLetter is the resolver for the editCoverLetter field.
+func (r *mutationResolver) EditCoverLetter(ctx context.Context, id string, body string) (model.CoverLetterResponse, error) {
+	r.log.Info("Editing cover letter",
+		logger.Feature("cover-letter"),
+		logger.String("cover_letter_id", id),
+	)
+
+	letterID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.CoverLetterValidationError{
+			Message: "invalid cover letter ID format",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	letter, err := r.coverLetterRepo.GetByID(ctx, letterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cover letter: %w", err)
+	}
+	if letter == nil {
+		return &model.CoverLetterValidationError{
+			Message: "cover letter not found",
+			Field:   stringPtr("id"),
+		}, nil
+	}
+
+	version, err := r.coverLetters.Edit(ctx, letter, body)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		if errors.As(err, &validationErr) {
+			return &model.CoverLetterValidationError{
+				Message: validationErr.Message,
+				Field:   stringPtr(validationErr.Field),
+			}, nil
+		}
+		r.log.Error("Failed to edit cover letter",
+			logger.Feature("cover-letter"),
+			logger.String("cover_letter_id", id),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to edit cover letter: %w", err)
+	}
+
+	r.log.Info("Cover letter edited",
+		logger.Feature("cover-letter"),
+		logger.String("cover_letter_id", id),
+		logger.Int("version", version.Version),
+	)
+
+	return &model.CoverLetterResult{CoverLetter: toGraphQLCoverLetter(letter)}, nil
+}
+
+// DeleteCoverLetter is the resolver for the deleteCoverLetter field.
+func (r *mutationResolver) DeleteCoverLetter(ctx context.Context, id string) (*model.DeleteResult, error) {
+	letterID, err := uuid.Parse(id)
+	if err != nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	letter, err := r.coverLetterRepo.GetByID(ctx, letterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cover letter: %w", err)
+	}
+	if letter == nil {
+		return &model.DeleteResult{
+			Success:   false,
+			DeletedID: id,
+		}, nil
+	}
+
+	if err := r.coverLetterRepo.Delete(ctx, letterID); err != nil {
+		return nil, fmt.Errorf("failed to delete cover letter: %w", err)
+	}
+
+	return &model.DeleteResult{
+		Success:   true,
+		DeletedID: id,
+	}, nil
+}
+
+// SourceFile is the resolver for the sourceFile field.
+func (r *profileCertificationResolver) SourceFile(ctx context.Context, obj *model.ProfileCertification) (*model.File, error) {
+	certID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid certification ID: %w", err)
+	}
+
+	cert, err := r.certificationRepo.GetByID(ctx, certID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certification: %w", err)
+	}
+	if cert == nil || cert.SourceFileID == nil {
+		return nil, nil
+	}
+
+	file, err := r.fileRepo.GetByID(ctx, *cert.SourceFileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	if file == nil {
+		return nil, nil
+	}
+	user, err := r.userRepo.GetByID(ctx, file.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user for file: %w", err)
+	}
+
+	return toGraphQLFile(file, toGraphQLUser(user)), nil
+}
+
+// VerificationDocument is the resolver for the verificationDocument field.
+func (r *profileEducationResolver) VerificationDocument(ctx context.Context, obj *model.ProfileEducation) (*model.CredentialDocument, error) {
+	eduID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid education ID: %w", err)
+	}
+
+	edu, err := r.profileEduRepo.GetByID(ctx, eduID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get education: %w", err)
+	}
+	if edu == nil || edu.VerifiedByDocumentID == nil {
+		return nil, nil
+	}
+
+	return r.loadCredentialDocument(ctx, *edu.VerifiedByDocumentID)
+}
+
+// ValidationCount is the resolver for the validationCount field.
+func (r *profileEducationResolver) ValidationCount(ctx context.Context, obj *model.ProfileEducation) (int, error) {
+	eduID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid education ID: %w", err)
+	}
+
+	count, err := r.eduValidationRepo.CountByProfileEducationID(ctx, eduID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count education validations: %w", err)
+	}
+
+	return count, nil
+}
+
+// Provenance is the resolver for the provenance field.
+func (r *profileEducationResolver) Provenance(ctx context.Context, obj *model.ProfileEducation) ([]*model.FieldProvenance, error) {
+	eduID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid education ID: %w", err)
+	}
+
+	item, err := r.profileEduRepo.GetByID(ctx, eduID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile education: %w", err)
+	}
+	if item == nil {
+		return []*model.FieldProvenance{}, nil
+	}
+
+	provenance, err := service.EducationProvenance(item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get education provenance: %w", err)
+	}
+
+	return toGraphQLFieldProvenance(provenance), nil
+}
+
+// ValidationCount is the resolver for the validationCount field.
+func (r *profileExperienceResolver) ValidationCount(ctx context.Context, obj *model.ProfileExperience) (int, error) {
+	expID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid experience ID: %w", err)
+	}
+
+	count, err := r.expValidationRepo.CountByProfileExperienceID(ctx, expID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count experience validations: %w", err)
+	}
+
+	return count, nil
+}
+
+// SourceReferenceLetter is the resolver for the sourceReferenceLetter field.
+func (r *profileExperienceResolver) SourceReferenceLetter(ctx context.Context, obj *model.ProfileExperience) (*model.ReferenceLetter, error) {
+	expID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid experience ID: %w", err)
+	}
+
+	// Get the profile experience to find the source reference letter ID
+	exp, err := r.profileExpRepo.GetByID(ctx, expID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile experience: %w", err)
+	}
+	if exp == nil || exp.SourceReferenceLetterID == nil {
+		return nil, nil
+	}
+
+	// Fetch the reference letter
+	refLetter, err := r.refLetterRepo.GetByID(ctx, *exp.SourceReferenceLetterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reference letter: %w", err)
+	}
+	if refLetter == nil {
+		return nil, nil
+	}
+
+	// Get user for the reference letter
+	var gqlUser *model.User
+	if refLetter.UserID != uuid.Nil {
+		user, err := r.userRepo.GetByID(ctx, refLetter.UserID)
+		if err == nil && user != nil {
+			gqlUser = toGraphQLUser(user)
+		}
+	}
+
+	// Get file for the reference letter
+	var gqlFile *model.File
+	if refLetter.FileID != nil {
+		file, err := r.fileRepo.GetByID(ctx, *refLetter.FileID)
+		if err == nil && file != nil {
+			gqlFile = toGraphQLFile(file, gqlUser)
 		}
+	}
+
+	return toGraphQLReferenceLetter(refLetter, gqlUser, gqlFile), nil
+}
+
+// LinkedCompany is the resolver for the linkedCompany field.
+func (r *profileExperienceResolver) LinkedCompany(ctx context.Context, obj *model.ProfileExperience) (*model.Company, error) {
+	expID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid experience ID: %w", err)
+	}
+
+	exp, err := r.profileExpRepo.GetByID(ctx, expID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile experience: %w", err)
+	}
+	if exp == nil || exp.CompanyID == nil {
+		return nil, nil
+	}
+
+	company, err := r.companyRepo.GetByID(ctx, *exp.CompanyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company: %w", err)
+	}
+	return toGraphQLCompany(company), nil
+}
+
+// Provenance is the resolver for the provenance field.
+func (r *profileExperienceResolver) Provenance(ctx context.Context, obj *model.ProfileExperience) ([]*model.FieldProvenance, error) {
+	expID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid experience ID: %w", err)
+	}
 
-		// Create skill validation
-		validation := &domain.SkillValidation{
-			ID:                uuid.New(),
-			ProfileSkillID:    skillID,
-			ReferenceLetterID: refLetterID,
-			QuoteSnippet:      &sv.QuoteSnippet,
-		}
+	item, err := r.profileExpRepo.GetByID(ctx, expID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile experience: %w", err)
+	}
+	if item == nil {
+		return []*model.FieldProvenance{}, nil
+	}
 
-		if createErr := r.skillValidationRepo.Create(ctx, validation); createErr != nil {
-			// Ignore duplicate constraint errors (upsert behavior)
-			if !strings.Contains(createErr.Error(), "duplicate") && !strings.Contains(createErr.Error(), "unique constraint") {
-				r.log.Error("Failed to create skill validation",
-					logger.Feature("credibility"),
-					logger.String("profile_skill_id", sv.ProfileSkillID),
-					logger.Err(createErr),
-				)
-			}
-			continue
-		}
-		appliedCount.SkillValidations++
+	provenance, err := service.ExperienceProvenance(item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experience provenance: %w", err)
 	}
 
-	// Apply experience validations
-	for _, ev := range input.ExperienceValidations {
-		expID, parseErr := uuid.Parse(ev.ProfileExperienceID)
-		if parseErr != nil {
-			r.log.Warning("Invalid profile experience ID, skipping",
-				logger.Feature("credibility"),
-				logger.String("profile_experience_id", ev.ProfileExperienceID),
-			)
-			continue
-		}
+	return toGraphQLFieldProvenance(provenance), nil
+}
 
-		// Verify experience exists
-		exp, expErr := r.profileExpRepo.GetByID(ctx, expID)
-		if expErr != nil {
-			r.log.Error("Failed to verify experience",
-				logger.Feature("credibility"),
-				logger.String("profile_experience_id", ev.ProfileExperienceID),
-				logger.Err(expErr),
-			)
-			continue
-		}
-		if exp == nil {
-			r.log.Warning("Experience not found, skipping",
-				logger.Feature("credibility"),
-				logger.String("profile_experience_id", ev.ProfileExperienceID),
-			)
-			continue
-		}
+// Experience is the resolver for the experience field.
+func (r *profileInconsistencyResolver) Experience(ctx context.Context, obj *model.ProfileInconsistency) (*model.ProfileExperience, error) {
+	inconsistencyID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid inconsistency ID: %w", err)
+	}
 
-		// Create experience validation
-		validation := &domain.ExperienceValidation{
-			ID:                  uuid.New(),
-			ProfileExperienceID: expID,
-			ReferenceLetterID:   refLetterID,
-			QuoteSnippet:        &ev.QuoteSnippet,
-		}
+	inconsistency, err := r.inconsistencyRepo.GetByID(ctx, inconsistencyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile inconsistency: %w", err)
+	}
+	if inconsistency == nil {
+		return nil, nil
+	}
 
-		if createErr := r.expValidationRepo.Create(ctx, validation); createErr != nil {
-			// Ignore duplicate constraint errors (upsert behavior)
-			if !strings.Contains(createErr.Error(), "duplicate") && !strings.Contains(createErr.Error(), "unique constraint") {
-				r.log.Error("Failed to create experience validation",
-					logger.Feature("credibility"),
-					logger.String("profile_experience_id", ev.ProfileExperienceID),
-					logger.Err(createErr),
-				)
-			}
-			continue
+	experience, err := r.profileExpRepo.GetByID(ctx, inconsistency.ProfileExperienceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile experience: %w", err)
+	}
+	if experience == nil {
+		return nil, nil
+	}
+
+	return toGraphQLProfileExperience(experience), nil
+}
+
+// ReferenceLetter is the resolver for the referenceLetter field.
+func (r *profileInconsistencyResolver) ReferenceLetter(ctx context.Context, obj *model.ProfileInconsistency) (*model.ReferenceLetter, error) {
+	inconsistencyID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid inconsistency ID: %w", err)
+	}
+
+	inconsistency, err := r.inconsistencyRepo.GetByID(ctx, inconsistencyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile inconsistency: %w", err)
+	}
+	if inconsistency == nil {
+		return nil, nil
+	}
+
+	refLetter, err := r.refLetterRepo.GetByID(ctx, inconsistency.ReferenceLetterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reference letter: %w", err)
+	}
+	if refLetter == nil {
+		return nil, nil
+	}
+
+	// Get the user for the reference letter
+	user, err := r.userRepo.GetByID(ctx, refLetter.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	gqlUser := toGraphQLUser(user)
+
+	// Get the file if present
+	var gqlFile *model.File
+	if refLetter.FileID != nil {
+		file, err := r.fileRepo.GetByID(ctx, *refLetter.FileID)
+		if err == nil && file != nil {
+			gqlFile = toGraphQLFile(file, gqlUser)
 		}
-		appliedCount.ExperienceValidations++
 	}
 
-	// Create testimonials
-	// First, find or create the Author entity for all testimonials from this reference letter
-	var author *domain.Author
-	if len(input.Testimonials) > 0 {
-		// Try to find existing author with same name and company
-		existingAuthor, findErr := r.authorRepo.FindByNameAndCompany(ctx, profile.ID, extractedData.Author.Name, extractedData.Author.Company)
-		if findErr != nil {
-			r.log.Error("Failed to find existing author",
-				logger.Feature("credibility"),
-				logger.String("profile_id", profile.ID.String()),
-				logger.Err(findErr),
-			)
+	return toGraphQLReferenceLetter(refLetter, gqlUser, gqlFile), nil
+}
+
+// ValidationCount is the resolver for the validationCount field.
+func (r *profileSkillResolver) ValidationCount(ctx context.Context, obj *model.ProfileSkill) (int, error) {
+	skillID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid skill ID: %w", err)
+	}
+
+	count, err := r.skillValidationRepo.CountByProfileSkillID(ctx, skillID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count skill validations: %w", err)
+	}
+
+	return count, nil
+}
+
+// SourceReferenceLetter is the resolver for the sourceReferenceLetter field.
+func (r *profileSkillResolver) SourceReferenceLetter(ctx context.Context, obj *model.ProfileSkill) (*model.ReferenceLetter, error) {
+	skillID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid skill ID: %w", err)
+	}
+
+	// Get the profile skill to find the source reference letter ID
+	skill, err := r.profileSkillRepo.GetByID(ctx, skillID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile skill: %w", err)
+	}
+	if skill == nil || skill.SourceReferenceLetterID == nil {
+		return nil, nil
+	}
+
+	// Fetch the reference letter
+	refLetter, err := r.refLetterRepo.GetByID(ctx, *skill.SourceReferenceLetterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reference letter: %w", err)
+	}
+	if refLetter == nil {
+		return nil, nil
+	}
+
+	// Get user for the reference letter
+	var gqlUser *model.User
+	if refLetter.UserID != uuid.Nil {
+		user, err := r.userRepo.GetByID(ctx, refLetter.UserID)
+		if err == nil && user != nil {
+			gqlUser = toGraphQLUser(user)
 		}
+	}
 
-		if existingAuthor != nil {
-			author = existingAuthor
-		} else {
-			// Create new author
-			author = &domain.Author{
-				ProfileID: profile.ID,
-				Name:      extractedData.Author.Name,
-				Title:     extractedData.Author.Title,
-				Company:   extractedData.Author.Company,
-			}
-			if createErr := r.authorRepo.Create(ctx, author); createErr != nil {
-				r.log.Error("Failed to create author",
-					logger.Feature("credibility"),
-					logger.String("profile_id", profile.ID.String()),
-					logger.Err(createErr),
-				)
-			}
+	// Get file for the reference letter
+	var gqlFile *model.File
+	if refLetter.FileID != nil {
+		file, err := r.fileRepo.GetByID(ctx, *refLetter.FileID)
+		if err == nil && file != nil {
+			gqlFile = toGraphQLFile(file, gqlUser)
 		}
 	}
 
-	for _, ti := range input.Testimonials {
-		// Map author relationship from extracted data to testimonial relationship
-		relationship := mapAuthorToTestimonialRelationship(extractedData.Author.Relationship)
+	return toGraphQLReferenceLetter(refLetter, gqlUser, gqlFile), nil
+}
+
+// CanonicalSkill is the resolver for the canonicalSkill field.
+func (r *profileSkillResolver) CanonicalSkill(ctx context.Context, obj *model.ProfileSkill) (*model.CanonicalSkill, error) {
+	skillID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid skill ID: %w", err)
+	}
+
+	skill, err := r.profileSkillRepo.GetByID(ctx, skillID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile skill: %w", err)
+	}
+	if skill == nil || skill.CanonicalSkillID == nil {
+		return nil, nil
+	}
+
+	tax, err := r.skillTaxonomy(ctx, skill.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphQLCanonicalSkill(tax, tax.Get(*skill.CanonicalSkillID)), nil
+}
+
+// Provenance is the resolver for the provenance field.
+func (r *profileSkillResolver) Provenance(ctx context.Context, obj *model.ProfileSkill) ([]*model.FieldProvenance, error) {
+	skillID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid skill ID: %w", err)
+	}
+
+	item, err := r.profileSkillRepo.GetByID(ctx, skillID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile skill: %w", err)
+	}
+	if item == nil {
+		return []*model.FieldProvenance{}, nil
+	}
+
+	provenance, err := service.SkillProvenance(item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skill provenance: %w", err)
+	}
+
+	return toGraphQLFieldProvenance(provenance), nil
+}
+
+// Profile is the resolver for the profile field.
+func (r *profileVariantResolver) Profile(ctx context.Context, obj *model.ProfileVariant) (*model.Profile, error) {
+	variant, err := r.loadVariant(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := r.loadProfileVariant(ctx, variant)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		return nil, fmt.Errorf("profile not found")
+	}
+	return profile, nil
+}
+
+// Testimonials is the resolver for the testimonials field.
+func (r *profileVariantResolver) Testimonials(ctx context.Context, obj *model.ProfileVariant) ([]*model.Testimonial, error) {
+	variant, err := r.loadVariant(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	return r.variantTestimonials(ctx, variant)
+}
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, id string) (*model.User, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	user, err := r.userRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
 
-		testimonial := &domain.Testimonial{
-			ID:                uuid.New(),
-			ProfileID:         profile.ID,
-			ReferenceLetterID: refLetterID,
-			Quote:             ti.Quote,
-			Relationship:      relationship,
-			SkillsMentioned:   ti.SkillsMentioned,
-		}
+	return toGraphQLUser(user), nil
+}
 
-		// Link to author if created successfully
-		if author != nil {
-			testimonial.AuthorID = &author.ID
-		}
+// File is the resolver for the file field.
+func (r *queryResolver) File(ctx context.Context, id string) (*model.File, error) {
+	fid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file ID: %w", err)
+	}
 
-		// Also populate legacy fields for backward compatibility
-		testimonial.AuthorName = &extractedData.Author.Name
-		testimonial.AuthorTitle = extractedData.Author.Title
-		testimonial.AuthorCompany = extractedData.Author.Company
+	file, err := r.fileRepo.GetByID(ctx, fid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
 
-		if createErr := r.testimonialRepo.Create(ctx, testimonial); createErr != nil {
-			r.log.Error("Failed to create testimonial",
-				logger.Feature("credibility"),
-				logger.String("profile_id", profile.ID.String()),
-				logger.Err(createErr),
-			)
-			continue
-		}
-		appliedCount.Testimonials++
+	if file == nil {
+		return nil, nil
 	}
 
-	// Create new skills discovered in the reference letter
-	for _, ns := range input.NewSkills {
-		// Get next display order
-		displayOrder, orderErr := r.profileSkillRepo.GetNextDisplayOrder(ctx, profile.ID)
-		if orderErr != nil {
-			r.log.Error("Failed to get next display order for skill",
-				logger.Feature("credibility"),
-				logger.String("profile_id", profile.ID.String()),
-				logger.Err(orderErr),
-			)
-			continue
-		}
+	// Fetch the user relation
+	user, err := r.userRepo.GetByID(ctx, file.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user for file: %w", err)
+	}
 
-		skill := &domain.ProfileSkill{
-			ID:                      uuid.New(),
-			ProfileID:               profile.ID,
-			Name:                    ns.Name,
-			NormalizedName:          normalizeSkillName(ns.Name),
-			Category:                strings.ToUpper(string(ns.Category)),
-			DisplayOrder:            displayOrder,
-			Source:                  domain.ExperienceSourceLetterDiscovered,
-			SourceReferenceLetterID: &refLetterID,
-		}
+	return toGraphQLFile(file, toGraphQLUser(user)), nil
+}
 
-		if createErr := r.profileSkillRepo.Create(ctx, skill); createErr != nil {
-			// Ignore duplicate skills (same normalized name)
-			if !strings.Contains(createErr.Error(), "duplicate") && !strings.Contains(createErr.Error(), "unique constraint") {
-				r.log.Error("Failed to create new skill",
-					logger.Feature("credibility"),
-					logger.String("skill_name", ns.Name),
-					logger.Err(createErr),
-				)
-			}
-			continue
-		}
+// Files is the resolver for the files field.
+func (r *queryResolver) Files(ctx context.Context, userID string) ([]*model.File, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
 
-		// If quoteContext provided, also create a skill validation for it
-		if ns.QuoteContext != nil && *ns.QuoteContext != "" {
-			validation := &domain.SkillValidation{
-				ID:                uuid.New(),
-				ProfileSkillID:    skill.ID,
-				ReferenceLetterID: refLetterID,
-				QuoteSnippet:      ns.QuoteContext,
-			}
-			if valErr := r.skillValidationRepo.Create(ctx, validation); valErr != nil {
-				r.log.Warning("Failed to create validation for new skill",
-					logger.Feature("credibility"),
-					logger.String("skill_id", skill.ID.String()),
-					logger.Err(valErr),
-				)
-			}
-		}
-		appliedCount.NewSkills++
+	files, err := r.fileRepo.GetByUserID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get files: %w", err)
 	}
 
-	// Update reference letter status to applied
-	refLetter.Status = domain.ReferenceLetterStatusApplied
-	if updateErr := r.refLetterRepo.Update(ctx, refLetter); updateErr != nil {
-		r.log.Error("Failed to update reference letter status",
-			logger.Feature("credibility"),
-			logger.String("reference_letter_id", input.ReferenceLetterID),
-			logger.Err(updateErr),
-		)
-		// Continue anyway - validations were applied successfully
+	// Fetch the user once for all files
+	user, err := r.userRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user for files: %w", err)
 	}
+	gqlUser := toGraphQLUser(user)
 
-	r.log.Info("Applied reference letter validations",
-		logger.Feature("credibility"),
-		logger.String("user_id", userID),
-		logger.String("reference_letter_id", input.ReferenceLetterID),
-		logger.Int("skill_validations", appliedCount.SkillValidations),
-		logger.Int("experience_validations", appliedCount.ExperienceValidations),
-		logger.Int("testimonials", appliedCount.Testimonials),
-		logger.Int("new_skills", appliedCount.NewSkills),
-	)
+	result := make([]*model.File, len(files))
+	for i, f := range files {
+		result[i] = toGraphQLFile(f, gqlUser)
+	}
 
-	// Fetch file for reference letter (may be nil)
-	var file *model.File
-	if refLetter.FileID != nil {
-		fileEntity, fileErr := r.fileRepo.GetByID(ctx, *refLetter.FileID)
-		if fileErr == nil && fileEntity != nil {
-			file = toGraphQLFile(fileEntity, toGraphQLUser(user))
-		}
+	return result, nil
+}
+
+// ReferenceLetter is the resolver for the referenceLetter field.
+func (r *queryResolver) ReferenceLetter(ctx context.Context, id string) (*model.ReferenceLetter, error) {
+	lid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reference letter ID: %w", err)
 	}
 
-	// Fetch profile relations for response
-	experiences, _ := r.profileExpRepo.GetByProfileID(ctx, profile.ID)
-	educations, _ := r.profileEduRepo.GetByProfileID(ctx, profile.ID)
-	skills, _ := r.profileSkillRepo.GetByProfileID(ctx, profile.ID)
+	letter, err := r.refLetterRepo.GetByID(ctx, lid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reference letter: %w", err)
+	}
 
-	// Get photo URL if present
-	var photoURL *string
-	if profile.ProfilePhotoFileID != nil {
-		photoFile, fileErr := r.fileRepo.GetByID(ctx, *profile.ProfilePhotoFileID)
-		if fileErr == nil && photoFile != nil {
-			url, urlErr := r.storage.GetPublicURL(ctx, photoFile.StorageKey, 24*time.Hour)
-			if urlErr == nil {
-				photoURL = &url
-			}
+	if letter == nil {
+		return nil, nil
+	}
+
+	// Fetch the user relation
+	user, err := r.userRepo.GetByID(ctx, letter.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user for reference letter: %w", err)
+	}
+
+	// Fetch the file relation if present
+	var gqlFile *model.File
+	if letter.FileID != nil {
+		file, err := r.fileRepo.GetByID(ctx, *letter.FileID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get file for reference letter: %w", err)
 		}
+		gqlFile = toGraphQLFile(file, toGraphQLUser(user))
 	}
 
-	return &model.ApplyValidationsResult{
-		ReferenceLetter: toGraphQLReferenceLetter(refLetter, toGraphQLUser(user), file),
-		Profile:         toGraphQLProfile(profile, toGraphQLUser(user), toGraphQLProfileExperiences(experiences), toGraphQLProfileEducations(educations), toGraphQLProfileSkills(skills), photoURL),
-		AppliedCount:    appliedCount,
-	}, nil
+	return toGraphQLReferenceLetter(letter, toGraphQLUser(user), gqlFile), nil
 }
 
-// UploadAuthorImage is the resolver for the uploadAuthorImage field.
-func (r *mutationResolver) UploadAuthorImage(ctx context.Context, authorID string, file graphql.Upload) (model.UploadAuthorImageResponse, error) {
-	r.log.Info("Author image upload started",
-		logger.Feature("profile"),
-		logger.String("author_id", authorID),
-		logger.String("filename", file.Filename),
-		logger.String("content_type", file.ContentType),
-		logger.Int64("size_bytes", file.Size),
-	)
+// ReferenceLetters is the resolver for the referenceLetters field.
+func (r *queryResolver) ReferenceLetters(ctx context.Context, userID string) ([]*model.ReferenceLetter, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
 
-	// Parse and validate author ID
-	aid, err := uuid.Parse(authorID)
+	letters, err := r.refLetterRepo.GetByUserID(ctx, uid)
 	if err != nil {
-		r.log.Warning("Invalid author ID format",
-			logger.Feature("profile"),
-			logger.String("author_id", authorID),
-		)
-		return &model.FileValidationError{
-			Message: "invalid author ID format",
-			Field:   "authorId",
-		}, nil
+		return nil, fmt.Errorf("failed to get reference letters: %w", err)
+	}
+
+	// Fetch the user once for all letters
+	user, err := r.userRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user for reference letters: %w", err)
+	}
+	gqlUser := toGraphQLUser(user)
+
+	// Collect all unique file IDs to batch fetch files
+	fileIDs := make(map[uuid.UUID]bool)
+	for _, l := range letters {
+		if l.FileID != nil {
+			fileIDs[*l.FileID] = true
+		}
+	}
+
+	// Fetch all files and create a lookup map
+	fileMap := make(map[uuid.UUID]*model.File)
+	for fid := range fileIDs {
+		file, err := r.fileRepo.GetByID(ctx, fid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get file for reference letter: %w", err)
+		}
+		if file != nil {
+			fileMap[fid] = toGraphQLFile(file, gqlUser)
+		}
+	}
+
+	result := make([]*model.ReferenceLetter, len(letters))
+	for i, l := range letters {
+		var gqlFile *model.File
+		if l.FileID != nil {
+			gqlFile = fileMap[*l.FileID]
+		}
+		result[i] = toGraphQLReferenceLetter(l, gqlUser, gqlFile)
 	}
 
-	// Get existing author to verify it exists
-	author, err := r.authorRepo.GetByID(ctx, aid)
+	return result, nil
+}
+
+// CredentialDocument is the resolver for the credentialDocument field.
+func (r *queryResolver) CredentialDocument(ctx context.Context, id string) (*model.CredentialDocument, error) {
+	docID, err := uuid.Parse(id)
 	if err != nil {
-		r.log.Error("Failed to get author",
-			logger.Feature("profile"),
-			logger.String("author_id", authorID),
-			logger.Err(err),
-		)
-		return nil, fmt.Errorf("failed to get author: %w", err)
-	}
-	if author == nil {
-		r.log.Warning("Author not found",
-			logger.Feature("profile"),
-			logger.String("author_id", authorID),
-		)
-		return &model.FileValidationError{
-			Message: "author not found",
-			Field:   "authorId",
-		}, nil
+		return nil, fmt.Errorf("invalid credential document ID: %w", err)
 	}
 
-	// Validate file type (images only)
-	allowedTypes := map[string]bool{
-		"image/jpeg": true,
-		"image/png":  true,
-		"image/gif":  true,
-		"image/webp": true,
+	return r.loadCredentialDocument(ctx, docID)
+}
+
+// CredentialDocuments is the resolver for the credentialDocuments field.
+func (r *queryResolver) CredentialDocuments(ctx context.Context, userID string) ([]*model.CredentialDocument, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
-	if !allowedTypes[file.ContentType] {
-		r.log.Warning("File type not allowed for author image",
-			logger.Feature("profile"),
-			logger.String("author_id", authorID),
-			logger.String("content_type", file.ContentType),
-		)
-		return &model.FileValidationError{
-			Message: "file type not allowed: must be JPEG, PNG, GIF, or WebP",
-			Field:   "contentType",
-		}, nil
+	docs, err := r.credentialDocRepo.GetByUserID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credential documents: %w", err)
 	}
 
-	// Validate file size (max 5MB for photos)
-	const maxSize = 5 * 1024 * 1024
-	if file.Size > maxSize {
-		r.log.Warning("File too large for author image",
-			logger.Feature("profile"),
-			logger.String("author_id", authorID),
-			logger.Int64("size_bytes", file.Size),
-			logger.Int("max_bytes", maxSize),
-		)
-		return &model.FileValidationError{
-			Message: "file too large: maximum size is 5MB",
-			Field:   "size",
-		}, nil
+	// Fetch the user once for all documents
+	user, err := r.userRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user for credential documents: %w", err)
 	}
+	gqlUser := toGraphQLUser(user)
 
-	// Delete old image file if exists
-	if author.ImageID != nil {
-		oldFile, err := r.fileRepo.GetByID(ctx, *author.ImageID)
-		if err == nil && oldFile != nil {
-			// Delete from storage (best effort)
-			_ = r.storage.Delete(ctx, oldFile.StorageKey)
-			// Delete file record (best effort)
-			_ = r.fileRepo.Delete(ctx, *author.ImageID)
+	// Documents extracted from the same upload share a file, so fetch each file once
+	fileMap := make(map[uuid.UUID]*model.File)
+	result := make([]*model.CredentialDocument, len(docs))
+	for i, d := range docs {
+		gqlFile, ok := fileMap[d.FileID]
+		if !ok {
+			file, fileErr := r.fileRepo.GetByID(ctx, d.FileID)
+			if fileErr != nil {
+				return nil, fmt.Errorf("failed to get file for credential document: %w", fileErr)
+			}
+			gqlFile = toGraphQLFile(file, gqlUser)
+			fileMap[d.FileID] = gqlFile
 		}
+		result[i] = toGraphQLCredentialDocument(d, gqlUser, gqlFile)
 	}
 
-	// Generate storage key - use profile ID for organization
-	fileID := uuid.New()
-	storageKey := fmt.Sprintf("author-images/%s/%s/%s", author.ProfileID.String(), fileID.String(), file.Filename)
+	return result, nil
+}
 
-	// Upload to storage
-	_, err = r.storage.Upload(ctx, storageKey, file.File, file.Size, file.ContentType)
+// Resume is the resolver for the resume field.
+func (r *queryResolver) Resume(ctx context.Context, id string) (*model.Resume, error) {
+	rid, err := uuid.Parse(id)
 	if err != nil {
-		r.log.Error("Failed to upload author image to storage",
-			logger.Feature("profile"),
-			logger.String("author_id", authorID),
-			logger.String("storage_key", storageKey),
-			logger.Err(err),
-		)
-		return nil, fmt.Errorf("failed to upload author image to storage: %w", err)
+		return nil, fmt.Errorf("invalid resume ID: %w", err)
 	}
 
-	// Get the profile's user ID for file record
-	profile, err := r.profileRepo.GetByID(ctx, author.ProfileID)
+	resume, err := r.resumeRepo.GetByID(ctx, rid)
 	if err != nil {
-		r.log.Error("Failed to get profile for author",
-			logger.Feature("profile"),
-			logger.String("author_id", authorID),
-			logger.String("profile_id", author.ProfileID.String()),
-			logger.Err(err),
-		)
-		// Clean up uploaded file
-		_ = r.storage.Delete(ctx, storageKey)
-		return nil, fmt.Errorf("failed to get profile: %w", err)
-	}
-
-	// Create file record
-	domainFile := &domain.File{
-		ID:          fileID,
-		UserID:      profile.UserID,
-		Filename:    file.Filename,
-		ContentType: file.ContentType,
-		SizeBytes:   file.Size,
-		StorageKey:  storageKey,
-	}
-
-	if err := r.fileRepo.Create(ctx, domainFile); err != nil {
-		r.log.Error("Failed to create file record",
-			logger.Feature("profile"),
-			logger.String("author_id", authorID),
-			logger.String("file_id", fileID.String()),
-			logger.Err(err),
-		)
-		// Attempt to clean up uploaded file
-		_ = r.storage.Delete(ctx, storageKey)
-		return nil, fmt.Errorf("failed to create file record: %w", err)
+		return nil, fmt.Errorf("failed to get resume: %w", err)
 	}
 
-	// Update author with new image ID
-	author.ImageID = &fileID
-	if err := r.authorRepo.Update(ctx, author); err != nil {
-		r.log.Error("Failed to update author with image",
-			logger.Feature("profile"),
-			logger.String("author_id", authorID),
-			logger.Err(err),
-		)
-		return nil, fmt.Errorf("failed to update author: %w", err)
+	if resume == nil {
+		return nil, nil
 	}
 
-	// Generate presigned URL for the response
-	imageURL, err := r.storage.GetPresignedURL(ctx, storageKey, time.Hour)
+	// Fetch the user relation
+	user, err := r.userRepo.GetByID(ctx, resume.UserID)
 	if err != nil {
-		r.log.Warning("Failed to generate presigned URL for author image",
-			logger.Feature("profile"),
-			logger.String("author_id", authorID),
-			logger.Err(err),
-		)
-		// Continue without URL - not a fatal error
+		return nil, fmt.Errorf("failed to get user for resume: %w", err)
 	}
 
-	// Get user for file response
-	user, err := r.userRepo.GetByID(ctx, profile.UserID)
+	// Fetch the file relation
+	file, err := r.fileRepo.GetByID(ctx, resume.FileID)
 	if err != nil {
-		r.log.Warning("Failed to get user for file response",
-			logger.Feature("profile"),
-			logger.String("user_id", profile.UserID.String()),
-			logger.Err(err),
-		)
+		return nil, fmt.Errorf("failed to get file for resume: %w", err)
 	}
 
-	r.log.Info("Author image uploaded successfully",
-		logger.Feature("profile"),
-		logger.String("author_id", authorID),
-		logger.String("file_id", fileID.String()),
-	)
+	gqlUser := toGraphQLUser(user)
+	gqlFile := toGraphQLFile(file, gqlUser)
 
-	return &model.UploadAuthorImageResult{
-		File:   toGraphQLFile(domainFile, toGraphQLUser(user)),
-		Author: toGraphQLAuthorWithImage(author, &imageURL),
-	}, nil
+	return toGraphQLResume(resume, gqlUser, gqlFile), nil
 }
 
-// UpdateAuthor is the resolver for the updateAuthor field.
-func (r *mutationResolver) UpdateAuthor(ctx context.Context, id string, input model.UpdateAuthorInput) (*model.Author, error) {
-	authorID, err := uuid.Parse(id)
+// Resumes is the resolver for the resumes field.
+func (r *queryResolver) Resumes(ctx context.Context, userID string) ([]*model.Resume, error) {
+	uid, err := uuid.Parse(userID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid author ID: %w", err)
+		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
-	// Get existing author
-	author, err := r.authorRepo.GetByID(ctx, authorID)
+	resumes, err := r.resumeRepo.GetByUserID(ctx, uid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get author: %w", err)
-	}
-	if author == nil {
-		return nil, fmt.Errorf("author not found")
+		return nil, fmt.Errorf("failed to get resumes: %w", err)
 	}
 
-	// Apply updates
-	if input.Name != nil {
-		author.Name = *input.Name
-	}
-	if input.Title != nil {
-		if *input.Title == "" {
-			author.Title = nil
-		} else {
-			author.Title = input.Title
-		}
-	}
-	if input.Company != nil {
-		if *input.Company == "" {
-			author.Company = nil
-		} else {
-			author.Company = input.Company
-		}
-	}
-	if input.LinkedInURL != nil {
-		if *input.LinkedInURL == "" {
-			author.LinkedInURL = nil
-		} else {
-			author.LinkedInURL = input.LinkedInURL
-		}
-	}
-	if input.ImageID != nil {
-		if *input.ImageID == "" {
-			// Clear the image
-			author.ImageID = nil
-		} else {
-			imageUUID, err := uuid.Parse(*input.ImageID)
-			if err != nil {
-				return nil, fmt.Errorf("invalid image ID: %w", err)
-			}
-			author.ImageID = &imageUUID
-		}
+	// Fetch the user once for all resumes
+	user, err := r.userRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user for resumes: %w", err)
 	}
+	gqlUser := toGraphQLUser(user)
 
-	// Save updates
-	if err := r.authorRepo.Update(ctx, author); err != nil {
-		return nil, fmt.Errorf("failed to update author: %w", err)
+	// Collect all unique file IDs to batch fetch files
+	fileIDs := make(map[uuid.UUID]bool)
+	for _, res := range resumes {
+		fileIDs[res.FileID] = true
 	}
 
-	// Get image URL if author has an image
-	var imageURL *string
-	if author.ImageID != nil {
-		file, err := r.fileRepo.GetByID(ctx, *author.ImageID)
-		if err == nil && file != nil {
-			url, err := r.storage.GetPresignedURL(ctx, file.StorageKey, time.Hour)
-			if err == nil {
-				imageURL = &url
-			}
+	// Fetch all files and create a lookup map
+	fileMap := make(map[uuid.UUID]*model.File)
+	for fid := range fileIDs {
+		file, err := r.fileRepo.GetByID(ctx, fid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get file for resume: %w", err)
+		}
+		if file != nil {
+			fileMap[fid] = toGraphQLFile(file, gqlUser)
 		}
 	}
 
-	return toGraphQLAuthorWithImage(author, imageURL), nil
-}
+	result := make([]*model.Resume, len(resumes))
+	for i, res := range resumes {
+		gqlFile := fileMap[res.FileID]
+		result[i] = toGraphQLResume(res, gqlUser, gqlFile)
+	}
 
-// DeleteTestimonial is the resolver for the deleteTestimonial field.
-func (r *mutationResolver) DeleteTestimonial(ctx context.Context, id string) (*model.DeleteResult, error) {
-	r.log.Info("Deleting testimonial",
-		logger.Feature("profile"),
-		logger.String("testimonial_id", id),
-	)
+	return result, nil
+}
 
-	// Parse and validate testimonial ID
-	testimonialID, err := uuid.Parse(id)
+// Profile is the resolver for the profile field.
+func (r *queryResolver) Profile(ctx context.Context, id string) (*model.Profile, error) {
+	pid, err := uuid.Parse(id)
 	if err != nil {
-		r.log.Warning("Invalid testimonial ID format",
-			logger.Feature("profile"),
-			logger.String("testimonial_id", id),
-		)
-		return &model.DeleteResult{
-			Success:   false,
-			DeletedID: id,
-		}, nil
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
 
-	// Check if testimonial exists
-	testimonial, err := r.testimonialRepo.GetByID(ctx, testimonialID)
+	profile, err := r.profileRepo.GetByID(ctx, pid)
 	if err != nil {
-		r.log.Error("Failed to get testimonial",
-			logger.Feature("profile"),
-			logger.String("testimonial_id", id),
-			logger.Err(err),
-		)
-		return nil, fmt.Errorf("failed to get testimonial: %w", err)
-	}
-	if testimonial == nil {
-		r.log.Warning("Testimonial not found",
-			logger.Feature("profile"),
-			logger.String("testimonial_id", id),
-		)
-		return &model.DeleteResult{
-			Success:   false,
-			DeletedID: id,
-		}, nil
+		return nil, fmt.Errorf("failed to get profile: %w", err)
 	}
-
-	// Delete testimonial
-	if err := r.testimonialRepo.Delete(ctx, testimonialID); err != nil {
-		r.log.Error("Failed to delete testimonial",
-			logger.Feature("profile"),
-			logger.String("testimonial_id", id),
-			logger.Err(err),
-		)
-		return nil, fmt.Errorf("failed to delete testimonial: %w", err)
+	if profile == nil {
+		return nil, nil
 	}
 
-	r.log.Info("Testimonial deleted",
-		logger.Feature("profile"),
-		logger.String("testimonial_id", id),
-	)
-
-	return &model.DeleteResult{
-		Success:   true,
-		DeletedID: id,
-	}, nil
+	return r.loadProfileData(ctx, profile)
 }
 
-// ValidationCount is the resolver for the validationCount field.
-func (r *profileExperienceResolver) ValidationCount(ctx context.Context, obj *model.ProfileExperience) (int, error) {
-	expID, err := uuid.Parse(obj.ID)
+// ProfileByUserID is the resolver for the profileByUserId field.
+func (r *queryResolver) ProfileByUserID(ctx context.Context, userID string) (*model.Profile, error) {
+	uid, err := uuid.Parse(userID)
 	if err != nil {
-		return 0, fmt.Errorf("invalid experience ID: %w", err)
+		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
-	count, err := r.expValidationRepo.CountByProfileExperienceID(ctx, expID)
+	profile, err := r.profileRepo.GetByUserID(ctx, uid)
 	if err != nil {
-		return 0, fmt.Errorf("failed to count experience validations: %w", err)
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	if profile == nil {
+		return nil, nil
 	}
 
-	return count, nil
+	return r.loadProfileData(ctx, profile)
 }
 
-// ValidationCount is the resolver for the validationCount field.
-func (r *profileSkillResolver) ValidationCount(ctx context.Context, obj *model.ProfileSkill) (int, error) {
-	skillID, err := uuid.Parse(obj.ID)
+// ProfileExperience is the resolver for the profileExperience field.
+func (r *queryResolver) ProfileExperience(ctx context.Context, id string) (*model.ProfileExperience, error) {
+	expID, err := uuid.Parse(id)
 	if err != nil {
-		return 0, fmt.Errorf("invalid skill ID: %w", err)
+		return nil, fmt.Errorf("invalid experience ID: %w", err)
 	}
 
-	count, err := r.skillValidationRepo.CountByProfileSkillID(ctx, skillID)
+	experience, err := r.profileExpRepo.GetByID(ctx, expID)
 	if err != nil {
-		return 0, fmt.Errorf("failed to count skill validations: %w", err)
+		return nil, fmt.Errorf("failed to get experience: %w", err)
+	}
+	if experience == nil {
+		return nil, nil
 	}
 
-	return count, nil
+	return toGraphQLProfileExperience(experience), nil
 }
 
-// SourceReferenceLetter is the resolver for the sourceReferenceLetter field.
-func (r *profileSkillResolver) SourceReferenceLetter(ctx context.Context, obj *model.ProfileSkill) (*model.ReferenceLetter, error) {
-	skillID, err := uuid.Parse(obj.ID)
+// ProfileEducation is the resolver for the profileEducation field.
+func (r *queryResolver) ProfileEducation(ctx context.Context, id string) (*model.ProfileEducation, error) {
+	eduID, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid skill ID: %w", err)
+		return nil, fmt.Errorf("invalid education ID: %w", err)
 	}
 
-	// Get the profile skill to find the source reference letter ID
-	skill, err := r.profileSkillRepo.GetByID(ctx, skillID)
+	education, err := r.profileEduRepo.GetByID(ctx, eduID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get profile skill: %w", err)
+		return nil, fmt.Errorf("failed to get education: %w", err)
 	}
-	if skill == nil || skill.SourceReferenceLetterID == nil {
+	if education == nil {
 		return nil, nil
 	}
 
-	// Fetch the reference letter
-	refLetter, err := r.refLetterRepo.GetByID(ctx, *skill.SourceReferenceLetterID)
+	return toGraphQLProfileEducation(education), nil
+}
+
+// ExpiringCertifications is the resolver for the expiringCertifications field.
+func (r *queryResolver) ExpiringCertifications(ctx context.Context, profileID string) ([]*model.ProfileCertification, error) {
+	pid, err := uuid.Parse(profileID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get reference letter: %w", err)
-	}
-	if refLetter == nil {
-		return nil, nil
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
 
-	// Get user for the reference letter
-	var gqlUser *model.User
-	if refLetter.UserID != uuid.Nil {
-		user, err := r.userRepo.GetByID(ctx, refLetter.UserID)
-		if err == nil && user != nil {
-			gqlUser = toGraphQLUser(user)
-		}
+	certifications, err := r.certificationRepo.GetByProfileID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certifications: %w", err)
 	}
 
-	// Get file for the reference letter
-	var gqlFile *model.File
-	if refLetter.FileID != nil {
-		file, err := r.fileRepo.GetByID(ctx, *refLetter.FileID)
-		if err == nil && file != nil {
-			gqlFile = toGraphQLFile(file, gqlUser)
+	// Checked here rather than by the flag, which the periodic check only sets once a day
+	now := time.Now()
+	var expiring []*domain.ProfileCertification
+	for _, cert := range certifications {
+		if service.CertificationExpiresWithin(cert, now, r.certExpiryWindow) {
+			expiring = append(expiring, cert)
 		}
 	}
+	sort.SliceStable(expiring, func(i, j int) bool {
+		a, _ := expiring[i].Expiry.LastMonth()
+		b, _ := expiring[j].Expiry.LastMonth()
+		return a < b
+	})
 
-	return toGraphQLReferenceLetter(refLetter, gqlUser, gqlFile), nil
+	return toGraphQLProfileCertifications(expiring), nil
 }
 
-// User is the resolver for the user field.
-func (r *queryResolver) User(ctx context.Context, id string) (*model.User, error) {
-	uid, err := uuid.Parse(id)
+// ProfileSkill is the resolver for the profileSkill field.
+func (r *queryResolver) ProfileSkill(ctx context.Context, id string) (*model.ProfileSkill, error) {
+	skillID, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid user ID: %w", err)
+		return nil, fmt.Errorf("invalid skill ID: %w", err)
 	}
 
-	user, err := r.userRepo.GetByID(ctx, uid)
+	skill, err := r.profileSkillRepo.GetByID(ctx, skillID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user: %w", err)
+		return nil, fmt.Errorf("failed to get skill: %w", err)
+	}
+	if skill == nil {
+		return nil, nil
 	}
 
-	return toGraphQLUser(user), nil
+	return toGraphQLProfileSkill(skill), nil
 }
 
-// File is the resolver for the file field.
-func (r *queryResolver) File(ctx context.Context, id string) (*model.File, error) {
-	fid, err := uuid.Parse(id)
+// Testimonials is the resolver for the testimonials field.
+func (r *queryResolver) Testimonials(ctx context.Context, profileID string) ([]*model.Testimonial, error) {
+	pid, err := uuid.Parse(profileID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid file ID: %w", err)
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
 
-	file, err := r.fileRepo.GetByID(ctx, fid)
+	testimonials, err := r.testimonialRepo.GetByProfileID(ctx, pid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get file: %w", err)
+		return nil, fmt.Errorf("failed to get testimonials: %w", err)
 	}
 
-	if file == nil {
-		return nil, nil
+	// Collect unique reference letter IDs and author IDs
+	refLetterIDs := make(map[uuid.UUID]struct{})
+	authorIDs := make(map[uuid.UUID]struct{})
+	for _, t := range testimonials {
+		refLetterIDs[t.ReferenceLetterID] = struct{}{}
+		if t.AuthorID != nil {
+			authorIDs[*t.AuthorID] = struct{}{}
+		}
 	}
 
-	// Fetch the user relation
-	user, err := r.userRepo.GetByID(ctx, file.UserID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get user for file: %w", err)
+	// Load authors and build map
+	authorsMap := make(map[uuid.UUID]*domain.Author)
+	for authorID := range authorIDs {
+		author, err := r.authorRepo.GetByID(ctx, authorID)
+		if err != nil {
+			continue // Skip on error
+		}
+		if author != nil {
+			authorsMap[authorID] = author
+		}
 	}
 
-	return toGraphQLFile(file, toGraphQLUser(user)), nil
-}
+	// Attach authors to testimonials
+	for _, t := range testimonials {
+		if t.AuthorID != nil {
+			if author, ok := authorsMap[*t.AuthorID]; ok {
+				t.Author = author
+			}
+		}
+	}
 
-// Files is the resolver for the files field.
-func (r *queryResolver) Files(ctx context.Context, userID string) ([]*model.File, error) {
-	uid, err := uuid.Parse(userID)
-	if err != nil {
-		return nil, fmt.Errorf("invalid user ID: %w", err)
+	// Build map of reference letter ID -> validated skills
+	validatedSkillsByRefLetter := make(map[string][]*model.ProfileSkill)
+	for refLetterID := range refLetterIDs {
+		// Get skill validations for this reference letter
+		skillValidations, err := r.skillValidationRepo.GetByReferenceLetterID(ctx, refLetterID)
+		if err != nil {
+			// Log but don't fail - validated skills are supplementary
+			continue
+		}
+
+		// Get the profile skills for each validation
+		var skills []*model.ProfileSkill
+		for _, sv := range skillValidations {
+			profileSkill, err := r.profileSkillRepo.GetByID(ctx, sv.ProfileSkillID)
+			if err != nil {
+				continue // Skip if skill was deleted
+			}
+			skills = append(skills, toGraphQLProfileSkill(profileSkill))
+		}
+
+		validatedSkillsByRefLetter[refLetterID.String()] = skills
 	}
 
-	files, err := r.fileRepo.GetByUserID(ctx, uid)
+	return toGraphQLTestimonials(testimonials, validatedSkillsByRefLetter), nil
+}
+
+// Author is the resolver for the author field.
+func (r *queryResolver) Author(ctx context.Context, id string) (*model.Author, error) {
+	authorID, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get files: %w", err)
+		return nil, fmt.Errorf("invalid author ID: %w", err)
 	}
 
-	// Fetch the user once for all files
-	user, err := r.userRepo.GetByID(ctx, uid)
+	author, err := r.authorRepo.GetByID(ctx, authorID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user for files: %w", err)
+		return nil, fmt.Errorf("failed to get author: %w", err)
+	}
+	if author == nil {
+		return nil, nil
 	}
-	gqlUser := toGraphQLUser(user)
 
-	result := make([]*model.File, len(files))
-	for i, f := range files {
-		result[i] = toGraphQLFile(f, gqlUser)
+	// Get image URL if author has an image
+	var imageURL *string
+	if author.ImageID != nil {
+		file, err := r.fileRepo.GetByID(ctx, *author.ImageID)
+		if err == nil && file != nil {
+			url, err := r.storage.GetPresignedURL(ctx, file.StorageKey, time.Hour)
+			if err == nil {
+				imageURL = &url
+			}
+		}
 	}
 
-	return result, nil
+	return toGraphQLAuthorWithImage(author, imageURL), nil
 }
 
-// ReferenceLetter is the resolver for the referenceLetter field.
-func (r *queryResolver) ReferenceLetter(ctx context.Context, id string) (*model.ReferenceLetter, error) {
-	lid, err := uuid.Parse(id)
+// Authors is the resolver for the authors field.
+func (r *queryResolver) Authors(ctx context.Context, profileID string) ([]*model.Author, error) {
+	pid, err := uuid.Parse(profileID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid reference letter ID: %w", err)
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
 
-	letter, err := r.refLetterRepo.GetByID(ctx, lid)
+	authors, err := r.authorRepo.GetByProfileID(ctx, pid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get reference letter: %w", err)
+		return nil, fmt.Errorf("failed to get authors: %w", err)
 	}
 
-	if letter == nil {
-		return nil, nil
+	// Collect image IDs for batch fetching
+	var imageIDs []uuid.UUID
+	for _, a := range authors {
+		if a.ImageID != nil {
+			imageIDs = append(imageIDs, *a.ImageID)
+		}
 	}
 
-	// Fetch the user relation
-	user, err := r.userRepo.GetByID(ctx, letter.UserID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get user for reference letter: %w", err)
+	// Fetch image files and generate URLs
+	imageURLs := make(map[uuid.UUID]string)
+	if len(imageIDs) > 0 {
+		for _, imageID := range imageIDs {
+			file, err := r.fileRepo.GetByID(ctx, imageID)
+			if err == nil && file != nil {
+				url, err := r.storage.GetPresignedURL(ctx, file.StorageKey, time.Hour)
+				if err == nil {
+					imageURLs[imageID] = url
+				}
+			}
+		}
 	}
 
-	// Fetch the file relation if present
-	var gqlFile *model.File
-	if letter.FileID != nil {
-		file, err := r.fileRepo.GetByID(ctx, *letter.FileID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get file for reference letter: %w", err)
+	result := make([]*model.Author, len(authors))
+	for i, a := range authors {
+		var imageURL *string
+		if a.ImageID != nil {
+			if url, ok := imageURLs[*a.ImageID]; ok {
+				imageURL = &url
+			}
 		}
-		gqlFile = toGraphQLFile(file, toGraphQLUser(user))
+		result[i] = toGraphQLAuthorWithImage(a, imageURL)
 	}
-
-	return toGraphQLReferenceLetter(letter, toGraphQLUser(user), gqlFile), nil
+	return result, nil
 }
 
-// ReferenceLetters is the resolver for the referenceLetters field.
-func (r *queryResolver) ReferenceLetters(ctx context.Context, userID string) ([]*model.ReferenceLetter, error) {
-	uid, err := uuid.Parse(userID)
+// Company is the resolver for the company field.
+func (r *queryResolver) Company(ctx context.Context, id string) (*model.Company, error) {
+	company, err := r.loadCompany(ctx, id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid user ID: %w", err)
+		return nil, err
 	}
+	return toGraphQLCompany(company), nil
+}
 
-	letters, err := r.refLetterRepo.GetByUserID(ctx, uid)
+// Companies is the resolver for the companies field.
+func (r *queryResolver) Companies(ctx context.Context, profileID string) ([]*model.Company, error) {
+	pid, err := uuid.Parse(profileID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get reference letters: %w", err)
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
 
-	// Fetch the user once for all letters
-	user, err := r.userRepo.GetByID(ctx, uid)
+	companies, err := r.companyRepo.GetByProfileID(ctx, pid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user for reference letters: %w", err)
+		return nil, fmt.Errorf("failed to get companies: %w", err)
 	}
-	gqlUser := toGraphQLUser(user)
 
-	// Collect all unique file IDs to batch fetch files
-	fileIDs := make(map[uuid.UUID]bool)
-	for _, l := range letters {
-		if l.FileID != nil {
-			fileIDs[*l.FileID] = true
-		}
+	result := make([]*model.Company, len(companies))
+	for i, c := range companies {
+		result[i] = toGraphQLCompany(c)
 	}
+	return result, nil
+}
 
-	// Fetch all files and create a lookup map
-	fileMap := make(map[uuid.UUID]*model.File)
-	for fid := range fileIDs {
-		file, err := r.fileRepo.GetByID(ctx, fid)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get file for reference letter: %w", err)
-		}
-		if file != nil {
-			fileMap[fid] = toGraphQLFile(file, gqlUser)
-		}
+// CompanyAliases is the resolver for the companyAliases field.
+func (r *queryResolver) CompanyAliases(ctx context.Context, profileID string) ([]*model.CompanyAlias, error) {
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
 
-	result := make([]*model.ReferenceLetter, len(letters))
-	for i, l := range letters {
-		var gqlFile *model.File
-		if l.FileID != nil {
-			gqlFile = fileMap[*l.FileID]
-		}
-		result[i] = toGraphQLReferenceLetter(l, gqlUser, gqlFile)
+	aliases, err := r.companyAliasRepo.GetByProfileID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company aliases: %w", err)
 	}
 
+	result := make([]*model.CompanyAlias, len(aliases))
+	for i, a := range aliases {
+		result[i] = toGraphQLCompanyAlias(a)
+	}
 	return result, nil
 }
 
-// Resume is the resolver for the resume field.
-func (r *queryResolver) Resume(ctx context.Context, id string) (*model.Resume, error) {
-	rid, err := uuid.Parse(id)
+// SkillTaxonomy is the resolver for the skillTaxonomy field.
+func (r *queryResolver) SkillTaxonomy(ctx context.Context, profileID string) ([]*model.CanonicalSkill, error) {
+	pid, err := uuid.Parse(profileID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid resume ID: %w", err)
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
 
-	resume, err := r.resumeRepo.GetByID(ctx, rid)
+	tax, err := r.skillTaxonomy(ctx, pid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get resume: %w", err)
-	}
-
-	if resume == nil {
-		return nil, nil
+		return nil, err
 	}
+	return toGraphQLCanonicalSkills(tax), nil
+}
 
-	// Fetch the user relation
-	user, err := r.userRepo.GetByID(ctx, resume.UserID)
+// ResolveSkill is the resolver for the resolveSkill field.
+func (r *queryResolver) ResolveSkill(ctx context.Context, profileID string, name string) (*model.CanonicalSkill, error) {
+	pid, err := uuid.Parse(profileID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user for resume: %w", err)
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
 
-	// Fetch the file relation
-	file, err := r.fileRepo.GetByID(ctx, resume.FileID)
+	tax, err := r.skillTaxonomy(ctx, pid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get file for resume: %w", err)
+		return nil, err
 	}
-
-	gqlUser := toGraphQLUser(user)
-	gqlFile := toGraphQLFile(file, gqlUser)
-
-	return toGraphQLResume(resume, gqlUser, gqlFile), nil
+	return toGraphQLCanonicalSkill(tax, tax.Resolve(name)), nil
 }
 
-// Resumes is the resolver for the resumes field.
-func (r *queryResolver) Resumes(ctx context.Context, userID string) ([]*model.Resume, error) {
-	uid, err := uuid.Parse(userID)
+// CareerTimeline is the resolver for the careerTimeline field.
+func (r *queryResolver) CareerTimeline(ctx context.Context, profileID string, gapThresholdMonths *int) (*model.CareerTimeline, error) {
+	pid, err := uuid.Parse(profileID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid user ID: %w", err)
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
 
-	resumes, err := r.resumeRepo.GetByUserID(ctx, uid)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get resumes: %w", err)
+	threshold := service.DefaultGapThresholdMonths
+	if gapThresholdMonths != nil {
+		threshold = *gapThresholdMonths
 	}
 
-	// Fetch the user once for all resumes
-	user, err := r.userRepo.GetByID(ctx, uid)
+	now := time.Now()
+	timeline, err := service.LoadCareerTimeline(ctx, r.profileExpRepo, r.profileEduRepo, pid, threshold, now)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user for resumes: %w", err)
+		return nil, err
 	}
-	gqlUser := toGraphQLUser(user)
+	return toGraphQLCareerTimeline(timeline, now), nil
+}
 
-	// Collect all unique file IDs to batch fetch files
-	fileIDs := make(map[uuid.UUID]bool)
-	for _, res := range resumes {
-		fileIDs[res.FileID] = true
+// ProfileCredibility is the resolver for the profileCredibility field.
+func (r *queryResolver) ProfileCredibility(ctx context.Context, profileID string) (*model.ProfileCredibility, error) {
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
 
-	// Fetch all files and create a lookup map
-	fileMap := make(map[uuid.UUID]*model.File)
-	for fid := range fileIDs {
-		file, err := r.fileRepo.GetByID(ctx, fid)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get file for resume: %w", err)
-		}
-		if file != nil {
-			fileMap[fid] = toGraphQLFile(file, gqlUser)
-		}
+	credibility, err := r.credibility.Score(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to score profile credibility: %w", err)
 	}
+	return toGraphQLProfileCredibility(credibility), nil
+}
 
-	result := make([]*model.Resume, len(resumes))
-	for i, res := range resumes {
-		gqlFile := fileMap[res.FileID]
-		result[i] = toGraphQLResume(res, gqlUser, gqlFile)
+// JobDescriptions is the resolver for the jobDescriptions field.
+func (r *queryResolver) JobDescriptions(ctx context.Context, userID string) ([]*model.JobDescription, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
-	return result, nil
+	jds, err := r.jobDescriptionRepo.GetByUserID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job descriptions: %w", err)
+	}
+	return toGraphQLJobDescriptions(jds), nil
 }
 
-// Profile is the resolver for the profile field.
-func (r *queryResolver) Profile(ctx context.Context, id string) (*model.Profile, error) {
-	pid, err := uuid.Parse(id)
+// JobDescriptionMatch is the resolver for the jobDescriptionMatch field.
+func (r *queryResolver) JobDescriptionMatch(ctx context.Context, profileID string, jobDescriptionID string) (*model.JobMatch, error) {
+	pid, err := uuid.Parse(profileID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
+	jdID, err := uuid.Parse(jobDescriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid job description ID: %w", err)
+	}
 
 	profile, err := r.profileRepo.GetByID(ctx, pid)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get profile: %w", err)
 	}
 	if profile == nil {
+		return nil, fmt.Errorf("profile not found")
+	}
+	jd, err := r.jobDescriptionRepo.GetByID(ctx, jdID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job description: %w", err)
+	}
+	if jd == nil || jd.UserID != profile.UserID {
 		return nil, nil
 	}
 
-	return r.loadProfileData(ctx, profile)
+	match, err := r.jobMatch.MatchSaved(ctx, profile, jd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to match job description: %w", err)
+	}
+	return toGraphQLJobMatch(match), nil
 }
 
-// ProfileByUserID is the resolver for the profileByUserId field.
-func (r *queryResolver) ProfileByUserID(ctx context.Context, userID string) (*model.Profile, error) {
-	uid, err := uuid.Parse(userID)
+// ProfileVariants is the resolver for the profileVariants field.
+func (r *queryResolver) ProfileVariants(ctx context.Context, profileID string) ([]*model.ProfileVariant, error) {
+	pid, err := uuid.Parse(profileID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid user ID: %w", err)
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
 
-	profile, err := r.profileRepo.GetByUserID(ctx, uid)
+	variants, err := r.profileVariantRepo.GetByProfileID(ctx, pid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get profile: %w", err)
-	}
-	if profile == nil {
-		return nil, nil
+		return nil, fmt.Errorf("failed to get profile variants: %w", err)
 	}
-
-	return r.loadProfileData(ctx, profile)
+	return toGraphQLProfileVariants(variants), nil
 }
 
-// ProfileExperience is the resolver for the profileExperience field.
-func (r *queryResolver) ProfileExperience(ctx context.Context, id string) (*model.ProfileExperience, error) {
-	expID, err := uuid.Parse(id)
+// ProfileVariant is the resolver for the profileVariant field.
+func (r *queryResolver) ProfileVariant(ctx context.Context, id string) (*model.ProfileVariant, error) {
+	variantID, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid experience ID: %w", err)
+		return nil, fmt.Errorf("invalid variant ID: %w", err)
 	}
 
-	experience, err := r.profileExpRepo.GetByID(ctx, expID)
+	variant, err := r.profileVariantRepo.GetByID(ctx, variantID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get experience: %w", err)
+		return nil, fmt.Errorf("failed to get profile variant: %w", err)
 	}
-	if experience == nil {
+	if variant == nil {
 		return nil, nil
 	}
-
-	return toGraphQLProfileExperience(experience), nil
+	return toGraphQLProfileVariant(variant), nil
 }
 
-// ProfileEducation is the resolver for the profileEducation field.
-func (r *queryResolver) ProfileEducation(ctx context.Context, id string) (*model.ProfileEducation, error) {
-	eduID, err := uuid.Parse(id)
-	if err != nil {
-		return nil, fmt.Errorf("invalid education ID: %w", err)
+// SharedProfileVariant is the resolver for the sharedProfileVariant field.
+func (r *queryResolver) SharedProfileVariant(ctx context.Context, shareToken string) (*model.ProfileVariant, error) {
+	if strings.TrimSpace(shareToken) == "" {
+		return nil, nil
 	}
 
-	education, err := r.profileEduRepo.GetByID(ctx, eduID)
+	variant, err := r.profileVariantRepo.GetByShareToken(ctx, shareToken)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get education: %w", err)
+		return nil, fmt.Errorf("failed to get shared profile variant: %w", err)
 	}
-	if education == nil {
+	if variant == nil {
 		return nil, nil
 	}
-
-	return toGraphQLProfileEducation(education), nil
+	return toGraphQLProfileVariant(variant), nil
 }
 
-// ProfileSkill is the resolver for the profileSkill field.
-func (r *queryResolver) ProfileSkill(ctx context.Context, id string) (*model.ProfileSkill, error) {
-	skillID, err := uuid.Parse(id)
+// ExportProfileVariant is the resolver for the exportProfileVariant field.
+func (r *queryResolver) ExportProfileVariant(ctx context.Context, id string, format *model.ProfileExportFormat) (*model.ProfileExport, error) {
+	variantID, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid skill ID: %w", err)
+		return nil, fmt.Errorf("invalid variant ID: %w", err)
 	}
-
-	skill, err := r.profileSkillRepo.GetByID(ctx, skillID)
+	variant, err := r.profileVariantRepo.GetByID(ctx, variantID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get skill: %w", err)
+		return nil, fmt.Errorf("failed to get profile variant: %w", err)
 	}
-	if skill == nil {
+	if variant == nil {
 		return nil, nil
 	}
 
-	return toGraphQLProfileSkill(skill), nil
+	profile, err := r.loadProfileVariant(ctx, variant)
+	if err != nil || profile == nil {
+		return nil, err
+	}
+	testimonials, err := r.variantTestimonials(ctx, variant)
+	if err != nil {
+		return nil, err
+	}
+
+	exportFormat := service.ExportText
+	if format != nil {
+		exportFormat = service.ExportFormat(strings.ToLower(string(*format)))
+	}
+	doc := variantExportDocument(variant, profile, testimonials)
+	export := doc.Render(service.ExportFileName(doc.Title, "profile"), exportFormat)
+	return &model.ProfileExport{
+		Filename:    export.Filename,
+		ContentType: export.ContentType,
+		Content:     export.Content,
+	}, nil
 }
 
-// Testimonials is the resolver for the testimonials field.
-func (r *queryResolver) Testimonials(ctx context.Context, profileID string) ([]*model.Testimonial, error) {
+// WritingSuggestions is the resolver for the writingSuggestions field.
+func (r *queryResolver) WritingSuggestions(ctx context.Context, profileID string, status *model.WritingSuggestionStatus) ([]*model.WritingSuggestion, error) {
 	pid, err := uuid.Parse(profileID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
 
-	testimonials, err := r.testimonialRepo.GetByProfileID(ctx, pid)
+	suggestions, err := r.writingSuggestionRepo.GetByProfileID(ctx, pid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get testimonials: %w", err)
-	}
-
-	// Collect unique reference letter IDs and author IDs
-	refLetterIDs := make(map[uuid.UUID]struct{})
-	authorIDs := make(map[uuid.UUID]struct{})
-	for _, t := range testimonials {
-		refLetterIDs[t.ReferenceLetterID] = struct{}{}
-		if t.AuthorID != nil {
-			authorIDs[*t.AuthorID] = struct{}{}
-		}
-	}
-
-	// Load authors and build map
-	authorsMap := make(map[uuid.UUID]*domain.Author)
-	for authorID := range authorIDs {
-		author, err := r.authorRepo.GetByID(ctx, authorID)
-		if err != nil {
-			continue // Skip on error
-		}
-		if author != nil {
-			authorsMap[authorID] = author
-		}
+		return nil, fmt.Errorf("failed to get writing suggestions: %w", err)
 	}
 
-	// Attach authors to testimonials
-	for _, t := range testimonials {
-		if t.AuthorID != nil {
-			if author, ok := authorsMap[*t.AuthorID]; ok {
-				t.Author = author
-			}
+	result := make([]*model.WritingSuggestion, 0, len(suggestions))
+	for _, s := range suggestions {
+		gqlSuggestion := toGraphQLWritingSuggestion(s)
+		if status != nil && gqlSuggestion.Status != *status {
+			continue
 		}
+		result = append(result, gqlSuggestion)
 	}
 
-	// Build map of reference letter ID -> validated skills
-	validatedSkillsByRefLetter := make(map[string][]*model.ProfileSkill)
-	for refLetterID := range refLetterIDs {
-		// Get skill validations for this reference letter
-		skillValidations, err := r.skillValidationRepo.GetByReferenceLetterID(ctx, refLetterID)
-		if err != nil {
-			// Log but don't fail - validated skills are supplementary
-			continue
-		}
+	return result, nil
+}
 
-		// Get the profile skills for each validation
-		var skills []*model.ProfileSkill
-		for _, sv := range skillValidations {
-			profileSkill, err := r.profileSkillRepo.GetByID(ctx, sv.ProfileSkillID)
-			if err != nil {
-				continue // Skip if skill was deleted
-			}
-			skills = append(skills, toGraphQLProfileSkill(profileSkill))
-		}
+// CoverLetters is the resolver for the coverLetters field.
+func (r *queryResolver) CoverLetters(ctx context.Context, profileID string) ([]*model.CoverLetter, error) {
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
+	}
 
-		validatedSkillsByRefLetter[refLetterID.String()] = skills
+	letters, err := r.coverLetterRepo.GetByProfileID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cover letters: %w", err)
 	}
+	return toGraphQLCoverLetters(letters), nil
+}
 
-	return toGraphQLTestimonials(testimonials, validatedSkillsByRefLetter), nil
+// CoverLetter is the resolver for the coverLetter field.
+func (r *queryResolver) CoverLetter(ctx context.Context, id string) (*model.CoverLetter, error) {
+	letter, err := r.coverLetterByID(ctx, id)
+	if err != nil || letter == nil {
+		return nil, err
+	}
+	return toGraphQLCoverLetter(letter), nil
 }
 
-// Author is the resolver for the author field.
-func (r *queryResolver) Author(ctx context.Context, id string) (*model.Author, error) {
-	authorID, err := uuid.Parse(id)
+// ExportCoverLetter is the resolver for the exportCoverLetter field.
+func (r *queryResolver) ExportCoverLetter(ctx context.Context, id string, version *int, format *model.CoverLetterExportFormat) (*model.CoverLetterExport, error) {
+	letter, err := r.coverLetterByID(ctx, id)
+	if err != nil || letter == nil {
+		return nil, err
+	}
+
+	v := 0
+	if version != nil {
+		v = *version
+	}
+	exportFormat := service.ExportText
+	if format != nil {
+		exportFormat = service.ExportFormat(strings.ToLower(string(*format)))
+	}
+
+	export, err := r.coverLetters.Export(ctx, letter, v, exportFormat)
+	if err != nil || export == nil {
+		return nil, err
+	}
+	return &model.CoverLetterExport{
+		Filename:    export.Filename,
+		ContentType: export.ContentType,
+		Content:     export.Content,
+	}, nil
+}
+
+// AskProfile is the resolver for the askProfile field.
+func (r *queryResolver) AskProfile(ctx context.Context, profileID string, question string) (*model.ProfileAnswer, error) {
+	pid, err := uuid.Parse(profileID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid author ID: %w", err)
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
+	}
+	if strings.TrimSpace(question) == "" {
+		return nil, fmt.Errorf("question is required")
+	}
+	if r.profileWriter == nil {
+		return nil, fmt.Errorf("question answering is not available")
 	}
 
-	author, err := r.authorRepo.GetByID(ctx, authorID)
+	profile, err := r.profileRepo.GetByID(ctx, pid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get author: %w", err)
+		return nil, fmt.Errorf("failed to get profile: %w", err)
 	}
-	if author == nil {
+	if profile == nil {
 		return nil, nil
 	}
 
-	// Get image URL if author has an image
-	var imageURL *string
-	if author.ImageID != nil {
-		file, err := r.fileRepo.GetByID(ctx, *author.ImageID)
-		if err == nil && file != nil {
-			url, err := r.storage.GetPresignedURL(ctx, file.StorageKey, time.Hour)
-			if err == nil {
-				imageURL = &url
-			}
-		}
+	answer, err := r.profileQA.Ask(ctx, pid, question)
+	if err != nil {
+		r.log.Error("Failed to answer profile question",
+			logger.Feature("profile-qa"),
+			logger.String("profile_id", profileID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to answer question: %w", err)
 	}
 
-	return toGraphQLAuthorWithImage(author, imageURL), nil
+	r.log.Info("Answered profile question",
+		logger.Feature("profile-qa"),
+		logger.String("profile_id", profileID),
+		logger.Int("citations", len(answer.Citations)),
+	)
+	return toGraphQLProfileAnswer(strings.TrimSpace(question), answer), nil
 }
 
-// Authors is the resolver for the authors field.
-func (r *queryResolver) Authors(ctx context.Context, profileID string) ([]*model.Author, error) {
+// SearchEvidence is the resolver for the searchEvidence field.
+func (r *queryResolver) SearchEvidence(ctx context.Context, profileID string, query string, limit *int) ([]*model.EvidenceSearchResult, error) {
 	pid, err := uuid.Parse(profileID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+	if r.embeddingSvc == nil {
+		return nil, fmt.Errorf("evidence search is not available")
+	}
 
-	authors, err := r.authorRepo.GetByProfileID(ctx, pid)
+	profile, err := r.profileRepo.GetByID(ctx, pid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get authors: %w", err)
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	if profile == nil {
+		return []*model.EvidenceSearchResult{}, nil
 	}
 
-	// Collect image IDs for batch fetching
-	var imageIDs []uuid.UUID
-	for _, a := range authors {
-		if a.ImageID != nil {
-			imageIDs = append(imageIDs, *a.ImageID)
-		}
+	n := 0
+	if limit != nil {
+		n = *limit
+	}
+	matches, err := r.embeddingSvc.Search(ctx, pid, query, n)
+	if err != nil {
+		r.log.Error("Failed to search evidence",
+			logger.Feature("embeddings"),
+			logger.String("profile_id", profileID),
+			logger.Err(err),
+		)
+		return nil, fmt.Errorf("failed to search evidence: %w", err)
 	}
 
-	// Fetch image files and generate URLs
-	imageURLs := make(map[uuid.UUID]string)
-	if len(imageIDs) > 0 {
-		for _, imageID := range imageIDs {
-			file, err := r.fileRepo.GetByID(ctx, imageID)
-			if err == nil && file != nil {
-				url, err := r.storage.GetPresignedURL(ctx, file.StorageKey, time.Hour)
-				if err == nil {
-					imageURLs[imageID] = url
-				}
-			}
-		}
+	return toGraphQLEvidenceSearchResults(matches), nil
+}
+
+// DuplicateSuggestions is the resolver for the duplicateSuggestions field.
+func (r *queryResolver) DuplicateSuggestions(ctx context.Context, profileID string) ([]*model.DuplicateSuggestion, error) {
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
 	}
 
-	result := make([]*model.Author, len(authors))
-	for i, a := range authors {
-		var imageURL *string
-		if a.ImageID != nil {
-			if url, ok := imageURLs[*a.ImageID]; ok {
-				imageURL = &url
-			}
-		}
-		result[i] = toGraphQLAuthorWithImage(a, imageURL)
+	clusters, err := r.dedup.Suggestions(ctx, pid, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicates: %w", err)
 	}
-	return result, nil
+	return toGraphQLDuplicateSuggestions(clusters), nil
 }
 
 // SkillValidations is the resolver for the skillValidations field.
@@ -3548,6 +8103,121 @@ func (r *queryResolver) ExperienceValidations(ctx context.Context, experienceID
 	return result, nil
 }
 
+// EducationValidations is the resolver for the educationValidations field.
+func (r *queryResolver) EducationValidations(ctx context.Context, educationID string) ([]*model.EducationValidation, error) {
+	eid, err := uuid.Parse(educationID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid education ID: %w", err)
+	}
+
+	validations, err := r.eduValidationRepo.GetByProfileEducationID(ctx, eid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get education validations: %w", err)
+	}
+
+	result := make([]*model.EducationValidation, len(validations))
+	for i, v := range validations {
+		result[i] = &model.EducationValidation{
+			ID:           v.ID.String(),
+			QuoteSnippet: v.QuoteSnippet,
+			CreatedAt:    v.CreatedAt,
+		}
+	}
+
+	return result, nil
+}
+
+// ProfileInconsistencies is the resolver for the profileInconsistencies field.
+func (r *queryResolver) ProfileInconsistencies(ctx context.Context, profileID string, status *model.InconsistencyStatus) ([]*model.ProfileInconsistency, error) {
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
+	}
+
+	inconsistencies, err := r.inconsistencyRepo.GetByProfileID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile inconsistencies: %w", err)
+	}
+
+	result := make([]*model.ProfileInconsistency, 0, len(inconsistencies))
+	for _, i := range inconsistencies {
+		gqlInconsistency := toGraphQLProfileInconsistency(i)
+		if status != nil && gqlInconsistency.Status != *status {
+			continue
+		}
+		result = append(result, gqlInconsistency)
+	}
+
+	return result, nil
+}
+
+// ProfileHistory is the resolver for the profileHistory field.
+func (r *queryResolver) ProfileHistory(ctx context.Context, profileID string) ([]*model.ProfileChange, error) {
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
+	}
+
+	changes, err := r.changeRepo.GetByProfileID(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile history: %w", err)
+	}
+
+	return toGraphQLProfileChanges(changes), nil
+}
+
+// ProfileAt is the resolver for the profileAt field.
+func (r *queryResolver) ProfileAt(ctx context.Context, profileID string, timestamp time.Time) (*model.ProfileSnapshot, error) {
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
+	}
+
+	snapshot, err := r.history.ProfileAt(ctx, pid, timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconstruct profile: %w", err)
+	}
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	return toGraphQLProfileSnapshot(snapshot), nil
+}
+
+// PreviewResumeMerge is the resolver for the previewResumeMerge field.
+func (r *queryResolver) PreviewResumeMerge(ctx context.Context, userID string, resumeID string) (*model.ResumeMergePreview, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	rid, err := uuid.Parse(resumeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resume ID: %w", err)
+	}
+
+	resume, err := r.resumeRepo.GetByID(ctx, rid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+	if resume == nil || resume.UserID != uid {
+		return nil, fmt.Errorf("resume not found")
+	}
+	if resume.Status != domain.ResumeStatusCompleted {
+		return nil, fmt.Errorf("resume is not ready for import (status: %s)", resume.Status)
+	}
+
+	var extractedData domain.ResumeExtractedData
+	if err := json.Unmarshal(resume.ExtractedData, &extractedData); err != nil {
+		return nil, fmt.Errorf("failed to parse extracted resume data: %w", err)
+	}
+
+	diff, err := r.materializationSvc.PreviewResumeMerge(ctx, rid, uid, &extractedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to preview resume merge: %w", err)
+	}
+	return toGraphQLResumeMergePreview(diff), nil
+}
+
 // CheckDuplicateFile is the resolver for the checkDuplicateFile field.
 func (r *queryResolver) CheckDuplicateFile(ctx context.Context, userID string, contentHash string) (*model.DuplicateFileDetected, error) {
 	r.log.Info("Checking for duplicate file",
@@ -3664,13 +8334,14 @@ func (r *queryResolver) CheckDuplicateFile(ctx context.Context, userID string, c
 }
 
 // DocumentProcessingStatus is the resolver for the documentProcessingStatus field.
-func (r *queryResolver) DocumentProcessingStatus(ctx context.Context, resumeID *string, referenceLetterID *string) (*model.DocumentProcessingStatus, error) {
-	if resumeID == nil && referenceLetterID == nil {
-		return nil, fmt.Errorf("at least one of resumeId or referenceLetterID must be provided")
+func (r *queryResolver) DocumentProcessingStatus(ctx context.Context, resumeID *string, referenceLetterID *string, credentialDocumentIds []string) (*model.DocumentProcessingStatus, error) {
+	if resumeID == nil && referenceLetterID == nil && len(credentialDocumentIds) == 0 {
+		return nil, fmt.Errorf("at least one of resumeId, referenceLetterID or credentialDocumentIds must be provided")
 	}
 
 	result := &model.DocumentProcessingStatus{
-		AllComplete: true,
+		AllComplete:         true,
+		CredentialDocuments: []*model.CredentialDocument{},
 	}
 
 	// Check resume status
@@ -3722,6 +8393,30 @@ func (r *queryResolver) DocumentProcessingStatus(ctx context.Context, resumeID *
 		}
 	}
 
+	// Check credential document statuses
+	for _, rawID := range credentialDocumentIds {
+		docID, err := uuid.Parse(rawID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid credential document ID: %w", err)
+		}
+		doc, err := r.credentialDocRepo.GetByID(ctx, docID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get credential document: %w", err)
+		}
+		if doc == nil {
+			continue
+		}
+		user, _ := r.userRepo.GetByID(ctx, doc.UserID)
+		gqlUser := toGraphQLUser(user)
+		file, _ := r.fileRepo.GetByID(ctx, doc.FileID)
+		gqlFile := toGraphQLFile(file, gqlUser)
+		result.CredentialDocuments = append(result.CredentialDocuments, toGraphQLCredentialDocument(doc, gqlUser, gqlFile))
+
+		if doc.Status != domain.CredentialDocumentStatusCompleted && doc.Status != domain.CredentialDocumentStatusFailed {
+			result.AllComplete = false
+		}
+	}
+
 	return result, nil
 }
 
@@ -3776,6 +8471,12 @@ func (r *queryResolver) DocumentDetectionStatus(ctx context.Context, fileID stri
 			gqlTypeHint = model.DocumentTypeHintReferenceLetter
 		case domain.DocumentTypeHybrid:
 			gqlTypeHint = model.DocumentTypeHintHybrid
+		case domain.DocumentTypeCertificate:
+			gqlTypeHint = model.DocumentTypeHintCertificate
+		case domain.DocumentTypeTranscript:
+			gqlTypeHint = model.DocumentTypeHintTranscript
+		case domain.DocumentTypePerformanceReview:
+			gqlTypeHint = model.DocumentTypeHintPerformanceReview
 		default:
 			gqlTypeHint = model.DocumentTypeHintUnknown
 		}
@@ -3944,7 +8645,7 @@ func (r *testimonialResolver) ReferenceLetter(ctx context.Context, obj *model.Te
 	if err != nil {
 		return nil, fmt.Errorf("failed to get testimonial: %w", err)
 	}
-	if testimonial == nil {
+	if testimonial == nil || testimonial.ReferenceLetterID == uuid.Nil {
 		return nil, nil
 	}
 
@@ -3983,6 +8684,24 @@ func (r *testimonialResolver) ReferenceLetter(ctx context.Context, obj *model.Te
 	return toGraphQLReferenceLetter(refLetter, gqlUser, gqlFile), nil
 }
 
+// CredentialDocument is the resolver for the credentialDocument field.
+func (r *testimonialResolver) CredentialDocument(ctx context.Context, obj *model.Testimonial) (*model.CredentialDocument, error) {
+	testimonialID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid testimonial ID: %w", err)
+	}
+
+	testimonial, err := r.testimonialRepo.GetByID(ctx, testimonialID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get testimonial: %w", err)
+	}
+	if testimonial == nil || testimonial.CredentialDocumentID == nil {
+		return nil, nil
+	}
+
+	return r.loadCredentialDocument(ctx, *testimonial.CredentialDocumentID)
+}
+
 // ValidatedSkills is the resolver for the validatedSkills field.
 func (r *testimonialResolver) ValidatedSkills(ctx context.Context, obj *model.Testimonial) ([]*model.ProfileSkill, error) {
 	testimonialID, err := uuid.Parse(obj.ID)
@@ -4022,6 +8741,46 @@ func (r *testimonialResolver) ValidatedSkills(ctx context.Context, obj *model.Te
 	return skills, nil
 }
 
+// Experience is the resolver for the experience field.
+func (r *writingSuggestionResolver) Experience(ctx context.Context, obj *model.WritingSuggestion) (*model.ProfileExperience, error) {
+	suggestionID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid suggestion ID: %w", err)
+	}
+
+	suggestion, err := r.writingSuggestionRepo.GetByID(ctx, suggestionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get writing suggestion: %w", err)
+	}
+	if suggestion == nil || suggestion.ExperienceID == nil {
+		return nil, nil
+	}
+
+	experience, err := r.profileExpRepo.GetByID(ctx, *suggestion.ExperienceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile experience: %w", err)
+	}
+	if experience == nil {
+		return nil, nil
+	}
+
+	return toGraphQLProfileExperience(experience), nil
+}
+
+// Author returns generated.AuthorResolver implementation.
+func (r *Resolver) Author() generated.AuthorResolver { return &authorResolver{r} }
+
+// Company returns generated.CompanyResolver implementation.
+func (r *Resolver) Company() generated.CompanyResolver { return &companyResolver{r} }
+
+// CoverLetter returns generated.CoverLetterResolver implementation.
+func (r *Resolver) CoverLetter() generated.CoverLetterResolver { return &coverLetterResolver{r} }
+
+// EducationValidation returns generated.EducationValidationResolver implementation.
+func (r *Resolver) EducationValidation() generated.EducationValidationResolver {
+	return &educationValidationResolver{r}
+}
+
 // ExperienceValidation returns generated.ExperienceValidationResolver implementation.
 func (r *Resolver) ExperienceValidation() generated.ExperienceValidationResolver {
 	return &experienceValidationResolver{r}
@@ -4033,14 +8792,34 @@ func (r *Resolver) File() generated.FileResolver { return &fileResolver{r} }
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
+// ProfileCertification returns generated.ProfileCertificationResolver implementation.
+func (r *Resolver) ProfileCertification() generated.ProfileCertificationResolver {
+	return &profileCertificationResolver{r}
+}
+
+// ProfileEducation returns generated.ProfileEducationResolver implementation.
+func (r *Resolver) ProfileEducation() generated.ProfileEducationResolver {
+	return &profileEducationResolver{r}
+}
+
 // ProfileExperience returns generated.ProfileExperienceResolver implementation.
 func (r *Resolver) ProfileExperience() generated.ProfileExperienceResolver {
 	return &profileExperienceResolver{r}
 }
 
+// ProfileInconsistency returns generated.ProfileInconsistencyResolver implementation.
+func (r *Resolver) ProfileInconsistency() generated.ProfileInconsistencyResolver {
+	return &profileInconsistencyResolver{r}
+}
+
 // ProfileSkill returns generated.ProfileSkillResolver implementation.
 func (r *Resolver) ProfileSkill() generated.ProfileSkillResolver { return &profileSkillResolver{r} }
 
+// ProfileVariant returns generated.ProfileVariantResolver implementation.
+func (r *Resolver) ProfileVariant() generated.ProfileVariantResolver {
+	return &profileVariantResolver{r}
+}
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
@@ -4052,13 +8831,25 @@ func (r *Resolver) SkillValidation() generated.SkillValidationResolver {
 // Testimonial returns generated.TestimonialResolver implementation.
 func (r *Resolver) Testimonial() generated.TestimonialResolver { return &testimonialResolver{r} }
 
+// WritingSuggestion returns generated.WritingSuggestionResolver implementation.
+func (r *Resolver) WritingSuggestion() generated.WritingSuggestionResolver {
+	return &writingSuggestionResolver{r}
+}
+
+type authorResolver struct{ *Resolver }
+type companyResolver struct{ *Resolver }
+type coverLetterResolver struct{ *Resolver }
+type educationValidationResolver struct{ *Resolver }
 type experienceValidationResolver struct{ *Resolver }
 type fileResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type profileCertificationResolver struct{ *Resolver }
+type profileEducationResolver struct{ *Resolver }
 type profileExperienceResolver struct{ *Resolver }
+type profileInconsistencyResolver struct{ *Resolver }
 type profileSkillResolver struct{ *Resolver }
+type profileVariantResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type skillValidationResolver struct{ *Resolver }
 type testimonialResolver struct{ *Resolver }
-
-// !!! WARNING !!!
+type writingSuggestionResolver struct{ *Resolver }
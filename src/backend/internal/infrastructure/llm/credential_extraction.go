@@ -0,0 +1,520 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	otelTrace "go.opentelemetry.io/otel/trace"
+
+	"backend/internal/domain"
+	"backend/internal/logger"
+)
+
+// maxCredentialTextSize limits certificate, transcript and performance review text (in bytes).
+// Transcripts with long course lists are the largest of the three.
+const maxCredentialTextSize = 50 * 1024 // 50KB
+
+// Certificate extraction prompts
+//
+//go:embed prompts/certificate_extraction_system.txt
+var certificateExtractionSystemPrompt string
+
+//go:embed prompts/certificate_extraction_user.txt
+var certificateExtractionUserTemplate string
+
+// Transcript extraction prompts
+//
+//go:embed prompts/transcript_extraction_system.txt
+var transcriptExtractionSystemPrompt string
+
+//go:embed prompts/transcript_extraction_user.txt
+var transcriptExtractionUserTemplate string
+
+// Performance review extraction prompts
+//
+//go:embed prompts/performance_review_extraction_system.txt
+var performanceReviewExtractionSystemPrompt string
+
+//go:embed prompts/performance_review_extraction_user.txt
+var performanceReviewExtractionUserTemplate string
+
+var certificateUserTmpl = template.Must(template.New("certificate_user").Parse(certificateExtractionUserTemplate))
+var transcriptUserTmpl = template.Must(template.New("transcript_user").Parse(transcriptExtractionUserTemplate))
+var performanceReviewUserTmpl = template.Must(template.New("performance_review_user").Parse(performanceReviewExtractionUserTemplate))
+
+// CredentialTemplateData holds the data for rendering the credential extraction user prompts.
+type CredentialTemplateData struct {
+	Text string
+}
+
+// certificateOutputSchema defines the JSON schema for structured certificate/license extraction.
+var certificateOutputSchema = map[string]any{
+	"type":                 "object",
+	"additionalProperties": false,
+	"properties": map[string]any{
+		"name": map[string]any{
+			"type":        "string",
+			"description": "Name of the certification or license as issued",
+		},
+		"issuer": map[string]any{
+			"type":        "string",
+			"description": "Organization that issued the credential",
+		},
+		"holderName": map[string]any{
+			"type":        "string",
+			"description": "Full name of the person the credential was issued to",
+		},
+		"credentialId": map[string]any{
+			"type":        "string",
+			"description": "Credential, certificate or license number if printed",
+		},
+		"verificationUrl": map[string]any{
+			"type":        "string",
+			"description": "URL to verify the credential, only if printed on the document",
+		},
+		"issueDate": map[string]any{
+			"type":        "string",
+			"description": "Issue date in ISO format YYYY-MM-DD, or null if not present",
+		},
+		"expiryDate": map[string]any{
+			"type":        "string",
+			"description": "Expiry date in ISO format YYYY-MM-DD, or null if the credential does not expire",
+		},
+		"skills": map[string]any{
+			"type":        "array",
+			"description": "Skills or competencies the credential attests to",
+			"items": map[string]any{
+				"type": "string",
+			},
+		},
+	},
+	"required": []string{"name", "issuer", "holderName", "credentialId", "verificationUrl", "issueDate", "expiryDate", "skills"},
+}
+
+// transcriptOutputSchema defines the JSON schema for structured transcript/diploma extraction.
+var transcriptOutputSchema = map[string]any{
+	"type":                 "object",
+	"additionalProperties": false,
+	"properties": map[string]any{
+		"holderName": map[string]any{
+			"type":        "string",
+			"description": "Full name of the student",
+		},
+		"institution": map[string]any{
+			"type":        "string",
+			"description": "School/University name",
+		},
+		"degree": map[string]any{
+			"type":        "string",
+			"description": "Degree type (e.g., 'Bachelor of Science')",
+		},
+		"field": map[string]any{
+			"type":        "string",
+			"description": "Field of study",
+		},
+		"startDate": map[string]any{
+			"type":        "string",
+			"description": "Start of studies in ISO format YYYY-MM-DD, or null if not present",
+		},
+		"graduationDate": map[string]any{
+			"type":        "string",
+			"description": "Date the degree was conferred in ISO format YYYY-MM-DD, or null if not present",
+		},
+		"gpa": map[string]any{
+			"type":        "string",
+			"description": "Overall grade or GPA exactly as stated",
+		},
+		"honors": map[string]any{
+			"type":        "string",
+			"description": "Honors or distinctions",
+		},
+		"courses": map[string]any{
+			"type":        "array",
+			"description": "Courses listed on the transcript",
+			"items": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Course name",
+					},
+					"grade": map[string]any{
+						"type":        "string",
+						"description": "Grade achieved",
+					},
+					"credits": map[string]any{
+						"type":        "string",
+						"description": "Credits or ECTS points",
+					},
+				},
+				"required": []string{"name", "grade", "credits"},
+			},
+		},
+	},
+	"required": []string{"holderName", "institution", "degree", "field", "startDate", "graduationDate", "gpa", "honors", "courses"},
+}
+
+// performanceReviewOutputSchema defines the JSON schema for structured performance review extraction.
+var performanceReviewOutputSchema = map[string]any{
+	"type":                 "object",
+	"additionalProperties": false,
+	"properties": map[string]any{
+		"reviewer": map[string]any{
+			"type":                 "object",
+			"additionalProperties": false,
+			"description":          "Information about the reviewer",
+			"properties": map[string]any{
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Full name of the reviewer",
+				},
+				"title": map[string]any{
+					"type":        "string",
+					"description": "Job title of the reviewer if mentioned",
+				},
+				"company": map[string]any{
+					"type":        "string",
+					"description": "Organization of the reviewer if mentioned",
+				},
+				"relationship": map[string]any{
+					"type":        "string",
+					"description": "Relationship to candidate: manager, peer, direct_report, client, mentor, professor, colleague, or other",
+					"enum":        []string{"manager", "peer", "direct_report", "client", "mentor", "professor", "colleague", "other"},
+				},
+			},
+			"required": []string{"name", "title", "company", "relationship"},
+		},
+		"company": map[string]any{
+			"type":        "string",
+			"description": "Employer the review was written at",
+		},
+		"role": map[string]any{
+			"type":        "string",
+			"description": "Candidate's job title during the review period",
+		},
+		"reviewPeriod": map[string]any{
+			"type":        "string",
+			"description": "Period covered by the review",
+		},
+		"rating": map[string]any{
+			"type":        "string",
+			"description": "Overall rating exactly as stated",
+		},
+		"testimonials": map[string]any{
+			"type":        "array",
+			"description": "2-6 statements from the reviewer suitable for display on the profile",
+			"items": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"quote": map[string]any{
+						"type":        "string",
+						"description": "Exact statement from the reviewer about the candidate",
+					},
+					"skillsMentioned": map[string]any{
+						"type":        "array",
+						"description": "Skills referenced in this statement",
+						"items": map[string]any{
+							"type": "string",
+						},
+					},
+				},
+				"required": []string{"quote", "skillsMentioned"},
+			},
+		},
+	},
+	"required": []string{"reviewer", "company", "role", "reviewPeriod", "rating", "testimonials"},
+}
+
+// structuredCompletion runs a structured-output LLM request for one of the credential extractors
+// or the job description extractor and returns the cleaned JSON content together with the raw response for metadata.
+func (e *DocumentExtractor) structuredCompletion(
+	ctx context.Context,
+	operation string,
+	systemPrompt string,
+	userTmpl *template.Template,
+	schema map[string]any,
+	text string,
+) (string, *domain.LLMResponse, error) {
+	span := otelTrace.SpanFromContext(ctx)
+
+	// Enforce document size limit to prevent cost/performance DoS
+	if len(text) > maxCredentialTextSize {
+		originalSize := len(text)
+		span.SetAttributes(attribute.Bool("truncated", true))
+		text = text[:maxCredentialTextSize]
+		if e.config.Logger != nil {
+			e.config.Logger.Warning("Credential text truncated due to size limit",
+				logger.Feature("llm"),
+				logger.String("operation", operation),
+				logger.Int("original_size", originalSize),
+				logger.Int("max_size", maxCredentialTextSize),
+			)
+		}
+	}
+
+	chain := e.config.CredentialExtractionChain
+	if len(chain) == 0 {
+		chain = e.config.ResumeExtractionChain
+	}
+	provider := e.getProviderForChain(chain)
+
+	var userPromptBuf bytes.Buffer
+	if err := userTmpl.Execute(&userPromptBuf, CredentialTemplateData{Text: text}); err != nil {
+		return "", nil, fmt.Errorf("failed to render user prompt template: %w", err)
+	}
+
+	resp, err := provider.Complete(ctx, domain.LLMRequest{
+		SystemPrompt: systemPrompt,
+		Messages: []domain.Message{
+			domain.NewTextMessage(domain.RoleUser, userPromptBuf.String()),
+		},
+		MaxTokens:    e.config.MaxTokens,
+		OutputSchema: schema,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return "", nil, fmt.Errorf("LLM extraction failed: %w", err)
+	}
+
+	// Check if response needs cleanup (indicates LLM output quality issue)
+	needsMarkdownCleanup := strings.Contains(resp.Content, "```")
+	needsCommaCleanup := trailingCommaRegex.MatchString(resp.Content)
+	if (needsMarkdownCleanup || needsCommaCleanup) && e.config.Logger != nil {
+		e.config.Logger.Warning("LLM response required cleanup",
+			logger.Feature("llm"),
+			logger.String("operation", operation),
+			logger.Bool("markdown_block", needsMarkdownCleanup),
+			logger.Bool("trailing_commas", needsCommaCleanup),
+			logger.String("model", resp.Model),
+		)
+	}
+
+	jsonContent := fixTrailingCommas(stripMarkdownCodeBlock(resp.Content))
+	return jsonContent, resp, nil
+}
+
+// extractionMetadata builds ExtractionMetadata for a completed credential extraction.
+func extractionMetadata(resp *domain.LLMResponse, promptVersion string, startTime time.Time) domain.ExtractionMetadata {
+	return domain.ExtractionMetadata{
+		ExtractedAt:   time.Now(),
+		ModelVersion:  resp.Model,
+		PromptVersion: promptVersion,
+		InputTokens:   resp.InputTokens,
+		OutputTokens:  resp.OutputTokens,
+		DurationMs:    time.Since(startTime).Milliseconds(),
+	}
+}
+
+// optionalString returns nil for empty strings so optional fields are omitted rather than blank.
+func optionalString(s string) *string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	return &s
+}
+
+// ExtractCertificateData implements domain.DocumentExtractor interface.
+// It extracts structured certificate/license data from text using LLM with structured output.
+func (e *DocumentExtractor) ExtractCertificateData(ctx context.Context, text string) (*domain.ExtractedCertificateData, error) {
+	startTime := time.Now()
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "certificate_data_extraction",
+		otelTrace.WithAttributes(
+			attribute.Int("text_length", len(text)),
+		),
+	)
+	defer span.End()
+
+	jsonContent, resp, err := e.structuredCompletion(ctx, "certificate_extraction",
+		certificateExtractionSystemPrompt, certificateUserTmpl, certificateOutputSchema, text)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawData struct { //nolint:govet // Field order matches JSON output for readability
+		Name            string   `json:"name"`
+		Issuer          string   `json:"issuer"`
+		HolderName      string   `json:"holderName"`
+		CredentialID    string   `json:"credentialId"`
+		VerificationURL string   `json:"verificationUrl"`
+		IssueDate       *string  `json:"issueDate"`
+		ExpiryDate      *string  `json:"expiryDate"`
+		Skills          []string `json:"skills"`
+	}
+	if err := json.Unmarshal([]byte(jsonContent), &rawData); err != nil {
+		return nil, fmt.Errorf("failed to parse extraction response: %w", err)
+	}
+
+	data := &domain.ExtractedCertificateData{
+		Name:            rawData.Name,
+		Issuer:          rawData.Issuer,
+		HolderName:      optionalString(rawData.HolderName),
+		CredentialID:    optionalString(rawData.CredentialID),
+		VerificationURL: optionalString(rawData.VerificationURL),
+		IssueDate:       rawData.IssueDate,
+		ExpiryDate:      rawData.ExpiryDate,
+		Skills:          rawData.Skills,
+		Metadata:        extractionMetadata(resp, domain.CertificateExtractionPromptVersion, startTime),
+	}
+	if data.Skills == nil {
+		data.Skills = []string{}
+	}
+
+	validator := NewExtractedDataValidator()
+	if err := validator.ValidateCertificateData(data); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	return data, nil
+}
+
+// ExtractTranscriptData implements domain.DocumentExtractor interface.
+// It extracts structured academic transcript/diploma data from text using LLM with structured output.
+func (e *DocumentExtractor) ExtractTranscriptData(ctx context.Context, text string) (*domain.ExtractedTranscriptData, error) {
+	startTime := time.Now()
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "transcript_data_extraction",
+		otelTrace.WithAttributes(
+			attribute.Int("text_length", len(text)),
+		),
+	)
+	defer span.End()
+
+	jsonContent, resp, err := e.structuredCompletion(ctx, "transcript_extraction",
+		transcriptExtractionSystemPrompt, transcriptUserTmpl, transcriptOutputSchema, text)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawData struct { //nolint:govet // Field order matches JSON output for readability
+		HolderName     string  `json:"holderName"`
+		Institution    string  `json:"institution"`
+		Degree         string  `json:"degree"`
+		Field          string  `json:"field"`
+		StartDate      *string `json:"startDate"`
+		GraduationDate *string `json:"graduationDate"`
+		GPA            string  `json:"gpa"`
+		Honors         string  `json:"honors"`
+		Courses        []struct {
+			Name    string `json:"name"`
+			Grade   string `json:"grade"`
+			Credits string `json:"credits"`
+		} `json:"courses"`
+	}
+	if err := json.Unmarshal([]byte(jsonContent), &rawData); err != nil {
+		return nil, fmt.Errorf("failed to parse extraction response: %w", err)
+	}
+
+	data := &domain.ExtractedTranscriptData{
+		HolderName:     optionalString(rawData.HolderName),
+		Institution:    rawData.Institution,
+		Degree:         optionalString(rawData.Degree),
+		Field:          optionalString(rawData.Field),
+		StartDate:      rawData.StartDate,
+		GraduationDate: rawData.GraduationDate,
+		GPA:            optionalString(rawData.GPA),
+		Honors:         optionalString(rawData.Honors),
+		Courses:        make([]domain.ExtractedCourse, 0, len(rawData.Courses)),
+		Metadata:       extractionMetadata(resp, domain.TranscriptExtractionPromptVersion, startTime),
+	}
+	for _, c := range rawData.Courses {
+		data.Courses = append(data.Courses, domain.ExtractedCourse{
+			Name:    c.Name,
+			Grade:   optionalString(c.Grade),
+			Credits: optionalString(c.Credits),
+		})
+	}
+
+	validator := NewExtractedDataValidator()
+	if err := validator.ValidateTranscriptData(data); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	return data, nil
+}
+
+// ExtractPerformanceReviewData implements domain.DocumentExtractor interface.
+// It extracts the reviewer and review statements from a performance review using LLM with structured output.
+func (e *DocumentExtractor) ExtractPerformanceReviewData(ctx context.Context, text string) (*domain.ExtractedPerformanceReviewData, error) {
+	startTime := time.Now()
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "performance_review_data_extraction",
+		otelTrace.WithAttributes(
+			attribute.Int("text_length", len(text)),
+		),
+	)
+	defer span.End()
+
+	jsonContent, resp, err := e.structuredCompletion(ctx, "performance_review_extraction",
+		performanceReviewExtractionSystemPrompt, performanceReviewUserTmpl, performanceReviewOutputSchema, text)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawData struct {
+		Reviewer struct {
+			Name         string `json:"name"`
+			Title        string `json:"title"`
+			Company      string `json:"company"`
+			Relationship string `json:"relationship"`
+		} `json:"reviewer"`
+		Company      string `json:"company"`
+		Role         string `json:"role"`
+		ReviewPeriod string `json:"reviewPeriod"`
+		Rating       string `json:"rating"`
+		Testimonials []struct {
+			Quote           string   `json:"quote"`
+			SkillsMentioned []string `json:"skillsMentioned"`
+		} `json:"testimonials"`
+	}
+	if err := json.Unmarshal([]byte(jsonContent), &rawData); err != nil {
+		return nil, fmt.Errorf("failed to parse extraction response: %w", err)
+	}
+
+	data := &domain.ExtractedPerformanceReviewData{
+		Reviewer: domain.ExtractedAuthor{
+			Name:         rawData.Reviewer.Name,
+			Title:        optionalString(rawData.Reviewer.Title),
+			Company:      optionalString(rawData.Reviewer.Company),
+			Relationship: domain.AuthorRelationship(rawData.Reviewer.Relationship),
+		},
+		Company:      optionalString(rawData.Company),
+		Role:         optionalString(rawData.Role),
+		ReviewPeriod: optionalString(rawData.ReviewPeriod),
+		Rating:       optionalString(rawData.Rating),
+		Testimonials: make([]domain.ExtractedTestimonial, 0, len(rawData.Testimonials)),
+		Metadata:     extractionMetadata(resp, domain.PerformanceReviewExtractionPromptVersion, startTime),
+	}
+	// The reviewer's organization is usually the employer under review
+	if data.Reviewer.Company == nil {
+		data.Reviewer.Company = data.Company
+	}
+	for _, t := range rawData.Testimonials {
+		data.Testimonials = append(data.Testimonials, domain.ExtractedTestimonial{
+			Quote:           t.Quote,
+			SkillsMentioned: t.SkillsMentioned,
+		})
+	}
+
+	validator := NewExtractedDataValidator()
+	if err := validator.ValidatePerformanceReviewData(data); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	return data, nil
+}
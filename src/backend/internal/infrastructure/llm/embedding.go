@@ -0,0 +1,313 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+)
+
+const (
+	defaultOpenAIEmbeddingModel = "text-embedding-3-small"
+	defaultLocalEmbeddingURL    = "http://localhost:11434"
+	defaultLocalEmbeddingModel  = "nomic-embed-text"
+	defaultEmbeddingTimeout     = 60 * time.Second
+	defaultFakeDimensions       = 256
+
+	// defaultMatchThreshold is the match threshold of models without a known one. It errs
+	// on the side of not matching.
+	defaultMatchThreshold = 0.7
+	fakeMatchThreshold    = 0.5
+)
+
+// modelMatchThresholds holds the match threshold of known models. OpenAI's
+// text-embedding-3 models score unrelated texts low, around 0.1 to 0.3, while older and
+// local models rarely score any two texts below 0.5.
+var modelMatchThresholds = map[string]float64{
+	"text-embedding-3-small": 0.5,
+	"text-embedding-3-large": 0.45,
+	"text-embedding-ada-002": 0.85,
+	"nomic-embed-text":       0.7,
+	"mxbai-embed-large":      0.75,
+	"all-minilm":             0.6,
+}
+
+// matchThreshold returns the configured threshold, or the model's if none is configured.
+func matchThreshold(configured float64, model string) float64 {
+	if configured > 0 {
+		return configured
+	}
+	if threshold, ok := modelMatchThresholds[strings.TrimSuffix(model, ":latest")]; ok {
+		return threshold
+	}
+	return defaultMatchThreshold
+}
+
+// OpenAIEmbedderConfig holds configuration for the OpenAI embedder.
+type OpenAIEmbedderConfig struct {
+	HTTPClient *http.Client
+	APIKey     string
+	BaseURL    string
+	Model      string
+	Timeout    time.Duration
+	// MatchThreshold overrides the model's match threshold (see domain.Embedder).
+	MatchThreshold float64
+}
+
+// OpenAIEmbedder implements domain.Embedder with OpenAI's embeddings API.
+type OpenAIEmbedder struct {
+	config OpenAIEmbedderConfig
+	client openai.Client
+}
+
+// NewOpenAIEmbedder creates a new OpenAI embedder.
+func NewOpenAIEmbedder(config OpenAIEmbedderConfig) *OpenAIEmbedder {
+	if config.Model == "" {
+		config.Model = defaultOpenAIEmbeddingModel
+	}
+	if config.Timeout == 0 {
+		config.Timeout = defaultEmbeddingTimeout
+	}
+
+	opts := []option.RequestOption{
+		option.WithAPIKey(config.APIKey),
+		option.WithRequestTimeout(config.Timeout),
+	}
+	if config.BaseURL != "" {
+		opts = append(opts, option.WithBaseURL(config.BaseURL))
+	}
+	if config.HTTPClient != nil {
+		opts = append(opts, option.WithHTTPClient(config.HTTPClient))
+	}
+
+	return &OpenAIEmbedder{
+		config: config,
+		client: openai.NewClient(opts...),
+	}
+}
+
+// Name returns the embedder name.
+func (e *OpenAIEmbedder) Name() string {
+	return "openai/" + e.config.Model
+}
+
+// MatchThreshold returns the configured match threshold or the model's.
+func (e *OpenAIEmbedder) MatchThreshold() float64 {
+	return matchThreshold(e.config.MatchThreshold, e.config.Model)
+}
+
+// Embed sends the texts to the OpenAI embeddings API in one request.
+func (e *OpenAIEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return [][]float32{}, nil
+	}
+
+	resp, err := e.client.Embeddings.New(ctx, openai.EmbeddingNewParams{
+		Input: openai.EmbeddingNewParamsInputUnion{OfArrayOfStrings: texts},
+		Model: openai.EmbeddingModel(e.config.Model),
+	})
+	if err != nil {
+		return nil, convertEmbeddingError("openai", err)
+	}
+	if len(resp.Data) != len(texts) {
+		return nil, &domain.LLMError{
+			Provider: "openai",
+			Message:  fmt.Sprintf("expected %d embeddings, got %d", len(texts), len(resp.Data)),
+		}
+	}
+
+	vectors := make([][]float32, len(texts))
+	for _, d := range resp.Data {
+		if d.Index < 0 || int(d.Index) >= len(texts) {
+			return nil, &domain.LLMError{Provider: "openai", Message: fmt.Sprintf("embedding index %d out of range", d.Index)}
+		}
+		vectors[d.Index] = toFloat32(d.Embedding)
+	}
+	return vectors, nil
+}
+
+// LocalEmbedderConfig holds configuration for an embedder served by a local model runner.
+type LocalEmbedderConfig struct {
+	HTTPClient *http.Client
+	// BaseURL is the address of an Ollama-compatible server. Defaults to http://localhost:11434.
+	BaseURL string
+	Model   string
+	Timeout time.Duration
+	// MatchThreshold overrides the model's match threshold (see domain.Embedder).
+	MatchThreshold float64
+}
+
+// LocalEmbedder implements domain.Embedder with a locally served model through the
+// Ollama embed API (POST /api/embed), so evidence never leaves the machine.
+type LocalEmbedder struct {
+	config LocalEmbedderConfig
+	client *http.Client
+}
+
+// NewLocalEmbedder creates a new local embedder.
+func NewLocalEmbedder(config LocalEmbedderConfig) *LocalEmbedder {
+	if config.BaseURL == "" {
+		config.BaseURL = defaultLocalEmbeddingURL
+	}
+	if config.Model == "" {
+		config.Model = defaultLocalEmbeddingModel
+	}
+	if config.Timeout == 0 {
+		config.Timeout = defaultEmbeddingTimeout
+	}
+	client := config.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: config.Timeout}
+	}
+	return &LocalEmbedder{config: config, client: client}
+}
+
+// Name returns the embedder name.
+func (e *LocalEmbedder) Name() string {
+	return "local/" + e.config.Model
+}
+
+// MatchThreshold returns the configured match threshold or the model's.
+func (e *LocalEmbedder) MatchThreshold() float64 {
+	return matchThreshold(e.config.MatchThreshold, e.config.Model)
+}
+
+// Embed sends the texts to the local model server in one request.
+func (e *LocalEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return [][]float32{}, nil
+	}
+
+	body, err := json.Marshal(map[string]any{"model": e.config.Model, "input": texts})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode embed request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(e.config.BaseURL, "/")+"/api/embed", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create embed request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, convertEmbeddingError("local", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, &domain.LLMError{
+			Provider:  "local",
+			Code:      resp.Status,
+			Message:   strings.TrimSpace(string(msg)),
+			Retryable: resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500,
+		}
+	}
+
+	var result struct {
+		Embeddings [][]float32 `json:"embeddings"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse embed response: %w", err)
+	}
+	if len(result.Embeddings) != len(texts) {
+		return nil, &domain.LLMError{
+			Provider: "local",
+			Message:  fmt.Sprintf("expected %d embeddings, got %d", len(texts), len(result.Embeddings)),
+		}
+	}
+	return result.Embeddings, nil
+}
+
+// FakeEmbedder implements domain.Embedder without a model, for tests and for running
+// without an embedding provider. Each word of a text is hashed to one dimension, so texts
+// sharing words are similar and the same text always gets the same vector.
+type FakeEmbedder struct {
+	dimensions int
+}
+
+// NewFakeEmbedder creates a fake embedder producing vectors of the given length, 256 if
+// dimensions is not positive.
+func NewFakeEmbedder(dimensions int) *FakeEmbedder {
+	if dimensions <= 0 {
+		dimensions = defaultFakeDimensions
+	}
+	return &FakeEmbedder{dimensions: dimensions}
+}
+
+// Name returns the embedder name.
+func (e *FakeEmbedder) Name() string {
+	return fmt.Sprintf("fake/hash-%d", e.dimensions)
+}
+
+// MatchThreshold returns 0.5: texts sharing half their words match.
+func (e *FakeEmbedder) MatchThreshold() float64 {
+	return fakeMatchThreshold
+}
+
+// Embed returns the normalized word-count vector of each text.
+func (e *FakeEmbedder) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vector := make([]float32, e.dimensions)
+		for _, token := range normalize.Tokens(text) {
+			h := fnv.New32a()
+			_, _ = h.Write([]byte(token))
+			vector[h.Sum32()%uint32(e.dimensions)]++ //nolint:gosec // dimensions is positive
+		}
+		var norm float64
+		for _, x := range vector {
+			norm += float64(x) * float64(x)
+		}
+		if norm > 0 {
+			scale := float32(1 / math.Sqrt(norm))
+			for j := range vector {
+				vector[j] *= scale
+			}
+		}
+		vectors[i] = vector
+	}
+	return vectors, nil
+}
+
+// toFloat32 narrows an embedding to the precision it is stored in.
+func toFloat32(values []float64) []float32 {
+	result := make([]float32, len(values))
+	for i, v := range values {
+		result[i] = float32(v)
+	}
+	return result
+}
+
+// convertEmbeddingError wraps an embedder request error as a domain.LLMError.
+func convertEmbeddingError(provider string, err error) error {
+	retryable := true
+	if apiErr, ok := err.(*openai.Error); ok {
+		retryable = apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+	return &domain.LLMError{
+		Provider:  provider,
+		Message:   err.Error(),
+		Retryable: retryable,
+		Err:       err,
+	}
+}
+
+// Compile-time checks that the embedders implement domain.Embedder.
+var (
+	_ domain.Embedder = (*OpenAIEmbedder)(nil)
+	_ domain.Embedder = (*LocalEmbedder)(nil)
+	_ domain.Embedder = (*FakeEmbedder)(nil)
+)
@@ -0,0 +1,154 @@
+//nolint:errcheck,revive // Test file - error checks and unused params are OK in test helpers
+package llm_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backend/internal/domain"
+	"backend/internal/infrastructure/llm"
+)
+
+func TestOpenAIEmbedder_Embed(t *testing.T) {
+	var receivedReq map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/embeddings" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &receivedReq)
+
+		// Embeddings may come back in any order; index says which input each belongs to.
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"object": "list",
+			"model": "text-embedding-3-small",
+			"data": [
+				{"object": "embedding", "index": 1, "embedding": [0, 1]},
+				{"object": "embedding", "index": 0, "embedding": [1, 0]}
+			],
+			"usage": {"prompt_tokens": 4, "total_tokens": 4}
+		}`))
+	}))
+	defer server.Close()
+
+	embedder := llm.NewOpenAIEmbedder(llm.OpenAIEmbedderConfig{APIKey: "test-key", BaseURL: server.URL + "/v1"})
+	if got := embedder.Name(); got != "openai/text-embedding-3-small" {
+		t.Errorf("Name() = %q", got)
+	}
+
+	vectors, err := embedder.Embed(context.Background(), []string{"first", "second"})
+	if err != nil {
+		t.Fatalf("Embed() error = %v", err)
+	}
+	if receivedReq["model"] != "text-embedding-3-small" {
+		t.Errorf("expected the default model, got %v", receivedReq["model"])
+	}
+	if len(vectors) != 2 || vectors[0][0] != 1 || vectors[1][1] != 1 {
+		t.Errorf("expected the vectors in input order, got %v", vectors)
+	}
+}
+
+func TestLocalEmbedder_Embed(t *testing.T) {
+	var receivedReq struct {
+		Model string   `json:"model"`
+		Input []string `json:"input"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/embed" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&receivedReq)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"model": "nomic-embed-text", "embeddings": [[0.5, 0.5], [1, 0]]}`))
+	}))
+	defer server.Close()
+
+	embedder := llm.NewLocalEmbedder(llm.LocalEmbedderConfig{BaseURL: server.URL})
+	if got := embedder.Name(); got != "local/nomic-embed-text" {
+		t.Errorf("Name() = %q", got)
+	}
+
+	vectors, err := embedder.Embed(context.Background(), []string{"first", "second"})
+	if err != nil {
+		t.Fatalf("Embed() error = %v", err)
+	}
+	if receivedReq.Model != "nomic-embed-text" || len(receivedReq.Input) != 2 {
+		t.Errorf("unexpected request %+v", receivedReq)
+	}
+	if len(vectors) != 2 || vectors[1][0] != 1 {
+		t.Errorf("unexpected vectors %v", vectors)
+	}
+}
+
+func TestLocalEmbedder_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "model not found", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := llm.NewLocalEmbedder(llm.LocalEmbedderConfig{BaseURL: server.URL}).Embed(context.Background(), []string{"text"})
+	var llmErr *domain.LLMError
+	if !errors.As(err, &llmErr) || llmErr.Retryable {
+		t.Errorf("expected a non-retryable LLMError, got %v", err)
+	}
+}
+
+func TestFakeEmbedder_Embed(t *testing.T) {
+	embedder := llm.NewFakeEmbedder(0)
+	vectors, err := embedder.Embed(context.Background(), []string{
+		"Kubernetes clusters in production",
+		"Kubernetes clusters in production",
+		"Kubernetes in production",
+		"",
+	})
+	if err != nil {
+		t.Fatalf("Embed() error = %v", err)
+	}
+
+	cosine := func(a, b []float32) float64 {
+		var dot float64
+		for i := range a {
+			dot += float64(a[i]) * float64(b[i])
+		}
+		return dot
+	}
+	if len(vectors[0]) != 256 || math.Abs(cosine(vectors[0], vectors[1])-1) > 1e-6 {
+		t.Errorf("expected the same text to get the same unit vector")
+	}
+	if sim := cosine(vectors[0], vectors[2]); sim < 0.8 || sim > 0.9 {
+		t.Errorf("expected texts sharing 3 of 4 words to be similar, got %f", sim)
+	}
+	if cosine(vectors[3], vectors[3]) != 0 {
+		t.Errorf("expected an empty text to get the zero vector")
+	}
+}
+
+func TestEmbedder_MatchThreshold(t *testing.T) {
+	tests := []struct {
+		name     string
+		embedder interface{ MatchThreshold() float64 }
+		want     float64
+	}{
+		{"openai default model", llm.NewOpenAIEmbedder(llm.OpenAIEmbedderConfig{}), 0.5},
+		{"openai large model", llm.NewOpenAIEmbedder(llm.OpenAIEmbedderConfig{Model: "text-embedding-3-large"}), 0.45},
+		{"local default model", llm.NewLocalEmbedder(llm.LocalEmbedderConfig{}), 0.7},
+		{"local tagged model", llm.NewLocalEmbedder(llm.LocalEmbedderConfig{Model: "nomic-embed-text:latest"}), 0.7},
+		{"unknown model", llm.NewLocalEmbedder(llm.LocalEmbedderConfig{Model: "some-new-model"}), 0.7},
+		{"configured", llm.NewOpenAIEmbedder(llm.OpenAIEmbedderConfig{MatchThreshold: 0.62}), 0.62},
+		{"fake", llm.NewFakeEmbedder(0), 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.embedder.MatchThreshold(); got != tt.want {
+				t.Errorf("MatchThreshold() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -100,6 +100,11 @@ type DocumentExtractorConfig struct { //nolint:govet // Field order prioritizes
 	// If nil or empty, falls back to ResumeExtractionChain, then the default provider.
 	DetectionChain ProviderChain
 
+	// CredentialExtractionChain specifies the provider chain for certificate, transcript and
+	// performance review extraction.
+	// If nil or empty, falls back to ResumeExtractionChain, then the default provider.
+	CredentialExtractionChain ProviderChain
+
 	// Logger for logging chain fallback events. If nil, fallbacks are silent.
 	Logger logger.Logger
 }
@@ -152,13 +157,19 @@ func NewDocumentExtractor(provider domain.LLMProvider, config DocumentExtractorC
 // If the chain is configured and registry is available, returns a chained provider.
 // Otherwise, falls back to the default provider.
 func (e *DocumentExtractor) getProviderForChain(chain ProviderChain) domain.LLMProvider {
-	if len(chain) > 0 && e.config.ProviderRegistry != nil {
-		chained, err := NewChainedProvider(e.config.ProviderRegistry, chain)
+	return providerForChain(e.config.ProviderRegistry, chain, e.defaultProvider, e.config.Logger)
+}
+
+// providerForChain returns a chained provider for the chain from the registry, or the
+// fallback provider if the chain is empty, there is no registry or the chain cannot be built.
+func providerForChain(registry *ProviderRegistry, chain ProviderChain, fallback domain.LLMProvider, log logger.Logger) domain.LLMProvider {
+	if len(chain) > 0 && registry != nil {
+		chained, err := NewChainedProvider(registry, chain)
 		if err == nil {
 			return chained
 		}
-		if e.config.Logger != nil {
-			e.config.Logger.Warning("Provider chain creation failed, falling back to default provider",
+		if log != nil {
+			log.Warning("Provider chain creation failed, falling back to default provider",
 				logger.Feature("llm"),
 				logger.String("chain_provider", chain.Primary().Provider),
 				logger.String("chain_model", chain.Primary().Model),
@@ -166,7 +177,7 @@ func (e *DocumentExtractor) getProviderForChain(chain ProviderChain) domain.LLMP
 			)
 		}
 	}
-	return e.defaultProvider
+	return fallback
 }
 
 // ExtractTextWithRequest extracts text from a document image or PDF using a detailed request.
@@ -344,10 +355,29 @@ var resumeOutputSchema = map[string]any{
 					},
 					"description": map[string]any{
 						"type":        "string",
-						"description": "Job description or responsibilities",
+						"description": "Prose describing the role, without its bullet points",
+					},
+					"highlights": map[string]any{
+						"type":        "array",
+						"description": "Bullet points of the role such as achievements, one entry per bullet, in resume order",
+						"items": map[string]any{
+							"type":                 "object",
+							"additionalProperties": false,
+							"properties": map[string]any{
+								"text": map[string]any{
+									"type":        "string",
+									"description": "Bullet text without the bullet marker",
+								},
+								"quantified": map[string]any{
+									"type":        "boolean",
+									"description": "True if the bullet states a measurable result with a number, percentage or amount of money",
+								},
+							},
+							"required": []string{"text", "quantified"},
+						},
 					},
 				},
-				"required": []string{"company", "title", "location", "startDate", "endDate", "isCurrent", "description"},
+				"required": []string{"company", "title", "location", "startDate", "endDate", "isCurrent", "description", "highlights"},
 			},
 		},
 		"education": map[string]any{
@@ -396,12 +426,191 @@ var resumeOutputSchema = map[string]any{
 				"type": "string",
 			},
 		},
+		"links": map[string]any{
+			"type":        "array",
+			"description": "The candidate's profile URLs (GitHub, LinkedIn, portfolio, personal website)",
+			"items": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"kind": map[string]any{
+						"type":        "string",
+						"description": "What the link points to",
+						"enum":        []string{"github", "linkedin", "portfolio", "website", "other"},
+					},
+					"url": map[string]any{
+						"type":        "string",
+						"description": "Full URL including https://",
+					},
+					"label": map[string]any{
+						"type":        "string",
+						"description": "Link text if it differs from the URL",
+					},
+				},
+				"required": []string{"kind", "url", "label"},
+			},
+		},
+		"certifications": map[string]any{
+			"type":        "array",
+			"description": "Certifications and licenses",
+			"items": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Certification name (e.g., 'AWS Certified Solutions Architect')",
+					},
+					"issuer": map[string]any{
+						"type":        "string",
+						"description": "Issuing organization",
+					},
+					"credentialId": map[string]any{
+						"type":        "string",
+						"description": "Credential or license number if stated",
+					},
+					"verificationUrl": map[string]any{
+						"type":        "string",
+						"description": "URL to verify the credential if stated",
+					},
+					"issueDate": map[string]any{
+						"type":        "string",
+						"description": "Issue date in ISO format YYYY-MM-DD. Use 01 for unknown day/month. Return null if unknown.",
+					},
+					"expiryDate": map[string]any{
+						"type":        "string",
+						"description": "Expiry date in ISO format YYYY-MM-DD. Return null if it does not expire or is unknown.",
+					},
+				},
+				"required": []string{"name", "issuer", "credentialId", "verificationUrl", "issueDate", "expiryDate"},
+			},
+		},
+		"languages": map[string]any{
+			"type":        "array",
+			"description": "Spoken languages (not programming languages)",
+			"items": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"language": map[string]any{
+						"type":        "string",
+						"description": "Language name in English (e.g., 'German')",
+					},
+					"proficiency": map[string]any{
+						"type":        "string",
+						"description": "CEFR level, or native. Null if no level is stated.",
+						"enum":        []string{"a1", "a2", "b1", "b2", "c1", "c2", "native"},
+					},
+				},
+				"required": []string{"language", "proficiency"},
+			},
+		},
+		"projects": map[string]any{
+			"type":        "array",
+			"description": "Projects listed separately from work experience",
+			"items": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Project name",
+					},
+					"role": map[string]any{
+						"type":        "string",
+						"description": "The candidate's role in the project",
+					},
+					"description": map[string]any{
+						"type":        "string",
+						"description": "What the project is and what the candidate did",
+					},
+					"url": map[string]any{
+						"type":        "string",
+						"description": "Project URL if stated",
+					},
+					"startDate": map[string]any{
+						"type":        "string",
+						"description": "Start date in ISO format YYYY-MM-DD. Return null if unknown.",
+					},
+					"endDate": map[string]any{
+						"type":        "string",
+						"description": "End date in ISO format YYYY-MM-DD. Return null if ongoing or unknown.",
+					},
+					"technologies": map[string]any{
+						"type":        "array",
+						"description": "Technologies used",
+						"items": map[string]any{
+							"type": "string",
+						},
+					},
+				},
+				"required": []string{"name", "role", "description", "url", "startDate", "endDate", "technologies"},
+			},
+		},
+		"publications": map[string]any{
+			"type":        "array",
+			"description": "Papers, articles, books and talks",
+			"items": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"title": map[string]any{
+						"type":        "string",
+						"description": "Publication title",
+					},
+					"publisher": map[string]any{
+						"type":        "string",
+						"description": "Journal, conference, publisher or venue",
+					},
+					"date": map[string]any{
+						"type":        "string",
+						"description": "Publication date in ISO format YYYY-MM-DD. Return null if unknown.",
+					},
+					"url": map[string]any{
+						"type":        "string",
+						"description": "URL or DOI link if stated",
+					},
+					"description": map[string]any{
+						"type":        "string",
+						"description": "Short description or co-authors if stated",
+					},
+				},
+				"required": []string{"title", "publisher", "date", "url", "description"},
+			},
+		},
+		"awards": map[string]any{
+			"type":        "array",
+			"description": "Awards, honors and prizes not tied to a degree",
+			"items": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"title": map[string]any{
+						"type":        "string",
+						"description": "Award name",
+					},
+					"issuer": map[string]any{
+						"type":        "string",
+						"description": "Organization that gave the award",
+					},
+					"date": map[string]any{
+						"type":        "string",
+						"description": "Award date in ISO format YYYY-MM-DD. Return null if unknown.",
+					},
+					"description": map[string]any{
+						"type":        "string",
+						"description": "What the award was for",
+					},
+				},
+				"required": []string{"title", "issuer", "date", "description"},
+			},
+		},
 		"confidence": map[string]any{
 			"type":        "number",
 			"description": "Confidence in extraction accuracy (0.0 to 1.0)",
 		},
 	},
-	"required": []string{"name", "email", "phone", "location", "summary", "experience", "education", "skills", "confidence"},
+	"required": []string{"name", "email", "phone", "location", "summary", "experience", "education", "skills", "links", "certifications", "languages", "projects", "publications", "awards", "confidence"},
 }
 
 // stripMarkdownCodeBlock removes markdown code block delimiters from LLM responses.
@@ -517,6 +726,24 @@ func (e *DocumentExtractor) ExtractResumeData(ctx context.Context, text string)
 	if data.Skills == nil {
 		data.Skills = []string{}
 	}
+	if data.Links == nil {
+		data.Links = []domain.ResumeLink{}
+	}
+	if data.Certifications == nil {
+		data.Certifications = []domain.Certification{}
+	}
+	if data.Languages == nil {
+		data.Languages = []domain.SpokenLanguage{}
+	}
+	if data.Projects == nil {
+		data.Projects = []domain.Project{}
+	}
+	if data.Publications == nil {
+		data.Publications = []domain.Publication{}
+	}
+	if data.Awards == nil {
+		data.Awards = []domain.Award{}
+	}
 
 	// Validate and sanitize extracted data before returning
 	validator := NewExtractedDataValidator()
@@ -624,8 +851,16 @@ var letterOutputSchema = map[string]any{
 						"type":        "string",
 						"description": "Sentence(s) discussing this role/company",
 					},
+					"startDate": map[string]any{
+						"type":        "string",
+						"description": "When the candidate started this role, as the letter states it (e.g. '2018' or 'March 2019'). Empty string if the letter does not say.",
+					},
+					"endDate": map[string]any{
+						"type":        "string",
+						"description": "When the candidate left this role, as the letter states it, or 'present' if they still hold it. Empty string if the letter does not say.",
+					},
 				},
-				"required": []string{"company", "role", "quote"},
+				"required": []string{"company", "role", "quote", "startDate", "endDate"},
 			},
 		},
 		"discoveredSkills": map[string]any{
@@ -759,9 +994,11 @@ func (e *DocumentExtractor) ExtractLetterData(ctx context.Context, text string,
 			Context string `json:"context"`
 		} `json:"skillMentions"`
 		ExperienceMentions []struct {
-			Company string `json:"company"`
-			Role    string `json:"role"`
-			Quote   string `json:"quote"`
+			Company   string `json:"company"`
+			Role      string `json:"role"`
+			Quote     string `json:"quote"`
+			StartDate string `json:"startDate"`
+			EndDate   string `json:"endDate"`
 		} `json:"experienceMentions"`
 		DiscoveredSkills []struct {
 			Skill    string `json:"skill"`
@@ -817,11 +1054,18 @@ func (e *DocumentExtractor) ExtractLetterData(ctx context.Context, text string,
 
 	// Convert experience mentions
 	for _, e := range rawData.ExperienceMentions {
-		data.ExperienceMentions = append(data.ExperienceMentions, domain.ExtractedExperienceMention{
+		mention := domain.ExtractedExperienceMention{
 			Company: e.Company,
 			Role:    e.Role,
 			Quote:   e.Quote,
-		})
+		}
+		if e.StartDate != "" {
+			mention.StartDate = &e.StartDate
+		}
+		if e.EndDate != "" {
+			mention.EndDate = &e.EndDate
+		}
+		data.ExperienceMentions = append(data.ExperienceMentions, mention)
 	}
 
 	// Convert discovered skills
@@ -900,8 +1144,8 @@ var detectionOutputSchema = map[string]any{
 		},
 		"documentTypeHint": map[string]any{
 			"type":        "string",
-			"description": "Document type: resume, reference_letter, hybrid, or unknown",
-			"enum":        []string{"resume", "reference_letter", "hybrid", "unknown"},
+			"description": "Document type: resume, reference_letter, hybrid, certificate, transcript, performance_review, or unknown",
+			"enum":        []string{"resume", "reference_letter", "hybrid", "certificate", "transcript", "performance_review", "unknown"},
 		},
 	},
 	"required": []string{"hasCareerInfo", "hasTestimonial", "testimonialAuthor", "confidence", "summary", "documentTypeHint"},
@@ -218,7 +218,9 @@ func TestDocumentExtractor_ExtractLetterData(t *testing.T) {
 			{
 				"company": "Acme Corp",
 				"role": "Senior Engineer",
-				"quote": "During her time as Senior Engineer at Acme Corp, Jane..."
+				"quote": "During her time as Senior Engineer at Acme Corp, Jane...",
+				"startDate": "2018",
+				"endDate": ""
 			}
 		],
 		"discoveredSkills": [
@@ -306,6 +308,12 @@ func TestDocumentExtractor_ExtractLetterData(t *testing.T) {
 	if result.ExperienceMentions[0].Role != "Senior Engineer" {
 		t.Errorf("ExperienceMentions[0].Role = %q, want %q", result.ExperienceMentions[0].Role, "Senior Engineer")
 	}
+	if result.ExperienceMentions[0].StartDate == nil || *result.ExperienceMentions[0].StartDate != "2018" {
+		t.Errorf("ExperienceMentions[0].StartDate = %v, want %q", result.ExperienceMentions[0].StartDate, "2018")
+	}
+	if result.ExperienceMentions[0].EndDate != nil {
+		t.Errorf("ExperienceMentions[0].EndDate = %v, want nil", *result.ExperienceMentions[0].EndDate)
+	}
 
 	// Verify discovered skills (now objects with skill, quote, context)
 	if len(result.DiscoveredSkills) != 3 {
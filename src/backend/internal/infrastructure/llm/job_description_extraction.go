@@ -0,0 +1,133 @@
+package llm
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	otelTrace "go.opentelemetry.io/otel/trace"
+
+	"backend/internal/domain"
+)
+
+// Job description extraction prompts
+//
+//go:embed prompts/job_description_extraction_system.txt
+var jobDescriptionExtractionSystemPrompt string
+
+//go:embed prompts/job_description_extraction_user.txt
+var jobDescriptionExtractionUserTemplate string
+
+var jobDescriptionUserTmpl = template.Must(template.New("job_description_user").Parse(jobDescriptionExtractionUserTemplate))
+
+// jobDescriptionOutputSchema defines the JSON schema for structured job description extraction.
+var jobDescriptionOutputSchema = map[string]any{
+	"type":                 "object",
+	"additionalProperties": false,
+	"properties": map[string]any{
+		"title": map[string]any{
+			"type":        "string",
+			"description": "Job title as advertised",
+		},
+		"company": map[string]any{
+			"type":        "string",
+			"description": "Hiring company if named",
+		},
+		"seniority": map[string]any{
+			"type":        "string",
+			"description": "Level of the role, or an empty string if it cannot be determined",
+			"enum":        []string{"intern", "junior", "mid", "senior", "lead", "principal", "executive", ""},
+		},
+		"minYearsExperience": map[string]any{
+			"type":        "integer",
+			"description": "Minimum years of professional experience asked for, or 0 if not stated",
+		},
+		"domain": map[string]any{
+			"type":        "string",
+			"description": "Industry or business domain of the role in a few words",
+		},
+		"requiredSkills": map[string]any{
+			"type":        "array",
+			"description": "Skills the posting requires",
+			"items": map[string]any{
+				"type": "string",
+			},
+		},
+		"preferredSkills": map[string]any{
+			"type":        "array",
+			"description": "Skills the posting lists as a plus",
+			"items": map[string]any{
+				"type": "string",
+			},
+		},
+	},
+	"required": []string{"title", "company", "seniority", "minYearsExperience", "domain", "requiredSkills", "preferredSkills"},
+}
+
+// ExtractJobDescriptionData implements domain.DocumentExtractor interface.
+// It extracts the requirements of a job description from its text using LLM with structured output.
+func (e *DocumentExtractor) ExtractJobDescriptionData(ctx context.Context, text string) (*domain.ExtractedJobDescriptionData, error) {
+	startTime := time.Now()
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "job_description_data_extraction",
+		otelTrace.WithAttributes(
+			attribute.Int("text_length", len(text)),
+		),
+	)
+	defer span.End()
+
+	jsonContent, resp, err := e.structuredCompletion(ctx, "job_description_extraction",
+		jobDescriptionExtractionSystemPrompt, jobDescriptionUserTmpl, jobDescriptionOutputSchema, text)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawData struct { //nolint:govet // Field order matches JSON output for readability
+		Title              string   `json:"title"`
+		Company            string   `json:"company"`
+		Seniority          string   `json:"seniority"`
+		MinYearsExperience int      `json:"minYearsExperience"`
+		Domain             string   `json:"domain"`
+		RequiredSkills     []string `json:"requiredSkills"`
+		PreferredSkills    []string `json:"preferredSkills"`
+	}
+	if err := json.Unmarshal([]byte(jsonContent), &rawData); err != nil {
+		return nil, fmt.Errorf("failed to parse extraction response: %w", err)
+	}
+
+	data := &domain.ExtractedJobDescriptionData{
+		Title:           optionalString(rawData.Title),
+		Company:         optionalString(rawData.Company),
+		Domain:          optionalString(rawData.Domain),
+		RequiredSkills:  rawData.RequiredSkills,
+		PreferredSkills: rawData.PreferredSkills,
+		Metadata:        extractionMetadata(resp, domain.JobDescriptionExtractionPromptVersion, startTime),
+	}
+	if seniority := domain.JobSeniority(strings.ToLower(strings.TrimSpace(rawData.Seniority))); seniority != "" {
+		data.Seniority = &seniority
+	}
+	if rawData.MinYearsExperience > 0 {
+		data.MinYearsExperience = &rawData.MinYearsExperience
+	}
+	if data.RequiredSkills == nil {
+		data.RequiredSkills = []string{}
+	}
+	if data.PreferredSkills == nil {
+		data.PreferredSkills = []string{}
+	}
+
+	validator := NewExtractedDataValidator()
+	if err := validator.ValidateJobDescriptionData(data); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,535 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	otelTrace "go.opentelemetry.io/otel/trace"
+
+	"backend/internal/domain"
+	"backend/internal/logger"
+)
+
+// Profile writing prompts
+//
+//go:embed prompts/profile_writing_system.txt
+var profileWritingSystemPrompt string
+
+//go:embed prompts/profile_summary_user.txt
+var profileSummaryUserTemplate string
+
+//go:embed prompts/profile_highlights_user.txt
+var profileHighlightsUserTemplate string
+
+//go:embed prompts/profile_headline_user.txt
+var profileHeadlineUserTemplate string
+
+var profileSummaryUserTmpl = template.Must(template.New("profile_summary_user").Parse(profileSummaryUserTemplate))
+var profileHighlightsUserTmpl = template.Must(template.New("profile_highlights_user").Parse(profileHighlightsUserTemplate))
+var profileHeadlineUserTmpl = template.Must(template.New("profile_headline_user").Parse(profileHeadlineUserTemplate))
+
+// Cover letter prompts
+//
+//go:embed prompts/cover_letter_system.txt
+var coverLetterSystemPrompt string
+
+//go:embed prompts/cover_letter_user.txt
+var coverLetterUserTemplate string
+
+var coverLetterUserTmpl = template.Must(template.New("cover_letter_user").Parse(coverLetterUserTemplate))
+
+// Profile question answering prompts
+//
+//go:embed prompts/profile_qa_system.txt
+var profileQASystemPrompt string
+
+//go:embed prompts/profile_qa_user.txt
+var profileQAUserTemplate string
+
+var profileQAUserTmpl = template.Must(template.New("profile_qa_user").Parse(profileQAUserTemplate))
+
+// Limits on profile writing requests and drafts.
+const (
+	maxWritingFacts     = 200
+	maxHeadlineLength   = 200
+	maxHeadlineDrafts   = 3
+	maxWritingFactBytes = 4 * 1024
+	maxJobTextBytes     = 32 * 1024
+	maxEvidence         = 50
+	maxQuestionBytes    = 1024
+	maxAnswerLength     = 2000
+)
+
+// profileWritingOutputSchema defines the JSON schema for drafted profile text.
+var profileWritingOutputSchema = map[string]any{
+	"type":                 "object",
+	"additionalProperties": false,
+	"properties": map[string]any{
+		"drafts": map[string]any{
+			"type":        "array",
+			"description": "Drafted texts",
+			"items": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"text": map[string]any{
+						"type":        "string",
+						"description": "The drafted text",
+					},
+					"citations": map[string]any{
+						"type":        "array",
+						"description": "References of the facts the text draws on, e.g. \"F3\"",
+						"items": map[string]any{
+							"type": "string",
+						},
+					},
+				},
+				"required": []string{"text", "citations"},
+			},
+		},
+	},
+	"required": []string{"drafts"},
+}
+
+// coverLetterOutputSchema defines the JSON schema for a drafted cover letter.
+var coverLetterOutputSchema = map[string]any{
+	"type":                 "object",
+	"additionalProperties": false,
+	"properties": map[string]any{
+		"body": map[string]any{
+			"type":        "string",
+			"description": "The cover letter, paragraphs separated by blank lines",
+		},
+		"citations": map[string]any{
+			"type":        "array",
+			"description": "References of the facts the letter draws on, e.g. \"F3\"",
+			"items": map[string]any{
+				"type": "string",
+			},
+		},
+		"quotes": map[string]any{
+			"type":        "array",
+			"description": "Testimonial passages quoted in the letter",
+			"items": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"ref": map[string]any{
+						"type":        "string",
+						"description": "Reference of the testimonial fact the passage is copied from",
+					},
+					"text": map[string]any{
+						"type":        "string",
+						"description": "The quoted passage, without quotation marks",
+					},
+				},
+				"required": []string{"ref", "text"},
+			},
+		},
+	},
+	"required": []string{"body", "citations", "quotes"},
+}
+
+// profileAnswerOutputSchema defines the JSON schema for an answer to a question about a profile.
+var profileAnswerOutputSchema = map[string]any{
+	"type":                 "object",
+	"additionalProperties": false,
+	"properties": map[string]any{
+		"answer": map[string]any{
+			"type":        "string",
+			"description": "The answer, or a statement that the evidence does not answer the question",
+		},
+		"supported": map[string]any{
+			"type":        "boolean",
+			"description": "Whether the evidence answers the question",
+		},
+		"citations": map[string]any{
+			"type":        "array",
+			"description": "Evidence the answer rests on",
+			"items": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"ref": map[string]any{
+						"type":        "string",
+						"description": "Reference of the evidence, e.g. \"E3\"",
+					},
+					"quote": map[string]any{
+						"type":        "string",
+						"description": "The passage relied on, copied exactly from the evidence's quote",
+					},
+				},
+				"required": []string{"ref", "quote"},
+			},
+		},
+	},
+	"required": []string{"answer", "supported", "citations"},
+}
+
+// ProfileWriterConfig holds configuration for the profile writer.
+type ProfileWriterConfig struct { //nolint:govet // Field order prioritizes readability
+	// MaxTokens for drafting responses. Defaults to 4096.
+	MaxTokens int
+
+	// ProviderRegistry holds all available providers for chain-based access.
+	// If nil, falls back to the single provider passed to NewProfileWriter.
+	ProviderRegistry *ProviderRegistry
+
+	// WritingChain specifies the provider chain for drafting profile text and answering
+	// questions about a profile. If nil or empty, uses the default provider.
+	WritingChain ProviderChain
+
+	// CoverLetterChain specifies the provider chain for drafting cover letters.
+	// If nil or empty, uses the default provider.
+	CoverLetterChain ProviderChain
+
+	// Logger for logging chain fallback events. If nil, fallbacks are silent.
+	Logger logger.Logger
+}
+
+// WritingTemplateData holds the data for rendering the profile writing user prompts.
+// Facts are referenced as F1, F2, ... so the LLM cites short references rather than IDs.
+type WritingTemplateData struct {
+	Facts  []WritingFactLine
+	Target string
+}
+
+// CoverLetterTemplateData holds the data for rendering the cover letter user prompt.
+type CoverLetterTemplateData struct {
+	Title          string
+	Company        string
+	JobDescription string
+	Facts          []WritingFactLine
+}
+
+// WritingFactLine is one fact as shown in a profile writing prompt.
+type WritingFactLine struct {
+	Ref  string
+	Kind string
+	Text string
+}
+
+// ProfileQATemplateData holds the data for rendering the profile question answering user prompt.
+// Evidence is referenced as E1, E2, ... so the LLM cites short references rather than IDs.
+type ProfileQATemplateData struct {
+	Question string
+	Evidence []EvidenceLine
+}
+
+// EvidenceLine is one piece of evidence as shown in a profile question answering prompt.
+type EvidenceLine struct {
+	Ref     string
+	Kind    string
+	Context string
+	Quote   string
+}
+
+// ProfileWriter drafts profile text grounded in the profile's facts using LLM.
+type ProfileWriter struct {
+	defaultProvider domain.LLMProvider
+	config          ProfileWriterConfig
+}
+
+// NewProfileWriter creates a new profile writer.
+func NewProfileWriter(provider domain.LLMProvider, config ProfileWriterConfig) *ProfileWriter {
+	if config.MaxTokens == 0 {
+		config.MaxTokens = 4096
+	}
+	return &ProfileWriter{
+		defaultProvider: provider,
+		config:          config,
+	}
+}
+
+// DraftSummary implements domain.ProfileWriter interface.
+func (w *ProfileWriter) DraftSummary(ctx context.Context, facts []domain.WritingFact) (*domain.WritingDrafts, error) {
+	return w.draft(ctx, "profile_summary_writing", profileSummaryUserTmpl, facts, nil, 1, maxSummaryLength)
+}
+
+// RewriteHighlights implements domain.ProfileWriter interface.
+func (w *ProfileWriter) RewriteHighlights(ctx context.Context, experience domain.WritingFact, facts []domain.WritingFact) (*domain.WritingDrafts, error) {
+	return w.draft(ctx, "profile_highlights_writing", profileHighlightsUserTmpl, facts, &experience, maxHighlightCount, maxSummaryLength)
+}
+
+// SuggestHeadlines implements domain.ProfileWriter interface.
+func (w *ProfileWriter) SuggestHeadlines(ctx context.Context, facts []domain.WritingFact) (*domain.WritingDrafts, error) {
+	return w.draft(ctx, "profile_headline_writing", profileHeadlineUserTmpl, facts, nil, maxHeadlineDrafts, maxHeadlineLength)
+}
+
+// draft runs one profile writing request. The facts are numbered in the prompt and the
+// references the LLM cites mapped back to them; references to unknown facts are dropped.
+func (w *ProfileWriter) draft(
+	ctx context.Context,
+	operation string,
+	userTmpl *template.Template,
+	facts []domain.WritingFact,
+	target *domain.WritingFact,
+	maxDrafts int,
+	maxLen int,
+) (*domain.WritingDrafts, error) {
+	startTime := time.Now()
+	ctx, span := otel.Tracer(tracerName).Start(ctx, operation,
+		otelTrace.WithAttributes(
+			attribute.Int("fact_count", len(facts)),
+		),
+	)
+	defer span.End()
+
+	lines, byRef := numberFacts(facts)
+	data := WritingTemplateData{Facts: lines}
+	if target != nil {
+		for _, line := range lines {
+			if byRef[line.Ref].ID == target.ID {
+				data.Target = line.Ref
+			}
+		}
+		if data.Target == "" {
+			return nil, fmt.Errorf("experience %s is not among the facts", target.ID)
+		}
+	}
+
+	var userPromptBuf bytes.Buffer
+	if err := userTmpl.Execute(&userPromptBuf, data); err != nil {
+		return nil, fmt.Errorf("failed to render user prompt template: %w", err)
+	}
+
+	provider := providerForChain(w.config.ProviderRegistry, w.config.WritingChain, w.defaultProvider, w.config.Logger)
+	resp, err := provider.Complete(ctx, domain.LLMRequest{
+		SystemPrompt: profileWritingSystemPrompt,
+		Messages: []domain.Message{
+			domain.NewTextMessage(domain.RoleUser, userPromptBuf.String()),
+		},
+		MaxTokens:    w.config.MaxTokens,
+		OutputSchema: profileWritingOutputSchema,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("LLM writing failed: %w", err)
+	}
+
+	var rawData struct {
+		Drafts []struct {
+			Text      string   `json:"text"`
+			Citations []string `json:"citations"`
+		} `json:"drafts"`
+	}
+	if err := json.Unmarshal([]byte(fixTrailingCommas(stripMarkdownCodeBlock(resp.Content))), &rawData); err != nil {
+		return nil, fmt.Errorf("failed to parse writing response: %w", err)
+	}
+
+	drafts := &domain.WritingDrafts{
+		Drafts:   make([]domain.WritingDraft, 0, len(rawData.Drafts)),
+		Metadata: extractionMetadata(resp, domain.ProfileWritingPromptVersion, startTime),
+	}
+	for _, raw := range rawData.Drafts {
+		drafts.Drafts = append(drafts.Drafts, domain.WritingDraft{Text: raw.Text, Citations: citeFacts(raw.Citations, byRef)})
+	}
+
+	validator := NewExtractedDataValidator()
+	if err := validator.ValidateWritingDrafts(drafts, maxDrafts, maxLen); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	return drafts, nil
+}
+
+// DraftCoverLetter implements domain.ProfileWriter interface.
+func (w *ProfileWriter) DraftCoverLetter(ctx context.Context, job domain.CoverLetterJob, facts []domain.WritingFact) (*domain.CoverLetterDraft, error) {
+	startTime := time.Now()
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "cover_letter_writing",
+		otelTrace.WithAttributes(
+			attribute.Int("fact_count", len(facts)),
+			attribute.Int("job_text_length", len(job.Text)),
+		),
+	)
+	defer span.End()
+
+	lines, byRef := numberFacts(facts)
+	data := CoverLetterTemplateData{
+		JobDescription: truncateUTF8(job.Text, maxJobTextBytes),
+		Facts:          lines,
+	}
+	if job.Title != nil {
+		data.Title = *job.Title
+	}
+	if job.Company != nil {
+		data.Company = *job.Company
+	}
+
+	var userPromptBuf bytes.Buffer
+	if err := coverLetterUserTmpl.Execute(&userPromptBuf, data); err != nil {
+		return nil, fmt.Errorf("failed to render user prompt template: %w", err)
+	}
+
+	provider := providerForChain(w.config.ProviderRegistry, w.config.CoverLetterChain, w.defaultProvider, w.config.Logger)
+	resp, err := provider.Complete(ctx, domain.LLMRequest{
+		SystemPrompt: coverLetterSystemPrompt,
+		Messages: []domain.Message{
+			domain.NewTextMessage(domain.RoleUser, userPromptBuf.String()),
+		},
+		MaxTokens:    w.config.MaxTokens,
+		OutputSchema: coverLetterOutputSchema,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("LLM cover letter writing failed: %w", err)
+	}
+
+	var rawData struct {
+		Body      string   `json:"body"`
+		Citations []string `json:"citations"`
+		Quotes    []struct {
+			Ref  string `json:"ref"`
+			Text string `json:"text"`
+		} `json:"quotes"`
+	}
+	if err := json.Unmarshal([]byte(fixTrailingCommas(stripMarkdownCodeBlock(resp.Content))), &rawData); err != nil {
+		return nil, fmt.Errorf("failed to parse cover letter response: %w", err)
+	}
+
+	draft := &domain.CoverLetterDraft{
+		Body:      rawData.Body,
+		Citations: citeFacts(rawData.Citations, byRef),
+		Quotes:    []domain.CoverLetterQuote{},
+		Metadata:  extractionMetadata(resp, domain.CoverLetterPromptVersion, startTime),
+	}
+	for _, q := range rawData.Quotes {
+		if fact, ok := byRef[factRef(q.Ref)]; ok && fact.Kind == domain.WritingFactTestimonial {
+			draft.Quotes = append(draft.Quotes, domain.CoverLetterQuote{TestimonialID: fact.ID, Text: q.Text})
+		}
+	}
+
+	validator := NewExtractedDataValidator()
+	if err := validator.ValidateCoverLetterDraft(draft); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	return draft, nil
+}
+
+// AnswerQuestion implements domain.ProfileWriter interface.
+func (w *ProfileWriter) AnswerQuestion(ctx context.Context, question string, evidence []domain.Evidence) (*domain.ProfileAnswer, error) {
+	startTime := time.Now()
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "profile_question_answering",
+		otelTrace.WithAttributes(
+			attribute.Int("evidence_count", len(evidence)),
+		),
+	)
+	defer span.End()
+
+	evidence = truncateSlice(evidence, maxEvidence)
+	data := ProfileQATemplateData{
+		Question: truncateUTF8(question, maxQuestionBytes),
+		Evidence: make([]EvidenceLine, len(evidence)),
+	}
+	byRef := make(map[string]domain.Evidence, len(evidence))
+	for i, e := range evidence {
+		ref := "E" + strconv.Itoa(i+1)
+		byRef[ref] = e
+		data.Evidence[i] = EvidenceLine{
+			Ref:     ref,
+			Kind:    strings.ReplaceAll(string(e.Kind), "_", " "),
+			Context: e.Context,
+			Quote:   truncateUTF8(e.Quote, maxWritingFactBytes),
+		}
+	}
+
+	var userPromptBuf bytes.Buffer
+	if err := profileQAUserTmpl.Execute(&userPromptBuf, data); err != nil {
+		return nil, fmt.Errorf("failed to render user prompt template: %w", err)
+	}
+
+	provider := providerForChain(w.config.ProviderRegistry, w.config.WritingChain, w.defaultProvider, w.config.Logger)
+	resp, err := provider.Complete(ctx, domain.LLMRequest{
+		SystemPrompt: profileQASystemPrompt,
+		Messages: []domain.Message{
+			domain.NewTextMessage(domain.RoleUser, userPromptBuf.String()),
+		},
+		MaxTokens:    w.config.MaxTokens,
+		OutputSchema: profileAnswerOutputSchema,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("LLM question answering failed: %w", err)
+	}
+
+	var rawData struct {
+		Answer    string `json:"answer"`
+		Supported bool   `json:"supported"`
+		Citations []struct {
+			Ref   string `json:"ref"`
+			Quote string `json:"quote"`
+		} `json:"citations"`
+	}
+	if err := json.Unmarshal([]byte(fixTrailingCommas(stripMarkdownCodeBlock(resp.Content))), &rawData); err != nil {
+		return nil, fmt.Errorf("failed to parse answer response: %w", err)
+	}
+
+	answer := &domain.ProfileAnswer{
+		Answer:    rawData.Answer,
+		Supported: rawData.Supported,
+		Citations: []domain.EvidenceCitation{},
+		Metadata:  extractionMetadata(resp, domain.ProfileQAPromptVersion, startTime),
+	}
+	for _, c := range rawData.Citations {
+		if e, ok := byRef[factRef(c.Ref)]; ok {
+			answer.Citations = append(answer.Citations, domain.EvidenceCitation{Kind: e.Kind, ID: e.ID, Quote: c.Quote})
+		}
+	}
+
+	validator := NewExtractedDataValidator()
+	if err := validator.ValidateProfileAnswer(answer); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	return answer, nil
+}
+
+// numberFacts renders the facts as prompt lines referenced F1, F2, ... and maps each
+// reference back to its fact.
+func numberFacts(facts []domain.WritingFact) ([]WritingFactLine, map[string]domain.WritingFact) {
+	facts = truncateSlice(facts, maxWritingFacts)
+	lines := make([]WritingFactLine, len(facts))
+	byRef := make(map[string]domain.WritingFact, len(facts))
+	for i, fact := range facts {
+		ref := "F" + strconv.Itoa(i+1)
+		byRef[ref] = fact
+		lines[i] = WritingFactLine{Ref: ref, Kind: string(fact.Kind), Text: truncateUTF8(fact.Text, maxWritingFactBytes)}
+	}
+	return lines, byRef
+}
+
+// citeFacts maps the references the LLM cites to citations, dropping unknown references.
+func citeFacts(refs []string, byRef map[string]domain.WritingFact) []domain.WritingCitation {
+	citations := []domain.WritingCitation{}
+	for _, ref := range refs {
+		if fact, ok := byRef[factRef(ref)]; ok {
+			citations = append(citations, domain.WritingCitation{Kind: fact.Kind, ID: fact.ID})
+		}
+	}
+	return citations
+}
+
+// factRef normalizes a cited fact or evidence reference, so "[f3]" and " F3" both become "F3".
+func factRef(ref string) string {
+	return strings.ToUpper(strings.Trim(strings.TrimSpace(ref), "[]"))
+}
@@ -0,0 +1,170 @@
+package llm_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/infrastructure/llm"
+)
+
+// recordingProvider returns a fixed response and keeps the request it was sent.
+type recordingProvider struct {
+	mockProvider
+	request domain.LLMRequest
+}
+
+func (p *recordingProvider) Complete(ctx context.Context, req domain.LLMRequest) (*domain.LLMResponse, error) {
+	p.request = req
+	return p.mockProvider.Complete(ctx, req)
+}
+
+func TestProfileWriter_RewriteHighlights(t *testing.T) {
+	experience := domain.WritingFact{ID: uuid.New(), Kind: domain.WritingFactExperience, Text: "Backend Engineer at Acme. Highlights: Migrated billing to Go"}
+	skill := domain.WritingFact{ID: uuid.New(), Kind: domain.WritingFactSkill, Text: "Go"}
+	inner := &recordingProvider{mockProvider: mockProvider{
+		response: &domain.LLMResponse{
+			Content: "```json\n" + `{"drafts": [
+				{"text": "Migrated billing to Go", "citations": ["F1", "[f2]", "F1", "F9"]},
+				{"text": "Invented a new database", "citations": ["F7"]},
+			]}` + "\n```",
+			Model: "test-model",
+		},
+	}}
+
+	writer := llm.NewProfileWriter(inner, llm.ProfileWriterConfig{})
+	drafts, err := writer.RewriteHighlights(context.Background(), experience, []domain.WritingFact{experience, skill})
+	if err != nil {
+		t.Fatalf("RewriteHighlights() error = %v", err)
+	}
+
+	if !strings.Contains(inner.request.Messages[0].Content[0].Text, "[F1] (experience) Backend Engineer at Acme") ||
+		!strings.Contains(inner.request.Messages[0].Content[0].Text, "the experience F1") {
+		t.Errorf("expected the prompt to number the facts and target F1, got %q", inner.request.Messages[0].Content[0].Text)
+	}
+	if inner.request.OutputSchema == nil {
+		t.Error("expected a structured output schema")
+	}
+
+	// The draft citing only an unknown fact is dropped; the other keeps its known citations once.
+	if len(drafts.Drafts) != 1 {
+		t.Fatalf("expected 1 draft, got %+v", drafts.Drafts)
+	}
+	citations := drafts.Drafts[0].Citations
+	if len(citations) != 2 || citations[0].ID != experience.ID || citations[0].Kind != domain.WritingFactExperience ||
+		citations[1].ID != skill.ID || citations[1].Kind != domain.WritingFactSkill {
+		t.Errorf("expected citations of the experience and skill, got %+v", citations)
+	}
+	if drafts.Metadata.PromptVersion != domain.ProfileWritingPromptVersion || drafts.Metadata.ModelVersion != "test-model" {
+		t.Errorf("unexpected metadata %+v", drafts.Metadata)
+	}
+}
+
+func TestProfileWriter_RejectsUncitedDrafts(t *testing.T) {
+	fact := domain.WritingFact{ID: uuid.New(), Kind: domain.WritingFactSkill, Text: "Go"}
+	inner := &mockProvider{response: &domain.LLMResponse{Content: `{"drafts": [{"text": "Go expert", "citations": []}]}`}}
+
+	writer := llm.NewProfileWriter(inner, llm.ProfileWriterConfig{})
+	_, err := writer.SuggestHeadlines(context.Background(), []domain.WritingFact{fact})
+
+	var valErr *domain.ValidationError
+	if !errors.As(err, &valErr) || valErr.Field != "drafts" {
+		t.Fatalf("expected a drafts validation error, got %v", err)
+	}
+}
+
+func TestProfileWriter_DraftCoverLetter(t *testing.T) {
+	experience := domain.WritingFact{ID: uuid.New(), Kind: domain.WritingFactExperience, Text: "Platform Engineer at Acme."}
+	testimonial := domain.WritingFact{ID: uuid.New(), Kind: domain.WritingFactTestimonial, Text: "“Calm under pressure.” by Jane Doe (manager)"}
+	inner := &recordingProvider{mockProvider: mockProvider{
+		response: &domain.LLMResponse{
+			Content: `{
+				"body": "My former manager described me as \"Calm under pressure\".",
+				"citations": ["F1", "F2", "F2"],
+				"quotes": [{"ref": "F2", "text": "Calm under pressure"}, {"ref": "F1", "text": "Platform Engineer"}]
+			}`,
+			Model: "test-model",
+		},
+	}}
+
+	writer := llm.NewProfileWriter(inner, llm.ProfileWriterConfig{})
+	title := "Site Reliability Engineer"
+	draft, err := writer.DraftCoverLetter(context.Background(), domain.CoverLetterJob{Title: &title, Text: "We run Kubernetes at scale."}, []domain.WritingFact{experience, testimonial})
+	if err != nil {
+		t.Fatalf("DraftCoverLetter() error = %v", err)
+	}
+
+	prompt := inner.request.Messages[0].Content[0].Text
+	if !strings.Contains(prompt, "the Site Reliability Engineer role") || !strings.Contains(prompt, "We run Kubernetes at scale.") ||
+		!strings.Contains(prompt, "[F2] (testimonial)") {
+		t.Errorf("expected the prompt to hold the job and the numbered facts, got %q", prompt)
+	}
+
+	if len(draft.Citations) != 2 {
+		t.Errorf("expected 2 distinct citations, got %+v", draft.Citations)
+	}
+	// Quotes may only come from testimonial facts.
+	if len(draft.Quotes) != 1 || draft.Quotes[0].TestimonialID != testimonial.ID || draft.Quotes[0].Text != "Calm under pressure" {
+		t.Errorf("expected the testimonial quote only, got %+v", draft.Quotes)
+	}
+	if !strings.Contains(draft.Body, "&#34;Calm under pressure&#34;") {
+		t.Errorf("expected the body to be sanitized, got %q", draft.Body)
+	}
+	if draft.Metadata.PromptVersion != domain.CoverLetterPromptVersion {
+		t.Errorf("unexpected metadata %+v", draft.Metadata)
+	}
+}
+
+func TestProfileWriter_AnswerQuestion(t *testing.T) {
+	testimonial := domain.Evidence{ID: uuid.New(), Kind: domain.EvidenceTestimonial, Quote: "She ran our on-call rotation.", Context: "by Jane Doe, manager"}
+	validation := domain.Evidence{ID: uuid.New(), Kind: domain.EvidenceSkillValidation, Quote: "migrated us to Kubernetes", Context: "validates the skill Kubernetes"}
+	inner := &recordingProvider{mockProvider: mockProvider{
+		response: &domain.LLMResponse{
+			Content: `{
+				"answer": "Yes, a manager writes that she ran the on-call rotation.",
+				"supported": true,
+				"citations": [{"ref": "E1", "quote": "ran our on-call rotation"}, {"ref": "E9", "quote": "invented"}, {"ref": "E2", "quote": " "}]
+			}`,
+			Model: "test-model",
+		},
+	}}
+
+	writer := llm.NewProfileWriter(inner, llm.ProfileWriterConfig{})
+	answer, err := writer.AnswerQuestion(context.Background(), "Has she been on call?", []domain.Evidence{testimonial, validation})
+	if err != nil {
+		t.Fatalf("AnswerQuestion() error = %v", err)
+	}
+
+	prompt := inner.request.Messages[0].Content[0].Text
+	if !strings.Contains(prompt, "Has she been on call?") || !strings.Contains(prompt, "[E2] (skill validation, validates the skill Kubernetes)") {
+		t.Errorf("expected the prompt to hold the question and the numbered evidence, got %q", prompt)
+	}
+
+	// Unknown refs and empty quotes are dropped.
+	if !answer.Supported || len(answer.Citations) != 1 || answer.Citations[0].ID != testimonial.ID || answer.Citations[0].Kind != domain.EvidenceTestimonial {
+		t.Errorf("expected the testimonial citation only, got %+v", answer)
+	}
+	if answer.Metadata.PromptVersion != domain.ProfileQAPromptVersion {
+		t.Errorf("unexpected metadata %+v", answer.Metadata)
+	}
+}
+
+func TestProfileWriter_AnswerQuestionUnsupported(t *testing.T) {
+	evidence := domain.Evidence{ID: uuid.New(), Kind: domain.EvidenceTestimonial, Quote: "Great teammate."}
+	inner := &mockProvider{response: &domain.LLMResponse{
+		Content: `{"answer": "The evidence does not say.", "supported": false, "citations": [{"ref": "E1", "quote": "Great teammate."}]}`,
+	}}
+
+	writer := llm.NewProfileWriter(inner, llm.ProfileWriterConfig{})
+	answer, err := writer.AnswerQuestion(context.Background(), "Does she know Rust?", []domain.Evidence{evidence})
+	if err != nil {
+		t.Fatalf("AnswerQuestion() error = %v", err)
+	}
+	if answer.Supported || len(answer.Citations) != 0 {
+		t.Errorf("expected an unsupported answer without citations, got %+v", answer)
+	}
+}
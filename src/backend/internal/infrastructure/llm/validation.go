@@ -6,7 +6,10 @@ import (
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/google/uuid"
+
 	"backend/internal/domain"
+	"backend/internal/normalize"
 )
 
 // Field length limits to prevent database overflow and XSS/injection attacks.
@@ -17,15 +20,28 @@ const (
 	maxLocationLength    = 200
 	maxSummaryLength     = 2000
 	maxDescriptionLength = 5000
+	maxCoverLetterLength = 10000
 	maxQuoteLength       = 2000
 	maxSkillNameLength   = 100
 	maxCompanyLength     = 200
 	maxTitleLength       = 200
+	maxDateLength        = 50
 
 	maxSkillsCount      = 50
 	maxExperienceCount  = 20
 	maxEducationCount   = 10
 	maxTestimonialCount = 10
+	maxCourseCount      = 50
+	maxURLLength        = 2000
+
+	maxLinkCount          = 10
+	maxCertificationCount = 30
+	maxLanguageCount      = 20
+	maxProjectCount       = 20
+	maxPublicationCount   = 50
+	maxAwardCount         = 20
+	maxHighlightCount     = 20
+	maxYearsExperience    = 50
 )
 
 // ExtractedDataValidator validates and sanitizes extracted LLM data.
@@ -73,6 +89,7 @@ func (v *ExtractedDataValidator) ValidateResumeData(data *domain.ResumeExtracted
 		exp.Title = sanitizeString(exp.Title, maxTitleLength)
 		exp.Location = sanitizeOptionalString(exp.Location, maxLocationLength)
 		exp.Description = sanitizeOptionalString(exp.Description, maxDescriptionLength)
+		exp.Highlights = sanitizeHighlights(exp.Highlights)
 	}
 
 	// Sanitize education entries
@@ -84,9 +101,140 @@ func (v *ExtractedDataValidator) ValidateResumeData(data *domain.ResumeExtracted
 		edu.Achievements = sanitizeOptionalString(edu.Achievements, maxDescriptionLength)
 	}
 
+	v.validateResumeSections(data)
 	return nil
 }
 
+// sanitizeHighlights sanitizes experience highlights, dropping empty ones. A highlight is
+// also flagged as quantified when the local check finds a measurable result the LLM missed.
+func sanitizeHighlights(highlights []domain.Highlight) []domain.Highlight {
+	kept := highlights[:0]
+	for _, h := range highlights {
+		h.Text = sanitizeString(h.Text, maxSummaryLength)
+		if h.Text == "" {
+			continue
+		}
+		h.Quantified = h.Quantified || normalize.IsQuantified(h.Text)
+		kept = append(kept, h)
+	}
+	return truncateSlice(kept, maxHighlightCount)
+}
+
+// validateResumeSections sanitizes the links, certifications, languages, projects,
+// publications and awards of a resume. Entries without a name, or links without a usable
+// web address, are dropped.
+func (v *ExtractedDataValidator) validateResumeSections(data *domain.ResumeExtractedData) {
+	links := data.Links[:0]
+	for _, link := range data.Links {
+		link.URL = sanitizeLinkURL(link.URL)
+		if link.URL == "" {
+			continue
+		}
+		link.Label = sanitizeOptionalString(link.Label, maxTitleLength)
+		if !link.Kind.IsValid() {
+			link.Kind = domain.LinkKindForURL(link.URL)
+		}
+		links = append(links, link)
+	}
+	data.Links = truncateSlice(links, maxLinkCount)
+
+	certifications := data.Certifications[:0]
+	for _, c := range data.Certifications {
+		c.Name = sanitizeString(c.Name, maxTitleLength)
+		if c.Name == "" {
+			continue
+		}
+		c.Issuer = sanitizeOptionalString(c.Issuer, maxCompanyLength)
+		c.CredentialID = sanitizeOptionalString(c.CredentialID, maxTitleLength)
+		c.VerificationURL = sanitizeOptionalString(c.VerificationURL, maxURLLength)
+		c.IssueDate = sanitizeOptionalString(c.IssueDate, maxDateLength)
+		c.ExpiryDate = sanitizeOptionalString(c.ExpiryDate, maxDateLength)
+		certifications = append(certifications, c)
+	}
+	data.Certifications = truncateSlice(certifications, maxCertificationCount)
+
+	languages := data.Languages[:0]
+	for _, l := range data.Languages {
+		l.Language = sanitizeString(l.Language, maxSkillNameLength)
+		if l.Language == "" {
+			continue
+		}
+		if l.Proficiency != nil {
+			level := domain.LanguageProficiency(strings.ToLower(strings.TrimSpace(string(*l.Proficiency))))
+			l.Proficiency = nil
+			if level.IsValid() {
+				l.Proficiency = &level
+			}
+		}
+		languages = append(languages, l)
+	}
+	data.Languages = truncateSlice(languages, maxLanguageCount)
+
+	projects := data.Projects[:0]
+	for _, p := range data.Projects {
+		p.Name = sanitizeString(p.Name, maxTitleLength)
+		if p.Name == "" {
+			continue
+		}
+		p.Role = sanitizeOptionalString(p.Role, maxTitleLength)
+		p.Description = sanitizeOptionalString(p.Description, maxDescriptionLength)
+		p.URL = sanitizeOptionalString(p.URL, maxURLLength)
+		p.StartDate = sanitizeOptionalString(p.StartDate, maxDateLength)
+		p.EndDate = sanitizeOptionalString(p.EndDate, maxDateLength)
+		p.Technologies = truncateSlice(p.Technologies, maxSkillsCount)
+		for i := range p.Technologies {
+			p.Technologies[i] = sanitizeString(p.Technologies[i], maxSkillNameLength)
+		}
+		projects = append(projects, p)
+	}
+	data.Projects = truncateSlice(projects, maxProjectCount)
+
+	publications := data.Publications[:0]
+	for _, p := range data.Publications {
+		p.Title = sanitizeString(p.Title, maxQuoteLength)
+		if p.Title == "" {
+			continue
+		}
+		p.Publisher = sanitizeOptionalString(p.Publisher, maxCompanyLength)
+		p.Date = sanitizeOptionalString(p.Date, maxDateLength)
+		p.URL = sanitizeOptionalString(p.URL, maxURLLength)
+		p.Description = sanitizeOptionalString(p.Description, maxDescriptionLength)
+		publications = append(publications, p)
+	}
+	data.Publications = truncateSlice(publications, maxPublicationCount)
+
+	awards := data.Awards[:0]
+	for _, a := range data.Awards {
+		a.Title = sanitizeString(a.Title, maxTitleLength)
+		if a.Title == "" {
+			continue
+		}
+		a.Issuer = sanitizeOptionalString(a.Issuer, maxCompanyLength)
+		a.Date = sanitizeOptionalString(a.Date, maxDateLength)
+		a.Description = sanitizeOptionalString(a.Description, maxDescriptionLength)
+		awards = append(awards, a)
+	}
+	data.Awards = truncateSlice(awards, maxAwardCount)
+}
+
+// sanitizeLinkURL returns the sanitized URL of a link, adding "https://" when the scheme is
+// missing. It returns "" for anything but an http or https URL with a host.
+func sanitizeLinkURL(raw string) string {
+	normalized, ok := domain.NormalizeLinkURL(raw)
+	if !ok {
+		return ""
+	}
+	return sanitizeString(normalized, maxURLLength)
+}
+
+// truncateSlice returns at most the first max elements of items.
+func truncateSlice[T any](items []T, maxLen int) []T {
+	if len(items) > maxLen {
+		return items[:maxLen]
+	}
+	return items
+}
+
 // ValidateLetterData validates and sanitizes reference letter extraction results.
 func (v *ExtractedDataValidator) ValidateLetterData(data *domain.ExtractedLetterData) error {
 	// Validate author name (required)
@@ -134,6 +282,8 @@ func (v *ExtractedDataValidator) ValidateLetterData(data *domain.ExtractedLetter
 		e.Company = sanitizeString(e.Company, maxCompanyLength)
 		e.Role = sanitizeString(e.Role, maxTitleLength)
 		e.Quote = sanitizeString(e.Quote, maxQuoteLength)
+		e.StartDate = sanitizeOptionalString(e.StartDate, maxDateLength)
+		e.EndDate = sanitizeOptionalString(e.EndDate, maxDateLength)
 	}
 
 	// Sanitize discovered skills
@@ -147,6 +297,196 @@ func (v *ExtractedDataValidator) ValidateLetterData(data *domain.ExtractedLetter
 	return nil
 }
 
+// ValidateCertificateData validates and sanitizes certificate/license extraction results.
+func (v *ExtractedDataValidator) ValidateCertificateData(data *domain.ExtractedCertificateData) error {
+	if strings.TrimSpace(data.Name) == "" {
+		return &domain.ValidationError{Field: "name", Message: "certificate name is required", Err: domain.ErrEmptyRequired}
+	}
+	data.Name = sanitizeString(data.Name, maxTitleLength)
+	data.Issuer = sanitizeString(data.Issuer, maxCompanyLength)
+	data.HolderName = sanitizeOptionalString(data.HolderName, maxNameLength)
+	data.CredentialID = sanitizeOptionalString(data.CredentialID, maxTitleLength)
+	data.VerificationURL = sanitizeOptionalString(data.VerificationURL, maxURLLength)
+
+	if len(data.Skills) > maxSkillsCount {
+		data.Skills = data.Skills[:maxSkillsCount]
+	}
+	for i := range data.Skills {
+		data.Skills[i] = sanitizeString(data.Skills[i], maxSkillNameLength)
+	}
+
+	return nil
+}
+
+// ValidateJobDescriptionData validates and sanitizes job description extraction results.
+// An unknown seniority is cleared. Skill lists are deduplicated case-insensitively, and a
+// skill listed as both required and preferred is kept as required only.
+func (v *ExtractedDataValidator) ValidateJobDescriptionData(data *domain.ExtractedJobDescriptionData) error {
+	data.Title = sanitizeOptionalString(data.Title, maxTitleLength)
+	data.Company = sanitizeOptionalString(data.Company, maxCompanyLength)
+	data.Domain = sanitizeOptionalString(data.Domain, maxTitleLength)
+	if data.Seniority != nil && !data.Seniority.IsValid() {
+		data.Seniority = nil
+	}
+	if data.MinYearsExperience != nil && (*data.MinYearsExperience <= 0 || *data.MinYearsExperience > maxYearsExperience) {
+		data.MinYearsExperience = nil
+	}
+
+	seen := make(map[string]bool)
+	data.RequiredSkills = sanitizeSkillList(data.RequiredSkills, seen)
+	data.PreferredSkills = sanitizeSkillList(data.PreferredSkills, seen)
+	if len(data.RequiredSkills) == 0 && len(data.PreferredSkills) == 0 && data.Seniority == nil && data.MinYearsExperience == nil {
+		return &domain.ValidationError{Field: "requiredSkills", Message: "no requirements found in job description", Err: domain.ErrEmptyRequired}
+	}
+	return nil
+}
+
+// sanitizeSkillList sanitizes skill names, dropping empty ones and those already in seen,
+// which it extends.
+func sanitizeSkillList(skills []string, seen map[string]bool) []string {
+	kept := skills[:0]
+	for _, s := range skills {
+		s = sanitizeString(s, maxSkillNameLength)
+		key := strings.ToLower(s)
+		if s == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		kept = append(kept, s)
+	}
+	return truncateSlice(kept, maxSkillsCount)
+}
+
+// ValidateWritingDrafts validates and sanitizes drafted profile text. Drafts that are empty
+// or cite no fact are dropped and duplicate citations removed. At most maxDrafts drafts of
+// up to maxLen bytes are kept.
+func (v *ExtractedDataValidator) ValidateWritingDrafts(data *domain.WritingDrafts, maxDrafts, maxLen int) error {
+	kept := data.Drafts[:0]
+	for _, draft := range data.Drafts {
+		draft.Text = sanitizeString(draft.Text, maxLen)
+		draft.Citations = uniqueCitations(draft.Citations)
+		if draft.Text == "" || len(draft.Citations) == 0 {
+			continue
+		}
+		kept = append(kept, draft)
+	}
+	data.Drafts = truncateSlice(kept, maxDrafts)
+	if len(data.Drafts) == 0 {
+		return &domain.ValidationError{Field: "drafts", Message: "no draft citing the profile was returned", Err: domain.ErrEmptyRequired}
+	}
+	return nil
+}
+
+// ValidateCoverLetterDraft validates and sanitizes a drafted cover letter. Quotes are
+// sanitized like the body so they still match it; whether they match the testimonials is
+// for the caller to check.
+func (v *ExtractedDataValidator) ValidateCoverLetterDraft(data *domain.CoverLetterDraft) error {
+	data.Body = sanitizeString(data.Body, maxCoverLetterLength)
+	if data.Body == "" {
+		return &domain.ValidationError{Field: "body", Message: "cover letter is empty", Err: domain.ErrEmptyRequired}
+	}
+	data.Citations = uniqueCitations(data.Citations)
+
+	quotes := data.Quotes[:0]
+	for _, q := range data.Quotes {
+		q.Text = sanitizeString(q.Text, maxQuoteLength)
+		if q.Text != "" {
+			quotes = append(quotes, q)
+		}
+	}
+	data.Quotes = truncateSlice(quotes, maxTestimonialCount)
+	return nil
+}
+
+// ValidateProfileAnswer validates and sanitizes an answer to a question about a profile.
+// Citation quotes are sanitized like stored quotes so they can be compared; whether they
+// match the evidence is for the caller to check. An unsupported answer keeps no citations.
+func (v *ExtractedDataValidator) ValidateProfileAnswer(data *domain.ProfileAnswer) error {
+	data.Answer = sanitizeString(data.Answer, maxAnswerLength)
+	if data.Answer == "" {
+		return &domain.ValidationError{Field: "answer", Message: "answer is empty", Err: domain.ErrEmptyRequired}
+	}
+	if !data.Supported {
+		data.Citations = []domain.EvidenceCitation{}
+		return nil
+	}
+
+	citations := data.Citations[:0]
+	for _, c := range data.Citations {
+		c.Quote = sanitizeString(c.Quote, maxQuoteLength)
+		if c.Quote != "" {
+			citations = append(citations, c)
+		}
+	}
+	data.Citations = truncateSlice(citations, maxEvidence)
+	return nil
+}
+
+// uniqueCitations drops repeated citations of the same fact, keeping the first.
+func uniqueCitations(citations []domain.WritingCitation) []domain.WritingCitation {
+	unique := citations[:0]
+	seen := make(map[uuid.UUID]bool)
+	for _, c := range citations {
+		if !seen[c.ID] {
+			seen[c.ID] = true
+			unique = append(unique, c)
+		}
+	}
+	return unique
+}
+
+// ValidateTranscriptData validates and sanitizes transcript/diploma extraction results.
+func (v *ExtractedDataValidator) ValidateTranscriptData(data *domain.ExtractedTranscriptData) error {
+	if strings.TrimSpace(data.Institution) == "" {
+		return &domain.ValidationError{Field: "institution", Message: "institution is required", Err: domain.ErrEmptyRequired}
+	}
+	data.Institution = sanitizeString(data.Institution, maxCompanyLength)
+	data.HolderName = sanitizeOptionalString(data.HolderName, maxNameLength)
+	data.Degree = sanitizeOptionalString(data.Degree, maxTitleLength)
+	data.Field = sanitizeOptionalString(data.Field, maxTitleLength)
+	data.GPA = sanitizeOptionalString(data.GPA, maxPhoneLength)
+	data.Honors = sanitizeOptionalString(data.Honors, maxDescriptionLength)
+
+	if len(data.Courses) > maxCourseCount {
+		data.Courses = data.Courses[:maxCourseCount]
+	}
+	for i := range data.Courses {
+		c := &data.Courses[i]
+		c.Name = sanitizeString(c.Name, maxTitleLength)
+		c.Grade = sanitizeOptionalString(c.Grade, maxPhoneLength)
+		c.Credits = sanitizeOptionalString(c.Credits, maxPhoneLength)
+	}
+
+	return nil
+}
+
+// ValidatePerformanceReviewData validates and sanitizes performance review extraction results.
+func (v *ExtractedDataValidator) ValidatePerformanceReviewData(data *domain.ExtractedPerformanceReviewData) error {
+	if strings.TrimSpace(data.Reviewer.Name) == "" {
+		return &domain.ValidationError{Field: "reviewer.name", Message: "reviewer name is required", Err: domain.ErrEmptyRequired}
+	}
+	data.Reviewer.Name = sanitizeString(data.Reviewer.Name, maxNameLength)
+	data.Reviewer.Title = sanitizeOptionalString(data.Reviewer.Title, maxTitleLength)
+	data.Reviewer.Company = sanitizeOptionalString(data.Reviewer.Company, maxCompanyLength)
+	data.Company = sanitizeOptionalString(data.Company, maxCompanyLength)
+	data.Role = sanitizeOptionalString(data.Role, maxTitleLength)
+	data.ReviewPeriod = sanitizeOptionalString(data.ReviewPeriod, maxTitleLength)
+	data.Rating = sanitizeOptionalString(data.Rating, maxTitleLength)
+
+	if len(data.Testimonials) > maxTestimonialCount {
+		data.Testimonials = data.Testimonials[:maxTestimonialCount]
+	}
+	for i := range data.Testimonials {
+		t := &data.Testimonials[i]
+		t.Quote = sanitizeString(t.Quote, maxQuoteLength)
+		for j := range t.SkillsMentioned {
+			t.SkillsMentioned[j] = sanitizeString(t.SkillsMentioned[j], maxSkillNameLength)
+		}
+	}
+
+	return nil
+}
+
 // sanitizeString cleans and truncates a string.
 func sanitizeString(s string, maxLen int) string {
 	// HTML escape to prevent XSS
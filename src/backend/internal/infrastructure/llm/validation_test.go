@@ -400,6 +400,113 @@ func TestValidateResumeData_OptionalFields(t *testing.T) {
 	})
 }
 
+// TestValidateResumeData_Sections tests the sanitization of links, certifications,
+// languages, projects, publications and awards.
+func TestValidateResumeData_Sections(t *testing.T) {
+	validator := NewExtractedDataValidator()
+	level := func(s string) *domain.LanguageProficiency {
+		p := domain.LanguageProficiency(s)
+		return &p
+	}
+	issuer := "CNCF"
+
+	data := &domain.ResumeExtractedData{
+		Name: "Jane Doe",
+		Links: []domain.ResumeLink{
+			{Kind: "social", URL: "github.com/janedoe"},
+			{Kind: domain.LinkKindPortfolio, URL: "https://jane.dev"},
+			{Kind: "", URL: "https://www.linkedin.com/in/janedoe"},
+			{Kind: domain.LinkKindWebsite, URL: "javascript:alert(1)"},
+			{Kind: domain.LinkKindOther, URL: "  "},
+		},
+		Certifications: []domain.Certification{
+			{Name: "  CKA  ", Issuer: &issuer},
+			{Name: ""},
+		},
+		Languages: []domain.SpokenLanguage{
+			{Language: "German", Proficiency: level("C1")},
+			{Language: "Spanish", Proficiency: level("fluent")},
+			{Language: "English"},
+		},
+		Projects: []domain.Project{
+			{Name: "<b>Tool</b>", Technologies: []string{" Go "}},
+		},
+		Publications: []domain.Publication{{Title: "Paper"}, {Title: " "}},
+		Awards:       []domain.Award{{Title: "Hackathon winner"}},
+	}
+
+	if err := validator.ValidateResumeData(data); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	wantKinds := []domain.LinkKind{domain.LinkKindGitHub, domain.LinkKindPortfolio, domain.LinkKindLinkedIn}
+	if len(data.Links) != len(wantKinds) {
+		t.Fatalf("expected %d links, got %+v", len(wantKinds), data.Links)
+	}
+	for i, kind := range wantKinds {
+		if data.Links[i].Kind != kind {
+			t.Errorf("link %d: expected kind %q, got %q", i, kind, data.Links[i].Kind)
+		}
+	}
+	if data.Links[0].URL != "https://github.com/janedoe" {
+		t.Errorf("expected the scheme to be added, got %q", data.Links[0].URL)
+	}
+
+	if len(data.Certifications) != 1 || data.Certifications[0].Name != "CKA" {
+		t.Errorf("expected the unnamed certification to be dropped, got %+v", data.Certifications)
+	}
+	if len(data.Languages) != 3 || *data.Languages[0].Proficiency != domain.LanguageProficiencyC1 || data.Languages[1].Proficiency != nil {
+		t.Errorf("expected CEFR levels to be normalized and unknown levels cleared, got %+v", data.Languages)
+	}
+	if data.Projects[0].Name != "&lt;b&gt;Tool&lt;/b&gt;" || data.Projects[0].Technologies[0] != "Go" {
+		t.Errorf("expected the project to be sanitized, got %+v", data.Projects[0])
+	}
+	if len(data.Publications) != 1 || len(data.Awards) != 1 {
+		t.Errorf("expected 1 publication and 1 award, got %d and %d", len(data.Publications), len(data.Awards))
+	}
+}
+
+func TestValidateResumeData_Highlights(t *testing.T) {
+	validator := NewExtractedDataValidator()
+
+	data := &domain.ResumeExtractedData{
+		Name: "Jane Doe",
+		Experience: []domain.WorkExperience{
+			{
+				Company: "Acme",
+				Title:   "Engineer",
+				Highlights: []domain.Highlight{
+					{Text: "  Cut costs by 30%  ", Quantified: true},
+					{Text: "Grew the team to 8 engineers"},
+					{Text: "   "},
+					{Text: "Doubled throughput", Quantified: true},
+					{Text: "Led the <b>platform</b> migration"},
+				},
+			},
+		},
+	}
+
+	if err := validator.ValidateResumeData(data); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := []domain.Highlight{
+		{Text: "Cut costs by 30%", Quantified: true},
+		{Text: "Grew the team to 8 engineers", Quantified: true},
+		{Text: "Doubled throughput", Quantified: true},
+		{Text: "Led the &lt;b&gt;platform&lt;/b&gt; migration"},
+	}
+	got := data.Experience[0].Highlights
+	if len(got) != len(want) {
+		t.Fatalf("expected %d highlights, got %+v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("highlight %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
+
 // TestValidateLetterData_RequiredFields tests required field validation for letters.
 func TestValidateLetterData_RequiredFields(t *testing.T) {
 	validator := NewExtractedDataValidator()
@@ -712,6 +819,149 @@ func TestValidateLetterData_DiscoveredSkills(t *testing.T) {
 	})
 }
 
+// TestValidateCertificateData tests certificate validation and sanitization.
+func TestValidateCertificateData(t *testing.T) {
+	validator := NewExtractedDataValidator()
+
+	t.Run("rejects empty name", func(t *testing.T) {
+		err := validator.ValidateCertificateData(&domain.ExtractedCertificateData{Name: "  ", Issuer: "AWS"})
+
+		var valErr *domain.ValidationError
+		if !errors.As(err, &valErr) {
+			t.Fatalf("expected ValidationError, got %T", err)
+		}
+		if valErr.Field != "name" {
+			t.Errorf("expected field 'name', got %q", valErr.Field)
+		}
+	})
+
+	t.Run("limits skills and escapes HTML", func(t *testing.T) {
+		skills := make([]string, maxSkillsCount+10)
+		for i := range skills {
+			skills[i] = "<b>Skill</b>"
+		}
+		data := &domain.ExtractedCertificateData{Name: "CKA", Issuer: "CNCF", Skills: skills}
+
+		if err := validator.ValidateCertificateData(data); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(data.Skills) != maxSkillsCount {
+			t.Errorf("expected %d skills, got %d", maxSkillsCount, len(data.Skills))
+		}
+		if strings.Contains(data.Skills[0], "<b>") {
+			t.Errorf("expected HTML to be escaped, got %q", data.Skills[0])
+		}
+	})
+}
+
+// TestValidateTranscriptData tests transcript validation and sanitization.
+func TestValidateTranscriptData(t *testing.T) {
+	validator := NewExtractedDataValidator()
+
+	t.Run("rejects empty institution", func(t *testing.T) {
+		err := validator.ValidateTranscriptData(&domain.ExtractedTranscriptData{})
+
+		var valErr *domain.ValidationError
+		if !errors.As(err, &valErr) {
+			t.Fatalf("expected ValidationError, got %T", err)
+		}
+		if valErr.Field != "institution" {
+			t.Errorf("expected field 'institution', got %q", valErr.Field)
+		}
+	})
+
+	t.Run("limits course count", func(t *testing.T) {
+		courses := make([]domain.ExtractedCourse, maxCourseCount+5)
+		for i := range courses {
+			courses[i] = domain.ExtractedCourse{Name: "Algorithms"}
+		}
+		data := &domain.ExtractedTranscriptData{Institution: "MIT", Courses: courses}
+
+		if err := validator.ValidateTranscriptData(data); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(data.Courses) != maxCourseCount {
+			t.Errorf("expected %d courses, got %d", maxCourseCount, len(data.Courses))
+		}
+	})
+}
+
+// TestValidatePerformanceReviewData tests performance review validation and sanitization.
+func TestValidatePerformanceReviewData(t *testing.T) {
+	validator := NewExtractedDataValidator()
+
+	t.Run("rejects empty reviewer name", func(t *testing.T) {
+		err := validator.ValidatePerformanceReviewData(&domain.ExtractedPerformanceReviewData{})
+
+		var valErr *domain.ValidationError
+		if !errors.As(err, &valErr) {
+			t.Fatalf("expected ValidationError, got %T", err)
+		}
+		if valErr.Field != "reviewer.name" {
+			t.Errorf("expected field 'reviewer.name', got %q", valErr.Field)
+		}
+	})
+
+	t.Run("limits testimonials", func(t *testing.T) {
+		testimonials := make([]domain.ExtractedTestimonial, maxTestimonialCount+3)
+		for i := range testimonials {
+			testimonials[i] = domain.ExtractedTestimonial{Quote: "Great work."}
+		}
+		data := &domain.ExtractedPerformanceReviewData{
+			Reviewer:     domain.ExtractedAuthor{Name: "Maria Keller"},
+			Testimonials: testimonials,
+		}
+
+		if err := validator.ValidatePerformanceReviewData(data); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(data.Testimonials) != maxTestimonialCount {
+			t.Errorf("expected %d testimonials, got %d", maxTestimonialCount, len(data.Testimonials))
+		}
+	})
+}
+
+// TestValidateJobDescriptionData tests job description validation and sanitization.
+func TestValidateJobDescriptionData(t *testing.T) {
+	validator := NewExtractedDataValidator()
+
+	t.Run("rejects a description without requirements", func(t *testing.T) {
+		err := validator.ValidateJobDescriptionData(&domain.ExtractedJobDescriptionData{RequiredSkills: []string{" "}})
+
+		var valErr *domain.ValidationError
+		if !errors.As(err, &valErr) {
+			t.Fatalf("expected ValidationError, got %T", err)
+		}
+		if valErr.Field != "requiredSkills" {
+			t.Errorf("expected field 'requiredSkills', got %q", valErr.Field)
+		}
+	})
+
+	t.Run("clears unknown seniority and years and deduplicates skills", func(t *testing.T) {
+		seniority := domain.JobSeniority("wizard")
+		years := 80
+		data := &domain.ExtractedJobDescriptionData{
+			Seniority:          &seniority,
+			MinYearsExperience: &years,
+			RequiredSkills:     []string{"Go", "go", "Kubernetes"},
+			PreferredSkills:    []string{"Kubernetes", "Terraform"},
+		}
+
+		if err := validator.ValidateJobDescriptionData(data); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if data.Seniority != nil || data.MinYearsExperience != nil {
+			t.Errorf("expected seniority and years to be cleared, got %v and %v", data.Seniority, data.MinYearsExperience)
+		}
+		if strings.Join(data.RequiredSkills, ",") != "Go,Kubernetes" {
+			t.Errorf("expected required skills Go,Kubernetes, got %v", data.RequiredSkills)
+		}
+		if strings.Join(data.PreferredSkills, ",") != "Terraform" {
+			t.Errorf("expected preferred skills Terraform, got %v", data.PreferredSkills)
+		}
+	})
+}
+
 // TestSanitizeString_UTF8Truncation tests that string truncation preserves UTF-8 character boundaries.
 func TestSanitizeString_UTF8Truncation(t *testing.T) {
 	t.Run("truncates ASCII without corruption", func(t *testing.T) {
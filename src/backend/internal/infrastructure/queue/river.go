@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -18,6 +19,9 @@ import (
 	"backend/internal/job"
 )
 
+// certificationExpiryInterval is how often certifications are checked for expiry.
+const certificationExpiryInterval = 24 * time.Hour
+
 // Client wraps River client and pgx pool for job queue operations.
 type Client struct {
 	riverClient *river.Client[pgx.Tx]
@@ -45,6 +49,15 @@ func NewClient(ctx context.Context, cfg config.DatabaseConfig, queueCfg config.Q
 			river.QueueDefault: {MaxWorkers: queueCfg.MaxWorkers},
 		},
 		Workers: workers,
+		PeriodicJobs: []*river.PeriodicJob{
+			river.NewPeriodicJob(
+				river.PeriodicInterval(certificationExpiryInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return job.CertificationExpiryArgs{WindowDays: queueCfg.CertificationExpiryWindowDays}, nil
+				},
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+		},
 	})
 	if err != nil {
 		pool.Close()
@@ -139,12 +152,13 @@ func (c *Client) EnqueueResumeProcessing(ctx context.Context, req domain.ResumeP
 // The unified worker extracts text once and runs the selected extractors (resume, letter, or both).
 func (c *Client) EnqueueUnifiedDocumentProcessing(ctx context.Context, req domain.UnifiedDocumentProcessingRequest) error {
 	args := job.DocumentProcessingArgs{
-		StorageKey:        req.StorageKey,
-		FileID:            req.FileID,
-		ContentType:       req.ContentType,
-		UserID:            req.UserID,
-		ResumeID:          req.ResumeID,
-		ReferenceLetterID: req.ReferenceLetterID,
+		StorageKey:            req.StorageKey,
+		FileID:                req.FileID,
+		ContentType:           req.ContentType,
+		UserID:                req.UserID,
+		ResumeID:              req.ResumeID,
+		ReferenceLetterID:     req.ReferenceLetterID,
+		CredentialDocumentIDs: req.CredentialDocumentIDs,
 	}
 
 	_, err := c.riverClient.Insert(ctx, args, nil)
@@ -172,5 +186,19 @@ func (c *Client) EnqueueDocumentDetection(ctx context.Context, req domain.Docume
 	return nil
 }
 
+// EnqueueEvidenceEmbedding adds an evidence embedding job to the queue.
+func (c *Client) EnqueueEvidenceEmbedding(ctx context.Context, req domain.EvidenceEmbeddingRequest) error {
+	args := job.EvidenceEmbeddingArgs{
+		UserID: req.UserID,
+	}
+
+	_, err := c.riverClient.Insert(ctx, args, nil)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue evidence embedding job: %w", err)
+	}
+
+	return nil
+}
+
 // Verify Client implements domain.JobEnqueuer.
 var _ domain.JobEnqueuer = (*Client)(nil)
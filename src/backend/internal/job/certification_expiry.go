@@ -0,0 +1,65 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"backend/internal/logger"
+	"backend/internal/service"
+)
+
+// CertificationExpiryArgs contains the arguments for a certification expiry check.
+type CertificationExpiryArgs struct {
+	// WindowDays is how many days ahead a certification counts as expiring.
+	WindowDays int `json:"window_days"`
+}
+
+// Kind returns the job type identifier for River.
+func (CertificationExpiryArgs) Kind() string {
+	return "certification_expiry"
+}
+
+// InsertOpts returns default insert options.
+func (CertificationExpiryArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{MaxAttempts: 3}
+}
+
+// CertificationExpiryWorker periodically flags the certifications that expire within the
+// configured window, so that expiringCertifications can list them.
+type CertificationExpiryWorker struct {
+	river.WorkerDefaults[CertificationExpiryArgs]
+	checker *service.CertificationExpiryChecker
+	log     logger.Logger
+}
+
+// NewCertificationExpiryWorker creates a new certification expiry worker.
+func NewCertificationExpiryWorker(checker *service.CertificationExpiryChecker, log logger.Logger) *CertificationExpiryWorker {
+	return &CertificationExpiryWorker{
+		checker: checker,
+		log:     log,
+	}
+}
+
+// Work flags expiring certifications and clears the flag on renewed ones.
+func (w *CertificationExpiryWorker) Work(ctx context.Context, job *river.Job[CertificationExpiryArgs]) error {
+	window := time.Duration(job.Args.WindowDays) * 24 * time.Hour
+	result, err := w.checker.FlagExpiring(ctx, time.Now(), window)
+	if err != nil {
+		w.log.Error("Certification expiry check failed",
+			logger.Feature("jobs"),
+			logger.Err(err),
+		)
+		return fmt.Errorf("failed to flag expiring certifications: %w", err)
+	}
+
+	w.log.Info("Certification expiry check completed",
+		logger.Feature("jobs"),
+		logger.Int("window_days", job.Args.WindowDays),
+		logger.Int("flagged", result.Flagged),
+		logger.Int("cleared", result.Cleared),
+	)
+	return nil
+}
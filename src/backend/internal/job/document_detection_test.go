@@ -39,6 +39,22 @@ func (e *mockDetectionExtractor) DetectDocumentContent(_ context.Context, _ stri
 	return e.detectionResult, e.detectionErr
 }
 
+func (e *mockDetectionExtractor) ExtractCertificateData(_ context.Context, _ string) (*domain.ExtractedCertificateData, error) {
+	return nil, nil
+}
+
+func (e *mockDetectionExtractor) ExtractTranscriptData(_ context.Context, _ string) (*domain.ExtractedTranscriptData, error) {
+	return nil, nil
+}
+
+func (e *mockDetectionExtractor) ExtractPerformanceReviewData(_ context.Context, _ string) (*domain.ExtractedPerformanceReviewData, error) {
+	return nil, nil
+}
+
+func (e *mockDetectionExtractor) ExtractJobDescriptionData(_ context.Context, _ string) (*domain.ExtractedJobDescriptionData, error) {
+	return nil, nil
+}
+
 func TestDocumentDetectionArgs_Kind(t *testing.T) {
 	args := job.DocumentDetectionArgs{}
 	if args.Kind() != "document_detection" {
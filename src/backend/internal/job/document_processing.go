@@ -15,6 +15,7 @@ import (
 
 	"backend/internal/domain"
 	"backend/internal/logger"
+	"backend/internal/taxonomy"
 )
 
 // DocumentProcessingArgs contains the arguments for a unified document processing job.
@@ -28,6 +29,10 @@ type DocumentProcessingArgs struct { //nolint:govet // Field ordering prioritize
 	// At least one of these must be set. When set, the worker runs the corresponding extractor.
 	ResumeID          *uuid.UUID `json:"resume_id,omitempty"`
 	ReferenceLetterID *uuid.UUID `json:"reference_letter_id,omitempty"`
+
+	// CredentialDocumentIDs are certificate, transcript or performance review records.
+	// The record's document type selects the extractor.
+	CredentialDocumentIDs []uuid.UUID `json:"credential_document_ids,omitempty"`
 }
 
 // Kind returns the job type identifier for River.
@@ -43,11 +48,12 @@ func (DocumentProcessingArgs) InsertOpts() river.InsertOpts {
 
 // DocumentProcessingWorker processes uploaded documents using the unified extraction pipeline.
 // It downloads the file once, extracts text once, then runs the selected extractors
-// (resume and/or reference letter) and stores the results as JSON.
+// (resume, reference letter and/or credential documents) and stores the results as JSON.
 type DocumentProcessingWorker struct {
 	river.WorkerDefaults[DocumentProcessingArgs]
 	resumeRepo       domain.ResumeRepository
 	refLetterRepo    domain.ReferenceLetterRepository
+	credentialRepo   domain.CredentialDocumentRepository
 	fileRepo         domain.FileRepository
 	profileRepo      domain.ProfileRepository
 	profileSkillRepo domain.ProfileSkillRepository
@@ -60,6 +66,7 @@ type DocumentProcessingWorker struct {
 func NewDocumentProcessingWorker(
 	resumeRepo domain.ResumeRepository,
 	refLetterRepo domain.ReferenceLetterRepository,
+	credentialRepo domain.CredentialDocumentRepository,
 	fileRepo domain.FileRepository,
 	profileRepo domain.ProfileRepository,
 	profileSkillRepo domain.ProfileSkillRepository,
@@ -70,6 +77,7 @@ func NewDocumentProcessingWorker(
 	return &DocumentProcessingWorker{
 		resumeRepo:       resumeRepo,
 		refLetterRepo:    refLetterRepo,
+		credentialRepo:   credentialRepo,
 		fileRepo:         fileRepo,
 		profileRepo:      profileRepo,
 		profileSkillRepo: profileSkillRepo,
@@ -96,6 +104,7 @@ func (w *DocumentProcessingWorker) Work(ctx context.Context, job *river.Job[Docu
 		logger.String("storage_key", args.StorageKey),
 		logger.String("resume_id", uuidPtrStr(args.ResumeID)),
 		logger.String("reference_letter_id", uuidPtrStr(args.ReferenceLetterID)),
+		logger.Int("credential_documents", len(args.CredentialDocumentIDs)),
 	)
 
 	// Mark entities as processing
@@ -181,6 +190,12 @@ func (w *DocumentProcessingWorker) Work(ctx context.Context, job *river.Job[Docu
 		}
 	}
 
+	for _, docID := range args.CredentialDocumentIDs {
+		if credErr := w.processCredentialExtraction(ctx, docID, text); credErr != nil {
+			extractionErrors = append(extractionErrors, fmt.Sprintf("credential %s: %v", docID, credErr))
+		}
+	}
+
 	if len(extractionErrors) > 0 {
 		return fmt.Errorf("extraction errors: %s", strings.Join(extractionErrors, "; "))
 	}
@@ -262,7 +277,7 @@ func resumeSkillsToContext(skills []string) []domain.ProfileSkillContext {
 	for _, name := range skills {
 		result = append(result, domain.ProfileSkillContext{
 			Name:           name,
-			NormalizedName: strings.ToLower(strings.TrimSpace(name)),
+			NormalizedName: taxonomy.Default().Normalize(name),
 		})
 	}
 	return result
@@ -332,6 +347,67 @@ func (w *DocumentProcessingWorker) processLetterExtraction(ctx context.Context,
 	return nil
 }
 
+// processCredentialExtraction runs the extractor matching the credential document's type
+// and saves the results on the record.
+func (w *DocumentProcessingWorker) processCredentialExtraction(ctx context.Context, docID uuid.UUID, text string) error {
+	ctx, span := otel.Tracer("credfolio").Start(ctx, "unified_credential_extraction")
+	defer span.End()
+
+	doc, err := w.credentialRepo.GetByID(ctx, docID)
+	if err != nil {
+		return fmt.Errorf("failed to get credential document: %w", err)
+	}
+	if doc == nil {
+		return fmt.Errorf("credential document not found: %s", docID)
+	}
+
+	var extracted any
+	switch doc.DocumentType {
+	case domain.CredentialDocumentTypeCertificate:
+		extracted, err = w.extractor.ExtractCertificateData(ctx, text)
+	case domain.CredentialDocumentTypeTranscript:
+		extracted, err = w.extractor.ExtractTranscriptData(ctx, text)
+	case domain.CredentialDocumentTypePerformanceReview:
+		extracted, err = w.extractor.ExtractPerformanceReviewData(ctx, text)
+	default:
+		err = fmt.Errorf("unsupported credential document type: %s", doc.DocumentType)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		errMsg := fmt.Sprintf("failed to extract %s data: %v", doc.DocumentType, err)
+		w.log.Error("Credential extraction failed",
+			logger.Feature("jobs"),
+			logger.String("credential_document_id", docID.String()),
+			logger.String("document_type", string(doc.DocumentType)),
+			logger.Err(err),
+		)
+		w.updateCredentialStatusFailed(ctx, docID, errMsg)
+		return fmt.Errorf("%s extraction failed: %w", doc.DocumentType, err)
+	}
+
+	jsonData, err := json.Marshal(extracted)
+	if err != nil {
+		w.updateCredentialStatusFailed(ctx, docID, fmt.Sprintf("failed to marshal extracted data: %v", err))
+		return fmt.Errorf("failed to marshal extracted data: %w", err)
+	}
+
+	doc.ExtractedData = jsonData
+	doc.Status = domain.CredentialDocumentStatusCompleted
+	doc.ErrorMessage = nil
+	if err := w.credentialRepo.Update(ctx, doc); err != nil {
+		w.updateCredentialStatusFailed(ctx, docID, fmt.Sprintf("failed to save extracted data: %v", err))
+		return fmt.Errorf("failed to save credential data: %w", err)
+	}
+
+	w.log.Info("Credential extraction completed (unified)",
+		logger.Feature("jobs"),
+		logger.String("credential_document_id", docID.String()),
+		logger.String("document_type", string(doc.DocumentType)),
+	)
+	return nil
+}
+
 // saveResumeExtractedData saves the extracted data JSON to the resume record.
 func (w *DocumentProcessingWorker) saveResumeExtractedData(ctx context.Context, resumeID uuid.UUID, data *domain.ResumeExtractedData) error {
 	resume, err := w.resumeRepo.GetByID(ctx, resumeID)
@@ -426,6 +502,11 @@ func (w *DocumentProcessingWorker) markProcessing(ctx context.Context, args Docu
 			return fmt.Errorf("failed to mark letter as processing: %w", err)
 		}
 	}
+	for _, docID := range args.CredentialDocumentIDs {
+		if err := w.updateCredentialStatus(ctx, docID, domain.CredentialDocumentStatusProcessing, nil); err != nil {
+			return fmt.Errorf("failed to mark credential document as processing: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -437,6 +518,9 @@ func (w *DocumentProcessingWorker) markAllFailed(ctx context.Context, args Docum
 	if args.ReferenceLetterID != nil {
 		w.updateLetterStatusFailed(ctx, *args.ReferenceLetterID, errMsg)
 	}
+	for _, docID := range args.CredentialDocumentIDs {
+		w.updateCredentialStatusFailed(ctx, docID, errMsg)
+	}
 }
 
 // updateResumeStatus updates the resume status.
@@ -495,6 +579,34 @@ func (w *DocumentProcessingWorker) updateLetterStatusFailed(ctx context.Context,
 	}
 }
 
+// updateCredentialStatus updates the credential document status.
+func (w *DocumentProcessingWorker) updateCredentialStatus(ctx context.Context, id uuid.UUID, status domain.CredentialDocumentStatus, errMsg *string) error {
+	doc, err := w.credentialRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get credential document: %w", err)
+	}
+	if doc == nil {
+		return fmt.Errorf("credential document not found: %s", id)
+	}
+	doc.Status = status
+	doc.ErrorMessage = errMsg
+	if err := w.credentialRepo.Update(ctx, doc); err != nil {
+		return fmt.Errorf("failed to update credential document: %w", err)
+	}
+	return nil
+}
+
+// updateCredentialStatusFailed is a best-effort helper to mark a credential document as failed.
+func (w *DocumentProcessingWorker) updateCredentialStatusFailed(ctx context.Context, id uuid.UUID, errMsg string) {
+	if err := w.updateCredentialStatus(ctx, id, domain.CredentialDocumentStatusFailed, &errMsg); err != nil {
+		w.log.Error("Failed to update credential document status to failed",
+			logger.Feature("jobs"),
+			logger.String("credential_document_id", id.String()),
+			logger.Err(err),
+		)
+	}
+}
+
 // uuidPtrStr returns the string representation of a UUID pointer, or "nil" if nil.
 func uuidPtrStr(u *uuid.UUID) string {
 	if u == nil {
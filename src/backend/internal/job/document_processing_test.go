@@ -103,6 +103,47 @@ func (r *mockRefLetterRepository) Delete(_ context.Context, id uuid.UUID) error
 	return nil
 }
 
+type mockCredentialDocRepository struct {
+	docs map[uuid.UUID]*domain.CredentialDocument
+}
+
+func newMockCredentialDocRepository() *mockCredentialDocRepository {
+	return &mockCredentialDocRepository{docs: make(map[uuid.UUID]*domain.CredentialDocument)}
+}
+
+func (r *mockCredentialDocRepository) Create(_ context.Context, doc *domain.CredentialDocument) error {
+	r.docs[doc.ID] = doc
+	return nil
+}
+
+func (r *mockCredentialDocRepository) GetByID(_ context.Context, id uuid.UUID) (*domain.CredentialDocument, error) {
+	doc, ok := r.docs[id]
+	if !ok {
+		return nil, nil
+	}
+	return doc, nil
+}
+
+func (r *mockCredentialDocRepository) GetByUserID(_ context.Context, userID uuid.UUID) ([]*domain.CredentialDocument, error) {
+	var result []*domain.CredentialDocument
+	for _, doc := range r.docs {
+		if doc.UserID == userID {
+			result = append(result, doc)
+		}
+	}
+	return result, nil
+}
+
+func (r *mockCredentialDocRepository) Update(_ context.Context, doc *domain.CredentialDocument) error {
+	r.docs[doc.ID] = doc
+	return nil
+}
+
+func (r *mockCredentialDocRepository) Delete(_ context.Context, id uuid.UUID) error {
+	delete(r.docs, id)
+	return nil
+}
+
 type mockFileRepository struct {
 	files map[uuid.UUID]*domain.File
 }
@@ -195,6 +236,10 @@ func (r *mockProfileRepository) Delete(_ context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func (r *mockProfileRepository) ReorderItems(_ context.Context, _ uuid.UUID, _ *domain.ProfileItemOrder) error {
+	return nil
+}
+
 type mockProfileSkillRepository struct {
 	skills map[uuid.UUID]*domain.ProfileSkill
 }
@@ -261,6 +306,33 @@ func (r *mockProfileSkillRepository) DeleteBySourceResumeID(_ context.Context, s
 	return nil
 }
 
+func (r *mockProfileSkillRepository) UpdateAll(_ context.Context, skills []*domain.ProfileSkill) error {
+	for _, skill := range skills {
+		r.skills[skill.ID] = skill
+	}
+	return nil
+}
+
+func (r *mockProfileSkillRepository) UpdateStats(_ context.Context, skills []*domain.ProfileSkill) error {
+	for _, skill := range skills {
+		if stored, ok := r.skills[skill.ID]; ok {
+			stored.YearsOfExperience = skill.YearsOfExperience
+			stored.LastUsed = skill.LastUsed
+			stored.EvidenceStrength = skill.EvidenceStrength
+		}
+	}
+	return nil
+}
+
+func (r *mockProfileSkillRepository) Merge(_ context.Context, targetID uuid.UUID, _ []uuid.UUID) (*domain.ProfileSkill, error) {
+	return r.skills[targetID], nil
+}
+
+func (r *mockProfileSkillRepository) Split(_ context.Context, skillID uuid.UUID, _ []*domain.ProfileSkill) error {
+	delete(r.skills, skillID)
+	return nil
+}
+
 // mockDownloadStorage supports Download with in-memory data.
 type mockDownloadStorage struct {
 	data  []byte
@@ -304,6 +376,10 @@ type mockDocExtractor struct {
 	resumeErr         error
 	letterData        *domain.ExtractedLetterData
 	letterErr         error
+	certificateData   *domain.ExtractedCertificateData
+	transcriptData    *domain.ExtractedTranscriptData
+	reviewData        *domain.ExtractedPerformanceReviewData
+	credentialErr     error
 }
 
 func (e *mockDocExtractor) ExtractText(_ context.Context, _ []byte, _ string) (string, error) {
@@ -322,6 +398,22 @@ func (e *mockDocExtractor) DetectDocumentContent(_ context.Context, _ string) (*
 	return nil, nil
 }
 
+func (e *mockDocExtractor) ExtractCertificateData(_ context.Context, _ string) (*domain.ExtractedCertificateData, error) {
+	return e.certificateData, e.credentialErr
+}
+
+func (e *mockDocExtractor) ExtractTranscriptData(_ context.Context, _ string) (*domain.ExtractedTranscriptData, error) {
+	return e.transcriptData, e.credentialErr
+}
+
+func (e *mockDocExtractor) ExtractPerformanceReviewData(_ context.Context, _ string) (*domain.ExtractedPerformanceReviewData, error) {
+	return e.reviewData, e.credentialErr
+}
+
+func (e *mockDocExtractor) ExtractJobDescriptionData(_ context.Context, _ string) (*domain.ExtractedJobDescriptionData, error) {
+	return nil, nil
+}
+
 // testLogger returns a logger that discards all output (for tests).
 func testLogger() logger.Logger {
 	return logger.NewStdoutLogger(logger.WithMinLevel(logger.Severity(100))) // level 100 = discard all
@@ -348,7 +440,7 @@ func TestDocumentProcessingArgs_InsertOpts_MaxAttempts(t *testing.T) {
 
 func TestDocumentProcessingWorker_Timeout_TenMinutes(t *testing.T) {
 	worker := job.NewDocumentProcessingWorker(
-		newMockResumeRepository(), newMockRefLetterRepository(), newMockFileRepository(),
+		newMockResumeRepository(), newMockRefLetterRepository(), newMockCredentialDocRepository(), newMockFileRepository(),
 		newMockProfileRepository(), newMockProfileSkillRepository(),
 		newMockDownloadStorage(nil), &mockDocExtractor{}, testLogger(),
 	)
@@ -381,7 +473,7 @@ func TestDocumentProcessingWorker_ResumeOnly(t *testing.T) {
 	}
 
 	worker := job.NewDocumentProcessingWorker(
-		resumeRepo, newMockRefLetterRepository(), fileRepo,
+		resumeRepo, newMockRefLetterRepository(), newMockCredentialDocRepository(), fileRepo,
 		newMockProfileRepository(), newMockProfileSkillRepository(),
 		newMockDownloadStorage([]byte("pdf data")), extractor, testLogger(),
 	)
@@ -451,7 +543,7 @@ func TestDocumentProcessingWorker_LetterOnly(t *testing.T) {
 	}
 
 	worker := job.NewDocumentProcessingWorker(
-		newMockResumeRepository(), refLetterRepo, fileRepo,
+		newMockResumeRepository(), refLetterRepo, newMockCredentialDocRepository(), fileRepo,
 		newMockProfileRepository(), newMockProfileSkillRepository(),
 		newMockDownloadStorage([]byte("pdf data")), extractor, testLogger(),
 	)
@@ -511,7 +603,7 @@ func TestDocumentProcessingWorker_DualExtraction(t *testing.T) {
 	}
 
 	worker := job.NewDocumentProcessingWorker(
-		resumeRepo, refLetterRepo, fileRepo,
+		resumeRepo, refLetterRepo, newMockCredentialDocRepository(), fileRepo,
 		newMockProfileRepository(), newMockProfileSkillRepository(),
 		newMockDownloadStorage([]byte("pdf data")), extractor, testLogger(),
 	)
@@ -567,7 +659,7 @@ func TestDocumentProcessingWorker_TextExtractionFails_MarksBothFailed(t *testing
 	extractor := &mockDocExtractor{extractTextErr: errors.New("OCR failure")}
 
 	worker := job.NewDocumentProcessingWorker(
-		resumeRepo, refLetterRepo, fileRepo,
+		resumeRepo, refLetterRepo, newMockCredentialDocRepository(), fileRepo,
 		newMockProfileRepository(), newMockProfileSkillRepository(),
 		newMockDownloadStorage([]byte("corrupt data")), extractor, testLogger(),
 	)
@@ -627,7 +719,7 @@ func TestDocumentProcessingWorker_ResumeExtractFails_LetterSucceeds(t *testing.T
 	}
 
 	worker := job.NewDocumentProcessingWorker(
-		resumeRepo, refLetterRepo, fileRepo,
+		resumeRepo, refLetterRepo, newMockCredentialDocRepository(), fileRepo,
 		newMockProfileRepository(), newMockProfileSkillRepository(),
 		newMockDownloadStorage([]byte("pdf data")), extractor, testLogger(),
 	)
@@ -667,6 +759,110 @@ func TestDocumentProcessingWorker_ResumeExtractFails_LetterSucceeds(t *testing.T
 	}
 }
 
+func TestDocumentProcessingWorker_CredentialExtraction(t *testing.T) {
+	ctx := context.Background()
+	credentialRepo := newMockCredentialDocRepository()
+	fileRepo := newMockFileRepository()
+
+	certID := uuid.New()
+	transcriptID := uuid.New()
+	userID := uuid.New()
+	fileID := uuid.New()
+
+	_ = credentialRepo.Create(ctx, &domain.CredentialDocument{ID: certID, UserID: userID, FileID: fileID, DocumentType: domain.CredentialDocumentTypeCertificate, Status: domain.CredentialDocumentStatusPending})      //nolint:errcheck // test setup
+	_ = credentialRepo.Create(ctx, &domain.CredentialDocument{ID: transcriptID, UserID: userID, FileID: fileID, DocumentType: domain.CredentialDocumentTypeTranscript, Status: domain.CredentialDocumentStatusPending}) //nolint:errcheck // test setup
+	_ = fileRepo.Create(ctx, &domain.File{ID: fileID, ContentType: "application/pdf"})                                                                                                                                 //nolint:errcheck // test setup
+
+	extractor := &mockDocExtractor{
+		extractTextResult: "AWS Certified Solutions Architect",
+		certificateData:   &domain.ExtractedCertificateData{Name: "AWS Certified Solutions Architect", Issuer: "Amazon Web Services"},
+		transcriptData:    &domain.ExtractedTranscriptData{Institution: "MIT"},
+	}
+
+	worker := job.NewDocumentProcessingWorker(
+		newMockResumeRepository(), newMockRefLetterRepository(), credentialRepo, fileRepo,
+		newMockProfileRepository(), newMockProfileSkillRepository(),
+		newMockDownloadStorage([]byte("pdf data")), extractor, testLogger(),
+	)
+
+	riverJob := &river.Job[job.DocumentProcessingArgs]{
+		Args: job.DocumentProcessingArgs{
+			StorageKey:            "test/cert.pdf",
+			FileID:                fileID,
+			ContentType:           "application/pdf",
+			UserID:                userID,
+			CredentialDocumentIDs: []uuid.UUID{certID, transcriptID},
+		},
+	}
+
+	if err := worker.Work(ctx, riverJob); err != nil {
+		t.Fatalf("Work() error = %v", err)
+	}
+
+	cert, _ := credentialRepo.GetByID(ctx, certID) //nolint:errcheck // mock never fails
+	if cert.Status != domain.CredentialDocumentStatusCompleted {
+		t.Errorf("certificate status = %s, want %s", cert.Status, domain.CredentialDocumentStatusCompleted)
+	}
+	var certData domain.ExtractedCertificateData
+	if err := json.Unmarshal(cert.ExtractedData, &certData); err != nil {
+		t.Fatalf("failed to unmarshal certificate data: %v", err)
+	}
+	if certData.Issuer != "Amazon Web Services" {
+		t.Errorf("certificate issuer = %q, want %q", certData.Issuer, "Amazon Web Services")
+	}
+
+	transcript, _ := credentialRepo.GetByID(ctx, transcriptID) //nolint:errcheck // mock never fails
+	if transcript.Status != domain.CredentialDocumentStatusCompleted {
+		t.Errorf("transcript status = %s, want %s", transcript.Status, domain.CredentialDocumentStatusCompleted)
+	}
+}
+
+func TestDocumentProcessingWorker_CredentialExtractionFails_MarksFailed(t *testing.T) {
+	ctx := context.Background()
+	credentialRepo := newMockCredentialDocRepository()
+	fileRepo := newMockFileRepository()
+
+	docID := uuid.New()
+	userID := uuid.New()
+	fileID := uuid.New()
+
+	_ = credentialRepo.Create(ctx, &domain.CredentialDocument{ID: docID, UserID: userID, FileID: fileID, DocumentType: domain.CredentialDocumentTypePerformanceReview, Status: domain.CredentialDocumentStatusPending}) //nolint:errcheck // test setup
+	_ = fileRepo.Create(ctx, &domain.File{ID: fileID, ContentType: "application/pdf"})                                                                                                                             //nolint:errcheck // test setup
+
+	extractor := &mockDocExtractor{
+		extractTextResult: "Annual review",
+		credentialErr:     errors.New("LLM timeout"),
+	}
+
+	worker := job.NewDocumentProcessingWorker(
+		newMockResumeRepository(), newMockRefLetterRepository(), credentialRepo, fileRepo,
+		newMockProfileRepository(), newMockProfileSkillRepository(),
+		newMockDownloadStorage([]byte("pdf data")), extractor, testLogger(),
+	)
+
+	riverJob := &river.Job[job.DocumentProcessingArgs]{
+		Args: job.DocumentProcessingArgs{
+			StorageKey:            "test/review.pdf",
+			FileID:                fileID,
+			ContentType:           "application/pdf",
+			UserID:                userID,
+			CredentialDocumentIDs: []uuid.UUID{docID},
+		},
+	}
+
+	if err := worker.Work(ctx, riverJob); err == nil {
+		t.Fatal("expected error when credential extraction fails")
+	}
+
+	doc, _ := credentialRepo.GetByID(ctx, docID) //nolint:errcheck // mock never fails
+	if doc.Status != domain.CredentialDocumentStatusFailed {
+		t.Errorf("status = %s, want %s", doc.Status, domain.CredentialDocumentStatusFailed)
+	}
+	if doc.ErrorMessage == nil {
+		t.Error("expected error message to be set")
+	}
+}
+
 func TestDocumentProcessingWorker_DownloadFails(t *testing.T) {
 	ctx := context.Background()
 	resumeRepo := newMockResumeRepository()
@@ -683,7 +879,7 @@ func TestDocumentProcessingWorker_DownloadFails(t *testing.T) {
 	storage.dlErr = fmt.Errorf("network error")
 
 	worker := job.NewDocumentProcessingWorker(
-		resumeRepo, newMockRefLetterRepository(), fileRepo,
+		resumeRepo, newMockRefLetterRepository(), newMockCredentialDocRepository(), fileRepo,
 		newMockProfileRepository(), newMockProfileSkillRepository(),
 		storage, &mockDocExtractor{}, testLogger(),
 	)
@@ -731,7 +927,7 @@ func TestDocumentProcessingWorker_FallbackToFileContentType(t *testing.T) {
 	}
 
 	worker := job.NewDocumentProcessingWorker(
-		resumeRepo, newMockRefLetterRepository(), fileRepo,
+		resumeRepo, newMockRefLetterRepository(), newMockCredentialDocRepository(), fileRepo,
 		newMockProfileRepository(), newMockProfileSkillRepository(),
 		newMockDownloadStorage([]byte("data")), extractor, testLogger(),
 	)
@@ -786,7 +982,7 @@ func TestDocumentProcessingWorker_UsesStoredExtractedText(t *testing.T) {
 	storage.dlErr = errors.New("Download should not be called when stored text exists")
 
 	worker := job.NewDocumentProcessingWorker(
-		resumeRepo, newMockRefLetterRepository(), fileRepo,
+		resumeRepo, newMockRefLetterRepository(), newMockCredentialDocRepository(), fileRepo,
 		newMockProfileRepository(), newMockProfileSkillRepository(),
 		storage, extractor, testLogger(),
 	)
@@ -836,7 +1032,7 @@ func TestDocumentProcessingWorker_FallsBackToExtractionWhenNoStoredText(t *testi
 	}
 
 	worker := job.NewDocumentProcessingWorker(
-		resumeRepo, newMockRefLetterRepository(), fileRepo,
+		resumeRepo, newMockRefLetterRepository(), newMockCredentialDocRepository(), fileRepo,
 		newMockProfileRepository(), newMockProfileSkillRepository(),
 		newMockDownloadStorage([]byte("pdf data")), extractor, testLogger(),
 	)
@@ -0,0 +1,115 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+
+	"backend/internal/domain"
+	"backend/internal/logger"
+	"backend/internal/service"
+)
+
+// EvidenceEmbeddingArgs contains the arguments for an evidence embedding job.
+type EvidenceEmbeddingArgs struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// Kind returns the job type identifier for River.
+func (EvidenceEmbeddingArgs) Kind() string {
+	return "evidence_embedding"
+}
+
+// InsertOpts returns default insert options. Running the job again is cheap: evidence
+// embedded before and unchanged since is skipped.
+func (EvidenceEmbeddingArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{MaxAttempts: 3}
+}
+
+// EvidenceEmbeddingWorker embeds the evidence and skill names of a user's profile after
+// materialization or an edit, so that evidence search and semantic skill matching see it.
+type EvidenceEmbeddingWorker struct {
+	river.WorkerDefaults[EvidenceEmbeddingArgs]
+	profileRepo  domain.ProfileRepository
+	embeddingSvc *service.EmbeddingService
+	log          logger.Logger
+}
+
+// NewEvidenceEmbeddingWorker creates a new evidence embedding worker. If embeddingSvc is
+// nil, because no embedder is configured, jobs complete without doing anything.
+func NewEvidenceEmbeddingWorker(profileRepo domain.ProfileRepository, embeddingSvc *service.EmbeddingService, log logger.Logger) *EvidenceEmbeddingWorker {
+	return &EvidenceEmbeddingWorker{
+		profileRepo:  profileRepo,
+		embeddingSvc: embeddingSvc,
+		log:          log,
+	}
+}
+
+// Timeout overrides River's default 60s job timeout, leaving room for a profile with
+// many testimonials to be embedded in several requests.
+func (w *EvidenceEmbeddingWorker) Timeout(*river.Job[EvidenceEmbeddingArgs]) time.Duration {
+	return 5 * time.Minute
+}
+
+// Work embeds the evidence of the user's profile that is new or changed.
+func (w *EvidenceEmbeddingWorker) Work(ctx context.Context, job *river.Job[EvidenceEmbeddingArgs]) error {
+	if w.embeddingSvc == nil {
+		w.log.Debug("Skipping evidence embedding (no embedder configured)",
+			logger.Feature("jobs"),
+			logger.String("user_id", job.Args.UserID.String()),
+		)
+		return nil
+	}
+
+	profile, err := w.profileRepo.GetByUserID(ctx, job.Args.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get profile: %w", err)
+	}
+	if profile == nil {
+		w.log.Warning("No profile to embed evidence for",
+			logger.Feature("jobs"),
+			logger.String("user_id", job.Args.UserID.String()),
+		)
+		return nil
+	}
+
+	result, err := w.embeddingSvc.EmbedProfile(ctx, profile.ID)
+	if err != nil {
+		w.log.Error("Evidence embedding failed",
+			logger.Feature("jobs"),
+			logger.String("profile_id", profile.ID.String()),
+			logger.Err(err),
+		)
+		return fmt.Errorf("failed to embed evidence: %w", err)
+	}
+
+	w.log.Info("Evidence embedding completed",
+		logger.Feature("jobs"),
+		logger.String("profile_id", profile.ID.String()),
+		logger.Int("embedded", result.Embedded),
+		logger.Int("unchanged", result.Unchanged),
+		logger.Int("removed", result.Removed),
+	)
+	return nil
+}
+
+// enqueueEvidenceEmbedding queues embedding the evidence of the user's profile from inside
+// a running job. Failing to queue it is logged, not returned: the materialized data is
+// saved either way, and the next materialization queues it again.
+func enqueueEvidenceEmbedding(ctx context.Context, userID uuid.UUID, log logger.Logger) {
+	client, err := river.ClientFromContextSafely[pgx.Tx](ctx)
+	if err == nil {
+		_, err = client.Insert(ctx, EvidenceEmbeddingArgs{UserID: userID}, nil)
+	}
+	if err != nil {
+		log.Warning("Failed to enqueue evidence embedding",
+			logger.Feature("jobs"),
+			logger.String("user_id", userID.String()),
+			logger.Err(err),
+		)
+	}
+}
@@ -0,0 +1,39 @@
+package job_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"backend/internal/job"
+	"backend/internal/service"
+)
+
+func TestEvidenceEmbeddingArgs_Kind(t *testing.T) {
+	if kind := (job.EvidenceEmbeddingArgs{}).Kind(); kind != "evidence_embedding" {
+		t.Errorf("Kind = %q, want evidence_embedding", kind)
+	}
+	if opts := (job.EvidenceEmbeddingArgs{}).InsertOpts(); opts.MaxAttempts != 3 {
+		t.Errorf("MaxAttempts = %d, want 3", opts.MaxAttempts)
+	}
+}
+
+func TestEvidenceEmbeddingWorker_SkipsWithoutEmbedder(t *testing.T) {
+	worker := job.NewEvidenceEmbeddingWorker(newMockProfileRepository(), nil, testLogger())
+	riverJob := &river.Job[job.EvidenceEmbeddingArgs]{Args: job.EvidenceEmbeddingArgs{UserID: uuid.New()}}
+	if err := worker.Work(context.Background(), riverJob); err != nil {
+		t.Errorf("Work() error = %v, want nil", err)
+	}
+}
+
+func TestEvidenceEmbeddingWorker_SkipsUserWithoutProfile(t *testing.T) {
+	// The service is never reached, so it needs no repositories.
+	svc := service.NewEmbeddingService(nil, nil, nil, nil, nil, nil)
+	worker := job.NewEvidenceEmbeddingWorker(newMockProfileRepository(), svc, testLogger())
+	riverJob := &river.Job[job.EvidenceEmbeddingArgs]{Args: job.EvidenceEmbeddingArgs{UserID: uuid.New()}}
+	if err := worker.Work(context.Background(), riverJob); err != nil {
+		t.Errorf("Work() error = %v, want nil", err)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,6 +14,7 @@ import (
 
 	"backend/internal/domain"
 	"backend/internal/logger"
+	"backend/internal/taxonomy"
 )
 
 // ReferenceLetterProcessingArgs contains the arguments for a reference letter processing job.
@@ -322,9 +322,10 @@ func (w *ReferenceLetterProcessingWorker) updateStatusFailed(ctx context.Context
 	}
 }
 
-// normalizeSkillName produces a lowercase, trimmed version of a skill name for matching.
+// normalizeSkillName normalizes a skill name through the bundled skill taxonomy,
+// so synonyms such as "k8s" and "Kubernetes" share a key.
 func normalizeSkillName(name string) string {
-	return strings.ToLower(strings.TrimSpace(name))
+	return taxonomy.Default().Normalize(name)
 }
 
 // getProfileSkillsContext fetches the user's profile skills and returns:
@@ -383,12 +384,18 @@ func mapAuthorToTestimonialRelationship(ar domain.AuthorRelationship) domain.Tes
 	switch ar {
 	case domain.AuthorRelationshipManager:
 		return domain.TestimonialRelationshipManager
-	case domain.AuthorRelationshipPeer, domain.AuthorRelationshipColleague:
+	case domain.AuthorRelationshipPeer:
 		return domain.TestimonialRelationshipPeer
+	case domain.AuthorRelationshipColleague:
+		return domain.TestimonialRelationshipColleague
 	case domain.AuthorRelationshipDirectReport:
 		return domain.TestimonialRelationshipDirectReport
 	case domain.AuthorRelationshipClient:
 		return domain.TestimonialRelationshipClient
+	case domain.AuthorRelationshipMentor:
+		return domain.TestimonialRelationshipMentor
+	case domain.AuthorRelationshipProfessor:
+		return domain.TestimonialRelationshipProfessor
 	default:
 		return domain.TestimonialRelationshipOther
 	}
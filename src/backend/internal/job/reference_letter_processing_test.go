@@ -189,6 +189,22 @@ func (e *mockDocumentExtractor) DetectDocumentContent(_ context.Context, _ strin
 	return nil, nil
 }
 
+func (e *mockDocumentExtractor) ExtractCertificateData(_ context.Context, _ string) (*domain.ExtractedCertificateData, error) {
+	return nil, nil
+}
+
+func (e *mockDocumentExtractor) ExtractTranscriptData(_ context.Context, _ string) (*domain.ExtractedTranscriptData, error) {
+	return nil, nil
+}
+
+func (e *mockDocumentExtractor) ExtractPerformanceReviewData(_ context.Context, _ string) (*domain.ExtractedPerformanceReviewData, error) {
+	return nil, nil
+}
+
+func (e *mockDocumentExtractor) ExtractJobDescriptionData(_ context.Context, _ string) (*domain.ExtractedJobDescriptionData, error) {
+	return nil, nil
+}
+
 // mockAuthorRepository implements domain.AuthorRepository for testing.
 // Note: mockProfileRepository and mockProfileSkillRepository are defined in resume_processing_test.go
 type mockAuthorRepository struct {
@@ -316,6 +332,15 @@ func (r *mockTestimonialRepository) DeleteByReferenceLetterID(_ context.Context,
 	return nil
 }
 
+func (r *mockTestimonialRepository) DeleteByCredentialDocumentID(_ context.Context, credentialDocumentID uuid.UUID) error {
+	for id, t := range r.testimonials {
+		if t.CredentialDocumentID != nil && *t.CredentialDocumentID == credentialDocumentID {
+			delete(r.testimonials, id)
+		}
+	}
+	return nil
+}
+
 // mockSkillValidationRepository implements domain.SkillValidationRepository for testing.
 type mockSkillValidationRepository struct {
 	validations map[uuid.UUID]*domain.SkillValidation
@@ -1113,9 +1138,11 @@ func TestMapAuthorToTestimonialRelationship(t *testing.T) {
 	}{
 		{domain.AuthorRelationshipManager, domain.TestimonialRelationshipManager},
 		{domain.AuthorRelationshipPeer, domain.TestimonialRelationshipPeer},
-		{domain.AuthorRelationshipColleague, domain.TestimonialRelationshipPeer},
+		{domain.AuthorRelationshipColleague, domain.TestimonialRelationshipColleague},
 		{domain.AuthorRelationshipDirectReport, domain.TestimonialRelationshipDirectReport},
 		{domain.AuthorRelationshipClient, domain.TestimonialRelationshipClient},
+		{domain.AuthorRelationshipMentor, domain.TestimonialRelationshipMentor},
+		{domain.AuthorRelationshipProfessor, domain.TestimonialRelationshipProfessor},
 		{domain.AuthorRelationshipOther, domain.TestimonialRelationshipOther},
 	}
 
@@ -188,6 +188,7 @@ func (w *ResumeProcessingWorker) Work(ctx context.Context, job *river.Job[Resume
 				logger.Int("education_count", len(extractedData.Education)),
 				logger.Int("skills_count", len(extractedData.Skills)),
 			)
+			enqueueEvidenceEmbedding(ctx, resume.UserID, w.log)
 		}
 	}
 
@@ -38,6 +38,22 @@ func (e *resumeMockExtractor) DetectDocumentContent(_ context.Context, _ string)
 	return nil, nil
 }
 
+func (e *resumeMockExtractor) ExtractCertificateData(_ context.Context, _ string) (*domain.ExtractedCertificateData, error) {
+	return nil, nil
+}
+
+func (e *resumeMockExtractor) ExtractTranscriptData(_ context.Context, _ string) (*domain.ExtractedTranscriptData, error) {
+	return nil, nil
+}
+
+func (e *resumeMockExtractor) ExtractPerformanceReviewData(_ context.Context, _ string) (*domain.ExtractedPerformanceReviewData, error) {
+	return nil, nil
+}
+
+func (e *resumeMockExtractor) ExtractJobDescriptionData(_ context.Context, _ string) (*domain.ExtractedJobDescriptionData, error) {
+	return nil, nil
+}
+
 // resumeMockFileRepository implements domain.FileRepository for testing.
 type resumeMockFileRepository struct{}
 
@@ -63,6 +63,10 @@ func (r *mockProfileRepository) Delete(_ context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func (r *mockProfileRepository) ReorderItems(_ context.Context, _ uuid.UUID, _ *domain.ProfileItemOrder) error {
+	return nil
+}
+
 // mockProfileSkillRepository implements domain.ProfileSkillRepository for testing.
 type mockProfileSkillRepository struct {
 	skills              map[uuid.UUID]*domain.ProfileSkill
@@ -158,3 +162,30 @@ func (r *mockProfileSkillRepository) DeleteBySourceResumeID(_ context.Context, s
 	}
 	return nil
 }
+
+func (r *mockProfileSkillRepository) UpdateAll(_ context.Context, skills []*domain.ProfileSkill) error {
+	for _, skill := range skills {
+		r.skills[skill.ID] = skill
+	}
+	return nil
+}
+
+func (r *mockProfileSkillRepository) UpdateStats(_ context.Context, skills []*domain.ProfileSkill) error {
+	for _, skill := range skills {
+		if stored, ok := r.skills[skill.ID]; ok {
+			stored.YearsOfExperience = skill.YearsOfExperience
+			stored.LastUsed = skill.LastUsed
+			stored.EvidenceStrength = skill.EvidenceStrength
+		}
+	}
+	return nil
+}
+
+func (r *mockProfileSkillRepository) Merge(_ context.Context, targetID uuid.UUID, _ []uuid.UUID) (*domain.ProfileSkill, error) {
+	return r.skills[targetID], nil
+}
+
+func (r *mockProfileSkillRepository) Split(_ context.Context, skillID uuid.UUID, _ []*domain.ProfileSkill) error {
+	delete(r.skills, skillID)
+	return nil
+}
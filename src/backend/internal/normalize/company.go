@@ -0,0 +1,281 @@
+package normalize
+
+import (
+	"regexp"
+	"strings"
+)
+
+// CompanyMatchThreshold is the minimum CompanySimilarity at which two names are
+// treated as the same company.
+const CompanyMatchThreshold = 0.8
+
+// legalSuffixes lists legal-form designators, as folded token sequences, that are
+// stripped from the end of company names. Dotted forms ("S.A.", "B.V.") are already
+// collapsed by Tokens, and multi-word forms are stripped as a unit.
+var legalSuffixes = [][]string{
+	// Common law jurisdictions
+	{"inc"}, {"incorporated"}, {"corp"}, {"corporation"}, {"co"}, {"company"},
+	{"ltd"}, {"limited"}, {"llc"}, {"llp"}, {"lp"}, {"plc"}, {"pllc"}, {"pc"},
+	{"pty"}, {"pvt"}, {"pte"}, {"bhd"}, {"sdn"},
+	// German-speaking countries
+	{"gmbh"}, {"mbh"}, {"gesmbh"}, {"ag"}, {"kg"}, {"kgaa"}, {"ohg"}, {"ug"}, {"eg"}, {"ev"},
+	// Romance languages
+	{"sa"}, {"sas"}, {"sarl"}, {"sasu"}, {"srl"}, {"spa"}, {"sl"}, {"slu"}, {"ltda"}, {"sc"},
+	{"s", "de", "rl"}, {"de", "cv"},
+	// Benelux and Nordics
+	{"bv"}, {"nv"}, {"vof"}, {"ab"}, {"as"}, {"asa"}, {"aps"}, {"oy"}, {"oyj"}, {"hf"}, {"ehf"},
+	{"a", "s"},
+	// Central and Eastern Europe
+	{"sp", "z", "oo"}, {"spzoo"}, {"sro"}, {"kft"}, {"zrt"}, {"nyrt"}, {"doo"}, {"ood"}, {"ooo"}, {"zao"}, {"oao"},
+	// Europe-wide and Asia
+	{"se"}, {"kk"}, {"gk"},
+}
+
+// companyDescriptors are words that describe a business rather than identify it.
+// They count less in similarity scoring, so "Acme" and "Acme Technologies" still match
+// while "Acme" and "Acme Robotics" do not.
+var companyDescriptors = map[string]bool{
+	"group": true, "holding": true, "holdings": true, "international": true, "global": true,
+	"technologies": true, "technology": true, "tech": true, "labs": true, "lab": true,
+	"software": true, "systems": true, "solutions": true, "services": true, "industries": true,
+	"enterprises": true, "ventures": true, "partners": true, "worldwide": true,
+}
+
+// companyDescriptorWeight is the similarity weight of a descriptor word relative to
+// an identifying word.
+const companyDescriptorWeight = 0.3
+
+// tradeNamePattern splits "Legal Name DBA Trade Name" into its two names.
+var tradeNamePattern = regexp.MustCompile(`(?i)\s*[,(]?\s*\b(?:dba|d/b/a|d\.b\.a\.|doing business as|trading as|t/a)\b\s*`)
+
+// CompanyNames returns the names a company string refers to. Usually this is just the
+// input, but "AL Talent, Inc. DBA Wellfound" yields both the legal and the trade name.
+func CompanyNames(name string) []string {
+	var names []string
+	for _, part := range tradeNamePattern.Split(name, -1) {
+		part = strings.Trim(strings.TrimSpace(part), "()")
+		if part != "" {
+			names = append(names, part)
+		}
+	}
+	return names
+}
+
+// CompanyTokens folds a company name into words and strips trailing legal-form
+// designators, so "ACME Corporation GmbH" and "Acme Corp." both yield ["acme"].
+// A leading "The" is dropped as well. At least one word is always kept.
+func CompanyTokens(name string) []string {
+	tokens := Tokens(name)
+	if len(tokens) > 1 && tokens[0] == "the" {
+		tokens = tokens[1:]
+	}
+	for {
+		stripped := false
+		for _, suffix := range legalSuffixes {
+			if len(tokens) > len(suffix) && hasSuffixTokens(tokens, suffix) {
+				tokens = tokens[:len(tokens)-len(suffix)]
+				stripped = true
+			}
+		}
+		if !stripped {
+			return tokens
+		}
+	}
+}
+
+// CompanyKey returns a canonical comparison key for a company name: its legal-form-free,
+// folded words joined by spaces. Use CompanySimilarity when near-matches should count too.
+func CompanyKey(name string) string {
+	return strings.Join(CompanyTokens(name), " ")
+}
+
+// CompanySimilarity scores how likely two company names refer to the same company,
+// from 0 (unrelated) to 1 (same normalized name). It compares every legal/trade name
+// pair and returns the best score. Scores are a weighted Dice coefficient over words,
+// where descriptor words like "Technologies" weigh less. Single-character typos in
+// longer words are only tolerated once another identifying word matches exactly, so
+// "Acme Robotcs" matches "Acme Robotics" but "Stripe" does not match "Strive".
+func CompanySimilarity(a, b string) float64 {
+	return companySimilarity(a, b, false)
+}
+
+// CompaniesNearMatch reports whether two company names that do not match would match if
+// every word could carry a typo, as "Notion" and "Motion" or "Canva" and "Canvas" do.
+// Such names may be one company misspelled or two different companies, so they should be
+// suggested to the user rather than linked.
+func CompaniesNearMatch(a, b string) bool {
+	return (*CompanyMatcher)(nil).NearMatch(a, b)
+}
+
+func companySimilarity(a, b string, anyTypos bool) float64 {
+	best := 0.0
+	for _, na := range CompanyNames(a) {
+		for _, nb := range CompanyNames(b) {
+			if score := tokenSimilarity(CompanyTokens(na), CompanyTokens(nb), anyTypos); score > best {
+				best = score
+			}
+		}
+	}
+	return best
+}
+
+// CompaniesMatch reports whether two company names refer to the same company,
+// without consulting any alias table.
+func CompaniesMatch(a, b string) bool {
+	return (*CompanyMatcher)(nil).Match(a, b)
+}
+
+// CompanyMatcher matches company names using a user-maintained alias table on top
+// of CompanySimilarity, e.g. to tell it that "Facebook" and "Meta" are the same company.
+// A nil *CompanyMatcher matches without aliases.
+type CompanyMatcher struct {
+	canonicalByKey map[string]string
+}
+
+// NewCompanyMatcher creates a matcher from alias -> canonical name pairs. Aliases are
+// looked up by CompanyKey, so "Facebook, Inc." finds an alias entered as "Facebook".
+func NewCompanyMatcher(aliases map[string]string) *CompanyMatcher {
+	m := &CompanyMatcher{canonicalByKey: make(map[string]string, len(aliases))}
+	for alias, canonical := range aliases {
+		if key := CompanyKey(alias); key != "" {
+			m.canonicalByKey[key] = canonical
+		}
+	}
+	return m
+}
+
+// Canonical returns the canonical name for a company: the alias target if the name
+// (or one of its trade names) has an alias, otherwise the name itself.
+func (m *CompanyMatcher) Canonical(name string) string {
+	if m == nil {
+		return name
+	}
+	for _, n := range CompanyNames(name) {
+		if canonical, ok := m.canonicalByKey[CompanyKey(n)]; ok {
+			return canonical
+		}
+	}
+	return name
+}
+
+// Similarity is CompanySimilarity applied after resolving aliases.
+func (m *CompanyMatcher) Similarity(a, b string) float64 {
+	return CompanySimilarity(m.Canonical(a), m.Canonical(b))
+}
+
+// Match reports whether two company names refer to the same company.
+func (m *CompanyMatcher) Match(a, b string) bool {
+	return m.Similarity(a, b) >= CompanyMatchThreshold
+}
+
+// NearMatch is CompaniesNearMatch applied after resolving aliases.
+func (m *CompanyMatcher) NearMatch(a, b string) bool {
+	a, b = m.Canonical(a), m.Canonical(b)
+	return CompanySimilarity(a, b) < CompanyMatchThreshold && companySimilarity(a, b, true) >= CompanyMatchThreshold
+}
+
+func hasSuffixTokens(tokens, suffix []string) bool {
+	offset := len(tokens) - len(suffix)
+	for i, s := range suffix {
+		if tokens[offset+i] != s {
+			return false
+		}
+	}
+	return true
+}
+
+// tokenSimilarity computes a weighted Dice coefficient between two word lists. Words
+// match exactly first; typos are then tolerated in the remaining words if anyTypos is
+// set or an identifying word matched exactly.
+func tokenSimilarity(a, b []string, anyTypos bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	// "Face Book" vs "Facebook"
+	if strings.Join(a, "") == strings.Join(b, "") {
+		return 1
+	}
+
+	total := 0.0
+	for _, t := range a {
+		total += tokenWeight(t)
+	}
+	for _, t := range b {
+		total += tokenWeight(t)
+	}
+
+	usedA, usedB := make([]bool, len(a)), make([]bool, len(b))
+	shared := 0.0
+	// match pairs up the unused words that are equal and reports whether an identifying
+	// word was among them.
+	match := func(equal func(a, b string) bool) bool {
+		identifying := false
+		for i, ta := range a {
+			for j, tb := range b {
+				if usedA[i] || usedB[j] || !equal(ta, tb) {
+					continue
+				}
+				usedA[i], usedB[j] = true, true
+				shared += tokenWeight(ta) + tokenWeight(tb)
+				identifying = identifying || !companyDescriptors[ta]
+			}
+		}
+		return identifying
+	}
+	if match(func(a, b string) bool { return a == b }) || anyTypos {
+		match(tokensEqual)
+	}
+	return shared / total
+}
+
+func tokenWeight(token string) float64 {
+	if companyDescriptors[token] {
+		return companyDescriptorWeight
+	}
+	return 1
+}
+
+// tokensEqual compares words, tolerating one typo in words of five or more letters.
+func tokensEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) < 5 || len(rb) < 5 {
+		return false
+	}
+	return withinOneEdit(ra, rb)
+}
+
+// withinOneEdit reports whether a can be turned into b with at most one insertion,
+// deletion, substitution or swap of adjacent letters.
+func withinOneEdit(a, b []rune) bool {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	if len(b)-len(a) > 1 {
+		return false
+	}
+	i, j, edits := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		if a[i] == b[j] {
+			i++
+			j++
+			continue
+		}
+		edits++
+		if edits > 1 {
+			return false
+		}
+		if len(a) == len(b) && i+1 < len(a) && a[i] == b[j+1] && a[i+1] == b[j] {
+			i += 2
+			j += 2
+			continue
+		}
+		if len(a) == len(b) {
+			i++
+		}
+		j++
+	}
+	return edits+(len(b)-j)+(len(a)-i) <= 1
+}
@@ -0,0 +1,158 @@
+package normalize
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompanyKey(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Acme Corp.", "acme"},
+		{"ACME Corporation GmbH", "acme"},
+		{"Acme", "acme"},
+		{"Siemens AG", "siemens"},
+		{"Nestlé S.A.", "nestle"},
+		{"Philips B.V.", "philips"},
+		{"Robert Bosch GmbH & Co. KG", "robert bosch"},
+		{"Asseco Poland Sp. z o.o.", "asseco poland"},
+		{"Maersk A/S", "maersk"},
+		{"Grupo Bimbo, S.A.B. de C.V.", "grupo bimbo sab"},
+		{"The Boring Company", "boring"},
+		{"Limited", "limited"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			if got := CompanyKey(tc.input); got != tc.expected {
+				t.Errorf("CompanyKey(%q) = %q, want %q", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCompanyNames(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"Acme Corp", []string{"Acme Corp"}},
+		{"AL Talent, Inc. DBA Wellfound", []string{"AL Talent, Inc.", "Wellfound"}},
+		{"Initech LLC (d/b/a Initrode)", []string{"Initech LLC", "Initrode"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			if got := CompanyNames(tc.input); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("CompanyNames(%q) = %q, want %q", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCompaniesMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{"legal suffix variants", "Acme Corp.", "ACME Corporation GmbH", true},
+		{"bare name", "Acme", "Acme Inc", true},
+		{"descriptor word", "Acme", "Acme Technologies", true},
+		{"diacritics", "Société Générale", "Societe Generale SA", true},
+		{"typo next to an exact word", "Accenture Federal Services", "Accenture Federl Services", true},
+		{"typo alone is not enough", "Accenture", "Accentrue", false},
+		{"one letter apart", "Notion", "Motion", false},
+		{"one letter substituted", "Stripe", "Strive", false},
+		{"one letter apart, short", "Slack", "Stack", false},
+		{"one letter added", "Canva", "Canvas", false},
+		{"typo with only a descriptor in common", "Notion Labs", "Motion Labs", false},
+		{"spacing", "Face Book", "Facebook", true},
+		{"trade name", "AL Talent, Inc. DBA Wellfound", "Wellfound", true},
+		{"different product line", "Acme", "Acme Robotics", false},
+		{"unrelated", "Globex", "Initech", false},
+		{"short words need exact match", "Uber", "Ubex", false},
+		{"empty", "", "Acme", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := CompaniesMatch(tc.a, tc.b); got != tc.expected {
+				t.Errorf("CompaniesMatch(%q, %q) = %v (similarity %.2f), want %v", tc.a, tc.b, got, CompanySimilarity(tc.a, tc.b), tc.expected)
+			}
+			if got := CompaniesMatch(tc.b, tc.a); got != tc.expected {
+				t.Errorf("CompaniesMatch(%q, %q) = %v, want %v", tc.b, tc.a, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCompaniesNearMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{"typo in single word", "Accenture", "Accentrue", true},
+		{"one letter apart", "Notion", "Motion", true},
+		{"one letter added", "Canva Pty Ltd", "Canvas", true},
+		{"typo with only a descriptor in common", "Notion Labs", "Motion Labs", true},
+		{"matching names are not near matches", "Acme Corp.", "ACME Corporation GmbH", false},
+		{"unrelated", "Globex", "Initech", false},
+		{"short words need exact match", "Uber", "Ubex", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := CompaniesNearMatch(tc.a, tc.b); got != tc.expected {
+				t.Errorf("CompaniesNearMatch(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.expected)
+			}
+			if got := CompaniesNearMatch(tc.b, tc.a); got != tc.expected {
+				t.Errorf("CompaniesNearMatch(%q, %q) = %v, want %v", tc.b, tc.a, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCompanyMatcherAliases(t *testing.T) {
+	m := NewCompanyMatcher(map[string]string{
+		"Facebook":  "Meta Platforms",
+		"Meta":      "Meta Platforms",
+		"Alphabet":  "Google",
+		"Big Blue":  "IBM",
+		"Corp.":     "ignored",
+		"Initrode ": "Initech",
+	})
+
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{"both aliased to the same company", "Facebook, Inc.", "Meta", true},
+		{"alias to canonical name", "Alphabet Inc.", "Google LLC", true},
+		{"alias with whitespace", "Initrode", "Initech Corp", true},
+		{"unaliased names still use similarity", "Acme Corp", "Acme", true},
+		{"aliased to different companies", "Facebook", "Alphabet", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := m.Match(tc.a, tc.b); got != tc.expected {
+				t.Errorf("Match(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.expected)
+			}
+		})
+	}
+
+	if got := m.Canonical("Big Blue"); got != "IBM" {
+		t.Errorf("Canonical(%q) = %q, want %q", "Big Blue", got, "IBM")
+	}
+	if got := (*CompanyMatcher)(nil).Canonical("Acme"); got != "Acme" {
+		t.Errorf("nil matcher Canonical = %q, want %q", got, "Acme")
+	}
+}
@@ -0,0 +1,210 @@
+package normalize
+
+import (
+	"strconv"
+	"strings"
+
+	"backend/internal/domain"
+)
+
+// monthNames maps folded month names and abbreviations in English, German, French,
+// Spanish, Italian, Portuguese and Dutch to month numbers.
+var monthNames = map[string]int{
+	// January
+	"january": 1, "jan": 1, "januar": 1, "janner": 1, "jann": 1, "janvier": 1, "janv": 1,
+	"enero": 1, "ene": 1, "gennaio": 1, "gen": 1, "janeiro": 1, "januari": 1,
+	// February
+	"february": 2, "feb": 2, "febr": 2, "februar": 2, "fevrier": 2, "fevr": 2, "fev": 2,
+	"febrero": 2, "febbraio": 2, "fevereiro": 2, "februari": 2,
+	// March
+	"march": 3, "mar": 3, "marz": 3, "mrz": 3, "mars": 3, "marzo": 3, "marco": 3, "maart": 3, "mrt": 3,
+	// April
+	"april": 4, "apr": 4, "avril": 4, "avr": 4, "abril": 4, "abr": 4, "aprile": 4,
+	// May
+	"may": 5, "mai": 5, "mayo": 5, "maggio": 5, "mag": 5, "maio": 5, "mei": 5,
+	// June
+	"june": 6, "jun": 6, "juni": 6, "juin": 6, "junio": 6, "giugno": 6, "giu": 6, "junho": 6,
+	// July
+	"july": 7, "jul": 7, "juli": 7, "juillet": 7, "juil": 7, "julio": 7, "luglio": 7, "lug": 7, "julho": 7,
+	// August
+	"august": 8, "aug": 8, "aout": 8, "agosto": 8, "ago": 8, "augustus": 8,
+	// September
+	"september": 9, "sep": 9, "sept": 9, "septembre": 9, "septiembre": 9, "setiembre": 9,
+	"settembre": 9, "set": 9, "setembro": 9,
+	// October
+	"october": 10, "oct": 10, "oktober": 10, "okt": 10, "octobre": 10, "octubre": 10,
+	"ottobre": 10, "ott": 10, "outubro": 10, "out": 10,
+	// November
+	"november": 11, "nov": 11, "novembre": 11, "noviembre": 11, "novembro": 11,
+	// December
+	"december": 12, "dec": 12, "dezember": 12, "dez": 12, "decembre": 12, "diciembre": 12,
+	"dic": 12, "dicembre": 12, "dezembro": 12,
+}
+
+// seasonMonths maps folded season, semester and quarter names to the first month of the
+// span they name. Seasons follow the northern hemisphere and the academic calendar, so
+// "Winter 2019" is the term starting January 2019. German semesters start in April
+// (Sommersemester) and October (Wintersemester).
+var seasonMonths = map[string]int{
+	"spring": 3, "summer": 6, "fall": 9, "autumn": 9, "winter": 1,
+	"fruhling": 3, "fruhjahr": 3, "sommer": 6, "herbst": 9,
+	"printemps": 3, "ete": 6, "automne": 9, "hiver": 1,
+	"primavera": 3, "verano": 6, "otono": 9, "invierno": 1,
+	"estate": 6, "autunno": 9, "inverno": 1, "verao": 6, "outono": 9,
+	"lente": 3, "zomer": 6, "herfst": 9,
+	"sommersemester": 4, "ss": 4, "sose": 4, "fs": 3, "fruhjahrssemester": 3,
+	"wintersemester": 10, "ws": 10, "wise": 10, "hs": 9, "herbstsemester": 9,
+	"q1": 1, "q2": 4, "q3": 7, "q4": 10,
+}
+
+// dateFillers are folded words that may surround a date without changing it, as in
+// "since March 2019", "enero de 2019" or "Spring semester 2018".
+var dateFillers = map[string]bool{
+	"of": true, "the": true, "in": true, "since": true, "from": true, "until": true, "till": true, "to": true,
+	"im": true, "seit": true, "von": true, "ab": true, "bis": true,
+	"de": true, "del": true, "en": true, "le": true, "el": true, "desde": true, "hasta": true, "depuis": true,
+	"jusqua": true, "di": true, "dal": true, "fino": true, "al": true, "em": true, "ate": true,
+	"vanaf": true, "tot": true,
+	"semester": true, "semestre": true, "term": true, "quarter": true, "trimester": true,
+}
+
+// ongoingPhrases are folded phrases that stand for "now" at the end of a date range.
+var ongoingPhrases = map[string]bool{
+	"present": true, "current": true, "currently": true, "now": true, "today": true, "ongoing": true,
+	"to date": true, "till date": true, "until now": true, "till now": true, "to present": true,
+	"heute": true, "bis heute": true, "aktuell": true, "derzeit": true, "jetzt": true, "bis jetzt": true,
+	"laufend": true, "dato": true, "bis dato": true, "gegenwart": true,
+	"aujourdhui": true, "actuel": true, "actuellement": true, "en cours": true, "a ce jour": true,
+	"jusqua present": true, "jusqua aujourdhui": true, "a present": true,
+	"presente": true, "actualidad": true, "actual": true, "actualmente": true, "hoy": true,
+	"a la fecha": true, "hasta la fecha": true, "hasta hoy": true, "en curso": true,
+	"oggi": true, "attuale": true, "attualmente": true, "in corso": true, "ad oggi": true, "a oggi": true,
+	"atual": true, "atualmente": true, "hoje": true, "ate hoje": true, "o momento": true,
+	"ate o momento": true, "em andamento": true, "em curso": true,
+	"heden": true, "tot heden": true, "nu": true, "tot nu": true, "huidig": true,
+}
+
+// ParseDate parses a date as written on a resume or in a form, in any of the common
+// numeric layouts ("2019", "2019-03", "03/2019", "15.03.2019") or with a month or season
+// name in one of several languages ("March 2019", "März 2019", "enero de 2019",
+// "Spring 2018", "WS 2018/19"). Words for "now" ("Present", "heute", "actualidad") yield
+// a present date. The result is as precise as the text: a day is dropped, a bare year
+// stays a year. ok is false when the text is blank or not recognized as a date.
+//
+// Numeric dates with three parts are read day-first when dotted or when the first part
+// cannot be a month, and month-first otherwise, so "03/04/2019" is March.
+func ParseDate(s string) (domain.PartialDate, bool) {
+	tokens := Tokens(s)
+	if len(tokens) == 0 {
+		return domain.PartialDate{}, false
+	}
+	if ongoingPhrases[strings.Join(tokens, " ")] {
+		return domain.PartialDate{Precision: domain.DatePrecisionPresent}, true
+	}
+
+	year, yearPos := 0, -1
+	month, season := 0, 0
+	var numbers []int
+	for i, tok := range tokens {
+		if n, ok := parseYear(tok); ok {
+			if year != 0 {
+				return domain.PartialDate{}, false
+			}
+			year, yearPos = n, i
+			continue
+		}
+		if m, ok := monthNames[tok]; ok {
+			if month != 0 || season != 0 {
+				return domain.PartialDate{}, false
+			}
+			month = m
+			continue
+		}
+		if m, ok := seasonMonths[tok]; ok {
+			if month != 0 || season != 0 {
+				return domain.PartialDate{}, false
+			}
+			season = m
+			continue
+		}
+		if dateFillers[tok] {
+			continue
+		}
+		n, ok := parseDayOrMonth(tok)
+		if !ok {
+			return domain.PartialDate{}, false
+		}
+		numbers = append(numbers, n)
+	}
+	if year == 0 {
+		return domain.PartialDate{}, false
+	}
+
+	switch {
+	case season != 0:
+		// Allow the second year of an academic year, as in "WS 2018/19".
+		if len(numbers) > 1 || (len(numbers) == 1 && numbers[0] != (year+1)%100) {
+			return domain.PartialDate{}, false
+		}
+		return domain.PartialDate{Year: year, Month: season, Precision: domain.DatePrecisionSeason}, true
+	case month != 0:
+		// A remaining number is the day.
+		if len(numbers) > 1 {
+			return domain.PartialDate{}, false
+		}
+		return domain.PartialDate{Year: year, Month: month, Precision: domain.DatePrecisionMonth}, true
+	}
+
+	switch len(numbers) {
+	case 0:
+		return domain.PartialDate{Year: year, Precision: domain.DatePrecisionYear}, true
+	case 1:
+		month = numbers[0]
+	case 2:
+		switch {
+		case yearPos == 0:
+			month = numbers[0] // 2019-03-15
+		case strings.Contains(s, ".") || numbers[0] > 12:
+			month = numbers[1] // 15.03.2019, 15/03/2019
+		default:
+			month = numbers[0] // 03/15/2019
+		}
+	default:
+		return domain.PartialDate{}, false
+	}
+	if month < 1 || month > 12 {
+		return domain.PartialDate{}, false
+	}
+	return domain.PartialDate{Year: year, Month: month, Precision: domain.DatePrecisionMonth}, true
+}
+
+// parseYear accepts a four-digit year between 1900 and 2100.
+func parseYear(tok string) (int, bool) {
+	if len(tok) != 4 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(tok)
+	if err != nil || n < 1900 || n > 2100 {
+		return 0, false
+	}
+	return n, true
+}
+
+// parseDayOrMonth accepts a one- or two-digit number, with an English or French ordinal
+// suffix ("15th", "1er").
+func parseDayOrMonth(tok string) (int, bool) {
+	for _, suffix := range []string{"st", "nd", "rd", "th", "er"} {
+		if trimmed := strings.TrimSuffix(tok, suffix); trimmed != tok {
+			tok = trimmed
+			break
+		}
+	}
+	if len(tok) == 0 || len(tok) > 2 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(tok)
+	if err != nil || n < 1 || n > 31 {
+		return 0, false
+	}
+	return n, true
+}
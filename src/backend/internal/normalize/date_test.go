@@ -0,0 +1,86 @@
+package normalize
+
+import (
+	"testing"
+
+	"backend/internal/domain"
+)
+
+func TestParseDate(t *testing.T) {
+	month := func(year, m int) domain.PartialDate {
+		return domain.PartialDate{Year: year, Month: m, Precision: domain.DatePrecisionMonth}
+	}
+	season := func(year, m int) domain.PartialDate {
+		return domain.PartialDate{Year: year, Month: m, Precision: domain.DatePrecisionSeason}
+	}
+	present := domain.PartialDate{Precision: domain.DatePrecisionPresent}
+
+	tests := []struct {
+		input    string
+		expected domain.PartialDate
+	}{
+		// Numeric layouts
+		{"2019", domain.PartialDate{Year: 2019, Precision: domain.DatePrecisionYear}},
+		{"2019-03", month(2019, 3)},
+		{"2019/3", month(2019, 3)},
+		{"2019-03-15", month(2019, 3)},
+		{"03/2019", month(2019, 3)},
+		{"3.2019", month(2019, 3)},
+		{"15.03.2019", month(2019, 3)},
+		{"15/03/2019", month(2019, 3)},
+		{"03/15/2019", month(2019, 3)},
+		{"04.03.2019", month(2019, 3)},
+		// Month names
+		{"Mar 2019", month(2019, 3)},
+		{"March 2019", month(2019, 3)},
+		{"Sept. 2019", month(2019, 9)},
+		{"September, 2019", month(2019, 9)},
+		{"March 15th, 2019", month(2019, 3)},
+		{"März 2019", month(2019, 3)},
+		{"15. März 2019", month(2019, 3)},
+		{"Jänner 2020", month(2020, 1)},
+		{"1er février 2019", month(2019, 2)},
+		{"août 2018", month(2018, 8)},
+		{"enero de 2019", month(2019, 1)},
+		{"giugno 2017", month(2017, 6)},
+		{"março de 2016", month(2016, 3)},
+		{"mei 2015", month(2015, 5)},
+		{"since May 2019", month(2019, 5)},
+		// Seasons, semesters and quarters
+		{"Spring 2018", season(2018, 3)},
+		{"Fall semester 2018", season(2018, 9)},
+		{"Herbst 2018", season(2018, 9)},
+		{"WS 2018/19", season(2018, 10)},
+		{"SoSe 2019", season(2019, 4)},
+		{"Q3 2019", season(2019, 7)},
+		// Ongoing
+		{"Present", present},
+		{"current", present},
+		{"heute", present},
+		{"bis heute", present},
+		{"aujourd'hui", present},
+		{"actualidad", present},
+		{"to date", present},
+	}
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			got, ok := ParseDate(tc.input)
+			if !ok {
+				t.Fatalf("ParseDate(%q) failed", tc.input)
+			}
+			if got != tc.expected {
+				t.Errorf("ParseDate(%q) = %+v, want %+v", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestParseDateRejects(t *testing.T) {
+	for _, input := range []string{"", "  ", "13/2019", "March", "2019 2020", "Spring March 2019", "WS 2018/21", "sometime in 2019", "1850", "32.01.2019"} {
+		t.Run(input, func(t *testing.T) {
+			if got, ok := ParseDate(input); ok {
+				t.Errorf("ParseDate(%q) = %+v, want failure", input, got)
+			}
+		})
+	}
+}
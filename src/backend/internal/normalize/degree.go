@@ -0,0 +1,61 @@
+package normalize
+
+// degreeWordAliases maps folded degree abbreviations and word variants to their spelled-out
+// form, so "BSc" and "Bachelor of Science" compare equal.
+var degreeWordAliases = map[string]string{
+	"ba":        "bachelor arts",
+	"ab":        "bachelor arts",
+	"bs":        "bachelor science",
+	"bsc":       "bachelor science",
+	"sb":        "bachelor science",
+	"beng":      "bachelor engineering",
+	"bba":       "bachelor business administration",
+	"llb":       "bachelor laws",
+	"ma":        "master arts",
+	"am":        "master arts",
+	"ms":        "master science",
+	"msc":       "master science",
+	"sm":        "master science",
+	"meng":      "master engineering",
+	"mba":       "master business administration",
+	"mphil":     "master philosophy",
+	"llm":       "master laws",
+	"phd":       "doctor philosophy",
+	"dphil":     "doctor philosophy",
+	"md":        "doctor medicine",
+	"doctorate": "doctor",
+	"doctoral":  "doctor",
+	"bachelors": "bachelor",
+	"masters":   "master",
+	"degree":    "",
+}
+
+// degreeStopwords are connective words dropped before comparison.
+var degreeStopwords = map[string]bool{
+	"of": true, "in": true, "the": true, "and": true, "a": true, "an": true,
+}
+
+// DegreeTokens returns the normalized word set of a degree or field of study: diacritics
+// folded, abbreviations spelled out and connective words removed.
+func DegreeTokens(degree string) map[string]bool {
+	result := make(map[string]bool)
+	for _, tok := range Tokens(degree) {
+		expansion, ok := degreeWordAliases[tok]
+		if !ok {
+			expansion = tok
+		}
+		for _, w := range Tokens(expansion) {
+			if !degreeStopwords[w] {
+				result[w] = true
+			}
+		}
+	}
+	return result
+}
+
+// DegreesAgree reports whether two degrees, or two fields of study, name the same thing.
+// Like job titles they agree when one word set contains the other, so "BSc" agrees with
+// "Bachelor of Science in Physics" but not with "MSc".
+func DegreesAgree(a, b string) bool {
+	return wordSetsAgree(DegreeTokens(a), DegreeTokens(b))
+}
@@ -0,0 +1,32 @@
+package normalize
+
+import "testing"
+
+func TestDegreesAgree(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"Bachelor of Science", "Bachelor of Science", true},
+		{"BSc", "Bachelor of Science", true},
+		{"B.Sc.", "Bachelor of Science in Computer Science", true},
+		{"Master's degree", "MS", true},
+		{"PhD", "Doctor of Philosophy", true},
+		{"BSc", "MSc", false},
+		{"Bachelor of Science", "Master of Science", false},
+		{"Bachelor of Arts", "Bachelor of Science", false},
+		{"Computer Science", "Physics", false},
+		{"", "BSc", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.a+" vs "+tc.b, func(t *testing.T) {
+			if got := DegreesAgree(tc.a, tc.b); got != tc.want {
+				t.Errorf("DegreesAgree(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.want)
+			}
+			if got := DegreesAgree(tc.b, tc.a); got != tc.want {
+				t.Errorf("DegreesAgree(%q, %q) = %v, want %v", tc.b, tc.a, got, tc.want)
+			}
+		})
+	}
+}
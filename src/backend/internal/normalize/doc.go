@@ -0,0 +1,4 @@
+// Package normalize contains name normalization and fuzzy matching used to line up
+// entities mentioned in different documents, e.g. an institution named in a reference
+// letter against the one listed on a resume, and parsing of free-text dates.
+package normalize
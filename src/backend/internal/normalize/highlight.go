@@ -0,0 +1,115 @@
+package normalize
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// bulletLine matches a line starting with a bullet symbol, or with a dash, asterisk or
+// list number such as "1." or "2)" followed by whitespace.
+var bulletLine = regexp.MustCompile(`^\s*([•◦▪▫‣●○■□►▸➢➤✓✔·]\s*|[*\-–—]\s+|\d{1,2}[.)]\s+)`)
+
+// inlineBullet matches a bullet symbol inside a line, where PDF extraction put several
+// bullets on one line. Dashes are left alone since they also separate words.
+var inlineBullet = regexp.MustCompile(`\s+[•◦▪▫‣●○■□►▸➢➤]\s+`)
+
+// SplitHighlights separates the bullet points of a role description from its prose.
+// Lines starting with a bullet or list number become highlights, without the marker.
+// A line continuing a bullet, one that follows it directly and does not start with a
+// capital letter, is joined to it, so bullets wrapped by PDF extraction stay whole. The other lines are
+// returned as prose, trimmed; prose is empty when the description is only bullets.
+// Without any bullet the description is returned unchanged and highlights is nil.
+func SplitHighlights(description string) (prose string, highlights []string) {
+	var proseLines []string
+	inBullet := false
+	for _, line := range strings.Split(description, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			inBullet = false
+			proseLines = append(proseLines, "")
+		case bulletLine.MatchString(line):
+			text := bulletLine.ReplaceAllString(line, "")
+			for _, part := range inlineBullet.Split(text, -1) {
+				if part = strings.TrimSpace(part); part != "" {
+					highlights = append(highlights, part)
+				}
+			}
+			inBullet = len(highlights) > 0
+		case inBullet && !startsUpper(trimmed):
+			highlights[len(highlights)-1] += " " + trimmed
+		default:
+			inBullet = false
+			proseLines = append(proseLines, trimmed)
+		}
+	}
+	if len(highlights) == 0 {
+		return description, nil
+	}
+	return collapseBlankLines(proseLines), highlights
+}
+
+func startsUpper(s string) bool {
+	for _, r := range s {
+		return unicode.IsUpper(r)
+	}
+	return false
+}
+
+// collapseBlankLines joins lines, dropping leading and trailing blank lines and keeping at
+// most one blank line between paragraphs.
+func collapseBlankLines(lines []string) string {
+	var kept []string
+	for _, line := range lines {
+		if line == "" && (len(kept) == 0 || kept[len(kept)-1] == "") {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	for len(kept) > 0 && kept[len(kept)-1] == "" {
+		kept = kept[:len(kept)-1]
+	}
+	return strings.Join(kept, "\n")
+}
+
+var (
+	// quantityPercent matches percentages: "40%", "12.5 %", "30 percent", "15 pct".
+	quantityPercent = regexp.MustCompile(`(?i)\d\s*(%|percent\b|per cent\b|pct\b|prozent\b|pour ?cent\b)`)
+	// quantityCurrency matches amounts of money: "$2M", "€ 500k", "1.2m USD", "CHF 80'000".
+	quantityCurrency = regexp.MustCompile(`(?i)[$€£¥₹]\s*\d|\b(usd|eur|gbp|chf|cad|aud|jpy|inr)\s*\d|\d\s*(usd|eur|gbp|chf|cad|aud|jpy|inr|dollars?|euros?|pounds?|francs?)\b`)
+	// quantityMultiple matches multipliers and scaled numbers: "3x", "10×", "2.5k", "4M", "1 billion".
+	quantityMultiple = regexp.MustCompile(`(?i)\b\d+(\.\d+)?\s*(x|×|k|m|bn|thousand|million|billion)(\b|$)`)
+	// quantityNumber matches a whole number that stands on its own, such as "12 engineers"
+	// or "1,200 users", but not one glued to letters as in "Python3", "S3" or "EC2", nor a
+	// decimal or version such as "3.11". The number is group 2.
+	quantityNumber = regexp.MustCompile(`(^|[^\p{L}\d.,'])(\d{1,3}(?:[,.']\d{3})+|\d+)([^\p{L}\d.,]|[.,](?:\D|$)|$)`)
+	// yearLike matches a bare year, which dates a highlight rather than quantifying it.
+	yearLike = regexp.MustCompile(`^(19|20)\d\d$`)
+	// quantityWords are folded words that state an amount without digits.
+	quantityWords = map[string]bool{
+		"doubled": true, "tripled": true, "quadrupled": true, "halved": true,
+		"dozens": true, "hundreds": true, "thousands": true, "millions": true, "billions": true,
+	}
+)
+
+// IsQuantified reports whether a highlight states a measurable result: a percentage, an
+// amount of money, a multiplier or a count, as in "Cut build times by 40%", "Saved $2M a
+// year", "Grew the team to 12 engineers" or "Doubled conversion". Years, version numbers
+// and numbers glued to names like "S3" do not count.
+func IsQuantified(text string) bool {
+	if quantityPercent.MatchString(text) || quantityCurrency.MatchString(text) || quantityMultiple.MatchString(text) {
+		return true
+	}
+	for _, m := range quantityNumber.FindAllStringSubmatch(text, -1) {
+		if !yearLike.MatchString(m[2]) {
+			return true
+		}
+	}
+	for _, word := range Tokens(text) {
+		if quantityWords[word] {
+			return true
+		}
+	}
+	return false
+}
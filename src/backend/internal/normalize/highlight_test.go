@@ -0,0 +1,101 @@
+package normalize
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitHighlights(t *testing.T) {
+	tests := []struct {
+		name           string
+		description    string
+		wantProse      string
+		wantHighlights []string
+	}{
+		{
+			name:        "prose only",
+			description: "Led the payments team.\nOwned the checkout roadmap.",
+			wantProse:   "Led the payments team.\nOwned the checkout roadmap.",
+		},
+		{
+			name:           "prose and bullets",
+			description:    "Backend engineer on the payments team.\n\n• Cut checkout latency by 40%\n• Migrated billing to Go",
+			wantProse:      "Backend engineer on the payments team.",
+			wantHighlights: []string{"Cut checkout latency by 40%", "Migrated billing to Go"},
+		},
+		{
+			name:           "dashes, asterisks and numbers",
+			description:    "- Built the API\n* Ran the on-call rotation\n1. Hired 4 engineers\n2) Wrote the style guide",
+			wantHighlights: []string{"Built the API", "Ran the on-call rotation", "Hired 4 engineers", "Wrote the style guide"},
+		},
+		{
+			name:           "wrapped bullet",
+			description:    "• Designed the event pipeline processing\n  2M events a day and\nreplacing the nightly batch",
+			wantHighlights: []string{"Designed the event pipeline processing 2M events a day and replacing the nightly batch"},
+		},
+		{
+			name:           "several bullets on one line",
+			description:    "• Shipped v2 • Led the redesign • Mentored interns",
+			wantHighlights: []string{"Shipped v2", "Led the redesign", "Mentored interns"},
+		},
+		{
+			name:           "bullet without space",
+			description:    "•Automated releases",
+			wantHighlights: []string{"Automated releases"},
+		},
+		{
+			name:           "prose after bullets",
+			description:    "• Built the API\nLater moved to the platform team.",
+			wantProse:      "Later moved to the platform team.",
+			wantHighlights: []string{"Built the API"},
+		},
+		{
+			name:        "hyphenated prose is not a bullet",
+			description: "2019-2021: full-stack work on the CRM",
+			wantProse:   "2019-2021: full-stack work on the CRM",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			prose, highlights := SplitHighlights(tc.description)
+			if prose != tc.wantProse {
+				t.Errorf("prose = %q, want %q", prose, tc.wantProse)
+			}
+			if !reflect.DeepEqual(highlights, tc.wantHighlights) {
+				t.Errorf("highlights = %q, want %q", highlights, tc.wantHighlights)
+			}
+		})
+	}
+}
+
+func TestIsQuantified(t *testing.T) {
+	tests := []struct {
+		text string
+		want bool
+	}{
+		{"Cut build times by 40%", true},
+		{"Improved retention by 12.5 percent", true},
+		{"Saved $2M in annual hosting costs", true},
+		{"Closed deals worth CHF 80'000", true},
+		{"Raised 1.2m EUR in seed funding", true},
+		{"Made the test suite 3x faster", true},
+		{"Grew the team to 12 engineers", true},
+		{"Served 1,200 customers", true},
+		{"Doubled conversion on the landing page", true},
+		{"Onboarded hundreds of merchants", true},
+		{"Led the migration to Kubernetes", false},
+		{"Moved file storage to S3 and EC2", false},
+		{"Upgraded the services to Python 3.11", false},
+		{"Joined in 2019 to build the data platform", false},
+		{"", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.text, func(t *testing.T) {
+			if got := IsQuantified(tc.text); got != tc.want {
+				t.Errorf("IsQuantified(%q) = %v, want %v", tc.text, got, tc.want)
+			}
+		})
+	}
+}
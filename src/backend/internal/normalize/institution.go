@@ -0,0 +1,242 @@
+package normalize
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// institutionWordAliases maps folded words (including common non-English spellings and
+// abbreviations) to their English equivalent, so "TU München" and "Technical University of
+// Munich" normalize to the same key.
+var institutionWordAliases = map[string]string{
+	"tu":            "technical university",
+	"technische":    "technical",
+	"technischen":   "technical",
+	"univ":          "university",
+	"uni":           "university",
+	"universitat":   "university",
+	"universitaet":  "university",
+	"universite":    "university",
+	"universidad":   "university",
+	"universidade":  "university",
+	"universita":    "university",
+	"universiteit":  "university",
+	"uniwersytet":   "university",
+	"inst":          "institute",
+	"institut":      "institute",
+	"instituto":     "institute",
+	"istituto":      "institute",
+	"tech":          "technology",
+	"technologie":   "technology",
+	"technologico":  "technology",
+	"politecnico":   "polytechnic",
+	"polytechnique": "polytechnic",
+	"ecole":         "school",
+	"escuela":       "school",
+	"munchen":       "munich",
+	"muenchen":      "munich",
+	"koln":          "cologne",
+	"koeln":         "cologne",
+	"nurnberg":      "nuremberg",
+	"nuernberg":     "nuremberg",
+	"wien":          "vienna",
+	"praha":         "prague",
+	"warszawa":      "warsaw",
+	"krakow":        "cracow",
+	"roma":          "rome",
+	"milano":        "milan",
+	"torino":        "turin",
+	"firenze":       "florence",
+	"napoli":        "naples",
+	"lisboa":        "lisbon",
+	"kobenhavn":     "copenhagen",
+	"goteborg":      "gothenburg",
+	"geneve":        "geneva",
+}
+
+// institutionAcronyms expands well-known acronyms that are used on their own.
+var institutionAcronyms = map[string]string{
+	"mit":     "massachusetts institute technology",
+	"caltech": "california institute technology",
+	"eth":     "swiss federal institute technology",
+	"ethz":    "swiss federal institute technology zurich",
+	"epfl":    "swiss federal institute technology lausanne",
+	"kth":     "royal institute technology",
+	"cmu":     "carnegie mellon university",
+	"nyu":     "new york university",
+	"ucl":     "university college london",
+	"ucla":    "university california los angeles",
+	"lse":     "london school economics political science",
+}
+
+// acronymSpellings are the exact spellings prose must use for an abbreviation to count as
+// an institution, where that is not simply all capitals. In running text "mit" is the German
+// for "with" and "tu" the French for "you", so only "MIT" and "TU" name institutions.
+var acronymSpellings = map[string]string{
+	"caltech": "Caltech",
+}
+
+// institutionStopwords are connective words dropped before comparison, across the languages
+// institution names commonly appear in.
+var institutionStopwords = map[string]bool{
+	"of": true, "the": true, "at": true, "in": true, "for": true, "and": true,
+	"de": true, "der": true, "des": true, "du": true, "di": true, "da": true, "do": true, "del": true,
+	"la": true, "le": true, "el": true, "y": true, "e": true,
+	"und": true, "fur": true, "zu": true, "von": true,
+}
+
+// genericInstitutionWords describe the kind of institution rather than which one it is.
+var genericInstitutionWords = map[string]bool{
+	"university": true, "college": true, "school": true, "institute": true, "academy": true,
+	"faculty": true, "department": true, "technology": true, "technical": true,
+	"science": true, "sciences": true, "polytechnic": true,
+}
+
+// identityQualifiers are words that distinguish otherwise similar institutions,
+// e.g. "Ohio State University" vs "Ohio University" or "Technical University of Munich"
+// vs "University of Munich". A name that only adds one of these is a different institution.
+var identityQualifiers = map[string]bool{
+	"technical": true, "technology": true, "state": true, "applied": true, "sciences": true,
+	"medical": true, "polytechnic": true, "catholic": true, "free": true, "open": true,
+	"city": true, "national": true, "international": true, "american": true,
+}
+
+// InstitutionTokens returns the normalized, deduplicated and sorted word set of an
+// institution name: diacritics folded, translations and abbreviations expanded,
+// and connective words removed.
+func InstitutionTokens(name string) []string {
+	seen := make(map[string]bool)
+	var result []string
+	add := func(word string) {
+		if word == "" || institutionStopwords[word] || seen[word] {
+			return
+		}
+		seen[word] = true
+		result = append(result, word)
+	}
+
+	for _, tok := range Tokens(name) {
+		if expansion, ok := institutionAcronyms[tok]; ok {
+			for _, w := range strings.Fields(expansion) {
+				add(w)
+			}
+			continue
+		}
+		if alias, ok := institutionWordAliases[tok]; ok {
+			for _, w := range strings.Fields(alias) {
+				add(w)
+			}
+			continue
+		}
+		add(tok)
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+// InstitutionKey returns a canonical comparison key for an institution name.
+// Names that refer to the same institution in different languages or spellings
+// produce the same key.
+func InstitutionKey(name string) string {
+	return strings.Join(InstitutionTokens(name), " ")
+}
+
+// InstitutionsMatch reports whether two institution names refer to the same institution.
+// Names match when their normalized word sets are equal, or when one is contained in the
+// other, e.g. "MIT" and "MIT Sloan School of Management". Containment only counts when the
+// shorter name has a distinctive word and the longer name does not merely add a qualifier
+// such as "Technical" or "State".
+func InstitutionsMatch(a, b string) bool {
+	ta := InstitutionTokens(a)
+	tb := InstitutionTokens(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return false
+	}
+	if len(ta) > len(tb) {
+		ta, tb = tb, ta
+	}
+
+	larger := make(map[string]bool, len(tb))
+	for _, t := range tb {
+		larger[t] = true
+	}
+
+	distinctive := false
+	for _, t := range ta {
+		if !larger[t] {
+			return false
+		}
+		if !genericInstitutionWords[t] {
+			distinctive = true
+		}
+	}
+	if !distinctive {
+		return false
+	}
+
+	smaller := make(map[string]bool, len(ta))
+	for _, t := range ta {
+		smaller[t] = true
+	}
+	for _, t := range tb {
+		if !smaller[t] && identityQualifiers[t] {
+			return false
+		}
+	}
+	return true
+}
+
+// MentionsInstitution reports whether free text (e.g. a quote from a letter) mentions the
+// institution, in any of the spellings InstitutionTokens understands. Every word of the
+// normalized institution name has to appear in the text, and the name needs at least one
+// distinctive word so that "University" alone never matches. Acronyms in the text only
+// count in their exact case, e.g. "MIT" but not the German "mit".
+func MentionsInstitution(text, institution string) bool {
+	nameTokens := InstitutionTokens(institution)
+	if len(nameTokens) == 0 {
+		return false
+	}
+
+	textTokens := make(map[string]bool)
+	for _, t := range InstitutionTokens(strings.Join(proseInstitutionWords(text), " ")) {
+		textTokens[t] = true
+	}
+
+	distinctive := false
+	for _, t := range nameTokens {
+		if !textTokens[t] {
+			return false
+		}
+		if !genericInstitutionWords[t] {
+			distinctive = true
+		}
+	}
+	return distinctive
+}
+
+// proseInstitutionWords splits free text into words like Tokens, leaving out abbreviations
+// that are not written in their exact case and so are more likely ordinary words.
+func proseInstitutionWords(text string) []string {
+	text = strings.NewReplacer("'", "", "’", "").Replace(text)
+	raw := strings.FieldsFunc(collapseDottedAbbreviations(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	words := make([]string, 0, len(raw))
+	for _, word := range raw {
+		folded := FoldText(word)
+		_, acronym := institutionAcronyms[folded]
+		if acronym || folded == "tu" {
+			spelling, ok := acronymSpellings[folded]
+			if !ok {
+				spelling = strings.ToUpper(folded)
+			}
+			if word != spelling {
+				continue
+			}
+		}
+		words = append(words, word)
+	}
+	return words
+}
@@ -0,0 +1,104 @@
+package normalize
+
+import "testing"
+
+func TestFoldText(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Universität Zürich", "universitat zurich"},
+		{"École Polytechnique", "ecole polytechnique"},
+		{"Technische Universität Braunschweig", "technische universitat braunschweig"},
+		{"Straße", "strasse"},
+		{"Københavns Universitet", "kobenhavns universitet"},
+		{"Politechnika Łódzka", "politechnika lodzka"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			if got := FoldText(tc.input); got != tc.expected {
+				t.Errorf("FoldText(%q) = %q, want %q", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestInstitutionKey(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+	}{
+		{"german abbreviation vs english", "TU München", "Technical University of Munich"},
+		{"transliterated umlaut", "Technische Universitaet Muenchen", "Technical University of Munich"},
+		{"acronym vs full name", "MIT", "Massachusetts Institute of Technology"},
+		{"acronym with city", "ETH Zürich", "Swiss Federal Institute of Technology Zurich"},
+		{"punctuation and case", "University of California, Los Angeles", "UCLA"},
+		{"word order", "Universität Wien", "University of Vienna"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ka, kb := InstitutionKey(tc.a), InstitutionKey(tc.b)
+			if ka != kb {
+				t.Errorf("InstitutionKey(%q) = %q, InstitutionKey(%q) = %q, want equal", tc.a, ka, tc.b, kb)
+			}
+		})
+	}
+}
+
+func TestInstitutionsMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{"same institution different language", "TU München", "Technical University of Munich", true},
+		{"school within university", "MIT", "MIT Sloan School of Management", true},
+		{"state qualifier is a different institution", "Ohio University", "Ohio State University", false},
+		{"technical qualifier is a different institution", "University of Munich", "Technical University of Munich", false},
+		{"generic words only", "University", "Stanford University", false},
+		{"unrelated institutions", "Stanford University", "Harvard University", false},
+		{"empty name", "", "Stanford University", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := InstitutionsMatch(tc.a, tc.b); got != tc.expected {
+				t.Errorf("InstitutionsMatch(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.expected)
+			}
+			if got := InstitutionsMatch(tc.b, tc.a); got != tc.expected {
+				t.Errorf("InstitutionsMatch(%q, %q) = %v, want %v", tc.b, tc.a, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestMentionsInstitution(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		institution string
+		expected    bool
+	}{
+		{"abbreviated mention", "I supervised her thesis at TU München.", "Technical University of Munich", true},
+		{"acronym in text", "He was my teaching assistant at MIT for two years.", "Massachusetts Institute of Technology", true},
+		{"not mentioned", "She led our platform team at Acme.", "Stanford University", false},
+		{"partial name only", "He studied in Munich.", "Technical University of Munich", false},
+		{"generic institution name", "Her time at university was formative.", "University", false},
+		{"German mit is not MIT", "Er hat mit großem Engagement an unserem Institut gearbeitet.", "MIT", false},
+		{"lowercase acronym", "she worked on it with mit colleagues", "Massachusetts Institute of Technology", false},
+		{"French tu is not TU", "Tu as été un excellent collègue à Munich.", "Technical University of Munich", false},
+		{"mixed-case acronym", "She did her postdoc at Caltech.", "California Institute of Technology", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := MentionsInstitution(tc.text, tc.institution); got != tc.expected {
+				t.Errorf("MentionsInstitution(%q, %q) = %v, want %v", tc.text, tc.institution, got, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package normalize
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// specialFolds covers letters that do not decompose into a base letter plus a combining mark.
+var specialFolds = map[rune]string{
+	'ß': "ss",
+	'æ': "ae",
+	'œ': "oe",
+	'ø': "o",
+	'ł': "l",
+	'đ': "d",
+	'ð': "d",
+	'þ': "th",
+	'ı': "i",
+}
+
+// FoldText lowercases s and strips diacritics, so that "Universität Zürich" becomes
+// "universitat zurich". Letters without a decomposition (ß, ø, æ, ...) are transliterated.
+func FoldText(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range norm.NFD.String(strings.ToLower(s)) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if repl, ok := specialFolds[r]; ok {
+			b.WriteString(repl)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Tokens folds s and splits it into alphanumeric words.
+// Punctuation acts as a separator, except for apostrophes and periods inside abbreviations,
+// which are dropped so "St. John's" yields ["st", "johns"] and "U.S." yields ["us"].
+func Tokens(s string) []string {
+	folded := FoldText(s)
+	folded = strings.NewReplacer("'", "", "’", "").Replace(folded)
+	folded = collapseDottedAbbreviations(folded)
+	return strings.FieldsFunc(folded, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// collapseDottedAbbreviations joins single letters separated by periods ("u.s.a." -> "usa").
+func collapseDottedAbbreviations(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	b.Grow(len(s))
+	for i, r := range runes {
+		if r == '.' && i > 0 && i+1 < len(runes) && unicode.IsLetter(runes[i-1]) && unicode.IsLetter(runes[i+1]) &&
+			(i < 2 || !unicode.IsLetter(runes[i-2])) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
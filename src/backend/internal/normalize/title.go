@@ -0,0 +1,73 @@
+package normalize
+
+// titleWordAliases maps folded abbreviations and interchangeable words in job titles to a
+// common form, so "Sr. Software Dev" and "Senior Software Engineer" compare equal.
+var titleWordAliases = map[string]string{
+	"sr":         "senior",
+	"snr":        "senior",
+	"jr":         "junior",
+	"jnr":        "junior",
+	"eng":        "engineer",
+	"engr":       "engineer",
+	"dev":        "engineer",
+	"developer":  "engineer",
+	"programmer": "engineer",
+	"mgr":        "manager",
+	"mngr":       "manager",
+	"dir":        "director",
+	"asst":       "assistant",
+	"assoc":      "associate",
+	"vp":         "vice president",
+	"cto":        "chief technology officer",
+	"ceo":        "chief executive officer",
+	"cfo":        "chief financial officer",
+	"swe":        "software engineer",
+	"sde":        "software engineer",
+}
+
+// titleStopwords are connective words dropped before comparison.
+var titleStopwords = map[string]bool{
+	"of": true, "the": true, "and": true, "for": true, "at": true, "a": true, "an": true,
+}
+
+// TitleTokens returns the normalized word set of a job title: diacritics folded,
+// abbreviations expanded and connective words removed.
+func TitleTokens(title string) map[string]bool {
+	result := make(map[string]bool)
+	for _, tok := range Tokens(title) {
+		expansion, ok := titleWordAliases[tok]
+		if !ok {
+			expansion = tok
+		}
+		for _, w := range Tokens(expansion) {
+			if !titleStopwords[w] {
+				result[w] = true
+			}
+		}
+	}
+	return result
+}
+
+// TitlesAgree reports whether two job titles describe the same role. Titles agree when
+// their normalized word sets are equal or one contains the other, since a letter often
+// shortens a title ("Engineer" for "Senior Software Engineer"). Titles that each carry a
+// word the other lacks, such as "Senior Engineer" and "Lead Engineer", disagree.
+func TitlesAgree(a, b string) bool {
+	return wordSetsAgree(TitleTokens(a), TitleTokens(b))
+}
+
+// wordSetsAgree reports whether two non-empty word sets are equal or one contains the other.
+func wordSetsAgree(ta, tb map[string]bool) bool {
+	if len(ta) == 0 || len(tb) == 0 {
+		return false
+	}
+	if len(ta) > len(tb) {
+		ta, tb = tb, ta
+	}
+	for t := range ta {
+		if !tb[t] {
+			return false
+		}
+	}
+	return true
+}
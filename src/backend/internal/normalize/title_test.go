@@ -0,0 +1,31 @@
+package normalize
+
+import "testing"
+
+func TestTitlesAgree(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"Senior Engineer", "Senior Engineer", true},
+		{"Sr. Software Engineer", "Senior Software Engineer", true},
+		{"Software Developer", "software engineer", true},
+		{"Engineer", "Senior Software Engineer", true},
+		{"VP of Engineering", "Vice President, Engineering", true},
+		{"Senior Engineer", "Lead Engineer", false},
+		{"Engineering Manager", "Senior Engineer", false},
+		{"Product Manager", "Project Manager", false},
+		{"", "Engineer", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.a+" vs "+tc.b, func(t *testing.T) {
+			if got := TitlesAgree(tc.a, tc.b); got != tc.want {
+				t.Errorf("TitlesAgree(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.want)
+			}
+			if got := TitlesAgree(tc.b, tc.a); got != tc.want {
+				t.Errorf("TitlesAgree(%q, %q) = %v, want %v", tc.b, tc.a, got, tc.want)
+			}
+		})
+	}
+}
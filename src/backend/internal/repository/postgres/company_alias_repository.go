@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// CompanyAliasRepository implements domain.CompanyAliasRepository using PostgreSQL.
+type CompanyAliasRepository struct {
+	db bun.IDB
+}
+
+// NewCompanyAliasRepository creates a new PostgreSQL company alias repository.
+// Accepts bun.IDB to support both regular DB operations and transactions.
+func NewCompanyAliasRepository(db bun.IDB) *CompanyAliasRepository {
+	return &CompanyAliasRepository{db: db}
+}
+
+// Create persists a new company alias.
+func (r *CompanyAliasRepository) Create(ctx context.Context, alias *domain.CompanyAlias) error {
+	_, err := r.db.NewInsert().Model(alias).Exec(ctx)
+	return err
+}
+
+// GetByID retrieves a company alias by its ID.
+func (r *CompanyAliasRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.CompanyAlias, error) {
+	alias := new(domain.CompanyAlias)
+	err := r.db.NewSelect().Model(alias).Where("id = ?", id).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return alias, nil
+}
+
+// GetByProfileID retrieves all company aliases for a profile, ordered by alias.
+func (r *CompanyAliasRepository) GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*domain.CompanyAlias, error) {
+	var aliases []*domain.CompanyAlias
+	err := r.db.NewSelect().
+		Model(&aliases).
+		Where("profile_id = ?", profileID).
+		Order("alias ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return aliases, nil
+}
+
+// Update persists changes to an existing company alias.
+func (r *CompanyAliasRepository) Update(ctx context.Context, alias *domain.CompanyAlias) error {
+	alias.UpdatedAt = time.Now()
+	_, err := r.db.NewUpdate().
+		Model(alias).
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// Delete removes a company alias by its ID.
+func (r *CompanyAliasRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewDelete().Model((*domain.CompanyAlias)(nil)).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+// Compile-time check that CompanyAliasRepository implements domain.CompanyAliasRepository.
+var _ domain.CompanyAliasRepository = (*CompanyAliasRepository)(nil)
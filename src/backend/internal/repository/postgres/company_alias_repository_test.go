@@ -0,0 +1,139 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func createAliasTestProfile(t *testing.T, ctx context.Context, userRepo *postgres.UserRepository, profileRepo *postgres.ProfileRepository, email string) *domain.Profile {
+	t.Helper()
+
+	user := &domain.User{
+		Email:        email,
+		PasswordHash: "hashed_password",
+	}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("Create user failed: %v", err)
+	}
+
+	profile := &domain.Profile{
+		UserID: user.ID,
+	}
+	if err := profileRepo.Create(ctx, profile); err != nil {
+		t.Fatalf("Create profile failed: %v", err)
+	}
+	return profile
+}
+
+func TestCompanyAliasRepository_CreateAndGetByProfileID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	aliasRepo := postgres.NewCompanyAliasRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "aliasuser@example.com")
+
+	for _, a := range []struct{ alias, canonical string }{
+		{"Facebook", "Meta Platforms"},
+		{"Alphabet", "Google"},
+	} {
+		alias := &domain.CompanyAlias{
+			ProfileID:     profile.ID,
+			Alias:         a.alias,
+			CanonicalName: a.canonical,
+		}
+		if err := aliasRepo.Create(ctx, alias); err != nil {
+			t.Fatalf("Create alias failed: %v", err)
+		}
+		if alias.ID == uuid.Nil {
+			t.Error("expected alias ID to be set after create")
+		}
+	}
+
+	aliases, err := aliasRepo.GetByProfileID(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileID failed: %v", err)
+	}
+	if len(aliases) != 2 {
+		t.Fatalf("expected 2 aliases, got %d", len(aliases))
+	}
+	if aliases[0].Alias != "Alphabet" || aliases[1].Alias != "Facebook" {
+		t.Errorf("expected aliases ordered by name, got %q, %q", aliases[0].Alias, aliases[1].Alias)
+	}
+}
+
+func TestCompanyAliasRepository_DuplicateAlias(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	aliasRepo := postgres.NewCompanyAliasRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "aliasdup@example.com")
+
+	first := &domain.CompanyAlias{ProfileID: profile.ID, Alias: "Facebook", CanonicalName: "Meta Platforms"}
+	if err := aliasRepo.Create(ctx, first); err != nil {
+		t.Fatalf("Create alias failed: %v", err)
+	}
+
+	// Same alias with different casing must be rejected
+	second := &domain.CompanyAlias{ProfileID: profile.ID, Alias: "FACEBOOK", CanonicalName: "Meta"}
+	if err := aliasRepo.Create(ctx, second); err == nil {
+		t.Error("expected duplicate alias to be rejected")
+	}
+}
+
+func TestCompanyAliasRepository_UpdateAndDelete(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	aliasRepo := postgres.NewCompanyAliasRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "aliasupdate@example.com")
+
+	alias := &domain.CompanyAlias{ProfileID: profile.ID, Alias: "Facebook", CanonicalName: "Meta"}
+	if err := aliasRepo.Create(ctx, alias); err != nil {
+		t.Fatalf("Create alias failed: %v", err)
+	}
+
+	alias.CanonicalName = "Meta Platforms"
+	if err := aliasRepo.Update(ctx, alias); err != nil {
+		t.Fatalf("Update alias failed: %v", err)
+	}
+
+	retrieved, err := aliasRepo.GetByID(ctx, alias.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if retrieved == nil || retrieved.CanonicalName != "Meta Platforms" {
+		t.Fatalf("expected updated canonical name, got %+v", retrieved)
+	}
+
+	if err := aliasRepo.Delete(ctx, alias.ID); err != nil {
+		t.Fatalf("Delete alias failed: %v", err)
+	}
+
+	deleted, err := aliasRepo.GetByID(ctx, alias.ID)
+	if err != nil {
+		t.Fatalf("GetByID after delete failed: %v", err)
+	}
+	if deleted != nil {
+		t.Error("expected alias to be deleted")
+	}
+}
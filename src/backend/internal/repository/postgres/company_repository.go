@@ -0,0 +1,193 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// CompanyRepository implements domain.CompanyRepository using PostgreSQL.
+type CompanyRepository struct {
+	db bun.IDB
+}
+
+// NewCompanyRepository creates a new PostgreSQL company repository.
+// Accepts bun.IDB to support both regular DB operations and transactions.
+func NewCompanyRepository(db bun.IDB) *CompanyRepository {
+	return &CompanyRepository{db: db}
+}
+
+// Create persists a new company.
+func (r *CompanyRepository) Create(ctx context.Context, company *domain.Company) error {
+	_, err := r.db.NewInsert().Model(company).Exec(ctx)
+	return err
+}
+
+// GetByID retrieves a company by its ID.
+func (r *CompanyRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Company, error) {
+	company := new(domain.Company)
+	err := r.db.NewSelect().Model(company).Where("id = ?", id).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return company, nil
+}
+
+// GetByProfileID retrieves all companies for a profile, ordered by name.
+func (r *CompanyRepository) GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*domain.Company, error) {
+	var companies []*domain.Company
+	err := r.db.NewSelect().
+		Model(&companies).
+		Where("profile_id = ?", profileID).
+		Order("name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return companies, nil
+}
+
+// Update persists changes to an existing company.
+func (r *CompanyRepository) Update(ctx context.Context, company *domain.Company) error {
+	company.UpdatedAt = time.Now()
+	_, err := r.db.NewUpdate().
+		Model(company).
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// Delete removes a company by its ID. The foreign keys unlink experiences and authors.
+func (r *CompanyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewDelete().Model((*domain.Company)(nil)).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+// Merge folds the source companies into the target within a transaction.
+func (r *CompanyRepository) Merge(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*domain.Company, error) {
+	target := new(domain.Company)
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().Model(target).Where("id = ?", targetID).For("UPDATE").Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("company %s not found", targetID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get target company: %w", err)
+		}
+
+		var sources []*domain.Company
+		err = tx.NewSelect().
+			Model(&sources).
+			Where("id IN (?)", bun.In(sourceIDs)).
+			Where("profile_id = ?", target.ProfileID).
+			Where("id <> ?", targetID).
+			Order("created_at ASC").
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get source companies: %w", err)
+		}
+		if len(sources) == 0 {
+			return fmt.Errorf("no source companies found on the target's profile")
+		}
+
+		ids := make([]uuid.UUID, len(sources))
+		names := make([]string, 0, len(sources))
+		for i, source := range sources {
+			ids[i] = source.ID
+			if !strings.EqualFold(strings.TrimSpace(source.Name), strings.TrimSpace(target.Name)) {
+				names = append(names, source.Name)
+			}
+			if target.Website == nil {
+				target.Website = source.Website
+			}
+			if target.LogoID == nil {
+				target.LogoID = source.LogoID
+			}
+		}
+
+		if _, err := tx.NewUpdate().
+			Model((*domain.ProfileExperience)(nil)).
+			Set("company_id = ?", target.ID).
+			Where("company_id IN (?)", bun.In(ids)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to relink experiences: %w", err)
+		}
+		if _, err := tx.NewUpdate().
+			Model((*domain.Author)(nil)).
+			Set("company_id = ?", target.ID).
+			Where("company_id IN (?)", bun.In(ids)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to relink authors: %w", err)
+		}
+
+		if _, err := tx.NewDelete().
+			Model((*domain.Company)(nil)).
+			Where("id IN (?)", bun.In(ids)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete merged companies: %w", err)
+		}
+
+		if len(names) > 0 {
+			if err := aliasCompanyNames(ctx, tx, target, names); err != nil {
+				return err
+			}
+		}
+
+		target.UpdatedAt = time.Now()
+		if _, err := tx.NewUpdate().Model(target).WherePK().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update target company: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// aliasCompanyNames makes names company aliases of the target: aliases already pointing at
+// one of the names are re-pointed to the target's name, and each name becomes an alias of it.
+func aliasCompanyNames(ctx context.Context, tx bun.Tx, target *domain.Company, names []string) error {
+	lowered := make([]string, len(names))
+	for i, name := range names {
+		lowered[i] = strings.ToLower(strings.TrimSpace(name))
+	}
+	if _, err := tx.NewUpdate().
+		Model((*domain.CompanyAlias)(nil)).
+		Set("canonical_name = ?", target.Name).
+		Set("updated_at = ?", time.Now()).
+		Where("profile_id = ?", target.ProfileID).
+		Where("LOWER(TRIM(canonical_name)) IN (?)", bun.In(lowered)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to re-point company aliases: %w", err)
+	}
+
+	aliases := make([]*domain.CompanyAlias, len(names))
+	for i, name := range names {
+		aliases[i] = &domain.CompanyAlias{ID: uuid.New(), ProfileID: target.ProfileID, Alias: strings.TrimSpace(name), CanonicalName: target.Name}
+	}
+	if _, err := tx.NewInsert().
+		Model(&aliases).
+		On("CONFLICT (profile_id, LOWER(alias)) DO UPDATE").
+		Set("canonical_name = EXCLUDED.canonical_name").
+		Set("updated_at = current_timestamp").
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to add company aliases: %w", err)
+	}
+	return nil
+}
+
+// Compile-time check that CompanyRepository implements domain.CompanyRepository.
+var _ domain.CompanyRepository = (*CompanyRepository)(nil)
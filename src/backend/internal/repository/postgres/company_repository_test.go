@@ -0,0 +1,124 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func TestCompanyRepository_CreateAndGetByProfileID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	companyRepo := postgres.NewCompanyRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "companyuser@example.com")
+
+	for _, name := range []string{"Globex", "Acme Corp"} {
+		company := &domain.Company{ProfileID: profile.ID, Name: name}
+		if err := companyRepo.Create(ctx, company); err != nil {
+			t.Fatalf("Create company failed: %v", err)
+		}
+		if company.ID == uuid.Nil {
+			t.Error("expected company ID to be set after create")
+		}
+	}
+
+	companies, err := companyRepo.GetByProfileID(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileID failed: %v", err)
+	}
+	if len(companies) != 2 {
+		t.Fatalf("expected 2 companies, got %d", len(companies))
+	}
+	if companies[0].Name != "Acme Corp" || companies[1].Name != "Globex" {
+		t.Errorf("expected companies ordered by name, got %q, %q", companies[0].Name, companies[1].Name)
+	}
+}
+
+func TestCompanyRepository_Merge(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	companyRepo := postgres.NewCompanyRepository(db)
+	aliasRepo := postgres.NewCompanyAliasRepository(db)
+	expRepo := postgres.NewProfileExperienceRepository(db)
+	authorRepo := postgres.NewAuthorRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "companymerge@example.com")
+
+	target := &domain.Company{ProfileID: profile.ID, Name: "Meta Platforms"}
+	source := &domain.Company{ProfileID: profile.ID, Name: "Facebook", Website: strPtr("https://facebook.com")}
+	for _, c := range []*domain.Company{target, source} {
+		if err := companyRepo.Create(ctx, c); err != nil {
+			t.Fatalf("Create company failed: %v", err)
+		}
+	}
+	if err := aliasRepo.Create(ctx, &domain.CompanyAlias{ProfileID: profile.ID, Alias: "The Facebook", CanonicalName: "Facebook"}); err != nil {
+		t.Fatalf("Create alias failed: %v", err)
+	}
+
+	exp := &domain.ProfileExperience{ProfileID: profile.ID, Company: "Facebook", Title: "Engineer", CompanyID: &source.ID}
+	if err := expRepo.Create(ctx, exp); err != nil {
+		t.Fatalf("Create experience failed: %v", err)
+	}
+	author := &domain.Author{ProfileID: profile.ID, Name: "Jane Smith", Company: strPtr("Facebook"), CompanyID: &source.ID}
+	if err := authorRepo.Create(ctx, author); err != nil {
+		t.Fatalf("Create author failed: %v", err)
+	}
+
+	merged, err := companyRepo.Merge(ctx, target.ID, []uuid.UUID{source.ID})
+	if err != nil {
+		t.Fatalf("Merge failed: %v", err)
+	}
+	aliases, err := aliasRepo.GetByProfileID(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileID aliases failed: %v", err)
+	}
+	if len(aliases) != 2 || aliases[0].Alias != "Facebook" || aliases[1].Alias != "The Facebook" {
+		t.Fatalf("expected aliases [Facebook The Facebook], got %d aliases", len(aliases))
+	}
+	for _, a := range aliases {
+		if a.CanonicalName != "Meta Platforms" {
+			t.Errorf("expected alias %q to point at Meta Platforms, got %q", a.Alias, a.CanonicalName)
+		}
+	}
+	if merged.Website == nil || *merged.Website != "https://facebook.com" {
+		t.Errorf("expected website to be taken from the source, got %v", merged.Website)
+	}
+
+	gone, err := companyRepo.GetByID(ctx, source.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if gone != nil {
+		t.Error("expected source company to be deleted")
+	}
+
+	gotExp, err := expRepo.GetByID(ctx, exp.ID)
+	if err != nil {
+		t.Fatalf("GetByID experience failed: %v", err)
+	}
+	if gotExp.CompanyID == nil || *gotExp.CompanyID != target.ID {
+		t.Errorf("expected experience to be relinked to target, got %v", gotExp.CompanyID)
+	}
+	gotAuthor, err := authorRepo.GetByID(ctx, author.ID)
+	if err != nil {
+		t.Fatalf("GetByID author failed: %v", err)
+	}
+	if gotAuthor.CompanyID == nil || *gotAuthor.CompanyID != target.ID {
+		t.Errorf("expected author to be relinked to target, got %v", gotAuthor.CompanyID)
+	}
+}
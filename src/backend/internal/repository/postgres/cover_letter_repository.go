@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// CoverLetterRepository implements domain.CoverLetterRepository using PostgreSQL.
+type CoverLetterRepository struct {
+	db bun.IDB
+}
+
+// NewCoverLetterRepository creates a new PostgreSQL cover letter repository.
+func NewCoverLetterRepository(db bun.IDB) *CoverLetterRepository {
+	return &CoverLetterRepository{db: db}
+}
+
+// Create persists a new cover letter with its first version in one transaction.
+func (r *CoverLetterRepository) Create(ctx context.Context, letter *domain.CoverLetter, version *domain.CoverLetterVersion) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		letter.LatestVersion = 1
+		if _, err := tx.NewInsert().Model(letter).Exec(ctx); err != nil {
+			return err
+		}
+		version.CoverLetterID = letter.ID
+		version.Version = 1
+		_, err := tx.NewInsert().Model(version).Exec(ctx)
+		return err
+	})
+}
+
+// GetByID retrieves a cover letter by its ID.
+func (r *CoverLetterRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.CoverLetter, error) {
+	letter := new(domain.CoverLetter)
+	err := r.db.NewSelect().Model(letter).Where("id = ?", id).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return letter, nil
+}
+
+// GetByProfileID retrieves all cover letters of a profile, newest first.
+func (r *CoverLetterRepository) GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*domain.CoverLetter, error) {
+	var letters []*domain.CoverLetter
+	err := r.db.NewSelect().Model(&letters).Where("profile_id = ?", profileID).Order("created_at DESC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return letters, nil
+}
+
+// AddVersion persists the next version of a cover letter and makes it the latest. The
+// letter row is locked so concurrent edits get consecutive version numbers.
+func (r *CoverLetterRepository) AddVersion(ctx context.Context, letter *domain.CoverLetter, version *domain.CoverLetterVersion) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var latest int
+		err := tx.NewSelect().Model((*domain.CoverLetter)(nil)).
+			Column("latest_version").
+			Where("id = ?", letter.ID).
+			For("UPDATE").
+			Scan(ctx, &latest)
+		if err != nil {
+			return err
+		}
+
+		version.CoverLetterID = letter.ID
+		version.Version = latest + 1
+		if _, err := tx.NewInsert().Model(version).Exec(ctx); err != nil {
+			return err
+		}
+		_, err = tx.NewUpdate().Model((*domain.CoverLetter)(nil)).
+			Set("latest_version = ?", version.Version).
+			Set("updated_at = ?", time.Now()).
+			Where("id = ?", letter.ID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		letter.LatestVersion = version.Version
+		return nil
+	})
+}
+
+// GetVersions retrieves all versions of a cover letter, newest first.
+func (r *CoverLetterRepository) GetVersions(ctx context.Context, coverLetterID uuid.UUID) ([]*domain.CoverLetterVersion, error) {
+	var versions []*domain.CoverLetterVersion
+	err := r.db.NewSelect().Model(&versions).Where("cover_letter_id = ?", coverLetterID).Order("version DESC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// GetVersion retrieves one version of a cover letter.
+func (r *CoverLetterRepository) GetVersion(ctx context.Context, coverLetterID uuid.UUID, version int) (*domain.CoverLetterVersion, error) {
+	v := new(domain.CoverLetterVersion)
+	err := r.db.NewSelect().Model(v).
+		Where("cover_letter_id = ?", coverLetterID).
+		Where("version = ?", version).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Delete removes a cover letter and, by cascade, its versions.
+func (r *CoverLetterRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewDelete().Model((*domain.CoverLetter)(nil)).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+// Compile-time check that CoverLetterRepository implements domain.CoverLetterRepository.
+var _ domain.CoverLetterRepository = (*CoverLetterRepository)(nil)
@@ -0,0 +1,90 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func TestCoverLetterRepository_Versions(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	letterRepo := postgres.NewCoverLetterRepository(db)
+	ctx := context.Background()
+
+	user := &domain.User{Email: "cover-letter@example.com", PasswordHash: "hashed_password"}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("Create user failed: %v", err)
+	}
+	profile := &domain.Profile{UserID: user.ID}
+	if err := profileRepo.Create(ctx, profile); err != nil {
+		t.Fatalf("Create profile failed: %v", err)
+	}
+
+	testimonialID := uuid.New()
+	letter := &domain.CoverLetter{ProfileID: profile.ID, Title: "Platform Engineer at Acme"}
+	generated := &domain.CoverLetterVersion{
+		Body:      "My former manager described me as “calm under pressure”.",
+		Citations: []domain.WritingCitation{{Kind: domain.WritingFactTestimonial, ID: testimonialID}},
+		Quotes:    []domain.CoverLetterQuote{{TestimonialID: testimonialID, Text: "calm under pressure"}},
+		Source:    domain.CoverLetterVersionGenerated,
+	}
+	if err := letterRepo.Create(ctx, letter, generated); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if generated.Version != 1 || generated.CoverLetterID != letter.ID {
+		t.Fatalf("expected version 1 of the letter, got %+v", generated)
+	}
+
+	edited := &domain.CoverLetterVersion{
+		Body:      "Dear team, my former manager described me as “calm under pressure”.",
+		Citations: generated.Citations,
+		Quotes:    generated.Quotes,
+		Source:    domain.CoverLetterVersionEdited,
+	}
+	if err := letterRepo.AddVersion(ctx, letter, edited); err != nil {
+		t.Fatalf("AddVersion failed: %v", err)
+	}
+	if edited.Version != 2 || letter.LatestVersion != 2 {
+		t.Fatalf("expected version 2, got version %d and latest %d", edited.Version, letter.LatestVersion)
+	}
+
+	retrieved, err := letterRepo.GetByID(ctx, letter.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if retrieved == nil || retrieved.LatestVersion != 2 {
+		t.Fatalf("expected the letter at version 2, got %+v", retrieved)
+	}
+
+	versions, err := letterRepo.GetVersions(ctx, letter.ID)
+	if err != nil {
+		t.Fatalf("GetVersions failed: %v", err)
+	}
+	if len(versions) != 2 || versions[0].Version != 2 || versions[1].Version != 1 {
+		t.Fatalf("expected versions 2 and 1, got %+v", versions)
+	}
+
+	first, err := letterRepo.GetVersion(ctx, letter.ID, 1)
+	if err != nil {
+		t.Fatalf("GetVersion failed: %v", err)
+	}
+	if first == nil || first.Body != generated.Body || len(first.Quotes) != 1 || first.Quotes[0].TestimonialID != testimonialID {
+		t.Errorf("expected the generated version with its quote, got %+v", first)
+	}
+
+	if err := letterRepo.Delete(ctx, letter.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if missing, _ := letterRepo.GetVersion(ctx, letter.ID, 2); missing != nil {
+		t.Errorf("expected the versions to be deleted with the letter, got %+v", missing)
+	}
+}
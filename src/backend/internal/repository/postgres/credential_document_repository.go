@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// CredentialDocumentRepository implements domain.CredentialDocumentRepository using PostgreSQL.
+type CredentialDocumentRepository struct {
+	db bun.IDB
+}
+
+// NewCredentialDocumentRepository creates a new PostgreSQL credential document repository.
+func NewCredentialDocumentRepository(db bun.IDB) *CredentialDocumentRepository {
+	return &CredentialDocumentRepository{db: db}
+}
+
+// Create persists a new credential document.
+func (r *CredentialDocumentRepository) Create(ctx context.Context, doc *domain.CredentialDocument) error {
+	_, err := r.db.NewInsert().Model(doc).Exec(ctx)
+	return err
+}
+
+// GetByID retrieves a credential document by its ID.
+func (r *CredentialDocumentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.CredentialDocument, error) {
+	doc := new(domain.CredentialDocument)
+	err := r.db.NewSelect().Model(doc).Where("id = ?", id).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// GetByUserID retrieves all credential documents belonging to a user.
+func (r *CredentialDocumentRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.CredentialDocument, error) {
+	var docs []*domain.CredentialDocument
+	err := r.db.NewSelect().Model(&docs).Where("user_id = ?", userID).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// Update persists changes to an existing credential document.
+func (r *CredentialDocumentRepository) Update(ctx context.Context, doc *domain.CredentialDocument) error {
+	_, err := r.db.NewUpdate().Model(doc).WherePK().Exec(ctx)
+	return err
+}
+
+// Delete removes a credential document by its ID.
+func (r *CredentialDocumentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewDelete().Model((*domain.CredentialDocument)(nil)).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+// Compile-time check that CredentialDocumentRepository implements domain.CredentialDocumentRepository.
+var _ domain.CredentialDocumentRepository = (*CredentialDocumentRepository)(nil)
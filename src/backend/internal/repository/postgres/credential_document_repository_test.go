@@ -0,0 +1,134 @@
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func createCredentialTestFile(ctx context.Context, t *testing.T, userRepo *postgres.UserRepository, fileRepo *postgres.FileRepository, email string) (*domain.User, *domain.File) {
+	t.Helper()
+
+	user := &domain.User{
+		Email:        email,
+		PasswordHash: "hashed_password",
+	}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("Create user failed: %v", err)
+	}
+
+	file := &domain.File{
+		UserID:      user.ID,
+		Filename:    "certificate.pdf",
+		ContentType: "application/pdf",
+		SizeBytes:   1024,
+		StorageKey:  "users/" + user.ID.String() + "/certificate.pdf",
+	}
+	if err := fileRepo.Create(ctx, file); err != nil {
+		t.Fatalf("Create file failed: %v", err)
+	}
+
+	return user, file
+}
+
+func TestCredentialDocumentRepository_CreateAndGetByID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	fileRepo := postgres.NewFileRepository(db)
+	docRepo := postgres.NewCredentialDocumentRepository(db)
+	ctx := context.Background()
+
+	user, file := createCredentialTestFile(ctx, t, userRepo, fileRepo, "credentialcreate@example.com")
+
+	doc := &domain.CredentialDocument{
+		UserID:       user.ID,
+		FileID:       file.ID,
+		DocumentType: domain.CredentialDocumentTypeCertificate,
+		Status:       domain.CredentialDocumentStatusPending,
+	}
+	if err := docRepo.Create(ctx, doc); err != nil {
+		t.Fatalf("Create credential document failed: %v", err)
+	}
+	if doc.ID == uuid.Nil {
+		t.Fatal("expected credential document ID to be set after create")
+	}
+
+	retrieved, err := docRepo.GetByID(ctx, doc.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if retrieved == nil {
+		t.Fatal("expected credential document, got nil")
+	}
+	if retrieved.DocumentType != domain.CredentialDocumentTypeCertificate {
+		t.Errorf("DocumentType = %s, want %s", retrieved.DocumentType, domain.CredentialDocumentTypeCertificate)
+	}
+
+	missing, err := docRepo.GetByID(ctx, uuid.New())
+	if err != nil {
+		t.Fatalf("GetByID for missing document failed: %v", err)
+	}
+	if missing != nil {
+		t.Error("expected nil for non-existent credential document")
+	}
+}
+
+func TestCredentialDocumentRepository_UpdateAndGetByUserID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	fileRepo := postgres.NewFileRepository(db)
+	docRepo := postgres.NewCredentialDocumentRepository(db)
+	ctx := context.Background()
+
+	user, file := createCredentialTestFile(ctx, t, userRepo, fileRepo, "credentialupdate@example.com")
+
+	doc := &domain.CredentialDocument{
+		UserID:       user.ID,
+		FileID:       file.ID,
+		DocumentType: domain.CredentialDocumentTypeTranscript,
+		Status:       domain.CredentialDocumentStatusPending,
+	}
+	if err := docRepo.Create(ctx, doc); err != nil {
+		t.Fatalf("Create credential document failed: %v", err)
+	}
+
+	data, err := json.Marshal(domain.ExtractedTranscriptData{Institution: "MIT"})
+	if err != nil {
+		t.Fatalf("failed to marshal transcript data: %v", err)
+	}
+	doc.ExtractedData = data
+	doc.Status = domain.CredentialDocumentStatusCompleted
+	if err := docRepo.Update(ctx, doc); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	docs, err := docRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("GetByUserID failed: %v", err)
+	}
+	if len(docs) != 1 {
+		t.Fatalf("expected 1 credential document, got %d", len(docs))
+	}
+	if docs[0].Status != domain.CredentialDocumentStatusCompleted {
+		t.Errorf("Status = %s, want %s", docs[0].Status, domain.CredentialDocumentStatusCompleted)
+	}
+
+	var transcript domain.ExtractedTranscriptData
+	if err := json.Unmarshal(docs[0].ExtractedData, &transcript); err != nil {
+		t.Fatalf("failed to unmarshal extracted data: %v", err)
+	}
+	if transcript.Institution != "MIT" {
+		t.Errorf("Institution = %q, want %q", transcript.Institution, "MIT")
+	}
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// EducationValidationRepository implements domain.EducationValidationRepository using PostgreSQL.
+type EducationValidationRepository struct {
+	db bun.IDB
+}
+
+// NewEducationValidationRepository creates a new PostgreSQL education validation repository.
+func NewEducationValidationRepository(db bun.IDB) *EducationValidationRepository {
+	return &EducationValidationRepository{db: db}
+}
+
+// Create persists a new education validation.
+func (r *EducationValidationRepository) Create(ctx context.Context, validation *domain.EducationValidation) error {
+	_, err := r.db.NewInsert().Model(validation).Exec(ctx)
+	return err
+}
+
+// GetByID retrieves an education validation by its ID.
+func (r *EducationValidationRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.EducationValidation, error) {
+	validation := new(domain.EducationValidation)
+	err := r.db.NewSelect().Model(validation).Where("id = ?", id).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return validation, nil
+}
+
+// GetByProfileEducationID retrieves all validations for a specific education entry.
+func (r *EducationValidationRepository) GetByProfileEducationID(ctx context.Context, profileEducationID uuid.UUID) ([]*domain.EducationValidation, error) {
+	var validations []*domain.EducationValidation
+	err := r.db.NewSelect().
+		Model(&validations).
+		Where("profile_education_id = ?", profileEducationID).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return validations, nil
+}
+
+// GetByReferenceLetterID retrieves all education validations from a reference letter.
+func (r *EducationValidationRepository) GetByReferenceLetterID(ctx context.Context, referenceLetterID uuid.UUID) ([]*domain.EducationValidation, error) {
+	var validations []*domain.EducationValidation
+	err := r.db.NewSelect().
+		Model(&validations).
+		Where("reference_letter_id = ?", referenceLetterID).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return validations, nil
+}
+
+// Delete removes an education validation by its ID.
+func (r *EducationValidationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewDelete().Model((*domain.EducationValidation)(nil)).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+// DeleteByReferenceLetterID removes all education validations from a reference letter.
+func (r *EducationValidationRepository) DeleteByReferenceLetterID(ctx context.Context, referenceLetterID uuid.UUID) error {
+	_, err := r.db.NewDelete().
+		Model((*domain.EducationValidation)(nil)).
+		Where("reference_letter_id = ?", referenceLetterID).
+		Exec(ctx)
+	return err
+}
+
+// CountByProfileEducationID returns the number of validations for an education entry.
+func (r *EducationValidationRepository) CountByProfileEducationID(ctx context.Context, profileEducationID uuid.UUID) (int, error) {
+	count, err := r.db.NewSelect().
+		Model((*domain.EducationValidation)(nil)).
+		Where("profile_education_id = ?", profileEducationID).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Compile-time check that EducationValidationRepository implements domain.EducationValidationRepository.
+var _ domain.EducationValidationRepository = (*EducationValidationRepository)(nil)
@@ -0,0 +1,315 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func TestEducationValidationRepository_Create(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	educationRepo := postgres.NewProfileEducationRepository(db)
+	letterRepo := postgres.NewReferenceLetterRepository(db)
+	validationRepo := postgres.NewEducationValidationRepository(db)
+	ctx := context.Background()
+
+	// Create user, profile, education, and reference letter
+	user := &domain.User{
+		Email:        "eduvalidation@example.com",
+		PasswordHash: "hashed_password",
+	}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("Create user failed: %v", err)
+	}
+
+	profile := &domain.Profile{
+		UserID: user.ID,
+	}
+	if err := profileRepo.Create(ctx, profile); err != nil {
+		t.Fatalf("Create profile failed: %v", err)
+	}
+
+	education := &domain.ProfileEducation{
+		ProfileID:    profile.ID,
+		Institution:  "Stanford University",
+		Degree:       "MSc",
+		DisplayOrder: 0,
+		Source:       domain.ExperienceSourceManual,
+	}
+	if err := educationRepo.Create(ctx, education); err != nil {
+		t.Fatalf("Create education failed: %v", err)
+	}
+
+	letter := &domain.ReferenceLetter{
+		UserID: user.ID,
+		Status: domain.ReferenceLetterStatusCompleted,
+	}
+	if err := letterRepo.Create(ctx, letter); err != nil {
+		t.Fatalf("Create letter failed: %v", err)
+	}
+
+	// Create validation
+	validation := &domain.EducationValidation{
+		ProfileEducationID: education.ID,
+		ReferenceLetterID:  letter.ID,
+		QuoteSnippet:       strPtr("As her thesis advisor at Stanford..."),
+	}
+
+	err := validationRepo.Create(ctx, validation)
+	if err != nil {
+		t.Fatalf("Create validation failed: %v", err)
+	}
+
+	if validation.ID == uuid.Nil {
+		t.Error("expected validation ID to be set after create")
+	}
+}
+
+func TestEducationValidationRepository_GetByProfileEducationID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	educationRepo := postgres.NewProfileEducationRepository(db)
+	letterRepo := postgres.NewReferenceLetterRepository(db)
+	validationRepo := postgres.NewEducationValidationRepository(db)
+	ctx := context.Background()
+
+	// Create user, profile, education
+	user := &domain.User{
+		Email:        "eduvalidationget@example.com",
+		PasswordHash: "hashed_password",
+	}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("Create user failed: %v", err)
+	}
+
+	profile := &domain.Profile{
+		UserID: user.ID,
+	}
+	if err := profileRepo.Create(ctx, profile); err != nil {
+		t.Fatalf("Create profile failed: %v", err)
+	}
+
+	education := &domain.ProfileEducation{
+		ProfileID:    profile.ID,
+		Institution:  "University of Vienna",
+		Degree:       "BSc",
+		DisplayOrder: 0,
+		Source:       domain.ExperienceSourceManual,
+	}
+	if err := educationRepo.Create(ctx, education); err != nil {
+		t.Fatalf("Create education failed: %v", err)
+	}
+
+	// Create multiple reference letters with validations
+	for i := 0; i < 2; i++ {
+		letter := &domain.ReferenceLetter{
+			UserID: user.ID,
+			Status: domain.ReferenceLetterStatusCompleted,
+		}
+		if err := letterRepo.Create(ctx, letter); err != nil {
+			t.Fatalf("Create letter %d failed: %v", i, err)
+		}
+
+		validation := &domain.EducationValidation{
+			ProfileEducationID: education.ID,
+			ReferenceLetterID:  letter.ID,
+			QuoteSnippet:       strPtr("Education quote " + string(rune('A'+i))),
+		}
+		if err := validationRepo.Create(ctx, validation); err != nil {
+			t.Fatalf("Create validation %d failed: %v", i, err)
+		}
+	}
+
+	// Retrieve by education ID
+	validations, err := validationRepo.GetByProfileEducationID(ctx, education.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileEducationID failed: %v", err)
+	}
+
+	if len(validations) != 2 {
+		t.Errorf("expected 2 validations, got %d", len(validations))
+	}
+}
+
+func TestEducationValidationRepository_CountByProfileEducationID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	educationRepo := postgres.NewProfileEducationRepository(db)
+	letterRepo := postgres.NewReferenceLetterRepository(db)
+	validationRepo := postgres.NewEducationValidationRepository(db)
+	ctx := context.Background()
+
+	// Create user, profile, education
+	user := &domain.User{
+		Email:        "eduvalidationcount@example.com",
+		PasswordHash: "hashed_password",
+	}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("Create user failed: %v", err)
+	}
+
+	profile := &domain.Profile{
+		UserID: user.ID,
+	}
+	if err := profileRepo.Create(ctx, profile); err != nil {
+		t.Fatalf("Create profile failed: %v", err)
+	}
+
+	education := &domain.ProfileEducation{
+		ProfileID:    profile.ID,
+		Institution:  "ETH Zurich",
+		Degree:       "PhD",
+		DisplayOrder: 0,
+		Source:       domain.ExperienceSourceManual,
+	}
+	if err := educationRepo.Create(ctx, education); err != nil {
+		t.Fatalf("Create education failed: %v", err)
+	}
+
+	// Verify count is 0
+	count, err := validationRepo.CountByProfileEducationID(ctx, education.ID)
+	if err != nil {
+		t.Fatalf("CountByProfileEducationID failed: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("expected 0 validations, got %d", count)
+	}
+
+	// Add validations
+	for i := 0; i < 3; i++ {
+		letter := &domain.ReferenceLetter{
+			UserID: user.ID,
+			Status: domain.ReferenceLetterStatusCompleted,
+		}
+		if createErr := letterRepo.Create(ctx, letter); createErr != nil {
+			t.Fatalf("Create letter %d failed: %v", i, createErr)
+		}
+
+		validation := &domain.EducationValidation{
+			ProfileEducationID: education.ID,
+			ReferenceLetterID:  letter.ID,
+		}
+		if createErr := validationRepo.Create(ctx, validation); createErr != nil {
+			t.Fatalf("Create validation %d failed: %v", i, createErr)
+		}
+	}
+
+	// Verify count is 3
+	count, err = validationRepo.CountByProfileEducationID(ctx, education.ID)
+	if err != nil {
+		t.Fatalf("CountByProfileEducationID failed: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("expected 3 validations, got %d", count)
+	}
+}
+
+func TestEducationValidationRepository_DeleteByReferenceLetterID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	educationRepo := postgres.NewProfileEducationRepository(db)
+	letterRepo := postgres.NewReferenceLetterRepository(db)
+	validationRepo := postgres.NewEducationValidationRepository(db)
+	ctx := context.Background()
+
+	// Create user, profile, education
+	user := &domain.User{
+		Email:        "eduvalidationdelete@example.com",
+		PasswordHash: "hashed_password",
+	}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("Create user failed: %v", err)
+	}
+
+	profile := &domain.Profile{
+		UserID: user.ID,
+	}
+	if err := profileRepo.Create(ctx, profile); err != nil {
+		t.Fatalf("Create profile failed: %v", err)
+	}
+
+	education := &domain.ProfileEducation{
+		ProfileID:    profile.ID,
+		Institution:  "MIT",
+		Degree:       "MBA",
+		DisplayOrder: 0,
+		Source:       domain.ExperienceSourceManual,
+	}
+	if err := educationRepo.Create(ctx, education); err != nil {
+		t.Fatalf("Create education failed: %v", err)
+	}
+
+	letter := &domain.ReferenceLetter{
+		UserID: user.ID,
+		Status: domain.ReferenceLetterStatusCompleted,
+	}
+	if err := letterRepo.Create(ctx, letter); err != nil {
+		t.Fatalf("Create letter failed: %v", err)
+	}
+
+	// Create validations
+	for i := 0; i < 2; i++ {
+		edu := &domain.ProfileEducation{
+			ProfileID:    profile.ID,
+			Institution:  "University " + string(rune('A'+i)),
+			Degree:       "BSc",
+			DisplayOrder: i + 1,
+			Source:       domain.ExperienceSourceManual,
+		}
+		if err := educationRepo.Create(ctx, edu); err != nil {
+			t.Fatalf("Create education %d failed: %v", i, err)
+		}
+
+		validation := &domain.EducationValidation{
+			ProfileEducationID: edu.ID,
+			ReferenceLetterID:  letter.ID,
+		}
+		if err := validationRepo.Create(ctx, validation); err != nil {
+			t.Fatalf("Create validation %d failed: %v", i, err)
+		}
+	}
+
+	// Verify validations exist
+	validations, err := validationRepo.GetByReferenceLetterID(ctx, letter.ID)
+	if err != nil {
+		t.Fatalf("GetByReferenceLetterID failed: %v", err)
+	}
+	if len(validations) != 2 {
+		t.Fatalf("expected 2 validations, got %d", len(validations))
+	}
+
+	// Delete all validations by reference letter ID
+	if deleteErr := validationRepo.DeleteByReferenceLetterID(ctx, letter.ID); deleteErr != nil {
+		t.Fatalf("DeleteByReferenceLetterID failed: %v", deleteErr)
+	}
+
+	// Verify deletion
+	remaining, err := validationRepo.GetByReferenceLetterID(ctx, letter.ID)
+	if err != nil {
+		t.Fatalf("GetByReferenceLetterID failed: %v", err)
+	}
+	if len(remaining) != 0 {
+		t.Errorf("expected 0 validations after delete, got %d", len(remaining))
+	}
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// EvidenceEmbeddingRepository implements domain.EvidenceEmbeddingRepository using PostgreSQL
+// with the pgvector extension.
+type EvidenceEmbeddingRepository struct {
+	db bun.IDB
+}
+
+// NewEvidenceEmbeddingRepository creates a new PostgreSQL evidence embedding repository.
+func NewEvidenceEmbeddingRepository(db bun.IDB) *EvidenceEmbeddingRepository {
+	return &EvidenceEmbeddingRepository{db: db}
+}
+
+// GetByProfileID retrieves all embeddings of a profile's evidence.
+func (r *EvidenceEmbeddingRepository) GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*domain.EvidenceEmbedding, error) {
+	var embeddings []*domain.EvidenceEmbedding
+	err := r.db.NewSelect().Model(&embeddings).Where("profile_id = ?", profileID).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return embeddings, nil
+}
+
+// Upsert saves embeddings, replacing the one stored for the same source.
+func (r *EvidenceEmbeddingRepository) Upsert(ctx context.Context, embeddings []*domain.EvidenceEmbedding) error {
+	if len(embeddings) == 0 {
+		return nil
+	}
+	now := time.Now()
+	for _, e := range embeddings {
+		if e.ID == uuid.Nil {
+			e.ID = uuid.New()
+		}
+		e.UpdatedAt = now
+	}
+	_, err := r.db.NewInsert().Model(&embeddings).
+		On("CONFLICT (source_kind, source_id) DO UPDATE").
+		Set("profile_id = EXCLUDED.profile_id").
+		Set("content = EXCLUDED.content").
+		Set("content_hash = EXCLUDED.content_hash").
+		Set("model = EXCLUDED.model").
+		Set("embedding = EXCLUDED.embedding").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	return err
+}
+
+// Delete removes embeddings by ID.
+func (r *EvidenceEmbeddingRepository) Delete(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := r.db.NewDelete().Model((*domain.EvidenceEmbedding)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+	return err
+}
+
+// evidenceMatchRow is an embedding with its similarity to a search vector.
+type evidenceMatchRow struct {
+	domain.EvidenceEmbedding `bun:",extend"`
+
+	Score float64 `bun:"score,scanonly"`
+}
+
+// Search returns up to limit of the profile's evidence embeddings made by model, most
+// similar to vector first, leaving out skill name embeddings. Similarity is the cosine similarity, from pgvector's cosine distance.
+func (r *EvidenceEmbeddingRepository) Search(ctx context.Context, profileID uuid.UUID, model string, vector domain.Vector, limit int) ([]*domain.EvidenceMatch, error) {
+	var rows []*evidenceMatchRow
+	err := r.db.NewSelect().Model(&rows).
+		ColumnExpr("ee.*").
+		ColumnExpr("1 - (ee.embedding <=> ?::vector) AS score", vector).
+		Where("ee.profile_id = ?", profileID).
+		Where("ee.model = ?", model).
+		Where("ee.source_kind <> ?", domain.EvidenceSkillName).
+		OrderExpr("ee.embedding <=> ?::vector", vector).
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*domain.EvidenceMatch, len(rows))
+	for i, row := range rows {
+		embedding := row.EvidenceEmbedding
+		matches[i] = &domain.EvidenceMatch{Embedding: &embedding, Score: row.Score}
+	}
+	return matches, nil
+}
+
+// Compile-time check that EvidenceEmbeddingRepository implements domain.EvidenceEmbeddingRepository.
+var _ domain.EvidenceEmbeddingRepository = (*EvidenceEmbeddingRepository)(nil)
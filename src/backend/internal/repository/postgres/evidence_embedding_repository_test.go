@@ -0,0 +1,115 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func TestEvidenceEmbeddingRepository_UpsertAndSearch(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	repo := postgres.NewEvidenceEmbeddingRepository(db)
+	ctx := context.Background()
+
+	user := &domain.User{Email: "embeddings@example.com", PasswordHash: "hashed_password"}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("Create user failed: %v", err)
+	}
+	profile := &domain.Profile{UserID: user.ID}
+	if err := profileRepo.Create(ctx, profile); err != nil {
+		t.Fatalf("Create profile failed: %v", err)
+	}
+
+	testimonial := &domain.EvidenceEmbedding{
+		ProfileID:   profile.ID,
+		SourceKind:  domain.EvidenceTestimonial,
+		SourceID:    uuid.New(),
+		Content:     "She kept our clusters running.",
+		ContentHash: "a",
+		Model:       "fake/test",
+		Embedding:   domain.Vector{1, 0, 0},
+	}
+	experience := &domain.EvidenceEmbedding{
+		ProfileID:   profile.ID,
+		SourceKind:  domain.EvidenceExperience,
+		SourceID:    uuid.New(),
+		Content:     "Led the data platform team.",
+		ContentHash: "b",
+		Model:       "fake/test",
+		Embedding:   domain.Vector{0, 1, 0},
+	}
+	// Skill names are embedded for matching, not searched.
+	skillName := &domain.EvidenceEmbedding{
+		ProfileID:   profile.ID,
+		SourceKind:  domain.EvidenceSkillName,
+		SourceID:    uuid.New(),
+		Content:     "Data Platforms",
+		ContentHash: "d",
+		Model:       "fake/test",
+		Embedding:   domain.Vector{0, 1, 0},
+	}
+	if err := repo.Upsert(ctx, []*domain.EvidenceEmbedding{testimonial, experience, skillName}); err != nil {
+		t.Fatalf("Upsert failed: %v", err)
+	}
+
+	// Upserting the same source replaces its embedding.
+	replacement := &domain.EvidenceEmbedding{
+		ProfileID:   profile.ID,
+		SourceKind:  domain.EvidenceExperience,
+		SourceID:    experience.SourceID,
+		Content:     "Led the data platform team of eight.",
+		ContentHash: "c",
+		Model:       "fake/test",
+		Embedding:   domain.Vector{0.6, 0.8, 0},
+	}
+	if err := repo.Upsert(ctx, []*domain.EvidenceEmbedding{replacement}); err != nil {
+		t.Fatalf("Upsert replacement failed: %v", err)
+	}
+
+	stored, err := repo.GetByProfileID(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileID failed: %v", err)
+	}
+	if len(stored) != 3 {
+		t.Fatalf("expected 3 embeddings, got %d", len(stored))
+	}
+
+	matches, err := repo.Search(ctx, profile.ID, "fake/test", domain.Vector{0, 1, 0}, 10)
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(matches) != 2 || matches[0].Embedding.SourceID != experience.SourceID || matches[0].Embedding.Content != replacement.Content {
+		t.Fatalf("expected the replaced experience first and no skill name, got %+v", matches)
+	}
+	if matches[0].Score < 0.79 || matches[0].Score > 0.81 || len(matches[0].Embedding.Embedding) != 3 {
+		t.Errorf("expected a cosine similarity of 0.8 and the stored vector, got %+v", matches[0])
+	}
+
+	other, err := repo.Search(ctx, profile.ID, "openai/text-embedding-3-small", domain.Vector{0, 1, 0}, 10)
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(other) != 0 {
+		t.Errorf("expected no matches for another model, got %d", len(other))
+	}
+
+	if err := repo.Delete(ctx, []uuid.UUID{testimonial.ID, skillName.ID}); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	stored, err = repo.GetByProfileID(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileID failed: %v", err)
+	}
+	if len(stored) != 1 || stored[0].SourceKind != domain.EvidenceExperience {
+		t.Errorf("expected only the experience to remain, got %+v", stored)
+	}
+}
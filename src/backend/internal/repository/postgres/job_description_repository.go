@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// JobDescriptionRepository implements domain.JobDescriptionRepository using PostgreSQL.
+type JobDescriptionRepository struct {
+	db bun.IDB
+}
+
+// NewJobDescriptionRepository creates a new PostgreSQL job description repository.
+func NewJobDescriptionRepository(db bun.IDB) *JobDescriptionRepository {
+	return &JobDescriptionRepository{db: db}
+}
+
+// Create persists a new job description.
+func (r *JobDescriptionRepository) Create(ctx context.Context, jd *domain.JobDescription) error {
+	_, err := r.db.NewInsert().Model(jd).Exec(ctx)
+	return err
+}
+
+// GetByID retrieves a job description by its ID.
+func (r *JobDescriptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.JobDescription, error) {
+	jd := new(domain.JobDescription)
+	err := r.db.NewSelect().Model(jd).Where("id = ?", id).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return jd, nil
+}
+
+// GetByUserID retrieves all job descriptions saved by a user, newest first.
+func (r *JobDescriptionRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.JobDescription, error) {
+	var jds []*domain.JobDescription
+	err := r.db.NewSelect().Model(&jds).Where("user_id = ?", userID).Order("created_at DESC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return jds, nil
+}
+
+// Delete removes a job description by its ID.
+func (r *JobDescriptionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewDelete().Model((*domain.JobDescription)(nil)).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+// Compile-time check that JobDescriptionRepository implements domain.JobDescriptionRepository.
+var _ domain.JobDescriptionRepository = (*JobDescriptionRepository)(nil)
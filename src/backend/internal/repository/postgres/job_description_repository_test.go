@@ -0,0 +1,79 @@
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func TestJobDescriptionRepository_CreateGetAndDelete(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	jdRepo := postgres.NewJobDescriptionRepository(db)
+	ctx := context.Background()
+
+	user := &domain.User{Email: "jobdescription@example.com", PasswordHash: "hashed_password"}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("Create user failed: %v", err)
+	}
+
+	title := "Senior Backend Engineer"
+	data, err := json.Marshal(domain.ExtractedJobDescriptionData{Title: &title, RequiredSkills: []string{"Go"}})
+	if err != nil {
+		t.Fatalf("failed to marshal job description data: %v", err)
+	}
+	jd := &domain.JobDescription{
+		UserID:        user.ID,
+		Title:         &title,
+		Text:          "We are looking for a senior backend engineer with Go experience.",
+		ExtractedData: data,
+	}
+	if err := jdRepo.Create(ctx, jd); err != nil {
+		t.Fatalf("Create job description failed: %v", err)
+	}
+	if jd.ID == uuid.Nil {
+		t.Fatal("expected job description ID to be set after create")
+	}
+
+	retrieved, err := jdRepo.GetByID(ctx, jd.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if retrieved == nil || retrieved.Title == nil || *retrieved.Title != title {
+		t.Fatalf("expected job description titled %q, got %+v", title, retrieved)
+	}
+
+	jds, err := jdRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("GetByUserID failed: %v", err)
+	}
+	if len(jds) != 1 {
+		t.Fatalf("expected 1 job description, got %d", len(jds))
+	}
+	var extracted domain.ExtractedJobDescriptionData
+	if err := json.Unmarshal(jds[0].ExtractedData, &extracted); err != nil {
+		t.Fatalf("failed to unmarshal extracted data: %v", err)
+	}
+	if len(extracted.RequiredSkills) != 1 || extracted.RequiredSkills[0] != "Go" {
+		t.Errorf("RequiredSkills = %v, want [Go]", extracted.RequiredSkills)
+	}
+
+	if err := jdRepo.Delete(ctx, jd.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	missing, err := jdRepo.GetByID(ctx, jd.ID)
+	if err != nil {
+		t.Fatalf("GetByID after delete failed: %v", err)
+	}
+	if missing != nil {
+		t.Error("expected nil after delete")
+	}
+}
@@ -0,0 +1,20 @@
+package postgres
+
+import (
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// ProfileAwardRepository implements domain.ProfileAwardRepository using PostgreSQL.
+type ProfileAwardRepository struct {
+	sectionRepository[domain.ProfileAward]
+}
+
+// NewProfileAwardRepository creates a new PostgreSQL profile award repository.
+func NewProfileAwardRepository(db bun.IDB) *ProfileAwardRepository {
+	return &ProfileAwardRepository{sectionRepository[domain.ProfileAward]{db: db}}
+}
+
+// Compile-time check that ProfileAwardRepository implements domain.ProfileAwardRepository.
+var _ domain.ProfileAwardRepository = (*ProfileAwardRepository)(nil)
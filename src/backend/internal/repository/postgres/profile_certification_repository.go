@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// ProfileCertificationRepository implements domain.ProfileCertificationRepository using PostgreSQL.
+type ProfileCertificationRepository struct {
+	sectionRepository[domain.ProfileCertification]
+}
+
+// NewProfileCertificationRepository creates a new PostgreSQL profile certification repository.
+func NewProfileCertificationRepository(db bun.IDB) *ProfileCertificationRepository {
+	return &ProfileCertificationRepository{sectionRepository[domain.ProfileCertification]{db: db}}
+}
+
+// GetWithExpiryDate retrieves the certifications of all profiles that state an expiry date.
+func (r *ProfileCertificationRepository) GetWithExpiryDate(ctx context.Context) ([]*domain.ProfileCertification, error) {
+	var certifications []*domain.ProfileCertification
+	err := r.db.NewSelect().
+		Model(&certifications).
+		Where("expiry_date IS NOT NULL").
+		Where("TRIM(expiry_date) <> ''").
+		Order("profile_id ASC").
+		Order("display_order ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return certifications, nil
+}
+
+// Compile-time check that ProfileCertificationRepository implements domain.ProfileCertificationRepository.
+var _ domain.ProfileCertificationRepository = (*ProfileCertificationRepository)(nil)
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// ProfileChangeRepository implements domain.ProfileChangeRepository using PostgreSQL.
+type ProfileChangeRepository struct {
+	db bun.IDB
+}
+
+// NewProfileChangeRepository creates a new PostgreSQL profile change repository.
+// Accepts bun.IDB to support both regular DB operations and transactions.
+func NewProfileChangeRepository(db bun.IDB) *ProfileChangeRepository {
+	return &ProfileChangeRepository{db: db}
+}
+
+// CreateAll appends changes to the log in one statement.
+func (r *ProfileChangeRepository) CreateAll(ctx context.Context, changes []*domain.ProfileChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	_, err := r.db.NewInsert().Model(&changes).Exec(ctx)
+	return err
+}
+
+// GetByID retrieves a change by its ID.
+func (r *ProfileChangeRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.ProfileChange, error) {
+	change := new(domain.ProfileChange)
+	err := r.db.NewSelect().Model(change).Where("id = ?", id).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// GetByProfileID retrieves all changes to a profile, newest first.
+func (r *ProfileChangeRepository) GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*domain.ProfileChange, error) {
+	var changes []*domain.ProfileChange
+	err := r.db.NewSelect().
+		Model(&changes).
+		Where("profile_id = ?", profileID).
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// GetByRevertsChangeID retrieves the change made by undoing the given change, or nil if it
+// has not been undone.
+func (r *ProfileChangeRepository) GetByRevertsChangeID(ctx context.Context, changeID uuid.UUID) (*domain.ProfileChange, error) {
+	change := new(domain.ProfileChange)
+	err := r.db.NewSelect().Model(change).Where("reverts_change_id = ?", changeID).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// Compile-time check that ProfileChangeRepository implements domain.ProfileChangeRepository.
+var _ domain.ProfileChangeRepository = (*ProfileChangeRepository)(nil)
@@ -0,0 +1,113 @@
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func TestProfileChangeRepository_AppendAndRead(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	changeRepo := postgres.NewProfileChangeRepository(db)
+	ctx := context.Background()
+
+	user := &domain.User{
+		Email:        "history@example.com",
+		PasswordHash: "hashed_password",
+	}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("Create user failed: %v", err)
+	}
+
+	profile := &domain.Profile{
+		UserID: user.ID,
+	}
+	if err := profileRepo.Create(ctx, profile); err != nil {
+		t.Fatalf("Create profile failed: %v", err)
+	}
+
+	earlier := time.Now().Add(-time.Hour)
+	created := &domain.ProfileChange{
+		ID:         uuid.New(),
+		ProfileID:  profile.ID,
+		EntityType: domain.ProfileChangeEntityExperience,
+		EntityID:   uuid.New(),
+		Action:     domain.ProfileChangeActionCreate,
+		NewValue:   json.RawMessage(`{"company":"Acme Corp","title":"Engineer"}`),
+		ActorID:    &user.ID,
+		Source:     domain.ProfileChangeSourceImport,
+		CreatedAt:  earlier,
+	}
+	updated := &domain.ProfileChange{
+		ID:         uuid.New(),
+		ProfileID:  profile.ID,
+		EntityType: domain.ProfileChangeEntityProfile,
+		EntityID:   profile.ID,
+		Action:     domain.ProfileChangeActionUpdate,
+		Field:      strPtr("summary"),
+		OldValue:   json.RawMessage(`"Hand-written summary"`),
+		NewValue:   json.RawMessage(`"Extracted summary"`),
+		Source:     domain.ProfileChangeSourceImport,
+		CreatedAt:  time.Now(),
+	}
+	if err := changeRepo.CreateAll(ctx, []*domain.ProfileChange{created, updated}); err != nil {
+		t.Fatalf("CreateAll failed: %v", err)
+	}
+
+	changes, err := changeRepo.GetByProfileID(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileID failed: %v", err)
+	}
+	if len(changes) != 2 || changes[0].ID != updated.ID {
+		t.Fatalf("expected the summary update first, got %+v", changes)
+	}
+	if string(changes[0].OldValue) != `"Hand-written summary"` {
+		t.Errorf("expected the old summary to round-trip, got %s", changes[0].OldValue)
+	}
+
+	undo := &domain.ProfileChange{
+		ID:              uuid.New(),
+		ProfileID:       profile.ID,
+		EntityType:      domain.ProfileChangeEntityProfile,
+		EntityID:        profile.ID,
+		Action:          domain.ProfileChangeActionUpdate,
+		Field:           strPtr("summary"),
+		OldValue:        updated.NewValue,
+		NewValue:        updated.OldValue,
+		Source:          domain.ProfileChangeSourceManual,
+		RevertsChangeID: &updated.ID,
+		CreatedAt:       time.Now(),
+	}
+	if err := changeRepo.CreateAll(ctx, []*domain.ProfileChange{undo}); err != nil {
+		t.Fatalf("CreateAll for the undo failed: %v", err)
+	}
+
+	// A change can only be undone once
+	again := *undo
+	again.ID = uuid.New()
+	if err := changeRepo.CreateAll(ctx, []*domain.ProfileChange{&again}); err == nil {
+		t.Error("expected a second undo of the same change to be rejected")
+	}
+
+	got, err := changeRepo.GetByRevertsChangeID(ctx, updated.ID)
+	if err != nil {
+		t.Fatalf("GetByRevertsChangeID failed: %v", err)
+	}
+	if got == nil || got.ID != undo.ID {
+		t.Errorf("expected the undo change, got %+v", got)
+	}
+	if got, err := changeRepo.GetByRevertsChangeID(ctx, created.ID); err != nil || got != nil {
+		t.Errorf("expected no undo for the create, got %+v (err %v)", got, err)
+	}
+}
@@ -41,13 +41,32 @@ func (r *ProfileEducationRepository) GetByID(ctx context.Context, id uuid.UUID)
 	return education, nil
 }
 
-// GetByProfileID retrieves all profile education entries for a profile, ordered by display order.
+// GetByProfileID retrieves all profile education entries for a profile, ordered by display
+// order and then most recent first.
 func (r *ProfileEducationRepository) GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*domain.ProfileEducation, error) {
 	var educations []*domain.ProfileEducation
 	err := r.db.NewSelect().
 		Model(&educations).
 		Where("profile_id = ?", profileID).
-		Order("display_order ASC", "created_at DESC").
+		Order("display_order ASC").
+		OrderExpr(chronologicalOrder).
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return educations, nil
+}
+
+// GetWithUnparsedDates retrieves the education entries of all profiles with a start or end
+// date that has no parsed counterpart.
+func (r *ProfileEducationRepository) GetWithUnparsedDates(ctx context.Context) ([]*domain.ProfileEducation, error) {
+	var educations []*domain.ProfileEducation
+	err := r.db.NewSelect().
+		Model(&educations).
+		Where(unparsedDates).
+		Order("profile_id ASC").
+		Order("display_order ASC").
 		Scan(ctx)
 	if err != nil {
 		return nil, err
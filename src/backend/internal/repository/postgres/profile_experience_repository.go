@@ -5,6 +5,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -41,13 +43,44 @@ func (r *ProfileExperienceRepository) GetByID(ctx context.Context, id uuid.UUID)
 	return experience, nil
 }
 
-// GetByProfileID retrieves all profile experiences for a profile, ordered by display order.
+// chronologicalOrder breaks display order ties most recent first, like service.SortByRecency:
+// ongoing entries, then by the month the entry ends and the month it starts. Undated
+// entries come last. Display orders themselves follow recency too, see
+// service.PlaceExperiences.
+const chronologicalOrder = `(is_current OR end_precision = 'present' OR (COALESCE(TRIM(end_date), '') = '' AND start_precision IS NOT NULL)) DESC,
+	end_year * 12 + CASE end_precision WHEN 'year' THEN 12 WHEN 'season' THEN end_month + 2 ELSE end_month END DESC NULLS LAST,
+	start_year * 12 + COALESCE(start_month, 1) DESC NULLS LAST`
+
+// unparsedDates matches rows with a start or end date that has no parsed precision.
+const unparsedDates = `((start_precision IS NULL AND TRIM(COALESCE(start_date, '')) <> '')
+	OR (end_precision IS NULL AND TRIM(COALESCE(end_date, '')) <> ''))`
+
+// GetByProfileID retrieves all profile experiences for a profile, ordered by display order
+// and then most recent first.
 func (r *ProfileExperienceRepository) GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*domain.ProfileExperience, error) {
 	var experiences []*domain.ProfileExperience
 	err := r.db.NewSelect().
 		Model(&experiences).
 		Where("profile_id = ?", profileID).
-		Order("display_order ASC", "created_at DESC").
+		Order("display_order ASC").
+		OrderExpr(chronologicalOrder).
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return experiences, nil
+}
+
+// GetWithUnparsedDates retrieves the experiences of all profiles with a start or end date
+// that has no parsed counterpart, such as rows stored before dates were parsed.
+func (r *ProfileExperienceRepository) GetWithUnparsedDates(ctx context.Context) ([]*domain.ProfileExperience, error) {
+	var experiences []*domain.ProfileExperience
+	err := r.db.NewSelect().
+		Model(&experiences).
+		Where(unparsedDates).
+		Order("profile_id ASC").
+		Order("display_order ASC").
 		Scan(ctx)
 	if err != nil {
 		return nil, err
@@ -90,5 +123,127 @@ func (r *ProfileExperienceRepository) DeleteBySourceResumeID(ctx context.Context
 	return err
 }
 
+// Merge folds the source experiences into the target in one transaction. Validations,
+// inconsistencies and writing suggestions move to the target; where the target already has
+// a validation from the same reference letter, the target's is kept and takes the source's
+// quote if it lacks one, and where it already has an inconsistency for the same letter and
+// field, the target's is kept.
+func (r *ProfileExperienceRepository) Merge(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*domain.ProfileExperience, error) {
+	target := new(domain.ProfileExperience)
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().Model(target).Where("id = ?", targetID).For("UPDATE").Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("experience %s not found", targetID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get target experience: %w", err)
+		}
+
+		var sources []*domain.ProfileExperience
+		err = tx.NewSelect().
+			Model(&sources).
+			Where("id IN (?)", bun.In(sourceIDs)).
+			Where("profile_id = ?", target.ProfileID).
+			Where("id <> ?", targetID).
+			Order("created_at ASC").
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get source experiences: %w", err)
+		}
+		if len(sources) == 0 {
+			return fmt.Errorf("no source experiences found on the target's profile")
+		}
+
+		ids := make([]uuid.UUID, len(sources))
+		for i, source := range sources {
+			ids[i] = source.ID
+			target.Absorb(source)
+		}
+
+		// Fill gaps in the target's validations from sources validating the same letter,
+		// then drop those source rows so the rest can move without hitting
+		// UNIQUE (profile_experience_id, reference_letter_id).
+		if _, err := tx.NewRaw(`
+			UPDATE experience_validations AS t
+			SET quote_snippet = COALESCE(t.quote_snippet, s.quote_snippet)
+			FROM experience_validations AS s
+			WHERE t.profile_experience_id = ?
+			  AND s.profile_experience_id IN (?)
+			  AND s.reference_letter_id = t.reference_letter_id`,
+			targetID, bun.In(ids)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to combine experience validations: %w", err)
+		}
+		if _, err := tx.NewRaw(`
+			DELETE FROM experience_validations AS s
+			WHERE s.profile_experience_id IN (?)
+			  AND (EXISTS (
+			        SELECT 1 FROM experience_validations AS t
+			        WHERE t.profile_experience_id = ? AND t.reference_letter_id = s.reference_letter_id)
+			    OR EXISTS (
+			        SELECT 1 FROM experience_validations AS o
+			        WHERE o.profile_experience_id IN (?) AND o.reference_letter_id = s.reference_letter_id
+			          AND (o.created_at, o.id) < (s.created_at, s.id)))`,
+			bun.In(ids), targetID, bun.In(ids)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to drop duplicate experience validations: %w", err)
+		}
+		if _, err := tx.NewUpdate().
+			Model((*domain.ExperienceValidation)(nil)).
+			Set("profile_experience_id = ?", targetID).
+			Where("profile_experience_id IN (?)", bun.In(ids)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to move experience validations: %w", err)
+		}
+
+		// Inconsistencies are unique per letter and field in the same way; the target keeps
+		// its own, so a resolution the candidate recorded on it survives.
+		if _, err := tx.NewRaw(`
+			DELETE FROM profile_inconsistencies AS s
+			WHERE s.profile_experience_id IN (?)
+			  AND (EXISTS (
+			        SELECT 1 FROM profile_inconsistencies AS t
+			        WHERE t.profile_experience_id = ? AND t.reference_letter_id = s.reference_letter_id
+			          AND t.field = s.field)
+			    OR EXISTS (
+			        SELECT 1 FROM profile_inconsistencies AS o
+			        WHERE o.profile_experience_id IN (?) AND o.reference_letter_id = s.reference_letter_id
+			          AND o.field = s.field AND (o.created_at, o.id) < (s.created_at, s.id)))`,
+			bun.In(ids), targetID, bun.In(ids)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to drop duplicate profile inconsistencies: %w", err)
+		}
+		if _, err := tx.NewUpdate().
+			Model((*domain.ProfileInconsistency)(nil)).
+			Set("profile_experience_id = ?", targetID).
+			Where("profile_experience_id IN (?)", bun.In(ids)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to move profile inconsistencies: %w", err)
+		}
+		if _, err := tx.NewUpdate().
+			Model((*domain.WritingSuggestion)(nil)).
+			Set("experience_id = ?", targetID).
+			Where("experience_id IN (?)", bun.In(ids)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to move writing suggestions: %w", err)
+		}
+
+		if _, err := tx.NewDelete().
+			Model((*domain.ProfileExperience)(nil)).
+			Where("id IN (?)", bun.In(ids)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete merged experiences: %w", err)
+		}
+
+		target.UpdatedAt = time.Now()
+		if _, err := tx.NewUpdate().Model(target).WherePK().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update target experience: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
 // Compile-time check that ProfileExperienceRepository implements domain.ProfileExperienceRepository.
 var _ domain.ProfileExperienceRepository = (*ProfileExperienceRepository)(nil)
@@ -0,0 +1,121 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func TestProfileExperienceRepository_Merge(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	experienceRepo := postgres.NewProfileExperienceRepository(db)
+	letterRepo := postgres.NewReferenceLetterRepository(db)
+	inconsistencyRepo := postgres.NewProfileInconsistencyRepository(db)
+	suggestionRepo := postgres.NewWritingSuggestionRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "experiencemerge@example.com")
+
+	target := &domain.ProfileExperience{ProfileID: profile.ID, Company: "Acme Corp", Title: "Lead Engineer", Source: domain.ExperienceSourceManual}
+	source := &domain.ProfileExperience{ProfileID: profile.ID, Company: "Acme", Title: "Lead Engineer", Source: domain.ExperienceSourceResumeExtracted}
+	for _, e := range []*domain.ProfileExperience{target, source} {
+		if err := experienceRepo.Create(ctx, e); err != nil {
+			t.Fatalf("Create experience failed: %v", err)
+		}
+	}
+
+	letter := &domain.ReferenceLetter{UserID: profile.UserID, Status: domain.ReferenceLetterStatusCompleted}
+	if err := letterRepo.Create(ctx, letter); err != nil {
+		t.Fatalf("Create letter failed: %v", err)
+	}
+
+	// The letter contradicts the title of both; only the source's start date
+	inconsistencies := []*domain.ProfileInconsistency{
+		{ProfileID: profile.ID, ProfileExperienceID: target.ID, ReferenceLetterID: letter.ID, Field: domain.InconsistencyFieldTitle, ResumeValue: "Lead Engineer", LetterValue: "Senior Engineer", Status: domain.InconsistencyStatusResolved},
+		{ProfileID: profile.ID, ProfileExperienceID: source.ID, ReferenceLetterID: letter.ID, Field: domain.InconsistencyFieldTitle, ResumeValue: "Lead Engineer", LetterValue: "Senior Engineer"},
+		{ProfileID: profile.ID, ProfileExperienceID: source.ID, ReferenceLetterID: letter.ID, Field: domain.InconsistencyFieldStartDate, ResumeValue: "2019", LetterValue: "2018"},
+	}
+	for _, i := range inconsistencies {
+		if err := inconsistencyRepo.Create(ctx, i); err != nil {
+			t.Fatalf("Create inconsistency failed: %v", err)
+		}
+	}
+
+	suggestion := &domain.WritingSuggestion{
+		ProfileID:    profile.ID,
+		ExperienceID: &source.ID,
+		Kind:         domain.WritingSuggestionHighlights,
+		Drafts:       []domain.WritingDraft{{Text: "Led the Acme payments team"}},
+		Status:       domain.WritingSuggestionPending,
+	}
+	if err := suggestionRepo.Create(ctx, suggestion); err != nil {
+		t.Fatalf("Create suggestion failed: %v", err)
+	}
+
+	if _, err := experienceRepo.Merge(ctx, target.ID, []uuid.UUID{source.ID}); err != nil {
+		t.Fatalf("Merge failed: %v", err)
+	}
+
+	found, err := inconsistencyRepo.GetByProfileID(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileID failed: %v", err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("expected the duplicate title inconsistency to be dropped, got %d", len(found))
+	}
+	for _, i := range found {
+		if i.ProfileExperienceID != target.ID {
+			t.Errorf("expected inconsistency %s to move to the target", i.Field)
+		}
+		if i.Field == domain.InconsistencyFieldTitle && i.Status != domain.InconsistencyStatusResolved {
+			t.Errorf("expected the target's resolved title inconsistency to be kept, got %s", i.Status)
+		}
+	}
+
+	moved, err := suggestionRepo.GetByID(ctx, suggestion.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if moved == nil || moved.ExperienceID == nil || *moved.ExperienceID != target.ID {
+		t.Errorf("expected the writing suggestion to move to the target, got %+v", moved)
+	}
+}
+
+func TestProfileExperienceRepository_GetWithUnparsedDates(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	experienceRepo := postgres.NewProfileExperienceRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "unparseddates@example.com")
+
+	parsed := &domain.ProfileExperience{ProfileID: profile.ID, Company: "Acme", Title: "Engineer", StartDate: strPtr("2019"), Start: domain.PartialDate{Year: 2019, Precision: domain.DatePrecisionYear}, Source: domain.ExperienceSourceManual}
+	unparsed := &domain.ProfileExperience{ProfileID: profile.ID, Company: "Globex", Title: "Engineer", StartDate: strPtr("since March 2019"), Source: domain.ExperienceSourceManual, DisplayOrder: 1}
+	undated := &domain.ProfileExperience{ProfileID: profile.ID, Company: "Initech", Title: "Engineer", EndDate: strPtr(" "), Source: domain.ExperienceSourceManual, DisplayOrder: 2}
+	for _, e := range []*domain.ProfileExperience{parsed, unparsed, undated} {
+		if err := experienceRepo.Create(ctx, e); err != nil {
+			t.Fatalf("Create experience failed: %v", err)
+		}
+	}
+
+	found, err := experienceRepo.GetWithUnparsedDates(ctx)
+	if err != nil {
+		t.Fatalf("GetWithUnparsedDates failed: %v", err)
+	}
+	if len(found) != 1 || found[0].ID != unparsed.ID {
+		t.Errorf("expected only the experience with an unparsed date, got %d", len(found))
+	}
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// ProfileInconsistencyRepository implements domain.ProfileInconsistencyRepository using PostgreSQL.
+type ProfileInconsistencyRepository struct {
+	db bun.IDB
+}
+
+// NewProfileInconsistencyRepository creates a new PostgreSQL profile inconsistency repository.
+// Accepts bun.IDB to support both regular DB operations and transactions.
+func NewProfileInconsistencyRepository(db bun.IDB) *ProfileInconsistencyRepository {
+	return &ProfileInconsistencyRepository{db: db}
+}
+
+// Create persists a new inconsistency.
+func (r *ProfileInconsistencyRepository) Create(ctx context.Context, inconsistency *domain.ProfileInconsistency) error {
+	_, err := r.db.NewInsert().Model(inconsistency).Exec(ctx)
+	return err
+}
+
+// GetByID retrieves an inconsistency by its ID.
+func (r *ProfileInconsistencyRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.ProfileInconsistency, error) {
+	inconsistency := new(domain.ProfileInconsistency)
+	err := r.db.NewSelect().Model(inconsistency).Where("id = ?", id).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return inconsistency, nil
+}
+
+// GetByProfileID retrieves all inconsistencies for a profile, newest first.
+func (r *ProfileInconsistencyRepository) GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*domain.ProfileInconsistency, error) {
+	var inconsistencies []*domain.ProfileInconsistency
+	err := r.db.NewSelect().
+		Model(&inconsistencies).
+		Where("profile_id = ?", profileID).
+		Order("created_at DESC", "field ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return inconsistencies, nil
+}
+
+// Update persists changes to an existing inconsistency.
+func (r *ProfileInconsistencyRepository) Update(ctx context.Context, inconsistency *domain.ProfileInconsistency) error {
+	inconsistency.UpdatedAt = time.Now()
+	_, err := r.db.NewUpdate().
+		Model(inconsistency).
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// Compile-time check that ProfileInconsistencyRepository implements domain.ProfileInconsistencyRepository.
+var _ domain.ProfileInconsistencyRepository = (*ProfileInconsistencyRepository)(nil)
@@ -0,0 +1,106 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func TestProfileInconsistencyRepository_CreateAndUpdate(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	experienceRepo := postgres.NewProfileExperienceRepository(db)
+	letterRepo := postgres.NewReferenceLetterRepository(db)
+	inconsistencyRepo := postgres.NewProfileInconsistencyRepository(db)
+	ctx := context.Background()
+
+	user := &domain.User{
+		Email:        "inconsistency@example.com",
+		PasswordHash: "hashed_password",
+	}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("Create user failed: %v", err)
+	}
+
+	profile := &domain.Profile{
+		UserID: user.ID,
+	}
+	if err := profileRepo.Create(ctx, profile); err != nil {
+		t.Fatalf("Create profile failed: %v", err)
+	}
+
+	experience := &domain.ProfileExperience{
+		ProfileID: profile.ID,
+		Company:   "Acme Corp",
+		Title:     "Lead Engineer",
+		Source:    domain.ExperienceSourceResumeExtracted,
+	}
+	if err := experienceRepo.Create(ctx, experience); err != nil {
+		t.Fatalf("Create experience failed: %v", err)
+	}
+
+	letter := &domain.ReferenceLetter{
+		UserID: user.ID,
+		Status: domain.ReferenceLetterStatusCompleted,
+	}
+	if err := letterRepo.Create(ctx, letter); err != nil {
+		t.Fatalf("Create letter failed: %v", err)
+	}
+
+	inconsistency := &domain.ProfileInconsistency{
+		ProfileID:           profile.ID,
+		ProfileExperienceID: experience.ID,
+		ReferenceLetterID:   letter.ID,
+		Field:               domain.InconsistencyFieldTitle,
+		ResumeValue:         "Lead Engineer",
+		LetterValue:         "Senior Engineer",
+		Quote:               strPtr("As a Senior Engineer at Acme Corp, she..."),
+	}
+	if err := inconsistencyRepo.Create(ctx, inconsistency); err != nil {
+		t.Fatalf("Create inconsistency failed: %v", err)
+	}
+
+	// The same field contradicted by the same letter is recorded once
+	duplicate := &domain.ProfileInconsistency{
+		ProfileID:           profile.ID,
+		ProfileExperienceID: experience.ID,
+		ReferenceLetterID:   letter.ID,
+		Field:               domain.InconsistencyFieldTitle,
+		ResumeValue:         "Lead Engineer",
+		LetterValue:         "Staff Engineer",
+	}
+	if err := inconsistencyRepo.Create(ctx, duplicate); err == nil {
+		t.Error("expected a duplicate inconsistency to be rejected")
+	}
+
+	found, err := inconsistencyRepo.GetByProfileID(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileID failed: %v", err)
+	}
+	if len(found) != 1 || found[0].Status != domain.InconsistencyStatusOpen {
+		t.Fatalf("expected 1 open inconsistency, got %+v", found)
+	}
+
+	resolvedAt := time.Now()
+	inconsistency.Status = domain.InconsistencyStatusDismissed
+	inconsistency.ResolutionNote = strPtr("Promoted to lead after the letter was written")
+	inconsistency.ResolvedAt = &resolvedAt
+	if err := inconsistencyRepo.Update(ctx, inconsistency); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	got, err := inconsistencyRepo.GetByID(ctx, inconsistency.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if got.Status != domain.InconsistencyStatusDismissed || got.ResolvedAt == nil || got.ResolutionNote == nil {
+		t.Errorf("expected a dismissed inconsistency with a note, got %+v", got)
+	}
+}
@@ -0,0 +1,20 @@
+package postgres
+
+import (
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// ProfileLanguageRepository implements domain.ProfileLanguageRepository using PostgreSQL.
+type ProfileLanguageRepository struct {
+	sectionRepository[domain.ProfileLanguage]
+}
+
+// NewProfileLanguageRepository creates a new PostgreSQL profile language repository.
+func NewProfileLanguageRepository(db bun.IDB) *ProfileLanguageRepository {
+	return &ProfileLanguageRepository{sectionRepository[domain.ProfileLanguage]{db: db}}
+}
+
+// Compile-time check that ProfileLanguageRepository implements domain.ProfileLanguageRepository.
+var _ domain.ProfileLanguageRepository = (*ProfileLanguageRepository)(nil)
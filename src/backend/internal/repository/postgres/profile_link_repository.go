@@ -0,0 +1,20 @@
+package postgres
+
+import (
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// ProfileLinkRepository implements domain.ProfileLinkRepository using PostgreSQL.
+type ProfileLinkRepository struct {
+	sectionRepository[domain.ProfileLink]
+}
+
+// NewProfileLinkRepository creates a new PostgreSQL profile link repository.
+func NewProfileLinkRepository(db bun.IDB) *ProfileLinkRepository {
+	return &ProfileLinkRepository{sectionRepository[domain.ProfileLink]{db: db}}
+}
+
+// Compile-time check that ProfileLinkRepository implements domain.ProfileLinkRepository.
+var _ domain.ProfileLinkRepository = (*ProfileLinkRepository)(nil)
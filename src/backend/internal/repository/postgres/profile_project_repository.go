@@ -0,0 +1,20 @@
+package postgres
+
+import (
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// ProfileProjectRepository implements domain.ProfileProjectRepository using PostgreSQL.
+type ProfileProjectRepository struct {
+	sectionRepository[domain.ProfileProject]
+}
+
+// NewProfileProjectRepository creates a new PostgreSQL profile project repository.
+func NewProfileProjectRepository(db bun.IDB) *ProfileProjectRepository {
+	return &ProfileProjectRepository{sectionRepository[domain.ProfileProject]{db: db}}
+}
+
+// Compile-time check that ProfileProjectRepository implements domain.ProfileProjectRepository.
+var _ domain.ProfileProjectRepository = (*ProfileProjectRepository)(nil)
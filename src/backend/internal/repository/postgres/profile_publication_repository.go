@@ -0,0 +1,20 @@
+package postgres
+
+import (
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// ProfilePublicationRepository implements domain.ProfilePublicationRepository using PostgreSQL.
+type ProfilePublicationRepository struct {
+	sectionRepository[domain.ProfilePublication]
+}
+
+// NewProfilePublicationRepository creates a new PostgreSQL profile publication repository.
+func NewProfilePublicationRepository(db bun.IDB) *ProfilePublicationRepository {
+	return &ProfilePublicationRepository{sectionRepository[domain.ProfilePublication]{db: db}}
+}
+
+// Compile-time check that ProfilePublicationRepository implements domain.ProfilePublicationRepository.
+var _ domain.ProfilePublicationRepository = (*ProfilePublicationRepository)(nil)
@@ -5,6 +5,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -87,5 +89,46 @@ func (r *ProfileRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+// ReorderItems sets the display order of the profile's experiences, education entries and
+// skills in one transaction. It fails without changes if an ID is not on the profile.
+func (r *ProfileRepository) ReorderItems(ctx context.Context, profileID uuid.UUID, order *domain.ProfileItemOrder) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := reorder(ctx, tx, (*domain.ProfileExperience)(nil), profileID, order.ExperienceIDs); err != nil {
+			return fmt.Errorf("failed to reorder experiences: %w", err)
+		}
+		if err := reorder(ctx, tx, (*domain.ProfileEducation)(nil), profileID, order.EducationIDs); err != nil {
+			return fmt.Errorf("failed to reorder education: %w", err)
+		}
+		if err := reorder(ctx, tx, (*domain.ProfileSkill)(nil), profileID, order.SkillIDs); err != nil {
+			return fmt.Errorf("failed to reorder skills: %w", err)
+		}
+		return nil
+	})
+}
+
+// reorder sets display_order to each ID's position in ids for rows of model's table.
+func reorder(ctx context.Context, tx bun.Tx, model any, profileID uuid.UUID, ids []uuid.UUID) error {
+	for i, id := range ids {
+		res, err := tx.NewUpdate().
+			Model(model).
+			Set("display_order = ?", i).
+			Set("updated_at = ?", time.Now()).
+			Where("id = ?", id).
+			Where("profile_id = ?", profileID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return fmt.Errorf("item %s not found on profile", id)
+		}
+	}
+	return nil
+}
+
 // Compile-time check that ProfileRepository implements domain.ProfileRepository.
 var _ domain.ProfileRepository = (*ProfileRepository)(nil)
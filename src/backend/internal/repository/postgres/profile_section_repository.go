@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// sectionRepository implements the operations the profile section repositories (links,
+// certifications, languages, projects, publications and awards) share. T is the section's
+// domain model; every section table has id, profile_id, display_order, created_at and
+// source_resume_id columns.
+type sectionRepository[T any] struct {
+	db bun.IDB
+}
+
+// Create persists a new section entry.
+func (r *sectionRepository[T]) Create(ctx context.Context, item *T) error {
+	_, err := r.db.NewInsert().Model(item).Exec(ctx)
+	return err
+}
+
+// GetByID retrieves a section entry by its ID, or nil if it does not exist.
+func (r *sectionRepository[T]) GetByID(ctx context.Context, id uuid.UUID) (*T, error) {
+	item := new(T)
+	err := r.db.NewSelect().Model(item).Where("id = ?", id).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// GetByProfileID retrieves all entries of a profile, ordered by display order.
+func (r *sectionRepository[T]) GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*T, error) {
+	var items []*T
+	err := r.db.NewSelect().
+		Model(&items).
+		Where("profile_id = ?", profileID).
+		Order("display_order ASC").
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// Update persists changes to an existing section entry.
+func (r *sectionRepository[T]) Update(ctx context.Context, item *T) error {
+	_, err := r.db.NewUpdate().Model(item).WherePK().Exec(ctx)
+	return err
+}
+
+// Delete removes a section entry by its ID.
+func (r *sectionRepository[T]) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewDelete().Model((*T)(nil)).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+// GetNextDisplayOrder returns the next display order value for a profile.
+func (r *sectionRepository[T]) GetNextDisplayOrder(ctx context.Context, profileID uuid.UUID) (int, error) {
+	var maxOrder int
+	err := r.db.NewSelect().
+		Model((*T)(nil)).
+		ColumnExpr("COALESCE(MAX(display_order), -1)").
+		Where("profile_id = ?", profileID).
+		Scan(ctx, &maxOrder)
+	if err != nil {
+		return 0, err
+	}
+	return maxOrder + 1, nil
+}
+
+// DeleteBySourceResumeID removes all entries extracted from a specific resume.
+func (r *sectionRepository[T]) DeleteBySourceResumeID(ctx context.Context, sourceResumeID uuid.UUID) error {
+	_, err := r.db.NewDelete().
+		Model((*T)(nil)).
+		Where("source_resume_id = ?", sourceResumeID).
+		Exec(ctx)
+	return err
+}
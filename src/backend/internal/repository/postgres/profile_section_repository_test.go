@@ -0,0 +1,97 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func TestProfileSectionRepository_CRUD(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	awardRepo := postgres.NewProfileAwardRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "sections@example.com")
+
+	order, err := awardRepo.GetNextDisplayOrder(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetNextDisplayOrder failed: %v", err)
+	}
+	if order != 0 {
+		t.Errorf("expected display order 0 on an empty profile, got %d", order)
+	}
+
+	second := &domain.ProfileAward{ProfileID: profile.ID, Title: "Hackathon Winner", DisplayOrder: 1, Source: domain.ExperienceSourceManual}
+	first := &domain.ProfileAward{ProfileID: profile.ID, Title: "Employee of the Year", DisplayOrder: 0, Source: domain.ExperienceSourceManual}
+	for _, a := range []*domain.ProfileAward{second, first} {
+		if err := awardRepo.Create(ctx, a); err != nil {
+			t.Fatalf("Create award failed: %v", err)
+		}
+	}
+
+	awards, err := awardRepo.GetByProfileID(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileID failed: %v", err)
+	}
+	if len(awards) != 2 || awards[0].ID != first.ID || awards[1].ID != second.ID {
+		t.Fatalf("expected both awards in display order, got %+v", awards)
+	}
+	if order, err = awardRepo.GetNextDisplayOrder(ctx, profile.ID); err != nil || order != 2 {
+		t.Errorf("expected next display order 2, got %d (%v)", order, err)
+	}
+
+	first.Issuer = strPtr("Acme Corp")
+	if err := awardRepo.Update(ctx, first); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	found, err := awardRepo.GetByID(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if found == nil || found.Issuer == nil || *found.Issuer != "Acme Corp" {
+		t.Errorf("expected the updated issuer, got %+v", found)
+	}
+
+	if err := awardRepo.Delete(ctx, first.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if found, err = awardRepo.GetByID(ctx, first.ID); err != nil || found != nil {
+		t.Errorf("expected the award to be deleted, got %+v (%v)", found, err)
+	}
+}
+
+func TestProfileCertificationRepository_GetWithExpiryDate(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	certificationRepo := postgres.NewProfileCertificationRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "certifications@example.com")
+
+	expiring := &domain.ProfileCertification{ProfileID: profile.ID, Name: "CKA", ExpiryDate: strPtr("2027-03"), Source: domain.ExperienceSourceManual}
+	lifetime := &domain.ProfileCertification{ProfileID: profile.ID, Name: "PMP", ExpiryDate: strPtr("  "), Source: domain.ExperienceSourceManual}
+	for _, c := range []*domain.ProfileCertification{expiring, lifetime} {
+		if err := certificationRepo.Create(ctx, c); err != nil {
+			t.Fatalf("Create certification failed: %v", err)
+		}
+	}
+
+	found, err := certificationRepo.GetWithExpiryDate(ctx)
+	if err != nil {
+		t.Fatalf("GetWithExpiryDate failed: %v", err)
+	}
+	if len(found) != 1 || found[0].ID != expiring.ID {
+		t.Errorf("expected only the certification with an expiry date, got %+v", found)
+	}
+}
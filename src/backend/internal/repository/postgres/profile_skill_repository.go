@@ -5,6 +5,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -24,6 +26,9 @@ func NewProfileSkillRepository(db bun.IDB) *ProfileSkillRepository {
 
 // Create persists a new profile skill.
 func (r *ProfileSkillRepository) Create(ctx context.Context, skill *domain.ProfileSkill) error {
+	if skill.Aliases == nil {
+		skill.Aliases = []string{}
+	}
 	_, err := r.db.NewInsert().Model(skill).Exec(ctx)
 	return err
 }
@@ -31,10 +36,15 @@ func (r *ProfileSkillRepository) Create(ctx context.Context, skill *domain.Profi
 // CreateIgnoreDuplicate persists a new profile skill, returning the existing row on duplicate.
 // On conflict (profile_id, normalized_name), the existing row's ID is returned into skill.ID
 // so callers can safely reference it for related records (e.g. SkillValidation).
+// An existing row without a canonical skill takes the new row's link.
 func (r *ProfileSkillRepository) CreateIgnoreDuplicate(ctx context.Context, skill *domain.ProfileSkill) error {
+	if skill.Aliases == nil {
+		skill.Aliases = []string{}
+	}
 	return r.db.NewInsert().
 		Model(skill).
-		On("CONFLICT (profile_id, normalized_name) DO UPDATE SET updated_at = EXCLUDED.updated_at").
+		On("CONFLICT (profile_id, normalized_name) DO UPDATE SET updated_at = EXCLUDED.updated_at, " +
+			"canonical_skill_id = COALESCE(ps.canonical_skill_id, EXCLUDED.canonical_skill_id)").
 		Returning("*").
 		Scan(ctx)
 }
@@ -68,6 +78,9 @@ func (r *ProfileSkillRepository) GetByProfileID(ctx context.Context, profileID u
 
 // Update persists changes to an existing profile skill.
 func (r *ProfileSkillRepository) Update(ctx context.Context, skill *domain.ProfileSkill) error {
+	if skill.Aliases == nil {
+		skill.Aliases = []string{}
+	}
 	_, err := r.db.NewUpdate().Model(skill).WherePK().Exec(ctx)
 	return err
 }
@@ -101,5 +114,193 @@ func (r *ProfileSkillRepository) DeleteBySourceResumeID(ctx context.Context, sou
 	return err
 }
 
+// UpdateAll persists changes to several profile skills in one transaction.
+func (r *ProfileSkillRepository) UpdateAll(ctx context.Context, skills []*domain.ProfileSkill) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, skill := range skills {
+			if skill.Aliases == nil {
+				skill.Aliases = []string{}
+			}
+			skill.UpdatedAt = time.Now()
+			if _, err := tx.NewUpdate().Model(skill).WherePK().Exec(ctx); err != nil {
+				return fmt.Errorf("failed to update skill %s: %w", skill.ID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateStats persists only the derived fields of the given skills in one transaction.
+func (r *ProfileSkillRepository) UpdateStats(ctx context.Context, skills []*domain.ProfileSkill) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, skill := range skills {
+			if _, err := tx.NewUpdate().
+				Model(skill).
+				Column("years_of_experience", "last_used", "evidence_strength").
+				WherePK().
+				Exec(ctx); err != nil {
+				return fmt.Errorf("failed to update stats of skill %s: %w", skill.ID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Merge folds the source skills into the target in one transaction. Validations move to
+// the target; where the target already has a validation from the same reference letter,
+// the target's is kept and takes the source's testimonial and quote if it lacks them.
+func (r *ProfileSkillRepository) Merge(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*domain.ProfileSkill, error) {
+	target := new(domain.ProfileSkill)
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().Model(target).Where("id = ?", targetID).For("UPDATE").Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("skill %s not found", targetID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get target skill: %w", err)
+		}
+
+		var sources []*domain.ProfileSkill
+		err = tx.NewSelect().
+			Model(&sources).
+			Where("id IN (?)", bun.In(sourceIDs)).
+			Where("profile_id = ?", target.ProfileID).
+			Where("id <> ?", targetID).
+			Order("created_at ASC").
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get source skills: %w", err)
+		}
+		if len(sources) == 0 {
+			return fmt.Errorf("no source skills found on the target's profile")
+		}
+
+		ids := make([]uuid.UUID, len(sources))
+		for i, source := range sources {
+			ids[i] = source.ID
+			target.AddAliases(source.Name)
+			target.AddAliases(source.Aliases...)
+			if target.CanonicalSkillID == nil {
+				target.CanonicalSkillID = source.CanonicalSkillID
+			}
+			if target.SourceReferenceLetterID == nil {
+				target.SourceReferenceLetterID = source.SourceReferenceLetterID
+			}
+			if target.OriginalData == nil {
+				target.OriginalData = source.OriginalData
+			}
+		}
+
+		// Fill gaps in the target's validations from sources validating the same letter,
+		// then drop those source rows so the rest can move without hitting
+		// UNIQUE (profile_skill_id, reference_letter_id).
+		if _, err := tx.NewRaw(`
+			UPDATE skill_validations AS t
+			SET testimonial_id = COALESCE(t.testimonial_id, s.testimonial_id),
+			    quote_snippet = COALESCE(t.quote_snippet, s.quote_snippet)
+			FROM skill_validations AS s
+			WHERE t.profile_skill_id = ?
+			  AND s.profile_skill_id IN (?)
+			  AND s.reference_letter_id = t.reference_letter_id`,
+			targetID, bun.In(ids)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to combine skill validations: %w", err)
+		}
+		if _, err := tx.NewRaw(`
+			DELETE FROM skill_validations AS s
+			WHERE s.profile_skill_id IN (?)
+			  AND (EXISTS (
+			        SELECT 1 FROM skill_validations AS t
+			        WHERE t.profile_skill_id = ? AND t.reference_letter_id = s.reference_letter_id)
+			    OR EXISTS (
+			        SELECT 1 FROM skill_validations AS o
+			        WHERE o.profile_skill_id IN (?) AND o.reference_letter_id = s.reference_letter_id
+			          AND (o.created_at, o.id) < (s.created_at, s.id)))`,
+			bun.In(ids), targetID, bun.In(ids)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to drop duplicate skill validations: %w", err)
+		}
+		if _, err := tx.NewUpdate().
+			Model((*domain.SkillValidation)(nil)).
+			Set("profile_skill_id = ?", targetID).
+			Where("profile_skill_id IN (?)", bun.In(ids)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to move skill validations: %w", err)
+		}
+
+		if _, err := tx.NewDelete().
+			Model((*domain.ProfileSkill)(nil)).
+			Where("id IN (?)", bun.In(ids)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete merged skills: %w", err)
+		}
+
+		if target.Aliases == nil {
+			target.Aliases = []string{}
+		}
+		target.UpdatedAt = time.Now()
+		if _, err := tx.NewUpdate().Model(target).WherePK().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update target skill: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// Split replaces a skill by the parts in one transaction. Each part gets a copy of every
+// validation of the skill. The skill is deleted before the parts are created, so a part
+// may keep its name.
+func (r *ProfileSkillRepository) Split(ctx context.Context, skillID uuid.UUID, parts []*domain.ProfileSkill) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		skill := new(domain.ProfileSkill)
+		err := tx.NewSelect().Model(skill).Where("id = ?", skillID).For("UPDATE").Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("skill %s not found", skillID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get skill: %w", err)
+		}
+
+		var validations []*domain.SkillValidation
+		if err := tx.NewSelect().Model(&validations).Where("profile_skill_id = ?", skillID).Scan(ctx); err != nil {
+			return fmt.Errorf("failed to get skill validations: %w", err)
+		}
+
+		// Deleting the skill cascades to its validations.
+		if _, err := tx.NewDelete().Model((*domain.ProfileSkill)(nil)).Where("id = ?", skillID).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete split skill: %w", err)
+		}
+
+		copies := make([]*domain.SkillValidation, 0, len(parts)*len(validations))
+		for _, part := range parts {
+			part.ProfileID = skill.ProfileID
+			if part.Aliases == nil {
+				part.Aliases = []string{}
+			}
+			for _, v := range validations {
+				copies = append(copies, &domain.SkillValidation{
+					ID:                uuid.New(),
+					ProfileSkillID:    part.ID,
+					ReferenceLetterID: v.ReferenceLetterID,
+					TestimonialID:     v.TestimonialID,
+					QuoteSnippet:      v.QuoteSnippet,
+					CreatedAt:         v.CreatedAt,
+				})
+			}
+		}
+		if _, err := tx.NewInsert().Model(&parts).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create skills: %w", err)
+		}
+		if len(copies) > 0 {
+			if _, err := tx.NewInsert().Model(&copies).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to copy skill validations: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
 // Compile-time check that ProfileSkillRepository implements domain.ProfileSkillRepository.
 var _ domain.ProfileSkillRepository = (*ProfileSkillRepository)(nil)
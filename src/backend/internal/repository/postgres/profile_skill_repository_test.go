@@ -0,0 +1,225 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func TestProfileSkillRepository_Merge(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	skillRepo := postgres.NewProfileSkillRepository(db)
+	letterRepo := postgres.NewReferenceLetterRepository(db)
+	validationRepo := postgres.NewSkillValidationRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "skillmerge@example.com")
+
+	target := &domain.ProfileSkill{ProfileID: profile.ID, Name: "Kubernetes", NormalizedName: "kubernetes", Category: "TECHNICAL"}
+	source := &domain.ProfileSkill{ProfileID: profile.ID, Name: "Container Orchestration", NormalizedName: "container orchestration", Category: "TECHNICAL"}
+	for _, s := range []*domain.ProfileSkill{target, source} {
+		if err := skillRepo.Create(ctx, s); err != nil {
+			t.Fatalf("Create skill failed: %v", err)
+		}
+	}
+
+	shared := &domain.ReferenceLetter{UserID: profile.UserID, Status: domain.ReferenceLetterStatusCompleted}
+	other := &domain.ReferenceLetter{UserID: profile.UserID, Status: domain.ReferenceLetterStatusCompleted}
+	for _, l := range []*domain.ReferenceLetter{shared, other} {
+		if err := letterRepo.Create(ctx, l); err != nil {
+			t.Fatalf("Create letter failed: %v", err)
+		}
+	}
+
+	// Both skills are validated by the shared letter; only the source has a quote for it
+	validations := []*domain.SkillValidation{
+		{ProfileSkillID: target.ID, ReferenceLetterID: shared.ID},
+		{ProfileSkillID: source.ID, ReferenceLetterID: shared.ID, QuoteSnippet: strPtr("ran our clusters")},
+		{ProfileSkillID: source.ID, ReferenceLetterID: other.ID},
+	}
+	for _, v := range validations {
+		if err := validationRepo.Create(ctx, v); err != nil {
+			t.Fatalf("Create validation failed: %v", err)
+		}
+	}
+
+	merged, err := skillRepo.Merge(ctx, target.ID, []uuid.UUID{source.ID})
+	if err != nil {
+		t.Fatalf("Merge failed: %v", err)
+	}
+	if len(merged.Aliases) != 1 || merged.Aliases[0] != "Container Orchestration" {
+		t.Errorf("expected aliases [Container Orchestration], got %v", merged.Aliases)
+	}
+
+	gone, err := skillRepo.GetByID(ctx, source.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if gone != nil {
+		t.Error("expected source skill to be deleted")
+	}
+
+	got, err := validationRepo.GetByProfileSkillID(ctx, target.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileSkillID failed: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 validations on the target, got %d", len(got))
+	}
+	for _, v := range got {
+		if v.ReferenceLetterID == shared.ID && (v.QuoteSnippet == nil || *v.QuoteSnippet != "ran our clusters") {
+			t.Errorf("expected the shared letter's validation to take the source's quote, got %v", v.QuoteSnippet)
+		}
+	}
+}
+
+func TestProfileSkillRepository_Split(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	skillRepo := postgres.NewProfileSkillRepository(db)
+	letterRepo := postgres.NewReferenceLetterRepository(db)
+	validationRepo := postgres.NewSkillValidationRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "skillsplit@example.com")
+
+	combined := &domain.ProfileSkill{ProfileID: profile.ID, Name: "React/Redux", NormalizedName: "react", Category: "TECHNICAL"}
+	if err := skillRepo.Create(ctx, combined); err != nil {
+		t.Fatalf("Create skill failed: %v", err)
+	}
+	letter := &domain.ReferenceLetter{UserID: profile.UserID, Status: domain.ReferenceLetterStatusCompleted}
+	if err := letterRepo.Create(ctx, letter); err != nil {
+		t.Fatalf("Create letter failed: %v", err)
+	}
+	if err := validationRepo.Create(ctx, &domain.SkillValidation{ProfileSkillID: combined.ID, ReferenceLetterID: letter.ID, QuoteSnippet: strPtr("built our React and Redux frontend")}); err != nil {
+		t.Fatalf("Create validation failed: %v", err)
+	}
+
+	// A part may keep the split skill's normalized name
+	parts := []*domain.ProfileSkill{
+		{ID: uuid.New(), Name: "React", NormalizedName: "react", Category: "TECHNICAL"},
+		{ID: uuid.New(), Name: "Redux", NormalizedName: "redux", Category: "TECHNICAL"},
+	}
+	if err := skillRepo.Split(ctx, combined.ID, parts); err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	gone, err := skillRepo.GetByID(ctx, combined.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if gone != nil {
+		t.Error("expected the split skill to be deleted")
+	}
+	for _, part := range parts {
+		got, err := validationRepo.GetByProfileSkillID(ctx, part.ID)
+		if err != nil {
+			t.Fatalf("GetByProfileSkillID failed: %v", err)
+		}
+		if part.ProfileID != profile.ID || len(got) != 1 || got[0].ReferenceLetterID != letter.ID || *got[0].QuoteSnippet != "built our React and Redux frontend" {
+			t.Errorf("expected %s on the profile with a copy of the validation, got %+v", part.Name, got)
+		}
+	}
+}
+
+func TestProfileSkillRepository_UpdateStats(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	skillRepo := postgres.NewProfileSkillRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "skillstats@example.com")
+
+	skill := &domain.ProfileSkill{ProfileID: profile.ID, Name: "Go", NormalizedName: "go", Category: "TECHNICAL"}
+	if err := skillRepo.Create(ctx, skill); err != nil {
+		t.Fatalf("Create skill failed: %v", err)
+	}
+
+	lastUsed := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	skill.YearsOfExperience = 5.5
+	skill.LastUsed = &lastUsed
+	skill.EvidenceStrength = 0.73
+	skill.Name = "Golang" // not a stats field, so not written
+	if err := skillRepo.UpdateStats(ctx, []*domain.ProfileSkill{skill}); err != nil {
+		t.Fatalf("UpdateStats failed: %v", err)
+	}
+
+	got, err := skillRepo.GetByID(ctx, skill.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if got.YearsOfExperience != 5.5 || got.EvidenceStrength != 0.73 {
+		t.Errorf("expected stats 5.5 years and 0.73 strength, got %v and %v", got.YearsOfExperience, got.EvidenceStrength)
+	}
+	if got.LastUsed == nil || got.LastUsed.Format("2006-01") != "2024-06" {
+		t.Errorf("expected last used 2024-06, got %v", got.LastUsed)
+	}
+	if got.Name != "Go" {
+		t.Errorf("expected name to be unchanged, got %q", got.Name)
+	}
+}
+
+func TestProfileRepository_ReorderItems(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	skillRepo := postgres.NewProfileSkillRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "reorder@example.com")
+	otherProfile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "reorder-other@example.com")
+
+	first := &domain.ProfileSkill{ProfileID: profile.ID, Name: "Go", NormalizedName: "go", Category: "TECHNICAL", DisplayOrder: 0}
+	second := &domain.ProfileSkill{ProfileID: profile.ID, Name: "Rust", NormalizedName: "rust", Category: "TECHNICAL", DisplayOrder: 1}
+	foreign := &domain.ProfileSkill{ProfileID: otherProfile.ID, Name: "Java", NormalizedName: "java", Category: "TECHNICAL"}
+	for _, s := range []*domain.ProfileSkill{first, second, foreign} {
+		if err := skillRepo.Create(ctx, s); err != nil {
+			t.Fatalf("Create skill failed: %v", err)
+		}
+	}
+
+	if err := profileRepo.ReorderItems(ctx, profile.ID, &domain.ProfileItemOrder{SkillIDs: []uuid.UUID{second.ID, first.ID}}); err != nil {
+		t.Fatalf("ReorderItems failed: %v", err)
+	}
+	skills, err := skillRepo.GetByProfileID(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileID failed: %v", err)
+	}
+	if len(skills) != 2 || skills[0].ID != second.ID || skills[1].ID != first.ID {
+		t.Errorf("expected skills reordered to [Rust Go], got %v", skills)
+	}
+
+	// An item from another profile rolls back the whole reorder
+	err = profileRepo.ReorderItems(ctx, profile.ID, &domain.ProfileItemOrder{SkillIDs: []uuid.UUID{first.ID, foreign.ID}})
+	if err == nil {
+		t.Fatal("expected reorder with a foreign item to fail")
+	}
+	got, err := skillRepo.GetByID(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if got.DisplayOrder != 1 {
+		t.Errorf("expected display order to be unchanged after a failed reorder, got %d", got.DisplayOrder)
+	}
+}
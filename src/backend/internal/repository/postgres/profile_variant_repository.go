@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// ProfileVariantRepository implements domain.ProfileVariantRepository using PostgreSQL.
+type ProfileVariantRepository struct {
+	db bun.IDB
+}
+
+// NewProfileVariantRepository creates a new PostgreSQL profile variant repository.
+func NewProfileVariantRepository(db bun.IDB) *ProfileVariantRepository {
+	return &ProfileVariantRepository{db: db}
+}
+
+// Create persists a new profile variant.
+func (r *ProfileVariantRepository) Create(ctx context.Context, variant *domain.ProfileVariant) error {
+	_, err := r.db.NewInsert().Model(variant).Exec(ctx)
+	return err
+}
+
+// GetByID retrieves a profile variant by its ID.
+func (r *ProfileVariantRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.ProfileVariant, error) {
+	return r.getOne(ctx, "id = ?", id)
+}
+
+// GetByProfileID retrieves all variants of a profile, oldest first.
+func (r *ProfileVariantRepository) GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*domain.ProfileVariant, error) {
+	var variants []*domain.ProfileVariant
+	err := r.db.NewSelect().Model(&variants).Where("profile_id = ?", profileID).Order("created_at ASC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return variants, nil
+}
+
+// GetByShareToken retrieves the variant shared with a token.
+func (r *ProfileVariantRepository) GetByShareToken(ctx context.Context, token string) (*domain.ProfileVariant, error) {
+	return r.getOne(ctx, "share_token = ?", token)
+}
+
+func (r *ProfileVariantRepository) getOne(ctx context.Context, where string, arg any) (*domain.ProfileVariant, error) {
+	variant := new(domain.ProfileVariant)
+	err := r.db.NewSelect().Model(variant).Where(where, arg).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return variant, nil
+}
+
+// Update updates an existing profile variant.
+func (r *ProfileVariantRepository) Update(ctx context.Context, variant *domain.ProfileVariant) error {
+	_, err := r.db.NewUpdate().Model(variant).WherePK().Exec(ctx)
+	return err
+}
+
+// Delete removes a profile variant by its ID.
+func (r *ProfileVariantRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewDelete().Model((*domain.ProfileVariant)(nil)).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+// Compile-time check that ProfileVariantRepository implements domain.ProfileVariantRepository.
+var _ domain.ProfileVariantRepository = (*ProfileVariantRepository)(nil)
@@ -0,0 +1,88 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func TestProfileVariantRepository_RulesAndShareToken(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	variantRepo := postgres.NewProfileVariantRepository(db)
+	ctx := context.Background()
+
+	user := &domain.User{Email: "variant@example.com", PasswordHash: "hashed_password"}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("Create user failed: %v", err)
+	}
+	profile := &domain.Profile{UserID: user.ID}
+	if err := profileRepo.Create(ctx, profile); err != nil {
+		t.Fatalf("Create profile failed: %v", err)
+	}
+
+	hidden, experienceID := uuid.New(), uuid.New()
+	description := "Led the platform team"
+	variant := &domain.ProfileVariant{
+		ProfileID: profile.ID,
+		Name:      "Platform roles",
+		Rules: domain.ProfileVariantRules{
+			HiddenItemIDs:       []uuid.UUID{hidden},
+			ExperienceOverrides: []domain.ExperienceOverride{{ExperienceID: experienceID, Description: &description}},
+		},
+	}
+	if err := variantRepo.Create(ctx, variant); err != nil {
+		t.Fatalf("Create variant failed: %v", err)
+	}
+
+	retrieved, err := variantRepo.GetByID(ctx, variant.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if retrieved == nil || !retrieved.Hides(hidden) {
+		t.Fatalf("expected the variant to hide %s, got %+v", hidden, retrieved)
+	}
+	if o := retrieved.ExperienceOverride(experienceID); o == nil || o.Description == nil || *o.Description != description || o.Highlights != nil {
+		t.Errorf("expected the description override to round-trip, got %+v", o)
+	}
+
+	token := "share-token"
+	retrieved.ShareToken = &token
+	if err := variantRepo.Update(ctx, retrieved); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	shared, err := variantRepo.GetByShareToken(ctx, token)
+	if err != nil {
+		t.Fatalf("GetByShareToken failed: %v", err)
+	}
+	if shared == nil || shared.ID != variant.ID {
+		t.Fatalf("expected the shared variant, got %+v", shared)
+	}
+
+	variants, err := variantRepo.GetByProfileID(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileID failed: %v", err)
+	}
+	if len(variants) != 1 {
+		t.Fatalf("expected 1 variant, got %d", len(variants))
+	}
+
+	if err := variantRepo.Delete(ctx, variant.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	missing, err := variantRepo.GetByID(ctx, variant.ID)
+	if err != nil {
+		t.Fatalf("GetByID after delete failed: %v", err)
+	}
+	if missing != nil {
+		t.Error("expected nil after delete")
+	}
+}
@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// SkillRepository implements domain.SkillRepository using PostgreSQL.
+type SkillRepository struct {
+	db bun.IDB
+}
+
+// NewSkillRepository creates a new PostgreSQL skill repository.
+// Accepts bun.IDB to support both regular DB operations and transactions.
+func NewSkillRepository(db bun.IDB) *SkillRepository {
+	return &SkillRepository{db: db}
+}
+
+// SeedGlobal upserts the bundled taxonomy and links unlinked profile skills to it, in a transaction.
+func (r *SkillRepository) SeedGlobal(ctx context.Context, skills []*domain.Skill) error {
+	if len(skills) == 0 {
+		return nil
+	}
+	for _, skill := range skills {
+		if skill.Synonyms == nil {
+			skill.Synonyms = []string{}
+		}
+	}
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Parents may come after their children; the parent foreign key is checked at the end of
+		// the statement, so everything is inserted at once.
+		if _, err := tx.NewInsert().
+			Model(&skills).
+			On("CONFLICT (id) DO UPDATE").
+			Set("slug = EXCLUDED.slug").
+			Set("name = EXCLUDED.name").
+			Set("category = EXCLUDED.category").
+			Set("parent_id = EXCLUDED.parent_id").
+			Set("synonyms = EXCLUDED.synonyms").
+			Set("ambiguous = EXCLUDED.ambiguous").
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to upsert skills: %w", err)
+		}
+
+		if _, err := tx.NewRaw(`
+			UPDATE profile_skills ps
+			SET canonical_skill_id = sk.id
+			FROM skills sk
+			WHERE ps.canonical_skill_id IS NULL
+			  AND sk.profile_id IS NULL
+			  AND (LOWER(sk.name) = ps.normalized_name
+			       OR EXISTS (SELECT 1 FROM unnest(sk.synonyms) AS syn WHERE LOWER(syn) = ps.normalized_name))
+		`).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to link profile skills: %w", err)
+		}
+		return nil
+	})
+}
+
+// Create persists a new skill.
+func (r *SkillRepository) Create(ctx context.Context, skill *domain.Skill) error {
+	if skill.Synonyms == nil {
+		skill.Synonyms = []string{}
+	}
+	_, err := r.db.NewInsert().Model(skill).Exec(ctx)
+	return err
+}
+
+// GetByID retrieves a skill by its ID.
+func (r *SkillRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Skill, error) {
+	skill := new(domain.Skill)
+	err := r.db.NewSelect().Model(skill).Where("id = ?", id).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return skill, nil
+}
+
+// GetByProfileID retrieves the extension skills of a profile, ordered by name.
+func (r *SkillRepository) GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*domain.Skill, error) {
+	var skills []*domain.Skill
+	err := r.db.NewSelect().
+		Model(&skills).
+		Where("profile_id = ?", profileID).
+		Order("name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return skills, nil
+}
+
+// Update persists changes to an existing skill.
+func (r *SkillRepository) Update(ctx context.Context, skill *domain.Skill) error {
+	if skill.Synonyms == nil {
+		skill.Synonyms = []string{}
+	}
+	skill.UpdatedAt = time.Now()
+	_, err := r.db.NewUpdate().
+		Model(skill).
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// Delete removes a skill by its ID. The foreign keys unlink profile skills and child skills.
+func (r *SkillRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewDelete().Model((*domain.Skill)(nil)).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+// Compile-time check that SkillRepository implements domain.SkillRepository.
+var _ domain.SkillRepository = (*SkillRepository)(nil)
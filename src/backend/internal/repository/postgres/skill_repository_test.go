@@ -0,0 +1,105 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func TestSkillRepository_SeedGlobalLinksProfileSkills(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	profileSkillRepo := postgres.NewProfileSkillRepository(db)
+	skillRepo := postgres.NewSkillRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "skillseed@example.com")
+
+	k8s := &domain.ProfileSkill{ProfileID: profile.ID, Name: "k8s", NormalizedName: "k8s", Category: "TECHNICAL"}
+	if err := profileSkillRepo.Create(ctx, k8s); err != nil {
+		t.Fatalf("Create profile skill failed: %v", err)
+	}
+
+	parentID := uuid.New()
+	skills := []*domain.Skill{
+		{ID: uuid.New(), Slug: "test-kubernetes", Name: "Test Kubernetes", ParentID: &parentID, Synonyms: []string{"K8s"}},
+		{ID: parentID, Slug: "test-containers", Name: "Test Containers"},
+	}
+	if err := skillRepo.SeedGlobal(ctx, skills); err != nil {
+		t.Fatalf("SeedGlobal failed: %v", err)
+	}
+	// Seeding again updates in place.
+	skills[1].Name = "Test Containerization"
+	if err := skillRepo.SeedGlobal(ctx, skills); err != nil {
+		t.Fatalf("second SeedGlobal failed: %v", err)
+	}
+
+	parent, err := skillRepo.GetByID(ctx, parentID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if parent == nil || parent.Name != "Test Containerization" {
+		t.Errorf("expected reseeded name, got %v", parent)
+	}
+
+	linked, err := profileSkillRepo.GetByID(ctx, k8s.ID)
+	if err != nil {
+		t.Fatalf("GetByID profile skill failed: %v", err)
+	}
+	if linked.CanonicalSkillID == nil || *linked.CanonicalSkillID != skills[0].ID {
+		t.Errorf("expected profile skill to be linked via synonym, got %v", linked.CanonicalSkillID)
+	}
+
+	for _, s := range skills {
+		if err := skillRepo.Delete(ctx, s.ID); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+	}
+}
+
+func TestSkillRepository_ProfileExtensions(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	skillRepo := postgres.NewSkillRepository(db)
+	ctx := context.Background()
+
+	profile := createAliasTestProfile(t, ctx, userRepo, profileRepo, "skillext@example.com")
+
+	for slug, name := range map[string]string{"quantum-widgets": "Quantum Widgets", "design-systems": "Design Systems"} {
+		skill := &domain.Skill{ProfileID: &profile.ID, Slug: slug, Name: name}
+		if err := skillRepo.Create(ctx, skill); err != nil {
+			t.Fatalf("Create skill failed: %v", err)
+		}
+	}
+
+	skills, err := skillRepo.GetByProfileID(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileID failed: %v", err)
+	}
+	if len(skills) != 2 {
+		t.Fatalf("expected 2 skills, got %d", len(skills))
+	}
+	if skills[0].Name != "Design Systems" {
+		t.Errorf("expected skills ordered by name, got %q first", skills[0].Name)
+	}
+	if skills[0].Synonyms == nil {
+		t.Error("expected synonyms to be an empty list, got nil")
+	}
+
+	dup := &domain.Skill{ProfileID: &profile.ID, Slug: "design-systems", Name: "Design Systems"}
+	if err := skillRepo.Create(ctx, dup); err == nil {
+		t.Error("expected duplicate slug within a profile to fail")
+	}
+}
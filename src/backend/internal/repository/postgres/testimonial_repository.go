@@ -83,5 +83,14 @@ func (r *TestimonialRepository) DeleteByReferenceLetterID(ctx context.Context, r
 	return err
 }
 
+// DeleteByCredentialDocumentID removes all testimonials from a credential document.
+func (r *TestimonialRepository) DeleteByCredentialDocumentID(ctx context.Context, credentialDocumentID uuid.UUID) error {
+	_, err := r.db.NewDelete().
+		Model((*domain.Testimonial)(nil)).
+		Where("credential_document_id = ?", credentialDocumentID).
+		Exec(ctx)
+	return err
+}
+
 // Compile-time check that TestimonialRepository implements domain.TestimonialRepository.
 var _ domain.TestimonialRepository = (*TestimonialRepository)(nil)
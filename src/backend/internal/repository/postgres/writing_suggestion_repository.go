@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+)
+
+// WritingSuggestionRepository implements domain.WritingSuggestionRepository using PostgreSQL.
+type WritingSuggestionRepository struct {
+	db bun.IDB
+}
+
+// NewWritingSuggestionRepository creates a new PostgreSQL writing suggestion repository.
+// Accepts bun.IDB to support both regular DB operations and transactions.
+func NewWritingSuggestionRepository(db bun.IDB) *WritingSuggestionRepository {
+	return &WritingSuggestionRepository{db: db}
+}
+
+// Create persists a new writing suggestion.
+func (r *WritingSuggestionRepository) Create(ctx context.Context, suggestion *domain.WritingSuggestion) error {
+	_, err := r.db.NewInsert().Model(suggestion).Exec(ctx)
+	return err
+}
+
+// GetByID retrieves a writing suggestion by its ID.
+func (r *WritingSuggestionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.WritingSuggestion, error) {
+	suggestion := new(domain.WritingSuggestion)
+	err := r.db.NewSelect().Model(suggestion).Where("id = ?", id).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return suggestion, nil
+}
+
+// GetByProfileID retrieves all writing suggestions for a profile, newest first.
+func (r *WritingSuggestionRepository) GetByProfileID(ctx context.Context, profileID uuid.UUID) ([]*domain.WritingSuggestion, error) {
+	var suggestions []*domain.WritingSuggestion
+	err := r.db.NewSelect().
+		Model(&suggestions).
+		Where("profile_id = ?", profileID).
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return suggestions, nil
+}
+
+// Update persists changes to an existing writing suggestion.
+func (r *WritingSuggestionRepository) Update(ctx context.Context, suggestion *domain.WritingSuggestion) error {
+	suggestion.UpdatedAt = time.Now()
+	_, err := r.db.NewUpdate().
+		Model(suggestion).
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// Compile-time check that WritingSuggestionRepository implements domain.WritingSuggestionRepository.
+var _ domain.WritingSuggestionRepository = (*WritingSuggestionRepository)(nil)
@@ -0,0 +1,94 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+func TestWritingSuggestionRepository_CreateAndUpdate(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	cleanupTestData(t, db)
+
+	userRepo := postgres.NewUserRepository(db)
+	profileRepo := postgres.NewProfileRepository(db)
+	experienceRepo := postgres.NewProfileExperienceRepository(db)
+	suggestionRepo := postgres.NewWritingSuggestionRepository(db)
+	ctx := context.Background()
+
+	user := &domain.User{
+		Email:        "writing@example.com",
+		PasswordHash: "hashed_password",
+	}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("Create user failed: %v", err)
+	}
+
+	profile := &domain.Profile{
+		UserID:   user.ID,
+		Headline: strPtr("Backend engineer"),
+	}
+	if err := profileRepo.Create(ctx, profile); err != nil {
+		t.Fatalf("Create profile failed: %v", err)
+	}
+
+	experience := &domain.ProfileExperience{
+		ProfileID: profile.ID,
+		Company:   "Acme Corp",
+		Title:     "Lead Engineer",
+		Source:    domain.ExperienceSourceManual,
+	}
+	if err := experienceRepo.Create(ctx, experience); err != nil {
+		t.Fatalf("Create experience failed: %v", err)
+	}
+
+	suggestion := &domain.WritingSuggestion{
+		ProfileID:    profile.ID,
+		ExperienceID: &experience.ID,
+		Kind:         domain.WritingSuggestionHighlights,
+		Drafts: []domain.WritingDraft{{
+			Text:      "Led the Acme Corp payments team",
+			Citations: []domain.WritingCitation{{Kind: domain.WritingFactExperience, ID: experience.ID}},
+		}},
+		Status: domain.WritingSuggestionPending,
+	}
+	if err := suggestionRepo.Create(ctx, suggestion); err != nil {
+		t.Fatalf("Create suggestion failed: %v", err)
+	}
+
+	found, err := suggestionRepo.GetByProfileID(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetByProfileID failed: %v", err)
+	}
+	if len(found) != 1 || len(found[0].Drafts) != 1 || len(found[0].Drafts[0].Citations) != 1 ||
+		found[0].Drafts[0].Citations[0].ID != experience.ID {
+		t.Fatalf("expected 1 suggestion citing the experience, got %+v", found)
+	}
+
+	decidedAt := time.Now()
+	suggestion.Status = domain.WritingSuggestionAccepted
+	suggestion.DecidedAt = &decidedAt
+	if err := suggestionRepo.Update(ctx, suggestion); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	got, err := suggestionRepo.GetByID(ctx, suggestion.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if got.Status != domain.WritingSuggestionAccepted || got.DecidedAt == nil {
+		t.Errorf("expected an accepted suggestion, got %+v", got)
+	}
+
+	gotProfile, err := profileRepo.GetByID(ctx, profile.ID)
+	if err != nil {
+		t.Fatalf("GetByID profile failed: %v", err)
+	}
+	if gotProfile.Headline == nil || *gotProfile.Headline != "Backend engineer" {
+		t.Errorf("expected the profile headline to be stored, got %v", gotProfile.Headline)
+	}
+}
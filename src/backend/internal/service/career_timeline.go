@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+// DefaultGapThresholdMonths is the shortest break between roles reported as a gap when the
+// caller does not choose one. Shorter breaks are normal between jobs.
+const DefaultGapThresholdMonths = 3
+
+// TimelineEntry is an experience or education entry on a career timeline. Exactly one of
+// Experience and Education is set.
+type TimelineEntry struct {
+	Experience *domain.ProfileExperience
+	Education  *domain.ProfileEducation
+	Dates      domain.DateRange
+}
+
+// CareerGap is a break between roles, in month indexes (see domain.MonthIndex), inclusive.
+// Previous is the role that ended before the gap and Next the one that started after it;
+// Next is nil for a gap running until now.
+type CareerGap struct {
+	StartMonth      int
+	EndMonth        int
+	Previous        *domain.ProfileExperience
+	Next            *domain.ProfileExperience
+	DuringEducation bool // the gap lies entirely within education entries
+}
+
+// Months returns the length of the gap.
+func (g CareerGap) Months() int {
+	return g.EndMonth - g.StartMonth + 1
+}
+
+// RoleOverlap is a span, in inclusive month indexes, during which two roles both ran.
+type RoleOverlap struct {
+	First      *domain.ProfileExperience
+	Second     *domain.ProfileExperience
+	StartMonth int
+	EndMonth   int
+}
+
+// Months returns the length of the overlap.
+func (o RoleOverlap) Months() int {
+	return o.EndMonth - o.StartMonth + 1
+}
+
+// CareerTimeline is the merged view of a profile's experience and education with the
+// figures recruiters ask about.
+type CareerTimeline struct {
+	// Entries holds experience and education most recent first, undated entries last.
+	Entries  []TimelineEntry
+	Gaps     []CareerGap
+	Overlaps []RoleOverlap
+	// TotalExperienceMonths counts the months covered by dated roles, overlaps once.
+	TotalExperienceMonths int
+	// AverageTenureMonths is the mean length of the dated roles.
+	AverageTenureMonths float64
+	// CurrentRole is the most recently started ongoing role, nil if there is none.
+	CurrentRole       *domain.ProfileExperience
+	CurrentRoleMonths int
+}
+
+// LoadCareerTimeline builds the career timeline of a profile.
+func LoadCareerTimeline(ctx context.Context, expRepo domain.ProfileExperienceRepository, eduRepo domain.ProfileEducationRepository, profileID uuid.UUID, gapThresholdMonths int, now time.Time) (*CareerTimeline, error) {
+	experiences, err := expRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experiences: %w", err)
+	}
+	educations, err := eduRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get education: %w", err)
+	}
+	return BuildCareerTimeline(experiences, educations, gapThresholdMonths, now), nil
+}
+
+// BuildCareerTimeline merges experience and education into a timeline and finds the gaps
+// of at least gapThresholdMonths between roles, including one since the last role if
+// none is ongoing, and the roles that overlap. Roles meeting within the same month, as
+// when changing jobs, are not reported as overlapping. Entries without usable dates are
+// listed but not analyzed.
+func BuildCareerTimeline(experiences []*domain.ProfileExperience, educations []*domain.ProfileEducation, gapThresholdMonths int, now time.Time) *CareerTimeline {
+	if gapThresholdMonths < 1 {
+		gapThresholdMonths = 1
+	}
+	timeline := &CareerTimeline{Gaps: []CareerGap{}, Overlaps: []RoleOverlap{}}
+
+	entries := make([]TimelineEntry, 0, len(experiences)+len(educations))
+	for _, exp := range experiences {
+		entries = append(entries, TimelineEntry{Experience: exp, Dates: exp.Dates()})
+	}
+	for _, edu := range educations {
+		entries = append(entries, TimelineEntry{Education: edu, Dates: edu.Dates()})
+	}
+	SortByRecency(entries, func(e TimelineEntry) domain.DateRange { return e.Dates }, now)
+	timeline.Entries = entries
+
+	var roles []datedExperience
+	var studies [][2]int
+	for _, entry := range entries {
+		first, last, ok := entry.Dates.Months(now)
+		if !ok || last < first {
+			continue
+		}
+		if entry.Experience != nil {
+			ongoing := entry.Dates.Ongoing || entry.Dates.End.IsPresent()
+			role := datedExperience{exp: entry.Experience, first: first, last: last, ongoing: ongoing}
+			role.starts = [2]int{first, first}
+			if startLast, ok := entry.Dates.Start.LastMonth(); ok {
+				role.starts[1] = startLast
+			}
+			role.ends = [2]int{last, last}
+			if endFirst, ok := entry.Dates.End.FirstMonth(); ok && !ongoing {
+				role.ends[0] = endFirst
+			}
+			roles = append(roles, role)
+		} else {
+			studies = append(studies, [2]int{first, last})
+		}
+	}
+	if len(roles) == 0 {
+		return timeline
+	}
+	sort.SliceStable(roles, func(i, j int) bool {
+		if roles[i].first != roles[j].first {
+			return roles[i].first < roles[j].first
+		}
+		return roles[i].last < roles[j].last
+	})
+
+	current := domain.MonthIndex(now.Year(), int(now.Month()))
+	intervals := make([][2]int, len(roles))
+	tenure := 0
+	for i, role := range roles {
+		intervals[i] = [2]int{role.first, role.last}
+		tenure += role.last - role.first + 1
+		if role.ongoing {
+			// Roles are sorted by start, so the last ongoing one started most recently.
+			timeline.CurrentRole = role.exp
+			timeline.CurrentRoleMonths = role.last - role.first + 1
+		}
+	}
+	timeline.TotalExperienceMonths = coveredMonths(intervals)
+	timeline.AverageTenureMonths = math.Round(float64(tenure)/float64(len(roles))*10) / 10
+	timeline.Gaps = findGaps(roles, studies, gapThresholdMonths, current)
+	timeline.Overlaps = findOverlaps(roles)
+	return timeline
+}
+
+// datedExperience is a role with its first and last month, and the months its start and
+// end dates span: all of 2019 for a bare "2019", a single month for "03/2019".
+type datedExperience struct {
+	exp          *domain.ProfileExperience
+	first, last  int
+	starts, ends [2]int
+	ongoing      bool
+}
+
+// findGaps reports each break of at least threshold months during which no role ran,
+// up to the current month. roles must be sorted by start.
+func findGaps(roles []datedExperience, studies [][2]int, threshold, current int) []CareerGap {
+	gaps := []CareerGap{}
+	latest := roles[0]
+	for _, role := range roles[1:] {
+		if role.first-latest.last-1 >= threshold {
+			gaps = append(gaps, newCareerGap(latest.last+1, role.first-1, latest.exp, role.exp, studies))
+		}
+		if role.last > latest.last {
+			latest = role
+		}
+	}
+	if current-latest.last >= threshold {
+		gaps = append(gaps, newCareerGap(latest.last+1, current, latest.exp, nil, studies))
+	}
+	return gaps
+}
+
+func newCareerGap(start, end int, previous, next *domain.ProfileExperience, studies [][2]int) CareerGap {
+	gap := CareerGap{StartMonth: start, EndMonth: end, Previous: previous, Next: next}
+	gap.DuringEducation = coveredMonths(clipIntervals(studies, start, end)) == gap.Months()
+	return gap
+}
+
+// clipIntervals returns the parts of the intervals that fall between start and end.
+func clipIntervals(intervals [][2]int, start, end int) [][2]int {
+	var clipped [][2]int
+	for _, iv := range intervals {
+		first, last := max(iv[0], start), min(iv[1], end)
+		if first <= last {
+			clipped = append(clipped, [2]int{first, last})
+		}
+	}
+	return clipped
+}
+
+// findOverlaps reports every pair of roles that ran together for more than a month.
+// The end of the first role and the start of the second are compared at the coarser of
+// their precisions: roles that only share the month, season or year in which one ends
+// and the other starts, as with "2015–2018" followed by "2018–2020", merely meet there.
+// roles must be sorted by start.
+func findOverlaps(roles []datedExperience) []RoleOverlap {
+	overlaps := []RoleOverlap{}
+	for i, a := range roles {
+		for _, b := range roles[i+1:] {
+			if b.first > a.last {
+				break
+			}
+			overlap := RoleOverlap{First: a.exp, Second: b.exp, StartMonth: b.first, EndMonth: min(a.last, b.last)}
+			meeting := a.ends
+			if b.starts[1]-b.starts[0] > meeting[1]-meeting[0] {
+				meeting = b.starts
+			}
+			if overlap.Months() <= 1 || (overlap.StartMonth >= meeting[0] && overlap.EndMonth <= meeting[1]) {
+				continue
+			}
+			overlaps = append(overlaps, overlap)
+		}
+	}
+	return overlaps
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+func newDatedExperience(t *testing.T, title, start, end string) *domain.ProfileExperience {
+	t.Helper()
+	exp := &domain.ProfileExperience{ID: uuid.New(), Title: title}
+	if start != "" {
+		exp.StartDate = stringPtr(start)
+	}
+	if end != "" {
+		exp.EndDate = stringPtr(end)
+	}
+	if err := SetExperienceDates(exp); err != nil {
+		t.Fatalf("invalid dates for %s: %v", title, err)
+	}
+	return exp
+}
+
+func TestBuildCareerTimeline(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	first := newDatedExperience(t, "Junior", "2012-01", "2014-12")     // 36 months
+	second := newDatedExperience(t, "Engineer", "2015-01", "2018-06")  // 42 months, job change
+	side := newDatedExperience(t, "Consultant", "2018-01", "2018-12")  // 12 months, 6 overlapping
+	current := newDatedExperience(t, "Lead", "Spring 2020", "Present") // 2020-03..2026-10, 80 months
+	undated := newDatedExperience(t, "Freelancer", "", "")
+	master := &domain.ProfileEducation{ID: uuid.New(), Institution: "TU", StartDate: stringPtr("2019"), EndDate: stringPtr("2020")}
+	if err := SetEducationDates(master); err != nil {
+		t.Fatalf("invalid education dates: %v", err)
+	}
+
+	timeline := BuildCareerTimeline([]*domain.ProfileExperience{first, undated, current, side, second}, []*domain.ProfileEducation{master}, DefaultGapThresholdMonths, now)
+
+	var order []string
+	for _, e := range timeline.Entries {
+		if e.Experience != nil {
+			order = append(order, e.Experience.Title)
+		} else {
+			order = append(order, e.Education.Institution)
+		}
+	}
+	want := []string{"Lead", "TU", "Consultant", "Engineer", "Junior", "Freelancer"}
+	if len(order) != len(want) {
+		t.Fatalf("expected entries %v, got %v", want, order)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("expected entries %v, got %v", want, order)
+		}
+	}
+
+	// The January 2019 to February 2020 break is a gap, covered by the master's degree.
+	if len(timeline.Gaps) != 1 {
+		t.Fatalf("expected 1 gap, got %d", len(timeline.Gaps))
+	}
+	gap := timeline.Gaps[0]
+	if gap.StartMonth != domain.MonthIndex(2019, 1) || gap.EndMonth != domain.MonthIndex(2020, 2) || gap.Months() != 14 {
+		t.Errorf("unexpected gap %d..%d (%d months)", gap.StartMonth, gap.EndMonth, gap.Months())
+	}
+	if gap.Previous != side || gap.Next != current {
+		t.Errorf("expected the gap between Consultant and Lead, got %v and %v", gap.Previous, gap.Next)
+	}
+	if !gap.DuringEducation {
+		t.Error("expected the gap to fall within education")
+	}
+
+	if len(timeline.Overlaps) != 1 {
+		t.Fatalf("expected 1 overlap, got %d", len(timeline.Overlaps))
+	}
+	overlap := timeline.Overlaps[0]
+	if overlap.First != second || overlap.Second != side || overlap.Months() != 6 {
+		t.Errorf("unexpected overlap %s/%s of %d months", overlap.First.Title, overlap.Second.Title, overlap.Months())
+	}
+
+	// 2012-01..2018-12 is 84 months, plus 80 in the current role
+	if timeline.TotalExperienceMonths != 164 {
+		t.Errorf("expected 164 months of experience, got %d", timeline.TotalExperienceMonths)
+	}
+	// (36 + 42 + 12 + 80) / 4
+	if timeline.AverageTenureMonths != 42.5 {
+		t.Errorf("expected an average tenure of 42.5 months, got %v", timeline.AverageTenureMonths)
+	}
+	if timeline.CurrentRole != current || timeline.CurrentRoleMonths != 80 {
+		t.Errorf("expected 80 months in the current role, got %v months", timeline.CurrentRoleMonths)
+	}
+}
+
+func TestBuildCareerTimelineGapThreshold(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	experiences := []*domain.ProfileExperience{
+		newDatedExperience(t, "A", "2015", "2016"),
+		newDatedExperience(t, "B", "03/2017", "12/2019"), // two months after A
+		newDatedExperience(t, "C", "2020-01", "2026-05"), // ended five months ago
+	}
+
+	timeline := BuildCareerTimeline(experiences, nil, DefaultGapThresholdMonths, now)
+	if len(timeline.Gaps) != 1 {
+		t.Fatalf("expected only the gap since the last role, got %d gaps", len(timeline.Gaps))
+	}
+	if gap := timeline.Gaps[0]; gap.Next != nil || gap.Months() != 5 || gap.DuringEducation {
+		t.Errorf("unexpected trailing gap: next %v, %d months", gap.Next, gap.Months())
+	}
+	if timeline.CurrentRole != nil || timeline.CurrentRoleMonths != 0 {
+		t.Errorf("expected no current role, got %v", timeline.CurrentRole)
+	}
+
+	timeline = BuildCareerTimeline(experiences, nil, 1, now)
+	if len(timeline.Gaps) != 2 || timeline.Gaps[0].Months() != 2 {
+		t.Errorf("expected the two-month gap with a threshold of one month, got %d gaps", len(timeline.Gaps))
+	}
+}
+
+func TestBuildCareerTimelineOverlapPrecision(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name                   string
+		firstStart, firstEnd   string
+		secondStart, secondEnd string
+		wantMonths             int
+	}{
+		{"consecutive years", "2015", "2018", "2018", "2020", 0},
+		{"year end and month start", "2015", "2018", "06/2018", "2020", 0},
+		{"month end and year start", "2015", "06/2018", "2018", "2020", 0},
+		{"consecutive seasons", "2015", "Spring 2018", "Spring 2018", "2020", 0},
+		{"overlapping years", "2015", "2018", "2017", "2020", 24},
+		{"overlapping months", "01/2015", "06/2018", "01/2018", "2020", 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			experiences := []*domain.ProfileExperience{
+				newDatedExperience(t, "First", tt.firstStart, tt.firstEnd),
+				newDatedExperience(t, "Second", tt.secondStart, tt.secondEnd),
+			}
+			timeline := BuildCareerTimeline(experiences, nil, DefaultGapThresholdMonths, now)
+			if tt.wantMonths == 0 {
+				if len(timeline.Overlaps) != 0 {
+					t.Errorf("expected no overlap, got %d months", timeline.Overlaps[0].Months())
+				}
+				return
+			}
+			if len(timeline.Overlaps) != 1 || timeline.Overlaps[0].Months() != tt.wantMonths {
+				t.Errorf("expected one overlap of %d months, got %+v", tt.wantMonths, timeline.Overlaps)
+			}
+		})
+	}
+}
+
+func TestBuildCareerTimelineWithoutDates(t *testing.T) {
+	timeline := BuildCareerTimeline([]*domain.ProfileExperience{{ID: uuid.New(), Title: "Somewhere"}}, nil, DefaultGapThresholdMonths, time.Now())
+	if len(timeline.Entries) != 1 || len(timeline.Gaps) != 0 || len(timeline.Overlaps) != 0 {
+		t.Errorf("expected one unanalyzed entry, got %+v", timeline)
+	}
+	if timeline.TotalExperienceMonths != 0 || timeline.AverageTenureMonths != 0 {
+		t.Errorf("expected no experience figures, got %+v", timeline)
+	}
+}
+
+func TestLoadCareerTimeline(t *testing.T) {
+	expRepo := newMockProfileExperienceRepository()
+	eduRepo := newMockProfileEducationRepository()
+	ctx := context.Background()
+	profileID := uuid.New()
+
+	exp := newDatedExperience(t, "Engineer", "2020-01", "2020-12")
+	exp.ProfileID = profileID
+	if err := expRepo.Create(ctx, exp); err != nil {
+		t.Fatalf("failed to create experience: %v", err)
+	}
+
+	timeline, err := LoadCareerTimeline(ctx, expRepo, eduRepo, profileID, DefaultGapThresholdMonths, time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("LoadCareerTimeline failed: %v", err)
+	}
+	if timeline.TotalExperienceMonths != 12 || len(timeline.Gaps) != 0 {
+		t.Errorf("expected 12 months and no gap yet, got %d months and %d gaps", timeline.TotalExperienceMonths, len(timeline.Gaps))
+	}
+}
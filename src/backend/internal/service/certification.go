@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+	"backend/internal/repository/postgres"
+)
+
+// ErrExpiresBeforeIssued is returned when a certification expires before it was issued.
+var ErrExpiresBeforeIssued = errors.New("expiry date is before issue date")
+
+// SetCertificationExpiry parses the certification's ExpiryDate into Expiry. An expiry that
+// cannot be parsed is left unknown. The parsed date is always set; the error reports
+// ErrExpiresBeforeIssued so that callers validating user input can reject it.
+func SetCertificationExpiry(c *domain.ProfileCertification) error {
+	c.Expiry = parseDate(c.ExpiryDate)
+	if (domain.DateRange{Start: parseDate(c.IssueDate), End: c.Expiry}).EndsBeforeStart() {
+		return ErrExpiresBeforeIssued
+	}
+	return nil
+}
+
+// CertificationExpiresWithin reports whether the certification expires before now plus
+// window, which includes certifications that have already expired. A certification stays
+// valid through the last month its expiry date covers, so "2026" lasts until December.
+func CertificationExpiresWithin(c *domain.ProfileCertification, now time.Time, window time.Duration) bool {
+	last, ok := c.Expiry.LastMonth()
+	if !ok {
+		return false
+	}
+	deadline := now.Add(window)
+	return last <= domain.MonthIndex(deadline.Year(), int(deadline.Month()))
+}
+
+// CertificationExpired reports whether the certification's last valid month is over.
+func CertificationExpired(c *domain.ProfileCertification, now time.Time) bool {
+	last, ok := c.Expiry.LastMonth()
+	return ok && last < domain.MonthIndex(now.Year(), int(now.Month()))
+}
+
+// CertificationExpiryResult counts the changes made by an expiry check.
+type CertificationExpiryResult struct {
+	Flagged int
+	Cleared int
+}
+
+// CertificationExpiryChecker flags the certifications that are about to expire.
+type CertificationExpiryChecker struct {
+	repo domain.ProfileCertificationRepository
+}
+
+// NewCertificationExpiryChecker creates a new CertificationExpiryChecker.
+func NewCertificationExpiryChecker(repo domain.ProfileCertificationRepository) *CertificationExpiryChecker {
+	return &CertificationExpiryChecker{repo: repo}
+}
+
+// FlagExpiring sets ExpiringFlaggedAt on every certification that expires within window of
+// now and clears it on those that no longer do, for example after being renewed. Expiry
+// dates are parsed again on the way, so rows stored before they were parsed are repaired.
+// A certification stays flagged since the check that first found it expiring.
+func (c *CertificationExpiryChecker) FlagExpiring(ctx context.Context, now time.Time, window time.Duration) (*CertificationExpiryResult, error) {
+	certifications, err := c.repo.GetWithExpiryDate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certifications: %w", err)
+	}
+
+	result := &CertificationExpiryResult{}
+	for _, cert := range certifications {
+		before := cert.Expiry
+		_ = SetCertificationExpiry(cert)
+		changed := cert.Expiry != before
+
+		expiring := CertificationExpiresWithin(cert, now, window)
+		switch {
+		case expiring && cert.ExpiringFlaggedAt == nil:
+			flaggedAt := now
+			cert.ExpiringFlaggedAt = &flaggedAt
+			result.Flagged++
+			changed = true
+		case !expiring && cert.ExpiringFlaggedAt != nil:
+			cert.ExpiringFlaggedAt = nil
+			result.Cleared++
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+		if err := c.repo.Update(ctx, cert); err != nil {
+			return result, fmt.Errorf("failed to update certification %s: %w", cert.ID, err)
+		}
+	}
+	return result, nil
+}
+
+// MaterializeCertificateData adds the certification an uploaded certificate or license
+// describes to the profile, keeping the document and its file as the source. When the
+// profile already lists the certification, by the same document, credential ID or name,
+// that entry is updated with what the document states instead.
+// Idempotent: re-importing the same document updates the entry it created.
+func (s *MaterializationService) MaterializeCertificateData(
+	ctx context.Context,
+	documentID uuid.UUID,
+	fileID uuid.UUID,
+	userID uuid.UUID,
+	data *domain.ExtractedCertificateData,
+) (*MaterializationResult, error) {
+	profile, err := s.profileRepo.GetOrCreateByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get or create profile: %w", err)
+	}
+
+	result := &MaterializationResult{}
+
+	if s.db == nil {
+		count, matErr := materializeCertificateWithRepo(ctx, s.sections.certifications, documentID, fileID, profile.ID, data)
+		result.Certifications = count
+		return result, matErr
+	}
+
+	txErr := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		count, matErr := materializeCertificateWithRepo(ctx, postgres.NewProfileCertificationRepository(tx), documentID, fileID, profile.ID, data)
+		result.Certifications = count
+		return matErr
+	})
+	if txErr != nil {
+		return result, txErr
+	}
+
+	return result, nil
+}
+
+// materializeCertificateWithRepo accepts a repository parameter for transaction support.
+func materializeCertificateWithRepo(
+	ctx context.Context,
+	repo domain.ProfileCertificationRepository,
+	documentID uuid.UUID,
+	fileID uuid.UUID,
+	profileID uuid.UUID,
+	data *domain.ExtractedCertificateData,
+) (int, error) {
+	name := strings.TrimSpace(data.Name)
+	if name == "" {
+		return 0, nil
+	}
+
+	existing, err := repo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get existing certifications: %w", err)
+	}
+
+	var cert *domain.ProfileCertification
+	for _, c := range existing {
+		if certificateMatches(c, documentID, name, data.CredentialID) {
+			cert = c
+			break
+		}
+	}
+	isNew := cert == nil
+	if isNew {
+		displayOrder, orderErr := repo.GetNextDisplayOrder(ctx, profileID)
+		if orderErr != nil {
+			return 0, fmt.Errorf("failed to get next certification display order: %w", orderErr)
+		}
+		cert = &domain.ProfileCertification{
+			ID:           uuid.New(),
+			ProfileID:    profileID,
+			Name:         name,
+			DisplayOrder: displayOrder,
+			Source:       domain.ExperienceSourceCredentialDocument,
+		}
+	}
+
+	// The document is the authoritative source for everything it states
+	if issuer := strings.TrimSpace(data.Issuer); issuer != "" {
+		cert.Issuer = &issuer
+	}
+	for _, field := range []struct {
+		value  *string
+		target **string
+	}{
+		{data.CredentialID, &cert.CredentialID},
+		{data.VerificationURL, &cert.VerificationURL},
+		{data.IssueDate, &cert.IssueDate},
+		{data.ExpiryDate, &cert.ExpiryDate},
+	} {
+		if textLength(field.value) > 0 {
+			v := strings.TrimSpace(*field.value)
+			*field.target = &v
+		}
+	}
+	if textLength(data.ExpiryDate) > 0 {
+		// A new expiry date is checked afresh by the next expiry check
+		cert.ExpiringFlaggedAt = nil
+	}
+	_ = SetCertificationExpiry(cert)
+	docID, fID := documentID, fileID
+	cert.SourceCredentialDocumentID = &docID
+	cert.SourceFileID = &fID
+
+	if isNew {
+		if err := repo.Create(ctx, cert); err != nil {
+			return 0, fmt.Errorf("failed to create certification %q: %w", name, err)
+		}
+		return 1, nil
+	}
+	if err := repo.Update(ctx, cert); err != nil {
+		return 0, fmt.Errorf("failed to update certification %q: %w", cert.Name, err)
+	}
+	return 1, nil
+}
+
+// certificateMatches reports whether a profile certification is the one a certificate
+// document describes: imported from that document, with the same credential ID, or with the
+// same name ignoring case and accents.
+func certificateMatches(c *domain.ProfileCertification, documentID uuid.UUID, name string, credentialID *string) bool {
+	if c.SourceCredentialDocumentID != nil && *c.SourceCredentialDocumentID == documentID {
+		return true
+	}
+	if textLength(c.CredentialID) > 0 && textLength(credentialID) > 0 &&
+		strings.EqualFold(strings.TrimSpace(*c.CredentialID), strings.TrimSpace(*credentialID)) {
+		return true
+	}
+	return normalize.FoldText(c.Name) == normalize.FoldText(name)
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+func TestCertificationExpiryChecker_FlagExpiring(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockProfileCertificationRepository()
+	profileID := uuid.New()
+	now := time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC)
+	window := 90 * 24 * time.Hour
+
+	add := func(name string, expiry *string, flagged bool) *domain.ProfileCertification {
+		cert := &domain.ProfileCertification{ID: uuid.New(), ProfileID: profileID, Name: name, ExpiryDate: expiry, DisplayOrder: len(repo.items)}
+		if flagged {
+			flaggedAt := now.Add(-48 * time.Hour)
+			cert.ExpiringFlaggedAt = &flaggedAt
+		}
+		_ = repo.Create(ctx, cert)
+		return cert
+	}
+	soon := add("CISSP", stringPtr("May 2026"), false)
+	expired := add("BLS", stringPtr("2025"), false)
+	renewed := add("ACLS", stringPtr("June 2028"), true)
+	later := add("PALS", stringPtr("2027-01"), false)
+	alreadyFlagged := add("CKA", stringPtr("04/2026"), true)
+	undated := add("Scrum Master", nil, false)
+
+	result, err := NewCertificationExpiryChecker(repo).FlagExpiring(ctx, now, window)
+	if err != nil {
+		t.Fatalf("FlagExpiring returned error: %v", err)
+	}
+	if result.Flagged != 2 || result.Cleared != 1 {
+		t.Errorf("expected 2 flagged and 1 cleared, got %+v", result)
+	}
+
+	for _, cert := range []*domain.ProfileCertification{soon, expired} {
+		if cert.ExpiringFlaggedAt == nil || !cert.ExpiringFlaggedAt.Equal(now) {
+			t.Errorf("%s: expected to be flagged at %v, got %v", cert.Name, now, cert.ExpiringFlaggedAt)
+		}
+	}
+	if alreadyFlagged.ExpiringFlaggedAt == nil || alreadyFlagged.ExpiringFlaggedAt.Equal(now) {
+		t.Errorf("CKA: expected to keep its earlier flag, got %v", alreadyFlagged.ExpiringFlaggedAt)
+	}
+	for _, cert := range []*domain.ProfileCertification{renewed, later, undated} {
+		if cert.ExpiringFlaggedAt != nil {
+			t.Errorf("%s: expected not to be flagged", cert.Name)
+		}
+	}
+	if soon.Expiry.Year != 2026 || soon.Expiry.Month != 5 {
+		t.Errorf("expected the expiry date to be parsed, got %+v", soon.Expiry)
+	}
+	if !CertificationExpired(expired, now) || CertificationExpired(soon, now) {
+		t.Error("expected only the 2025 certification to have expired")
+	}
+}
+
+func TestSetCertificationExpiry(t *testing.T) {
+	cert := &domain.ProfileCertification{IssueDate: stringPtr("March 2024"), ExpiryDate: stringPtr("2023")}
+	if err := SetCertificationExpiry(cert); err != ErrExpiresBeforeIssued {
+		t.Errorf("expected ErrExpiresBeforeIssued, got %v", err)
+	}
+	cert.ExpiryDate = stringPtr("March 2027")
+	if err := SetCertificationExpiry(cert); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestMaterializeCertificateData(t *testing.T) {
+	ctx := context.Background()
+	profileRepo := newMockProfileRepository()
+	certRepo := newMockProfileCertificationRepository()
+	svc := NewMaterializationService(nil, profileRepo, newMockProfileExperienceRepository(), newMockProfileEducationRepository(), newMockProfileSkillRepository(), newMockAuthorRepository(), newMockTestimonialRepository(), newMockSkillValidationRepository(), newMockExpValidationRepository(), newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), certRepo, newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
+
+	userID := uuid.New()
+	profile, _ := profileRepo.GetOrCreateByUserID(ctx, userID)
+	resumeID := uuid.New()
+	fromResume := &domain.ProfileCertification{
+		ID: uuid.New(), ProfileID: profile.ID, Name: "Certified Kubernetes Administrator",
+		Source: domain.ExperienceSourceResumeExtracted, SourceResumeID: &resumeID,
+	}
+	_ = certRepo.Create(ctx, fromResume)
+
+	t.Run("updates the matching resume entry", func(t *testing.T) {
+		docID, fileID := uuid.New(), uuid.New()
+		result, err := svc.MaterializeCertificateData(ctx, docID, fileID, userID, &domain.ExtractedCertificateData{
+			Name:         "certified kubernetes administrator",
+			Issuer:       "CNCF",
+			CredentialID: stringPtr("LF-123"),
+			ExpiryDate:   stringPtr("2027-02"),
+		})
+		if err != nil {
+			t.Fatalf("MaterializeCertificateData returned error: %v", err)
+		}
+		if result.Certifications != 1 || len(certRepo.items) != 1 {
+			t.Fatalf("expected the existing certification to be updated, got %d certifications", len(certRepo.items))
+		}
+		if fromResume.SourceFileID == nil || *fromResume.SourceFileID != fileID {
+			t.Errorf("expected source file %s, got %v", fileID, fromResume.SourceFileID)
+		}
+		if fromResume.Issuer == nil || *fromResume.Issuer != "CNCF" || fromResume.Expiry.Year != 2027 {
+			t.Errorf("expected issuer and parsed expiry from the document, got %+v", fromResume)
+		}
+	})
+
+	t.Run("creates a new certification and is idempotent", func(t *testing.T) {
+		docID, fileID := uuid.New(), uuid.New()
+		data := &domain.ExtractedCertificateData{Name: "Registered Nurse", Issuer: "State Board of Nursing", ExpiryDate: stringPtr("2026")}
+		for i := 0; i < 2; i++ {
+			if _, err := svc.MaterializeCertificateData(ctx, docID, fileID, userID, data); err != nil {
+				t.Fatalf("MaterializeCertificateData returned error: %v", err)
+			}
+		}
+		certs, _ := certRepo.GetByProfileID(ctx, profile.ID)
+		if len(certs) != 2 {
+			t.Fatalf("expected 2 certifications, got %d", len(certs))
+		}
+		created := certs[1]
+		if created.Source != domain.ExperienceSourceCredentialDocument || created.SourceCredentialDocumentID == nil || *created.SourceCredentialDocumentID != docID {
+			t.Errorf("unexpected source of created certification: %+v", created)
+		}
+	})
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+)
+
+// LoadCompanyMatcher builds a company matcher that applies the profile's company aliases.
+func LoadCompanyMatcher(ctx context.Context, aliasRepo domain.CompanyAliasRepository, profileID uuid.UUID) (*normalize.CompanyMatcher, error) {
+	aliases, err := aliasRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company aliases: %w", err)
+	}
+	pairs := make(map[string]string, len(aliases))
+	for _, a := range aliases {
+		pairs[a.Alias] = a.CanonicalName
+	}
+	return normalize.NewCompanyMatcher(pairs), nil
+}
+
+// LinkCompany returns the ID of the profile's company called name, creating the company if
+// the profile has none by that name. A company matches when the matcher matches its name,
+// so "Acme Corp." links to a company stored as "ACME Corporation", and a matcher loaded with
+// the profile's aliases also links the company's other names. Names that only match with a
+// typo in every word, like "Stripe" and "Strive", get separate companies; duplicate
+// suggestions point them out instead. Returns nil for a blank name.
+func LinkCompany(ctx context.Context, companyRepo domain.CompanyRepository, matcher *normalize.CompanyMatcher, profileID uuid.UUID, name string) (*uuid.UUID, error) {
+	name = strings.TrimSpace(name)
+	if normalize.CompanyKey(name) == "" {
+		return nil, nil
+	}
+
+	companies, err := companyRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get companies: %w", err)
+	}
+	for _, company := range companies {
+		if matcher.Match(company.Name, name) {
+			return &company.ID, nil
+		}
+	}
+
+	company := &domain.Company{
+		ID:        uuid.New(),
+		ProfileID: profileID,
+		Name:      name,
+	}
+	if err := companyRepo.Create(ctx, company); err != nil {
+		return nil, fmt.Errorf("failed to create company %q: %w", name, err)
+	}
+	return &company.ID, nil
+}
+
+// CompanyAliasNames returns the aliases among the profile's company aliases that name the
+// company, i.e. those whose canonical name is the company's name, ordered by alias.
+func CompanyAliasNames(aliases []*domain.CompanyAlias, company *domain.Company) []string {
+	key := normalize.CompanyKey(company.Name)
+	names := []string{}
+	for _, a := range aliases {
+		if normalize.CompanyKey(a.CanonicalName) == key {
+			names = append(names, a.Alias)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SyncCompanyAliases keeps the profile's company aliases in step with an edited company.
+// Aliases of its old name are re-pointed to its current name and, when names is non-nil,
+// the company's aliases are replaced by names. Blank names and the company's own name are
+// skipped.
+func SyncCompanyAliases(ctx context.Context, aliasRepo domain.CompanyAliasRepository, company *domain.Company, oldName string, names []string) error {
+	existing, err := aliasRepo.GetByProfileID(ctx, company.ProfileID)
+	if err != nil {
+		return fmt.Errorf("failed to get company aliases: %w", err)
+	}
+
+	oldKey, newKey := normalize.CompanyKey(oldName), normalize.CompanyKey(company.Name)
+	byAlias := make(map[string]*domain.CompanyAlias, len(existing))
+	for _, a := range existing {
+		byAlias[strings.ToLower(a.Alias)] = a
+		key := normalize.CompanyKey(a.CanonicalName)
+		if key != oldKey && key != newKey {
+			continue
+		}
+		if names != nil {
+			if err := aliasRepo.Delete(ctx, a.ID); err != nil {
+				return fmt.Errorf("failed to delete company alias: %w", err)
+			}
+			delete(byAlias, strings.ToLower(a.Alias))
+			continue
+		}
+		if a.CanonicalName != company.Name {
+			a.CanonicalName = company.Name
+			if err := aliasRepo.Update(ctx, a); err != nil {
+				return fmt.Errorf("failed to update company alias: %w", err)
+			}
+		}
+	}
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" || strings.EqualFold(name, company.Name) {
+			continue
+		}
+		if a, ok := byAlias[strings.ToLower(name)]; ok {
+			// The name already aliases another company; it now names this one
+			a.CanonicalName = company.Name
+			if err := aliasRepo.Update(ctx, a); err != nil {
+				return fmt.Errorf("failed to update company alias: %w", err)
+			}
+			continue
+		}
+		a := &domain.CompanyAlias{ID: uuid.New(), ProfileID: company.ProfileID, Alias: name, CanonicalName: company.Name}
+		if err := aliasRepo.Create(ctx, a); err != nil {
+			return fmt.Errorf("failed to create company alias: %w", err)
+		}
+		byAlias[strings.ToLower(name)] = a
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestLinkCompanyKeepsNearMatchesApart(t *testing.T) {
+	ctx := context.Background()
+	companyRepo := newMockCompanyRepository()
+	profileID := uuid.New()
+
+	link := func(name string) uuid.UUID {
+		id, err := LinkCompany(ctx, companyRepo, nil, profileID, name)
+		if err != nil {
+			t.Fatalf("LinkCompany(%q) failed: %v", name, err)
+		}
+		return *id
+	}
+
+	for _, pair := range [][2]string{{"Notion", "Motion"}, {"Stripe", "Strive"}, {"Slack", "Stack"}, {"Canva", "Canvas"}} {
+		if link(pair[0]) == link(pair[1]) {
+			t.Errorf("expected %q and %q to get separate companies", pair[0], pair[1])
+		}
+	}
+	if link("Acme Corp.") != link("ACME Corporation") {
+		t.Error("expected legal suffix variants to link to one company")
+	}
+	if len(companyRepo.companies) != 9 {
+		t.Errorf("expected 9 companies, got %d", len(companyRepo.companies))
+	}
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+)
+
+// ConsistencyService compares what reference letters say about the candidate's roles with
+// the profile's experience and records the discrepancies, such as a letter calling the
+// candidate "Senior Engineer 2018–2020" where the resume says "Lead Engineer 2017–2021".
+type ConsistencyService struct {
+	profileExpRepo    domain.ProfileExperienceRepository
+	companyAliasRepo  domain.CompanyAliasRepository
+	inconsistencyRepo domain.ProfileInconsistencyRepository
+	now               func() time.Time
+}
+
+// NewConsistencyService creates a new ConsistencyService.
+func NewConsistencyService(
+	profileExpRepo domain.ProfileExperienceRepository,
+	companyAliasRepo domain.CompanyAliasRepository,
+	inconsistencyRepo domain.ProfileInconsistencyRepository,
+) *ConsistencyService {
+	return &ConsistencyService{
+		profileExpRepo:    profileExpRepo,
+		companyAliasRepo:  companyAliasRepo,
+		inconsistencyRepo: inconsistencyRepo,
+		now:               time.Now,
+	}
+}
+
+// CheckLetter records the discrepancies between the letter's experience mentions and the
+// profile's experience, and returns how many new ones it found. Experience discovered in
+// letters is not checked, since it has no independent source. Re-checking a letter does
+// not record a discrepancy twice, nor reopen one the user resolved or dismissed.
+func (s *ConsistencyService) CheckLetter(
+	ctx context.Context,
+	profileID uuid.UUID,
+	referenceLetterID uuid.UUID,
+	letterData *domain.ExtractedLetterData,
+) (int, error) {
+	if len(letterData.ExperienceMentions) == 0 {
+		return 0, nil
+	}
+	experiences, err := s.profileExpRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get profile experiences: %w", err)
+	}
+	matcher, err := LoadCompanyMatcher(ctx, s.companyAliasRepo, profileID)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, mention := range letterData.ExperienceMentions {
+		var candidates []*domain.ProfileExperience
+		for _, exp := range experiences {
+			if exp.Source != domain.ExperienceSourceLetterDiscovered && matcher.Match(mention.Company, exp.Company) {
+				candidates = append(candidates, exp)
+			}
+		}
+		for _, inconsistency := range findInconsistencies(mention, candidates, s.now()) {
+			inconsistency.ID = uuid.New()
+			inconsistency.ProfileID = profileID
+			inconsistency.ReferenceLetterID = referenceLetterID
+			if createErr := s.inconsistencyRepo.Create(ctx, inconsistency); createErr != nil {
+				if !strings.Contains(createErr.Error(), "duplicate") && !strings.Contains(createErr.Error(), "unique constraint") {
+					return count, fmt.Errorf("failed to create profile inconsistency: %w", createErr)
+				}
+				continue
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+// quotedTenure matches a tenure written into a quote, as in "Senior Engineer (2018–2020)"
+// or "from 2018 to present".
+var quotedTenure = regexp.MustCompile(`(?i)\b((?:19|20)\d{2})\s*(?:-|–|—|to|until|through)\s*((?:19|20)\d{2}|present|today|now)\b`)
+
+// mentionTenure returns the start and end of a role as the letter states them, taken from
+// the extracted dates or, failing those, from a year range in the quote.
+func mentionTenure(mention domain.ExtractedExperienceMention) (start, end *string) {
+	start, end = nonBlank(mention.StartDate), nonBlank(mention.EndDate)
+	if start != nil || end != nil {
+		return start, end
+	}
+	if m := quotedTenure.FindStringSubmatch(mention.Quote); m != nil {
+		return &m[1], &m[2]
+	}
+	return nil, nil
+}
+
+func nonBlank(s *string) *string {
+	if s == nil || strings.TrimSpace(*s) == "" {
+		return nil
+	}
+	return s
+}
+
+// findInconsistencies compares a letter's mention of a role with the profile's roles at the
+// same company. The mention is compared with the roles whose title agrees with it, or all of
+// them if none does, and reported against the one it contradicts least, preferring the one
+// whose dates overlap it most. The title conflicts when no role at the company agrees with
+// it; a date conflicts when the letter's and the resume's dates cannot be the same month at
+// the precision both give, so "2018" agrees with "March 2018". A letter saying the
+// candidate still holds a role that the resume says ended is not reported, since the
+// letter may predate the end.
+func findInconsistencies(mention domain.ExtractedExperienceMention, candidates []*domain.ProfileExperience, now time.Time) []*domain.ProfileInconsistency {
+	if len(candidates) == 0 {
+		return nil
+	}
+	startText, endText := mentionTenure(mention)
+	letterDates := domain.DateRange{Start: parseDate(startText), End: parseDate(endText)}
+
+	role := strings.TrimSpace(mention.Role)
+	var agreeing []*domain.ProfileExperience
+	for _, exp := range candidates {
+		if role != "" && normalize.TitlesAgree(role, exp.Title) {
+			agreeing = append(agreeing, exp)
+		}
+	}
+	titleAgrees := role == "" || len(agreeing) > 0
+	if len(agreeing) > 0 {
+		candidates = agreeing
+	}
+
+	var best *domain.ProfileExperience
+	var found []*domain.ProfileInconsistency
+	bestOverlap := 0
+	for _, exp := range candidates {
+		conflicts := dateInconsistencies(exp, letterDates, startText, endText, now)
+		overlap := overlapMonths(letterDates, exp.Dates(), now)
+		if best != nil && (len(conflicts) > len(found) || len(conflicts) == len(found) && overlap <= bestOverlap) {
+			continue
+		}
+		best, found, bestOverlap = exp, conflicts, overlap
+	}
+	if !titleAgrees {
+		found = append([]*domain.ProfileInconsistency{{
+			ProfileExperienceID: best.ID,
+			Field:               domain.InconsistencyFieldTitle,
+			ResumeValue:         best.Title,
+			LetterValue:         role,
+		}}, found...)
+	}
+
+	for _, inconsistency := range found {
+		inconsistency.Status = domain.InconsistencyStatusOpen
+		if quote := strings.TrimSpace(mention.Quote); quote != "" {
+			inconsistency.Quote = &quote
+		}
+	}
+	return found
+}
+
+// dateInconsistencies returns the conflicts between the letter's dates for a role and the
+// experience's.
+func dateInconsistencies(exp *domain.ProfileExperience, letterDates domain.DateRange, startText, endText *string, now time.Time) []*domain.ProfileInconsistency {
+	var found []*domain.ProfileInconsistency
+	resumeDates := exp.Dates()
+	if datesDisagree(letterDates.Start, resumeDates.Start) {
+		found = append(found, &domain.ProfileInconsistency{
+			ProfileExperienceID: exp.ID,
+			Field:               domain.InconsistencyFieldStartDate,
+			ResumeValue:         *exp.StartDate,
+			LetterValue:         *startText,
+		})
+	}
+	if letterDates.End.IsZero() || letterDates.End.IsPresent() {
+		return found
+	}
+	resumeEnd := resumeDates.End
+	resumeValue := ""
+	if exp.EndDate != nil {
+		resumeValue = *exp.EndDate
+	}
+	if resumeDates.Ongoing {
+		resumeEnd = domain.PartialDate{Year: now.Year(), Month: int(now.Month()), Precision: domain.DatePrecisionMonth}
+		if resumeValue == "" {
+			resumeValue = "Present"
+		}
+	}
+	if datesDisagree(letterDates.End, resumeEnd) {
+		found = append(found, &domain.ProfileInconsistency{
+			ProfileExperienceID: exp.ID,
+			Field:               domain.InconsistencyFieldEndDate,
+			ResumeValue:         resumeValue,
+			LetterValue:         *endText,
+		})
+	}
+	return found
+}
+
+// datesDisagree reports whether two known dates cannot fall in the same month. It is false
+// when either date is unknown.
+func datesDisagree(a, b domain.PartialDate) bool {
+	aFirst, ok := a.FirstMonth()
+	if !ok {
+		return false
+	}
+	bFirst, ok := b.FirstMonth()
+	if !ok {
+		return false
+	}
+	aLast, _ := a.LastMonth()
+	bLast, _ := b.LastMonth()
+	return aLast < bFirst || bLast < aFirst
+}
+
+// overlapMonths returns the number of months two ranges share, zero when either lacks dates.
+func overlapMonths(a, b domain.DateRange, now time.Time) int {
+	aFirst, aLast, ok := a.Months(now)
+	if !ok {
+		return 0
+	}
+	bFirst, bLast, ok := b.Months(now)
+	if !ok {
+		return 0
+	}
+	return max(0, min(aLast, bLast)-max(aFirst, bFirst)+1)
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+func TestFindInconsistencies(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	lead := newDatedExperience(t, "Lead Engineer", "2017", "2021")
+
+	found := findInconsistencies(domain.ExtractedExperienceMention{
+		Company: "Acme Corp",
+		Role:    "Senior Engineer",
+		Quote:   "She worked with us as a Senior Engineer 2018–2020.",
+	}, []*domain.ProfileExperience{lead}, now)
+
+	want := []struct {
+		field          domain.InconsistencyField
+		resume, letter string
+	}{
+		{domain.InconsistencyFieldTitle, "Lead Engineer", "Senior Engineer"},
+		{domain.InconsistencyFieldStartDate, "2017", "2018"},
+		{domain.InconsistencyFieldEndDate, "2021", "2020"},
+	}
+	if len(found) != len(want) {
+		t.Fatalf("expected %d inconsistencies, got %d", len(want), len(found))
+	}
+	for i, w := range want {
+		got := found[i]
+		if got.Field != w.field || got.ResumeValue != w.resume || got.LetterValue != w.letter {
+			t.Errorf("inconsistency %d = %s %q vs %q, want %s %q vs %q", i, got.Field, got.ResumeValue, got.LetterValue, w.field, w.resume, w.letter)
+		}
+		if got.ProfileExperienceID != lead.ID || got.Quote == nil {
+			t.Errorf("inconsistency %d should reference the experience and quote the letter", i)
+		}
+	}
+}
+
+func TestFindInconsistenciesAgreement(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	engineer := newDatedExperience(t, "Software Engineer", "2016-01", "2017-12")
+	senior := newDatedExperience(t, "Senior Software Engineer", "2018", "Present")
+
+	tests := []struct {
+		name    string
+		mention domain.ExtractedExperienceMention
+	}{
+		{"abbreviated title, coarser start", domain.ExtractedExperienceMention{Role: "Sr. Engineer", StartDate: stringPtr("2018"), EndDate: stringPtr("present")}},
+		{"month within the resume's year", domain.ExtractedExperienceMention{Role: "Senior Software Engineer", StartDate: stringPtr("March 2018")}},
+		{"earlier role at the company", domain.ExtractedExperienceMention{Role: "Software Developer", Quote: "from 2016 to 2017"}},
+		{"no role or tenure", domain.ExtractedExperienceMention{Quote: "She was a pleasure to work with."}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if found := findInconsistencies(tc.mention, []*domain.ProfileExperience{engineer, senior}, now); len(found) != 0 {
+				t.Errorf("expected no inconsistencies, got %s %q vs %q", found[0].Field, found[0].ResumeValue, found[0].LetterValue)
+			}
+		})
+	}
+}
+
+func TestFindInconsistenciesOngoingRole(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	current := newDatedExperience(t, "Engineer", "2019", "")
+	current.IsCurrent = true
+
+	found := findInconsistencies(domain.ExtractedExperienceMention{Role: "Engineer", StartDate: stringPtr("2019"), EndDate: stringPtr("2022")}, []*domain.ProfileExperience{current}, now)
+	if len(found) != 1 || found[0].Field != domain.InconsistencyFieldEndDate || found[0].ResumeValue != "Present" {
+		t.Fatalf("expected an end date conflict with the ongoing role, got %+v", found)
+	}
+
+	past := newDatedExperience(t, "Engineer", "2019", "2021")
+	if found := findInconsistencies(domain.ExtractedExperienceMention{Role: "Engineer", EndDate: stringPtr("present")}, []*domain.ProfileExperience{past}, now); len(found) != 0 {
+		t.Errorf("a letter written during the role should not conflict with its later end, got %+v", found)
+	}
+}
+
+func TestConsistencyServiceCheckLetter(t *testing.T) {
+	expRepo := newMockProfileExperienceRepository()
+	aliasRepo := newMockCompanyAliasRepository()
+	inconsistencyRepo := newMockProfileInconsistencyRepository()
+	svc := NewConsistencyService(expRepo, aliasRepo, inconsistencyRepo)
+	ctx := context.Background()
+	profileID := uuid.New()
+	letterID := uuid.New()
+
+	resumeRole := newDatedExperience(t, "Lead Engineer", "2017", "2021")
+	resumeRole.ProfileID = profileID
+	resumeRole.Company = "Acme Corporation"
+	resumeRole.Source = domain.ExperienceSourceResumeExtracted
+	discovered := newDatedExperience(t, "Senior Engineer", "2018", "2020")
+	discovered.ProfileID = profileID
+	discovered.Company = "Acme Corp"
+	discovered.Source = domain.ExperienceSourceLetterDiscovered
+	for _, exp := range []*domain.ProfileExperience{resumeRole, discovered} {
+		if err := expRepo.Create(ctx, exp); err != nil {
+			t.Fatalf("failed to create experience: %v", err)
+		}
+	}
+
+	letter := &domain.ExtractedLetterData{
+		ExperienceMentions: []domain.ExtractedExperienceMention{{
+			Company:   "Acme Corp.",
+			Role:      "Senior Engineer",
+			Quote:     "As a Senior Engineer at Acme, she...",
+			StartDate: stringPtr("2018"),
+			EndDate:   stringPtr("2020"),
+		}},
+	}
+
+	count, err := svc.CheckLetter(ctx, profileID, letterID, letter)
+	if err != nil {
+		t.Fatalf("CheckLetter failed: %v", err)
+	}
+	if count != 3 {
+		t.Fatalf("expected 3 inconsistencies with the resume role, got %d", count)
+	}
+	recorded, _ := inconsistencyRepo.GetByProfileID(ctx, profileID)
+	for _, i := range recorded {
+		if i.ProfileExperienceID != resumeRole.ID || i.ReferenceLetterID != letterID || i.Status != domain.InconsistencyStatusOpen {
+			t.Errorf("unexpected inconsistency %+v", i)
+		}
+	}
+
+	count, err = svc.CheckLetter(ctx, profileID, letterID, letter)
+	if err != nil {
+		t.Fatalf("second CheckLetter failed: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("expected re-checking the letter to record nothing new, got %d", count)
+	}
+}
@@ -0,0 +1,516 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+)
+
+// coverLetterConventions are words a letter may capitalize mid-sentence without the
+// profile or the job description stating them.
+const coverLetterConventions = "I I'm I've I'd I'll Dear Hiring Manager Team Sincerely Regards Best Kind Thank You Yours"
+
+var (
+	quotedPassage     = regexp.MustCompile(`“([^”]+)”|"([^"]+)"`)
+	quoteSpace        = regexp.MustCompile(`\s+`)
+	sentenceEnd       = regexp.MustCompile(`[.!?]["”]?(\s|$)`)
+	quoteEndsSentence = regexp.MustCompile(`[.!?][\s…]*$`)
+)
+
+// CoverLetterService writes cover letters from a profile for job descriptions. The job
+// description is saved and matched like any other, the letter is drafted with a
+// ProfileWriter and every passage it quotes is checked word for word against the stored
+// testimonials and the job description. Letters are kept in versions, so an edit never overwrites the draft.
+type CoverLetterService struct {
+	writer           domain.ProfileWriter
+	jobMatch         *JobMatchService
+	profileExpRepo   domain.ProfileExperienceRepository
+	profileSkillRepo domain.ProfileSkillRepository
+	testimonialRepo  domain.TestimonialRepository
+	letterRepo       domain.CoverLetterRepository
+}
+
+// NewCoverLetterService creates a new CoverLetterService.
+func NewCoverLetterService(
+	writer domain.ProfileWriter,
+	jobMatch *JobMatchService,
+	profileExpRepo domain.ProfileExperienceRepository,
+	profileSkillRepo domain.ProfileSkillRepository,
+	testimonialRepo domain.TestimonialRepository,
+	letterRepo domain.CoverLetterRepository,
+) *CoverLetterService {
+	return &CoverLetterService{
+		writer:           writer,
+		jobMatch:         jobMatch,
+		profileExpRepo:   profileExpRepo,
+		profileSkillRepo: profileSkillRepo,
+		testimonialRepo:  testimonialRepo,
+		letterRepo:       letterRepo,
+	}
+}
+
+// Generate saves the job description for the profile's user and drafts a cover letter
+// for it as version 1. A draft making claims the profile and job description do not
+// support, quoting words neither a testimonial nor the job description contains, or
+// attributing a testimonial to someone else, is rejected with a ValidationError.
+func (s *CoverLetterService) Generate(ctx context.Context, profile *domain.Profile, jobText string) (*domain.CoverLetter, error) {
+	facts, err := writingFacts(ctx, s.profileExpRepo, s.profileSkillRepo, s.testimonialRepo, profile.ID)
+	if err != nil {
+		return nil, err
+	}
+	match, err := s.jobMatch.MatchText(ctx, profile, jobText)
+	if err != nil {
+		return nil, err
+	}
+	jd := match.JobDescription
+
+	draft, err := s.writer.DraftCoverLetter(ctx, domain.CoverLetterJob{Title: jd.Title, Company: jd.Company, Text: jd.Text}, facts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to draft cover letter: %w", err)
+	}
+
+	extra := []string{jd.Text, coverLetterConventions}
+	if profile.Name != nil {
+		extra = append(extra, *profile.Name)
+	}
+	numbers, words := claimCorpus(facts, extra...)
+	if claims := unsupportedClaims(html.UnescapeString(draft.Body), numbers, words); len(claims) > 0 {
+		return nil, &domain.ValidationError{
+			Field:   "body",
+			Message: "the cover letter made claims the profile does not support: " + strings.Join(claims, ", "),
+			Err:     domain.ErrUnsupportedClaim,
+		}
+	}
+
+	quotes, err := s.verifyQuotes(ctx, profile.ID, jd.Text, draft.Body, draft.Quotes)
+	if err != nil {
+		return nil, err
+	}
+
+	letter := &domain.CoverLetter{
+		ID:               uuid.New(),
+		ProfileID:        profile.ID,
+		JobDescriptionID: &jd.ID,
+		Title:            coverLetterTitle(jd),
+	}
+	version := &domain.CoverLetterVersion{
+		ID:           uuid.New(),
+		Body:         draft.Body,
+		Citations:    draft.Citations,
+		Quotes:       quotes,
+		Source:       domain.CoverLetterVersionGenerated,
+		ModelVersion: draft.Metadata.ModelVersion,
+	}
+	if err := s.letterRepo.Create(ctx, letter, version); err != nil {
+		return nil, fmt.Errorf("failed to save cover letter: %w", err)
+	}
+	return letter, nil
+}
+
+// Edit saves body as the next version of the letter. Its quotes are verified like a
+// generated draft's, against the job description the letter was written for if it is
+// still saved. It keeps the citations of the version it replaces that the body still
+// draws on, and cites every testimonial the body quotes.
+func (s *CoverLetterService) Edit(ctx context.Context, letter *domain.CoverLetter, body string) (*domain.CoverLetterVersion, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, &domain.ValidationError{Field: "body", Message: "cover letter text is required", Err: domain.ErrEmptyRequired}
+	}
+
+	latest, err := s.letterRepo.GetVersion(ctx, letter.ID, letter.LatestVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cover letter version: %w", err)
+	}
+	jobText := ""
+	if letter.JobDescriptionID != nil {
+		jd, err := s.jobMatch.jobDescRepo.GetByID(ctx, *letter.JobDescriptionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get job description: %w", err)
+		}
+		if jd != nil {
+			jobText = jd.Text
+		}
+	}
+
+	quotes, err := s.verifyQuotes(ctx, letter.ProfileID, jobText, body, nil)
+	if err != nil {
+		return nil, err
+	}
+	var previous []domain.WritingCitation
+	if latest != nil {
+		previous = latest.Citations
+	}
+	citations, err := s.editedCitations(ctx, letter.ProfileID, body, previous, quotes)
+	if err != nil {
+		return nil, err
+	}
+
+	version := &domain.CoverLetterVersion{
+		ID:        uuid.New(),
+		Body:      body,
+		Citations: citations,
+		Quotes:    quotes,
+		Source:    domain.CoverLetterVersionEdited,
+	}
+	if err := s.letterRepo.AddVersion(ctx, letter, version); err != nil {
+		return nil, fmt.Errorf("failed to save cover letter version: %w", err)
+	}
+	return version, nil
+}
+
+// editedCitations returns the citations of an edited body: those of the previous version
+// whose fact the body still draws on, followed by the testimonials it quotes that were not
+// cited yet. A body draws on an experience if it names its company or title, on a skill if
+// it names the skill, and on a testimonial if it quotes it. Citations of facts no longer
+// in the profile are dropped.
+func (s *CoverLetterService) editedCitations(ctx context.Context, profileID uuid.UUID, body string, previous []domain.WritingCitation, quotes []domain.CoverLetterQuote) ([]domain.WritingCitation, error) {
+	experiences, err := s.profileExpRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile experiences: %w", err)
+	}
+	skills, err := s.profileSkillRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile skills: %w", err)
+	}
+
+	text := normalize.FoldText(html.UnescapeString(body))
+	names := func(candidates ...string) bool {
+		for _, c := range candidates {
+			if c = normalize.FoldText(strings.TrimSpace(c)); c != "" && containsWords(text, c) {
+				return true
+			}
+		}
+		return false
+	}
+	used := map[uuid.UUID]bool{}
+	for _, exp := range experiences {
+		used[exp.ID] = names(exp.Company, exp.Title)
+	}
+	for _, skill := range skills {
+		used[skill.ID] = names(skill.Name)
+	}
+	for _, q := range quotes {
+		used[q.TestimonialID] = true
+	}
+
+	citations := []domain.WritingCitation{}
+	cited := map[uuid.UUID]bool{}
+	for _, c := range previous {
+		if used[c.ID] && !cited[c.ID] {
+			cited[c.ID] = true
+			citations = append(citations, c)
+		}
+	}
+	for _, q := range quotes {
+		if !cited[q.TestimonialID] {
+			cited[q.TestimonialID] = true
+			citations = append(citations, domain.WritingCitation{Kind: domain.WritingFactTestimonial, ID: q.TestimonialID})
+		}
+	}
+	return citations, nil
+}
+
+// Export renders a version of the letter as a file. Version 0 is the latest. It returns
+// nil if the letter has no such version.
+func (s *CoverLetterService) Export(ctx context.Context, letter *domain.CoverLetter, version int, format ExportFormat) (*ExportedFile, error) {
+	if version == 0 {
+		version = letter.LatestVersion
+	}
+	v, err := s.letterRepo.GetVersion(ctx, letter.ID, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cover letter version: %w", err)
+	}
+	if v == nil {
+		return nil, nil
+	}
+	return exportCoverLetter(letter, v, format), nil
+}
+
+// verifyQuotes checks every passage the body puts in double quotation marks against the
+// profile's stored testimonials and the job description the letter is written for, and
+// returns the testimonial quotes with the testimonial each is taken from. A passage must
+// appear as whole words. It is attributed to the testimonial the writer reported if that
+// one contains it, otherwise to any testimonial that does, but only to one whose author
+// fits what the sentence says about who wrote it: their name, their company or their
+// relationship to the candidate. Passages quoted from the job description are accepted
+// without a testimonial. Quotation marks around words neither contains, and testimonial
+// quotes attributed to someone else, are rejected.
+func (s *CoverLetterService) verifyQuotes(ctx context.Context, profileID uuid.UUID, jobText, body string, reported []domain.CoverLetterQuote) ([]domain.CoverLetterQuote, error) {
+	testimonials, err := s.testimonialRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get testimonials: %w", err)
+	}
+	stored := make(map[uuid.UUID]string, len(testimonials))
+	for _, t := range testimonials {
+		stored[t.ID] = quoteKey(t.Quote)
+	}
+	job := quoteKey(jobText)
+
+	quotes := []domain.CoverLetterQuote{}
+	var unverified, misattributed []string
+	for _, q := range quotedPassages(body) {
+		key := quoteKey(q.passage)
+		var candidates []*domain.Testimonial
+		for _, r := range reported {
+			if quoteKey(r.Text) == key && containsWords(stored[r.TestimonialID], key) {
+				for _, t := range testimonials {
+					if t.ID == r.TestimonialID {
+						candidates = append(candidates, t)
+					}
+				}
+			}
+		}
+		for _, t := range testimonials {
+			if containsWords(stored[t.ID], key) {
+				candidates = append(candidates, t)
+			}
+		}
+
+		if len(candidates) == 0 {
+			if !containsWords(job, key) {
+				unverified = append(unverified, "“"+q.passage+"”")
+			}
+			continue
+		}
+		attribution := quoteAttributionOf(q, testimonials)
+		source := uuid.Nil
+		for _, t := range candidates {
+			if attribution.fits(t) {
+				source = t.ID
+				break
+			}
+		}
+		if source == uuid.Nil {
+			misattributed = append(misattributed, "“"+q.passage+"”")
+			continue
+		}
+		quotes = append(quotes, domain.CoverLetterQuote{TestimonialID: source, Text: q.passage})
+	}
+
+	if len(unverified) > 0 {
+		return nil, &domain.ValidationError{
+			Field:   "body",
+			Message: "quotes not found verbatim in any testimonial or the job description: " + strings.Join(unverified, ", "),
+			Err:     domain.ErrUnsupportedClaim,
+		}
+	}
+	if len(misattributed) > 0 {
+		return nil, &domain.ValidationError{
+			Field:   "body",
+			Message: "quotes attributed to someone other than the testimonial's author: " + strings.Join(misattributed, ", "),
+			Err:     domain.ErrUnsupportedClaim,
+		}
+	}
+	return quotes, nil
+}
+
+// letterQuote is a passage a letter puts in double quotation marks, with the words around
+// it that may say who wrote it: the part of its sentence before the quote and the part after
+// it, each up to the neighbouring quote.
+type letterQuote struct {
+	passage string
+	before  string
+	after   string
+}
+
+// quotedPassages returns the passages of text in double quotation marks, without the
+// punctuation and ellipses a letter may add at either end of a quote.
+func quotedPassages(text string) []letterQuote {
+	text = html.UnescapeString(text)
+	matches := quotedPassage.FindAllStringSubmatchIndex(text, -1)
+	var quotes []letterQuote
+	for i, m := range matches {
+		raw := text[m[0]:m[1]]
+		passage := strings.Trim(raw, `“”"`)
+		// A quote ending its sentence leaves nothing after it to attribute it
+		endsSentence := quoteEndsSentence.MatchString(passage)
+		passage = strings.TrimRight(passage, " .,;:!?…")
+		passage = strings.TrimLeft(passage, " .…")
+		if passage == "" {
+			continue
+		}
+
+		from := 0
+		if i > 0 {
+			from = matches[i-1][1]
+		}
+		before := text[from:m[0]]
+		if ends := sentenceEnd.FindAllStringIndex(before, -1); len(ends) > 0 {
+			before = before[ends[len(ends)-1][1]:]
+		}
+		to := len(text)
+		if i+1 < len(matches) {
+			to = matches[i+1][0]
+		}
+		after := text[m[1]:to]
+		if endsSentence {
+			after = ""
+		} else if end := sentenceEnd.FindStringIndex(after); end != nil {
+			after = after[:end[0]]
+		}
+		quotes = append(quotes, letterQuote{passage: passage, before: before, after: after})
+	}
+	return quotes
+}
+
+// containsWords reports whether phrase appears in text as whole words: not as part of a
+// longer word such as "lead" in "leadership".
+func containsWords(text, phrase string) bool {
+	if phrase == "" {
+		return false
+	}
+	first, _ := utf8.DecodeRuneInString(phrase)
+	last, _ := utf8.DecodeLastRuneInString(phrase)
+	for offset := 0; ; {
+		i := strings.Index(text[offset:], phrase)
+		if i < 0 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(phrase)
+		prev, _ := utf8.DecodeLastRuneInString(text[:start])
+		next, _ := utf8.DecodeRuneInString(text[end:])
+		if !(isWordRune(first) && start > 0 && isWordRune(prev)) && !(isWordRune(last) && end < len(text) && isWordRune(next)) {
+			return true
+		}
+		offset = start + utf8.RuneLen(first)
+	}
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// relationshipWords are the words a letter may use for a testimonial's author, with the
+// relationships each may stand for.
+var relationshipWords = map[string][]domain.TestimonialRelationship{
+	"manager":    {domain.TestimonialRelationshipManager},
+	"boss":       {domain.TestimonialRelationshipManager},
+	"supervisor": {domain.TestimonialRelationshipManager, domain.TestimonialRelationshipProfessor},
+	"report":     {domain.TestimonialRelationshipDirectReport},
+	"reports":    {domain.TestimonialRelationshipDirectReport},
+	"client":     {domain.TestimonialRelationshipClient},
+	"clients":    {domain.TestimonialRelationshipClient},
+	"customer":   {domain.TestimonialRelationshipClient},
+	"mentor":     {domain.TestimonialRelationshipMentor},
+	"professor":  {domain.TestimonialRelationshipProfessor},
+	"advisor":    {domain.TestimonialRelationshipProfessor, domain.TestimonialRelationshipMentor},
+	"colleague":  {domain.TestimonialRelationshipColleague, domain.TestimonialRelationshipPeer},
+	"colleagues": {domain.TestimonialRelationshipColleague, domain.TestimonialRelationshipPeer},
+	"coworker":   {domain.TestimonialRelationshipColleague, domain.TestimonialRelationshipPeer},
+	"co-worker":  {domain.TestimonialRelationshipColleague, domain.TestimonialRelationshipPeer},
+	"peer":       {domain.TestimonialRelationshipPeer, domain.TestimonialRelationshipColleague},
+	"peers":      {domain.TestimonialRelationshipPeer, domain.TestimonialRelationshipColleague},
+	"teammate":   {domain.TestimonialRelationshipPeer, domain.TestimonialRelationshipColleague},
+	"review":     {domain.TestimonialRelationshipPerformanceReview},
+}
+
+// quoteAttribution is what a letter says about who wrote a quote: the relationships it
+// names, and the testimonial authors and companies it mentions by name.
+type quoteAttribution struct {
+	relationships map[domain.TestimonialRelationship]bool
+	authors       map[string]bool
+	companies     map[string]bool
+}
+
+// quoteAttributionOf reads the attribution of a quote from the words before it in its
+// sentence, or from those after it when the words before say nothing about its author.
+// Only the names and companies of the profile's testimonial authors are recognized.
+func quoteAttributionOf(q letterQuote, testimonials []*domain.Testimonial) quoteAttribution {
+	a := attributionIn(q.before, testimonials)
+	if a.empty() {
+		a = attributionIn(q.after, testimonials)
+	}
+	return a
+}
+
+func attributionIn(text string, testimonials []*domain.Testimonial) quoteAttribution {
+	a := quoteAttribution{
+		relationships: map[domain.TestimonialRelationship]bool{},
+		authors:       map[string]bool{},
+		companies:     map[string]bool{},
+	}
+	folded := normalize.FoldText(text)
+	for _, word := range strings.FieldsFunc(folded, func(r rune) bool { return !isWordRune(r) && r != '-' }) {
+		for _, rel := range relationshipWords[word] {
+			a.relationships[rel] = true
+		}
+	}
+	for _, t := range testimonials {
+		if name := authorKey(t.AuthorName); name != "" {
+			fields := strings.Fields(name)
+			if containsWords(folded, name) || (len(fields) > 1 && containsWords(folded, fields[len(fields)-1])) {
+				a.authors[name] = true
+			}
+		}
+		if company := authorKey(t.AuthorCompany); company != "" && containsWords(folded, company) {
+			a.companies[company] = true
+		}
+	}
+	return a
+}
+
+func (a quoteAttribution) empty() bool {
+	return len(a.relationships) == 0 && len(a.authors) == 0 && len(a.companies) == 0
+}
+
+// fits reports whether a testimonial's author fits the attribution. An author whose
+// relationship to the candidate is unknown fits any relationship.
+func (a quoteAttribution) fits(t *domain.Testimonial) bool {
+	if len(a.authors) > 0 && !a.authors[authorKey(t.AuthorName)] {
+		return false
+	}
+	if len(a.companies) > 0 && !a.companies[authorKey(t.AuthorCompany)] {
+		return false
+	}
+	known := t.Relationship != "" && t.Relationship != domain.TestimonialRelationshipOther
+	return len(a.relationships) == 0 || !known || a.relationships[t.Relationship]
+}
+
+// authorKey folds an author's name or company for comparison.
+func authorKey(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return strings.Join(strings.Fields(normalize.FoldText(*s)), " ")
+}
+
+// quoteKey normalizes a quote for verbatim comparison: HTML entities are decoded, curly
+// apostrophes made straight and runs of whitespace collapsed, but no words or letters change.
+func quoteKey(s string) string {
+	s = strings.ReplaceAll(html.UnescapeString(s), "’", "'")
+	return strings.TrimSpace(quoteSpace.ReplaceAllString(s, " "))
+}
+
+// coverLetterTitle names a letter after the job it is written for.
+func coverLetterTitle(jd *domain.JobDescription) string {
+	switch {
+	case jd.Title != nil && jd.Company != nil:
+		return *jd.Title + " at " + *jd.Company
+	case jd.Title != nil:
+		return *jd.Title
+	case jd.Company != nil:
+		return "Cover letter for " + *jd.Company
+	default:
+		return "Cover letter"
+	}
+}
+
+// exportCoverLetter renders a letter version as plain text or a standalone HTML document
+// with one paragraph per blank-line-separated block.
+func exportCoverLetter(letter *domain.CoverLetter, v *domain.CoverLetterVersion, format ExportFormat) *ExportedFile {
+	doc := &ExportDocument{
+		Title:    letter.Title,
+		Sections: []ExportSection{{Paragraphs: splitParagraphs(html.UnescapeString(v.Body))}},
+	}
+	return doc.Render(ExportFileName(letter.Title, "cover-letter")+"-v"+strconv.Itoa(v.Version), format)
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+func TestCoverLetterService_VerifyQuotes(t *testing.T) {
+	profileID := uuid.New()
+	repo := newMockTestimonialRepository()
+	manager := &domain.Testimonial{
+		ID:            uuid.New(),
+		ProfileID:     profileID,
+		Quote:         "She is calm under pressure and generous with her time. Her leadership grew the team.",
+		AuthorName:    stringPtr("Maria Schmidt"),
+		AuthorCompany: stringPtr("Acme"),
+		Relationship:  domain.TestimonialRelationshipManager,
+	}
+	peer := &domain.Testimonial{
+		ID:            uuid.New(),
+		ProfileID:     profileID,
+		Quote:         "Jane&#39;s code reviews made the whole team better.",
+		AuthorName:    stringPtr("Tom Lee"),
+		AuthorCompany: stringPtr("Globex"),
+		Relationship:  domain.TestimonialRelationshipPeer,
+	}
+	repo.testimonials[manager.ID] = manager
+	repo.testimonials[peer.ID] = peer
+	s := &CoverLetterService{testimonialRepo: repo}
+
+	jobText := "We are looking for an engineer who thrives in a fast-paced environment."
+
+	tests := []struct {
+		name     string
+		body     string
+		reported []domain.CoverLetterQuote
+		want     []domain.CoverLetterQuote
+		wantErr  bool
+	}{
+		{
+			name: "attributes quotes to the testimonials containing them",
+			body: "My former manager described me as “calm under pressure.” A colleague wrote that \"Jane's code reviews made the whole team better\".",
+			want: []domain.CoverLetterQuote{
+				{TestimonialID: manager.ID, Text: "calm under pressure"},
+				{TestimonialID: peer.ID, Text: "Jane's code reviews made the whole team better"},
+			},
+		},
+		{
+			name:     "keeps the reported testimonial when it contains the quote",
+			body:     "I was called “generous with her time”.",
+			reported: []domain.CoverLetterQuote{{TestimonialID: manager.ID, Text: "generous with her time"}},
+			want:     []domain.CoverLetterQuote{{TestimonialID: manager.ID, Text: "generous with her time"}},
+		},
+		{
+			name:    "rejects a reworded quote",
+			body:    "My manager called me “calm under stress”.",
+			wantErr: true,
+		},
+		{
+			name:    "rejects a quote joining two testimonials",
+			body:    "I am “calm under pressure and made the whole team better”.",
+			wantErr: true,
+		},
+		{
+			name:    "matches quotes on word boundaries",
+			body:    "My manager praised my “lead”.",
+			wantErr: true,
+		},
+		{
+			name: "accepts a quote from the job description without a testimonial",
+			body: "You want someone who “thrives in a fast-paced environment”, and my former manager called me “calm under pressure”.",
+			want: []domain.CoverLetterQuote{{TestimonialID: manager.ID, Text: "calm under pressure"}},
+		},
+		{
+			name: "accepts an attribution by name and company",
+			body: "Maria Schmidt, my former manager at Acme, wrote that I am “generous with her time”.",
+			want: []domain.CoverLetterQuote{{TestimonialID: manager.ID, Text: "generous with her time"}},
+		},
+		{
+			name:    "rejects a quote attributed to the wrong relationship",
+			body:    "A colleague described me as “calm under pressure”.",
+			wantErr: true,
+		},
+		{
+			name:    "rejects a quote attributed to the wrong company",
+			body:    "My former manager at Globex called me “calm under pressure”.",
+			wantErr: true,
+		},
+		{
+			name:    "rejects a quote attributed to another author",
+			body:    "Tom Lee wrote that I am “generous with her time”.",
+			wantErr: true,
+		},
+		{
+			name: "accepts a letter without quotes",
+			body: "I would like to apply for the role.",
+			want: []domain.CoverLetterQuote{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.verifyQuotes(context.Background(), profileID, jobText, tt.body, tt.reported)
+			if tt.wantErr {
+				if !errors.Is(err, domain.ErrUnsupportedClaim) {
+					t.Fatalf("expected an unsupported claim error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("verifyQuotes() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("quote %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCoverLetterService_EditedCitations(t *testing.T) {
+	ctx := context.Background()
+	profileID := uuid.New()
+	expRepo := newMockProfileExperienceRepository()
+	acme := &domain.ProfileExperience{ID: uuid.New(), ProfileID: profileID, Title: "Platform Engineer", Company: "Acme"}
+	globex := &domain.ProfileExperience{ID: uuid.New(), ProfileID: profileID, Title: "Engineer", Company: "Globex"}
+	expRepo.experiences[acme.ID] = acme
+	expRepo.experiences[globex.ID] = globex
+	skillRepo := newMockProfileSkillRepository()
+	kubernetes := &domain.ProfileSkill{ID: uuid.New(), ProfileID: profileID, Name: "Kubernetes"}
+	skillRepo.skills[kubernetes.ID] = kubernetes
+	s := &CoverLetterService{profileExpRepo: expRepo, profileSkillRepo: skillRepo}
+
+	removed, quoted, deleted := uuid.New(), uuid.New(), uuid.New()
+	previous := []domain.WritingCitation{
+		{Kind: domain.WritingFactExperience, ID: acme.ID},
+		{Kind: domain.WritingFactExperience, ID: globex.ID},
+		{Kind: domain.WritingFactSkill, ID: kubernetes.ID},
+		{Kind: domain.WritingFactTestimonial, ID: removed},
+		{Kind: domain.WritingFactExperience, ID: deleted},
+	}
+	body := "At ACME I ran kubernetes, and my manager said I \"kept our clusters running\"."
+	quotes := []domain.CoverLetterQuote{{TestimonialID: quoted, Text: "kept our clusters running"}}
+
+	got, err := s.editedCitations(ctx, profileID, body, previous, quotes)
+	if err != nil {
+		t.Fatalf("editedCitations() error = %v", err)
+	}
+	want := []uuid.UUID{acme.ID, kubernetes.ID, quoted}
+	if len(got) != len(want) {
+		t.Fatalf("expected citations %v, got %+v", want, got)
+	}
+	for i, id := range want {
+		if got[i].ID != id {
+			t.Errorf("citation %d: expected %s, got %+v", i, id, got[i])
+		}
+	}
+	if got[2].Kind != domain.WritingFactTestimonial {
+		t.Errorf("expected the quoted testimonial to be cited as a testimonial, got %+v", got[2])
+	}
+}
+
+func TestExportCoverLetter(t *testing.T) {
+	letter := &domain.CoverLetter{Title: "Platform Engineer at Acme & Co"}
+	version := &domain.CoverLetterVersion{Version: 2, Body: "Dear team,\n\nI am &#34;calm under pressure&#34; <really>.\nThanks"}
+
+	text := exportCoverLetter(letter, version, ExportText)
+	if text.Filename != "platform-engineer-at-acme-co-v2.txt" || !strings.HasPrefix(text.ContentType, "text/plain") ||
+		!strings.Contains(text.Content, `I am "calm under pressure" <really>.`) {
+		t.Errorf("unexpected text export %+v", text)
+	}
+
+	doc := exportCoverLetter(letter, version, ExportHTML)
+	if doc.Filename != "platform-engineer-at-acme-co-v2.html" ||
+		!strings.Contains(doc.Content, "<title>Platform Engineer at Acme &amp; Co</title>") ||
+		!strings.Contains(doc.Content, "<p>Dear team,</p>") ||
+		!strings.Contains(doc.Content, "<p>I am &#34;calm under pressure&#34; &lt;really&gt;.<br>\nThanks</p>") {
+		t.Errorf("unexpected HTML export %s", doc.Content)
+	}
+}
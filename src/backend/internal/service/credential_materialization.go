@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+	"backend/internal/repository/postgres"
+)
+
+// MaterializeTranscriptData marks the profile education entries that an academic transcript
+// or diploma corroborates as verified by that document.
+// An entry matches when its institution matches the transcript's institution (after
+// normalization) and, if both sides state a degree, the degrees match too.
+// Idempotent: re-importing the same transcript clears the verifications it made before and
+// re-applies them, so entries it no longer matches lose its verification.
+func (s *MaterializationService) MaterializeTranscriptData(
+	ctx context.Context,
+	documentID uuid.UUID,
+	userID uuid.UUID,
+	data *domain.ExtractedTranscriptData,
+) (*MaterializationResult, error) {
+	profile, err := s.profileRepo.GetOrCreateByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get or create profile: %w", err)
+	}
+
+	result := &MaterializationResult{}
+
+	if s.db == nil {
+		count, verifyErr := s.verifyEducationWithRepo(ctx, s.profileEduRepo, documentID, profile.ID, data)
+		result.VerifiedEducations = count
+		return result, verifyErr
+	}
+
+	txErr := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		count, verifyErr := s.verifyEducationWithRepo(ctx, postgres.NewProfileEducationRepository(tx), documentID, profile.ID, data)
+		result.VerifiedEducations = count
+		return verifyErr
+	})
+	if txErr != nil {
+		return result, txErr
+	}
+
+	return result, nil
+}
+
+// verifyEducationWithRepo accepts a repository parameter for transaction support.
+func (s *MaterializationService) verifyEducationWithRepo(
+	ctx context.Context,
+	eduRepo domain.ProfileEducationRepository,
+	documentID uuid.UUID,
+	profileID uuid.UUID,
+	data *domain.ExtractedTranscriptData,
+) (int, error) {
+	educations, err := eduRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get profile education: %w", err)
+	}
+
+	count := 0
+	for _, edu := range educations {
+		if !transcriptMatchesEducation(data, edu) {
+			if edu.VerifiedByDocumentID != nil && *edu.VerifiedByDocumentID == documentID {
+				edu.VerifiedByDocumentID = nil
+				if updateErr := eduRepo.Update(ctx, edu); updateErr != nil {
+					return count, fmt.Errorf("failed to clear education verification: %w", updateErr)
+				}
+			}
+			continue
+		}
+		docID := documentID
+		edu.VerifiedByDocumentID = &docID
+		if updateErr := eduRepo.Update(ctx, edu); updateErr != nil {
+			return count, fmt.Errorf("failed to mark education as verified: %w", updateErr)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// transcriptMatchesEducation reports whether a transcript corroborates an education entry:
+// the institutions match and, where both sides state them, so do the degree and field of study.
+func transcriptMatchesEducation(data *domain.ExtractedTranscriptData, edu *domain.ProfileEducation) bool {
+	if !normalize.InstitutionsMatch(data.Institution, edu.Institution) {
+		return false
+	}
+	if data.Degree != nil && strings.TrimSpace(edu.Degree) != "" && !normalize.DegreesAgree(*data.Degree, edu.Degree) {
+		return false
+	}
+	if data.Field != nil && edu.Field != nil && strings.TrimSpace(*data.Field) != "" && strings.TrimSpace(*edu.Field) != "" &&
+		!normalize.DegreesAgree(*data.Field, *edu.Field) {
+		return false
+	}
+	return true
+}
+
+// MaterializePerformanceReviewData creates testimonial rows from a performance review.
+// The reviewer becomes an Author and each review statement a Testimonial with the
+// performance_review relationship, linked to the credential document instead of a reference letter.
+// Idempotent: deletes existing testimonials from the same document before re-creating.
+func (s *MaterializationService) MaterializePerformanceReviewData(
+	ctx context.Context,
+	documentID uuid.UUID,
+	userID uuid.UUID,
+	data *domain.ExtractedPerformanceReviewData,
+) (*MaterializationResult, error) {
+	profile, err := s.profileRepo.GetOrCreateByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get or create profile: %w", err)
+	}
+
+	result := &MaterializationResult{}
+
+	if s.db == nil {
+		count, createErr := s.materializeReviewTestimonialsWithRepos(ctx, s.testimonialRepo, s.authorRepo, s.companyRepo, documentID, profile.ID, data)
+		result.Testimonials = count
+		if createErr != nil {
+			return result, createErr
+		}
+		return result, s.RecomputeSkillStats(ctx, profile.ID)
+	}
+
+	txErr := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		count, createErr := s.materializeReviewTestimonialsWithRepos(ctx, postgres.NewTestimonialRepository(tx), postgres.NewAuthorRepository(tx), postgres.NewCompanyRepository(tx), documentID, profile.ID, data)
+		result.Testimonials = count
+		return createErr
+	})
+	if txErr != nil {
+		return result, txErr
+	}
+
+	return result, s.RecomputeSkillStats(ctx, profile.ID)
+}
+
+// materializeReviewTestimonialsWithRepos accepts repository parameters for transaction support.
+func (s *MaterializationService) materializeReviewTestimonialsWithRepos(
+	ctx context.Context,
+	testimonialRepo domain.TestimonialRepository,
+	authorRepo domain.AuthorRepository,
+	companyRepo domain.CompanyRepository,
+	documentID uuid.UUID,
+	profileID uuid.UUID,
+	data *domain.ExtractedPerformanceReviewData,
+) (int, error) {
+	if delErr := testimonialRepo.DeleteByCredentialDocumentID(ctx, documentID); delErr != nil {
+		return 0, fmt.Errorf("failed to delete existing testimonials for credential document: %w", delErr)
+	}
+
+	if len(data.Testimonials) == 0 {
+		return 0, nil
+	}
+
+	author, err := s.findOrCreateAuthorWithRepo(ctx, authorRepo, companyRepo, profileID, &data.Reviewer)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find or create author: %w", err)
+	}
+
+	count := 0
+	for _, extracted := range data.Testimonials {
+		docID := documentID
+		testimonial := &domain.Testimonial{
+			ID:                   uuid.New(),
+			ProfileID:            profileID,
+			CredentialDocumentID: &docID,
+			Quote:                extracted.Quote,
+			Relationship:         domain.TestimonialRelationshipPerformanceReview,
+			SkillsMentioned:      extracted.SkillsMentioned,
+			AuthorName:           &data.Reviewer.Name,
+			AuthorTitle:          data.Reviewer.Title,
+			AuthorCompany:        data.Reviewer.Company,
+		}
+		if author != nil {
+			testimonial.AuthorID = &author.ID
+		}
+
+		if createErr := testimonialRepo.Create(ctx, testimonial); createErr != nil {
+			return count, fmt.Errorf("failed to create testimonial: %w", createErr)
+		}
+		count++
+	}
+	return count, nil
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+func TestMaterializeTranscriptVerifiesMatchingEducation(t *testing.T) {
+	svc, profileRepo, _, eduRepo, _ := newTestService()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	profile, err := profileRepo.GetOrCreateByUserID(ctx, userID)
+	if err != nil {
+		t.Fatalf("failed to create profile: %v", err)
+	}
+	matching := &domain.ProfileEducation{ProfileID: profile.ID, Institution: "Massachusetts Institute of Technology", Degree: "Bachelor of Science"}
+	other := &domain.ProfileEducation{ProfileID: profile.ID, Institution: "Stanford University", Degree: "Master of Science"}
+	_ = eduRepo.Create(ctx, matching)
+	_ = eduRepo.Create(ctx, other)
+
+	docID := uuid.New()
+	result, err := svc.MaterializeTranscriptData(ctx, docID, userID, &domain.ExtractedTranscriptData{
+		Institution: "massachusetts institute of technology",
+		Degree:      stringPtr("Bachelor of Science"),
+	})
+	if err != nil {
+		t.Fatalf("MaterializeTranscriptData returned error: %v", err)
+	}
+
+	if result.VerifiedEducations != 1 {
+		t.Errorf("expected 1 verified education, got %d", result.VerifiedEducations)
+	}
+	if matching.VerifiedByDocumentID == nil || *matching.VerifiedByDocumentID != docID {
+		t.Errorf("expected matching education to be verified by %s, got %v", docID, matching.VerifiedByDocumentID)
+	}
+	if other.VerifiedByDocumentID != nil {
+		t.Error("expected non-matching education to remain unverified")
+	}
+}
+
+func TestMaterializeTranscriptRejectsDifferentDegree(t *testing.T) {
+	svc, profileRepo, _, eduRepo, _ := newTestService()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	profile, _ := profileRepo.GetOrCreateByUserID(ctx, userID)
+	edu := &domain.ProfileEducation{ProfileID: profile.ID, Institution: "MIT", Degree: "PhD"}
+	_ = eduRepo.Create(ctx, edu)
+
+	result, err := svc.MaterializeTranscriptData(ctx, uuid.New(), userID, &domain.ExtractedTranscriptData{
+		Institution: "MIT",
+		Degree:      stringPtr("Bachelor of Science"),
+	})
+	if err != nil {
+		t.Fatalf("MaterializeTranscriptData returned error: %v", err)
+	}
+
+	if result.VerifiedEducations != 0 {
+		t.Errorf("expected 0 verified educations, got %d", result.VerifiedEducations)
+	}
+	if edu.VerifiedByDocumentID != nil {
+		t.Error("expected education with a different degree to remain unverified")
+	}
+}
+
+func TestMaterializeTranscriptDoesNotVerifyOtherDegreeAtSameInstitution(t *testing.T) {
+	svc, profileRepo, _, eduRepo, _ := newTestService()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	profile, _ := profileRepo.GetOrCreateByUserID(ctx, userID)
+	bachelor := &domain.ProfileEducation{ProfileID: profile.ID, Institution: "ETH Zurich", Degree: "Bachelor of Science", Field: stringPtr("Computer Science")}
+	master := &domain.ProfileEducation{ProfileID: profile.ID, Institution: "ETH Zurich", Degree: "MSc", Field: stringPtr("Computer Science")}
+	_ = eduRepo.Create(ctx, bachelor)
+	_ = eduRepo.Create(ctx, master)
+
+	docID := uuid.New()
+	result, err := svc.MaterializeTranscriptData(ctx, docID, userID, &domain.ExtractedTranscriptData{
+		Institution: "ETH Zürich",
+		Degree:      stringPtr("BSc"),
+		Field:       stringPtr("Computer Science"),
+	})
+	if err != nil {
+		t.Fatalf("MaterializeTranscriptData returned error: %v", err)
+	}
+
+	if result.VerifiedEducations != 1 {
+		t.Errorf("expected 1 verified education, got %d", result.VerifiedEducations)
+	}
+	if bachelor.VerifiedByDocumentID == nil || *bachelor.VerifiedByDocumentID != docID {
+		t.Errorf("expected the bachelor's degree to be verified by %s, got %v", docID, bachelor.VerifiedByDocumentID)
+	}
+	if master.VerifiedByDocumentID != nil {
+		t.Error("expected a BSc transcript not to verify the MSc entry")
+	}
+}
+
+func TestMaterializeTranscriptReimportClearsStaleVerifications(t *testing.T) {
+	svc, profileRepo, _, eduRepo, _ := newTestService()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	profile, _ := profileRepo.GetOrCreateByUserID(ctx, userID)
+	docID := uuid.New()
+	otherDocID := uuid.New()
+	stale := &domain.ProfileEducation{ProfileID: profile.ID, Institution: "Stanford University", Degree: "Master of Science", VerifiedByDocumentID: &docID}
+	otherDocument := &domain.ProfileEducation{ProfileID: profile.ID, Institution: "Stanford University", Degree: "Bachelor of Arts", VerifiedByDocumentID: &otherDocID}
+	matching := &domain.ProfileEducation{ProfileID: profile.ID, Institution: "MIT", Degree: "Bachelor of Science"}
+	for _, edu := range []*domain.ProfileEducation{stale, otherDocument, matching} {
+		_ = eduRepo.Create(ctx, edu)
+	}
+
+	result, err := svc.MaterializeTranscriptData(ctx, docID, userID, &domain.ExtractedTranscriptData{
+		Institution: "MIT",
+		Degree:      stringPtr("Bachelor of Science"),
+	})
+	if err != nil {
+		t.Fatalf("MaterializeTranscriptData returned error: %v", err)
+	}
+
+	if result.VerifiedEducations != 1 {
+		t.Errorf("expected 1 verified education, got %d", result.VerifiedEducations)
+	}
+	if matching.VerifiedByDocumentID == nil || *matching.VerifiedByDocumentID != docID {
+		t.Errorf("expected the matching education to be verified by %s, got %v", docID, matching.VerifiedByDocumentID)
+	}
+	if stale.VerifiedByDocumentID != nil {
+		t.Errorf("expected the verification the transcript no longer supports to be cleared, got %v", stale.VerifiedByDocumentID)
+	}
+	if otherDocument.VerifiedByDocumentID == nil || *otherDocument.VerifiedByDocumentID != otherDocID {
+		t.Errorf("expected another document's verification to be kept, got %v", otherDocument.VerifiedByDocumentID)
+	}
+}
+
+func TestMaterializePerformanceReviewCreatesTestimonials(t *testing.T) {
+	svc, _, authorRepo, testimonialRepo := newTestServiceWithRefs()
+
+	docID := uuid.New()
+	data := &domain.ExtractedPerformanceReviewData{
+		Reviewer: domain.ExtractedAuthor{
+			Name:         "Maria Keller",
+			Title:        stringPtr("Engineering Manager"),
+			Company:      stringPtr("Acme Corp"),
+			Relationship: domain.AuthorRelationshipManager,
+		},
+		Testimonials: []domain.ExtractedTestimonial{
+			{Quote: "Consistently exceeded expectations.", SkillsMentioned: []string{"Leadership"}},
+			{Quote: "Drove the migration to Go."},
+		},
+	}
+
+	result, err := svc.MaterializePerformanceReviewData(context.Background(), docID, uuid.New(), data)
+	if err != nil {
+		t.Fatalf("MaterializePerformanceReviewData returned error: %v", err)
+	}
+
+	if result.Testimonials != 2 {
+		t.Errorf("expected 2 testimonials, got %d", result.Testimonials)
+	}
+	if len(authorRepo.authors) != 1 {
+		t.Errorf("expected 1 author, got %d", len(authorRepo.authors))
+	}
+	for _, tm := range testimonialRepo.testimonials {
+		if tm.Relationship != domain.TestimonialRelationshipPerformanceReview {
+			t.Errorf("expected relationship %q, got %q", domain.TestimonialRelationshipPerformanceReview, tm.Relationship)
+		}
+		if tm.CredentialDocumentID == nil || *tm.CredentialDocumentID != docID {
+			t.Errorf("expected testimonial to be linked to credential document %s", docID)
+		}
+		if tm.ReferenceLetterID != uuid.Nil {
+			t.Errorf("expected no reference letter ID, got %s", tm.ReferenceLetterID)
+		}
+	}
+
+	// Re-importing the same review replaces its testimonials instead of duplicating them
+	if _, err := svc.MaterializePerformanceReviewData(context.Background(), docID, uuid.New(), data); err != nil {
+		t.Fatalf("second MaterializePerformanceReviewData returned error: %v", err)
+	}
+	if len(testimonialRepo.testimonials) != 2 {
+		t.Errorf("expected 2 testimonials after re-import, got %d", len(testimonialRepo.testimonials))
+	}
+}
@@ -0,0 +1,493 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+)
+
+// CredibilityFactorName identifies one of the factors a credibility score is made of.
+type CredibilityFactorName string
+
+// Credibility factors. Each is a value from 0 to 1.
+const (
+	// CredibilityFactorRelationship is the mean relationship weight (see relationshipWeights)
+	// of the sources, each source counting at its strongest relationship.
+	CredibilityFactorRelationship CredibilityFactorName = "relationship"
+	// CredibilityFactorAuthorDiversity rewards evidence from several people and companies:
+	// the mean of 1-0.5^authors and 1-0.5^companies.
+	CredibilityFactorAuthorDiversity CredibilityFactorName = "author_diversity"
+	// CredibilityFactorRecency is the mean age weight of the sources, halving every
+	// credibilityHalfLifeYears since the letter was written.
+	CredibilityFactorRecency CredibilityFactorName = "recency"
+	// CredibilityFactorGrounding is the mean grounding of the quotes: 1 for a quote found
+	// in the letter's text, 0.5 when the text is unavailable, 0.25 for a quote the text
+	// does not contain, and 0 for evidence without a quote.
+	CredibilityFactorGrounding CredibilityFactorName = "grounding"
+	// CredibilityFactorCoverage is the share of the profile's skills and experiences that
+	// have at least one validation. It only applies to the profile score.
+	CredibilityFactorCoverage CredibilityFactorName = "coverage"
+)
+
+// credibilityHalfLifeYears is the age at which a letter counts half as much as a new one.
+const credibilityHalfLifeYears = 3.0
+
+// itemCredibilityWeights and profileCredibilityWeights weight the factors of skill and
+// experience scores and of the profile score. Each set sums to 1.
+var (
+	itemCredibilityWeights = []factorWeight{
+		{CredibilityFactorRelationship, 0.35},
+		{CredibilityFactorAuthorDiversity, 0.25},
+		{CredibilityFactorRecency, 0.2},
+		{CredibilityFactorGrounding, 0.2},
+	}
+	profileCredibilityWeights = []factorWeight{
+		{CredibilityFactorRelationship, 0.3},
+		{CredibilityFactorAuthorDiversity, 0.2},
+		{CredibilityFactorRecency, 0.15},
+		{CredibilityFactorGrounding, 0.15},
+		{CredibilityFactorCoverage, 0.2},
+	}
+)
+
+type factorWeight struct {
+	name   CredibilityFactorName
+	weight float64
+}
+
+// CredibilityFactor is one term of a credibility score. The score is the sum of each
+// factor's Value times its Weight; Detail explains the value in words.
+type CredibilityFactor struct {
+	Name   CredibilityFactorName
+	Value  float64
+	Weight float64
+	Detail string
+}
+
+// Contribution returns the factor's share of the score.
+func (f CredibilityFactor) Contribution() float64 {
+	return math.Round(f.Value*f.Weight*100) / 100
+}
+
+// CredibilityScore is a score from 0 to 1 with the factors it is made of.
+type CredibilityScore struct {
+	Score         float64
+	Factors       []CredibilityFactor
+	EvidenceCount int
+}
+
+// SkillCredibility is the credibility score of a profile skill.
+type SkillCredibility struct {
+	Skill *domain.ProfileSkill
+	CredibilityScore
+}
+
+// ExperienceCredibility is the credibility score of a profile experience.
+type ExperienceCredibility struct {
+	Experience *domain.ProfileExperience
+	CredibilityScore
+}
+
+// ProfileCredibility holds the credibility scores of a profile, its skills and its experiences.
+type ProfileCredibility struct {
+	Profile     CredibilityScore
+	Skills      []SkillCredibility
+	Experiences []ExperienceCredibility
+}
+
+// CredibilityService scores how well reference letters and performance reviews back a
+// profile. Scores are computed on request from the validations and testimonials, so they
+// always reflect the current evidence.
+type CredibilityService struct {
+	profileRepo      domain.ProfileRepository
+	profileSkillRepo domain.ProfileSkillRepository
+	profileExpRepo   domain.ProfileExperienceRepository
+	refLetterRepo    domain.ReferenceLetterRepository
+	testimonialRepo  domain.TestimonialRepository
+	authorRepo       domain.AuthorRepository
+	skillValRepo     domain.SkillValidationRepository
+	expValRepo       domain.ExperienceValidationRepository
+	now              func() time.Time
+}
+
+// NewCredibilityService creates a new CredibilityService.
+func NewCredibilityService(
+	profileRepo domain.ProfileRepository,
+	profileSkillRepo domain.ProfileSkillRepository,
+	profileExpRepo domain.ProfileExperienceRepository,
+	refLetterRepo domain.ReferenceLetterRepository,
+	testimonialRepo domain.TestimonialRepository,
+	authorRepo domain.AuthorRepository,
+	skillValRepo domain.SkillValidationRepository,
+	expValRepo domain.ExperienceValidationRepository,
+) *CredibilityService {
+	return &CredibilityService{
+		profileRepo:      profileRepo,
+		profileSkillRepo: profileSkillRepo,
+		profileExpRepo:   profileExpRepo,
+		refLetterRepo:    refLetterRepo,
+		testimonialRepo:  testimonialRepo,
+		authorRepo:       authorRepo,
+		skillValRepo:     skillValRepo,
+		expValRepo:       expValRepo,
+		now:              time.Now,
+	}
+}
+
+// credibilityData is everything on a profile that credibility scores draw on.
+type credibilityData struct {
+	skills           []*domain.ProfileSkill
+	experiences      []*domain.ProfileExperience
+	letters          []*domain.ReferenceLetter
+	testimonials     []*domain.Testimonial
+	authors          []*domain.Author
+	skillValidations map[uuid.UUID][]*domain.SkillValidation      // keyed by profile skill ID
+	expValidations   map[uuid.UUID][]*domain.ExperienceValidation // keyed by profile experience ID
+}
+
+// Score computes the credibility of a profile, its skills and its experiences.
+func (s *CredibilityService) Score(ctx context.Context, profileID uuid.UUID) (*ProfileCredibility, error) {
+	profile, err := s.profileRepo.GetByID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	if profile == nil {
+		return nil, fmt.Errorf("profile not found: %s", profileID)
+	}
+
+	data := &credibilityData{
+		skillValidations: map[uuid.UUID][]*domain.SkillValidation{},
+		expValidations:   map[uuid.UUID][]*domain.ExperienceValidation{},
+	}
+	if data.skills, err = s.profileSkillRepo.GetByProfileID(ctx, profileID); err != nil {
+		return nil, fmt.Errorf("failed to get profile skills: %w", err)
+	}
+	if data.experiences, err = s.profileExpRepo.GetByProfileID(ctx, profileID); err != nil {
+		return nil, fmt.Errorf("failed to get profile experiences: %w", err)
+	}
+	if data.letters, err = s.refLetterRepo.GetByUserID(ctx, profile.UserID); err != nil {
+		return nil, fmt.Errorf("failed to get reference letters: %w", err)
+	}
+	if data.testimonials, err = s.testimonialRepo.GetByProfileID(ctx, profileID); err != nil {
+		return nil, fmt.Errorf("failed to get testimonials: %w", err)
+	}
+	if data.authors, err = s.authorRepo.GetByProfileID(ctx, profileID); err != nil {
+		return nil, fmt.Errorf("failed to get authors: %w", err)
+	}
+	for _, skill := range data.skills {
+		validations, valErr := s.skillValRepo.GetByProfileSkillID(ctx, skill.ID)
+		if valErr != nil {
+			return nil, fmt.Errorf("failed to get validations for skill %s: %w", skill.ID, valErr)
+		}
+		data.skillValidations[skill.ID] = validations
+	}
+	for _, exp := range data.experiences {
+		validations, valErr := s.expValRepo.GetByProfileExperienceID(ctx, exp.ID)
+		if valErr != nil {
+			return nil, fmt.Errorf("failed to get validations for experience %s: %w", exp.ID, valErr)
+		}
+		data.expValidations[exp.ID] = validations
+	}
+
+	return scoreCredibility(data, s.now()), nil
+}
+
+// credibilityEvidence is one statement backing a profile item: a validation or a testimonial.
+type credibilityEvidence struct {
+	sourceID     uuid.UUID // the reference letter or credential document
+	authorKey    string
+	companyKey   string
+	relationship domain.TestimonialRelationship
+	writtenAt    time.Time
+	quote        *string
+	sourceText   *string
+}
+
+// scoreCredibility scores the profile and each of its skills and experiences as of now.
+func scoreCredibility(data *credibilityData, now time.Time) *ProfileCredibility {
+	sources := newCredibilitySources(data)
+	result := &ProfileCredibility{
+		Skills:      make([]SkillCredibility, 0, len(data.skills)),
+		Experiences: make([]ExperienceCredibility, 0, len(data.experiences)),
+	}
+
+	validated, items := 0, len(data.skills)+len(data.experiences)
+	for _, skill := range data.skills {
+		var evidence []credibilityEvidence
+		for _, v := range data.skillValidations[skill.ID] {
+			evidence = append(evidence, sources.validationEvidence(v.ReferenceLetterID, v.TestimonialID, v.QuoteSnippet))
+		}
+		if len(evidence) > 0 {
+			validated++
+		}
+		result.Skills = append(result.Skills, SkillCredibility{Skill: skill, CredibilityScore: scoreEvidence(evidence, itemCredibilityWeights, now)})
+	}
+	for _, exp := range data.experiences {
+		var evidence []credibilityEvidence
+		for _, v := range data.expValidations[exp.ID] {
+			evidence = append(evidence, sources.validationEvidence(v.ReferenceLetterID, nil, v.QuoteSnippet))
+		}
+		if len(evidence) > 0 {
+			validated++
+		}
+		result.Experiences = append(result.Experiences, ExperienceCredibility{Experience: exp, CredibilityScore: scoreEvidence(evidence, itemCredibilityWeights, now)})
+	}
+
+	evidence := make([]credibilityEvidence, 0, len(data.testimonials))
+	for _, t := range data.testimonials {
+		quote := t.Quote
+		e := sources.testimonialEvidence(t)
+		e.quote = &quote
+		evidence = append(evidence, e)
+	}
+	result.Profile = scoreEvidence(evidence, profileCredibilityWeights, now)
+	if items > 0 && len(evidence) > 0 {
+		coverage := float64(validated) / float64(items)
+		for i := range result.Profile.Factors {
+			if result.Profile.Factors[i].Name == CredibilityFactorCoverage {
+				result.Profile.Factors[i].Value = round2(coverage)
+				result.Profile.Factors[i].Detail = fmt.Sprintf("%d of %d skills and experiences validated", validated, items)
+			}
+		}
+		result.Profile.Score = sumFactors(result.Profile.Factors)
+	}
+	return result
+}
+
+// credibilitySources looks up who stands behind each reference letter.
+type credibilitySources struct {
+	letters         map[uuid.UUID]*domain.ReferenceLetter
+	authors         map[uuid.UUID]*domain.Author
+	testimonials    map[uuid.UUID]*domain.Testimonial
+	letterTestimony map[uuid.UUID][]*domain.Testimonial // keyed by reference letter ID
+}
+
+func newCredibilitySources(data *credibilityData) *credibilitySources {
+	s := &credibilitySources{
+		letters:         make(map[uuid.UUID]*domain.ReferenceLetter, len(data.letters)),
+		authors:         make(map[uuid.UUID]*domain.Author, len(data.authors)),
+		testimonials:    make(map[uuid.UUID]*domain.Testimonial, len(data.testimonials)),
+		letterTestimony: map[uuid.UUID][]*domain.Testimonial{},
+	}
+	for _, l := range data.letters {
+		s.letters[l.ID] = l
+	}
+	for _, a := range data.authors {
+		s.authors[a.ID] = a
+	}
+	for _, t := range data.testimonials {
+		s.testimonials[t.ID] = t
+		if t.ReferenceLetterID != uuid.Nil {
+			s.letterTestimony[t.ReferenceLetterID] = append(s.letterTestimony[t.ReferenceLetterID], t)
+		}
+	}
+	return s
+}
+
+// validationEvidence describes a validation from a letter. Its author is that of the linked
+// testimonial, else of the letter's testimonial with the strongest relationship.
+func (s *credibilitySources) validationEvidence(letterID uuid.UUID, testimonialID *uuid.UUID, quote *string) credibilityEvidence {
+	var testimonial *domain.Testimonial
+	if testimonialID != nil {
+		testimonial = s.testimonials[*testimonialID]
+	}
+	if testimonial == nil {
+		for _, t := range s.letterTestimony[letterID] {
+			if testimonial == nil || relationshipWeight(t.Relationship) > relationshipWeight(testimonial.Relationship) {
+				testimonial = t
+			}
+		}
+	}
+
+	var e credibilityEvidence
+	if testimonial != nil {
+		e = s.testimonialEvidence(testimonial)
+	} else {
+		e = credibilityEvidence{sourceID: letterID, relationship: domain.TestimonialRelationshipOther}
+		if letter := s.letters[letterID]; letter != nil {
+			e.writtenAt = letterDate(letter)
+			e.sourceText = letter.RawText
+			if letter.AuthorName != nil {
+				e.authorKey = normalize.FoldText(strings.TrimSpace(*letter.AuthorName))
+			}
+			if letter.Organization != nil {
+				e.companyKey = normalize.CompanyKey(*letter.Organization)
+			}
+		}
+		if e.authorKey == "" {
+			e.authorKey = letterID.String()
+		}
+	}
+	e.sourceID = letterID
+	e.quote = quote
+	return e
+}
+
+// testimonialEvidence describes a testimonial's author and source, without a quote.
+func (s *credibilitySources) testimonialEvidence(t *domain.Testimonial) credibilityEvidence {
+	e := credibilityEvidence{relationship: t.Relationship, writtenAt: t.CreatedAt}
+	switch {
+	case t.ReferenceLetterID != uuid.Nil:
+		e.sourceID = t.ReferenceLetterID
+		if letter := s.letters[t.ReferenceLetterID]; letter != nil {
+			e.writtenAt = letterDate(letter)
+			e.sourceText = letter.RawText
+		}
+	case t.CredentialDocumentID != nil:
+		e.sourceID = *t.CredentialDocumentID
+	}
+
+	var company *string
+	if t.AuthorID != nil {
+		if author := s.authors[*t.AuthorID]; author != nil {
+			e.authorKey = author.ID.String()
+			if author.CompanyID != nil {
+				e.companyKey = author.CompanyID.String()
+			}
+			company = author.Company
+		}
+	}
+	if e.authorKey == "" && t.AuthorName != nil {
+		e.authorKey = normalize.FoldText(strings.TrimSpace(*t.AuthorName))
+	}
+	if e.authorKey == "" {
+		e.authorKey = e.sourceID.String()
+	}
+	if company == nil {
+		company = t.AuthorCompany
+	}
+	if e.companyKey == "" && company != nil {
+		e.companyKey = normalize.CompanyKey(*company)
+	}
+	return e
+}
+
+// letterDate returns when a letter was written, or uploaded if the letter is undated.
+func letterDate(letter *domain.ReferenceLetter) time.Time {
+	if letter.DateWritten != nil {
+		return *letter.DateWritten
+	}
+	return letter.CreatedAt
+}
+
+// scoreEvidence combines the factors of the evidence with the given weights. Evidence is
+// grouped by source, so that several validations from one letter count as one letter for
+// relationship and recency.
+func scoreEvidence(evidence []credibilityEvidence, weights []factorWeight, now time.Time) CredibilityScore {
+	factors := make([]CredibilityFactor, len(weights))
+	for i, w := range weights {
+		factors[i] = CredibilityFactor{Name: w.name, Weight: w.weight, Detail: "no evidence"}
+	}
+	if len(evidence) == 0 {
+		return CredibilityScore{Factors: factors}
+	}
+
+	sourceWeights := map[uuid.UUID]float64{}
+	sourceDates := map[uuid.UUID]time.Time{}
+	authors := map[string]bool{}
+	companies := map[string]bool{}
+	grounding, grounded := 0.0, 0
+	for _, e := range evidence {
+		if w := relationshipWeight(e.relationship); w > sourceWeights[e.sourceID] {
+			sourceWeights[e.sourceID] = w
+		}
+		if e.writtenAt.After(sourceDates[e.sourceID]) {
+			sourceDates[e.sourceID] = e.writtenAt
+		}
+		authors[e.authorKey] = true
+		if e.companyKey != "" {
+			companies[e.companyKey] = true
+		}
+		g := quoteGrounding(e.quote, e.sourceText)
+		grounding += g
+		if g == 1 {
+			grounded++
+		}
+	}
+
+	relationship := 0.0
+	for _, w := range sourceWeights {
+		relationship += w
+	}
+	recency := 0.0
+	var newest time.Time
+	for _, d := range sourceDates {
+		recency += ageWeight(d, now)
+		if d.After(newest) {
+			newest = d
+		}
+	}
+
+	for i := range factors {
+		f := &factors[i]
+		switch f.Name {
+		case CredibilityFactorRelationship:
+			f.Value = relationship / float64(len(sourceWeights))
+			f.Detail = fmt.Sprintf("%d sources, mean relationship weight %.2f", len(sourceWeights), f.Value)
+		case CredibilityFactorAuthorDiversity:
+			f.Value = (2 - math.Pow(0.5, float64(len(authors))) - math.Pow(0.5, float64(len(companies)))) / 2
+			f.Detail = fmt.Sprintf("%d authors at %d companies", len(authors), len(companies))
+		case CredibilityFactorRecency:
+			f.Value = recency / float64(len(sourceDates))
+			f.Detail = "newest source from " + newest.Format("2006-01")
+		case CredibilityFactorGrounding:
+			f.Value = grounding / float64(len(evidence))
+			f.Detail = fmt.Sprintf("%d of %d quotes found in the source text", grounded, len(evidence))
+		case CredibilityFactorCoverage:
+			f.Detail = "no validations"
+		}
+		f.Value = round2(f.Value)
+	}
+	return CredibilityScore{Score: sumFactors(factors), Factors: factors, EvidenceCount: len(evidence)}
+}
+
+// ageWeight halves every credibilityHalfLifeYears since t.
+func ageWeight(t time.Time, now time.Time) float64 {
+	years := now.Sub(t).Hours() / 24 / 365.25
+	if years < 0 {
+		years = 0
+	}
+	return math.Pow(0.5, years/credibilityHalfLifeYears)
+}
+
+// quoteGrounding rates how well a quote is tied to its source text; see
+// CredibilityFactorGrounding.
+func quoteGrounding(quote, sourceText *string) float64 {
+	if quote == nil || strings.TrimSpace(*quote) == "" {
+		return 0
+	}
+	if sourceText == nil || strings.TrimSpace(*sourceText) == "" {
+		return 0.5
+	}
+	q := groundingText(strings.TrimRight(strings.TrimSpace(*quote), ".…"))
+	if q != "" && strings.Contains(groundingText(*sourceText), q) {
+		return 1
+	}
+	return 0.25
+}
+
+// groundingText folds text and collapses whitespace and quotation marks, so that a quote
+// matches its source across line breaks and typographic differences.
+func groundingText(s string) string {
+	s = strings.NewReplacer("“", "", "”", "", "\"", "", "‘", "'", "’", "'").Replace(normalize.FoldText(s))
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func sumFactors(factors []CredibilityFactor) float64 {
+	score := 0.0
+	for _, f := range factors {
+		score += f.Value * f.Weight
+	}
+	return round2(score)
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+func factorValue(t *testing.T, score CredibilityScore, name CredibilityFactorName) float64 {
+	t.Helper()
+	for _, f := range score.Factors {
+		if f.Name == name {
+			return f.Value
+		}
+	}
+	t.Fatalf("score has no %s factor", name)
+	return 0
+}
+
+func TestScoreCredibility(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	written := now.AddDate(-3, 0, 0)
+	text := "Jane led our move to Kubernetes.\nShe is a superb engineer."
+
+	managerLetter := &domain.ReferenceLetter{ID: uuid.New(), DateWritten: &written, RawText: &text}
+	peerLetter := &domain.ReferenceLetter{ID: uuid.New(), CreatedAt: now}
+	acme, globex := uuid.New(), uuid.New()
+	boss := &domain.Author{ID: uuid.New(), Name: "Bob", CompanyID: &acme}
+	peer := &domain.Author{ID: uuid.New(), Name: "Pat", CompanyID: &globex}
+	bossTestimonial := &domain.Testimonial{ID: uuid.New(), ReferenceLetterID: managerLetter.ID, AuthorID: &boss.ID, Relationship: domain.TestimonialRelationshipManager, Quote: "She is a superb engineer."}
+	peerTestimonial := &domain.Testimonial{ID: uuid.New(), ReferenceLetterID: peerLetter.ID, AuthorID: &peer.ID, Relationship: domain.TestimonialRelationshipPeer, Quote: "A joy to work with."}
+
+	kubernetes := &domain.ProfileSkill{ID: uuid.New(), Name: "Kubernetes"}
+	golang := &domain.ProfileSkill{ID: uuid.New(), Name: "Go"}
+	role := &domain.ProfileExperience{ID: uuid.New(), Title: "Engineer"}
+
+	data := &credibilityData{
+		skills:       []*domain.ProfileSkill{kubernetes, golang},
+		experiences:  []*domain.ProfileExperience{role},
+		letters:      []*domain.ReferenceLetter{managerLetter, peerLetter},
+		testimonials: []*domain.Testimonial{bossTestimonial, peerTestimonial},
+		authors:      []*domain.Author{boss, peer},
+		skillValidations: map[uuid.UUID][]*domain.SkillValidation{
+			kubernetes.ID: {
+				{ReferenceLetterID: managerLetter.ID, QuoteSnippet: stringPtr("Jane led our move to Kubernetes...")},
+				{ReferenceLetterID: peerLetter.ID, TestimonialID: &peerTestimonial.ID},
+			},
+		},
+		expValidations: map[uuid.UUID][]*domain.ExperienceValidation{},
+	}
+
+	result := scoreCredibility(data, now)
+
+	k := result.Skills[0]
+	if k.EvidenceCount != 2 {
+		t.Fatalf("expected 2 pieces of evidence for Kubernetes, got %d", k.EvidenceCount)
+	}
+	// Manager 1.0 and peer 0.7
+	if v := factorValue(t, k.CredibilityScore, CredibilityFactorRelationship); v != 0.85 {
+		t.Errorf("expected relationship 0.85, got %v", v)
+	}
+	// Two authors at two companies: (0.75 + 0.75) / 2
+	if v := factorValue(t, k.CredibilityScore, CredibilityFactorAuthorDiversity); v != 0.75 {
+		t.Errorf("expected author diversity 0.75, got %v", v)
+	}
+	// A three-year-old letter counts half, a new one fully
+	if v := factorValue(t, k.CredibilityScore, CredibilityFactorRecency); v != 0.75 {
+		t.Errorf("expected recency 0.75, got %v", v)
+	}
+	// One quote found in the letter text, one validation without a quote
+	if v := factorValue(t, k.CredibilityScore, CredibilityFactorGrounding); v != 0.5 {
+		t.Errorf("expected grounding 0.5, got %v", v)
+	}
+	// 0.35*0.85 + 0.25*0.75 + 0.2*0.75 + 0.2*0.5
+	if k.Score != 0.74 {
+		t.Errorf("expected a score of 0.74, got %v", k.Score)
+	}
+
+	if g := result.Skills[1]; g.Score != 0 || g.EvidenceCount != 0 || len(g.Factors) != len(itemCredibilityWeights) {
+		t.Errorf("expected an unvalidated skill to score 0 with all factors, got %+v", g.CredibilityScore)
+	}
+	if e := result.Experiences[0]; e.Score != 0 {
+		t.Errorf("expected an unvalidated experience to score 0, got %v", e.Score)
+	}
+
+	// One of three items validated
+	if v := factorValue(t, result.Profile, CredibilityFactorCoverage); v != 0.33 {
+		t.Errorf("expected coverage 0.33, got %v", v)
+	}
+	if result.Profile.EvidenceCount != 2 || result.Profile.Score <= 0 || result.Profile.Score >= k.Score {
+		t.Errorf("unexpected profile score %+v", result.Profile)
+	}
+}
+
+func TestScoreCredibilityWeightsRelationships(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	score := func(rel domain.TestimonialRelationship) float64 {
+		letter := &domain.ReferenceLetter{ID: uuid.New(), CreatedAt: now}
+		testimonial := &domain.Testimonial{ID: uuid.New(), ReferenceLetterID: letter.ID, Relationship: rel, AuthorName: stringPtr("Sam")}
+		skill := &domain.ProfileSkill{ID: uuid.New()}
+		return scoreCredibility(&credibilityData{
+			skills:           []*domain.ProfileSkill{skill},
+			letters:          []*domain.ReferenceLetter{letter},
+			testimonials:     []*domain.Testimonial{testimonial},
+			skillValidations: map[uuid.UUID][]*domain.SkillValidation{skill.ID: {{ReferenceLetterID: letter.ID}}},
+		}, now).Skills[0].Score
+	}
+
+	manager := score(domain.TestimonialRelationshipManager)
+	mentor := score(domain.TestimonialRelationshipMentor)
+	peer := score(domain.TestimonialRelationshipPeer)
+	colleague := score(domain.TestimonialRelationshipColleague)
+	other := score(domain.TestimonialRelationshipOther)
+	if !(manager > mentor && mentor > peer && peer > colleague && colleague > other) {
+		t.Errorf("expected manager > mentor > peer > colleague > other, got %v %v %v %v %v", manager, mentor, peer, colleague, other)
+	}
+}
+
+func TestQuoteGrounding(t *testing.T) {
+	text := "She “consistently” delivered\nahead of schedule."
+	tests := []struct {
+		name  string
+		quote *string
+		text  *string
+		want  float64
+	}{
+		{"found across line break and quotes", stringPtr("She consistently delivered ahead of schedule."), &text, 1},
+		{"paraphrased", stringPtr("She was always early."), &text, 0.25},
+		{"no source text", stringPtr("She was always early."), nil, 0.5},
+		{"no quote", nil, &text, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := quoteGrounding(tc.quote, tc.text); got != tc.want {
+				t.Errorf("quoteGrounding() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+)
+
+// ErrEndBeforeStart is returned when a date range ends before it starts.
+var ErrEndBeforeStart = errors.New("end date is before start date")
+
+// SetExperienceDates parses the experience's StartDate and EndDate into Start and End.
+// Dates that cannot be parsed are left unknown. The parsed dates are always set; the
+// error reports ErrEndBeforeStart so that callers validating user input can reject it.
+func SetExperienceDates(exp *domain.ProfileExperience) error {
+	exp.Start = parseDate(exp.StartDate)
+	exp.End = parseDate(exp.EndDate)
+	if exp.Dates().EndsBeforeStart() {
+		return ErrEndBeforeStart
+	}
+	return nil
+}
+
+// SetEducationDates parses the education entry's StartDate and EndDate into Start and End,
+// like SetExperienceDates.
+func SetEducationDates(edu *domain.ProfileEducation) error {
+	edu.Start = parseDate(edu.StartDate)
+	edu.End = parseDate(edu.EndDate)
+	if edu.Dates().EndsBeforeStart() {
+		return ErrEndBeforeStart
+	}
+	return nil
+}
+
+// BackfillProfileDates parses the dates of experiences and education entries that were
+// stored before dates were parsed, with the same parser as new entries. It runs at startup;
+// entries whose dates still cannot be parsed are left for the next run. Returns how many
+// entries were updated.
+func BackfillProfileDates(ctx context.Context, expRepo domain.ProfileExperienceRepository, eduRepo domain.ProfileEducationRepository) (int, error) {
+	experiences, err := expRepo.GetWithUnparsedDates(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get experiences with unparsed dates: %w", err)
+	}
+	updated := 0
+	for _, exp := range experiences {
+		start, end := exp.Start, exp.End
+		_ = SetExperienceDates(exp)
+		if exp.Start == start && exp.End == end {
+			continue
+		}
+		if err := expRepo.Update(ctx, exp); err != nil {
+			return updated, fmt.Errorf("failed to update experience %s: %w", exp.ID, err)
+		}
+		updated++
+	}
+
+	educations, err := eduRepo.GetWithUnparsedDates(ctx)
+	if err != nil {
+		return updated, fmt.Errorf("failed to get education with unparsed dates: %w", err)
+	}
+	for _, edu := range educations {
+		start, end := edu.Start, edu.End
+		_ = SetEducationDates(edu)
+		if edu.Start == start && edu.End == end {
+			continue
+		}
+		if err := eduRepo.Update(ctx, edu); err != nil {
+			return updated, fmt.Errorf("failed to update education %s: %w", edu.ID, err)
+		}
+		updated++
+	}
+	return updated, nil
+}
+
+func parseDate(s *string) domain.PartialDate {
+	if s == nil {
+		return domain.PartialDate{}
+	}
+	date, _ := normalize.ParseDate(*s)
+	return date
+}
+
+// SortByRecency orders entries most recent first: by the month each ends, an ongoing entry
+// ending now, then by the month it starts. Entries without usable dates keep their
+// relative order after the dated ones.
+func SortByRecency[T any](items []T, dates func(T) domain.DateRange, now time.Time) {
+	keys := make([]recencyKey, len(items))
+	for i, item := range items {
+		keys[i] = newRecencyKey(dates(item), now)
+	}
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return keys[order[a]].moreRecent(keys[order[b]]) })
+	sorted := make([]T, len(items))
+	for i, j := range order {
+		sorted[i] = items[j]
+	}
+	copy(items, sorted)
+}
+
+// recencyKey holds the months an entry spans, for ordering by recency.
+type recencyKey struct {
+	first, last int
+	ok          bool
+}
+
+func newRecencyKey(dates domain.DateRange, now time.Time) recencyKey {
+	first, last, ok := dates.Months(now)
+	return recencyKey{first: first, last: last, ok: ok}
+}
+
+// moreRecent reports whether k sorts before other in SortByRecency.
+func (k recencyKey) moreRecent(other recencyKey) bool {
+	switch {
+	case k.ok != other.ok:
+		return k.ok
+	case !k.ok:
+		return false
+	case k.last != other.last:
+		return k.last > other.last
+	}
+	return k.first > other.first
+}
+
+// PlaceExperiences gives experiences that are about to be created, or whose dates changed,
+// the display order of their place by recency on their profile: each goes before the first
+// of the profile's other experiences that is older. The other experiences keep their order,
+// which may be one the user chose, and are shifted down; those whose display order changes
+// are updated. All experiences must belong to one profile.
+func PlaceExperiences(ctx context.Context, repo domain.ProfileExperienceRepository, placed []*domain.ProfileExperience) error {
+	if len(placed) == 0 {
+		return nil
+	}
+	existing, err := repo.GetByProfileID(ctx, placed[0].ProfileID)
+	if err != nil {
+		return fmt.Errorf("failed to get profile experiences: %w", err)
+	}
+	for _, exp := range placeByRecency(existing, placed, experienceID, experienceDisplayOrder, (*domain.ProfileExperience).Dates, time.Now()) {
+		if err := repo.Update(ctx, exp); err != nil {
+			return fmt.Errorf("failed to update experience display order: %w", err)
+		}
+	}
+	return nil
+}
+
+// PlaceEducations gives education entries their display order by recency, like
+// PlaceExperiences.
+func PlaceEducations(ctx context.Context, repo domain.ProfileEducationRepository, placed []*domain.ProfileEducation) error {
+	if len(placed) == 0 {
+		return nil
+	}
+	existing, err := repo.GetByProfileID(ctx, placed[0].ProfileID)
+	if err != nil {
+		return fmt.Errorf("failed to get profile education: %w", err)
+	}
+	for _, edu := range placeByRecency(existing, placed, educationID, educationDisplayOrder, (*domain.ProfileEducation).Dates, time.Now()) {
+		if err := repo.Update(ctx, edu); err != nil {
+			return fmt.Errorf("failed to update education display order: %w", err)
+		}
+	}
+	return nil
+}
+
+// placeByRecency merges placed into existing, which is in display order, and sets the
+// display orders of the result to 0, 1, ... Each placed entry, most recent first, goes
+// before the first entry already in the list that is older; an entry of existing with a
+// placed entry's ID is replaced by it. Returns the entries of existing whose display order
+// changed.
+func placeByRecency[T any](existing, placed []T, id func(T) uuid.UUID, displayOrder func(T) *int, dates func(T) domain.DateRange, now time.Time) []T {
+	isPlaced := make(map[uuid.UUID]bool, len(placed))
+	for _, item := range placed {
+		isPlaced[id(item)] = true
+	}
+	var list []T
+	var keys []recencyKey
+	for _, item := range existing {
+		if !isPlaced[id(item)] {
+			list = append(list, item)
+			keys = append(keys, newRecencyKey(dates(item), now))
+		}
+	}
+	placed = append([]T(nil), placed...)
+	SortByRecency(placed, dates, now)
+	for _, item := range placed {
+		key := newRecencyKey(dates(item), now)
+		at := len(list)
+		for i, k := range keys {
+			if key.moreRecent(k) {
+				at = i
+				break
+			}
+		}
+		list = append(list[:at], append([]T{item}, list[at:]...)...)
+		keys = append(keys[:at], append([]recencyKey{key}, keys[at:]...)...)
+	}
+
+	var changed []T
+	for i, item := range list {
+		order := displayOrder(item)
+		if *order == i {
+			continue
+		}
+		*order = i
+		if !isPlaced[id(item)] {
+			changed = append(changed, item)
+		}
+	}
+	return changed
+}
+
+func experienceID(e *domain.ProfileExperience) uuid.UUID      { return e.ID }
+func experienceDisplayOrder(e *domain.ProfileExperience) *int { return &e.DisplayOrder }
+func educationID(e *domain.ProfileEducation) uuid.UUID        { return e.ID }
+func educationDisplayOrder(e *domain.ProfileEducation) *int   { return &e.DisplayOrder }
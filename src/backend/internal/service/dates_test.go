@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+func TestSetExperienceDates(t *testing.T) {
+	exp := &domain.ProfileExperience{StartDate: stringPtr("Spring 2018"), EndDate: stringPtr("03/2019")}
+	if err := SetExperienceDates(exp); err != nil {
+		t.Fatalf("SetExperienceDates returned error: %v", err)
+	}
+	if exp.Start != (domain.PartialDate{Year: 2018, Month: 3, Precision: domain.DatePrecisionSeason}) {
+		t.Errorf("unexpected start %+v", exp.Start)
+	}
+	if exp.End != (domain.PartialDate{Year: 2019, Month: 3, Precision: domain.DatePrecisionMonth}) {
+		t.Errorf("unexpected end %+v", exp.End)
+	}
+
+	// Unparseable dates are cleared rather than left stale.
+	exp.StartDate = stringPtr("a while ago")
+	if err := SetExperienceDates(exp); err != nil {
+		t.Fatalf("SetExperienceDates returned error: %v", err)
+	}
+	if !exp.Start.IsZero() {
+		t.Errorf("expected an unknown start, got %+v", exp.Start)
+	}
+
+	// The dates are set even when the range is rejected.
+	exp.StartDate = stringPtr("2020")
+	err := SetExperienceDates(exp)
+	if !errors.Is(err, ErrEndBeforeStart) {
+		t.Fatalf("expected ErrEndBeforeStart, got %v", err)
+	}
+	if exp.Start.Year != 2020 {
+		t.Errorf("expected the start to be parsed, got %+v", exp.Start)
+	}
+
+	// A current role is never rejected for its end date.
+	exp.IsCurrent = true
+	if err := SetExperienceDates(exp); err != nil {
+		t.Errorf("expected a current role to be accepted, got %v", err)
+	}
+}
+
+func TestSetEducationDates(t *testing.T) {
+	edu := &domain.ProfileEducation{StartDate: stringPtr("2019"), EndDate: stringPtr("Dec 2018")}
+	if err := SetEducationDates(edu); !errors.Is(err, ErrEndBeforeStart) {
+		t.Fatalf("expected ErrEndBeforeStart, got %v", err)
+	}
+	edu.EndDate = stringPtr("2019")
+	if err := SetEducationDates(edu); err != nil {
+		t.Errorf("expected a one-year degree to be accepted, got %v", err)
+	}
+}
+
+func TestSortByRecency(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	entries := []*domain.ProfileExperience{
+		{Title: "undated"},
+		{Title: "old", StartDate: stringPtr("2010"), EndDate: stringPtr("2012")},
+		{Title: "current", StartDate: stringPtr("2022-01"), IsCurrent: true},
+		{Title: "also undated", StartDate: stringPtr("someday")},
+		{Title: "recent", StartDate: stringPtr("2018-01"), EndDate: stringPtr("2021-12")},
+		{Title: "overlapping", StartDate: stringPtr("2019-06"), EndDate: stringPtr("2021-12")},
+		{Title: "newer current", StartDate: stringPtr("2024"), EndDate: stringPtr("Present")},
+	}
+	for _, exp := range entries {
+		_ = SetExperienceDates(exp)
+	}
+
+	SortByRecency(entries, (*domain.ProfileExperience).Dates, now)
+
+	want := []string{"newer current", "current", "overlapping", "recent", "old", "undated", "also undated"}
+	for i, exp := range entries {
+		if exp.Title != want[i] {
+			t.Errorf("position %d: expected %q, got %q", i, want[i], exp.Title)
+		}
+	}
+}
+
+func TestPlaceExperiences(t *testing.T) {
+	ctx := context.Background()
+	profileID := uuid.New()
+	repo := newMockProfileExperienceRepository()
+	experience := func(title, start, end string) *domain.ProfileExperience {
+		exp := &domain.ProfileExperience{ID: uuid.New(), ProfileID: profileID, Title: title, StartDate: stringPtr(start), EndDate: stringPtr(end)}
+		_ = SetExperienceDates(exp)
+		return exp
+	}
+	create := func(exp *domain.ProfileExperience) {
+		if err := PlaceExperiences(ctx, repo, []*domain.ProfileExperience{exp}); err != nil {
+			t.Fatalf("PlaceExperiences failed: %v", err)
+		}
+		_ = repo.Create(ctx, exp)
+	}
+	titles := func() []string {
+		experiences, _ := repo.GetByProfileID(ctx, profileID)
+		var titles []string
+		for _, exp := range experiences {
+			titles = append(titles, exp.Title)
+		}
+		return titles
+	}
+
+	// Entries created one at a time end up most recent first
+	create(experience("first job", "2012", "2015"))
+	create(experience("current job", "2020-03", "Present"))
+	create(experience("second job", "2015-06", "2019-12"))
+	create(experience("side project", "", ""))
+	if got, want := titles(), []string{"current job", "second job", "first job", "side project"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	// A chosen order is kept; new entries go before the first older entry
+	experiences, _ := repo.GetByProfileID(ctx, profileID)
+	experiences[0].DisplayOrder, experiences[2].DisplayOrder = 2, 0
+	create(experience("internship", "2011", "2011"))
+	if got, want := titles(), []string{"first job", "second job", "current job", "internship", "side project"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestBackfillProfileDates(t *testing.T) {
+	ctx := context.Background()
+	expRepo := newMockProfileExperienceRepository()
+	eduRepo := newMockProfileEducationRepository()
+	// Stored before dates were parsed: only the dates as written are set
+	since := &domain.ProfileExperience{ID: uuid.New(), StartDate: stringPtr("since March 2019"), EndDate: stringPtr("heute")}
+	german := &domain.ProfileExperience{ID: uuid.New(), StartDate: stringPtr("15. März 2019")}
+	unreadable := &domain.ProfileExperience{ID: uuid.New(), StartDate: stringPtr("a while ago")}
+	for _, exp := range []*domain.ProfileExperience{since, german, unreadable} {
+		expRepo.experiences[exp.ID] = exp
+	}
+	edu := &domain.ProfileEducation{ID: uuid.New(), StartDate: stringPtr("WS 2015/16"), EndDate: stringPtr("2019")}
+	eduRepo.educations[edu.ID] = edu
+
+	updated, err := BackfillProfileDates(ctx, expRepo, eduRepo)
+	if err != nil {
+		t.Fatalf("BackfillProfileDates failed: %v", err)
+	}
+	if updated != 3 {
+		t.Errorf("expected 3 entries updated, got %d", updated)
+	}
+	if since.Start != (domain.PartialDate{Year: 2019, Month: 3, Precision: domain.DatePrecisionMonth}) || since.End.Precision != domain.DatePrecisionPresent {
+		t.Errorf("expected March 2019 to present, got %+v to %+v", since.Start, since.End)
+	}
+	if german.Start.Year != 2019 || german.Start.Month != 3 {
+		t.Errorf("expected March 2019, got %+v", german.Start)
+	}
+	if !unreadable.Start.IsZero() {
+		t.Errorf("expected an unreadable date to stay unparsed, got %+v", unreadable.Start)
+	}
+	if edu.Start.Year != 2015 || edu.End.Year != 2019 {
+		t.Errorf("expected 2015 to 2019, got %+v to %+v", edu.Start, edu.End)
+	}
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+)
+
+// DuplicateReason is one way in which entries of a duplicate cluster agree.
+type DuplicateReason string
+
+// Duplicate reasons.
+const (
+	DuplicateReasonSameCompany      DuplicateReason = "same_company"
+	DuplicateReasonSimilarCompany   DuplicateReason = "similar_company"
+	DuplicateReasonSameInstitution  DuplicateReason = "same_institution"
+	DuplicateReasonSimilarTitle     DuplicateReason = "similar_title"
+	DuplicateReasonSimilarDegree    DuplicateReason = "similar_degree"
+	DuplicateReasonSameStartDate    DuplicateReason = "same_start_date"
+	DuplicateReasonOverlappingDates DuplicateReason = "overlapping_dates"
+)
+
+// DuplicateCluster is a group of experiences or education entries that look like the same
+// entry listed more than once, for example after importing two resumes. Exactly one of
+// Experiences and Educations is set, in display order. Target is the entry with the most
+// detail, the one to keep when merging. Reasons lists how the entries agree, in the order
+// of the constants above.
+type DuplicateCluster struct {
+	EntityType  domain.ProfileChangeEntity
+	Experiences []*domain.ProfileExperience
+	Educations  []*domain.ProfileEducation
+	Target      uuid.UUID
+	Reasons     []DuplicateReason
+}
+
+// DedupService finds duplicate entries on a profile.
+type DedupService struct {
+	expRepo   domain.ProfileExperienceRepository
+	eduRepo   domain.ProfileEducationRepository
+	aliasRepo domain.CompanyAliasRepository
+}
+
+// NewDedupService creates a new DedupService.
+func NewDedupService(
+	expRepo domain.ProfileExperienceRepository,
+	eduRepo domain.ProfileEducationRepository,
+	aliasRepo domain.CompanyAliasRepository,
+) *DedupService {
+	return &DedupService{expRepo: expRepo, eduRepo: eduRepo, aliasRepo: aliasRepo}
+}
+
+// Suggestions clusters the profile's experiences and its education entries into groups of
+// likely duplicates. Experiences are duplicates at the same company, matched through the
+// profile's company aliases, or at companies whose names differ by typos, with start dates that can be the same month and either an
+// agreeing title and dates that overlap or are unknown, or known start dates and
+// overlapping dates. Education entries are duplicates at the same institution with an
+// agreeing degree and start and end dates that can be the same months. Entries linked
+// through a common duplicate share a cluster. Experience clusters come first.
+func (s *DedupService) Suggestions(ctx context.Context, profileID uuid.UUID, now time.Time) ([]*DuplicateCluster, error) {
+	experiences, err := s.expRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experiences: %w", err)
+	}
+	educations, err := s.eduRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get education: %w", err)
+	}
+	matcher, err := LoadCompanyMatcher(ctx, s.aliasRepo, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	var clusters []*DuplicateCluster
+	for _, group := range clusterDuplicates(len(experiences), func(i, j int) []DuplicateReason {
+		return experienceDuplicateReasons(matcher, experiences[i], experiences[j], now)
+	}) {
+		cluster := &DuplicateCluster{EntityType: domain.ProfileChangeEntityExperience, Reasons: group.reasons}
+		best := -1
+		for _, i := range group.members {
+			exp := experiences[i]
+			cluster.Experiences = append(cluster.Experiences, exp)
+			if detail := experienceDetail(exp); detail > best {
+				cluster.Target, best = exp.ID, detail
+			}
+		}
+		clusters = append(clusters, cluster)
+	}
+	for _, group := range clusterDuplicates(len(educations), func(i, j int) []DuplicateReason {
+		return educationDuplicateReasons(educations[i], educations[j], now)
+	}) {
+		cluster := &DuplicateCluster{EntityType: domain.ProfileChangeEntityEducation, Reasons: group.reasons}
+		best := -1
+		for _, i := range group.members {
+			edu := educations[i]
+			cluster.Educations = append(cluster.Educations, edu)
+			if detail := educationDetail(edu); detail > best {
+				cluster.Target, best = edu.ID, detail
+			}
+		}
+		clusters = append(clusters, cluster)
+	}
+	return clusters, nil
+}
+
+// duplicateGroup is a cluster of list indexes, ascending, and the reasons linking them.
+type duplicateGroup struct {
+	members []int
+	reasons []DuplicateReason
+}
+
+// clusterDuplicates groups the indexes 0..n-1 into the connected components of the pairs
+// for which reasons returns any reason. Components of a single index are left out.
+func clusterDuplicates(n int, reasons func(i, j int) []DuplicateReason) []duplicateGroup {
+	parent := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	found := make(map[int]map[DuplicateReason]bool)
+	var pairs [][2]int
+	var pairReasons [][]DuplicateReason
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if r := reasons(i, j); len(r) > 0 {
+				parent[find(j)] = find(i)
+				pairs = append(pairs, [2]int{i, j})
+				pairReasons = append(pairReasons, r)
+			}
+		}
+	}
+	for k, pair := range pairs {
+		root := find(pair[0])
+		if found[root] == nil {
+			found[root] = make(map[DuplicateReason]bool)
+		}
+		for _, r := range pairReasons[k] {
+			found[root][r] = true
+		}
+	}
+
+	var groups []duplicateGroup
+	index := make(map[int]int)
+	for i := 0; i < n; i++ {
+		root := find(i)
+		if found[root] == nil {
+			continue
+		}
+		k, ok := index[root]
+		if !ok {
+			k = len(groups)
+			index[root] = k
+			group := duplicateGroup{}
+			for _, r := range duplicateReasonOrder {
+				if found[root][r] {
+					group.reasons = append(group.reasons, r)
+				}
+			}
+			groups = append(groups, group)
+		}
+		groups[k].members = append(groups[k].members, i)
+	}
+	return groups
+}
+
+var duplicateReasonOrder = []DuplicateReason{
+	DuplicateReasonSameCompany,
+	DuplicateReasonSimilarCompany,
+	DuplicateReasonSameInstitution,
+	DuplicateReasonSimilarTitle,
+	DuplicateReasonSimilarDegree,
+	DuplicateReasonSameStartDate,
+	DuplicateReasonOverlappingDates,
+}
+
+// experienceDuplicateReasons returns how two experiences agree if they look like the same
+// role, nil otherwise.
+func experienceDuplicateReasons(matcher *normalize.CompanyMatcher, a, b *domain.ProfileExperience, now time.Time) []DuplicateReason {
+	company := DuplicateReasonSameCompany
+	if !matcher.Match(a.Company, b.Company) {
+		// Names like "Stripe" and "Strive" are never linked, so they are left to the user
+		if !matcher.NearMatch(a.Company, b.Company) {
+			return nil
+		}
+		company = DuplicateReasonSimilarCompany
+	}
+	if datesDisagree(a.Start, b.Start) {
+		return nil
+	}
+	titleAgrees := normalize.TitlesAgree(a.Title, b.Title)
+	sameStart := !a.Start.IsZero() && !b.Start.IsZero()
+	overlap := overlapMonths(a.Dates(), b.Dates(), now)
+	_, _, aDated := a.Dates().Months(now)
+	_, _, bDated := b.Dates().Months(now)
+
+	switch {
+	case titleAgrees && (overlap > 0 || !aDated || !bDated):
+	case sameStart && overlap > 0:
+	default:
+		return nil
+	}
+
+	reasons := []DuplicateReason{company}
+	if titleAgrees {
+		reasons = append(reasons, DuplicateReasonSimilarTitle)
+	}
+	if sameStart {
+		reasons = append(reasons, DuplicateReasonSameStartDate)
+	}
+	if overlap > 0 {
+		reasons = append(reasons, DuplicateReasonOverlappingDates)
+	}
+	return reasons
+}
+
+// educationDuplicateReasons returns how two education entries agree if they look like the
+// same studies, nil otherwise.
+func educationDuplicateReasons(a, b *domain.ProfileEducation, now time.Time) []DuplicateReason {
+	if !normalize.InstitutionsMatch(a.Institution, b.Institution) || !degreesAgree(a.Degree, b.Degree) ||
+		datesDisagree(a.Start, b.Start) || datesDisagree(a.End, b.End) {
+		return nil
+	}
+	reasons := []DuplicateReason{DuplicateReasonSameInstitution, DuplicateReasonSimilarDegree}
+	if !a.Start.IsZero() && !b.Start.IsZero() {
+		reasons = append(reasons, DuplicateReasonSameStartDate)
+	}
+	if overlapMonths(a.Dates(), b.Dates(), now) > 0 {
+		reasons = append(reasons, DuplicateReasonOverlappingDates)
+	}
+	return reasons
+}
+
+// experienceDetail scores how much an experience says: one point per filled optional
+// field and highlight, plus one per 100 characters of description.
+func experienceDetail(e *domain.ProfileExperience) int {
+	return filledCount(e.Location, e.StartDate, e.EndDate, e.Description) + len(e.Highlights) + textLength(e.Description)/100
+}
+
+// educationDetail scores how much an education entry says, like experienceDetail.
+func educationDetail(e *domain.ProfileEducation) int {
+	return filledCount(e.Field, e.StartDate, e.EndDate, e.Description, e.GPA) + textLength(e.Description)/100
+}
+
+func filledCount(values ...*string) int {
+	n := 0
+	for _, v := range values {
+		if textLength(v) > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func textLength(s *string) int {
+	if s == nil {
+		return 0
+	}
+	return len([]rune(strings.TrimSpace(*s)))
+}
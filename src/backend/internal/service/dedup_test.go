@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+func TestDedupSuggestions(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	profileID := uuid.New()
+	expRepo := newMockProfileExperienceRepository()
+	eduRepo := newMockProfileEducationRepository()
+
+	experience := func(company, title, start, end string) *domain.ProfileExperience {
+		exp := newDatedExperience(t, title, start, end)
+		exp.ProfileID, exp.Company = profileID, company
+		exp.DisplayOrder = len(expRepo.experiences)
+		expRepo.experiences[exp.ID] = exp
+		return exp
+	}
+	experience("Acme Corp.", "Software Engineer", "2018-01", "2020-12")
+	second := experience("ACME Corporation", "Software Engineer II", "Jan 2018", "")
+	experience("Acme", "Backend Developer", "2018-01", "2019-06")
+	promotion := experience("Acme", "Engineering Manager", "2021-01", "Present")
+	experience("Globex", "Software Engineer", "2018-01", "2020-12")
+	second.Description = stringPtr("Built the billing platform")
+	second.Highlights = []string{"Launched invoicing"}
+
+	education := func(institution, degree, end string) *domain.ProfileEducation {
+		edu := &domain.ProfileEducation{ID: uuid.New(), ProfileID: profileID, Institution: institution, Degree: degree, EndDate: stringPtr(end)}
+		if err := SetEducationDates(edu); err != nil {
+			t.Fatalf("invalid education dates: %v", err)
+		}
+		eduRepo.educations[edu.ID] = edu
+		return edu
+	}
+	education("MIT", "Bachelor of Science in Computer Science", "2017")
+	education("Massachusetts Institute of Technology", "Bachelor of Science", "2017")
+	education("MIT", "Master of Science", "2019")
+
+	svc := NewDedupService(expRepo, eduRepo, newMockCompanyAliasRepository())
+	clusters, err := svc.Suggestions(ctx, profileID, now)
+	if err != nil {
+		t.Fatalf("Suggestions failed: %v", err)
+	}
+	if len(clusters) != 2 {
+		t.Fatalf("expected an experience and an education cluster, got %d", len(clusters))
+	}
+
+	roles := clusters[0]
+	if roles.EntityType != domain.ProfileChangeEntityExperience || len(roles.Experiences) != 3 {
+		t.Fatalf("expected the three 2018 Acme roles in one cluster, got %+v", roles)
+	}
+	for _, exp := range roles.Experiences {
+		if exp.ID == promotion.ID {
+			t.Error("expected the later role at the same company to be left out")
+		}
+	}
+	if roles.Target != second.ID {
+		t.Errorf("expected the role with a description to be the suggested target")
+	}
+	want := []DuplicateReason{DuplicateReasonSameCompany, DuplicateReasonSimilarTitle, DuplicateReasonSameStartDate, DuplicateReasonOverlappingDates}
+	if len(roles.Reasons) != len(want) {
+		t.Fatalf("expected reasons %v, got %v", want, roles.Reasons)
+	}
+	for i := range want {
+		if roles.Reasons[i] != want[i] {
+			t.Errorf("expected reasons %v, got %v", want, roles.Reasons)
+		}
+	}
+
+	studies := clusters[1]
+	if studies.EntityType != domain.ProfileChangeEntityEducation || len(studies.Educations) != 2 {
+		t.Fatalf("expected the two bachelor entries in one cluster, got %+v", studies)
+	}
+}
+
+func TestDedupSuggestionsFlagSimilarCompanies(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	profileID := uuid.New()
+	expRepo := newMockProfileExperienceRepository()
+
+	for _, company := range []string{"Stripe", "Strive"} {
+		exp := newDatedExperience(t, "Software Engineer", "2019-03", "2021-08")
+		exp.ProfileID, exp.Company = profileID, company
+		expRepo.experiences[exp.ID] = exp
+	}
+
+	svc := NewDedupService(expRepo, newMockProfileEducationRepository(), newMockCompanyAliasRepository())
+	clusters, err := svc.Suggestions(ctx, profileID, now)
+	if err != nil {
+		t.Fatalf("Suggestions failed: %v", err)
+	}
+	if len(clusters) != 1 || len(clusters[0].Experiences) != 2 {
+		t.Fatalf("expected the two roles in one cluster, got %+v", clusters)
+	}
+	if reasons := clusters[0].Reasons; len(reasons) == 0 || reasons[0] != DuplicateReasonSimilarCompany {
+		t.Errorf("expected the companies to be flagged as similar, got %v", reasons)
+	}
+}
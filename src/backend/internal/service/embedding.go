@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+const (
+	// embedBatchSize is the most texts sent to the embedder in one request.
+	embedBatchSize = 64
+
+	// defaultEvidenceSearchLimit and maxEvidenceSearchLimit bound the results of a search.
+	defaultEvidenceSearchLimit = 10
+	maxEvidenceSearchLimit     = 50
+)
+
+// EmbedResult counts what embedding a profile's evidence did.
+type EmbedResult struct {
+	Embedded  int
+	Unchanged int
+	Removed   int
+}
+
+// EmbeddingService keeps embeddings of a profile's evidence — its testimonials, the quotes
+// validating its skills and its experience descriptions and highlights — and searches them
+// by meaning.
+// It also keeps embeddings of the profile's skill names for matching skill mentions.
+type EmbeddingService struct {
+	embedder         domain.Embedder
+	embeddingRepo    domain.EvidenceEmbeddingRepository
+	profileExpRepo   domain.ProfileExperienceRepository
+	profileSkillRepo domain.ProfileSkillRepository
+	testimonialRepo  domain.TestimonialRepository
+	skillValRepo     domain.SkillValidationRepository
+}
+
+// NewEmbeddingService creates a new EmbeddingService.
+func NewEmbeddingService(
+	embedder domain.Embedder,
+	embeddingRepo domain.EvidenceEmbeddingRepository,
+	profileExpRepo domain.ProfileExperienceRepository,
+	profileSkillRepo domain.ProfileSkillRepository,
+	testimonialRepo domain.TestimonialRepository,
+	skillValRepo domain.SkillValidationRepository,
+) *EmbeddingService {
+	return &EmbeddingService{
+		embedder:         embedder,
+		embeddingRepo:    embeddingRepo,
+		profileExpRepo:   profileExpRepo,
+		profileSkillRepo: profileSkillRepo,
+		testimonialRepo:  testimonialRepo,
+		skillValRepo:     skillValRepo,
+	}
+}
+
+// EmbedProfile brings the embeddings of the profile's evidence up to date. Evidence whose
+// text and embedder are unchanged since it was last embedded is skipped, and embeddings of
+// evidence that no longer exists are removed.
+func (s *EmbeddingService) EmbedProfile(ctx context.Context, profileID uuid.UUID) (*EmbedResult, error) {
+	sources, err := s.evidenceSources(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.embeddingRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get evidence embeddings: %w", err)
+	}
+
+	model := s.embedder.Name()
+	stored := make(map[uuid.UUID]*domain.EvidenceEmbedding, len(existing))
+	for _, e := range existing {
+		stored[e.SourceID] = e
+	}
+
+	result := &EmbedResult{}
+	var pending []*domain.EvidenceEmbedding
+	current := make(map[uuid.UUID]bool, len(sources))
+	for _, src := range sources {
+		current[src.SourceID] = true
+		if e := stored[src.SourceID]; e != nil && e.SourceKind == src.SourceKind && e.ContentHash == src.ContentHash && e.Model == model {
+			result.Unchanged++
+			continue
+		}
+		pending = append(pending, src)
+	}
+
+	for start := 0; start < len(pending); start += embedBatchSize {
+		batch := pending[start:min(start+embedBatchSize, len(pending))]
+		texts := make([]string, len(batch))
+		for i, e := range batch {
+			texts[i] = e.Content
+		}
+		vectors, embedErr := s.embedder.Embed(ctx, texts)
+		if embedErr != nil {
+			return nil, fmt.Errorf("failed to embed evidence: %w", embedErr)
+		}
+		if len(vectors) != len(batch) {
+			return nil, fmt.Errorf("embedder returned %d vectors for %d texts", len(vectors), len(batch))
+		}
+		for i, e := range batch {
+			e.Model = model
+			e.Embedding = vectors[i]
+		}
+		if err := s.embeddingRepo.Upsert(ctx, batch); err != nil {
+			return nil, fmt.Errorf("failed to save evidence embeddings: %w", err)
+		}
+		result.Embedded += len(batch)
+	}
+
+	var stale []uuid.UUID
+	for _, e := range existing {
+		if !current[e.SourceID] {
+			stale = append(stale, e.ID)
+		}
+	}
+	if err := s.embeddingRepo.Delete(ctx, stale); err != nil {
+		return nil, fmt.Errorf("failed to delete stale evidence embeddings: %w", err)
+	}
+	result.Removed = len(stale)
+
+	return result, nil
+}
+
+// Search returns the profile's evidence closest in meaning to the query, most similar
+// first. A limit of 0 returns 10 results; at most 50 are returned.
+func (s *EmbeddingService) Search(ctx context.Context, profileID uuid.UUID, query string, limit int) ([]*domain.EvidenceMatch, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, &domain.ValidationError{Field: "query", Message: "query is required", Err: domain.ErrEmptyRequired}
+	}
+	if limit <= 0 {
+		limit = defaultEvidenceSearchLimit
+	}
+	limit = min(limit, maxEvidenceSearchLimit)
+
+	vectors, err := s.embedder.Embed(ctx, []string{query})
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed query: %w", err)
+	}
+	if len(vectors) != 1 {
+		return nil, fmt.Errorf("embedder returned %d vectors for 1 text", len(vectors))
+	}
+
+	matches, err := s.embeddingRepo.Search(ctx, profileID, s.embedder.Name(), vectors[0], limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search evidence: %w", err)
+	}
+	return matches, nil
+}
+
+// skillNameVectors returns the embedding of each skill's name, in order. Stored embeddings
+// of an unchanged name made by the current embedder are reused; the other names are
+// embedded now but not stored, which EmbedProfile does.
+func (s *EmbeddingService) skillNameVectors(ctx context.Context, profileID uuid.UUID, skills []*domain.ProfileSkill) ([][]float32, error) {
+	existing, err := s.embeddingRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get evidence embeddings: %w", err)
+	}
+	model := s.embedder.Name()
+	stored := make(map[uuid.UUID]*domain.EvidenceEmbedding)
+	for _, e := range existing {
+		if e.SourceKind == domain.EvidenceSkillName && e.Model == model {
+			stored[e.SourceID] = e
+		}
+	}
+
+	vectors := make([][]float32, len(skills))
+	var missing []int
+	for i, sk := range skills {
+		if e := stored[sk.ID]; e != nil && e.ContentHash == contentHash(evidenceText(sk.Name)) {
+			vectors[i] = e.Embedding
+		} else {
+			missing = append(missing, i)
+		}
+	}
+	if len(missing) == 0 {
+		return vectors, nil
+	}
+
+	texts := make([]string, len(missing))
+	for j, i := range missing {
+		texts[j] = evidenceText(skills[i].Name)
+	}
+	embedded, err := s.embedder.Embed(ctx, texts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed skill names: %w", err)
+	}
+	if len(embedded) != len(texts) {
+		return nil, fmt.Errorf("embedder returned %d vectors for %d texts", len(embedded), len(texts))
+	}
+	for j, i := range missing {
+		vectors[i] = embedded[j]
+	}
+	return vectors, nil
+}
+
+// evidenceText returns text as it reads: stored quotes are HTML-escaped.
+func evidenceText(text string) string {
+	return strings.TrimSpace(html.UnescapeString(text))
+}
+
+// contentHash returns the hex SHA-256 of text.
+func contentHash(text string) string {
+	hash := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(hash[:])
+}
+
+// evidenceSources returns an unembedded EvidenceEmbedding for each piece of the profile's
+// evidence that has text, and for each skill name.
+func (s *EmbeddingService) evidenceSources(ctx context.Context, profileID uuid.UUID) ([]*domain.EvidenceEmbedding, error) {
+	testimonials, err := s.testimonialRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get testimonials: %w", err)
+	}
+	skills, err := s.profileSkillRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile skills: %w", err)
+	}
+	experiences, err := s.profileExpRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile experiences: %w", err)
+	}
+
+	var sources []*domain.EvidenceEmbedding
+	add := func(kind domain.EvidenceKind, id uuid.UUID, text string) {
+		text = evidenceText(text)
+		if text == "" {
+			return
+		}
+		sources = append(sources, &domain.EvidenceEmbedding{
+			ProfileID:   profileID,
+			SourceKind:  kind,
+			SourceID:    id,
+			Content:     text,
+			ContentHash: contentHash(text),
+		})
+	}
+
+	for _, t := range testimonials {
+		add(domain.EvidenceTestimonial, t.ID, t.Quote)
+	}
+	for _, skill := range skills {
+		add(domain.EvidenceSkillName, skill.ID, skill.Name)
+		validations, valErr := s.skillValRepo.GetByProfileSkillID(ctx, skill.ID)
+		if valErr != nil {
+			return nil, fmt.Errorf("failed to get validations for skill %s: %w", skill.ID, valErr)
+		}
+		for _, v := range validations {
+			if v.QuoteSnippet != nil {
+				add(domain.EvidenceSkillValidation, v.ID, *v.QuoteSnippet)
+			}
+		}
+	}
+	for _, exp := range experiences {
+		add(domain.EvidenceExperience, exp.ID, experienceEvidenceText(exp))
+	}
+	return sources, nil
+}
+
+// experienceEvidenceText returns an experience's description followed by its highlights,
+// one per line. Resume bullets are stored as highlights, so many experiences have no
+// description at all.
+func experienceEvidenceText(exp *domain.ProfileExperience) string {
+	var lines []string
+	if exp.Description != nil && strings.TrimSpace(*exp.Description) != "" {
+		lines = append(lines, strings.TrimSpace(*exp.Description))
+	}
+	for _, h := range exp.Highlights {
+		if h = strings.TrimSpace(h); h != "" {
+			lines = append(lines, h)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// cosineSimilarity returns the cosine of the angle between two vectors, 0 if either is
+// zero or their lengths differ.
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/infrastructure/llm"
+)
+
+type mockEvidenceEmbeddingRepository struct {
+	embeddings map[uuid.UUID]*domain.EvidenceEmbedding // keyed by source ID
+}
+
+func newMockEvidenceEmbeddingRepository() *mockEvidenceEmbeddingRepository {
+	return &mockEvidenceEmbeddingRepository{embeddings: make(map[uuid.UUID]*domain.EvidenceEmbedding)}
+}
+
+func (r *mockEvidenceEmbeddingRepository) GetByProfileID(_ context.Context, profileID uuid.UUID) ([]*domain.EvidenceEmbedding, error) {
+	var result []*domain.EvidenceEmbedding
+	for _, e := range r.embeddings {
+		if e.ProfileID == profileID {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+func (r *mockEvidenceEmbeddingRepository) Upsert(_ context.Context, embeddings []*domain.EvidenceEmbedding) error {
+	for _, e := range embeddings {
+		if existing := r.embeddings[e.SourceID]; existing != nil {
+			e.ID = existing.ID
+		} else if e.ID == uuid.Nil {
+			e.ID = uuid.New()
+		}
+		r.embeddings[e.SourceID] = e
+	}
+	return nil
+}
+
+func (r *mockEvidenceEmbeddingRepository) Delete(_ context.Context, ids []uuid.UUID) error {
+	for _, id := range ids {
+		for sourceID, e := range r.embeddings {
+			if e.ID == id {
+				delete(r.embeddings, sourceID)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *mockEvidenceEmbeddingRepository) Search(_ context.Context, profileID uuid.UUID, model string, vector domain.Vector, limit int) ([]*domain.EvidenceMatch, error) {
+	var matches []*domain.EvidenceMatch
+	for _, e := range r.embeddings {
+		if e.ProfileID == profileID && e.Model == model && e.SourceKind != domain.EvidenceSkillName {
+			matches = append(matches, &domain.EvidenceMatch{Embedding: e, Score: cosineSimilarity(e.Embedding, vector)})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	return matches[:min(len(matches), limit)], nil
+}
+
+// countingEmbedder records how many texts it was asked to embed.
+type countingEmbedder struct {
+	domain.Embedder
+	texts int
+}
+
+func (e *countingEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	e.texts += len(texts)
+	return e.Embedder.Embed(ctx, texts)
+}
+
+func TestEmbeddingService_EmbedProfile(t *testing.T) {
+	ctx := context.Background()
+	profileID := uuid.New()
+	expRepo := newMockProfileExperienceRepository()
+	description := "Built the payments platform &amp; its fraud checks."
+	exp := &domain.ProfileExperience{ID: uuid.New(), ProfileID: profileID, Title: "Engineer", Company: "Acme", Description: &description, Highlights: []string{"Cut chargebacks by 30%"}}
+	expRepo.experiences[exp.ID] = exp
+	bullets := &domain.ProfileExperience{ID: uuid.New(), ProfileID: profileID, Title: "Intern", Company: "Globex", Highlights: []string{"Led the intern hackathon team", " "}}
+	expRepo.experiences[bullets.ID] = bullets
+	skillRepo := newMockProfileSkillRepository()
+	golang := &domain.ProfileSkill{ID: uuid.New(), ProfileID: profileID, Name: "Go"}
+	skillRepo.skills[golang.ID] = golang
+	skillValRepo := newMockSkillValidationRepository()
+	snippet := "wrote our Go services"
+	skillVal := &domain.SkillValidation{ID: uuid.New(), ProfileSkillID: golang.ID, QuoteSnippet: &snippet}
+	skillValRepo.validations[skillVal.ID] = skillVal
+	testimonialRepo := newMockTestimonialRepository()
+	quote := &domain.Testimonial{ID: uuid.New(), ProfileID: profileID, Quote: "A calm and thorough engineer."}
+	testimonialRepo.testimonials[quote.ID] = quote
+	embeddingRepo := newMockEvidenceEmbeddingRepository()
+	embedder := &countingEmbedder{Embedder: llm.NewFakeEmbedder(0)}
+	svc := NewEmbeddingService(embedder, embeddingRepo, expRepo, skillRepo, testimonialRepo, skillValRepo)
+
+	result, err := svc.EmbedProfile(ctx, profileID)
+	if err != nil {
+		t.Fatalf("EmbedProfile() error = %v", err)
+	}
+	if result.Embedded != 5 || len(embeddingRepo.embeddings) != 5 {
+		t.Fatalf("expected 5 embeddings, got %+v", result)
+	}
+	if name := embeddingRepo.embeddings[golang.ID]; name == nil || name.SourceKind != domain.EvidenceSkillName || name.Content != "Go" {
+		t.Errorf("expected the skill name to be embedded, got %+v", name)
+	}
+	stored := embeddingRepo.embeddings[exp.ID]
+	if stored.SourceKind != domain.EvidenceExperience || stored.Content != "Built the payments platform & its fraud checks.\nCut chargebacks by 30%" || stored.Model != "fake/hash-256" || len(stored.Embedding) != 256 {
+		t.Errorf("unexpected experience embedding %+v", stored)
+	}
+	if stored := embeddingRepo.embeddings[bullets.ID]; stored == nil || stored.Content != "Led the intern hackathon team" {
+		t.Errorf("expected the highlights of an experience without description to be embedded, got %+v", stored)
+	}
+
+	// Only changed evidence is embedded again, and removed evidence loses its embedding.
+	quote.Quote = "A calm, thorough engineer."
+	bullets.Highlights = append(bullets.Highlights, "Shipped the onboarding checklist")
+	delete(skillValRepo.validations, skillVal.ID)
+	embedder.texts = 0
+	result, err = svc.EmbedProfile(ctx, profileID)
+	if err != nil {
+		t.Fatalf("EmbedProfile() error = %v", err)
+	}
+	if result.Embedded != 2 || result.Unchanged != 2 || result.Removed != 1 || embedder.texts != 2 {
+		t.Errorf("expected 2 embedded, 2 unchanged and 1 removed, got %+v after %d texts", result, embedder.texts)
+	}
+	if _, ok := embeddingRepo.embeddings[skillVal.ID]; ok {
+		t.Error("expected the removed validation's embedding to be deleted")
+	}
+}
+
+func TestEmbeddingService_Search(t *testing.T) {
+	ctx := context.Background()
+	profileID := uuid.New()
+	testimonialRepo := newMockTestimonialRepository()
+	for _, text := range []string{"She mentored every junior engineer on the team.", "Our cloud costs fell by a third."} {
+		tm := &domain.Testimonial{ID: uuid.New(), ProfileID: profileID, Quote: text}
+		testimonialRepo.testimonials[tm.ID] = tm
+	}
+	embeddingRepo := newMockEvidenceEmbeddingRepository()
+	svc := NewEmbeddingService(llm.NewFakeEmbedder(0), embeddingRepo, newMockProfileExperienceRepository(), newMockProfileSkillRepository(), testimonialRepo, newMockSkillValidationRepository())
+	if _, err := svc.EmbedProfile(ctx, profileID); err != nil {
+		t.Fatalf("EmbedProfile() error = %v", err)
+	}
+
+	matches, err := svc.Search(ctx, profileID, "mentoring junior engineers", 1)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(matches) != 1 || matches[0].Embedding.Content != "She mentored every junior engineer on the team." || matches[0].Score <= 0 {
+		t.Errorf("expected the mentoring testimonial, got %+v", matches)
+	}
+
+	var validationErr *domain.ValidationError
+	if _, err := svc.Search(ctx, profileID, "  ", 0); !errors.As(err, &validationErr) || validationErr.Field != "query" {
+		t.Errorf("expected a query validation error, got %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// ExportFormat is the file format a document is exported to.
+type ExportFormat string
+
+// Export formats.
+const (
+	ExportText ExportFormat = "text"
+	ExportHTML ExportFormat = "html"
+)
+
+// ExportedFile is a document rendered as a file.
+type ExportedFile struct {
+	Filename    string
+	ContentType string
+	Content     string
+}
+
+// ExportDocument is a document to export, such as a cover letter or a profile: a title and
+// sections of paragraphs. Lines within a paragraph are kept as line breaks.
+type ExportDocument struct {
+	Title    string
+	Sections []ExportSection
+}
+
+// ExportSection is a part of an exported document. Its heading may be empty.
+type ExportSection struct {
+	Heading    string
+	Paragraphs []string
+}
+
+var (
+	fileNameUnsafe = regexp.MustCompile(`[^a-z0-9]+`)
+	paragraphBreak = regexp.MustCompile(`\n\s*\n`)
+)
+
+// splitParagraphs splits text into its blank-line-separated paragraphs.
+func splitParagraphs(text string) []string {
+	var paragraphs []string
+	for _, paragraph := range paragraphBreak.Split(text, -1) {
+		if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+			paragraphs = append(paragraphs, paragraph)
+		}
+	}
+	return paragraphs
+}
+
+// ExportFileName turns a title into a file name without extension, falling back to the
+// given name when no letters or digits are left.
+func ExportFileName(title, fallback string) string {
+	name := strings.Trim(fileNameUnsafe.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if name == "" {
+		return fallback
+	}
+	return name
+}
+
+// Render renders the document as plain text, with headings in capitals, or as a
+// standalone HTML document. The name is the file name without extension.
+func (d *ExportDocument) Render(name string, format ExportFormat) *ExportedFile {
+	if format != ExportHTML {
+		var blocks []string
+		for _, section := range d.Sections {
+			if section.Heading != "" {
+				blocks = append(blocks, strings.ToUpper(section.Heading))
+			}
+			blocks = append(blocks, section.Paragraphs...)
+		}
+		return &ExportedFile{Filename: name + ".txt", ContentType: "text/plain; charset=utf-8", Content: strings.Join(blocks, "\n\n") + "\n"}
+	}
+
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>")
+	b.WriteString(html.EscapeString(d.Title))
+	b.WriteString("</title>\n</head>\n<body>\n")
+	for _, section := range d.Sections {
+		if section.Heading != "" {
+			b.WriteString("<h2>" + html.EscapeString(section.Heading) + "</h2>\n")
+		}
+		for _, paragraph := range section.Paragraphs {
+			b.WriteString("<p>" + strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>\n") + "</p>\n")
+		}
+	}
+	b.WriteString("</body>\n</html>\n")
+	return &ExportedFile{Filename: name + ".html", ContentType: "text/html; charset=utf-8", Content: b.String()}
+}
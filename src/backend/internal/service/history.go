@@ -0,0 +1,938 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+	"backend/internal/repository/postgres"
+)
+
+var (
+	// ErrChangeAlreadyUndone is returned when undoing a change that has been undone before.
+	ErrChangeAlreadyUndone = errors.New("change has already been undone")
+	// ErrChangedItemMissing is returned when undoing a change to an item that has since been deleted.
+	ErrChangedItemMissing = errors.New("changed item no longer exists")
+	// ErrDeletedItemExists is returned when undoing the deletion of an item that exists again.
+	ErrDeletedItemExists = errors.New("deleted item has already been restored")
+	// ErrChangeSuperseded is returned when undoing an update to a field that has changed since.
+	ErrChangeSuperseded = errors.New("changed field has been changed again since")
+)
+
+// The change log tracks these fields of each kind of item, keyed by their GraphQL names.
+// Derived fields (parsed dates, normalized skill names, company and canonical skill links,
+// skill stats) are recomputed when a change is undone, and display order is left to
+// reordering.
+
+type headerFields struct {
+	Name     *string `json:"name"`
+	Headline *string `json:"headline"`
+	Email    *string `json:"email"`
+	Phone    *string `json:"phone"`
+	Location *string `json:"location"`
+	Summary  *string `json:"summary"`
+}
+
+func headerFieldsOf(p *domain.Profile) headerFields {
+	return headerFields{Name: p.Name, Headline: p.Headline, Email: p.Email, Phone: p.Phone, Location: p.Location, Summary: p.Summary}
+}
+
+func (f headerFields) applyTo(p *domain.Profile) {
+	p.Name, p.Headline, p.Email, p.Phone, p.Location, p.Summary = f.Name, f.Headline, f.Email, f.Phone, f.Location, f.Summary
+}
+
+type experienceFields struct {
+	Company                 string                  `json:"company"`
+	Title                   string                  `json:"title"`
+	Location                *string                 `json:"location"`
+	StartDate               *string                 `json:"startDate"`
+	EndDate                 *string                 `json:"endDate"`
+	IsCurrent               bool                    `json:"isCurrent"`
+	Description             *string                 `json:"description"`
+	Highlights              []string                `json:"highlights"`
+	GapNote                 *string                 `json:"gapNote,omitempty"`
+	Source                  domain.ExperienceSource `json:"source"`
+	SourceResumeID          *uuid.UUID              `json:"sourceResumeId"`
+	SourceReferenceLetterID *uuid.UUID              `json:"sourceReferenceLetterId"`
+	OriginalData            json.RawMessage         `json:"originalData,omitempty"`
+}
+
+func experienceFieldsOf(e *domain.ProfileExperience) experienceFields {
+	return experienceFields{
+		Company:                 e.Company,
+		Title:                   e.Title,
+		Location:                e.Location,
+		StartDate:               e.StartDate,
+		EndDate:                 e.EndDate,
+		IsCurrent:               e.IsCurrent,
+		Description:             e.Description,
+		Highlights:              nilIfEmpty(e.Highlights),
+		GapNote:                 e.GapNote,
+		Source:                  e.Source,
+		SourceResumeID:          e.SourceResumeID,
+		SourceReferenceLetterID: e.SourceReferenceLetterID,
+		OriginalData:            e.OriginalData,
+	}
+}
+
+func (f experienceFields) applyTo(e *domain.ProfileExperience) {
+	e.Company = f.Company
+	e.Title = f.Title
+	e.Location = f.Location
+	e.StartDate = f.StartDate
+	e.EndDate = f.EndDate
+	e.IsCurrent = f.IsCurrent
+	e.Description = f.Description
+	e.Highlights = f.Highlights
+	e.GapNote = f.GapNote
+	e.Source = f.Source
+	e.SourceResumeID = f.SourceResumeID
+	e.SourceReferenceLetterID = f.SourceReferenceLetterID
+	e.OriginalData = f.OriginalData
+}
+
+type educationFields struct {
+	Institution    string                  `json:"institution"`
+	Degree         string                  `json:"degree"`
+	Field          *string                 `json:"field"`
+	StartDate      *string                 `json:"startDate"`
+	EndDate        *string                 `json:"endDate"`
+	IsCurrent      bool                    `json:"isCurrent"`
+	Description    *string                 `json:"description"`
+	GPA            *string                 `json:"gpa"`
+	Source         domain.ExperienceSource `json:"source"`
+	SourceResumeID *uuid.UUID              `json:"sourceResumeId"`
+	OriginalData   json.RawMessage         `json:"originalData,omitempty"`
+}
+
+func educationFieldsOf(e *domain.ProfileEducation) educationFields {
+	return educationFields{
+		Institution:    e.Institution,
+		Degree:         e.Degree,
+		Field:          e.Field,
+		StartDate:      e.StartDate,
+		EndDate:        e.EndDate,
+		IsCurrent:      e.IsCurrent,
+		Description:    e.Description,
+		GPA:            e.GPA,
+		Source:         e.Source,
+		SourceResumeID: e.SourceResumeID,
+		OriginalData:   e.OriginalData,
+	}
+}
+
+func (f educationFields) applyTo(e *domain.ProfileEducation) {
+	e.Institution = f.Institution
+	e.Degree = f.Degree
+	e.Field = f.Field
+	e.StartDate = f.StartDate
+	e.EndDate = f.EndDate
+	e.IsCurrent = f.IsCurrent
+	e.Description = f.Description
+	e.GPA = f.GPA
+	e.Source = f.Source
+	e.SourceResumeID = f.SourceResumeID
+	e.OriginalData = f.OriginalData
+}
+
+type skillFields struct {
+	Name                    string                  `json:"name"`
+	Category                string                  `json:"category"`
+	Aliases                 []string                `json:"aliases"`
+	Source                  domain.ExperienceSource `json:"source"`
+	SourceResumeID          *uuid.UUID              `json:"sourceResumeId"`
+	SourceReferenceLetterID *uuid.UUID              `json:"sourceReferenceLetterId"`
+	OriginalData            json.RawMessage         `json:"originalData,omitempty"`
+}
+
+func skillFieldsOf(s *domain.ProfileSkill) skillFields {
+	return skillFields{
+		Name:                    s.Name,
+		Category:                s.Category,
+		Aliases:                 nilIfEmpty(s.Aliases),
+		Source:                  s.Source,
+		SourceResumeID:          s.SourceResumeID,
+		SourceReferenceLetterID: s.SourceReferenceLetterID,
+		OriginalData:            s.OriginalData,
+	}
+}
+
+func (f skillFields) applyTo(s *domain.ProfileSkill) {
+	s.Name = f.Name
+	s.Category = f.Category
+	s.Aliases = f.Aliases
+	s.Source = f.Source
+	s.SourceResumeID = f.SourceResumeID
+	s.SourceReferenceLetterID = f.SourceReferenceLetterID
+	s.OriginalData = f.OriginalData
+}
+
+// nilIfEmpty treats an empty list like a missing one, so that clearing a list that was
+// never set is not recorded as a change.
+func nilIfEmpty(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+// fieldValues encodes each tracked field as JSON. The field structs hold only plain values,
+// so encoding them cannot fail.
+func fieldValues(fields any) map[string]json.RawMessage {
+	data, _ := json.Marshal(fields)
+	var values map[string]json.RawMessage
+	_ = json.Unmarshal(data, &values)
+	return values
+}
+
+// checkUnchanged returns ErrChangeSuperseded unless a field still holds the value an
+// update set it to, so undoing the update does not discard a later edit.
+func checkUnchanged(fields any, change *domain.ProfileChange) error {
+	if !sameJSON(fieldValues(fields)[*change.Field], change.NewValue) {
+		return ErrChangeSuperseded
+	}
+	return nil
+}
+
+// sameJSON reports whether two JSON values are equal, ignoring formatting and key order,
+// which the database does not preserve. A missing value equals null.
+func sameJSON(a, b json.RawMessage) bool {
+	var va, vb any
+	if len(a) > 0 && json.Unmarshal(a, &va) != nil {
+		return false
+	}
+	if len(b) > 0 && json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// withField returns the fields with one of them set to a JSON-encoded value.
+func withField[F any](fields F, name string, value json.RawMessage) (F, error) {
+	values := fieldValues(fields)
+	if len(value) == 0 {
+		value = json.RawMessage("null")
+	}
+	values[name] = value
+	var updated F
+	data, err := json.Marshal(values)
+	if err == nil {
+		err = json.Unmarshal(data, &updated)
+	}
+	if err != nil {
+		return updated, fmt.Errorf("failed to decode value of %s: %w", name, err)
+	}
+	return updated, nil
+}
+
+// itemChanges returns the changes that turn before into after, as field structs. A nil
+// before means the item was created and a nil after that it was deleted.
+func itemChanges(entityType domain.ProfileChangeEntity, profileID, entityID uuid.UUID, before, after any) []*domain.ProfileChange {
+	newChange := func(action domain.ProfileChangeAction) *domain.ProfileChange {
+		return &domain.ProfileChange{ProfileID: profileID, EntityType: entityType, EntityID: entityID, Action: action}
+	}
+	switch {
+	case before == nil && after == nil:
+		return nil
+	case before == nil:
+		change := newChange(domain.ProfileChangeActionCreate)
+		change.NewValue, _ = json.Marshal(after)
+		return []*domain.ProfileChange{change}
+	case after == nil:
+		change := newChange(domain.ProfileChangeActionDelete)
+		change.OldValue, _ = json.Marshal(before)
+		return []*domain.ProfileChange{change}
+	}
+
+	oldValues, newValues := fieldValues(before), fieldValues(after)
+	names := make([]string, 0, len(newValues))
+	for name := range newValues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var changes []*domain.ProfileChange
+	for _, name := range names {
+		if bytes.Equal(oldValues[name], newValues[name]) {
+			continue
+		}
+		change := newChange(domain.ProfileChangeActionUpdate)
+		change.Field = &name
+		change.OldValue = oldValues[name]
+		change.NewValue = newValues[name]
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// ProfileHeaderChanges returns the changes to the profile header between two versions of a profile.
+func ProfileHeaderChanges(before, after *domain.Profile) []*domain.ProfileChange {
+	return itemChanges(domain.ProfileChangeEntityProfile, after.ID, after.ID, headerFieldsOf(before), headerFieldsOf(after))
+}
+
+// ExperienceChanges returns the changes between two versions of an experience. Pass a nil
+// before for a new experience and a nil after for a deleted one.
+func ExperienceChanges(before, after *domain.ProfileExperience) []*domain.ProfileChange {
+	var old, updated any
+	item := after
+	if before != nil {
+		old, item = experienceFieldsOf(before), before
+	}
+	if after != nil {
+		updated = experienceFieldsOf(after)
+	}
+	if item == nil {
+		return nil
+	}
+	return itemChanges(domain.ProfileChangeEntityExperience, item.ProfileID, item.ID, old, updated)
+}
+
+// EducationChanges returns the changes between two versions of an education entry. Pass a
+// nil before for a new entry and a nil after for a deleted one.
+func EducationChanges(before, after *domain.ProfileEducation) []*domain.ProfileChange {
+	var old, updated any
+	item := after
+	if before != nil {
+		old, item = educationFieldsOf(before), before
+	}
+	if after != nil {
+		updated = educationFieldsOf(after)
+	}
+	if item == nil {
+		return nil
+	}
+	return itemChanges(domain.ProfileChangeEntityEducation, item.ProfileID, item.ID, old, updated)
+}
+
+// SkillChanges returns the changes between two versions of a skill. Pass a nil before for
+// a new skill and a nil after for a deleted one.
+func SkillChanges(before, after *domain.ProfileSkill) []*domain.ProfileChange {
+	var old, updated any
+	item := after
+	if before != nil {
+		old, item = skillFieldsOf(before), before
+	}
+	if after != nil {
+		updated = skillFieldsOf(after)
+	}
+	if item == nil {
+		return nil
+	}
+	return itemChanges(domain.ProfileChangeEntitySkill, item.ProfileID, item.ID, old, updated)
+}
+
+// ProfileSnapshot is a profile's header and items as they were at a point in time.
+type ProfileSnapshot struct {
+	At          time.Time
+	Profile     *domain.Profile
+	Experiences []*domain.ProfileExperience
+	Educations  []*domain.ProfileEducation
+	Skills      []*domain.ProfileSkill
+}
+
+// loadProfileItems loads the current experiences, education entries and skills of a profile.
+func loadProfileItems(
+	ctx context.Context,
+	expRepo domain.ProfileExperienceRepository,
+	eduRepo domain.ProfileEducationRepository,
+	skillRepo domain.ProfileSkillRepository,
+	profileID uuid.UUID,
+) (*ProfileSnapshot, error) {
+	experiences, err := expRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile experiences: %w", err)
+	}
+	educations, err := eduRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile education: %w", err)
+	}
+	skills, err := skillRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile skills: %w", err)
+	}
+	return &ProfileSnapshot{Experiences: experiences, Educations: educations, Skills: skills}, nil
+}
+
+// snapshotChanges returns the changes that turn one snapshot of a profile's items into
+// another, matching items by ID. Headers are compared when both snapshots have one.
+func snapshotChanges(before, after *ProfileSnapshot) []*domain.ProfileChange {
+	var changes []*domain.ProfileChange
+	if before.Profile != nil && after.Profile != nil {
+		changes = append(changes, ProfileHeaderChanges(before.Profile, after.Profile)...)
+	}
+	changes = append(changes, diffItems(before.Experiences, after.Experiences, func(e *domain.ProfileExperience) uuid.UUID { return e.ID }, ExperienceChanges)...)
+	changes = append(changes, diffItems(before.Educations, after.Educations, func(e *domain.ProfileEducation) uuid.UUID { return e.ID }, EducationChanges)...)
+	changes = append(changes, diffItems(before.Skills, after.Skills, func(s *domain.ProfileSkill) uuid.UUID { return s.ID }, SkillChanges)...)
+	return changes
+}
+
+func diffItems[T any](before, after []*T, id func(*T) uuid.UUID, changesOf func(before, after *T) []*domain.ProfileChange) []*domain.ProfileChange {
+	remaining := make(map[uuid.UUID]*T, len(before))
+	for _, item := range before {
+		remaining[id(item)] = item
+	}
+	var changes []*domain.ProfileChange
+	for _, item := range after {
+		changes = append(changes, changesOf(remaining[id(item)], item)...)
+		delete(remaining, id(item))
+	}
+	for _, item := range before {
+		if _, deleted := remaining[id(item)]; deleted {
+			changes = append(changes, changesOf(item, nil)...)
+		}
+	}
+	return changes
+}
+
+// recordChanges stamps the changes with their actor, source and time and appends them to the log.
+func recordChanges(
+	ctx context.Context,
+	changeRepo domain.ProfileChangeRepository,
+	actorID *uuid.UUID,
+	source domain.ProfileChangeSource,
+	now time.Time,
+	changes []*domain.ProfileChange,
+) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	for _, change := range changes {
+		change.ID = uuid.New()
+		change.ActorID = actorID
+		change.Source = source
+		change.CreatedAt = now
+	}
+	if err := changeRepo.CreateAll(ctx, changes); err != nil {
+		return fmt.Errorf("failed to record profile changes: %w", err)
+	}
+	return nil
+}
+
+// HistoryService keeps the profile change log, and uses it to undo changes and to
+// reconstruct a profile as it was at an earlier time.
+type HistoryService struct {
+	db               *bun.DB
+	profileRepo      domain.ProfileRepository
+	profileExpRepo   domain.ProfileExperienceRepository
+	profileEduRepo   domain.ProfileEducationRepository
+	profileSkillRepo domain.ProfileSkillRepository
+	skillRepo        domain.SkillRepository
+	companyAliasRepo domain.CompanyAliasRepository
+	companyRepo      domain.CompanyRepository
+	changeRepo       domain.ProfileChangeRepository
+	sections         sectionRepos
+	now              func() time.Time
+}
+
+// NewHistoryService creates a new HistoryService.
+// The db parameter is optional (can be nil) for testing with mocks; without it, undoing a
+// change and recording the undo are not atomic.
+func NewHistoryService(
+	db *bun.DB,
+	profileRepo domain.ProfileRepository,
+	profileExpRepo domain.ProfileExperienceRepository,
+	profileEduRepo domain.ProfileEducationRepository,
+	profileSkillRepo domain.ProfileSkillRepository,
+	skillRepo domain.SkillRepository,
+	companyAliasRepo domain.CompanyAliasRepository,
+	companyRepo domain.CompanyRepository,
+	changeRepo domain.ProfileChangeRepository,
+	profileLinkRepo domain.ProfileLinkRepository,
+	profileCertificationRepo domain.ProfileCertificationRepository,
+	profileLanguageRepo domain.ProfileLanguageRepository,
+	profileProjectRepo domain.ProfileProjectRepository,
+	profilePublicationRepo domain.ProfilePublicationRepository,
+	profileAwardRepo domain.ProfileAwardRepository,
+) *HistoryService {
+	return &HistoryService{
+		db:               db,
+		profileRepo:      profileRepo,
+		profileExpRepo:   profileExpRepo,
+		profileEduRepo:   profileEduRepo,
+		profileSkillRepo: profileSkillRepo,
+		skillRepo:        skillRepo,
+		companyAliasRepo: companyAliasRepo,
+		companyRepo:      companyRepo,
+		changeRepo:       changeRepo,
+		sections: sectionRepos{
+			links:          profileLinkRepo,
+			certifications: profileCertificationRepo,
+			languages:      profileLanguageRepo,
+			projects:       profileProjectRepo,
+			publications:   profilePublicationRepo,
+			awards:         profileAwardRepo,
+		},
+		now: time.Now,
+	}
+}
+
+// RecordEdits appends the user's edits of a profile to its change log, attributed to the
+// profile's owner.
+func (s *HistoryService) RecordEdits(ctx context.Context, profileID uuid.UUID, changes []*domain.ProfileChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	profile, err := s.profileRepo.GetByID(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("failed to get profile: %w", err)
+	}
+	var actorID *uuid.UUID
+	if profile != nil {
+		actorID = &profile.UserID
+	}
+	return recordChanges(ctx, s.changeRepo, actorID, domain.ProfileChangeSourceManual, s.now(), changes)
+}
+
+// Undo reverts a change and records the reverting change as an edit, in a transaction: an
+// update restores the field's old value, a creation deletes the item and a deletion
+// restores the item under its old ID, at the end of the list and without the validations
+// it had. An update can only be undone while the field still holds the value it set;
+// otherwise Undo returns ErrChangeSuperseded. It returns the changes made.
+func (s *HistoryService) Undo(ctx context.Context, change *domain.ProfileChange) ([]*domain.ProfileChange, error) {
+	var changes []*domain.ProfileChange
+	err := s.inTx(ctx, func(ctx context.Context, tx *HistoryService) error {
+		var err error
+		changes, err = tx.undo(ctx, change)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// inTx runs fn with a copy of the service whose repositories share a transaction, or with
+// the service itself when it has no database.
+func (s *HistoryService) inTx(ctx context.Context, fn func(context.Context, *HistoryService) error) error {
+	if s.db == nil {
+		return fn(ctx, s)
+	}
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return fn(ctx, &HistoryService{
+			profileRepo:      postgres.NewProfileRepository(tx),
+			profileExpRepo:   postgres.NewProfileExperienceRepository(tx),
+			profileEduRepo:   postgres.NewProfileEducationRepository(tx),
+			profileSkillRepo: postgres.NewProfileSkillRepository(tx),
+			skillRepo:        postgres.NewSkillRepository(tx),
+			companyAliasRepo: postgres.NewCompanyAliasRepository(tx),
+			companyRepo:      postgres.NewCompanyRepository(tx),
+			changeRepo:       postgres.NewProfileChangeRepository(tx),
+			sections:         newTxSectionRepos(tx),
+			now:              s.now,
+		})
+	})
+}
+
+func (s *HistoryService) undo(ctx context.Context, change *domain.ProfileChange) ([]*domain.ProfileChange, error) {
+	undone, err := s.changeRepo.GetByRevertsChangeID(ctx, change.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for an earlier undo: %w", err)
+	}
+	if undone != nil {
+		return nil, ErrChangeAlreadyUndone
+	}
+
+	var changes []*domain.ProfileChange
+	switch change.EntityType {
+	case domain.ProfileChangeEntityProfile:
+		changes, err = s.undoHeaderChange(ctx, change)
+	case domain.ProfileChangeEntityExperience:
+		changes, err = s.undoExperienceChange(ctx, change)
+	case domain.ProfileChangeEntityEducation:
+		changes, err = s.undoEducationChange(ctx, change)
+	case domain.ProfileChangeEntitySkill:
+		changes, err = s.undoSkillChange(ctx, change)
+	case domain.ProfileChangeEntityLink, domain.ProfileChangeEntityCertification, domain.ProfileChangeEntityLanguage,
+		domain.ProfileChangeEntityProject, domain.ProfileChangeEntityPublication, domain.ProfileChangeEntityAward:
+		changes, err = s.undoSectionEntryChange(ctx, change)
+	default:
+		err = fmt.Errorf("unknown profile change entity %q", change.EntityType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Undoing touches one field or item, so it makes at most one change.
+	for _, revert := range changes {
+		revert.RevertsChangeID = &change.ID
+	}
+	if err := s.RecordEdits(ctx, change.ProfileID, changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func (s *HistoryService) undoHeaderChange(ctx context.Context, change *domain.ProfileChange) ([]*domain.ProfileChange, error) {
+	if change.Action != domain.ProfileChangeActionUpdate || change.Field == nil {
+		return nil, fmt.Errorf("cannot undo a %s of the profile header", change.Action)
+	}
+	profile, err := s.profileRepo.GetByID(ctx, change.EntityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	if profile == nil {
+		return nil, ErrChangedItemMissing
+	}
+	if err := checkUnchanged(headerFieldsOf(profile), change); err != nil {
+		return nil, err
+	}
+	before := *profile
+	fields, err := withField(headerFieldsOf(profile), *change.Field, change.OldValue)
+	if err != nil {
+		return nil, err
+	}
+	fields.applyTo(profile)
+	if err := s.profileRepo.Update(ctx, profile); err != nil {
+		return nil, fmt.Errorf("failed to update profile: %w", err)
+	}
+	return ProfileHeaderChanges(&before, profile), nil
+}
+
+func (s *HistoryService) undoExperienceChange(ctx context.Context, change *domain.ProfileChange) ([]*domain.ProfileChange, error) {
+	exp, err := s.profileExpRepo.GetByID(ctx, change.EntityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experience: %w", err)
+	}
+	switch change.Action {
+	case domain.ProfileChangeActionCreate:
+		if exp == nil {
+			return nil, ErrChangedItemMissing
+		}
+		if err := s.profileExpRepo.Delete(ctx, exp.ID); err != nil {
+			return nil, fmt.Errorf("failed to delete experience: %w", err)
+		}
+		return ExperienceChanges(exp, nil), nil
+
+	case domain.ProfileChangeActionDelete:
+		if exp != nil {
+			return nil, ErrDeletedItemExists
+		}
+		var fields experienceFields
+		if err := json.Unmarshal(change.OldValue, &fields); err != nil {
+			return nil, fmt.Errorf("failed to decode deleted experience: %w", err)
+		}
+		exp = &domain.ProfileExperience{ID: change.EntityID, ProfileID: change.ProfileID}
+		fields.applyTo(exp)
+		if err := s.prepareExperience(ctx, exp, nil); err != nil {
+			return nil, err
+		}
+		if err := PlaceExperiences(ctx, s.profileExpRepo, []*domain.ProfileExperience{exp}); err != nil {
+			return nil, err
+		}
+		if err := s.profileExpRepo.Create(ctx, exp); err != nil {
+			return nil, fmt.Errorf("failed to restore experience: %w", err)
+		}
+		return ExperienceChanges(nil, exp), nil
+
+	default:
+		if exp == nil {
+			return nil, ErrChangedItemMissing
+		}
+		if err := checkUnchanged(experienceFieldsOf(exp), change); err != nil {
+			return nil, err
+		}
+		before := *exp
+		fields, err := withField(experienceFieldsOf(exp), *change.Field, change.OldValue)
+		if err != nil {
+			return nil, err
+		}
+		fields.applyTo(exp)
+		if err := s.prepareExperience(ctx, exp, &before); err != nil {
+			return nil, err
+		}
+		if err := s.profileExpRepo.Update(ctx, exp); err != nil {
+			return nil, fmt.Errorf("failed to update experience: %w", err)
+		}
+		return ExperienceChanges(&before, exp), nil
+	}
+}
+
+// prepareExperience parses a restored experience's dates and links it to its company when
+// the company changed.
+func (s *HistoryService) prepareExperience(ctx context.Context, exp, before *domain.ProfileExperience) error {
+	if err := SetExperienceDates(exp); err != nil {
+		return err
+	}
+	if before != nil && before.Company == exp.Company && exp.CompanyID != nil {
+		return nil
+	}
+	matcher, err := LoadCompanyMatcher(ctx, s.companyAliasRepo, exp.ProfileID)
+	if err != nil {
+		return err
+	}
+	exp.CompanyID, err = LinkCompany(ctx, s.companyRepo, matcher, exp.ProfileID, exp.Company)
+	return err
+}
+
+func (s *HistoryService) undoEducationChange(ctx context.Context, change *domain.ProfileChange) ([]*domain.ProfileChange, error) {
+	edu, err := s.profileEduRepo.GetByID(ctx, change.EntityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get education: %w", err)
+	}
+	switch change.Action {
+	case domain.ProfileChangeActionCreate:
+		if edu == nil {
+			return nil, ErrChangedItemMissing
+		}
+		if err := s.profileEduRepo.Delete(ctx, edu.ID); err != nil {
+			return nil, fmt.Errorf("failed to delete education: %w", err)
+		}
+		return EducationChanges(edu, nil), nil
+
+	case domain.ProfileChangeActionDelete:
+		if edu != nil {
+			return nil, ErrDeletedItemExists
+		}
+		var fields educationFields
+		if err := json.Unmarshal(change.OldValue, &fields); err != nil {
+			return nil, fmt.Errorf("failed to decode deleted education: %w", err)
+		}
+		edu = &domain.ProfileEducation{ID: change.EntityID, ProfileID: change.ProfileID}
+		fields.applyTo(edu)
+		if err := SetEducationDates(edu); err != nil {
+			return nil, err
+		}
+		if err := PlaceEducations(ctx, s.profileEduRepo, []*domain.ProfileEducation{edu}); err != nil {
+			return nil, err
+		}
+		if err := s.profileEduRepo.Create(ctx, edu); err != nil {
+			return nil, fmt.Errorf("failed to restore education: %w", err)
+		}
+		return EducationChanges(nil, edu), nil
+
+	default:
+		if edu == nil {
+			return nil, ErrChangedItemMissing
+		}
+		if err := checkUnchanged(educationFieldsOf(edu), change); err != nil {
+			return nil, err
+		}
+		before := *edu
+		fields, err := withField(educationFieldsOf(edu), *change.Field, change.OldValue)
+		if err != nil {
+			return nil, err
+		}
+		fields.applyTo(edu)
+		if err := SetEducationDates(edu); err != nil {
+			return nil, err
+		}
+		if err := s.profileEduRepo.Update(ctx, edu); err != nil {
+			return nil, fmt.Errorf("failed to update education: %w", err)
+		}
+		return EducationChanges(&before, edu), nil
+	}
+}
+
+func (s *HistoryService) undoSkillChange(ctx context.Context, change *domain.ProfileChange) ([]*domain.ProfileChange, error) {
+	skill, err := s.profileSkillRepo.GetByID(ctx, change.EntityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skill: %w", err)
+	}
+	switch change.Action {
+	case domain.ProfileChangeActionCreate:
+		if skill == nil {
+			return nil, ErrChangedItemMissing
+		}
+		if err := s.profileSkillRepo.Delete(ctx, skill.ID); err != nil {
+			return nil, fmt.Errorf("failed to delete skill: %w", err)
+		}
+		return SkillChanges(skill, nil), nil
+
+	case domain.ProfileChangeActionDelete:
+		if skill != nil {
+			return nil, ErrDeletedItemExists
+		}
+		var fields skillFields
+		if err := json.Unmarshal(change.OldValue, &fields); err != nil {
+			return nil, fmt.Errorf("failed to decode deleted skill: %w", err)
+		}
+		skill = &domain.ProfileSkill{ID: change.EntityID, ProfileID: change.ProfileID}
+		fields.applyTo(skill)
+		if err := s.linkSkill(ctx, skill); err != nil {
+			return nil, err
+		}
+		if skill.DisplayOrder, err = s.profileSkillRepo.GetNextDisplayOrder(ctx, skill.ProfileID); err != nil {
+			return nil, fmt.Errorf("failed to get next display order: %w", err)
+		}
+		if err := s.profileSkillRepo.Create(ctx, skill); err != nil {
+			return nil, fmt.Errorf("failed to restore skill: %w", err)
+		}
+		return SkillChanges(nil, skill), nil
+
+	default:
+		if skill == nil {
+			return nil, ErrChangedItemMissing
+		}
+		if err := checkUnchanged(skillFieldsOf(skill), change); err != nil {
+			return nil, err
+		}
+		before := *skill
+		fields, err := withField(skillFieldsOf(skill), *change.Field, change.OldValue)
+		if err != nil {
+			return nil, err
+		}
+		fields.applyTo(skill)
+		if skill.Name != before.Name {
+			if err := s.linkSkill(ctx, skill); err != nil {
+				return nil, err
+			}
+		}
+		if err := s.profileSkillRepo.Update(ctx, skill); err != nil {
+			return nil, fmt.Errorf("failed to update skill: %w", err)
+		}
+		return SkillChanges(&before, skill), nil
+	}
+}
+
+// linkSkill sets a restored skill's normalized name and canonical skill from its name.
+func (s *HistoryService) linkSkill(ctx context.Context, skill *domain.ProfileSkill) error {
+	tax, err := LoadSkillTaxonomy(ctx, s.skillRepo, skill.ProfileID)
+	if err != nil {
+		return err
+	}
+	skill.NormalizedName = tax.Normalize(skill.Name)
+	skill.CanonicalSkillID = CanonicalSkillID(tax, skill.Name)
+	return nil
+}
+
+// ProfileAt reconstructs a profile as it was at the given time by reverting the logged
+// changes made since, newest first. Items restored this way follow the current ones, and
+// edits made before the change log existed are not reverted. It returns nil if the
+// profile does not exist.
+func (s *HistoryService) ProfileAt(ctx context.Context, profileID uuid.UUID, at time.Time) (*ProfileSnapshot, error) {
+	profile, err := s.profileRepo.GetByID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	if profile == nil {
+		return nil, nil
+	}
+	snapshot, err := loadProfileItems(ctx, s.profileExpRepo, s.profileEduRepo, s.profileSkillRepo, profileID)
+	if err != nil {
+		return nil, err
+	}
+	header := *profile
+	snapshot.At = at
+	snapshot.Profile = &header
+
+	changes, err := s.changeRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile changes: %w", err)
+	}
+	for _, change := range changes {
+		if !change.CreatedAt.After(at) {
+			break
+		}
+		if err := snapshot.revert(change); err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
+
+// revert undoes a change to the snapshot, copying the items it touches rather than
+// modifying them in place.
+func (p *ProfileSnapshot) revert(change *domain.ProfileChange) error {
+	var err error
+	switch change.EntityType {
+	case domain.ProfileChangeEntityProfile:
+		if change.Action != domain.ProfileChangeActionUpdate || change.Field == nil {
+			return nil
+		}
+		fields, fieldErr := withField(headerFieldsOf(p.Profile), *change.Field, change.OldValue)
+		if fieldErr != nil {
+			return fieldErr
+		}
+		fields.applyTo(p.Profile)
+	case domain.ProfileChangeEntityExperience:
+		p.Experiences, err = revertItem(p.Experiences, change,
+			func(e *domain.ProfileExperience) uuid.UUID { return e.ID },
+			experienceFieldsOf,
+			func(id, profileID uuid.UUID) *domain.ProfileExperience {
+				return &domain.ProfileExperience{ID: id, ProfileID: profileID}
+			},
+			func(e *domain.ProfileExperience) { _ = SetExperienceDates(e) },
+		)
+	case domain.ProfileChangeEntityEducation:
+		p.Educations, err = revertItem(p.Educations, change,
+			func(e *domain.ProfileEducation) uuid.UUID { return e.ID },
+			educationFieldsOf,
+			func(id, profileID uuid.UUID) *domain.ProfileEducation {
+				return &domain.ProfileEducation{ID: id, ProfileID: profileID}
+			},
+			func(e *domain.ProfileEducation) { _ = SetEducationDates(e) },
+		)
+	case domain.ProfileChangeEntitySkill:
+		p.Skills, err = revertItem(p.Skills, change,
+			func(s *domain.ProfileSkill) uuid.UUID { return s.ID },
+			skillFieldsOf,
+			func(id, profileID uuid.UUID) *domain.ProfileSkill {
+				return &domain.ProfileSkill{ID: id, ProfileID: profileID}
+			},
+			func(*domain.ProfileSkill) {},
+		)
+	}
+	return err
+}
+
+func revertItem[T any, F interface{ applyTo(*T) }](
+	items []*T,
+	change *domain.ProfileChange,
+	id func(*T) uuid.UUID,
+	fieldsOf func(*T) F,
+	newItem func(id, profileID uuid.UUID) *T,
+	derive func(*T),
+) ([]*T, error) {
+	index := -1
+	for i, item := range items {
+		if id(item) == change.EntityID {
+			index = i
+			break
+		}
+	}
+
+	switch change.Action {
+	case domain.ProfileChangeActionCreate:
+		if index < 0 {
+			return items, nil
+		}
+		return append(items[:index:index], items[index+1:]...), nil
+
+	case domain.ProfileChangeActionDelete:
+		if index >= 0 {
+			return items, nil
+		}
+		var fields F
+		if err := json.Unmarshal(change.OldValue, &fields); err != nil {
+			return nil, fmt.Errorf("failed to decode deleted %s: %w", change.EntityType, err)
+		}
+		item := newItem(change.EntityID, change.ProfileID)
+		fields.applyTo(item)
+		derive(item)
+		return append(items, item), nil
+
+	default:
+		if index < 0 || change.Field == nil {
+			return items, nil
+		}
+		fields, err := withField(fieldsOf(items[index]), *change.Field, change.OldValue)
+		if err != nil {
+			return nil, err
+		}
+		item := *items[index]
+		fields.applyTo(&item)
+		derive(&item)
+		reverted := append([]*T(nil), items...)
+		reverted[index] = &item
+		return reverted, nil
+	}
+}
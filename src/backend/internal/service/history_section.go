@@ -0,0 +1,381 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+// The change log tracks these fields of the profile sections beyond experience, education
+// and skills, keyed by their GraphQL names. A certification's parsed expiry is recomputed
+// when a change is undone; its expiry flag is left to the periodic check.
+
+type linkFields struct {
+	Kind           domain.LinkKind         `json:"kind"`
+	URL            string                  `json:"url"`
+	Label          *string                 `json:"label"`
+	Source         domain.ExperienceSource `json:"source"`
+	SourceResumeID *uuid.UUID              `json:"sourceResumeId"`
+}
+
+func linkFieldsOf(l *domain.ProfileLink) linkFields {
+	return linkFields{Kind: l.Kind, URL: l.URL, Label: l.Label, Source: l.Source, SourceResumeID: l.SourceResumeID}
+}
+
+func (f linkFields) applyTo(l *domain.ProfileLink) {
+	l.Kind, l.URL, l.Label, l.Source, l.SourceResumeID = f.Kind, f.URL, f.Label, f.Source, f.SourceResumeID
+}
+
+type certificationFields struct {
+	Name                       string                  `json:"name"`
+	Issuer                     *string                 `json:"issuer"`
+	CredentialID               *string                 `json:"credentialId"`
+	VerificationURL            *string                 `json:"verificationUrl"`
+	IssueDate                  *string                 `json:"issueDate"`
+	ExpiryDate                 *string                 `json:"expiryDate"`
+	Source                     domain.ExperienceSource `json:"source"`
+	SourceResumeID             *uuid.UUID              `json:"sourceResumeId"`
+	SourceFileID               *uuid.UUID              `json:"sourceFileId"`
+	SourceCredentialDocumentID *uuid.UUID              `json:"sourceCredentialDocumentId"`
+}
+
+func certificationFieldsOf(c *domain.ProfileCertification) certificationFields {
+	return certificationFields{
+		Name:                       c.Name,
+		Issuer:                     c.Issuer,
+		CredentialID:               c.CredentialID,
+		VerificationURL:            c.VerificationURL,
+		IssueDate:                  c.IssueDate,
+		ExpiryDate:                 c.ExpiryDate,
+		Source:                     c.Source,
+		SourceResumeID:             c.SourceResumeID,
+		SourceFileID:               c.SourceFileID,
+		SourceCredentialDocumentID: c.SourceCredentialDocumentID,
+	}
+}
+
+func (f certificationFields) applyTo(c *domain.ProfileCertification) {
+	c.Name = f.Name
+	c.Issuer = f.Issuer
+	c.CredentialID = f.CredentialID
+	c.VerificationURL = f.VerificationURL
+	c.IssueDate = f.IssueDate
+	c.ExpiryDate = f.ExpiryDate
+	c.Source = f.Source
+	c.SourceResumeID = f.SourceResumeID
+	c.SourceFileID = f.SourceFileID
+	c.SourceCredentialDocumentID = f.SourceCredentialDocumentID
+}
+
+type languageFields struct {
+	Language       string                      `json:"language"`
+	Proficiency    *domain.LanguageProficiency `json:"proficiency"`
+	Source         domain.ExperienceSource     `json:"source"`
+	SourceResumeID *uuid.UUID                  `json:"sourceResumeId"`
+}
+
+func languageFieldsOf(l *domain.ProfileLanguage) languageFields {
+	return languageFields{Language: l.Language, Proficiency: l.Proficiency, Source: l.Source, SourceResumeID: l.SourceResumeID}
+}
+
+func (f languageFields) applyTo(l *domain.ProfileLanguage) {
+	l.Language, l.Proficiency, l.Source, l.SourceResumeID = f.Language, f.Proficiency, f.Source, f.SourceResumeID
+}
+
+type projectFields struct {
+	Name           string                  `json:"name"`
+	Role           *string                 `json:"role"`
+	Description    *string                 `json:"description"`
+	URL            *string                 `json:"url"`
+	StartDate      *string                 `json:"startDate"`
+	EndDate        *string                 `json:"endDate"`
+	Technologies   []string                `json:"technologies"`
+	Source         domain.ExperienceSource `json:"source"`
+	SourceResumeID *uuid.UUID              `json:"sourceResumeId"`
+}
+
+func projectFieldsOf(p *domain.ProfileProject) projectFields {
+	return projectFields{
+		Name:           p.Name,
+		Role:           p.Role,
+		Description:    p.Description,
+		URL:            p.URL,
+		StartDate:      p.StartDate,
+		EndDate:        p.EndDate,
+		Technologies:   nilIfEmpty(p.Technologies),
+		Source:         p.Source,
+		SourceResumeID: p.SourceResumeID,
+	}
+}
+
+func (f projectFields) applyTo(p *domain.ProfileProject) {
+	p.Name = f.Name
+	p.Role = f.Role
+	p.Description = f.Description
+	p.URL = f.URL
+	p.StartDate = f.StartDate
+	p.EndDate = f.EndDate
+	p.Technologies = f.Technologies
+	p.Source = f.Source
+	p.SourceResumeID = f.SourceResumeID
+}
+
+type publicationFields struct {
+	Title          string                  `json:"title"`
+	Publisher      *string                 `json:"publisher"`
+	Date           *string                 `json:"date"`
+	URL            *string                 `json:"url"`
+	Description    *string                 `json:"description"`
+	Source         domain.ExperienceSource `json:"source"`
+	SourceResumeID *uuid.UUID              `json:"sourceResumeId"`
+}
+
+func publicationFieldsOf(p *domain.ProfilePublication) publicationFields {
+	return publicationFields{
+		Title:          p.Title,
+		Publisher:      p.Publisher,
+		Date:           p.Date,
+		URL:            p.URL,
+		Description:    p.Description,
+		Source:         p.Source,
+		SourceResumeID: p.SourceResumeID,
+	}
+}
+
+func (f publicationFields) applyTo(p *domain.ProfilePublication) {
+	p.Title = f.Title
+	p.Publisher = f.Publisher
+	p.Date = f.Date
+	p.URL = f.URL
+	p.Description = f.Description
+	p.Source = f.Source
+	p.SourceResumeID = f.SourceResumeID
+}
+
+type awardFields struct {
+	Title          string                  `json:"title"`
+	Issuer         *string                 `json:"issuer"`
+	Date           *string                 `json:"date"`
+	Description    *string                 `json:"description"`
+	Source         domain.ExperienceSource `json:"source"`
+	SourceResumeID *uuid.UUID              `json:"sourceResumeId"`
+}
+
+func awardFieldsOf(a *domain.ProfileAward) awardFields {
+	return awardFields{
+		Title:          a.Title,
+		Issuer:         a.Issuer,
+		Date:           a.Date,
+		Description:    a.Description,
+		Source:         a.Source,
+		SourceResumeID: a.SourceResumeID,
+	}
+}
+
+func (f awardFields) applyTo(a *domain.ProfileAward) {
+	a.Title = f.Title
+	a.Issuer = f.Issuer
+	a.Date = f.Date
+	a.Description = f.Description
+	a.Source = f.Source
+	a.SourceResumeID = f.SourceResumeID
+}
+
+// sectionChanges returns the changes between two versions of a section entry. A nil before
+// means the entry was created and a nil after that it was deleted.
+func sectionChanges[T, F any](entityType domain.ProfileChangeEntity, before, after *T, fieldsOf func(*T) F, ids func(*T) (id, profileID uuid.UUID)) []*domain.ProfileChange {
+	var old, updated any
+	item := after
+	if before != nil {
+		old, item = fieldsOf(before), before
+	}
+	if after != nil {
+		updated = fieldsOf(after)
+	}
+	if item == nil {
+		return nil
+	}
+	id, profileID := ids(item)
+	return itemChanges(entityType, profileID, id, old, updated)
+}
+
+// LinkChanges returns the changes between two versions of a link. Pass a nil before for a
+// new link and a nil after for a deleted one.
+func LinkChanges(before, after *domain.ProfileLink) []*domain.ProfileChange {
+	return sectionChanges(domain.ProfileChangeEntityLink, before, after, linkFieldsOf,
+		func(l *domain.ProfileLink) (uuid.UUID, uuid.UUID) { return l.ID, l.ProfileID })
+}
+
+// CertificationChanges returns the changes between two versions of a certification. Pass
+// a nil before for a new certification and a nil after for a deleted one.
+func CertificationChanges(before, after *domain.ProfileCertification) []*domain.ProfileChange {
+	return sectionChanges(domain.ProfileChangeEntityCertification, before, after, certificationFieldsOf,
+		func(c *domain.ProfileCertification) (uuid.UUID, uuid.UUID) { return c.ID, c.ProfileID })
+}
+
+// LanguageChanges returns the changes between two versions of a language. Pass a nil
+// before for a new language and a nil after for a deleted one.
+func LanguageChanges(before, after *domain.ProfileLanguage) []*domain.ProfileChange {
+	return sectionChanges(domain.ProfileChangeEntityLanguage, before, after, languageFieldsOf,
+		func(l *domain.ProfileLanguage) (uuid.UUID, uuid.UUID) { return l.ID, l.ProfileID })
+}
+
+// ProjectChanges returns the changes between two versions of a project. Pass a nil before
+// for a new project and a nil after for a deleted one.
+func ProjectChanges(before, after *domain.ProfileProject) []*domain.ProfileChange {
+	return sectionChanges(domain.ProfileChangeEntityProject, before, after, projectFieldsOf,
+		func(p *domain.ProfileProject) (uuid.UUID, uuid.UUID) { return p.ID, p.ProfileID })
+}
+
+// PublicationChanges returns the changes between two versions of a publication. Pass a nil
+// before for a new publication and a nil after for a deleted one.
+func PublicationChanges(before, after *domain.ProfilePublication) []*domain.ProfileChange {
+	return sectionChanges(domain.ProfileChangeEntityPublication, before, after, publicationFieldsOf,
+		func(p *domain.ProfilePublication) (uuid.UUID, uuid.UUID) { return p.ID, p.ProfileID })
+}
+
+// AwardChanges returns the changes between two versions of an award. Pass a nil before for
+// a new award and a nil after for a deleted one.
+func AwardChanges(before, after *domain.ProfileAward) []*domain.ProfileChange {
+	return sectionChanges(domain.ProfileChangeEntityAward, before, after, awardFieldsOf,
+		func(a *domain.ProfileAward) (uuid.UUID, uuid.UUID) { return a.ID, a.ProfileID })
+}
+
+// sectionStore is the part of a section repository that undoing a change uses.
+type sectionStore[T any] interface {
+	Create(ctx context.Context, item *T) error
+	GetByID(ctx context.Context, id uuid.UUID) (*T, error)
+	Update(ctx context.Context, item *T) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetNextDisplayOrder(ctx context.Context, profileID uuid.UUID) (int, error)
+}
+
+// sectionUndo describes how to undo changes to the entries of one section. newItem creates
+// an empty entry to restore a deleted one into, and derive recomputes derived fields.
+type sectionUndo[T any, F interface{ applyTo(*T) }] struct {
+	store    sectionStore[T]
+	fieldsOf func(*T) F
+	changes  func(before, after *T) []*domain.ProfileChange
+	newItem  func(id, profileID uuid.UUID, displayOrder int) *T
+	derive   func(*T) error
+}
+
+// undoSectionChange undoes a change to a section entry like the other undo methods: an
+// update restores the field's old value while the field still holds its new one, a
+// creation deletes the entry and a deletion restores it under its old ID at the end of
+// the section.
+func undoSectionChange[T any, F interface{ applyTo(*T) }](ctx context.Context, change *domain.ProfileChange, u sectionUndo[T, F]) ([]*domain.ProfileChange, error) {
+	item, err := u.store.GetByID(ctx, change.EntityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", change.EntityType, err)
+	}
+	switch change.Action {
+	case domain.ProfileChangeActionCreate:
+		if item == nil {
+			return nil, ErrChangedItemMissing
+		}
+		if err := u.store.Delete(ctx, change.EntityID); err != nil {
+			return nil, fmt.Errorf("failed to delete %s: %w", change.EntityType, err)
+		}
+		return u.changes(item, nil), nil
+
+	case domain.ProfileChangeActionDelete:
+		if item != nil {
+			return nil, ErrDeletedItemExists
+		}
+		var fields F
+		if err := json.Unmarshal(change.OldValue, &fields); err != nil {
+			return nil, fmt.Errorf("failed to decode deleted %s: %w", change.EntityType, err)
+		}
+		order, err := u.store.GetNextDisplayOrder(ctx, change.ProfileID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get next display order: %w", err)
+		}
+		item = u.newItem(change.EntityID, change.ProfileID, order)
+		fields.applyTo(item)
+		if err := u.derive(item); err != nil {
+			return nil, err
+		}
+		if err := u.store.Create(ctx, item); err != nil {
+			return nil, fmt.Errorf("failed to restore %s: %w", change.EntityType, err)
+		}
+		return u.changes(nil, item), nil
+
+	default:
+		if item == nil {
+			return nil, ErrChangedItemMissing
+		}
+		if err := checkUnchanged(u.fieldsOf(item), change); err != nil {
+			return nil, err
+		}
+		before := *item
+		fields, err := withField(u.fieldsOf(item), *change.Field, change.OldValue)
+		if err != nil {
+			return nil, err
+		}
+		fields.applyTo(item)
+		if err := u.derive(item); err != nil {
+			return nil, err
+		}
+		if err := u.store.Update(ctx, item); err != nil {
+			return nil, fmt.Errorf("failed to update %s: %w", change.EntityType, err)
+		}
+		return u.changes(&before, item), nil
+	}
+}
+
+func noDerivedFields[T any](*T) error { return nil }
+
+// undoSectionEntryChange undoes a change to an entry of one of the sections beyond
+// experience, education and skills.
+func (s *HistoryService) undoSectionEntryChange(ctx context.Context, change *domain.ProfileChange) ([]*domain.ProfileChange, error) {
+	switch change.EntityType {
+	case domain.ProfileChangeEntityLink:
+		return undoSectionChange(ctx, change, sectionUndo[domain.ProfileLink, linkFields]{
+			store: s.sections.links, fieldsOf: linkFieldsOf, changes: LinkChanges, derive: noDerivedFields[domain.ProfileLink],
+			newItem: func(id, profileID uuid.UUID, order int) *domain.ProfileLink {
+				return &domain.ProfileLink{ID: id, ProfileID: profileID, DisplayOrder: order}
+			},
+		})
+	case domain.ProfileChangeEntityCertification:
+		return undoSectionChange(ctx, change, sectionUndo[domain.ProfileCertification, certificationFields]{
+			store: s.sections.certifications, fieldsOf: certificationFieldsOf, changes: CertificationChanges, derive: SetCertificationExpiry,
+			newItem: func(id, profileID uuid.UUID, order int) *domain.ProfileCertification {
+				return &domain.ProfileCertification{ID: id, ProfileID: profileID, DisplayOrder: order}
+			},
+		})
+	case domain.ProfileChangeEntityLanguage:
+		return undoSectionChange(ctx, change, sectionUndo[domain.ProfileLanguage, languageFields]{
+			store: s.sections.languages, fieldsOf: languageFieldsOf, changes: LanguageChanges, derive: noDerivedFields[domain.ProfileLanguage],
+			newItem: func(id, profileID uuid.UUID, order int) *domain.ProfileLanguage {
+				return &domain.ProfileLanguage{ID: id, ProfileID: profileID, DisplayOrder: order}
+			},
+		})
+	case domain.ProfileChangeEntityProject:
+		return undoSectionChange(ctx, change, sectionUndo[domain.ProfileProject, projectFields]{
+			store: s.sections.projects, fieldsOf: projectFieldsOf, changes: ProjectChanges, derive: noDerivedFields[domain.ProfileProject],
+			newItem: func(id, profileID uuid.UUID, order int) *domain.ProfileProject {
+				return &domain.ProfileProject{ID: id, ProfileID: profileID, DisplayOrder: order}
+			},
+		})
+	case domain.ProfileChangeEntityPublication:
+		return undoSectionChange(ctx, change, sectionUndo[domain.ProfilePublication, publicationFields]{
+			store: s.sections.publications, fieldsOf: publicationFieldsOf, changes: PublicationChanges, derive: noDerivedFields[domain.ProfilePublication],
+			newItem: func(id, profileID uuid.UUID, order int) *domain.ProfilePublication {
+				return &domain.ProfilePublication{ID: id, ProfileID: profileID, DisplayOrder: order}
+			},
+		})
+	case domain.ProfileChangeEntityAward:
+		return undoSectionChange(ctx, change, sectionUndo[domain.ProfileAward, awardFields]{
+			store: s.sections.awards, fieldsOf: awardFieldsOf, changes: AwardChanges, derive: noDerivedFields[domain.ProfileAward],
+			newItem: func(id, profileID uuid.UUID, order int) *domain.ProfileAward {
+				return &domain.ProfileAward{ID: id, ProfileID: profileID, DisplayOrder: order}
+			},
+		})
+	}
+	return nil, fmt.Errorf("unknown profile change entity %q", change.EntityType)
+}
@@ -0,0 +1,344 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+type historyFixture struct {
+	history     *HistoryService
+	profileRepo *mockProfileRepository
+	expRepo     *mockProfileExperienceRepository
+	skillRepo   *mockProfileSkillRepository
+	changeRepo  *mockProfileChangeRepository
+	certRepo    *mockProfileCertificationRepository
+	awardRepo   *mockSectionRepository[domain.ProfileAward]
+	profile     *domain.Profile
+}
+
+func newHistoryFixture() *historyFixture {
+	f := &historyFixture{
+		profileRepo: newMockProfileRepository(),
+		expRepo:     newMockProfileExperienceRepository(),
+		skillRepo:   newMockProfileSkillRepository(),
+		changeRepo:  newMockProfileChangeRepository(),
+		certRepo:    newMockProfileCertificationRepository(),
+		awardRepo:   newMockProfileAwardRepository(),
+		profile:     &domain.Profile{ID: uuid.New(), UserID: uuid.New()},
+	}
+	f.profileRepo.profiles[f.profile.ID] = f.profile
+	f.history = NewHistoryService(nil, f.profileRepo, f.expRepo, newMockProfileEducationRepository(), f.skillRepo, newMockSkillRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), f.changeRepo,
+		newMockProfileLinkRepository(), f.certRepo, newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), f.awardRepo)
+	return f
+}
+
+// record logs changes as if they were made at the given time.
+func (f *historyFixture) record(t *testing.T, at time.Time, changes []*domain.ProfileChange) {
+	t.Helper()
+	f.history.now = func() time.Time { return at }
+	if err := f.history.RecordEdits(context.Background(), f.profile.ID, changes); err != nil {
+		t.Fatalf("RecordEdits failed: %v", err)
+	}
+	f.history.now = time.Now
+}
+
+func TestExperienceChangesRecordsChangedFields(t *testing.T) {
+	exp := &domain.ProfileExperience{ID: uuid.New(), ProfileID: uuid.New(), Company: "Acme", Title: "Engineer"}
+	updated := *exp
+	updated.Title = "Lead Engineer"
+	updated.Highlights = []string{} // clearing highlights that were never set is no change
+
+	changes := ExperienceChanges(exp, &updated)
+	if len(changes) != 1 {
+		t.Fatalf("expected 1 change, got %d", len(changes))
+	}
+	change := changes[0]
+	if change.Action != domain.ProfileChangeActionUpdate || change.Field == nil || *change.Field != "title" {
+		t.Fatalf("expected an update of the title, got %+v", change)
+	}
+	if string(change.OldValue) != `"Engineer"` || string(change.NewValue) != `"Lead Engineer"` {
+		t.Errorf("unexpected values %s -> %s", change.OldValue, change.NewValue)
+	}
+
+	created := ExperienceChanges(nil, exp)
+	if len(created) != 1 || created[0].Action != domain.ProfileChangeActionCreate || created[0].Field != nil || created[0].OldValue != nil {
+		t.Errorf("expected a create with the whole experience, got %+v", created)
+	}
+	if deleted := ExperienceChanges(exp, nil); len(deleted) != 1 || deleted[0].Action != domain.ProfileChangeActionDelete || deleted[0].EntityID != exp.ID {
+		t.Errorf("expected a delete of the experience, got %+v", deleted)
+	}
+}
+
+func TestMaterializeRecordsImportChanges(t *testing.T) {
+	changeRepo := newMockProfileChangeRepository()
+	expRepo := newMockProfileExperienceRepository()
+	svc := NewMaterializationService(nil, newMockProfileRepository(), expRepo, newMockProfileEducationRepository(), newMockProfileSkillRepository(), newMockAuthorRepository(), newMockTestimonialRepository(), newMockSkillValidationRepository(), newMockExpValidationRepository(), newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), changeRepo, newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
+	ctx := context.Background()
+	userID := uuid.New()
+	resumeID := uuid.New()
+
+	if _, err := svc.MaterializeResumeData(ctx, resumeID, userID, testExtractedData()); err != nil {
+		t.Fatalf("MaterializeResumeData failed: %v", err)
+	}
+	counts := map[domain.ProfileChangeAction]int{}
+	for _, change := range changeRepo.changes {
+		if change.Source != domain.ProfileChangeSourceImport || change.ActorID == nil || *change.ActorID != userID {
+			t.Fatalf("expected an import by the user, got %+v", change)
+		}
+		counts[change.Action]++
+	}
+	// Five header fields, and one experience, one education entry and three skills
+	if counts[domain.ProfileChangeActionUpdate] != 5 || counts[domain.ProfileChangeActionCreate] != 5 {
+		t.Fatalf("expected 5 updates and 5 creates, got %v", counts)
+	}
+
+	// Re-importing replaces the resume's items, and the log shows which were removed
+	var oldExpID uuid.UUID
+	for id := range expRepo.experiences {
+		oldExpID = id
+	}
+	changeRepo.changes = nil
+	if _, err := svc.MaterializeResumeData(ctx, resumeID, userID, testExtractedData()); err != nil {
+		t.Fatalf("re-import failed: %v", err)
+	}
+	counts = map[domain.ProfileChangeAction]int{}
+	deletedOld := false
+	for _, change := range changeRepo.changes {
+		counts[change.Action]++
+		if change.Action == domain.ProfileChangeActionDelete && change.EntityID == oldExpID {
+			deletedOld = true
+		}
+	}
+	if counts[domain.ProfileChangeActionDelete] != 5 || counts[domain.ProfileChangeActionCreate] != 5 || counts[domain.ProfileChangeActionUpdate] != 0 {
+		t.Errorf("expected 5 deletes and 5 creates, got %v", counts)
+	}
+	if !deletedOld {
+		t.Error("expected the deletion of the first import's experience to be logged")
+	}
+}
+
+func TestHistoryUndoRestoresOverwrittenSummary(t *testing.T) {
+	f := newHistoryFixture()
+	ctx := context.Background()
+
+	before := *f.profile
+	before.Summary = stringPtr("Hand-written summary")
+	f.profile.Summary = stringPtr("Extracted summary")
+	f.record(t, time.Now(), ProfileHeaderChanges(&before, f.profile))
+	overwrite := f.changeRepo.changes[0]
+
+	reverts, err := f.history.Undo(ctx, overwrite)
+	if err != nil {
+		t.Fatalf("Undo failed: %v", err)
+	}
+	if f.profile.Summary == nil || *f.profile.Summary != "Hand-written summary" {
+		t.Fatalf("expected the summary to be restored, got %v", f.profile.Summary)
+	}
+	if len(reverts) != 1 || reverts[0].RevertsChangeID == nil || *reverts[0].RevertsChangeID != overwrite.ID {
+		t.Fatalf("expected one change reverting the overwrite, got %+v", reverts)
+	}
+	if reverts[0].Source != domain.ProfileChangeSourceManual || reverts[0].ActorID == nil || *reverts[0].ActorID != f.profile.UserID {
+		t.Errorf("expected the undo to be an edit by the owner, got %+v", reverts[0])
+	}
+
+	if _, err := f.history.Undo(ctx, overwrite); !errors.Is(err, ErrChangeAlreadyUndone) {
+		t.Errorf("expected ErrChangeAlreadyUndone, got %v", err)
+	}
+}
+
+func TestHistoryUndoDeleteAndCreate(t *testing.T) {
+	f := newHistoryFixture()
+	ctx := context.Background()
+
+	exp := newDatedExperience(t, "Engineer", "2019", "2021")
+	exp.ProfileID = f.profile.ID
+	exp.Company = "Acme"
+	exp.Highlights = []string{"Shipped the thing"}
+	f.record(t, time.Now(), ExperienceChanges(exp, nil))
+	deletion := f.changeRepo.changes[0]
+
+	if _, err := f.history.Undo(ctx, deletion); err != nil {
+		t.Fatalf("Undo of the deletion failed: %v", err)
+	}
+	restored := f.expRepo.experiences[exp.ID]
+	if restored == nil {
+		t.Fatal("expected the experience to be restored under its old ID")
+	}
+	if restored.Title != "Engineer" || len(restored.Highlights) != 1 || restored.Start.Year != 2019 || restored.CompanyID == nil {
+		t.Errorf("expected the restored experience with parsed dates and a company link, got %+v", restored)
+	}
+
+	// Undoing the restoring creation deletes the experience again
+	creation := f.changeRepo.changes[1]
+	if creation.Action != domain.ProfileChangeActionCreate {
+		t.Fatalf("expected the restore to be logged as a creation, got %s", creation.Action)
+	}
+	if _, err := f.history.Undo(ctx, creation); err != nil {
+		t.Fatalf("Undo of the creation failed: %v", err)
+	}
+	if f.expRepo.experiences[exp.ID] != nil {
+		t.Error("expected the experience to be deleted again")
+	}
+
+	// The first undo has been recorded, so the deletion cannot be undone twice
+	if _, err := f.history.Undo(ctx, deletion); !errors.Is(err, ErrChangeAlreadyUndone) {
+		t.Errorf("expected ErrChangeAlreadyUndone, got %v", err)
+	}
+}
+
+func TestHistoryUndoSupersededUpdate(t *testing.T) {
+	f := newHistoryFixture()
+	ctx := context.Background()
+
+	exp := &domain.ProfileExperience{ID: uuid.New(), ProfileID: f.profile.ID, Company: "Acme", Title: "Engineer"}
+	f.expRepo.experiences[exp.ID] = exp
+	lead := *exp
+	lead.Title = "Lead"
+	f.record(t, time.Now(), ExperienceChanges(exp, &lead))
+	manager := lead
+	manager.Title = "Manager"
+	f.record(t, time.Now(), ExperienceChanges(&lead, &manager))
+	*exp = manager
+
+	// The first rename was overwritten by the second, so undoing it would lose "Manager"
+	if _, err := f.history.Undo(ctx, f.changeRepo.changes[0]); !errors.Is(err, ErrChangeSuperseded) {
+		t.Fatalf("expected ErrChangeSuperseded, got %v", err)
+	}
+	if exp.Title != "Manager" {
+		t.Errorf("expected the title to be left alone, got %q", exp.Title)
+	}
+
+	if _, err := f.history.Undo(ctx, f.changeRepo.changes[1]); err != nil {
+		t.Fatalf("Undo of the latest change failed: %v", err)
+	}
+	if got := f.expRepo.experiences[exp.ID].Title; got != "Lead" {
+		t.Errorf("expected the latest change to be undone, got %q", got)
+	}
+}
+
+func TestHistoryUndoUpdateOfDeletedItem(t *testing.T) {
+	f := newHistoryFixture()
+	exp := &domain.ProfileExperience{ID: uuid.New(), ProfileID: f.profile.ID, Company: "Acme", Title: "Engineer"}
+	updated := *exp
+	updated.Title = "Lead"
+	f.record(t, time.Now(), ExperienceChanges(exp, &updated))
+
+	if _, err := f.history.Undo(context.Background(), f.changeRepo.changes[0]); !errors.Is(err, ErrChangedItemMissing) {
+		t.Errorf("expected ErrChangedItemMissing, got %v", err)
+	}
+}
+
+func TestHistoryUndoSectionUpdate(t *testing.T) {
+	f := newHistoryFixture()
+	ctx := context.Background()
+
+	cert := &domain.ProfileCertification{ID: uuid.New(), ProfileID: f.profile.ID, Name: "CKA", ExpiryDate: stringPtr("2027-03")}
+	f.certRepo.items[cert.ID] = cert
+	renewed := *cert
+	renewed.ExpiryDate = stringPtr("2030-03")
+	f.record(t, time.Now(), CertificationChanges(cert, &renewed))
+	*cert = renewed
+
+	if len(f.changeRepo.changes) != 1 || *f.changeRepo.changes[0].Field != "expiryDate" {
+		t.Fatalf("expected one expiryDate change, got %+v", f.changeRepo.changes)
+	}
+	changes, err := f.history.Undo(ctx, f.changeRepo.changes[0])
+	if err != nil {
+		t.Fatalf("Undo failed: %v", err)
+	}
+	if got := f.certRepo.items[cert.ID]; *got.ExpiryDate != "2027-03" || got.Expiry.Year != 2027 {
+		t.Errorf("expected the old expiry to be restored and parsed, got %+v", got)
+	}
+	if len(changes) != 1 || changes[0].RevertsChangeID == nil {
+		t.Errorf("expected the undo to be recorded as reverting the change, got %+v", changes)
+	}
+}
+
+func TestHistoryUndoSectionDeleteAndCreate(t *testing.T) {
+	f := newHistoryFixture()
+	ctx := context.Background()
+
+	award := &domain.ProfileAward{ID: uuid.New(), ProfileID: f.profile.ID, Title: "Hackathon Winner", Issuer: stringPtr("Acme")}
+	f.record(t, time.Now(), AwardChanges(award, nil))
+
+	if _, err := f.history.Undo(ctx, f.changeRepo.changes[0]); err != nil {
+		t.Fatalf("Undo of the deletion failed: %v", err)
+	}
+	restored := f.awardRepo.items[award.ID]
+	if restored == nil || restored.Title != "Hackathon Winner" || restored.Issuer == nil || *restored.Issuer != "Acme" {
+		t.Fatalf("expected the award to be restored under its old ID, got %+v", restored)
+	}
+
+	// The restore is itself a creation, and undoing it deletes the award again
+	if _, err := f.history.Undo(ctx, f.changeRepo.changes[1]); err != nil {
+		t.Fatalf("Undo of the restore failed: %v", err)
+	}
+	if _, ok := f.awardRepo.items[award.ID]; ok {
+		t.Error("expected the award to be deleted again")
+	}
+}
+
+func TestHistoryProfileAt(t *testing.T) {
+	f := newHistoryFixture()
+	ctx := context.Background()
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Day 1: the user writes a summary and adds a skill and an experience
+	f.profile.Summary = stringPtr("Hand-written summary")
+	goSkill := &domain.ProfileSkill{ID: uuid.New(), ProfileID: f.profile.ID, Name: "Go", Category: "TECHNICAL"}
+	f.skillRepo.skills[goSkill.ID] = goSkill
+	exp := &domain.ProfileExperience{ID: uuid.New(), ProfileID: f.profile.ID, Company: "Acme", Title: "Engineer"}
+	f.expRepo.experiences[exp.ID] = exp
+	f.record(t, t0.Add(24*time.Hour), append(SkillChanges(nil, goSkill), ExperienceChanges(nil, exp)...))
+
+	// Day 3: a re-import overwrites the summary, and the user deletes the skill, adds
+	// another and renames the experience
+	before := *f.profile
+	before.Summary = stringPtr("Hand-written summary")
+	f.profile.Summary = stringPtr("Extracted summary")
+	delete(f.skillRepo.skills, goSkill.ID)
+	rust := &domain.ProfileSkill{ID: uuid.New(), ProfileID: f.profile.ID, Name: "Rust", Category: "TECHNICAL"}
+	f.skillRepo.skills[rust.ID] = rust
+	renamed := *exp
+	renamed.Title = "Staff Engineer"
+	f.expRepo.experiences[exp.ID] = &renamed
+	changes := ProfileHeaderChanges(&before, f.profile)
+	changes = append(changes, SkillChanges(goSkill, nil)...)
+	changes = append(changes, SkillChanges(nil, rust)...)
+	changes = append(changes, ExperienceChanges(exp, &renamed)...)
+	f.record(t, t0.Add(72*time.Hour), changes)
+
+	snapshot, err := f.history.ProfileAt(ctx, f.profile.ID, t0.Add(48*time.Hour))
+	if err != nil {
+		t.Fatalf("ProfileAt failed: %v", err)
+	}
+	if snapshot.Profile.Summary == nil || *snapshot.Profile.Summary != "Hand-written summary" {
+		t.Errorf("expected the hand-written summary, got %v", snapshot.Profile.Summary)
+	}
+	if len(snapshot.Skills) != 1 || snapshot.Skills[0].Name != "Go" || snapshot.Skills[0].ID != goSkill.ID {
+		t.Errorf("expected only the deleted Go skill, got %+v", snapshot.Skills)
+	}
+	if len(snapshot.Experiences) != 1 || snapshot.Experiences[0].Title != "Engineer" {
+		t.Errorf("expected the experience under its old title, got %+v", snapshot.Experiences)
+	}
+
+	// The reconstruction leaves the current profile alone
+	if *f.profile.Summary != "Extracted summary" || f.expRepo.experiences[exp.ID].Title != "Staff Engineer" {
+		t.Error("expected the current profile to be unchanged")
+	}
+
+	// Before anything was logged, the profile had no items
+	snapshot, err = f.history.ProfileAt(ctx, f.profile.ID, t0)
+	if err != nil {
+		t.Fatalf("ProfileAt failed: %v", err)
+	}
+	if len(snapshot.Skills) != 0 || len(snapshot.Experiences) != 0 {
+		t.Errorf("expected an empty profile, got %d skills and %d experiences", len(snapshot.Skills), len(snapshot.Experiences))
+	}
+}
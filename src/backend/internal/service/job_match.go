@@ -0,0 +1,428 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+	"backend/internal/taxonomy"
+)
+
+// JobRequirementKind is the kind of requirement a job description states.
+type JobRequirementKind string
+
+// Job requirement kinds.
+const (
+	JobRequirementRequiredSkill  JobRequirementKind = "required_skill"
+	JobRequirementPreferredSkill JobRequirementKind = "preferred_skill"
+	JobRequirementSeniority      JobRequirementKind = "seniority"
+	JobRequirementDomain         JobRequirementKind = "domain"
+)
+
+// JobRequirementStatus is how well a profile meets a requirement.
+type JobRequirementStatus string
+
+// Job requirement statuses.
+const (
+	JobRequirementMatched JobRequirementStatus = "matched"
+	JobRequirementPartial JobRequirementStatus = "partial"
+	JobRequirementMissing JobRequirementStatus = "missing"
+)
+
+// jobMatchWeights weights the kinds of requirement in the fit score. Kinds a job
+// description does not state are left out and the others scaled up to sum to 1.
+var jobMatchWeights = []struct {
+	kind   JobRequirementKind
+	weight float64
+}{
+	{JobRequirementRequiredSkill, 0.6},
+	{JobRequirementPreferredSkill, 0.15},
+	{JobRequirementSeniority, 0.15},
+	{JobRequirementDomain, 0.1},
+}
+
+// Credit of a skill the profile has: claimed on the profile or in an experience, and
+// backed by a reference letter validation or testimonial.
+const (
+	skillClaimedCredit = 0.75
+	skillBackedCredit  = 1.0
+)
+
+// JobRequirementMatch is one requirement of a job description and the evidence on the
+// profile that meets it. Credit is the share of the requirement met, from 0 to 1, and
+// Detail explains it in words. For a skill, Skill is the matching profile skill if any,
+// Experiences the experiences mentioning the skill and Testimonials the quotes backing it.
+// For seniority, Experiences are the dated roles counted; for the domain, the roles in it.
+type JobRequirementMatch struct {
+	Kind            JobRequirementKind
+	Requirement     string
+	Status          JobRequirementStatus
+	Credit          float64
+	Detail          string
+	Skill           *domain.ProfileSkill
+	ValidationCount int
+	Experiences     []*domain.ProfileExperience
+	Testimonials    []*domain.Testimonial
+}
+
+// JobMatch is how well a profile fits a job description. Score is from 0 to 1. Matched
+// lists the requirements the profile meets and Gaps those it meets partly or not at all,
+// each in the order the job description states them: required skills, preferred skills,
+// seniority, domain.
+type JobMatch struct {
+	JobDescription    *domain.JobDescription
+	Data              *domain.ExtractedJobDescriptionData
+	Score             float64
+	YearsOfExperience float64
+	Matched           []*JobRequirementMatch
+	Gaps              []*JobRequirementMatch
+}
+
+// JobMatchService matches profiles against job descriptions. Job descriptions are saved
+// per user with their extracted requirements, so matching one again needs no extraction.
+type JobMatchService struct {
+	extractor        domain.DocumentExtractor
+	jobDescRepo      domain.JobDescriptionRepository
+	profileSkillRepo domain.ProfileSkillRepository
+	profileExpRepo   domain.ProfileExperienceRepository
+	testimonialRepo  domain.TestimonialRepository
+	skillValRepo     domain.SkillValidationRepository
+	skillRepo        domain.SkillRepository
+	now              func() time.Time
+}
+
+// NewJobMatchService creates a new JobMatchService.
+func NewJobMatchService(
+	extractor domain.DocumentExtractor,
+	jobDescRepo domain.JobDescriptionRepository,
+	profileSkillRepo domain.ProfileSkillRepository,
+	profileExpRepo domain.ProfileExperienceRepository,
+	testimonialRepo domain.TestimonialRepository,
+	skillValRepo domain.SkillValidationRepository,
+	skillRepo domain.SkillRepository,
+) *JobMatchService {
+	return &JobMatchService{
+		extractor:        extractor,
+		jobDescRepo:      jobDescRepo,
+		profileSkillRepo: profileSkillRepo,
+		profileExpRepo:   profileExpRepo,
+		testimonialRepo:  testimonialRepo,
+		skillValRepo:     skillValRepo,
+		skillRepo:        skillRepo,
+		now:              time.Now,
+	}
+}
+
+// MatchText extracts the requirements of a job description, saves it for the profile's
+// user and matches the profile against it.
+func (s *JobMatchService) MatchText(ctx context.Context, profile *domain.Profile, text string) (*JobMatch, error) {
+	data, err := s.extractor.ExtractJobDescriptionData(ctx, text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract job description: %w", err)
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal job description data: %w", err)
+	}
+	jd := &domain.JobDescription{
+		ID:            uuid.New(),
+		UserID:        profile.UserID,
+		Title:         data.Title,
+		Company:       data.Company,
+		Text:          text,
+		ExtractedData: dataJSON,
+	}
+	if err := s.jobDescRepo.Create(ctx, jd); err != nil {
+		return nil, fmt.Errorf("failed to save job description: %w", err)
+	}
+	return s.match(ctx, profile.ID, jd, data)
+}
+
+// MatchSaved matches a profile against a saved job description.
+func (s *JobMatchService) MatchSaved(ctx context.Context, profile *domain.Profile, jd *domain.JobDescription) (*JobMatch, error) {
+	var data domain.ExtractedJobDescriptionData
+	if err := json.Unmarshal(jd.ExtractedData, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode job description data: %w", err)
+	}
+	return s.match(ctx, profile.ID, jd, &data)
+}
+
+// jobMatchData is everything on a profile that job matching draws on.
+type jobMatchData struct {
+	skills       []*domain.ProfileSkill
+	experiences  []*domain.ProfileExperience
+	testimonials []*domain.Testimonial
+	validations  map[uuid.UUID][]*domain.SkillValidation // keyed by profile skill ID
+}
+
+func (s *JobMatchService) match(ctx context.Context, profileID uuid.UUID, jd *domain.JobDescription, data *domain.ExtractedJobDescriptionData) (*JobMatch, error) {
+	profile := &jobMatchData{validations: map[uuid.UUID][]*domain.SkillValidation{}}
+	var err error
+	if profile.skills, err = s.profileSkillRepo.GetByProfileID(ctx, profileID); err != nil {
+		return nil, fmt.Errorf("failed to get profile skills: %w", err)
+	}
+	if profile.experiences, err = s.profileExpRepo.GetByProfileID(ctx, profileID); err != nil {
+		return nil, fmt.Errorf("failed to get profile experiences: %w", err)
+	}
+	if profile.testimonials, err = s.testimonialRepo.GetByProfileID(ctx, profileID); err != nil {
+		return nil, fmt.Errorf("failed to get testimonials: %w", err)
+	}
+	for _, skill := range profile.skills {
+		validations, valErr := s.skillValRepo.GetByProfileSkillID(ctx, skill.ID)
+		if valErr != nil {
+			return nil, fmt.Errorf("failed to get validations for skill %s: %w", skill.ID, valErr)
+		}
+		profile.validations[skill.ID] = validations
+	}
+	tax, err := LoadSkillTaxonomy(ctx, s.skillRepo, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := matchJobDescription(tax, profile, data, s.now())
+	result.JobDescription = jd
+	return result, nil
+}
+
+// matchJobDescription matches the profile data against each requirement of a job
+// description and scores the fit as of now.
+func matchJobDescription(tax *taxonomy.Taxonomy, profile *jobMatchData, data *domain.ExtractedJobDescriptionData, now time.Time) *JobMatch {
+	texts := make(map[uuid.UUID][]*taxonomy.Text, len(profile.experiences))
+	for _, exp := range profile.experiences {
+		texts[exp.ID] = append(texts[exp.ID], tax.Prepare(exp.Title))
+		for _, h := range exp.Highlights {
+			texts[exp.ID] = append(texts[exp.ID], tax.PrepareProse(h))
+		}
+		if exp.Description != nil {
+			texts[exp.ID] = append(texts[exp.ID], tax.PrepareProse(*exp.Description))
+		}
+	}
+
+	timeline := BuildCareerTimeline(profile.experiences, nil, 1, now)
+	result := &JobMatch{
+		Data:              data,
+		YearsOfExperience: math.Round(float64(timeline.TotalExperienceMonths)/12*10) / 10,
+		Matched:           []*JobRequirementMatch{},
+		Gaps:              []*JobRequirementMatch{},
+	}
+
+	var requirements []*JobRequirementMatch
+	for _, name := range data.RequiredSkills {
+		requirements = append(requirements, matchSkillRequirement(tax, profile, texts, JobRequirementRequiredSkill, name))
+	}
+	for _, name := range data.PreferredSkills {
+		requirements = append(requirements, matchSkillRequirement(tax, profile, texts, JobRequirementPreferredSkill, name))
+	}
+	if req := matchSeniorityRequirement(profile, data, result.YearsOfExperience, now); req != nil {
+		requirements = append(requirements, req)
+	}
+	if data.Domain != nil && strings.TrimSpace(*data.Domain) != "" {
+		requirements = append(requirements, matchDomainRequirement(tax, profile, *data.Domain))
+	}
+
+	credit := map[JobRequirementKind][]float64{}
+	for _, req := range requirements {
+		credit[req.Kind] = append(credit[req.Kind], req.Credit)
+		if req.Status == JobRequirementMatched {
+			result.Matched = append(result.Matched, req)
+		} else {
+			result.Gaps = append(result.Gaps, req)
+		}
+	}
+	var score, weights float64
+	for _, w := range jobMatchWeights {
+		if len(credit[w.kind]) == 0 {
+			continue
+		}
+		var sum float64
+		for _, c := range credit[w.kind] {
+			sum += c
+		}
+		score += w.weight * sum / float64(len(credit[w.kind]))
+		weights += w.weight
+	}
+	if weights > 0 {
+		result.Score = round2(score / weights)
+	}
+	return result
+}
+
+// matchSkillRequirement looks for a skill on the profile: as a profile skill, in the title,
+// description or highlights of an experience, or among the skills testimonials mention.
+// A skill found anywhere is matched; it earns full credit when a reference letter
+// validation or testimonial backs it.
+func matchSkillRequirement(tax *taxonomy.Taxonomy, profile *jobMatchData, texts map[uuid.UUID][]*taxonomy.Text, kind JobRequirementKind, name string) *JobRequirementMatch {
+	req := &JobRequirementMatch{Kind: kind, Requirement: name, Status: JobRequirementMissing, Experiences: []*domain.ProfileExperience{}, Testimonials: []*domain.Testimonial{}}
+
+	req.Skill = tax.FindProfileSkill(profile.skills, name)
+	mentions := func(text *taxonomy.Text) bool {
+		if req.Skill != nil {
+			return tax.MentionsProfileSkill(text, req.Skill)
+		}
+		return tax.MentionsProfileSkill(text, &domain.ProfileSkill{Name: name})
+	}
+	for _, exp := range profile.experiences {
+		for _, text := range texts[exp.ID] {
+			if mentions(text) {
+				req.Experiences = append(req.Experiences, exp)
+				break
+			}
+		}
+	}
+
+	seen := map[uuid.UUID]bool{}
+	addTestimonial := func(t *domain.Testimonial) {
+		if t != nil && !seen[t.ID] {
+			seen[t.ID] = true
+			req.Testimonials = append(req.Testimonials, t)
+		}
+	}
+	byID := make(map[uuid.UUID]*domain.Testimonial, len(profile.testimonials))
+	for _, t := range profile.testimonials {
+		byID[t.ID] = t
+	}
+	if req.Skill != nil {
+		validations := profile.validations[req.Skill.ID]
+		req.ValidationCount = len(validations)
+		for _, v := range validations {
+			if v.TestimonialID != nil {
+				addTestimonial(byID[*v.TestimonialID])
+			}
+		}
+	}
+	for _, t := range profile.testimonials {
+		for _, mentioned := range t.SkillsMentioned {
+			if req.Skill != nil && tax.MatchProfileSkill(req.Skill, mentioned) || req.Skill == nil && tax.Match(name, mentioned) {
+				addTestimonial(t)
+				break
+			}
+		}
+	}
+
+	var sources []string
+	switch {
+	case req.ValidationCount > 0:
+		sources = append(sources, fmt.Sprintf("validated by %d reference %s", req.ValidationCount, plural(req.ValidationCount, "letter", "letters")))
+	case len(req.Testimonials) > 0:
+		sources = append(sources, fmt.Sprintf("mentioned in %d %s", len(req.Testimonials), plural(len(req.Testimonials), "testimonial", "testimonials")))
+	}
+	if req.Skill != nil {
+		sources = append(sources, "listed as "+req.Skill.Name)
+	}
+	if len(req.Experiences) > 0 {
+		sources = append(sources, fmt.Sprintf("used in %d %s", len(req.Experiences), plural(len(req.Experiences), "role", "roles")))
+	}
+	switch {
+	case len(sources) == 0:
+		req.Detail = "Not found on the profile"
+	case req.ValidationCount > 0 || len(req.Testimonials) > 0:
+		req.Status, req.Credit = JobRequirementMatched, skillBackedCredit
+	default:
+		req.Status, req.Credit = JobRequirementMatched, skillClaimedCredit
+	}
+	if len(sources) > 0 {
+		req.Detail = capitalize(strings.Join(sources, ", "))
+	}
+	return req
+}
+
+// matchSeniorityRequirement compares the profile's years of experience, overlapping roles
+// counted once, with the years the job asks for: the stated minimum, else the years its
+// seniority usually takes. It returns nil when the job asks for neither or for no experience.
+func matchSeniorityRequirement(profile *jobMatchData, data *domain.ExtractedJobDescriptionData, years float64, now time.Time) *JobRequirementMatch {
+	var needed float64
+	var requirement string
+	switch {
+	case data.MinYearsExperience != nil:
+		needed = float64(*data.MinYearsExperience)
+		requirement = fmt.Sprintf("%d+ years of experience", *data.MinYearsExperience)
+		if data.Seniority != nil {
+			requirement = capitalize(string(*data.Seniority)) + " level, " + requirement
+		}
+	case data.Seniority != nil:
+		needed = data.Seniority.TypicalYears()
+		requirement = capitalize(string(*data.Seniority)) + " level"
+	default:
+		return nil
+	}
+
+	req := &JobRequirementMatch{Kind: JobRequirementSeniority, Requirement: requirement, Experiences: []*domain.ProfileExperience{}, Testimonials: []*domain.Testimonial{}}
+	for _, exp := range profile.experiences {
+		if _, _, ok := exp.Dates().Months(now); ok {
+			req.Experiences = append(req.Experiences, exp)
+		}
+	}
+	req.Detail = fmt.Sprintf("%.1f years of experience", years)
+	switch {
+	case years >= needed:
+		req.Status, req.Credit = JobRequirementMatched, 1
+	case years > 0:
+		req.Status, req.Credit = JobRequirementPartial, round2(years/needed)
+		req.Detail += fmt.Sprintf(", %.0f asked for", needed)
+	default:
+		req.Status = JobRequirementMissing
+		req.Detail = "No dated experience on the profile"
+	}
+	return req
+}
+
+// matchDomainRequirement looks for the job's domain in the company, title, description
+// and highlights of the profile's experiences, and among its domain skills.
+func matchDomainRequirement(tax *taxonomy.Taxonomy, profile *jobMatchData, jobDomain string) *JobRequirementMatch {
+	req := &JobRequirementMatch{Kind: JobRequirementDomain, Requirement: jobDomain, Status: JobRequirementMissing, Experiences: []*domain.ProfileExperience{}, Testimonials: []*domain.Testimonial{}}
+	phrase := " " + strings.Join(normalize.Tokens(jobDomain), " ") + " "
+	if strings.TrimSpace(phrase) == "" {
+		return req
+	}
+	for _, exp := range profile.experiences {
+		passages := append([]string{exp.Company, exp.Title}, exp.Highlights...)
+		if exp.Description != nil {
+			passages = append(passages, *exp.Description)
+		}
+		for _, p := range passages {
+			if strings.Contains(" "+strings.Join(normalize.Tokens(p), " ")+" ", phrase) {
+				req.Experiences = append(req.Experiences, exp)
+				break
+			}
+		}
+	}
+	for _, skill := range profile.skills {
+		if strings.EqualFold(skill.Category, string(domain.SkillCategoryDomain)) && tax.MatchProfileSkill(skill, jobDomain) {
+			req.Skill = skill
+			break
+		}
+	}
+
+	switch {
+	case len(req.Experiences) > 0:
+		req.Status, req.Credit = JobRequirementMatched, 1
+		req.Detail = fmt.Sprintf("Worked in %s in %d %s", jobDomain, len(req.Experiences), plural(len(req.Experiences), "role", "roles"))
+	case req.Skill != nil:
+		req.Status, req.Credit = JobRequirementMatched, skillClaimedCredit
+		req.Detail = "Listed as " + req.Skill.Name
+	default:
+		req.Detail = "No experience in " + jobDomain + " on the profile"
+	}
+	return req
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/taxonomy"
+)
+
+func TestMatchJobDescription(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	goSkill := &domain.ProfileSkill{ID: uuid.New(), Name: "Go", NormalizedName: "go", Category: string(domain.SkillCategoryTechnical)}
+	k8sSkill := &domain.ProfileSkill{ID: uuid.New(), Name: "Kubernetes", NormalizedName: "kubernetes", Category: string(domain.SkillCategoryTechnical)}
+	payments := &domain.ProfileExperience{ID: uuid.New(), Company: "Stripe", Title: "Backend Engineer", Description: stringPtr("Built Go services for payments"), StartDate: stringPtr("2018-01"), EndDate: stringPtr("2022-12")}
+	platform := &domain.ProfileExperience{ID: uuid.New(), Company: "Acme", Title: "Platform Engineer", Description: stringPtr("Ran Kubernetes clusters"), StartDate: stringPtr("2023-01"), IsCurrent: true}
+	manager := &domain.Testimonial{ID: uuid.New(), Quote: "The best Go engineer I have worked with.", SkillsMentioned: []string{"Golang"}}
+	peer := &domain.Testimonial{ID: uuid.New(), Quote: "Tuned every slow query we had.", SkillsMentioned: []string{"Postgres"}}
+	for _, exp := range []*domain.ProfileExperience{payments, platform} {
+		if err := SetExperienceDates(exp); err != nil {
+			t.Fatalf("invalid dates for %s: %v", exp.Title, err)
+		}
+	}
+	profile := &jobMatchData{
+		skills:       []*domain.ProfileSkill{goSkill, k8sSkill},
+		experiences:  []*domain.ProfileExperience{payments, platform},
+		testimonials: []*domain.Testimonial{manager, peer},
+		validations: map[uuid.UUID][]*domain.SkillValidation{
+			goSkill.ID: {{ProfileSkillID: goSkill.ID, TestimonialID: &manager.ID}},
+		},
+	}
+	senior := domain.JobSenioritySenior
+	minYears := 10
+	data := &domain.ExtractedJobDescriptionData{
+		Seniority:          &senior,
+		MinYearsExperience: &minYears,
+		Domain:             stringPtr("Payments"),
+		RequiredSkills:     []string{"Golang", "Kubernetes", "Rust"},
+		PreferredSkills:    []string{"PostgreSQL"},
+	}
+
+	match := matchJobDescription(taxonomy.Default(), profile, data, now)
+
+	if match.YearsOfExperience != 8.8 {
+		t.Errorf("expected 8.8 years of experience (106 months), got %v", match.YearsOfExperience)
+	}
+	// required (1 + 0.75 + 0) / 3 × 0.6 + preferred 1 × 0.15 + seniority 0.88 × 0.15 + domain 1 × 0.1
+	if match.Score != 0.73 {
+		t.Errorf("expected a score of 0.73, got %v", match.Score)
+	}
+
+	matched := map[string]*JobRequirementMatch{}
+	for _, req := range match.Matched {
+		matched[req.Requirement] = req
+	}
+	if len(match.Matched) != 4 {
+		t.Fatalf("expected 4 matched requirements, got %d", len(match.Matched))
+	}
+	if req := matched["Golang"]; req == nil || req.Skill != goSkill || req.ValidationCount != 1 || req.Credit != skillBackedCredit ||
+		len(req.Testimonials) != 1 || req.Testimonials[0] != manager || len(req.Experiences) != 1 || req.Experiences[0] != payments {
+		t.Errorf("expected Golang to match the validated Go skill, its testimonial and role, got %+v", req)
+	}
+	if req := matched["Kubernetes"]; req == nil || req.Credit != skillClaimedCredit || req.Detail != "Listed as Kubernetes, used in 1 role" {
+		t.Errorf("expected Kubernetes to match as a claimed skill, got %+v", req)
+	}
+	if req := matched["PostgreSQL"]; req == nil || req.Skill != nil || req.Credit != skillBackedCredit || req.Detail != "Mentioned in 1 testimonial" {
+		t.Errorf("expected PostgreSQL to match through a testimonial, got %+v", req)
+	}
+	if req := matched["Payments"]; req == nil || req.Kind != JobRequirementDomain || len(req.Experiences) != 1 || req.Experiences[0] != payments {
+		t.Errorf("expected the payments domain to match the payments role, got %+v", req)
+	}
+
+	if len(match.Gaps) != 2 {
+		t.Fatalf("expected 2 gaps, got %d", len(match.Gaps))
+	}
+	if gap := match.Gaps[0]; gap.Requirement != "Rust" || gap.Status != JobRequirementMissing || gap.Credit != 0 {
+		t.Errorf("expected Rust to be missing, got %+v", gap)
+	}
+	if gap := match.Gaps[1]; gap.Kind != JobRequirementSeniority || gap.Status != JobRequirementPartial || gap.Credit != 0.88 ||
+		gap.Requirement != "Senior level, 10+ years of experience" || gap.Detail != "8.8 years of experience, 10 asked for" {
+		t.Errorf("expected seniority to be partly met, got %+v", gap)
+	}
+}
+
+func TestMatchJobDescriptionScalesWeights(t *testing.T) {
+	goSkill := &domain.ProfileSkill{ID: uuid.New(), Name: "Go", NormalizedName: "go"}
+	profile := &jobMatchData{skills: []*domain.ProfileSkill{goSkill}, validations: map[uuid.UUID][]*domain.SkillValidation{}}
+	data := &domain.ExtractedJobDescriptionData{RequiredSkills: []string{"Go"}}
+
+	// Only required skills are stated, so a claimed skill scores its credit alone.
+	match := matchJobDescription(taxonomy.Default(), profile, data, time.Now())
+	if match.Score != skillClaimedCredit {
+		t.Errorf("expected a score of %v, got %v", skillClaimedCredit, match.Score)
+	}
+	if len(match.Gaps) != 0 {
+		t.Errorf("expected no gaps, got %d", len(match.Gaps))
+	}
+}
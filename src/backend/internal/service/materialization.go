@@ -5,26 +5,42 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 
 	"backend/internal/domain"
+	"backend/internal/normalize"
 	"backend/internal/repository/postgres"
+	"backend/internal/taxonomy"
 )
 
 // MaterializationResult contains counts of materialized items.
 type MaterializationResult struct {
-	Experiences  int
-	Educations   int
-	Skills       int
-	Testimonials int
+	Experiences        int
+	Educations         int
+	Skills             int
+	Testimonials       int
+	VerifiedEducations int
+	// Removed counts the profile items a resume merge removed.
+	Removed int
+	// The profile sections beyond experience, education and skills.
+	Links          int
+	Certifications int
+	Languages      int
+	Projects       int
+	Publications   int
+	Awards         int
 }
 
-// CrossReferenceResult contains counts of auto-applied validations.
+// CrossReferenceResult contains counts of auto-applied validations and of the
+// discrepancies found between the letter and the profile.
 type CrossReferenceResult struct {
 	SkillValidations      int
 	ExperienceValidations int
+	EducationValidations  int
+	Inconsistencies       int
 }
 
 // MaterializationService handles materializing extracted data into profile tables.
@@ -40,11 +56,21 @@ type MaterializationService struct {
 	testimonialRepo  domain.TestimonialRepository
 	skillValRepo     domain.SkillValidationRepository
 	expValRepo       domain.ExperienceValidationRepository
+	eduValRepo       domain.EducationValidationRepository
+	companyAliasRepo domain.CompanyAliasRepository
+	companyRepo      domain.CompanyRepository
+	skillRepo        domain.SkillRepository
+	changeRepo       domain.ProfileChangeRepository
+	sections         sectionRepos
+	skillStats       *SkillStatsService
+	consistency      *ConsistencyService
+	embeddings       *EmbeddingService
 }
 
 // NewMaterializationService creates a new MaterializationService.
 // The db parameter is optional (can be nil) for testing with mocks.
 // For production use, it's required for transaction support.
+// The embedding service is optional; without one, skill mentions are matched by name only.
 func NewMaterializationService(
 	db *bun.DB,
 	profileRepo domain.ProfileRepository,
@@ -55,6 +81,19 @@ func NewMaterializationService(
 	testimonialRepo domain.TestimonialRepository,
 	skillValRepo domain.SkillValidationRepository,
 	expValRepo domain.ExperienceValidationRepository,
+	eduValRepo domain.EducationValidationRepository,
+	companyAliasRepo domain.CompanyAliasRepository,
+	companyRepo domain.CompanyRepository,
+	skillRepo domain.SkillRepository,
+	inconsistencyRepo domain.ProfileInconsistencyRepository,
+	changeRepo domain.ProfileChangeRepository,
+	linkRepo domain.ProfileLinkRepository,
+	certificationRepo domain.ProfileCertificationRepository,
+	languageRepo domain.ProfileLanguageRepository,
+	projectRepo domain.ProfileProjectRepository,
+	publicationRepo domain.ProfilePublicationRepository,
+	awardRepo domain.ProfileAwardRepository,
+	embeddings *EmbeddingService,
 ) *MaterializationService {
 	return &MaterializationService{
 		db:               db,
@@ -66,14 +105,34 @@ func NewMaterializationService(
 		testimonialRepo:  testimonialRepo,
 		skillValRepo:     skillValRepo,
 		expValRepo:       expValRepo,
+		eduValRepo:       eduValRepo,
+		companyAliasRepo: companyAliasRepo,
+		companyRepo:      companyRepo,
+		skillRepo:        skillRepo,
+		changeRepo:       changeRepo,
+		sections:         sectionRepos{linkRepo, certificationRepo, languageRepo, projectRepo, publicationRepo, awardRepo},
+		skillStats:       NewSkillStatsService(profileExpRepo, profileSkillRepo, testimonialRepo, authorRepo, skillValRepo, skillRepo),
+		consistency:      NewConsistencyService(profileExpRepo, companyAliasRepo, inconsistencyRepo),
+		embeddings:       embeddings,
 	}
 }
 
-// MaterializeResumeData creates profile education, experience, and skill rows from extracted resume data.
+// RecomputeSkillStats refreshes the computed fields of the profile's skills. Materializing
+// does this itself; call it after other changes to experiences, skills or testimonials.
+func (s *MaterializationService) RecomputeSkillStats(ctx context.Context, profileID uuid.UUID) error {
+	if _, err := s.skillStats.Recompute(ctx, profileID); err != nil {
+		return fmt.Errorf("failed to recompute skill stats: %w", err)
+	}
+	return nil
+}
+
+// MaterializeResumeData creates profile education, experience, and skill rows from extracted resume data,
+// along with its links, certifications, languages, projects, publications and awards.
 // This makes profile tables the single source of truth for display.
 // Each category (experiences, education, skills) is processed independently so that failures in one
 // category don't prevent the others from being saved.
 // Idempotent: deletes existing entries from the same resume before re-creating.
+// The changes to the profile are recorded in its change log as an import by the user.
 func (s *MaterializationService) MaterializeResumeData(
 	ctx context.Context,
 	resumeID uuid.UUID,
@@ -91,11 +150,22 @@ func (s *MaterializationService) MaterializeResumeData(
 		return nil, err
 	}
 
+	before, err := loadProfileItems(ctx, s.profileExpRepo, s.profileEduRepo, s.profileSkillRepo, profile.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	result := &MaterializationResult{}
 
 	// If db is nil (testing with mocks), fall back to non-transactional behavior
 	if s.db == nil {
-		return s.materializeResumeDataWithoutTx(ctx, resumeID, profile.ID, data, result)
+		if partial, err := s.materializeResumeDataWithoutTx(ctx, resumeID, profile.ID, data, result); err != nil {
+			return partial, err
+		}
+		if err := s.recordImport(ctx, s.changeRepo, s.profileExpRepo, s.profileEduRepo, s.profileSkillRepo, userID, profile.ID, before); err != nil {
+			return result, err
+		}
+		return result, s.RecomputeSkillStats(ctx, profile.ID)
 	}
 
 	// Wrap delete+create cycle in a transaction for atomicity
@@ -105,6 +175,7 @@ func (s *MaterializationService) MaterializeResumeData(
 		txExpRepo := postgres.NewProfileExperienceRepository(tx)
 		txEduRepo := postgres.NewProfileEducationRepository(tx)
 		txSkillRepo := postgres.NewProfileSkillRepository(tx)
+		txCompanyRepo := postgres.NewCompanyRepository(tx)
 
 		// Delete any existing entries from this resume (idempotent re-processing)
 		if delErr := txExpRepo.DeleteBySourceResumeID(ctx, resumeID); delErr != nil {
@@ -116,11 +187,15 @@ func (s *MaterializationService) MaterializeResumeData(
 		if delErr := txSkillRepo.DeleteBySourceResumeID(ctx, resumeID); delErr != nil {
 			return fmt.Errorf("failed to delete existing skills for resume: %w", delErr)
 		}
+		txSections := newTxSectionRepos(tx)
+		if delErr := txSections.deleteBySourceResumeID(ctx, resumeID); delErr != nil {
+			return delErr
+		}
 
 		var errors []error
 
 		// Materialize experiences using transactional repository
-		if expCount, expErr := s.materializeExperiencesWithRepo(ctx, txExpRepo, resumeID, profile.ID, data.Experience); expErr != nil {
+		if expCount, expErr := s.materializeExperiencesWithRepo(ctx, txExpRepo, txCompanyRepo, resumeID, profile.ID, data.Experience); expErr != nil {
 			errors = append(errors, fmt.Errorf("experiences: %w", expErr))
 		} else {
 			result.Experiences = expCount
@@ -140,18 +215,23 @@ func (s *MaterializationService) MaterializeResumeData(
 			result.Skills = skillCount
 		}
 
+		// Materialize the other resume sections using transactional repositories
+		if sectionErr := materializeSectionsWithRepos(ctx, txSections, resumeID, profile.ID, data, result); sectionErr != nil {
+			errors = append(errors, sectionErr)
+		}
+
 		if len(errors) > 0 {
 			return fmt.Errorf("materialization errors: %v", errors)
 		}
 
-		return nil
+		return s.recordImport(ctx, postgres.NewProfileChangeRepository(tx), txExpRepo, txEduRepo, txSkillRepo, userID, profile.ID, before)
 	})
 
 	if txErr != nil {
 		return result, txErr
 	}
 
-	return result, nil
+	return result, s.RecomputeSkillStats(ctx, profile.ID)
 }
 
 // materializeResumeDataWithoutTx is the fallback for tests that use mocks (no db)
@@ -166,6 +246,9 @@ func (s *MaterializationService) materializeResumeDataWithoutTx(ctx context.Cont
 	if delErr := s.profileSkillRepo.DeleteBySourceResumeID(ctx, resumeID); delErr != nil {
 		return nil, fmt.Errorf("failed to delete existing skills for resume: %w", delErr)
 	}
+	if delErr := s.sections.deleteBySourceResumeID(ctx, resumeID); delErr != nil {
+		return nil, delErr
+	}
 
 	var errors []error
 
@@ -187,6 +270,10 @@ func (s *MaterializationService) materializeResumeDataWithoutTx(ctx context.Cont
 		result.Skills = skillCount
 	}
 
+	if sectionErr := materializeSectionsWithRepos(ctx, s.sections, resumeID, profileID, data, result); sectionErr != nil {
+		errors = append(errors, sectionErr)
+	}
+
 	if len(errors) > 0 {
 		return result, fmt.Errorf("materialization errors: %v", errors)
 	}
@@ -196,42 +283,122 @@ func (s *MaterializationService) materializeResumeDataWithoutTx(ctx context.Cont
 
 // materializeExperiences is the legacy method that uses the service's repository
 func (s *MaterializationService) materializeExperiences(ctx context.Context, resumeID, profileID uuid.UUID, experiences []domain.WorkExperience) (int, error) {
-	return s.materializeExperiencesWithRepo(ctx, s.profileExpRepo, resumeID, profileID, experiences)
+	return s.materializeExperiencesWithRepo(ctx, s.profileExpRepo, s.companyRepo, resumeID, profileID, experiences)
 }
 
-// materializeExperiencesWithRepo accepts a repository parameter for transaction support
-func (s *MaterializationService) materializeExperiencesWithRepo(ctx context.Context, repo domain.ProfileExperienceRepository, resumeID, profileID uuid.UUID, experiences []domain.WorkExperience) (int, error) {
-	displayOrder, err := repo.GetNextDisplayOrder(ctx, profileID)
+// materializeExperiencesWithRepo accepts repository parameters for transaction support
+// Each experience is linked to the profile's company of the same name, which is created if needed.
+func (s *MaterializationService) materializeExperiencesWithRepo(ctx context.Context, repo domain.ProfileExperienceRepository, companyRepo domain.CompanyRepository, resumeID, profileID uuid.UUID, experiences []domain.WorkExperience) (int, error) {
+	matcher, err := s.companyMatcher(ctx, profileID)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get next experience display order: %w", err)
+		return 0, err
 	}
 
-	for i, exp := range experiences {
-		originalJSON, marshalErr := json.Marshal(exp)
-		if marshalErr != nil {
-			return i, fmt.Errorf("failed to marshal experience original data: %w", marshalErr)
-		}
-
-		profileExp := &domain.ProfileExperience{
-			ID:             uuid.New(),
-			ProfileID:      profileID,
-			Company:        exp.Company,
-			Title:          exp.Title,
-			Location:       exp.Location,
-			StartDate:      exp.StartDate,
-			EndDate:        exp.EndDate,
-			IsCurrent:      exp.IsCurrent,
-			Description:    exp.Description,
-			DisplayOrder:   displayOrder + i,
-			Source:         domain.ExperienceSourceResumeExtracted,
-			SourceResumeID: &resumeID,
-			OriginalData:   originalJSON,
+	// Build every entry first so the batch can be placed among the profile's experiences
+	// by recency.
+	profileExps := make([]*domain.ProfileExperience, 0, len(experiences))
+	for _, exp := range experiences {
+		profileExp, buildErr := newResumeExperience(resumeID, profileID, exp)
+		if buildErr != nil {
+			return 0, buildErr
+		}
+		if profileExp.CompanyID, err = LinkCompany(ctx, companyRepo, matcher, profileID, exp.Company); err != nil {
+			return 0, err
 		}
+		profileExps = append(profileExps, profileExp)
+	}
+	if err := PlaceExperiences(ctx, repo, profileExps); err != nil {
+		return 0, err
+	}
+
+	for i, profileExp := range profileExps {
 		if createErr := repo.Create(ctx, profileExp); createErr != nil {
-			return i, fmt.Errorf("failed to create experience for %s at %s: %w", exp.Title, exp.Company, createErr)
+			return i, fmt.Errorf("failed to create experience for %s at %s: %w", profileExp.Title, profileExp.Company, createErr)
+		}
+	}
+	return len(profileExps), nil
+}
+
+// newResumeExperience builds the profile experience for a resume entry, keeping the entry as
+// its original data. It is not yet linked to a company.
+func newResumeExperience(resumeID, profileID uuid.UUID, exp domain.WorkExperience) (*domain.ProfileExperience, error) {
+	originalJSON, err := json.Marshal(exp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal experience original data: %w", err)
+	}
+
+	profileExp := &domain.ProfileExperience{
+		ID:             uuid.New(),
+		ProfileID:      profileID,
+		Company:        exp.Company,
+		Title:          exp.Title,
+		Location:       exp.Location,
+		StartDate:      exp.StartDate,
+		EndDate:        exp.EndDate,
+		IsCurrent:      exp.IsCurrent,
+		Source:         domain.ExperienceSourceResumeExtracted,
+		SourceResumeID: &resumeID,
+		OriginalData:   originalJSON,
+	}
+	profileExp.Description, profileExp.Highlights = resumeExperienceText(exp)
+	// Extracted dates are kept as written even when they are inconsistent.
+	_ = SetExperienceDates(profileExp)
+	return profileExp, nil
+}
+
+// resumeExperienceText returns the description and highlights of a resume entry. Entries
+// extracted before highlights were returned separately, or whose bullets the LLM left in
+// the description, have the bullets split out of the description.
+func resumeExperienceText(exp domain.WorkExperience) (*string, []string) {
+	if len(exp.Highlights) > 0 {
+		highlights := make([]string, len(exp.Highlights))
+		for i, h := range exp.Highlights {
+			highlights[i] = h.Text
 		}
+		return exp.Description, highlights
+	}
+	if exp.Description == nil {
+		return nil, nil
+	}
+	prose, bullets := normalize.SplitHighlights(*exp.Description)
+	if len(bullets) == 0 {
+		return exp.Description, nil
+	}
+	if prose == "" {
+		return nil, bullets
+	}
+	return &prose, bullets
+}
+
+// newResumeEducation builds the profile education entry for a resume entry, keeping the
+// entry as its original data.
+func newResumeEducation(resumeID, profileID uuid.UUID, edu domain.Education) (*domain.ProfileEducation, error) {
+	originalJSON, err := json.Marshal(edu)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal education original data: %w", err)
+	}
+
+	degree := "Degree"
+	if edu.Degree != nil && *edu.Degree != "" {
+		degree = *edu.Degree
+	}
+
+	profileEdu := &domain.ProfileEducation{
+		ID:             uuid.New(),
+		ProfileID:      profileID,
+		Institution:    edu.Institution,
+		Degree:         degree,
+		Field:          edu.Field,
+		StartDate:      edu.StartDate,
+		EndDate:        edu.EndDate,
+		Description:    edu.Achievements, // Map achievements -> description
+		GPA:            edu.GPA,
+		Source:         domain.ExperienceSourceResumeExtracted,
+		SourceResumeID: &resumeID,
+		OriginalData:   originalJSON,
 	}
-	return len(experiences), nil
+	_ = SetEducationDates(profileEdu)
+	return profileEdu, nil
 }
 
 // materializeEducation is the legacy method that uses the service's repository
@@ -241,42 +408,24 @@ func (s *MaterializationService) materializeEducation(ctx context.Context, resum
 
 // materializeEducationWithRepo accepts a repository parameter for transaction support
 func (s *MaterializationService) materializeEducationWithRepo(ctx context.Context, repo domain.ProfileEducationRepository, resumeID, profileID uuid.UUID, educations []domain.Education) (int, error) {
-	displayOrder, err := repo.GetNextDisplayOrder(ctx, profileID)
-	if err != nil {
-		return 0, fmt.Errorf("failed to get next education display order: %w", err)
-	}
-
-	for i, edu := range educations {
-		originalJSON, marshalErr := json.Marshal(edu)
-		if marshalErr != nil {
-			return i, fmt.Errorf("failed to marshal education original data: %w", marshalErr)
-		}
-
-		degree := "Degree"
-		if edu.Degree != nil && *edu.Degree != "" {
-			degree = *edu.Degree
+	profileEdus := make([]*domain.ProfileEducation, 0, len(educations))
+	for _, edu := range educations {
+		profileEdu, buildErr := newResumeEducation(resumeID, profileID, edu)
+		if buildErr != nil {
+			return 0, buildErr
 		}
+		profileEdus = append(profileEdus, profileEdu)
+	}
+	if err := PlaceEducations(ctx, repo, profileEdus); err != nil {
+		return 0, err
+	}
 
-		profileEdu := &domain.ProfileEducation{
-			ID:             uuid.New(),
-			ProfileID:      profileID,
-			Institution:    edu.Institution,
-			Degree:         degree,
-			Field:          edu.Field,
-			StartDate:      edu.StartDate,
-			EndDate:        edu.EndDate,
-			Description:    edu.Achievements, // Map achievements -> description
-			GPA:            edu.GPA,
-			DisplayOrder:   displayOrder + i,
-			Source:         domain.ExperienceSourceResumeExtracted,
-			SourceResumeID: &resumeID,
-			OriginalData:   originalJSON,
-		}
+	for i, profileEdu := range profileEdus {
 		if createErr := repo.Create(ctx, profileEdu); createErr != nil {
-			return i, fmt.Errorf("failed to create education for %s: %w", edu.Institution, createErr)
+			return i, fmt.Errorf("failed to create education for %s: %w", profileEdu.Institution, createErr)
 		}
 	}
-	return len(educations), nil
+	return len(profileEdus), nil
 }
 
 // materializeSkills is the legacy method that uses the service's repository
@@ -291,19 +440,35 @@ func (s *MaterializationService) materializeSkillsWithRepo(ctx context.Context,
 		return 0, fmt.Errorf("failed to get next skill display order: %w", err)
 	}
 
-	dedupedSkills := DeduplicateSkills(skills)
+	tax, err := s.skillTaxonomy(ctx, profileID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Names merged into an existing skill stay merged instead of coming back as new skills
+	existing, err := repo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get existing skills: %w", err)
+	}
+	mergedKeys := make(map[string]bool)
+	for _, sk := range existing {
+		for _, alias := range sk.Aliases {
+			mergedKeys[taxonomy.Key(alias)] = true
+		}
+	}
+	var dedupedSkills []string
+	for _, name := range DeduplicateSkills(tax, skills) {
+		if !mergedKeys[taxonomy.Key(name)] {
+			dedupedSkills = append(dedupedSkills, name)
+		}
+	}
 
 	for i, skillName := range dedupedSkills {
-		profileSkill := &domain.ProfileSkill{
-			ID:             uuid.New(),
-			ProfileID:      profileID,
-			Name:           skillName,
-			NormalizedName: strings.ToLower(skillName),
-			Category:       "TECHNICAL",
-			DisplayOrder:   displayOrder + i,
-			Source:         domain.ExperienceSourceResumeExtracted,
-			SourceResumeID: &resumeID,
+		profileSkill, buildErr := newResumeSkill(tax, resumeID, profileID, skillName)
+		if buildErr != nil {
+			return i, buildErr
 		}
+		profileSkill.DisplayOrder = displayOrder + i
 		if createErr := repo.CreateIgnoreDuplicate(ctx, profileSkill); createErr != nil {
 			return i, fmt.Errorf("failed to create skill %q: %w", skillName, createErr)
 		}
@@ -311,31 +476,42 @@ func (s *MaterializationService) materializeSkillsWithRepo(ctx context.Context,
 	return len(dedupedSkills), nil
 }
 
-// DeduplicateSkills removes duplicate skills by normalized name, preserving the first occurrence.
-// It also trims whitespace and filters out empty strings.
-func DeduplicateSkills(skills []string) []string {
-	seen := make(map[string]bool)
-	result := make([]string, 0, len(skills))
-
-	for _, skill := range skills {
-		trimmed := strings.TrimSpace(skill)
-		if trimmed == "" {
-			continue
-		}
-		normalized := strings.ToLower(trimmed)
-		if !seen[normalized] {
-			seen[normalized] = true
-			result = append(result, trimmed)
-		}
+// newResumeSkill builds the profile skill for a skill name listed on a resume, keeping the
+// name as its original data.
+func newResumeSkill(tax *taxonomy.Taxonomy, resumeID, profileID uuid.UUID, name string) (*domain.ProfileSkill, error) {
+	originalJSON, err := json.Marshal(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal skill original data: %w", err)
 	}
-	return result
+	return &domain.ProfileSkill{
+		ID:               uuid.New(),
+		ProfileID:        profileID,
+		Name:             name,
+		NormalizedName:   tax.Normalize(name),
+		CanonicalSkillID: CanonicalSkillID(tax, name),
+		Category:         "TECHNICAL",
+		Source:           domain.ExperienceSourceResumeExtracted,
+		SourceResumeID:   &resumeID,
+		OriginalData:     originalJSON,
+	}, nil
 }
 
-// MaterializeReferenceLetterData creates testimonial rows and ProfileSkill records
-// for discovered skills from extracted reference letter data.
+// DeduplicateSkills removes skills that the taxonomy resolves to the same canonical skill
+// ("k8s" and "Kubernetes"), preserving the first occurrence.
+// It also trims whitespace and filters out empty strings.
+func DeduplicateSkills(tax *taxonomy.Taxonomy, skills []string) []string {
+	return tax.Deduplicate(skills)
+}
+
+// MaterializeReferenceLetterData creates testimonial rows, ProfileSkill records
+// for discovered skills, and ProfileExperience records for discovered experiences
+// from extracted reference letter data.
 // It finds or creates an Author entity, creates Testimonial records for each extracted testimonial,
-// and creates ProfileSkill + SkillValidation records for each discovered skill.
+// creates ProfileSkill + SkillValidation records for each discovered skill, and creates
+// ProfileExperience + ExperienceValidation records for experience mentions that don't match
+// an experience already on the profile. This lets a profile be built from letters alone.
 // Idempotent: deletes existing testimonials from the same reference letter before re-creating.
+// The skills and experiences it adds are recorded in the profile's change log as an import.
 func (s *MaterializationService) MaterializeReferenceLetterData(
 	ctx context.Context,
 	referenceLetterID uuid.UUID,
@@ -348,11 +524,22 @@ func (s *MaterializationService) MaterializeReferenceLetterData(
 		return nil, fmt.Errorf("failed to get or create profile: %w", err)
 	}
 
+	before, err := loadProfileItems(ctx, s.profileExpRepo, s.profileEduRepo, s.profileSkillRepo, profile.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	result := &MaterializationResult{}
 
 	// If db is nil (testing with mocks), fall back to non-transactional behavior
 	if s.db == nil {
-		return s.materializeReferenceLetterDataWithoutTx(ctx, referenceLetterID, profile.ID, data, result)
+		if partial, err := s.materializeReferenceLetterDataWithoutTx(ctx, referenceLetterID, profile.ID, data, result); err != nil {
+			return partial, err
+		}
+		if err := s.recordImport(ctx, s.changeRepo, s.profileExpRepo, s.profileEduRepo, s.profileSkillRepo, userID, profile.ID, before); err != nil {
+			return result, err
+		}
+		return result, s.RecomputeSkillStats(ctx, profile.ID)
 	}
 
 	// Wrap delete+create cycle in a transaction for atomicity
@@ -363,6 +550,9 @@ func (s *MaterializationService) MaterializeReferenceLetterData(
 		txSkillRepo := postgres.NewProfileSkillRepository(tx)
 		txSkillValRepo := postgres.NewSkillValidationRepository(tx)
 		txAuthorRepo := postgres.NewAuthorRepository(tx)
+		txExpRepo := postgres.NewProfileExperienceRepository(tx)
+		txExpValRepo := postgres.NewExperienceValidationRepository(tx)
+		txCompanyRepo := postgres.NewCompanyRepository(tx)
 
 		// Delete any existing testimonials from this reference letter (idempotent re-processing)
 		if delErr := txTestimonialRepo.DeleteByReferenceLetterID(ctx, referenceLetterID); delErr != nil {
@@ -378,12 +568,25 @@ func (s *MaterializationService) MaterializeReferenceLetterData(
 			result.Skills = skillCount
 		}
 
+		// Materialize unmatched experience mentions as ProfileExperience records
+		if len(data.ExperienceMentions) > 0 {
+			expCount, expErr := s.materializeDiscoveredExperiencesWithRepos(ctx, txExpRepo, txExpValRepo, txCompanyRepo, referenceLetterID, profile.ID, data.ExperienceMentions)
+			if expErr != nil {
+				return fmt.Errorf("failed to materialize discovered experiences: %w", expErr)
+			}
+			result.Experiences = expCount
+		}
+
+		if err := s.recordImport(ctx, postgres.NewProfileChangeRepository(tx), txExpRepo, postgres.NewProfileEducationRepository(tx), txSkillRepo, userID, profile.ID, before); err != nil {
+			return err
+		}
+
 		if len(data.Testimonials) == 0 {
 			return nil
 		}
 
 		// Find or create the Author entity
-		author, err := s.findOrCreateAuthorWithRepo(ctx, txAuthorRepo, profile.ID, &data.Author)
+		author, err := s.findOrCreateAuthorWithRepo(ctx, txAuthorRepo, txCompanyRepo, profile.ID, &data.Author)
 		if err != nil {
 			return fmt.Errorf("failed to find or create author: %w", err)
 		}
@@ -419,7 +622,7 @@ func (s *MaterializationService) MaterializeReferenceLetterData(
 		return result, txErr
 	}
 
-	return result, nil
+	return result, s.RecomputeSkillStats(ctx, profile.ID)
 }
 
 // materializeReferenceLetterDataWithoutTx is the fallback for tests that use mocks (no db)
@@ -438,6 +641,15 @@ func (s *MaterializationService) materializeReferenceLetterDataWithoutTx(ctx con
 		result.Skills = skillCount
 	}
 
+	// Materialize unmatched experience mentions as ProfileExperience records
+	if len(data.ExperienceMentions) > 0 {
+		expCount, expErr := s.materializeDiscoveredExperiences(ctx, referenceLetterID, profileID, data.ExperienceMentions)
+		if expErr != nil {
+			return result, fmt.Errorf("failed to materialize discovered experiences: %w", expErr)
+		}
+		result.Experiences = expCount
+	}
+
 	if len(data.Testimonials) == 0 {
 		return result, nil
 	}
@@ -501,6 +713,11 @@ func (s *MaterializationService) materializeDiscoveredSkillsWithRepos(
 		return 0, fmt.Errorf("failed to get next skill display order: %w", err)
 	}
 
+	tax, err := s.skillTaxonomy(ctx, profileID)
+	if err != nil {
+		return 0, err
+	}
+
 	count := 0
 	for i, ds := range discoveredSkills {
 		category := strings.ToUpper(string(ds.Category))
@@ -508,16 +725,23 @@ func (s *MaterializationService) materializeDiscoveredSkillsWithRepos(
 			category = "SOFT"
 		}
 
+		originalJSON, marshalErr := json.Marshal(ds)
+		if marshalErr != nil {
+			return count, fmt.Errorf("failed to marshal discovered skill original data: %w", marshalErr)
+		}
+
 		refLetterID := referenceLetterID
 		skill := &domain.ProfileSkill{
 			ID:                      uuid.New(),
 			ProfileID:               profileID,
 			Name:                    ds.Skill,
-			NormalizedName:          strings.ToLower(ds.Skill),
+			NormalizedName:          tax.Normalize(ds.Skill),
+			CanonicalSkillID:        CanonicalSkillID(tax, ds.Skill),
 			Category:                category,
 			DisplayOrder:            displayOrder + i,
 			Source:                  domain.ExperienceSourceLetterDiscovered,
 			SourceReferenceLetterID: &refLetterID,
+			OriginalData:            originalJSON,
 		}
 
 		if createErr := skillRepo.CreateIgnoreDuplicate(ctx, skill); createErr != nil {
@@ -546,23 +770,170 @@ func (s *MaterializationService) materializeDiscoveredSkillsWithRepos(
 	return count, nil
 }
 
+// materializeDiscoveredExperiences is the legacy method that uses the service's repositories
+func (s *MaterializationService) materializeDiscoveredExperiences(
+	ctx context.Context,
+	referenceLetterID uuid.UUID,
+	profileID uuid.UUID,
+	mentions []domain.ExtractedExperienceMention,
+) (int, error) {
+	return s.materializeDiscoveredExperiencesWithRepos(ctx, s.profileExpRepo, s.expValRepo, s.companyRepo, referenceLetterID, profileID, mentions)
+}
+
+// materializeDiscoveredExperiencesWithRepos accepts repository parameters for transaction support
+// Creates ProfileExperience records for experience mentions whose company is not yet on the profile.
+// Companies are compared with the profile's company matcher, so "Acme Corp." is already known
+// when the profile lists "ACME Corporation GmbH".
+// Mentions of a company that is already on the profile are left to CrossReferenceValidations,
+// which validates the existing entries instead. Each created experience is linked to its company
+// and gets an ExperienceValidation linking it to the reference letter with the mention's quote.
+func (s *MaterializationService) materializeDiscoveredExperiencesWithRepos(
+	ctx context.Context,
+	expRepo domain.ProfileExperienceRepository,
+	expValRepo domain.ExperienceValidationRepository,
+	companyRepo domain.CompanyRepository,
+	referenceLetterID uuid.UUID,
+	profileID uuid.UUID,
+	mentions []domain.ExtractedExperienceMention,
+) (int, error) {
+	existing, err := expRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get profile experiences: %w", err)
+	}
+
+	matcher, err := s.companyMatcher(ctx, profileID)
+	if err != nil {
+		return 0, err
+	}
+
+	displayOrder, err := expRepo.GetNextDisplayOrder(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get next experience display order: %w", err)
+	}
+
+	// The same role can be mentioned several times in one letter; create it once
+	var created []*domain.ProfileExperience
+	count := 0
+	for _, mention := range mentions {
+		company := strings.TrimSpace(mention.Company)
+		if normalize.CompanyKey(company) == "" || hasCompany(matcher, existing, company) {
+			continue
+		}
+		role := strings.TrimSpace(mention.Role)
+		if hasRole(matcher, created, company, role) {
+			continue
+		}
+
+		companyID, linkErr := LinkCompany(ctx, companyRepo, matcher, profileID, company)
+		if linkErr != nil {
+			return count, linkErr
+		}
+
+		originalJSON, marshalErr := json.Marshal(mention)
+		if marshalErr != nil {
+			return count, fmt.Errorf("failed to marshal experience mention original data: %w", marshalErr)
+		}
+
+		refLetterID := referenceLetterID
+		exp := &domain.ProfileExperience{
+			ID:                      uuid.New(),
+			ProfileID:               profileID,
+			Company:                 company,
+			CompanyID:               companyID,
+			Title:                   role,
+			DisplayOrder:            displayOrder + count,
+			Source:                  domain.ExperienceSourceLetterDiscovered,
+			SourceReferenceLetterID: &refLetterID,
+			OriginalData:            originalJSON,
+		}
+		if createErr := expRepo.Create(ctx, exp); createErr != nil {
+			return count, fmt.Errorf("failed to create discovered experience at %q: %w", company, createErr)
+		}
+		created = append(created, exp)
+
+		if mention.Quote != "" {
+			quote := mention.Quote
+			validation := &domain.ExperienceValidation{
+				ID:                  uuid.New(),
+				ProfileExperienceID: exp.ID,
+				ReferenceLetterID:   referenceLetterID,
+				QuoteSnippet:        &quote,
+			}
+			if valErr := expValRepo.Create(ctx, validation); valErr != nil {
+				if !strings.Contains(valErr.Error(), "duplicate") && !strings.Contains(valErr.Error(), "unique constraint") {
+					return count, fmt.Errorf("failed to create experience validation for %q: %w", company, valErr)
+				}
+			}
+		}
+
+		count++
+	}
+	return count, nil
+}
+
 // findOrCreateAuthor is the legacy method that uses the service's repository
 func (s *MaterializationService) findOrCreateAuthor(ctx context.Context, profileID uuid.UUID, extracted *domain.ExtractedAuthor) (*domain.Author, error) {
-	return s.findOrCreateAuthorWithRepo(ctx, s.authorRepo, profileID, extracted)
+	return s.findOrCreateAuthorWithRepo(ctx, s.authorRepo, s.companyRepo, profileID, extracted)
 }
 
-// findOrCreateAuthorWithRepo accepts a repository parameter for transaction support
+// findOrCreateAuthorWithRepo accepts repository parameters for transaction support
 // Finds an existing author by name and company, or creates a new one.
-// Uses database upsert to eliminate TOCTOU race conditions by relying on the unique constraint.
+// Names are compared case- and accent-insensitively and companies with the profile's company
+// matcher, so "Jane Smith, Acme Corp." reuses an author stored as "Jane Smith, ACME Corporation".
+// Otherwise uses database upsert to eliminate TOCTOU race conditions by relying on the unique constraint.
+// Authors with a company are linked to the profile's company of that name, including reused
+// authors that predate company linking.
 // TODO: Consider updating existing author's Title when reusing, so newer reference letters
 // with updated titles (e.g., promotions) refresh the canonical Author entity.
-func (s *MaterializationService) findOrCreateAuthorWithRepo(ctx context.Context, authorRepo domain.AuthorRepository, profileID uuid.UUID, extracted *domain.ExtractedAuthor) (*domain.Author, error) {
+func (s *MaterializationService) findOrCreateAuthorWithRepo(ctx context.Context, authorRepo domain.AuthorRepository, companyRepo domain.CompanyRepository, profileID uuid.UUID, extracted *domain.ExtractedAuthor) (*domain.Author, error) {
+	existing, err := authorRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authors: %w", err)
+	}
+
+	matcher, err := s.companyMatcher(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	nameKey := strings.Join(normalize.Tokens(extracted.Name), " ")
+	for _, candidate := range existing {
+		if strings.Join(normalize.Tokens(candidate.Name), " ") != nameKey {
+			continue
+		}
+		if !sameAuthorCompany(matcher, candidate.Company, extracted.Company) {
+			continue
+		}
+		if candidate.CompanyID == nil && candidate.Company != nil {
+			companyID, linkErr := LinkCompany(ctx, companyRepo, matcher, profileID, *candidate.Company)
+			if linkErr != nil {
+				return nil, linkErr
+			}
+			if companyID != nil {
+				candidate.CompanyID = companyID
+				if updateErr := authorRepo.Update(ctx, candidate); updateErr != nil {
+					return nil, fmt.Errorf("failed to link author to company: %w", updateErr)
+				}
+			}
+		}
+		return candidate, nil
+	}
+
+	var companyID *uuid.UUID
+	if extracted.Company != nil {
+		companyID, err = LinkCompany(ctx, companyRepo, matcher, profileID, *extracted.Company)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	author := &domain.Author{
 		ID:        uuid.New(),
 		ProfileID: profileID,
 		Name:      extracted.Name,
 		Title:     extracted.Title,
 		Company:   extracted.Company,
+		CompanyID: companyID,
 	}
 
 	result, err := authorRepo.Upsert(ctx, author)
@@ -576,6 +947,7 @@ func (s *MaterializationService) findOrCreateAuthorWithRepo(ctx context.Context,
 // populateProfileHeader fills empty profile header fields from extracted resume data.
 // Fields that already have values (e.g., from user edits) are preserved.
 func (s *MaterializationService) populateProfileHeader(ctx context.Context, profile *domain.Profile, data *domain.ResumeExtractedData) error {
+	before := *profile
 	updated := false
 	if profile.Name == nil && data.Name != "" {
 		profile.Name = &data.Name
@@ -601,17 +973,37 @@ func (s *MaterializationService) populateProfileHeader(ctx context.Context, prof
 		if err := s.profileRepo.Update(ctx, profile); err != nil {
 			return fmt.Errorf("failed to update profile header: %w", err)
 		}
+		return recordChanges(ctx, s.changeRepo, &profile.UserID, domain.ProfileChangeSourceImport, time.Now(), ProfileHeaderChanges(&before, profile))
 	}
 	return nil
 }
 
+// recordImport records in the profile's change log how an import changed the profile's
+// items since before was loaded, attributed to the importing user.
+func (s *MaterializationService) recordImport(
+	ctx context.Context,
+	changeRepo domain.ProfileChangeRepository,
+	expRepo domain.ProfileExperienceRepository,
+	eduRepo domain.ProfileEducationRepository,
+	skillRepo domain.ProfileSkillRepository,
+	userID uuid.UUID,
+	profileID uuid.UUID,
+	before *ProfileSnapshot,
+) error {
+	after, err := loadProfileItems(ctx, expRepo, eduRepo, skillRepo, profileID)
+	if err != nil {
+		return err
+	}
+	return recordChanges(ctx, changeRepo, &userID, domain.ProfileChangeSourceImport, time.Now(), snapshotChanges(before, after))
+}
+
 // skillRef is a normalized reference to a skill from either SkillMentions or DiscoveredSkills.
 type skillRef struct {
 	Skill string
 	Quote string
 }
 
-// CrossReferenceValidations matches skill and experience mentions from a reference letter
+// CrossReferenceValidations matches skill, experience and education mentions from a reference letter
 // against existing profile data, creating validation records for matches.
 // It checks both SkillMentions and DiscoveredSkills from the extracted letter data.
 func (s *MaterializationService) CrossReferenceValidations(
@@ -634,7 +1026,19 @@ func (s *MaterializationService) CrossReferenceValidations(
 	}
 	result.ExperienceValidations = count
 
-	return result, nil
+	count, err = s.matchEducationValidations(ctx, profileID, referenceLetterID, letterData)
+	if err != nil {
+		return result, err
+	}
+	result.EducationValidations = count
+
+	count, err = s.consistency.CheckLetter(ctx, profileID, referenceLetterID, letterData)
+	if err != nil {
+		return result, err
+	}
+	result.Inconsistencies = count
+
+	return result, s.RecomputeSkillStats(ctx, profileID)
 }
 
 func (s *MaterializationService) matchSkillValidations(
@@ -648,9 +1052,9 @@ func (s *MaterializationService) matchSkillValidations(
 		return 0, fmt.Errorf("failed to get profile skills: %w", err)
 	}
 
-	skillByNorm := make(map[string]*domain.ProfileSkill, len(skills))
-	for _, sk := range skills {
-		skillByNorm[sk.NormalizedName] = sk
+	tax, err := s.skillTaxonomy(ctx, profileID)
+	if err != nil {
+		return 0, err
 	}
 
 	// Collect skill references from both SkillMentions and DiscoveredSkills
@@ -662,11 +1066,12 @@ func (s *MaterializationService) matchSkillValidations(
 		refs = append(refs, skillRef{Skill: d.Skill, Quote: d.Quote})
 	}
 
+	matches := s.findMentionedSkills(ctx, profileID, tax, skills, refs)
+
 	matched := make(map[uuid.UUID]bool)
 	count := 0
-	for _, ref := range refs {
-		normalized := strings.ToLower(strings.TrimSpace(ref.Skill))
-		sk := findMatchingSkill(normalized, skillByNorm)
+	for i, ref := range refs {
+		sk := matches[i]
 		if sk == nil || matched[sk.ID] {
 			continue
 		}
@@ -689,6 +1094,91 @@ func (s *MaterializationService) matchSkillValidations(
 	return count, nil
 }
 
+// semanticSkillMargin is how much more similar a skill mention must be to the skill it
+// validates than to any other profile skill. A mention about as close to two skills, such
+// as one of an unlisted skill related to both, is too ambiguous to validate either.
+const semanticSkillMargin = 0.05
+
+// findMentionedSkills returns the profile skill each reference refers to, or nil. Names
+// the taxonomy resolves to the same skill, or that equal a skill's name or alias, match
+// exactly. The other references are matched by meaning when the service has an embedding
+// service: the mention, its name and quote together, is compared with the stored
+// embedding of every profile skill name and validates the most similar skill if it
+// reaches the embedder's match threshold and beats every other skill by
+// semanticSkillMargin. Without embeddings, or if embedding fails, they fall back to the
+// taxonomy's whole-word phrase match.
+func (s *MaterializationService) findMentionedSkills(
+	ctx context.Context,
+	profileID uuid.UUID,
+	tax *taxonomy.Taxonomy,
+	skills []*domain.ProfileSkill,
+	refs []skillRef,
+) []*domain.ProfileSkill {
+	matches := make([]*domain.ProfileSkill, len(refs))
+	var unmatched []int
+	for i, ref := range refs {
+		if matches[i] = tax.FindProfileSkillExact(skills, ref.Skill); matches[i] == nil {
+			unmatched = append(unmatched, i)
+		}
+	}
+	if len(unmatched) == 0 || len(skills) == 0 {
+		return matches
+	}
+
+	if s.embeddings != nil {
+		if mentionVectors, skillVectors, err := s.embedMentions(ctx, profileID, skills, refs, unmatched); err == nil {
+			threshold := s.embeddings.embedder.MatchThreshold()
+			for j, i := range unmatched {
+				best, bestScore, runnerUp := -1, 0.0, 0.0
+				for k := range skills {
+					score := cosineSimilarity(mentionVectors[j], skillVectors[k])
+					if best < 0 || score > bestScore {
+						best, bestScore, runnerUp = k, score, bestScore
+					} else if score > runnerUp {
+						runnerUp = score
+					}
+				}
+				if bestScore >= threshold && (len(skills) == 1 || bestScore-runnerUp >= semanticSkillMargin) {
+					matches[i] = skills[best]
+				}
+			}
+			return matches
+		}
+	}
+
+	for _, i := range unmatched {
+		matches[i] = tax.FindProfileSkill(skills, refs[i].Skill)
+	}
+	return matches
+}
+
+// embedMentions embeds the unmatched references and returns them with the embeddings of
+// the skill names.
+func (s *MaterializationService) embedMentions(
+	ctx context.Context,
+	profileID uuid.UUID,
+	skills []*domain.ProfileSkill,
+	refs []skillRef,
+	unmatched []int,
+) (mentionVectors, skillVectors [][]float32, err error) {
+	skillVectors, err = s.embeddings.skillNameVectors(ctx, profileID, skills)
+	if err != nil {
+		return nil, nil, err
+	}
+	texts := make([]string, len(unmatched))
+	for j, i := range unmatched {
+		texts[j] = refs[i].Skill + ": " + refs[i].Quote
+	}
+	mentionVectors, err = s.embeddings.embedder.Embed(ctx, texts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(mentionVectors) != len(texts) {
+		return nil, nil, fmt.Errorf("embedder returned %d vectors for %d texts", len(mentionVectors), len(texts))
+	}
+	return mentionVectors, skillVectors, nil
+}
+
 func (s *MaterializationService) matchExperienceValidations(
 	ctx context.Context,
 	profileID uuid.UUID,
@@ -700,22 +1190,18 @@ func (s *MaterializationService) matchExperienceValidations(
 		return 0, fmt.Errorf("failed to get profile experiences: %w", err)
 	}
 
-	// Build company -> experiences lookup (multiple roles at the same company)
-	expsByCompany := make(map[string][]*domain.ProfileExperience, len(experiences))
-	for _, exp := range experiences {
-		norm := strings.ToLower(strings.TrimSpace(exp.Company))
-		expsByCompany[norm] = append(expsByCompany[norm], exp)
+	matcher, err := s.companyMatcher(ctx, profileID)
+	if err != nil {
+		return 0, err
 	}
 
 	count := 0
 	for _, mention := range letterData.ExperienceMentions {
-		normalized := strings.ToLower(strings.TrimSpace(mention.Company))
-		exps, ok := expsByCompany[normalized]
-		if !ok {
-			continue
-		}
 		// Validate all roles at the matching company
-		for _, exp := range exps {
+		for _, exp := range experiences {
+			if !matcher.Match(mention.Company, exp.Company) {
+				continue
+			}
 			quote := mention.Quote
 			validation := &domain.ExperienceValidation{
 				ID:                  uuid.New(),
@@ -735,21 +1221,112 @@ func (s *MaterializationService) matchExperienceValidations(
 	return count, nil
 }
 
-// findMatchingSkill looks up a profile skill by normalized name.
-// It tries exact match first, then falls back to substring matching
-// (e.g. "incident response" matches "incident response program design").
-func findMatchingSkill(refNorm string, skillByNorm map[string]*domain.ProfileSkill) *domain.ProfileSkill {
-	// Exact match
-	if sk, ok := skillByNorm[refNorm]; ok {
-		return sk
+// matchEducationValidations validates education entries using the letter as evidence.
+// A letter from a professor or mentor validates the institution they work at (the author's company).
+// Any letter also validates an institution it mentions, either as an experience mention
+// or within a testimonial quote. Institution names are compared after normalization,
+// so "TU München" matches "Technical University of Munich".
+func (s *MaterializationService) matchEducationValidations(
+	ctx context.Context,
+	profileID uuid.UUID,
+	referenceLetterID uuid.UUID,
+	letterData *domain.ExtractedLetterData,
+) (int, error) {
+	educations, err := s.profileEduRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get profile education: %w", err)
 	}
-	// Substring match: check if either name contains the other
-	for norm, sk := range skillByNorm {
-		if strings.Contains(refNorm, norm) || strings.Contains(norm, refNorm) {
-			return sk
+
+	academicAuthor := letterData.Author.Relationship == domain.AuthorRelationshipProfessor ||
+		letterData.Author.Relationship == domain.AuthorRelationshipMentor
+
+	count := 0
+	for _, edu := range educations {
+		quote, ok := findEducationEvidence(edu.Institution, letterData, academicAuthor)
+		if !ok {
+			continue
+		}
+		validation := &domain.EducationValidation{
+			ID:                 uuid.New(),
+			ProfileEducationID: edu.ID,
+			ReferenceLetterID:  referenceLetterID,
+			QuoteSnippet:       quote,
 		}
+		if createErr := s.eduValRepo.Create(ctx, validation); createErr != nil {
+			if !strings.Contains(createErr.Error(), "duplicate") && !strings.Contains(createErr.Error(), "unique constraint") {
+				return count, fmt.Errorf("failed to create education validation: %w", createErr)
+			}
+			continue
+		}
+		count++
 	}
-	return nil
+	return count, nil
+}
+
+// findEducationEvidence looks for evidence of an institution in a letter and returns
+// the supporting quote, if any.
+func findEducationEvidence(institution string, letterData *domain.ExtractedLetterData, academicAuthor bool) (*string, bool) {
+	for _, mention := range letterData.ExperienceMentions {
+		if normalize.InstitutionsMatch(mention.Company, institution) || normalize.MentionsInstitution(mention.Quote, institution) {
+			quote := mention.Quote
+			return &quote, true
+		}
+	}
+	for _, t := range letterData.Testimonials {
+		if normalize.MentionsInstitution(t.Quote, institution) {
+			quote := t.Quote
+			return &quote, true
+		}
+	}
+	if academicAuthor && letterData.Author.Company != nil && normalize.InstitutionsMatch(*letterData.Author.Company, institution) {
+		if len(letterData.Testimonials) > 0 {
+			quote := letterData.Testimonials[0].Quote
+			return &quote, true
+		}
+		return nil, true
+	}
+	return nil, false
+}
+
+// skillTaxonomy returns the skill taxonomy extended with the profile's own skills.
+func (s *MaterializationService) skillTaxonomy(ctx context.Context, profileID uuid.UUID) (*taxonomy.Taxonomy, error) {
+	return LoadSkillTaxonomy(ctx, s.skillRepo, profileID)
+}
+
+// companyMatcher builds a company matcher that applies the profile's company aliases.
+func (s *MaterializationService) companyMatcher(ctx context.Context, profileID uuid.UUID) (*normalize.CompanyMatcher, error) {
+	return LoadCompanyMatcher(ctx, s.companyAliasRepo, profileID)
+}
+
+// hasCompany reports whether any of the experiences is at the given company.
+func hasCompany(matcher *normalize.CompanyMatcher, experiences []*domain.ProfileExperience, company string) bool {
+	for _, exp := range experiences {
+		if matcher.Match(exp.Company, company) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasRole reports whether any of the experiences is the given role at the given company.
+func hasRole(matcher *normalize.CompanyMatcher, experiences []*domain.ProfileExperience, company, role string) bool {
+	for _, exp := range experiences {
+		if normalize.FoldText(exp.Title) == normalize.FoldText(role) && matcher.Match(exp.Company, company) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameAuthorCompany reports whether two optional author companies refer to the same company.
+// Two authors without a company are considered to be at the same one.
+func sameAuthorCompany(matcher *normalize.CompanyMatcher, a, b *string) bool {
+	aEmpty := a == nil || strings.TrimSpace(*a) == ""
+	bEmpty := b == nil || strings.TrimSpace(*b) == ""
+	if aEmpty || bEmpty {
+		return aEmpty && bEmpty
+	}
+	return matcher.Match(*a, *b)
 }
 
 // FilterByIndices returns a subset of items at the given indices, skipping out-of-range and duplicate indices.
@@ -824,12 +1401,18 @@ func mapAuthorRelationship(ar domain.AuthorRelationship) domain.TestimonialRelat
 	switch ar {
 	case domain.AuthorRelationshipManager:
 		return domain.TestimonialRelationshipManager
-	case domain.AuthorRelationshipPeer, domain.AuthorRelationshipColleague:
+	case domain.AuthorRelationshipPeer:
 		return domain.TestimonialRelationshipPeer
+	case domain.AuthorRelationshipColleague:
+		return domain.TestimonialRelationshipColleague
 	case domain.AuthorRelationshipDirectReport:
 		return domain.TestimonialRelationshipDirectReport
 	case domain.AuthorRelationshipClient:
 		return domain.TestimonialRelationshipClient
+	case domain.AuthorRelationshipMentor:
+		return domain.TestimonialRelationshipMentor
+	case domain.AuthorRelationshipProfessor:
+		return domain.TestimonialRelationshipProfessor
 	default:
 		return domain.TestimonialRelationshipOther
 	}
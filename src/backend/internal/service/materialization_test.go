@@ -4,12 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 
 	"backend/internal/domain"
+	"backend/internal/infrastructure/llm"
+	"backend/internal/taxonomy"
 )
 
 // Mock repositories for testing (duplicated from job package - Go test convention)
@@ -67,6 +71,10 @@ func (r *mockProfileRepository) Delete(_ context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func (r *mockProfileRepository) ReorderItems(_ context.Context, _ uuid.UUID, _ *domain.ProfileItemOrder) error {
+	return nil
+}
+
 type mockProfileExperienceRepository struct {
 	experiences map[uuid.UUID]*domain.ProfileExperience
 }
@@ -98,6 +106,7 @@ func (r *mockProfileExperienceRepository) GetByProfileID(_ context.Context, prof
 			result = append(result, exp)
 		}
 	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].DisplayOrder < result[j].DisplayOrder })
 	return result, nil
 }
 
@@ -115,6 +124,16 @@ func (r *mockProfileExperienceRepository) GetNextDisplayOrder(_ context.Context,
 	return len(r.experiences), nil
 }
 
+func (r *mockProfileExperienceRepository) GetWithUnparsedDates(_ context.Context) ([]*domain.ProfileExperience, error) {
+	var result []*domain.ProfileExperience
+	for _, exp := range r.experiences {
+		if exp.StartDate != nil && exp.Start.IsZero() || exp.EndDate != nil && exp.End.IsZero() {
+			result = append(result, exp)
+		}
+	}
+	return result, nil
+}
+
 func (r *mockProfileExperienceRepository) DeleteBySourceResumeID(_ context.Context, sourceResumeID uuid.UUID) error {
 	for id, exp := range r.experiences {
 		if exp.SourceResumeID != nil && *exp.SourceResumeID == sourceResumeID {
@@ -124,6 +143,20 @@ func (r *mockProfileExperienceRepository) DeleteBySourceResumeID(_ context.Conte
 	return nil
 }
 
+func (r *mockProfileExperienceRepository) Merge(_ context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*domain.ProfileExperience, error) {
+	target, ok := r.experiences[targetID]
+	if !ok {
+		return nil, fmt.Errorf("experience %s not found", targetID)
+	}
+	for _, id := range sourceIDs {
+		if source, ok := r.experiences[id]; ok && id != targetID && source.ProfileID == target.ProfileID {
+			target.Absorb(source)
+			delete(r.experiences, id)
+		}
+	}
+	return target, nil
+}
+
 type mockProfileEducationRepository struct {
 	educations map[uuid.UUID]*domain.ProfileEducation
 }
@@ -155,6 +188,7 @@ func (r *mockProfileEducationRepository) GetByProfileID(_ context.Context, profi
 			result = append(result, edu)
 		}
 	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].DisplayOrder < result[j].DisplayOrder })
 	return result, nil
 }
 
@@ -172,6 +206,16 @@ func (r *mockProfileEducationRepository) GetNextDisplayOrder(_ context.Context,
 	return len(r.educations), nil
 }
 
+func (r *mockProfileEducationRepository) GetWithUnparsedDates(_ context.Context) ([]*domain.ProfileEducation, error) {
+	var result []*domain.ProfileEducation
+	for _, edu := range r.educations {
+		if edu.StartDate != nil && edu.Start.IsZero() || edu.EndDate != nil && edu.End.IsZero() {
+			result = append(result, edu)
+		}
+	}
+	return result, nil
+}
+
 func (r *mockProfileEducationRepository) DeleteBySourceResumeID(_ context.Context, sourceResumeID uuid.UUID) error {
 	for id, edu := range r.educations {
 		if edu.SourceResumeID != nil && *edu.SourceResumeID == sourceResumeID {
@@ -274,6 +318,41 @@ func (r *mockProfileSkillRepository) DeleteBySourceResumeID(_ context.Context, s
 	return nil
 }
 
+func (r *mockProfileSkillRepository) UpdateAll(_ context.Context, skills []*domain.ProfileSkill) error {
+	for _, skill := range skills {
+		r.skills[skill.ID] = skill
+	}
+	return nil
+}
+
+func (r *mockProfileSkillRepository) UpdateStats(_ context.Context, skills []*domain.ProfileSkill) error {
+	for _, skill := range skills {
+		if stored, ok := r.skills[skill.ID]; ok {
+			stored.YearsOfExperience = skill.YearsOfExperience
+			stored.LastUsed = skill.LastUsed
+			stored.EvidenceStrength = skill.EvidenceStrength
+		}
+	}
+	return nil
+}
+
+func (r *mockProfileSkillRepository) Merge(_ context.Context, targetID uuid.UUID, _ []uuid.UUID) (*domain.ProfileSkill, error) {
+	return r.skills[targetID], nil
+}
+
+func (r *mockProfileSkillRepository) Split(_ context.Context, skillID uuid.UUID, parts []*domain.ProfileSkill) error {
+	skill, ok := r.skills[skillID]
+	if !ok {
+		return fmt.Errorf("skill %s not found", skillID)
+	}
+	delete(r.skills, skillID)
+	for _, part := range parts {
+		part.ProfileID = skill.ProfileID
+		r.skills[part.ID] = part
+	}
+	return nil
+}
+
 // Test helpers
 
 func stringPtr(s string) *string { return &s }
@@ -410,6 +489,15 @@ func (r *mockTestimonialRepository) DeleteByReferenceLetterID(_ context.Context,
 	return nil
 }
 
+func (r *mockTestimonialRepository) DeleteByCredentialDocumentID(_ context.Context, credentialDocumentID uuid.UUID) error {
+	for id, t := range r.testimonials {
+		if t.CredentialDocumentID != nil && *t.CredentialDocumentID == credentialDocumentID {
+			delete(r.testimonials, id)
+		}
+	}
+	return nil
+}
+
 type mockSkillValidationRepository struct {
 	validations map[uuid.UUID]*domain.SkillValidation
 }
@@ -556,6 +644,452 @@ func (r *mockExpValidationRepository) Delete(_ context.Context, id uuid.UUID) er
 	return nil
 }
 
+type mockEduValidationRepository struct {
+	validations map[uuid.UUID]*domain.EducationValidation
+}
+
+func newMockEduValidationRepository() *mockEduValidationRepository {
+	return &mockEduValidationRepository{validations: make(map[uuid.UUID]*domain.EducationValidation)}
+}
+
+func (r *mockEduValidationRepository) Create(_ context.Context, v *domain.EducationValidation) error {
+	for _, existing := range r.validations {
+		if existing.ProfileEducationID == v.ProfileEducationID && existing.ReferenceLetterID == v.ReferenceLetterID {
+			return fmt.Errorf("duplicate key value violates unique constraint")
+		}
+	}
+	if v.ID == uuid.Nil {
+		v.ID = uuid.New()
+	}
+	r.validations[v.ID] = v
+	return nil
+}
+
+func (r *mockEduValidationRepository) GetByID(_ context.Context, id uuid.UUID) (*domain.EducationValidation, error) {
+	v, ok := r.validations[id]
+	if !ok {
+		return nil, nil
+	}
+	return v, nil
+}
+
+func (r *mockEduValidationRepository) GetByProfileEducationID(_ context.Context, profileEduID uuid.UUID) ([]*domain.EducationValidation, error) {
+	var result []*domain.EducationValidation
+	for _, v := range r.validations {
+		if v.ProfileEducationID == profileEduID {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+func (r *mockEduValidationRepository) GetByReferenceLetterID(_ context.Context, refLetterID uuid.UUID) ([]*domain.EducationValidation, error) {
+	var result []*domain.EducationValidation
+	for _, v := range r.validations {
+		if v.ReferenceLetterID == refLetterID {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+func (r *mockEduValidationRepository) DeleteByReferenceLetterID(_ context.Context, refLetterID uuid.UUID) error {
+	for id, v := range r.validations {
+		if v.ReferenceLetterID == refLetterID {
+			delete(r.validations, id)
+		}
+	}
+	return nil
+}
+
+func (r *mockEduValidationRepository) CountByProfileEducationID(_ context.Context, profileEduID uuid.UUID) (int, error) {
+	count := 0
+	for _, v := range r.validations {
+		if v.ProfileEducationID == profileEduID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *mockEduValidationRepository) Delete(_ context.Context, id uuid.UUID) error {
+	delete(r.validations, id)
+	return nil
+}
+
+type mockCompanyAliasRepository struct {
+	aliases map[uuid.UUID]*domain.CompanyAlias
+}
+
+func newMockCompanyAliasRepository() *mockCompanyAliasRepository {
+	return &mockCompanyAliasRepository{aliases: make(map[uuid.UUID]*domain.CompanyAlias)}
+}
+
+func (r *mockCompanyAliasRepository) Create(_ context.Context, alias *domain.CompanyAlias) error {
+	if alias.ID == uuid.Nil {
+		alias.ID = uuid.New()
+	}
+	r.aliases[alias.ID] = alias
+	return nil
+}
+
+func (r *mockCompanyAliasRepository) GetByID(_ context.Context, id uuid.UUID) (*domain.CompanyAlias, error) {
+	alias, ok := r.aliases[id]
+	if !ok {
+		return nil, nil
+	}
+	return alias, nil
+}
+
+func (r *mockCompanyAliasRepository) GetByProfileID(_ context.Context, profileID uuid.UUID) ([]*domain.CompanyAlias, error) {
+	var result []*domain.CompanyAlias
+	for _, a := range r.aliases {
+		if a.ProfileID == profileID {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
+
+func (r *mockCompanyAliasRepository) Update(_ context.Context, alias *domain.CompanyAlias) error {
+	r.aliases[alias.ID] = alias
+	return nil
+}
+
+func (r *mockCompanyAliasRepository) Delete(_ context.Context, id uuid.UUID) error {
+	delete(r.aliases, id)
+	return nil
+}
+
+type mockProfileInconsistencyRepository struct {
+	inconsistencies map[uuid.UUID]*domain.ProfileInconsistency
+}
+
+func newMockProfileInconsistencyRepository() *mockProfileInconsistencyRepository {
+	return &mockProfileInconsistencyRepository{inconsistencies: make(map[uuid.UUID]*domain.ProfileInconsistency)}
+}
+
+func (r *mockProfileInconsistencyRepository) Create(_ context.Context, inconsistency *domain.ProfileInconsistency) error {
+	for _, existing := range r.inconsistencies {
+		if existing.ReferenceLetterID == inconsistency.ReferenceLetterID &&
+			existing.ProfileExperienceID == inconsistency.ProfileExperienceID &&
+			existing.Field == inconsistency.Field {
+			return fmt.Errorf("duplicate key value violates unique constraint")
+		}
+	}
+	if inconsistency.ID == uuid.Nil {
+		inconsistency.ID = uuid.New()
+	}
+	r.inconsistencies[inconsistency.ID] = inconsistency
+	return nil
+}
+
+func (r *mockProfileInconsistencyRepository) GetByID(_ context.Context, id uuid.UUID) (*domain.ProfileInconsistency, error) {
+	inconsistency, ok := r.inconsistencies[id]
+	if !ok {
+		return nil, nil
+	}
+	return inconsistency, nil
+}
+
+func (r *mockProfileInconsistencyRepository) GetByProfileID(_ context.Context, profileID uuid.UUID) ([]*domain.ProfileInconsistency, error) {
+	var result []*domain.ProfileInconsistency
+	for _, i := range r.inconsistencies {
+		if i.ProfileID == profileID {
+			result = append(result, i)
+		}
+	}
+	return result, nil
+}
+
+func (r *mockProfileInconsistencyRepository) Update(_ context.Context, inconsistency *domain.ProfileInconsistency) error {
+	r.inconsistencies[inconsistency.ID] = inconsistency
+	return nil
+}
+
+// sectionFields are the fields of a profile section entry the section repository mocks
+// look at.
+type sectionFields struct {
+	id             uuid.UUID
+	profileID      uuid.UUID
+	displayOrder   int
+	sourceResumeID *uuid.UUID
+}
+
+// mockSectionRepository is an in-memory repository for the profile link, certification,
+// language, project, publication and award entities.
+type mockSectionRepository[T any] struct {
+	items  map[uuid.UUID]*T
+	fields func(*T) sectionFields
+}
+
+func newMockSectionRepository[T any](fields func(*T) sectionFields) *mockSectionRepository[T] {
+	return &mockSectionRepository[T]{items: make(map[uuid.UUID]*T), fields: fields}
+}
+
+func newMockProfileLinkRepository() *mockSectionRepository[domain.ProfileLink] {
+	return newMockSectionRepository(func(l *domain.ProfileLink) sectionFields {
+		return sectionFields{l.ID, l.ProfileID, l.DisplayOrder, l.SourceResumeID}
+	})
+}
+
+// mockProfileCertificationRepository adds the certification specific queries.
+type mockProfileCertificationRepository struct {
+	*mockSectionRepository[domain.ProfileCertification]
+}
+
+func newMockProfileCertificationRepository() *mockProfileCertificationRepository {
+	return &mockProfileCertificationRepository{newMockSectionRepository(func(c *domain.ProfileCertification) sectionFields {
+		return sectionFields{c.ID, c.ProfileID, c.DisplayOrder, c.SourceResumeID}
+	})}
+}
+
+func (r *mockProfileCertificationRepository) GetWithExpiryDate(_ context.Context) ([]*domain.ProfileCertification, error) {
+	var result []*domain.ProfileCertification
+	for _, c := range r.items {
+		if c.ExpiryDate != nil && strings.TrimSpace(*c.ExpiryDate) != "" {
+			result = append(result, c)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].DisplayOrder < result[j].DisplayOrder })
+	return result, nil
+}
+
+func newMockProfileLanguageRepository() *mockSectionRepository[domain.ProfileLanguage] {
+	return newMockSectionRepository(func(l *domain.ProfileLanguage) sectionFields {
+		return sectionFields{l.ID, l.ProfileID, l.DisplayOrder, l.SourceResumeID}
+	})
+}
+
+func newMockProfileProjectRepository() *mockSectionRepository[domain.ProfileProject] {
+	return newMockSectionRepository(func(p *domain.ProfileProject) sectionFields {
+		return sectionFields{p.ID, p.ProfileID, p.DisplayOrder, p.SourceResumeID}
+	})
+}
+
+func newMockProfilePublicationRepository() *mockSectionRepository[domain.ProfilePublication] {
+	return newMockSectionRepository(func(p *domain.ProfilePublication) sectionFields {
+		return sectionFields{p.ID, p.ProfileID, p.DisplayOrder, p.SourceResumeID}
+	})
+}
+
+func newMockProfileAwardRepository() *mockSectionRepository[domain.ProfileAward] {
+	return newMockSectionRepository(func(a *domain.ProfileAward) sectionFields {
+		return sectionFields{a.ID, a.ProfileID, a.DisplayOrder, a.SourceResumeID}
+	})
+}
+
+func (r *mockSectionRepository[T]) Create(_ context.Context, item *T) error {
+	r.items[r.fields(item).id] = item
+	return nil
+}
+
+func (r *mockSectionRepository[T]) GetByID(_ context.Context, id uuid.UUID) (*T, error) {
+	return r.items[id], nil
+}
+
+func (r *mockSectionRepository[T]) GetByProfileID(_ context.Context, profileID uuid.UUID) ([]*T, error) {
+	var result []*T
+	for _, item := range r.items {
+		if r.fields(item).profileID == profileID {
+			result = append(result, item)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return r.fields(result[i]).displayOrder < r.fields(result[j]).displayOrder
+	})
+	return result, nil
+}
+
+func (r *mockSectionRepository[T]) Update(_ context.Context, item *T) error {
+	r.items[r.fields(item).id] = item
+	return nil
+}
+
+func (r *mockSectionRepository[T]) Delete(_ context.Context, id uuid.UUID) error {
+	delete(r.items, id)
+	return nil
+}
+
+func (r *mockSectionRepository[T]) GetNextDisplayOrder(_ context.Context, profileID uuid.UUID) (int, error) {
+	next := 0
+	for _, item := range r.items {
+		if f := r.fields(item); f.profileID == profileID && f.displayOrder >= next {
+			next = f.displayOrder + 1
+		}
+	}
+	return next, nil
+}
+
+func (r *mockSectionRepository[T]) DeleteBySourceResumeID(_ context.Context, sourceResumeID uuid.UUID) error {
+	for id, item := range r.items {
+		if f := r.fields(item); f.sourceResumeID != nil && *f.sourceResumeID == sourceResumeID {
+			delete(r.items, id)
+		}
+	}
+	return nil
+}
+
+type mockProfileChangeRepository struct {
+	changes []*domain.ProfileChange
+}
+
+func newMockProfileChangeRepository() *mockProfileChangeRepository {
+	return &mockProfileChangeRepository{}
+}
+
+func (r *mockProfileChangeRepository) CreateAll(_ context.Context, changes []*domain.ProfileChange) error {
+	for _, change := range changes {
+		if change.RevertsChangeID == nil {
+			continue
+		}
+		for _, existing := range r.changes {
+			if existing.RevertsChangeID != nil && *existing.RevertsChangeID == *change.RevertsChangeID {
+				return fmt.Errorf("duplicate key value violates unique constraint")
+			}
+		}
+	}
+	r.changes = append(r.changes, changes...)
+	return nil
+}
+
+func (r *mockProfileChangeRepository) GetByID(_ context.Context, id uuid.UUID) (*domain.ProfileChange, error) {
+	for _, change := range r.changes {
+		if change.ID == id {
+			return change, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *mockProfileChangeRepository) GetByProfileID(_ context.Context, profileID uuid.UUID) ([]*domain.ProfileChange, error) {
+	var result []*domain.ProfileChange
+	for i := len(r.changes) - 1; i >= 0; i-- {
+		if r.changes[i].ProfileID == profileID {
+			result = append(result, r.changes[i])
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+	return result, nil
+}
+
+func (r *mockProfileChangeRepository) GetByRevertsChangeID(_ context.Context, changeID uuid.UUID) (*domain.ProfileChange, error) {
+	for _, change := range r.changes {
+		if change.RevertsChangeID != nil && *change.RevertsChangeID == changeID {
+			return change, nil
+		}
+	}
+	return nil, nil
+}
+
+type mockCompanyRepository struct {
+	companies map[uuid.UUID]*domain.Company
+}
+
+func newMockCompanyRepository() *mockCompanyRepository {
+	return &mockCompanyRepository{companies: make(map[uuid.UUID]*domain.Company)}
+}
+
+func (r *mockCompanyRepository) Create(_ context.Context, company *domain.Company) error {
+	if company.ID == uuid.Nil {
+		company.ID = uuid.New()
+	}
+	r.companies[company.ID] = company
+	return nil
+}
+
+func (r *mockCompanyRepository) GetByID(_ context.Context, id uuid.UUID) (*domain.Company, error) {
+	company, ok := r.companies[id]
+	if !ok {
+		return nil, nil
+	}
+	return company, nil
+}
+
+func (r *mockCompanyRepository) GetByProfileID(_ context.Context, profileID uuid.UUID) ([]*domain.Company, error) {
+	var result []*domain.Company
+	for _, c := range r.companies {
+		if c.ProfileID == profileID {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+func (r *mockCompanyRepository) Update(_ context.Context, company *domain.Company) error {
+	r.companies[company.ID] = company
+	return nil
+}
+
+func (r *mockCompanyRepository) Delete(_ context.Context, id uuid.UUID) error {
+	delete(r.companies, id)
+	return nil
+}
+
+func (r *mockCompanyRepository) Merge(_ context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*domain.Company, error) {
+	target, ok := r.companies[targetID]
+	if !ok {
+		return nil, fmt.Errorf("company %s not found", targetID)
+	}
+	for _, id := range sourceIDs {
+		if _, ok := r.companies[id]; ok && id != targetID {
+			delete(r.companies, id)
+		}
+	}
+	return target, nil
+}
+
+type mockSkillRepository struct {
+	skills map[uuid.UUID]*domain.Skill
+}
+
+func newMockSkillRepository() *mockSkillRepository {
+	return &mockSkillRepository{skills: make(map[uuid.UUID]*domain.Skill)}
+}
+
+func (r *mockSkillRepository) SeedGlobal(_ context.Context, skills []*domain.Skill) error {
+	for _, s := range skills {
+		r.skills[s.ID] = s
+	}
+	return nil
+}
+
+func (r *mockSkillRepository) Create(_ context.Context, skill *domain.Skill) error {
+	if skill.ID == uuid.Nil {
+		skill.ID = uuid.New()
+	}
+	r.skills[skill.ID] = skill
+	return nil
+}
+
+func (r *mockSkillRepository) GetByID(_ context.Context, id uuid.UUID) (*domain.Skill, error) {
+	skill, ok := r.skills[id]
+	if !ok {
+		return nil, nil
+	}
+	return skill, nil
+}
+
+func (r *mockSkillRepository) GetByProfileID(_ context.Context, profileID uuid.UUID) ([]*domain.Skill, error) {
+	var result []*domain.Skill
+	for _, s := range r.skills {
+		if s.ProfileID != nil && *s.ProfileID == profileID {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (r *mockSkillRepository) Update(_ context.Context, skill *domain.Skill) error {
+	r.skills[skill.ID] = skill
+	return nil
+}
+
+func (r *mockSkillRepository) Delete(_ context.Context, id uuid.UUID) error {
+	delete(r.skills, id)
+	return nil
+}
+
 func newTestService() (*MaterializationService, *mockProfileRepository, *mockProfileExperienceRepository, *mockProfileEducationRepository, *mockProfileSkillRepository) {
 	profileRepo := newMockProfileRepository()
 	expRepo := newMockProfileExperienceRepository()
@@ -563,7 +1097,7 @@ func newTestService() (*MaterializationService, *mockProfileRepository, *mockPro
 	skillRepo := newMockProfileSkillRepository()
 	authorRepo := newMockAuthorRepository()
 	testimonialRepo := newMockTestimonialRepository()
-	svc := NewMaterializationService(nil, profileRepo, expRepo, eduRepo, skillRepo, authorRepo, testimonialRepo, newMockSkillValidationRepository(), newMockExpValidationRepository())
+	svc := NewMaterializationService(nil, profileRepo, expRepo, eduRepo, skillRepo, authorRepo, testimonialRepo, newMockSkillValidationRepository(), newMockExpValidationRepository(), newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
 	return svc, profileRepo, expRepo, eduRepo, skillRepo
 }
 
@@ -574,7 +1108,7 @@ func newTestServiceWithRefs() (*MaterializationService, *mockProfileRepository,
 	skillRepo := newMockProfileSkillRepository()
 	authorRepo := newMockAuthorRepository()
 	testimonialRepo := newMockTestimonialRepository()
-	svc := NewMaterializationService(nil, profileRepo, expRepo, eduRepo, skillRepo, authorRepo, testimonialRepo, newMockSkillValidationRepository(), newMockExpValidationRepository())
+	svc := NewMaterializationService(nil, profileRepo, expRepo, eduRepo, skillRepo, authorRepo, testimonialRepo, newMockSkillValidationRepository(), newMockExpValidationRepository(), newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
 	return svc, profileRepo, authorRepo, testimonialRepo
 }
 
@@ -761,6 +1295,43 @@ func TestMaterializeCreatesEducation(t *testing.T) {
 	}
 }
 
+func TestMaterializeOrdersEntriesByRecency(t *testing.T) {
+	svc, _, expRepo, eduRepo, _ := newTestService()
+
+	data := &domain.ResumeExtractedData{
+		Name: "Test User",
+		Experience: []domain.WorkExperience{
+			{Company: "First Job", Title: "Junior", StartDate: stringPtr("2012"), EndDate: stringPtr("2015")},
+			{Company: "Current Job", Title: "Lead", StartDate: stringPtr("März 2020"), EndDate: stringPtr("heute")},
+			{Company: "Undated Job", Title: "Freelancer"},
+			{Company: "Second Job", Title: "Engineer", StartDate: stringPtr("01/2016"), EndDate: stringPtr("02/2020")},
+		},
+		Education: []domain.Education{
+			{Institution: "School", StartDate: stringPtr("2005"), EndDate: stringPtr("2008")},
+			{Institution: "University", StartDate: stringPtr("Fall 2008"), EndDate: stringPtr("Spring 2012")},
+		},
+	}
+	if _, err := svc.MaterializeResumeData(context.Background(), uuid.New(), uuid.New(), data); err != nil {
+		t.Fatalf("MaterializeResumeData returned error: %v", err)
+	}
+
+	wantExp := map[string]int{"Current Job": 0, "Second Job": 1, "First Job": 2, "Undated Job": 3}
+	for _, exp := range expRepo.experiences {
+		if exp.DisplayOrder != wantExp[exp.Company] {
+			t.Errorf("expected %s at position %d, got %d", exp.Company, wantExp[exp.Company], exp.DisplayOrder)
+		}
+		if exp.Company == "Current Job" && (exp.Start.Month != 3 || !exp.End.IsPresent()) {
+			t.Errorf("expected parsed dates for the current job, got %+v to %+v", exp.Start, exp.End)
+		}
+	}
+	wantEdu := map[string]int{"University": 0, "School": 1}
+	for _, edu := range eduRepo.educations {
+		if edu.DisplayOrder != wantEdu[edu.Institution] {
+			t.Errorf("expected %s at position %d, got %d", edu.Institution, wantEdu[edu.Institution], edu.DisplayOrder)
+		}
+	}
+}
+
 func TestMaterializeCreatesSkills(t *testing.T) {
 	svc, _, _, _, skillRepo := newTestService()
 
@@ -798,6 +1369,78 @@ func TestMaterializeCreatesSkills(t *testing.T) {
 	}
 }
 
+func TestMaterializeResumeSections(t *testing.T) {
+	profileRepo := newMockProfileRepository()
+	linkRepo := newMockProfileLinkRepository()
+	certRepo := newMockProfileCertificationRepository()
+	languageRepo := newMockProfileLanguageRepository()
+	projectRepo := newMockProfileProjectRepository()
+	publicationRepo := newMockProfilePublicationRepository()
+	awardRepo := newMockProfileAwardRepository()
+	svc := NewMaterializationService(nil, profileRepo, newMockProfileExperienceRepository(), newMockProfileEducationRepository(), newMockProfileSkillRepository(), newMockAuthorRepository(), newMockTestimonialRepository(), newMockSkillValidationRepository(), newMockExpValidationRepository(), newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), linkRepo, certRepo, languageRepo, projectRepo, publicationRepo, awardRepo, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	resumeID := uuid.New()
+	profile, _ := profileRepo.GetOrCreateByUserID(ctx, userID)
+	_ = linkRepo.Create(ctx, &domain.ProfileLink{
+		ID: uuid.New(), ProfileID: profile.ID, Kind: domain.LinkKindGitHub,
+		URL: "https://github.com/janedoe", Source: domain.ExperienceSourceManual,
+	})
+
+	native := domain.LanguageProficiencyNative
+	data := &domain.ResumeExtractedData{
+		Links: []domain.ResumeLink{
+			{Kind: domain.LinkKindGitHub, URL: "http://www.github.com/janedoe/"},
+			{Kind: domain.LinkKindLinkedIn, URL: "https://linkedin.com/in/janedoe"},
+		},
+		Certifications: []domain.Certification{
+			{Name: "AWS Solutions Architect", Issuer: stringPtr("Amazon"), ExpiryDate: stringPtr("2026-03")},
+			{Name: "aws solutions architect"},
+		},
+		Languages:    []domain.SpokenLanguage{{Language: "German", Proficiency: &native}},
+		Projects:     []domain.Project{{Name: "credfolio", Technologies: []string{"Go"}}},
+		Publications: []domain.Publication{{Title: "On Reference Letters"}},
+		Awards:       []domain.Award{{Title: "Engineer of the Year"}},
+	}
+
+	result, err := svc.MaterializeResumeData(ctx, resumeID, userID, data)
+	if err != nil {
+		t.Fatalf("MaterializeResumeData returned error: %v", err)
+	}
+	if result.Links != 1 || result.Certifications != 1 || result.Languages != 1 ||
+		result.Projects != 1 || result.Publications != 1 || result.Awards != 1 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+
+	links, _ := linkRepo.GetByProfileID(ctx, profile.ID)
+	if len(links) != 2 {
+		t.Fatalf("expected the manual GitHub link and the LinkedIn link, got %d links", len(links))
+	}
+	if links[1].Kind != domain.LinkKindLinkedIn || links[1].DisplayOrder != 1 || links[1].Source != domain.ExperienceSourceResumeExtracted {
+		t.Errorf("unexpected extracted link: %+v", links[1])
+	}
+	certs, _ := certRepo.GetByProfileID(ctx, profile.ID)
+	if len(certs) != 1 || certs[0].ExpiryDate == nil || *certs[0].ExpiryDate != "2026-03" {
+		t.Errorf("expected one certification with its expiry date, got %+v", certs)
+	}
+
+	// Re-importing the resume replaces its entries instead of adding them again.
+	data.Awards = nil
+	if _, err := svc.MaterializeResumeData(ctx, resumeID, userID, data); err != nil {
+		t.Fatalf("second materialization failed: %v", err)
+	}
+	if n := len(linkRepo.items); n != 2 {
+		t.Errorf("expected 2 links after re-import, got %d", n)
+	}
+	if n := len(awardRepo.items); n != 0 {
+		t.Errorf("expected the award to be removed after re-import, got %d", n)
+	}
+	if n := len(languageRepo.items); n != 1 {
+		t.Errorf("expected 1 language after re-import, got %d", n)
+	}
+}
+
 func TestMaterializeIdempotentReprocessing(t *testing.T) {
 	svc, _, expRepo, eduRepo, skillRepo := newTestService()
 
@@ -958,6 +1601,64 @@ func TestMaterializeMapsAchievementsToDescription(t *testing.T) {
 	}
 }
 
+func TestMaterializeExperienceHighlights(t *testing.T) {
+	svc, _, expRepo, _, _ := newTestService()
+
+	data := &domain.ResumeExtractedData{
+		Experience: []domain.WorkExperience{
+			{
+				Company:     "Extracted Inc",
+				Title:       "Engineer",
+				Description: stringPtr("Platform team."),
+				Highlights:  []domain.Highlight{{Text: "Cut costs by 30%", Quantified: true}, {Text: "Ran the on-call rotation"}},
+			},
+			{
+				Company:     "Legacy Inc",
+				Title:       "Developer",
+				Description: stringPtr("Built internal tools.\n• Automated releases\n• Saved $50k a year"),
+			},
+			{
+				Company:     "Bullets Inc",
+				Title:       "Intern",
+				Description: stringPtr("- Wrote tests\n- Fixed bugs"),
+			},
+		},
+	}
+
+	_, err := svc.MaterializeResumeData(context.Background(), uuid.New(), uuid.New(), data)
+	if err != nil {
+		t.Fatalf("MaterializeResumeData returned error: %v", err)
+	}
+
+	want := map[string]struct {
+		description *string
+		highlights  []string
+	}{
+		"Extracted Inc": {stringPtr("Platform team."), []string{"Cut costs by 30%", "Ran the on-call rotation"}},
+		"Legacy Inc":    {stringPtr("Built internal tools."), []string{"Automated releases", "Saved $50k a year"}},
+		"Bullets Inc":   {nil, []string{"Wrote tests", "Fixed bugs"}},
+	}
+	for _, exp := range expRepo.experiences {
+		w := want[exp.Company]
+		if (exp.Description == nil) != (w.description == nil) || exp.Description != nil && *exp.Description != *w.description {
+			t.Errorf("%s: expected description %v, got %v", exp.Company, w.description, exp.Description)
+		}
+		if !reflect.DeepEqual([]string(exp.Highlights), w.highlights) {
+			t.Errorf("%s: expected highlights %q, got %q", exp.Company, w.highlights, exp.Highlights)
+		}
+
+		provenance, provErr := ExperienceProvenance(exp)
+		if provErr != nil {
+			t.Fatalf("ExperienceProvenance returned error: %v", provErr)
+		}
+		for _, p := range provenance {
+			if p.Field == "highlights" && p.Origin != domain.ExperienceSourceResumeExtracted {
+				t.Errorf("%s: expected highlights to count as extracted, got %q", exp.Company, p.Origin)
+			}
+		}
+	}
+}
+
 func TestMaterializeSkillsWithDuplicatesInExtraction(t *testing.T) {
 	svc, _, _, _, skillRepo := newTestService()
 
@@ -987,6 +1688,80 @@ func TestMaterializeSkillsWithDuplicatesInExtraction(t *testing.T) {
 	}
 }
 
+func TestMaterializeSkillsLinksCanonicalSkills(t *testing.T) {
+	svc, _, _, _, skillRepo := newTestService()
+
+	data := &domain.ResumeExtractedData{
+		Skills: []string{"k8s", "Kubernetes", "JS", "Basket Weaving"},
+	}
+
+	_, err := svc.MaterializeResumeData(context.Background(), uuid.New(), uuid.New(), data)
+	if err != nil {
+		t.Fatalf("MaterializeResumeData returned error: %v", err)
+	}
+
+	if len(skillRepo.skills) != 3 {
+		t.Fatalf("expected k8s and Kubernetes to merge into 3 skills, got %d", len(skillRepo.skills))
+	}
+
+	byName := make(map[string]*domain.ProfileSkill)
+	for _, skill := range skillRepo.skills {
+		byName[skill.Name] = skill
+	}
+	k8s := byName["k8s"]
+	if k8s == nil || k8s.NormalizedName != "kubernetes" {
+		t.Fatalf("expected k8s to be kept as written and normalized to kubernetes, got %+v", k8s)
+	}
+	if k8s.CanonicalSkillID == nil || *k8s.CanonicalSkillID != taxonomy.GlobalID("kubernetes") {
+		t.Errorf("expected k8s to link to the Kubernetes canonical skill, got %v", k8s.CanonicalSkillID)
+	}
+	if js := byName["JS"]; js == nil || js.CanonicalSkillID == nil || *js.CanonicalSkillID != taxonomy.GlobalID("javascript") {
+		t.Errorf("expected JS to link to the JavaScript canonical skill")
+	}
+	if unknown := byName["Basket Weaving"]; unknown == nil || unknown.CanonicalSkillID != nil {
+		t.Errorf("expected a skill outside the taxonomy to stay unlinked")
+	}
+}
+
+func TestMaterializeSkillsSkipsMergedNames(t *testing.T) {
+	svc, profileRepo, _, _, skillRepo := newTestService()
+
+	userID := uuid.New()
+	profile := &domain.Profile{ID: uuid.New(), UserID: userID}
+	profileRepo.profiles[profile.ID] = profile
+
+	// "Container Orchestration" was merged into Kubernetes by the user
+	merged := &domain.ProfileSkill{
+		ID:             uuid.New(),
+		ProfileID:      profile.ID,
+		Name:           "Kubernetes",
+		NormalizedName: "kubernetes",
+		Aliases:        []string{"Container Orchestration"},
+		Category:       "TECHNICAL",
+		Source:         domain.ExperienceSourceManual,
+	}
+	if err := skillRepo.Create(context.Background(), merged); err != nil {
+		t.Fatalf("failed to create merged skill: %v", err)
+	}
+
+	data := &domain.ResumeExtractedData{
+		Skills: []string{"container orchestration", "Terraform"},
+	}
+	result, err := svc.MaterializeResumeData(context.Background(), uuid.New(), userID, data)
+	if err != nil {
+		t.Fatalf("MaterializeResumeData returned error: %v", err)
+	}
+
+	if result.Skills != 1 {
+		t.Errorf("expected only Terraform to be materialized, got %d skills", result.Skills)
+	}
+	for _, skill := range skillRepo.skills {
+		if skill.NormalizedName == "container orchestration" {
+			t.Error("expected a merged name not to be re-created as a separate skill")
+		}
+	}
+}
+
 func TestMaterializeSkillsWithExistingManualSkill(t *testing.T) {
 	svc, profileRepo, expRepo, eduRepo, skillRepo := newTestService()
 
@@ -1063,7 +1838,7 @@ func TestMaterializePartialSuccess_ExperiencesFail(t *testing.T) {
 	expRepo := &mockFailingProfileExperienceRepository{newMockProfileExperienceRepository()}
 	eduRepo := newMockProfileEducationRepository()
 	skillRepo := newMockProfileSkillRepository()
-	svc := NewMaterializationService(nil, profileRepo, expRepo, eduRepo, skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), newMockSkillValidationRepository(), newMockExpValidationRepository())
+	svc := NewMaterializationService(nil, profileRepo, expRepo, eduRepo, skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), newMockSkillValidationRepository(), newMockExpValidationRepository(), newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
 
 	data := &domain.ResumeExtractedData{
 		Experience: []domain.WorkExperience{
@@ -1110,7 +1885,7 @@ func TestMaterializePartialSuccess_SkillsFail(t *testing.T) {
 	expRepo := newMockProfileExperienceRepository()
 	eduRepo := newMockProfileEducationRepository()
 	skillRepo := &mockFailingProfileSkillRepository{newMockProfileSkillRepository()}
-	svc := NewMaterializationService(nil, profileRepo, expRepo, eduRepo, skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), newMockSkillValidationRepository(), newMockExpValidationRepository())
+	svc := NewMaterializationService(nil, profileRepo, expRepo, eduRepo, skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), newMockSkillValidationRepository(), newMockExpValidationRepository(), newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
 
 	data := &domain.ResumeExtractedData{
 		Experience: []domain.WorkExperience{
@@ -1173,11 +1948,21 @@ func TestDeduplicateSkills(t *testing.T) {
 			input:    []string{"JavaScript", "javascript", "JAVASCRIPT", "TypeScript"},
 			expected: []string{"JavaScript", "TypeScript"},
 		},
+		{
+			name:     "synonyms and abbreviations merge",
+			input:    []string{"Kubernetes", "k8s", "JS", "ECMAScript", "JavaScript"},
+			expected: []string{"Kubernetes", "JS"},
+		},
+		{
+			name:     "shared prefix is not a duplicate",
+			input:    []string{"Java", "JavaScript"},
+			expected: []string{"Java", "JavaScript"},
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			result := DeduplicateSkills(tc.input)
+			result := DeduplicateSkills(taxonomy.Default(), tc.input)
 			if len(result) != len(tc.expected) {
 				t.Fatalf("expected %d skills, got %d: %v", len(tc.expected), len(result), result)
 			}
@@ -1292,22 +2077,63 @@ func TestMaterializeReferenceLetterReusesExistingAuthor(t *testing.T) {
 	profile := &domain.Profile{ID: uuid.New(), UserID: userID}
 	profileRepo.profiles[profile.ID] = profile
 
-	existingAuthor := &domain.Author{
-		ID:        uuid.New(),
-		ProfileID: profile.ID,
-		Name:      "Jane Smith",
-		Company:   stringPtr("TechCo"),
+	existingAuthor := &domain.Author{
+		ID:        uuid.New(),
+		ProfileID: profile.ID,
+		Name:      "Jane Smith",
+		Company:   stringPtr("TechCo"),
+	}
+	authorRepo.authors[existingAuthor.ID] = existingAuthor
+
+	_, err := svc.MaterializeReferenceLetterData(context.Background(), refLetterID, userID, testExtractedLetterData())
+	if err != nil {
+		t.Fatalf("MaterializeReferenceLetterData returned error: %v", err)
+	}
+
+	// Should still have exactly 1 author (reused existing)
+	if len(authorRepo.authors) != 1 {
+		t.Errorf("expected 1 author (reused), got %d", len(authorRepo.authors))
+	}
+}
+
+func TestMaterializeLinksExperiencesAndAuthorsToCompanies(t *testing.T) {
+	profileRepo := newMockProfileRepository()
+	expRepo := newMockProfileExperienceRepository()
+	authorRepo := newMockAuthorRepository()
+	companyRepo := newMockCompanyRepository()
+	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), newMockProfileSkillRepository(), authorRepo, newMockTestimonialRepository(), newMockSkillValidationRepository(), newMockExpValidationRepository(), newMockEduValidationRepository(), newMockCompanyAliasRepository(), companyRepo, newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	data := testExtractedData()
+	data.Experience = append(data.Experience, domain.WorkExperience{Company: "ACME Corporation", Title: "Senior Engineer"})
+	if _, err := svc.MaterializeResumeData(ctx, uuid.New(), userID, data); err != nil {
+		t.Fatalf("MaterializeResumeData returned error: %v", err)
 	}
-	authorRepo.authors[existingAuthor.ID] = existingAuthor
 
-	_, err := svc.MaterializeReferenceLetterData(context.Background(), refLetterID, userID, testExtractedLetterData())
-	if err != nil {
+	letter := testExtractedLetterData()
+	letter.Author.Company = stringPtr("Acme Corp.")
+	if _, err := svc.MaterializeReferenceLetterData(ctx, uuid.New(), userID, letter); err != nil {
 		t.Fatalf("MaterializeReferenceLetterData returned error: %v", err)
 	}
 
-	// Should still have exactly 1 author (reused existing)
-	if len(authorRepo.authors) != 1 {
-		t.Errorf("expected 1 author (reused), got %d", len(authorRepo.authors))
+	if len(companyRepo.companies) != 1 {
+		t.Fatalf("expected 1 company for all Acme spellings, got %d", len(companyRepo.companies))
+	}
+	var companyID uuid.UUID
+	for id := range companyRepo.companies {
+		companyID = id
+	}
+	for _, exp := range expRepo.experiences {
+		if exp.CompanyID == nil || *exp.CompanyID != companyID {
+			t.Errorf("expected experience %q to link to the company, got %v", exp.Company, exp.CompanyID)
+		}
+	}
+	for _, author := range authorRepo.authors {
+		if author.CompanyID == nil || *author.CompanyID != companyID {
+			t.Errorf("expected author %q to link to the company, got %v", author.Name, author.CompanyID)
+		}
 	}
 }
 
@@ -1455,7 +2281,7 @@ func newTestServiceWithAll() (*MaterializationService, *mockProfileRepository, *
 	testimonialRepo := newMockTestimonialRepository()
 	skillValRepo := newMockSkillValidationRepository()
 	expValRepo := newMockExpValidationRepository()
-	svc := NewMaterializationService(nil, profileRepo, expRepo, eduRepo, skillRepo, authorRepo, testimonialRepo, skillValRepo, expValRepo)
+	svc := NewMaterializationService(nil, profileRepo, expRepo, eduRepo, skillRepo, authorRepo, testimonialRepo, skillValRepo, expValRepo, newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
 	return svc, profileRepo, skillRepo, skillValRepo, testimonialRepo
 }
 
@@ -1583,6 +2409,119 @@ func TestMaterializeReferenceLetterDiscoveredSkillsWithNoTestimonials(t *testing
 	}
 }
 
+func TestMaterializeReferenceLetterCreatesDiscoveredExperiences(t *testing.T) {
+	profileRepo := newMockProfileRepository()
+	expRepo := newMockProfileExperienceRepository()
+	expValRepo := newMockExpValidationRepository()
+	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), newMockProfileSkillRepository(), newMockAuthorRepository(), newMockTestimonialRepository(), newMockSkillValidationRepository(), expValRepo, newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
+
+	userID := uuid.New()
+	profile, _ := profileRepo.GetOrCreateByUserID(context.Background(), userID)
+	existing := &domain.ProfileExperience{ID: uuid.New(), ProfileID: profile.ID, Company: "Acme Corp", Title: "Engineer", Source: domain.ExperienceSourceResumeExtracted}
+	expRepo.experiences[existing.ID] = existing
+
+	refLetterID := uuid.New()
+	data := &domain.ExtractedLetterData{
+		Author: domain.ExtractedAuthor{Name: "Jane Smith", Relationship: domain.AuthorRelationshipManager},
+		ExperienceMentions: []domain.ExtractedExperienceMention{
+			{Company: "acme corp", Role: "Engineer", Quote: "Great work at Acme"},       // already on profile
+			{Company: "Globex", Role: "Tech Lead", Quote: "Led our platform team"},      // new
+			{Company: "Globex", Role: "Tech Lead", Quote: "A trusted tech lead"},        // same role again
+			{Company: "Globex", Role: "Architect", Quote: "Later became our architect"}, // second role
+			{Company: " ", Role: "Consultant", Quote: "No company given"},               // skipped
+		},
+	}
+
+	result, err := svc.MaterializeReferenceLetterData(context.Background(), refLetterID, userID, data)
+	if err != nil {
+		t.Fatalf("MaterializeReferenceLetterData returned error: %v", err)
+	}
+
+	if result.Experiences != 2 {
+		t.Fatalf("expected 2 discovered experiences, got %d", result.Experiences)
+	}
+	if len(expRepo.experiences) != 3 {
+		t.Fatalf("expected 3 experiences in repo, got %d", len(expRepo.experiences))
+	}
+
+	for _, exp := range expRepo.experiences {
+		if exp.ID == existing.ID {
+			continue
+		}
+		if exp.Company != "Globex" {
+			t.Errorf("expected discovered experience at Globex, got %q", exp.Company)
+		}
+		if exp.Source != domain.ExperienceSourceLetterDiscovered {
+			t.Errorf("expected source 'letter_discovered', got %q", exp.Source)
+		}
+		if exp.SourceReferenceLetterID == nil || *exp.SourceReferenceLetterID != refLetterID {
+			t.Error("expected SourceReferenceLetterID to match reference letter ID")
+		}
+		if exp.ProfileID != profile.ID {
+			t.Errorf("expected profile ID %s, got %s", profile.ID, exp.ProfileID)
+		}
+	}
+
+	// Each discovered experience is validated by the letter that mentioned it
+	if len(expValRepo.validations) != 2 {
+		t.Fatalf("expected 2 experience validations, got %d", len(expValRepo.validations))
+	}
+	for _, val := range expValRepo.validations {
+		if val.ProfileExperienceID == existing.ID {
+			t.Error("expected existing experience to be left to cross-referencing")
+		}
+		if val.ReferenceLetterID != refLetterID {
+			t.Errorf("expected reference letter ID %s, got %s", refLetterID, val.ReferenceLetterID)
+		}
+		if val.QuoteSnippet == nil || *val.QuoteSnippet == "" {
+			t.Error("expected quote snippet to be set")
+		}
+	}
+
+	// Re-importing the same letter matches the experiences it created instead of duplicating them
+	result, err = svc.MaterializeReferenceLetterData(context.Background(), refLetterID, userID, data)
+	if err != nil {
+		t.Fatalf("second MaterializeReferenceLetterData returned error: %v", err)
+	}
+	if result.Experiences != 0 {
+		t.Errorf("expected no new experiences on re-import, got %d", result.Experiences)
+	}
+	if len(expRepo.experiences) != 3 {
+		t.Errorf("expected 3 experiences in repo after re-import, got %d", len(expRepo.experiences))
+	}
+}
+
+func TestMaterializeReferenceLetterBuildsProfileWithoutResume(t *testing.T) {
+	profileRepo := newMockProfileRepository()
+	expRepo := newMockProfileExperienceRepository()
+	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), newMockProfileSkillRepository(), newMockAuthorRepository(), newMockTestimonialRepository(), newMockSkillValidationRepository(), newMockExpValidationRepository(), newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
+
+	userID := uuid.New()
+	data := &domain.ExtractedLetterData{
+		Author: domain.ExtractedAuthor{Name: "Jane Smith", Relationship: domain.AuthorRelationshipManager},
+		ExperienceMentions: []domain.ExtractedExperienceMention{
+			{Company: "Initech", Role: "Software Engineer", Quote: "She shipped our billing system"},
+		},
+	}
+
+	result, err := svc.MaterializeReferenceLetterData(context.Background(), uuid.New(), userID, data)
+	if err != nil {
+		t.Fatalf("MaterializeReferenceLetterData returned error: %v", err)
+	}
+	if result.Experiences != 1 {
+		t.Fatalf("expected 1 discovered experience, got %d", result.Experiences)
+	}
+
+	profile, _ := profileRepo.GetByUserID(context.Background(), userID)
+	if profile == nil {
+		t.Fatal("expected a profile to be created for the user")
+	}
+	experiences, _ := expRepo.GetByProfileID(context.Background(), profile.ID)
+	if len(experiences) != 1 || experiences[0].Title != "Software Engineer" {
+		t.Errorf("expected the profile to contain the discovered experience, got %+v", experiences)
+	}
+}
+
 func TestFilterDiscoveredSkillsWithCategory(t *testing.T) {
 	skills := []domain.DiscoveredSkill{
 		{Skill: "Go", Quote: "Expert Go", Category: domain.SkillCategoryTechnical},
@@ -1655,7 +2594,7 @@ func TestCrossReferenceValidationsMatchesSkills(t *testing.T) {
 	skillRepo := newMockProfileSkillRepository()
 	skillValRepo := newMockSkillValidationRepository()
 	expValRepo := newMockExpValidationRepository()
-	svc := NewMaterializationService(nil, profileRepo, expRepo, eduRepo, skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), skillValRepo, expValRepo)
+	svc := NewMaterializationService(nil, profileRepo, expRepo, eduRepo, skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), skillValRepo, expValRepo, newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
 
 	profileID := uuid.New()
 
@@ -1717,7 +2656,7 @@ func TestCrossReferenceValidationsCaseInsensitive(t *testing.T) {
 	skillRepo := newMockProfileSkillRepository()
 	skillValRepo := newMockSkillValidationRepository()
 	expValRepo := newMockExpValidationRepository()
-	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), skillValRepo, expValRepo)
+	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), skillValRepo, expValRepo, newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
 
 	profileID := uuid.New()
 
@@ -1757,7 +2696,7 @@ func TestCrossReferenceValidationsMatchesDiscoveredSkills(t *testing.T) {
 	skillRepo := newMockProfileSkillRepository()
 	skillValRepo := newMockSkillValidationRepository()
 	expValRepo := newMockExpValidationRepository()
-	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), skillValRepo, expValRepo)
+	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), skillValRepo, expValRepo, newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
 
 	profileID := uuid.New()
 
@@ -1797,7 +2736,7 @@ func TestCrossReferenceValidationsDeduplicatesAcrossSources(t *testing.T) {
 	skillRepo := newMockProfileSkillRepository()
 	skillValRepo := newMockSkillValidationRepository()
 	expValRepo := newMockExpValidationRepository()
-	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), skillValRepo, expValRepo)
+	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), skillValRepo, expValRepo, newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
 
 	profileID := uuid.New()
 
@@ -1835,7 +2774,7 @@ func TestCrossReferenceValidationsSubstringMatching(t *testing.T) {
 	skillRepo := newMockProfileSkillRepository()
 	skillValRepo := newMockSkillValidationRepository()
 	expValRepo := newMockExpValidationRepository()
-	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), skillValRepo, expValRepo)
+	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), skillValRepo, expValRepo, newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
 
 	profileID := uuid.New()
 
@@ -1882,6 +2821,214 @@ func TestCrossReferenceValidationsSubstringMatching(t *testing.T) {
 	}
 }
 
+// newSemanticMaterializationService creates a MaterializationService matching skill
+// mentions by meaning with the embedder.
+func newSemanticMaterializationService(skillRepo *mockProfileSkillRepository, skillValRepo *mockSkillValidationRepository, embedder domain.Embedder) (*MaterializationService, *EmbeddingService) {
+	embeddingSvc := NewEmbeddingService(embedder, newMockEvidenceEmbeddingRepository(), newMockProfileExperienceRepository(), skillRepo, newMockTestimonialRepository(), skillValRepo)
+	svc := NewMaterializationService(nil, newMockProfileRepository(), newMockProfileExperienceRepository(), newMockProfileEducationRepository(), skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), skillValRepo, newMockExpValidationRepository(), newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), embeddingSvc)
+	return svc, embeddingSvc
+}
+
+// thresholdEmbedder is an embedder with another match threshold.
+type thresholdEmbedder struct {
+	domain.Embedder
+	threshold float64
+}
+
+func (e thresholdEmbedder) MatchThreshold() float64 {
+	return e.threshold
+}
+
+func TestCrossReferenceValidationsSemanticMatching(t *testing.T) {
+	skillRepo := newMockProfileSkillRepository()
+	skillValRepo := newMockSkillValidationRepository()
+	embedder := &countingEmbedder{Embedder: llm.NewFakeEmbedder(0)}
+	svc, embeddingSvc := newSemanticMaterializationService(skillRepo, skillValRepo, embedder)
+
+	profileID := uuid.New()
+	incResp := &domain.ProfileSkill{ID: uuid.New(), ProfileID: profileID, Name: "Incident Response", NormalizedName: "incident response"}
+	distributed := &domain.ProfileSkill{ID: uuid.New(), ProfileID: profileID, Name: "Distributed Systems", NormalizedName: "distributed systems"}
+	skillRepo.skills[incResp.ID] = incResp
+	skillRepo.skills[distributed.ID] = distributed
+
+	// The skill names are embedded once, by the embedding job, and reused when matching.
+	if _, err := embeddingSvc.EmbedProfile(context.Background(), profileID); err != nil {
+		t.Fatalf("EmbedProfile() error = %v", err)
+	}
+	embedder.texts = 0
+
+	letterData := &domain.ExtractedLetterData{
+		DiscoveredSkills: []domain.DiscoveredSkill{
+			// Matched by meaning although the names share no phrase.
+			{Skill: "incident handling", Quote: "ran our incident response rotation", Category: domain.SkillCategoryTechnical},
+			// A shared word alone no longer validates a skill, as the phrase match did.
+			{Skill: "systems", Quote: "kept the billing systems compliant with audits", Category: domain.SkillCategoryTechnical},
+		},
+	}
+
+	result, err := svc.CrossReferenceValidations(context.Background(), profileID, uuid.New(), letterData)
+	if err != nil {
+		t.Fatalf("CrossReferenceValidations returned error: %v", err)
+	}
+	if result.SkillValidations != 1 {
+		t.Fatalf("expected 1 skill validation, got %d", result.SkillValidations)
+	}
+	for _, sv := range skillValRepo.validations {
+		if sv.ProfileSkillID != incResp.ID || *sv.QuoteSnippet != "ran our incident response rotation" {
+			t.Errorf("expected Incident Response to be validated by its quote, got %+v", sv)
+		}
+	}
+	if embedder.texts != 2 {
+		t.Errorf("expected only the 2 mentions to be embedded, got %d texts", embedder.texts)
+	}
+}
+
+func TestCrossReferenceValidationsSemanticMatchingAvoidsOtherSkills(t *testing.T) {
+	tests := []struct {
+		name      string
+		skills    []string
+		mention   domain.DiscoveredSkill
+		threshold float64 // 0 keeps the fake embedder's
+		want      string  // the validated skill, "" for none
+	}{
+		{
+			name:    "an unlisted skill does not validate a related one",
+			skills:  []string{"Docker", "Go"},
+			mention: domain.DiscoveredSkill{Skill: "Kubernetes", Quote: "ran our Docker containers on Kubernetes"},
+		},
+		{
+			name:    "a mention as close to two skills validates neither",
+			skills:  []string{"AWS Lambda", "AWS ECS"},
+			mention: domain.DiscoveredSkill{Skill: "AWS", Quote: "AWS"},
+		},
+		{
+			name:    "a mention clearly closest to one skill validates it",
+			skills:  []string{"AWS Lambda", "AWS ECS"},
+			mention: domain.DiscoveredSkill{Skill: "serverless", Quote: "built our AWS Lambda functions"},
+			want:    "AWS Lambda",
+		},
+		{
+			name:      "the embedder's threshold applies",
+			skills:    []string{"Incident Response", "Distributed Systems"},
+			mention:   domain.DiscoveredSkill{Skill: "incident handling", Quote: "ran our incident response rotation"},
+			threshold: 0.8,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			skillRepo := newMockProfileSkillRepository()
+			skillValRepo := newMockSkillValidationRepository()
+			var embedder domain.Embedder = llm.NewFakeEmbedder(0)
+			if tt.threshold > 0 {
+				embedder = thresholdEmbedder{Embedder: embedder, threshold: tt.threshold}
+			}
+			svc, _ := newSemanticMaterializationService(skillRepo, skillValRepo, embedder)
+
+			profileID := uuid.New()
+			names := make(map[uuid.UUID]string)
+			for _, name := range tt.skills {
+				sk := &domain.ProfileSkill{ID: uuid.New(), ProfileID: profileID, Name: name, NormalizedName: strings.ToLower(name)}
+				skillRepo.skills[sk.ID] = sk
+				names[sk.ID] = name
+			}
+
+			letterData := &domain.ExtractedLetterData{DiscoveredSkills: []domain.DiscoveredSkill{tt.mention}}
+			if _, err := svc.CrossReferenceValidations(context.Background(), profileID, uuid.New(), letterData); err != nil {
+				t.Fatalf("CrossReferenceValidations returned error: %v", err)
+			}
+			var validated []string
+			for _, sv := range skillValRepo.validations {
+				validated = append(validated, names[sv.ProfileSkillID])
+			}
+			if tt.want == "" && len(validated) != 0 {
+				t.Errorf("expected no skill validation, got %v", validated)
+			}
+			if tt.want != "" && (len(validated) != 1 || validated[0] != tt.want) {
+				t.Errorf("expected %s to be validated, got %v", tt.want, validated)
+			}
+		})
+	}
+}
+
+func TestCrossReferenceValidationsUsesSkillTaxonomy(t *testing.T) {
+	skillRepo := newMockProfileSkillRepository()
+	skillValRepo := newMockSkillValidationRepository()
+	taxonomyRepo := newMockSkillRepository()
+	svc := NewMaterializationService(nil, newMockProfileRepository(), newMockProfileExperienceRepository(), newMockProfileEducationRepository(), skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), skillValRepo, newMockExpValidationRepository(), newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), taxonomyRepo, newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
+
+	profileID := uuid.New()
+	java := &domain.ProfileSkill{ID: uuid.New(), ProfileID: profileID, Name: "Java", NormalizedName: "java"}
+	k8s := &domain.ProfileSkill{ID: uuid.New(), ProfileID: profileID, Name: "Kubernetes", NormalizedName: "kubernetes"}
+	widgets := &domain.ProfileSkill{ID: uuid.New(), ProfileID: profileID, Name: "Widget Tuning", NormalizedName: "widget tuning"}
+	for _, sk := range []*domain.ProfileSkill{java, k8s, widgets} {
+		skillRepo.skills[sk.ID] = sk
+	}
+
+	// A profile extension teaches the taxonomy a synonym for the custom skill.
+	custom := &domain.Skill{ID: uuid.New(), ProfileID: &profileID, Slug: "widget-tuning", Name: "Widget Tuning", Synonyms: []string{"gizmo calibration"}}
+	taxonomyRepo.skills[custom.ID] = custom
+
+	letterData := &domain.ExtractedLetterData{
+		SkillMentions: []domain.ExtractedSkillMention{
+			{Skill: "JavaScript", Quote: "wrote our JavaScript frontend"},
+			{Skill: "k8s", Quote: "ran our k8s clusters"},
+			{Skill: "gizmo calibration", Quote: "calibrated every gizmo"},
+		},
+	}
+
+	result, err := svc.CrossReferenceValidations(context.Background(), profileID, uuid.New(), letterData)
+	if err != nil {
+		t.Fatalf("CrossReferenceValidations returned error: %v", err)
+	}
+	if result.SkillValidations != 2 {
+		t.Errorf("expected 2 skill validations, got %d", result.SkillValidations)
+	}
+
+	matched := make(map[uuid.UUID]bool)
+	for _, sv := range skillValRepo.validations {
+		matched[sv.ProfileSkillID] = true
+	}
+	if matched[java.ID] {
+		t.Error("expected JavaScript not to validate Java")
+	}
+	if !matched[k8s.ID] {
+		t.Error("expected k8s to validate Kubernetes")
+	}
+	if !matched[widgets.ID] {
+		t.Error("expected the profile extension synonym to validate Widget Tuning")
+	}
+}
+
+func TestRelinkProfileSkills(t *testing.T) {
+	skillRepo := newMockProfileSkillRepository()
+	profileID := uuid.New()
+	widgets := &domain.ProfileSkill{ID: uuid.New(), ProfileID: profileID, Name: "Widget Tuning", NormalizedName: "widget tuning"}
+	golang := &domain.ProfileSkill{ID: uuid.New(), ProfileID: profileID, Name: "Golang", NormalizedName: "go"}
+	skillRepo.skills[widgets.ID] = widgets
+	skillRepo.skills[golang.ID] = golang
+
+	custom := &domain.Skill{ID: uuid.New(), ProfileID: &profileID, Slug: "widget-tuning", Name: "Widget Tuning"}
+	tax := taxonomy.Default().With(custom)
+
+	if err := RelinkProfileSkills(context.Background(), skillRepo, tax, profileID); err != nil {
+		t.Fatalf("RelinkProfileSkills returned error: %v", err)
+	}
+	if widgets.CanonicalSkillID == nil || *widgets.CanonicalSkillID != custom.ID {
+		t.Errorf("expected Widget Tuning to link to the profile extension, got %v", widgets.CanonicalSkillID)
+	}
+	if golang.CanonicalSkillID == nil || *golang.CanonicalSkillID != taxonomy.GlobalID("go") {
+		t.Errorf("expected Golang to link to Go, got %v", golang.CanonicalSkillID)
+	}
+
+	// Removing the extension unlinks the skill that depended on it.
+	if err := RelinkProfileSkills(context.Background(), skillRepo, taxonomy.Default(), profileID); err != nil {
+		t.Fatalf("RelinkProfileSkills returned error: %v", err)
+	}
+	if widgets.CanonicalSkillID != nil {
+		t.Errorf("expected Widget Tuning to be unlinked, got %v", widgets.CanonicalSkillID)
+	}
+}
+
 func TestFilterByIndices(t *testing.T) {
 	items := []string{"a", "b", "c", "d", "e"}
 
@@ -1966,7 +3113,7 @@ func TestCrossReferenceValidationsMultipleRolesAtSameCompany(t *testing.T) {
 	skillRepo := newMockProfileSkillRepository()
 	skillValRepo := newMockSkillValidationRepository()
 	expValRepo := newMockExpValidationRepository()
-	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), skillValRepo, expValRepo)
+	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), skillValRepo, expValRepo, newMockEduValidationRepository(), newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
 
 	profileID := uuid.New()
 
@@ -2009,3 +3156,203 @@ func TestCrossReferenceValidationsMultipleRolesAtSameCompany(t *testing.T) {
 		}
 	}
 }
+
+func TestCrossReferenceValidationsMatchesNormalizedCompanyNames(t *testing.T) {
+	profileRepo := newMockProfileRepository()
+	expRepo := newMockProfileExperienceRepository()
+	expValRepo := newMockExpValidationRepository()
+	aliasRepo := newMockCompanyAliasRepository()
+	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), newMockProfileSkillRepository(), newMockAuthorRepository(), newMockTestimonialRepository(), newMockSkillValidationRepository(), expValRepo, newMockEduValidationRepository(), aliasRepo, newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
+
+	profileID := uuid.New()
+
+	acme := &domain.ProfileExperience{ID: uuid.New(), ProfileID: profileID, Company: "ACME Corporation", Title: "Engineer"}
+	meta := &domain.ProfileExperience{ID: uuid.New(), ProfileID: profileID, Company: "Meta Platforms, Inc.", Title: "Staff Engineer"}
+	other := &domain.ProfileExperience{ID: uuid.New(), ProfileID: profileID, Company: "Acme Robotics", Title: "Intern"}
+	expRepo.experiences[acme.ID] = acme
+	expRepo.experiences[meta.ID] = meta
+	expRepo.experiences[other.ID] = other
+
+	aliasRepo.aliases[uuid.New()] = &domain.CompanyAlias{ProfileID: profileID, Alias: "Facebook", CanonicalName: "Meta Platforms"}
+
+	letterData := &domain.ExtractedLetterData{
+		ExperienceMentions: []domain.ExtractedExperienceMention{
+			{Company: "Acme Corp.", Role: "Engineer", Quote: "a great engineer at Acme"},
+			{Company: "Facebook", Role: "Staff Engineer", Quote: "led our infrastructure team at Facebook"},
+		},
+	}
+
+	result, err := svc.CrossReferenceValidations(context.Background(), profileID, uuid.New(), letterData)
+	if err != nil {
+		t.Fatalf("CrossReferenceValidations returned error: %v", err)
+	}
+
+	if result.ExperienceValidations != 2 {
+		t.Errorf("expected 2 experience validations, got %d", result.ExperienceValidations)
+	}
+	matched := make(map[uuid.UUID]bool)
+	for _, ev := range expValRepo.validations {
+		matched[ev.ProfileExperienceID] = true
+	}
+	if !matched[acme.ID] {
+		t.Error("expected 'ACME Corporation' to match 'Acme Corp.'")
+	}
+	if !matched[meta.ID] {
+		t.Error("expected 'Meta Platforms, Inc.' to match 'Facebook' through the alias")
+	}
+	if matched[other.ID] {
+		t.Error("expected 'Acme Robotics' not to match 'Acme Corp.'")
+	}
+}
+
+func TestMaterializeReferenceLetterReusesAuthorWithNormalizedCompany(t *testing.T) {
+	svc, profileRepo, authorRepo, _ := newTestServiceWithRefs()
+
+	userID := uuid.New()
+	profile := &domain.Profile{ID: uuid.New(), UserID: userID}
+	profileRepo.profiles[profile.ID] = profile
+
+	existingAuthor := &domain.Author{
+		ID:        uuid.New(),
+		ProfileID: profile.ID,
+		Name:      "Jane  Smith",
+		Company:   stringPtr("TechCo GmbH"),
+	}
+	authorRepo.authors[existingAuthor.ID] = existingAuthor
+
+	_, err := svc.MaterializeReferenceLetterData(context.Background(), uuid.New(), userID, testExtractedLetterData())
+	if err != nil {
+		t.Fatalf("MaterializeReferenceLetterData returned error: %v", err)
+	}
+
+	if len(authorRepo.authors) != 1 {
+		t.Errorf("expected 1 author (reused across company spellings), got %d", len(authorRepo.authors))
+	}
+}
+
+func TestCrossReferenceValidationsMatchesEducation(t *testing.T) {
+	tests := []struct {
+		name          string
+		institution   string
+		letterData    *domain.ExtractedLetterData
+		expectedCount int
+		expectedQuote *string
+	}{
+		{
+			name:        "professor at the institution",
+			institution: "Technical University of Munich",
+			letterData: &domain.ExtractedLetterData{
+				Author: domain.ExtractedAuthor{
+					Name:         "Prof. Dr. Weber",
+					Company:      stringPtr("TU München"),
+					Relationship: domain.AuthorRelationshipProfessor,
+				},
+				Testimonials: []domain.ExtractedTestimonial{{Quote: "One of the strongest students I have supervised."}},
+			},
+			expectedCount: 1,
+			expectedQuote: stringPtr("One of the strongest students I have supervised."),
+		},
+		{
+			name:        "mentor without testimonials",
+			institution: "MIT",
+			letterData: &domain.ExtractedLetterData{
+				Author: domain.ExtractedAuthor{
+					Name:         "Dr. Chen",
+					Company:      stringPtr("Massachusetts Institute of Technology"),
+					Relationship: domain.AuthorRelationshipMentor,
+				},
+			},
+			expectedCount: 1,
+		},
+		{
+			name:        "manager mentions the institution in a quote",
+			institution: "Stanford University",
+			letterData: &domain.ExtractedLetterData{
+				Author: domain.ExtractedAuthor{
+					Name:         "Alex Kim",
+					Company:      stringPtr("Acme Corp"),
+					Relationship: domain.AuthorRelationshipManager,
+				},
+				Testimonials: []domain.ExtractedTestimonial{{Quote: "She joined us straight out of Stanford University and ramped up quickly."}},
+			},
+			expectedCount: 1,
+			expectedQuote: stringPtr("She joined us straight out of Stanford University and ramped up quickly."),
+		},
+		{
+			name:        "institution named as an experience mention",
+			institution: "University of Vienna",
+			letterData: &domain.ExtractedLetterData{
+				Author: domain.ExtractedAuthor{Name: "Eva Huber", Relationship: domain.AuthorRelationshipColleague},
+				ExperienceMentions: []domain.ExtractedExperienceMention{
+					{Company: "Universität Wien", Role: "Research Assistant", Quote: "a meticulous research assistant"},
+				},
+			},
+			expectedCount: 1,
+			expectedQuote: stringPtr("a meticulous research assistant"),
+		},
+		{
+			name:        "manager at the institution without a mention",
+			institution: "Stanford University",
+			letterData: &domain.ExtractedLetterData{
+				Author: domain.ExtractedAuthor{
+					Name:         "Alex Kim",
+					Company:      stringPtr("Stanford University"),
+					Relationship: domain.AuthorRelationshipManager,
+				},
+				Testimonials: []domain.ExtractedTestimonial{{Quote: "A dependable engineer."}},
+			},
+			expectedCount: 0,
+		},
+		{
+			name:        "professor at a different institution",
+			institution: "Ohio University",
+			letterData: &domain.ExtractedLetterData{
+				Author: domain.ExtractedAuthor{
+					Name:         "Prof. Miller",
+					Company:      stringPtr("Ohio State University"),
+					Relationship: domain.AuthorRelationshipProfessor,
+				},
+			},
+			expectedCount: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			eduRepo := newMockProfileEducationRepository()
+			eduValRepo := newMockEduValidationRepository()
+			svc := NewMaterializationService(nil, newMockProfileRepository(), newMockProfileExperienceRepository(), eduRepo, newMockProfileSkillRepository(), newMockAuthorRepository(), newMockTestimonialRepository(), newMockSkillValidationRepository(), newMockExpValidationRepository(), eduValRepo, newMockCompanyAliasRepository(), newMockCompanyRepository(), newMockSkillRepository(), newMockProfileInconsistencyRepository(), newMockProfileChangeRepository(), newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
+
+			profileID := uuid.New()
+			edu := &domain.ProfileEducation{ID: uuid.New(), ProfileID: profileID, Institution: tc.institution}
+			eduRepo.educations[edu.ID] = edu
+
+			refLetterID := uuid.New()
+			result, err := svc.CrossReferenceValidations(context.Background(), profileID, refLetterID, tc.letterData)
+			if err != nil {
+				t.Fatalf("CrossReferenceValidations returned error: %v", err)
+			}
+			if result.EducationValidations != tc.expectedCount {
+				t.Fatalf("expected %d education validations, got %d", tc.expectedCount, result.EducationValidations)
+			}
+			for _, v := range eduValRepo.validations {
+				if v.ProfileEducationID != edu.ID || v.ReferenceLetterID != refLetterID {
+					t.Errorf("validation references wrong records: %+v", v)
+				}
+				if (v.QuoteSnippet == nil) != (tc.expectedQuote == nil) ||
+					(v.QuoteSnippet != nil && *v.QuoteSnippet != *tc.expectedQuote) {
+					t.Errorf("unexpected quote snippet: %v", v.QuoteSnippet)
+				}
+			}
+
+			// Cross-referencing the same letter again must not create duplicates.
+			result, err = svc.CrossReferenceValidations(context.Background(), profileID, refLetterID, tc.letterData)
+			if err != nil {
+				t.Fatalf("second CrossReferenceValidations returned error: %v", err)
+			}
+			if result.EducationValidations != 0 {
+				t.Errorf("expected no new education validations on re-run, got %d", result.EducationValidations)
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+)
+
+// NoEvidenceAnswer is the answer to a question the profile's evidence does not answer.
+const NoEvidenceAnswer = "The profile has no evidence that answers this question."
+
+// maxAnswerEvidence is the most evidence sent to the LLM with a question.
+const maxAnswerEvidence = 20
+
+// questionStopWords are words of a question that say nothing about what it asks.
+var questionStopWords = map[string]bool{
+	"a": true, "about": true, "an": true, "and": true, "any": true, "are": true, "as": true,
+	"at": true, "be": true, "been": true, "by": true, "can": true, "candidate": true,
+	"did": true, "do": true, "does": true, "ever": true, "evidence": true, "for": true,
+	"from": true, "has": true, "have": true, "he": true, "her": true, "him": true,
+	"his": true, "how": true, "in": true, "is": true, "it": true, "of": true, "on": true,
+	"or": true, "she": true, "that": true, "the": true, "their": true, "them": true,
+	"there": true, "they": true, "this": true, "to": true, "was": true, "what": true,
+	"when": true, "where": true, "which": true, "who": true, "with": true, "worked": true,
+	"would": true,
+}
+
+// questionWordStems maps irregular verb forms common in questions about a candidate to
+// their stem, so "led" finds "leads" and "leadership".
+var questionWordStems = map[string]string{
+	"built": "build", "bought": "buy", "brought": "bring", "chose": "choose", "drove": "drive",
+	"fought": "fight", "grew": "grow", "grown": "grow", "kept": "keep", "led": "lead",
+	"made": "make", "ran": "run", "sold": "sell", "spoke": "speak", "spoken": "speak",
+	"taught": "teach", "thought": "think", "took": "take", "wrote": "write", "written": "write",
+}
+
+// stemSuffixes are the suffixes stripped from words before they are compared, longest first.
+var stemSuffixes = []string{"ship", "ment", "ness", "ing", "ers", "er", "ed", "es", "ly", "s"}
+
+// ProfileQAService answers questions about a profile from its evidence: the testimonials
+// and passages validating its skills and experiences in its reference letters, and the
+// candidate's own descriptions and highlights of their experiences. The most relevant
+// evidence goes to a ProfileWriter, and an answer only counts as supported if a passage it
+// cites is found word for word in the stored evidence.
+type ProfileQAService struct {
+	writer           domain.ProfileWriter
+	embeddings       *EmbeddingService
+	profileSkillRepo domain.ProfileSkillRepository
+	profileExpRepo   domain.ProfileExperienceRepository
+	testimonialRepo  domain.TestimonialRepository
+	skillValRepo     domain.SkillValidationRepository
+	expValRepo       domain.ExperienceValidationRepository
+}
+
+// NewProfileQAService creates a new ProfileQAService. Without an embedding service,
+// evidence is only found by the words it shares with the question.
+func NewProfileQAService(
+	writer domain.ProfileWriter,
+	embeddings *EmbeddingService,
+	profileSkillRepo domain.ProfileSkillRepository,
+	profileExpRepo domain.ProfileExperienceRepository,
+	testimonialRepo domain.TestimonialRepository,
+	skillValRepo domain.SkillValidationRepository,
+	expValRepo domain.ExperienceValidationRepository,
+) *ProfileQAService {
+	return &ProfileQAService{
+		writer:           writer,
+		embeddings:       embeddings,
+		profileSkillRepo: profileSkillRepo,
+		profileExpRepo:   profileExpRepo,
+		testimonialRepo:  testimonialRepo,
+		skillValRepo:     skillValRepo,
+		expValRepo:       expValRepo,
+	}
+}
+
+// Ask answers a question about the profile. When no evidence relates to the question it
+// answers NoEvidenceAnswer without asking the LLM. An answer the LLM reports as unsupported
+// is replaced by NoEvidenceAnswer, so its wording never reaches the user. Citations whose
+// quote is not in the cited evidence are dropped, and an answer claiming support that none
+// of its citations bear out is replaced by NoEvidenceAnswer.
+func (s *ProfileQAService) Ask(ctx context.Context, profileID uuid.UUID, question string) (*domain.ProfileAnswer, error) {
+	question = strings.TrimSpace(question)
+	if question == "" {
+		return nil, &domain.ValidationError{Field: "question", Message: "question is required", Err: domain.ErrEmptyRequired}
+	}
+
+	evidence, err := s.Evidence(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	relevant := s.relevantEvidence(ctx, profileID, question, evidence)
+	if len(relevant) == 0 {
+		return noEvidenceAnswer(domain.ExtractionMetadata{}), nil
+	}
+
+	answer, err := s.writer.AnswerQuestion(ctx, question, relevant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to answer question: %w", err)
+	}
+
+	if !answer.Supported {
+		return noEvidenceAnswer(answer.Metadata), nil
+	}
+
+	stored := make(map[uuid.UUID]string, len(relevant))
+	for _, e := range relevant {
+		stored[e.ID] = quoteKey(e.Quote)
+	}
+	verified := []domain.EvidenceCitation{}
+	for _, c := range answer.Citations {
+		if key := quoteKey(c.Quote); key != "" && strings.Contains(stored[c.ID], key) {
+			verified = append(verified, c)
+		}
+	}
+	if len(verified) == 0 {
+		return noEvidenceAnswer(answer.Metadata), nil
+	}
+	answer.Citations = verified
+	return answer, nil
+}
+
+// Evidence collects the profile's testimonials, the quoted passages validating its skills
+// and experiences, and the descriptions and highlights of its experiences.
+func (s *ProfileQAService) Evidence(ctx context.Context, profileID uuid.UUID) ([]domain.Evidence, error) {
+	testimonials, err := s.testimonialRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get testimonials: %w", err)
+	}
+	skills, err := s.profileSkillRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile skills: %w", err)
+	}
+	experiences, err := s.profileExpRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile experiences: %w", err)
+	}
+
+	var evidence []domain.Evidence
+	for _, t := range testimonials {
+		evidence = append(evidence, domain.Evidence{ID: t.ID, Kind: domain.EvidenceTestimonial, Quote: t.Quote, Context: testimonialContext(t)})
+	}
+	for _, skill := range skills {
+		validations, valErr := s.skillValRepo.GetByProfileSkillID(ctx, skill.ID)
+		if valErr != nil {
+			return nil, fmt.Errorf("failed to get validations for skill %s: %w", skill.ID, valErr)
+		}
+		for _, v := range validations {
+			if v.QuoteSnippet != nil && *v.QuoteSnippet != "" {
+				evidence = append(evidence, domain.Evidence{ID: v.ID, Kind: domain.EvidenceSkillValidation, Quote: *v.QuoteSnippet, Context: "validates the skill " + skill.Name})
+			}
+		}
+	}
+	for _, exp := range experiences {
+		validations, valErr := s.expValRepo.GetByProfileExperienceID(ctx, exp.ID)
+		if valErr != nil {
+			return nil, fmt.Errorf("failed to get validations for experience %s: %w", exp.ID, valErr)
+		}
+		for _, v := range validations {
+			if v.QuoteSnippet != nil && *v.QuoteSnippet != "" {
+				evidence = append(evidence, domain.Evidence{ID: v.ID, Kind: domain.EvidenceExperienceValidation, Quote: *v.QuoteSnippet, Context: "validates the role " + exp.Title + " at " + exp.Company})
+			}
+		}
+		if text := experienceEvidenceText(exp); text != "" {
+			evidence = append(evidence, domain.Evidence{ID: exp.ID, Kind: domain.EvidenceExperience, Quote: text, Context: "the candidate's own description of the role " + exp.Title + " at " + exp.Company})
+		}
+	}
+	return evidence, nil
+}
+
+// relevantEvidence returns up to maxAnswerEvidence pieces of evidence related to the
+// question. Evidence the embedding service finds at least as close in meaning as its
+// embedder's match threshold comes first, most similar first, followed by evidence sharing
+// words with the question. When the search fails, only shared words are used.
+func (s *ProfileQAService) relevantEvidence(ctx context.Context, profileID uuid.UUID, question string, evidence []domain.Evidence) []domain.Evidence {
+	lexical := relevantEvidence(question, evidence, maxAnswerEvidence)
+	if s.embeddings == nil {
+		return lexical
+	}
+	matches, err := s.embeddings.Search(ctx, profileID, question, maxAnswerEvidence)
+	if err != nil {
+		return lexical
+	}
+
+	byID := make(map[uuid.UUID]domain.Evidence, len(evidence))
+	for _, e := range evidence {
+		byID[e.ID] = e
+	}
+	threshold := s.embeddings.embedder.MatchThreshold()
+	var result []domain.Evidence
+	seen := map[uuid.UUID]bool{}
+	for _, m := range matches {
+		e, ok := byID[m.Embedding.SourceID]
+		if ok && m.Score >= threshold && !seen[e.ID] {
+			seen[e.ID] = true
+			result = append(result, e)
+		}
+	}
+	for _, e := range lexical {
+		if !seen[e.ID] {
+			seen[e.ID] = true
+			result = append(result, e)
+		}
+	}
+	return result[:min(len(result), maxAnswerEvidence)]
+}
+
+func noEvidenceAnswer(metadata domain.ExtractionMetadata) *domain.ProfileAnswer {
+	return &domain.ProfileAnswer{
+		Answer:    NoEvidenceAnswer,
+		Citations: []domain.EvidenceCitation{},
+		Metadata:  metadata,
+	}
+}
+
+// testimonialContext describes who wrote a testimonial and the skills it mentions.
+func testimonialContext(t *domain.Testimonial) string {
+	var parts []string
+	for _, part := range []*string{t.AuthorName, t.AuthorTitle, t.AuthorCompany} {
+		if part != nil && *part != "" {
+			parts = append(parts, *part)
+		}
+	}
+	relationship := strings.ReplaceAll(string(t.Relationship), "_", " ")
+	desc := "by " + relationship
+	if len(parts) > 0 {
+		desc = "by " + strings.Join(parts, ", ") + ", " + relationship
+	}
+	if len(t.SkillsMentioned) > 0 {
+		desc += "; mentions " + strings.Join(t.SkillsMentioned, ", ")
+	}
+	return desc
+}
+
+// relevantEvidence returns up to limit pieces of evidence sharing terms with the question,
+// those sharing the most first. Words are compared by their stems, and stems match when
+// one is a prefix of the other at least four letters long, so "led" matches "leadership"
+// and "managed" matches "management".
+func relevantEvidence(question string, evidence []domain.Evidence, limit int) []domain.Evidence {
+	var terms []string
+	seen := map[string]bool{}
+	for _, token := range normalize.Tokens(question) {
+		if questionStopWords[token] {
+			continue
+		}
+		if term := stem(token); !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+
+	type scored struct {
+		evidence domain.Evidence
+		score    int
+	}
+	var matches []scored
+	for _, e := range evidence {
+		tokens := normalize.Tokens(e.Quote + " " + e.Context)
+		for i, token := range tokens {
+			tokens[i] = stem(token)
+		}
+		score := 0
+		for _, term := range terms {
+			for _, token := range tokens {
+				if termMatches(term, token) {
+					score++
+					break
+				}
+			}
+		}
+		if score > 0 {
+			matches = append(matches, scored{evidence: e, score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+
+	matches = matches[:min(len(matches), limit)]
+	result := make([]domain.Evidence, len(matches))
+	for i, m := range matches {
+		result[i] = m.evidence
+	}
+	return result
+}
+
+func termMatches(term, token string) bool {
+	if term == token {
+		return true
+	}
+	shorter, longer := term, token
+	if utf8.RuneCountInString(shorter) > utf8.RuneCountInString(longer) {
+		shorter, longer = longer, shorter
+	}
+	return utf8.RuneCountInString(shorter) >= 4 && strings.HasPrefix(longer, shorter)
+}
+
+// stem reduces a word to a crude stem by mapping irregular forms and repeatedly stripping
+// common suffixes, leaving at least three letters: "leadership" and "leaders" become "lead".
+// A doubled final consonant left by "ing", "ed" or "er" is undoubled, so "running" becomes
+// "run".
+func stem(word string) string {
+	if irregular, ok := questionWordStems[word]; ok {
+		return irregular
+	}
+	for {
+		stripped := ""
+		for _, suffix := range stemSuffixes {
+			if strings.HasSuffix(word, suffix) && utf8.RuneCountInString(word)-utf8.RuneCountInString(suffix) >= 3 {
+				stripped = strings.TrimSuffix(word, suffix)
+				if n := len(stripped); (suffix == "ing" || suffix == "ed" || suffix == "er") && n >= 4 &&
+					stripped[n-1] == stripped[n-2] && !strings.ContainsRune("aeiouslz", rune(stripped[n-1])) {
+					stripped = stripped[:n-1]
+				}
+				break
+			}
+		}
+		if stripped == "" {
+			return word
+		}
+		word = stripped
+	}
+}
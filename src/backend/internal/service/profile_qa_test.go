@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+// stubAnswerWriter answers every question with a fixed answer and keeps the evidence it
+// was given. Only AnswerQuestion is used.
+type stubAnswerWriter struct {
+	domain.ProfileWriter
+	answer   *domain.ProfileAnswer
+	evidence []domain.Evidence
+}
+
+func (w *stubAnswerWriter) AnswerQuestion(_ context.Context, _ string, evidence []domain.Evidence) (*domain.ProfileAnswer, error) {
+	w.evidence = evidence
+	answer := *w.answer
+	return &answer, nil
+}
+
+func TestProfileQAService_Ask(t *testing.T) {
+	ctx := context.Background()
+	profileID := uuid.New()
+	skillRepo := newMockProfileSkillRepository()
+	kubernetes := &domain.ProfileSkill{ID: uuid.New(), ProfileID: profileID, Name: "Kubernetes"}
+	skillRepo.skills[kubernetes.ID] = kubernetes
+	expRepo := newMockProfileExperienceRepository()
+	exp := &domain.ProfileExperience{ID: uuid.New(), ProfileID: profileID, Title: "Engineering Manager", Company: "Acme"}
+	expRepo.experiences[exp.ID] = exp
+	testimonialRepo := newMockTestimonialRepository()
+	quote := &domain.Testimonial{ID: uuid.New(), ProfileID: profileID, Quote: "She led a team of six through two reorganizations.", Relationship: domain.TestimonialRelationshipManager}
+	testimonialRepo.testimonials[quote.ID] = quote
+	skillValRepo := newMockSkillValidationRepository()
+	skillSnippet := "ran our Kubernetes clusters in production"
+	skillVal := &domain.SkillValidation{ID: uuid.New(), ProfileSkillID: kubernetes.ID, QuoteSnippet: &skillSnippet}
+	skillValRepo.validations[skillVal.ID] = skillVal
+	expValRepo := newMockExpValidationRepository()
+	expSnippet := "managed the platform group"
+	expVal := &domain.ExperienceValidation{ID: uuid.New(), ProfileExperienceID: exp.ID, QuoteSnippet: &expSnippet}
+	expValRepo.validations[expVal.ID] = expVal
+
+	ask := func(answer *domain.ProfileAnswer, question string) (*domain.ProfileAnswer, *stubAnswerWriter) {
+		t.Helper()
+		writer := &stubAnswerWriter{answer: answer}
+		s := NewProfileQAService(writer, nil, skillRepo, expRepo, testimonialRepo, skillValRepo, expValRepo)
+		got, err := s.Ask(ctx, profileID, question)
+		if err != nil {
+			t.Fatalf("Ask() error = %v", err)
+		}
+		return got, writer
+	}
+
+	t.Run("sends the relevant evidence and keeps verified citations", func(t *testing.T) {
+		got, writer := ask(&domain.ProfileAnswer{
+			Answer:    "Yes, a former manager writes that she ran the Kubernetes clusters in production.",
+			Supported: true,
+			Citations: []domain.EvidenceCitation{
+				{Kind: domain.EvidenceSkillValidation, ID: skillVal.ID, Quote: "Kubernetes clusters in production"},
+				{Kind: domain.EvidenceTestimonial, ID: quote.ID, Quote: "ran Kubernetes at scale"},
+			},
+		}, "Any evidence of Kubernetes in production?")
+
+		if len(writer.evidence) != 1 || writer.evidence[0].ID != skillVal.ID || writer.evidence[0].Context != "validates the skill Kubernetes" {
+			t.Errorf("expected only the Kubernetes validation to be sent, got %+v", writer.evidence)
+		}
+		if !got.Supported || len(got.Citations) != 1 || got.Citations[0].ID != skillVal.ID {
+			t.Errorf("expected the misquoted citation to be dropped, got %+v", got)
+		}
+	})
+
+	t.Run("ranks evidence sharing more terms first", func(t *testing.T) {
+		_, writer := ask(&domain.ProfileAnswer{Answer: "Yes.", Supported: true}, "Has she led or managed a team?")
+		if len(writer.evidence) != 2 || writer.evidence[0].ID != quote.ID || writer.evidence[1].ID != expVal.ID {
+			t.Errorf("expected the testimonial and then the experience validation, got %+v", writer.evidence)
+		}
+	})
+
+	t.Run("answers without the LLM when nothing is relevant", func(t *testing.T) {
+		got, writer := ask(&domain.ProfileAnswer{Answer: "Probably.", Supported: true}, "Does she speak Japanese?")
+		if writer.evidence != nil || got.Supported || got.Answer != NoEvidenceAnswer {
+			t.Errorf("expected the no-evidence answer, got %+v", got)
+		}
+	})
+
+	t.Run("replaces an unsupported answer's speculation", func(t *testing.T) {
+		got, _ := ask(&domain.ProfileAnswer{
+			Answer:    "The letters do not say, but as a manager she most likely led a team of ten.",
+			Supported: false,
+			Citations: []domain.EvidenceCitation{{Kind: domain.EvidenceTestimonial, ID: quote.ID, Quote: "She led a team of six"}},
+			Metadata:  domain.ExtractionMetadata{ModelVersion: "test-model"},
+		}, "How large a team has she led?")
+		if got.Supported || got.Answer != NoEvidenceAnswer || len(got.Citations) != 0 || got.Metadata.ModelVersion != "test-model" {
+			t.Errorf("expected the no-evidence answer keeping the metadata, got %+v", got)
+		}
+	})
+
+	t.Run("replaces a supported answer whose citations do not check out", func(t *testing.T) {
+		got, _ := ask(&domain.ProfileAnswer{
+			Answer:    "She led a team of twenty.",
+			Supported: true,
+			Citations: []domain.EvidenceCitation{{Kind: domain.EvidenceTestimonial, ID: quote.ID, Quote: "led a team of twenty"}},
+		}, "Has she led a team?")
+		if got.Supported || got.Answer != NoEvidenceAnswer || len(got.Citations) != 0 {
+			t.Errorf("expected the no-evidence answer, got %+v", got)
+		}
+	})
+}
+
+func TestProfileQAService_AskFindsStemsAndExperiences(t *testing.T) {
+	ctx := context.Background()
+	profileID := uuid.New()
+	expRepo := newMockProfileExperienceRepository()
+	exp := &domain.ProfileExperience{ID: uuid.New(), ProfileID: profileID, Title: "Staff Engineer", Company: "Acme", Highlights: []string{"Grew the payments team from two to nine engineers"}}
+	expRepo.experiences[exp.ID] = exp
+	testimonialRepo := newMockTestimonialRepository()
+	quote := &domain.Testimonial{ID: uuid.New(), ProfileID: profileID, Quote: "Her leadership kept the launch on schedule.", Relationship: domain.TestimonialRelationshipPeer}
+	testimonialRepo.testimonials[quote.ID] = quote
+	unrelated := &domain.Testimonial{ID: uuid.New(), ProfileID: profileID, Quote: "A thoughtful reviewer of designs.", Relationship: domain.TestimonialRelationshipPeer}
+	testimonialRepo.testimonials[unrelated.ID] = unrelated
+
+	writer := &stubAnswerWriter{answer: &domain.ProfileAnswer{Answer: "Yes.", Supported: true}}
+	s := NewProfileQAService(writer, nil, newMockProfileSkillRepository(), expRepo, testimonialRepo, newMockSkillValidationRepository(), newMockExpValidationRepository())
+	if _, err := s.Ask(ctx, profileID, "Has she led a team?"); err != nil {
+		t.Fatalf("Ask() error = %v", err)
+	}
+
+	got := map[uuid.UUID]domain.Evidence{}
+	for _, e := range writer.evidence {
+		got[e.ID] = e
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected the leadership testimonial and the team highlight, got %+v", writer.evidence)
+	}
+	if _, ok := got[quote.ID]; !ok {
+		t.Errorf("expected \"led\" to find \"leadership\", got %+v", writer.evidence)
+	}
+	if e, ok := got[exp.ID]; !ok || e.Kind != domain.EvidenceExperience || e.Quote != exp.Highlights[0] || e.Context != "the candidate's own description of the role Staff Engineer at Acme" {
+		t.Errorf("expected the experience highlight as evidence, got %+v", writer.evidence)
+	}
+}
+
+// constantEmbedder embeds every text as the same vector, so every piece of evidence is
+// as close in meaning to a question as can be.
+type constantEmbedder struct{}
+
+func (constantEmbedder) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i := range texts {
+		vectors[i] = []float32{1, 0}
+	}
+	return vectors, nil
+}
+
+func (constantEmbedder) Name() string { return "constant" }
+
+func (constantEmbedder) MatchThreshold() float64 { return 0.9 }
+
+func TestProfileQAService_AskSearchesByMeaning(t *testing.T) {
+	ctx := context.Background()
+	profileID := uuid.New()
+	expRepo := newMockProfileExperienceRepository()
+	skillRepo := newMockProfileSkillRepository()
+	skillValRepo := newMockSkillValidationRepository()
+	testimonialRepo := newMockTestimonialRepository()
+	quote := &domain.Testimonial{ID: uuid.New(), ProfileID: profileID, Quote: "She coached four engineers into senior roles.", Relationship: domain.TestimonialRelationshipManager}
+	testimonialRepo.testimonials[quote.ID] = quote
+	embeddingSvc := NewEmbeddingService(constantEmbedder{}, newMockEvidenceEmbeddingRepository(), expRepo, skillRepo, testimonialRepo, skillValRepo)
+	if _, err := embeddingSvc.EmbedProfile(ctx, profileID); err != nil {
+		t.Fatalf("EmbedProfile() error = %v", err)
+	}
+
+	writer := &stubAnswerWriter{answer: &domain.ProfileAnswer{Answer: "Yes.", Supported: true}}
+	s := NewProfileQAService(writer, embeddingSvc, skillRepo, expRepo, testimonialRepo, skillValRepo, newMockExpValidationRepository())
+	if _, err := s.Ask(ctx, profileID, "Has she mentored anyone?"); err != nil {
+		t.Fatalf("Ask() error = %v", err)
+	}
+	if len(writer.evidence) != 1 || writer.evidence[0].ID != quote.ID {
+		t.Errorf("expected the coaching testimonial found by meaning, got %+v", writer.evidence)
+	}
+}
@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+	"backend/internal/repository/postgres"
+)
+
+// sectionRepos holds the repositories of the profile sections beyond experience, education
+// and skills.
+type sectionRepos struct {
+	links          domain.ProfileLinkRepository
+	certifications domain.ProfileCertificationRepository
+	languages      domain.ProfileLanguageRepository
+	projects       domain.ProfileProjectRepository
+	publications   domain.ProfilePublicationRepository
+	awards         domain.ProfileAwardRepository
+}
+
+// newTxSectionRepos creates the section repositories of a transaction.
+func newTxSectionRepos(tx bun.IDB) sectionRepos {
+	return sectionRepos{
+		links:          postgres.NewProfileLinkRepository(tx),
+		certifications: postgres.NewProfileCertificationRepository(tx),
+		languages:      postgres.NewProfileLanguageRepository(tx),
+		projects:       postgres.NewProfileProjectRepository(tx),
+		publications:   postgres.NewProfilePublicationRepository(tx),
+		awards:         postgres.NewProfileAwardRepository(tx),
+	}
+}
+
+// deleteBySourceResumeID removes the section entries extracted from a resume.
+func (r sectionRepos) deleteBySourceResumeID(ctx context.Context, resumeID uuid.UUID) error {
+	deletes := []struct {
+		section string
+		delete  func(context.Context, uuid.UUID) error
+	}{
+		{"links", r.links.DeleteBySourceResumeID},
+		{"certifications", r.certifications.DeleteBySourceResumeID},
+		{"languages", r.languages.DeleteBySourceResumeID},
+		{"projects", r.projects.DeleteBySourceResumeID},
+		{"publications", r.publications.DeleteBySourceResumeID},
+		{"awards", r.awards.DeleteBySourceResumeID},
+	}
+	for _, d := range deletes {
+		if err := d.delete(ctx, resumeID); err != nil {
+			return fmt.Errorf("failed to delete existing %s for resume: %w", d.section, err)
+		}
+	}
+	return nil
+}
+
+// materializeSectionsWithRepos adds the resume's links, certifications, languages,
+// projects, publications and awards to the profile after its current entries, counting them
+// in result. Entries the profile already has are skipped: links with the same address,
+// and other entries with the same name or title, ignoring case and accents.
+func materializeSectionsWithRepos(ctx context.Context, repos sectionRepos, resumeID, profileID uuid.UUID, data *domain.ResumeExtractedData, result *MaterializationResult) error {
+	var err error
+	if result.Links, err = materializeLinks(ctx, repos.links, resumeID, profileID, data.Links); err != nil {
+		return fmt.Errorf("links: %w", err)
+	}
+	if result.Certifications, err = materializeCertifications(ctx, repos.certifications, resumeID, profileID, data.Certifications); err != nil {
+		return fmt.Errorf("certifications: %w", err)
+	}
+	if result.Languages, err = materializeLanguages(ctx, repos.languages, resumeID, profileID, data.Languages); err != nil {
+		return fmt.Errorf("languages: %w", err)
+	}
+	if result.Projects, err = materializeProjects(ctx, repos.projects, resumeID, profileID, data.Projects); err != nil {
+		return fmt.Errorf("projects: %w", err)
+	}
+	if result.Publications, err = materializePublications(ctx, repos.publications, resumeID, profileID, data.Publications); err != nil {
+		return fmt.Errorf("publications: %w", err)
+	}
+	if result.Awards, err = materializeAwards(ctx, repos.awards, resumeID, profileID, data.Awards); err != nil {
+		return fmt.Errorf("awards: %w", err)
+	}
+	return nil
+}
+
+// LinkKey identifies a link by its address, ignoring the scheme, a leading "www.", case and
+// a trailing slash.
+func LinkKey(url string) string {
+	key := strings.ToLower(strings.TrimSpace(url))
+	if i := strings.Index(key, "://"); i >= 0 {
+		key = key[i+3:]
+	}
+	key = strings.TrimPrefix(key, "www.")
+	return strings.TrimRight(key, "/")
+}
+
+// sectionKeys collects the keys of a section's current entries.
+func sectionKeys[T any](items []T, key func(T) string) map[string]bool {
+	keys := make(map[string]bool, len(items))
+	for _, item := range items {
+		keys[key(item)] = true
+	}
+	return keys
+}
+
+// claimKey reports whether key is new, remembering it if so. Blank keys are never new.
+func claimKey(keys map[string]bool, key string) bool {
+	if key == "" || keys[key] {
+		return false
+	}
+	keys[key] = true
+	return true
+}
+
+func materializeLinks(ctx context.Context, repo domain.ProfileLinkRepository, resumeID, profileID uuid.UUID, links []domain.ResumeLink) (int, error) {
+	existing, err := repo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get existing links: %w", err)
+	}
+	keys := sectionKeys(existing, func(l *domain.ProfileLink) string { return LinkKey(l.URL) })
+	displayOrder, err := repo.GetNextDisplayOrder(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get next link display order: %w", err)
+	}
+
+	count := 0
+	for _, link := range links {
+		if !claimKey(keys, LinkKey(link.URL)) {
+			continue
+		}
+		kind := link.Kind
+		if !kind.IsValid() {
+			kind = domain.LinkKindOther
+		}
+		if err := repo.Create(ctx, &domain.ProfileLink{
+			ID:             uuid.New(),
+			ProfileID:      profileID,
+			Kind:           kind,
+			URL:            link.URL,
+			Label:          link.Label,
+			DisplayOrder:   displayOrder + count,
+			Source:         domain.ExperienceSourceResumeExtracted,
+			SourceResumeID: &resumeID,
+		}); err != nil {
+			return count, fmt.Errorf("failed to create link %s: %w", link.URL, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+func materializeCertifications(ctx context.Context, repo domain.ProfileCertificationRepository, resumeID, profileID uuid.UUID, certifications []domain.Certification) (int, error) {
+	existing, err := repo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get existing certifications: %w", err)
+	}
+	keys := sectionKeys(existing, func(c *domain.ProfileCertification) string { return normalize.FoldText(c.Name) })
+	displayOrder, err := repo.GetNextDisplayOrder(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get next certification display order: %w", err)
+	}
+
+	count := 0
+	for _, c := range certifications {
+		if !claimKey(keys, normalize.FoldText(c.Name)) {
+			continue
+		}
+		cert := &domain.ProfileCertification{
+			ID:              uuid.New(),
+			ProfileID:       profileID,
+			Name:            c.Name,
+			Issuer:          c.Issuer,
+			CredentialID:    c.CredentialID,
+			VerificationURL: c.VerificationURL,
+			IssueDate:       c.IssueDate,
+			ExpiryDate:      c.ExpiryDate,
+			DisplayOrder:    displayOrder + count,
+			Source:          domain.ExperienceSourceResumeExtracted,
+			SourceResumeID:  &resumeID,
+		}
+		_ = SetCertificationExpiry(cert)
+		if err := repo.Create(ctx, cert); err != nil {
+			return count, fmt.Errorf("failed to create certification %q: %w", c.Name, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+func materializeLanguages(ctx context.Context, repo domain.ProfileLanguageRepository, resumeID, profileID uuid.UUID, languages []domain.SpokenLanguage) (int, error) {
+	existing, err := repo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get existing languages: %w", err)
+	}
+	keys := sectionKeys(existing, func(l *domain.ProfileLanguage) string { return normalize.FoldText(l.Language) })
+	displayOrder, err := repo.GetNextDisplayOrder(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get next language display order: %w", err)
+	}
+
+	count := 0
+	for _, l := range languages {
+		if !claimKey(keys, normalize.FoldText(l.Language)) {
+			continue
+		}
+		if err := repo.Create(ctx, &domain.ProfileLanguage{
+			ID:             uuid.New(),
+			ProfileID:      profileID,
+			Language:       l.Language,
+			Proficiency:    l.Proficiency,
+			DisplayOrder:   displayOrder + count,
+			Source:         domain.ExperienceSourceResumeExtracted,
+			SourceResumeID: &resumeID,
+		}); err != nil {
+			return count, fmt.Errorf("failed to create language %q: %w", l.Language, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+func materializeProjects(ctx context.Context, repo domain.ProfileProjectRepository, resumeID, profileID uuid.UUID, projects []domain.Project) (int, error) {
+	existing, err := repo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get existing projects: %w", err)
+	}
+	keys := sectionKeys(existing, func(p *domain.ProfileProject) string { return normalize.FoldText(p.Name) })
+	displayOrder, err := repo.GetNextDisplayOrder(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get next project display order: %w", err)
+	}
+
+	count := 0
+	for _, p := range projects {
+		if !claimKey(keys, normalize.FoldText(p.Name)) {
+			continue
+		}
+		if err := repo.Create(ctx, &domain.ProfileProject{
+			ID:             uuid.New(),
+			ProfileID:      profileID,
+			Name:           p.Name,
+			Role:           p.Role,
+			Description:    p.Description,
+			URL:            p.URL,
+			StartDate:      p.StartDate,
+			EndDate:        p.EndDate,
+			Technologies:   p.Technologies,
+			DisplayOrder:   displayOrder + count,
+			Source:         domain.ExperienceSourceResumeExtracted,
+			SourceResumeID: &resumeID,
+		}); err != nil {
+			return count, fmt.Errorf("failed to create project %q: %w", p.Name, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+func materializePublications(ctx context.Context, repo domain.ProfilePublicationRepository, resumeID, profileID uuid.UUID, publications []domain.Publication) (int, error) {
+	existing, err := repo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get existing publications: %w", err)
+	}
+	keys := sectionKeys(existing, func(p *domain.ProfilePublication) string { return normalize.FoldText(p.Title) })
+	displayOrder, err := repo.GetNextDisplayOrder(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get next publication display order: %w", err)
+	}
+
+	count := 0
+	for _, p := range publications {
+		if !claimKey(keys, normalize.FoldText(p.Title)) {
+			continue
+		}
+		if err := repo.Create(ctx, &domain.ProfilePublication{
+			ID:             uuid.New(),
+			ProfileID:      profileID,
+			Title:          p.Title,
+			Publisher:      p.Publisher,
+			Date:           p.Date,
+			URL:            p.URL,
+			Description:    p.Description,
+			DisplayOrder:   displayOrder + count,
+			Source:         domain.ExperienceSourceResumeExtracted,
+			SourceResumeID: &resumeID,
+		}); err != nil {
+			return count, fmt.Errorf("failed to create publication %q: %w", p.Title, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+func materializeAwards(ctx context.Context, repo domain.ProfileAwardRepository, resumeID, profileID uuid.UUID, awards []domain.Award) (int, error) {
+	existing, err := repo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get existing awards: %w", err)
+	}
+	keys := sectionKeys(existing, func(a *domain.ProfileAward) string { return normalize.FoldText(a.Title) })
+	displayOrder, err := repo.GetNextDisplayOrder(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get next award display order: %w", err)
+	}
+
+	count := 0
+	for _, a := range awards {
+		if !claimKey(keys, normalize.FoldText(a.Title)) {
+			continue
+		}
+		if err := repo.Create(ctx, &domain.ProfileAward{
+			ID:             uuid.New(),
+			ProfileID:      profileID,
+			Title:          a.Title,
+			Issuer:         a.Issuer,
+			Date:           a.Date,
+			Description:    a.Description,
+			DisplayOrder:   displayOrder + count,
+			Source:         domain.ExperienceSourceResumeExtracted,
+			SourceResumeID: &resumeID,
+		}); err != nil {
+			return count, fmt.Errorf("failed to create award %q: %w", a.Title, err)
+		}
+		count++
+	}
+	return count, nil
+}
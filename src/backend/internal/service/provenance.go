@@ -0,0 +1,339 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+var (
+	// ErrNoExtractedValues is returned when reverting an item that was entered manually or
+	// whose extracted values were not kept.
+	ErrNoExtractedValues = errors.New("item has no extracted values")
+	// ErrUnknownField is returned when reverting a field that provenance does not cover.
+	ErrUnknownField = errors.New("unknown field")
+)
+
+// FieldProvenance tells where the current value of one field of a profile item came from.
+// Origin is the item's source while the field still holds the value taken from the source
+// document, and manual once the user has changed it. ExtractedValue is that value encoded
+// as JSON; it is nil when the item was entered manually or its extracted values were not
+// kept, and the field is then attributed to the item's source.
+type FieldProvenance struct {
+	Field                   string
+	Origin                  domain.ExperienceSource
+	SourceResumeID          *uuid.UUID
+	SourceReferenceLetterID *uuid.UUID
+	ExtractedValue          json.RawMessage
+}
+
+// The fields provenance covers, named like in the change log. Source fields and fields
+// that only merging or the skill stats change are left out.
+var (
+	experienceProvenanceFields = []string{"company", "title", "location", "startDate", "endDate", "isCurrent", "description", "highlights"}
+	educationProvenanceFields  = []string{"institution", "degree", "field", "startDate", "endDate", "isCurrent", "description", "gpa"}
+	skillProvenanceFields      = []string{"name", "category"}
+)
+
+// extractedExperience rebuilds the fields of an experience as materialization first stored
+// them, from the resume entry or letter mention kept in its original data. It reports
+// false when there is nothing to rebuild from.
+func extractedExperience(e *domain.ProfileExperience) (experienceFields, bool, error) {
+	fields := experienceFields{
+		Source:                  e.Source,
+		SourceResumeID:          e.SourceResumeID,
+		SourceReferenceLetterID: e.SourceReferenceLetterID,
+		OriginalData:            e.OriginalData,
+	}
+	if len(e.OriginalData) == 0 {
+		return fields, false, nil
+	}
+	switch e.Source {
+	case domain.ExperienceSourceResumeExtracted:
+		var original domain.WorkExperience
+		if err := json.Unmarshal(e.OriginalData, &original); err != nil {
+			return fields, false, fmt.Errorf("failed to decode original experience: %w", err)
+		}
+		fields.Company = original.Company
+		fields.Title = original.Title
+		fields.Location = original.Location
+		fields.StartDate = original.StartDate
+		fields.EndDate = original.EndDate
+		fields.IsCurrent = original.IsCurrent
+		fields.Description, fields.Highlights = resumeExperienceText(original)
+	case domain.ExperienceSourceLetterDiscovered:
+		var mention domain.ExtractedExperienceMention
+		if err := json.Unmarshal(e.OriginalData, &mention); err != nil {
+			return fields, false, fmt.Errorf("failed to decode original experience mention: %w", err)
+		}
+		fields.Company = strings.TrimSpace(mention.Company)
+		fields.Title = strings.TrimSpace(mention.Role)
+	default:
+		return fields, false, nil
+	}
+	return fields, true, nil
+}
+
+// extractedEducation rebuilds the fields of an education entry as materialization first
+// stored them from the resume entry kept in its original data.
+func extractedEducation(e *domain.ProfileEducation) (educationFields, bool, error) {
+	fields := educationFields{
+		Source:         e.Source,
+		SourceResumeID: e.SourceResumeID,
+		OriginalData:   e.OriginalData,
+	}
+	if len(e.OriginalData) == 0 || e.Source != domain.ExperienceSourceResumeExtracted {
+		return fields, false, nil
+	}
+	var original domain.Education
+	if err := json.Unmarshal(e.OriginalData, &original); err != nil {
+		return fields, false, fmt.Errorf("failed to decode original education: %w", err)
+	}
+	fields.Institution = original.Institution
+	fields.Degree = "Degree"
+	if original.Degree != nil && *original.Degree != "" {
+		fields.Degree = *original.Degree
+	}
+	fields.Field = original.Field
+	fields.StartDate = original.StartDate
+	fields.EndDate = original.EndDate
+	fields.Description = original.Achievements
+	fields.GPA = original.GPA
+	return fields, true, nil
+}
+
+// extractedSkill rebuilds the fields of a skill as first stored, from the resume skill name
+// or the discovered letter skill kept in its original data.
+func extractedSkill(s *domain.ProfileSkill) (skillFields, bool, error) {
+	fields := skillFields{
+		Aliases:                 nilIfEmpty(s.Aliases),
+		Source:                  s.Source,
+		SourceResumeID:          s.SourceResumeID,
+		SourceReferenceLetterID: s.SourceReferenceLetterID,
+		OriginalData:            s.OriginalData,
+	}
+	if len(s.OriginalData) == 0 {
+		return fields, false, nil
+	}
+	switch s.Source {
+	case domain.ExperienceSourceResumeExtracted:
+		if err := json.Unmarshal(s.OriginalData, &fields.Name); err != nil {
+			return fields, false, fmt.Errorf("failed to decode original skill: %w", err)
+		}
+		fields.Category = "TECHNICAL"
+	case domain.ExperienceSourceLetterDiscovered:
+		var discovered domain.DiscoveredSkill
+		if err := json.Unmarshal(s.OriginalData, &discovered); err != nil {
+			return fields, false, fmt.Errorf("failed to decode original discovered skill: %w", err)
+		}
+		fields.Name = discovered.Skill
+		fields.Category = strings.ToUpper(string(discovered.Category))
+		if fields.Category == "" {
+			fields.Category = "SOFT"
+		}
+	default:
+		return fields, false, nil
+	}
+	return fields, true, nil
+}
+
+// fieldProvenance compares the current fields of an item with the extracted ones, which
+// are nil when unknown.
+func fieldProvenance(names []string, source domain.ExperienceSource, resumeID, letterID *uuid.UUID, current, extracted any) []FieldProvenance {
+	currentValues := fieldValues(current)
+	var extractedValues map[string]json.RawMessage
+	if extracted != nil {
+		extractedValues = fieldValues(extracted)
+	}
+
+	result := make([]FieldProvenance, len(names))
+	for i, name := range names {
+		result[i] = FieldProvenance{
+			Field:                   name,
+			Origin:                  source,
+			SourceResumeID:          resumeID,
+			SourceReferenceLetterID: letterID,
+		}
+		if value, ok := extractedValues[name]; ok {
+			result[i].ExtractedValue = value
+			if !bytes.Equal(value, currentValues[name]) {
+				result[i].Origin = domain.ExperienceSourceManual
+			}
+		}
+	}
+	return result
+}
+
+// ExperienceProvenance returns where the value of each field of an experience came from.
+func ExperienceProvenance(e *domain.ProfileExperience) ([]FieldProvenance, error) {
+	extracted, ok, err := extractedExperience(e)
+	if err != nil {
+		return nil, err
+	}
+	var original any
+	if ok {
+		original = extracted
+	}
+	return fieldProvenance(experienceProvenanceFields, e.Source, e.SourceResumeID, e.SourceReferenceLetterID, experienceFieldsOf(e), original), nil
+}
+
+// EducationProvenance returns where the value of each field of an education entry came from.
+func EducationProvenance(e *domain.ProfileEducation) ([]FieldProvenance, error) {
+	extracted, ok, err := extractedEducation(e)
+	if err != nil {
+		return nil, err
+	}
+	var original any
+	if ok {
+		original = extracted
+	}
+	return fieldProvenance(educationProvenanceFields, e.Source, e.SourceResumeID, nil, educationFieldsOf(e), original), nil
+}
+
+// SkillProvenance returns where the value of each field of a skill came from.
+func SkillProvenance(s *domain.ProfileSkill) ([]FieldProvenance, error) {
+	extracted, ok, err := extractedSkill(s)
+	if err != nil {
+		return nil, err
+	}
+	var original any
+	if ok {
+		original = extracted
+	}
+	return fieldProvenance(skillProvenanceFields, s.Source, s.SourceResumeID, s.SourceReferenceLetterID, skillFieldsOf(s), original), nil
+}
+
+// revertFields copies the named fields from the extracted fields onto the current ones,
+// or every field provenance covers when no names are given.
+func revertFields[F any](current, extracted F, names, covered []string) (F, error) {
+	if len(names) == 0 {
+		names = covered
+	}
+	values := fieldValues(extracted)
+	for _, name := range names {
+		if !slices.Contains(covered, name) {
+			return current, fmt.Errorf("%w: %s", ErrUnknownField, name)
+		}
+		var err error
+		if current, err = withField(current, name, values[name]); err != nil {
+			return current, err
+		}
+	}
+	return current, nil
+}
+
+// RevertedItem is the item changed by RevertToExtracted; exactly one field is set.
+type RevertedItem struct {
+	Experience *domain.ProfileExperience
+	Education  *domain.ProfileEducation
+	Skill      *domain.ProfileSkill
+}
+
+// RevertToExtracted sets fields of an experience, education entry or skill back to the
+// values taken from its source document, every field when none are named, and logs the
+// edit like a manual one. It returns nil if no item has the ID.
+func (s *HistoryService) RevertToExtracted(ctx context.Context, entityID uuid.UUID, fields []string) (*RevertedItem, error) {
+	exp, err := s.profileExpRepo.GetByID(ctx, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experience: %w", err)
+	}
+	if exp != nil {
+		return &RevertedItem{Experience: exp}, s.revertExperience(ctx, exp, fields)
+	}
+
+	edu, err := s.profileEduRepo.GetByID(ctx, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get education: %w", err)
+	}
+	if edu != nil {
+		return &RevertedItem{Education: edu}, s.revertEducation(ctx, edu, fields)
+	}
+
+	skill, err := s.profileSkillRepo.GetByID(ctx, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skill: %w", err)
+	}
+	if skill != nil {
+		return &RevertedItem{Skill: skill}, s.revertSkill(ctx, skill, fields)
+	}
+	return nil, nil
+}
+
+func (s *HistoryService) revertExperience(ctx context.Context, exp *domain.ProfileExperience, names []string) error {
+	extracted, ok, err := extractedExperience(exp)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNoExtractedValues
+	}
+	before := *exp
+	fields, err := revertFields(experienceFieldsOf(exp), extracted, names, experienceProvenanceFields)
+	if err != nil {
+		return err
+	}
+	fields.applyTo(exp)
+	if err := s.prepareExperience(ctx, exp, &before); err != nil {
+		return err
+	}
+	if err := s.profileExpRepo.Update(ctx, exp); err != nil {
+		return fmt.Errorf("failed to update experience: %w", err)
+	}
+	return s.RecordEdits(ctx, exp.ProfileID, ExperienceChanges(&before, exp))
+}
+
+func (s *HistoryService) revertEducation(ctx context.Context, edu *domain.ProfileEducation, names []string) error {
+	extracted, ok, err := extractedEducation(edu)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNoExtractedValues
+	}
+	before := *edu
+	fields, err := revertFields(educationFieldsOf(edu), extracted, names, educationProvenanceFields)
+	if err != nil {
+		return err
+	}
+	fields.applyTo(edu)
+	if err := SetEducationDates(edu); err != nil {
+		return err
+	}
+	if err := s.profileEduRepo.Update(ctx, edu); err != nil {
+		return fmt.Errorf("failed to update education: %w", err)
+	}
+	return s.RecordEdits(ctx, edu.ProfileID, EducationChanges(&before, edu))
+}
+
+func (s *HistoryService) revertSkill(ctx context.Context, skill *domain.ProfileSkill, names []string) error {
+	extracted, ok, err := extractedSkill(skill)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNoExtractedValues
+	}
+	before := *skill
+	fields, err := revertFields(skillFieldsOf(skill), extracted, names, skillProvenanceFields)
+	if err != nil {
+		return err
+	}
+	fields.applyTo(skill)
+	if skill.Name != before.Name {
+		if err := s.linkSkill(ctx, skill); err != nil {
+			return err
+		}
+	}
+	if err := s.profileSkillRepo.Update(ctx, skill); err != nil {
+		return fmt.Errorf("failed to update skill: %w", err)
+	}
+	return s.RecordEdits(ctx, skill.ProfileID, SkillChanges(&before, skill))
+}
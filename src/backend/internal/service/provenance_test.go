@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+func provenanceByField(provenance []FieldProvenance) map[string]FieldProvenance {
+	byField := make(map[string]FieldProvenance, len(provenance))
+	for _, p := range provenance {
+		byField[p.Field] = p
+	}
+	return byField
+}
+
+func TestExperienceProvenanceAndRevert(t *testing.T) {
+	profileRepo := newMockProfileRepository()
+	expRepo := newMockProfileExperienceRepository()
+	skillRepo := newMockProfileSkillRepository()
+	companyRepo := newMockCompanyRepository()
+	changeRepo := newMockProfileChangeRepository()
+	svc := NewMaterializationService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), skillRepo, newMockAuthorRepository(), newMockTestimonialRepository(), newMockSkillValidationRepository(), newMockExpValidationRepository(), newMockEduValidationRepository(), newMockCompanyAliasRepository(), companyRepo, newMockSkillRepository(), newMockProfileInconsistencyRepository(), changeRepo, newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository(), nil)
+	history := NewHistoryService(nil, profileRepo, expRepo, newMockProfileEducationRepository(), skillRepo, newMockSkillRepository(), newMockCompanyAliasRepository(), companyRepo, changeRepo, newMockProfileLinkRepository(), newMockProfileCertificationRepository(), newMockProfileLanguageRepository(), newMockProfileProjectRepository(), newMockProfilePublicationRepository(), newMockProfileAwardRepository())
+	ctx := context.Background()
+	resumeID := uuid.New()
+
+	if _, err := svc.MaterializeResumeData(ctx, resumeID, uuid.New(), testExtractedData()); err != nil {
+		t.Fatalf("MaterializeResumeData failed: %v", err)
+	}
+	var exp *domain.ProfileExperience
+	for _, e := range expRepo.experiences {
+		exp = e
+	}
+	exp.Title = "Staff Engineer"
+	exp.Highlights = []string{"Led the platform team"}
+
+	provenance, err := ExperienceProvenance(exp)
+	if err != nil {
+		t.Fatalf("ExperienceProvenance failed: %v", err)
+	}
+	byField := provenanceByField(provenance)
+	if len(byField) != len(experienceProvenanceFields) {
+		t.Fatalf("expected %d fields, got %d", len(experienceProvenanceFields), len(byField))
+	}
+	company := byField["company"]
+	if company.Origin != domain.ExperienceSourceResumeExtracted || string(company.ExtractedValue) != `"Acme Corp"` || company.SourceResumeID == nil || *company.SourceResumeID != resumeID {
+		t.Errorf("expected the company to be extracted from the resume, got %+v", company)
+	}
+	title := byField["title"]
+	if title.Origin != domain.ExperienceSourceManual || string(title.ExtractedValue) != `"Software Engineer"` {
+		t.Errorf("expected an edited title, got %+v", title)
+	}
+	if byField["highlights"].Origin != domain.ExperienceSourceManual || byField["location"].Origin != domain.ExperienceSourceResumeExtracted {
+		t.Errorf("expected added highlights and the extracted location, got %+v and %+v", byField["highlights"], byField["location"])
+	}
+
+	if _, err := history.RevertToExtracted(ctx, exp.ID, []string{"source"}); !errors.Is(err, ErrUnknownField) {
+		t.Errorf("expected ErrUnknownField, got %v", err)
+	}
+	item, err := history.RevertToExtracted(ctx, exp.ID, []string{"title"})
+	if err != nil {
+		t.Fatalf("RevertToExtracted failed: %v", err)
+	}
+	if item.Experience == nil || item.Experience.Title != "Software Engineer" || len(item.Experience.Highlights) != 1 {
+		t.Fatalf("expected only the title to be reverted, got %+v", item.Experience)
+	}
+	logged := changeRepo.changes[len(changeRepo.changes)-1]
+	if logged.Source != domain.ProfileChangeSourceManual || logged.Field == nil || *logged.Field != "title" {
+		t.Errorf("expected the revert to be logged as a manual title change, got %+v", logged)
+	}
+
+	if _, err := history.RevertToExtracted(ctx, exp.ID, nil); err != nil {
+		t.Fatalf("RevertToExtracted failed: %v", err)
+	}
+	provenance, _ = ExperienceProvenance(exp)
+	for _, p := range provenance {
+		if p.Origin != domain.ExperienceSourceResumeExtracted {
+			t.Errorf("expected %s to be extracted after reverting every field, got %s", p.Field, p.Origin)
+		}
+	}
+
+	if item, err := history.RevertToExtracted(ctx, uuid.New(), nil); err != nil || item != nil {
+		t.Errorf("expected nil for an unknown item, got %+v, %v", item, err)
+	}
+}
+
+func TestSkillProvenance(t *testing.T) {
+	letterID := uuid.New()
+	discovered, _ := json.Marshal(domain.DiscoveredSkill{Skill: "Mentoring", Category: domain.SkillCategorySoft})
+	skill := &domain.ProfileSkill{
+		ID:                      uuid.New(),
+		Name:                    "Mentorship",
+		Category:                "SOFT",
+		Source:                  domain.ExperienceSourceLetterDiscovered,
+		SourceReferenceLetterID: &letterID,
+		OriginalData:            discovered,
+	}
+	byField := provenanceByField(mustSkillProvenance(t, skill))
+	if byField["name"].Origin != domain.ExperienceSourceManual || string(byField["name"].ExtractedValue) != `"Mentoring"` {
+		t.Errorf("expected an edited name, got %+v", byField["name"])
+	}
+	if byField["category"].Origin != domain.ExperienceSourceLetterDiscovered || *byField["category"].SourceReferenceLetterID != letterID {
+		t.Errorf("expected the category to come from the letter, got %+v", byField["category"])
+	}
+
+	// Without original data nothing tells edits apart, and manual skills are manual throughout
+	skill.OriginalData = nil
+	if p := mustSkillProvenance(t, skill)[0]; p.Origin != domain.ExperienceSourceLetterDiscovered || p.ExtractedValue != nil {
+		t.Errorf("expected the name to be attributed to the letter, got %+v", p)
+	}
+	manual := &domain.ProfileSkill{ID: uuid.New(), Name: "Go", Category: "TECHNICAL", Source: domain.ExperienceSourceManual}
+	if p := mustSkillProvenance(t, manual)[0]; p.Origin != domain.ExperienceSourceManual || p.SourceResumeID != nil {
+		t.Errorf("expected a manual name, got %+v", p)
+	}
+}
+
+func mustSkillProvenance(t *testing.T, skill *domain.ProfileSkill) []FieldProvenance {
+	t.Helper()
+	provenance, err := SkillProvenance(skill)
+	if err != nil {
+		t.Fatalf("SkillProvenance failed: %v", err)
+	}
+	return provenance
+}
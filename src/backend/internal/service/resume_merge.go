@@ -0,0 +1,656 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+	"backend/internal/repository/postgres"
+	"backend/internal/taxonomy"
+)
+
+// ErrUnknownMergeChange is returned when accepting a change that the resume merge does not
+// propose, for example because the profile changed since the merge was previewed.
+var ErrUnknownMergeChange = errors.New("unknown resume merge change")
+
+// MergeAction is what a resume merge change does to the profile.
+type MergeAction string
+
+// Merge action constants.
+const (
+	MergeActionAdd    MergeAction = "add"
+	MergeActionUpdate MergeAction = "update"
+	MergeActionRemove MergeAction = "remove"
+)
+
+// The fields a resume merge compares, named like in the change log. They are the fields a
+// resume entry sets.
+var (
+	experienceMergeFields = []string{"company", "title", "location", "startDate", "endDate", "isCurrent", "description", "highlights"}
+	educationMergeFields  = []string{"institution", "degree", "field", "startDate", "endDate", "description", "gpa"}
+)
+
+// MergeFieldChange is a field whose value on the resume differs from the profile's. Both
+// values are encoded as JSON. ManuallyEdited is set when the user changed the profile's
+// value after it was imported, or entered the item by hand.
+type MergeFieldChange struct {
+	Field          string
+	CurrentValue   json.RawMessage
+	ExtractedValue json.RawMessage
+	ManuallyEdited bool
+}
+
+// ResumeMergeChange is one change a resume merge proposes. An add creates the resume entry
+// at ExtractedIndex in its list; an update sets the Fields of the matching profile item
+// EntityID to the resume's values; a remove deletes a profile item imported from a resume
+// that the new resume no longer lists. ID identifies the change when accepting it.
+type ResumeMergeChange struct {
+	ID             string
+	Action         MergeAction
+	EntityType     domain.ProfileChangeEntity
+	EntityID       *uuid.UUID
+	ExtractedIndex *int
+	Label          string
+	Fields         []MergeFieldChange
+
+	experience          *domain.ProfileExperience
+	extractedExperience *domain.ProfileExperience
+	education           *domain.ProfileEducation
+	extractedEducation  *domain.ProfileEducation
+	extractedSkill      string
+}
+
+// ResumeMergeDiff is the difference between a resume's extracted data and a profile, as the
+// changes that would bring the profile in line with the resume. Unchanged counts the
+// profile items the resume lists as they are.
+type ResumeMergeDiff struct {
+	ResumeID  uuid.UUID
+	ProfileID uuid.UUID
+	Changes   []*ResumeMergeChange
+	Unchanged int
+}
+
+func mergeChangeID(entityType domain.ProfileChangeEntity, action MergeAction, key string) string {
+	return fmt.Sprintf("%s:%s:%s", entityType, action, key)
+}
+
+// mergeFieldChanges lists the fields whose value on the resume differs from the current
+// one. Fields the resume leaves empty are not proposed, so that a sparser resume does not
+// clear values the profile already has.
+func mergeFieldChanges(names []string, current, extracted any, provenance []FieldProvenance) []MergeFieldChange {
+	currentValues, extractedValues := fieldValues(current), fieldValues(extracted)
+	edited := make(map[string]bool, len(provenance))
+	for _, p := range provenance {
+		edited[p.Field] = p.Origin == domain.ExperienceSourceManual
+	}
+
+	var changes []MergeFieldChange
+	for _, name := range names {
+		value := extractedValues[name]
+		if isEmptyValue(value) || bytes.Equal(value, currentValues[name]) {
+			continue
+		}
+		changes = append(changes, MergeFieldChange{
+			Field:          name,
+			CurrentValue:   currentValues[name],
+			ExtractedValue: value,
+			ManuallyEdited: edited[name],
+		})
+	}
+	return changes
+}
+
+func isEmptyValue(value json.RawMessage) bool {
+	s := string(bytes.TrimSpace(value))
+	return s == "" || s == "null" || s == `""` || s == "[]"
+}
+
+// matchExperience returns the unmatched profile experience that is the same role as an
+// extracted one: at the same company, with start dates that can be the same month, and
+// with an agreeing title or overlapping dates. A role whose title agrees is preferred,
+// then the one overlapping most.
+func matchExperience(matcher *normalize.CompanyMatcher, candidates []*domain.ProfileExperience, matched map[uuid.UUID]bool, extracted *domain.ProfileExperience, now time.Time) *domain.ProfileExperience {
+	var best *domain.ProfileExperience
+	bestTitle, bestOverlap := false, 0
+	for _, exp := range candidates {
+		if matched[exp.ID] || !matcher.Match(exp.Company, extracted.Company) || datesDisagree(exp.Start, extracted.Start) {
+			continue
+		}
+		titleAgrees := normalize.TitlesAgree(exp.Title, extracted.Title)
+		overlap := overlapMonths(exp.Dates(), extracted.Dates(), now)
+		if !titleAgrees && overlap == 0 {
+			continue
+		}
+		if best != nil && (bestTitle && !titleAgrees || bestTitle == titleAgrees && overlap <= bestOverlap) {
+			continue
+		}
+		best, bestTitle, bestOverlap = exp, titleAgrees, overlap
+	}
+	return best
+}
+
+// matchEducation returns the unmatched profile education entry at the same institution as
+// an extracted one. Entries whose degree and end date both disagree are different studies
+// at the institution; an agreeing degree is preferred, then an agreeing end date.
+func matchEducation(candidates []*domain.ProfileEducation, matched map[uuid.UUID]bool, extracted *domain.ProfileEducation) *domain.ProfileEducation {
+	var best *domain.ProfileEducation
+	bestScore := 0
+	for _, edu := range candidates {
+		if matched[edu.ID] || !normalize.InstitutionsMatch(edu.Institution, extracted.Institution) {
+			continue
+		}
+		score := 0
+		if degreesAgree(edu.Degree, extracted.Degree) {
+			score += 2
+		}
+		if !datesDisagree(edu.End, extracted.End) {
+			score++
+		}
+		if score > bestScore {
+			best, bestScore = edu, score
+		}
+	}
+	return best
+}
+
+// degreesAgree compares degrees like job titles. The "Degree" placeholder stored for a
+// resume entry without a degree agrees with any degree.
+func degreesAgree(a, b string) bool {
+	if a == "Degree" || b == "Degree" {
+		return true
+	}
+	return normalize.FoldText(a) == normalize.FoldText(b) || normalize.TitlesAgree(a, b)
+}
+
+// PreviewResumeMerge computes how merging a resume's extracted data into the user's profile
+// would change it, without changing anything. A user without a profile gets a diff that
+// adds every entry.
+func (s *MaterializationService) PreviewResumeMerge(
+	ctx context.Context,
+	resumeID uuid.UUID,
+	userID uuid.UUID,
+	data *domain.ResumeExtractedData,
+) (*ResumeMergeDiff, error) {
+	profile, err := s.profileRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	profileID := uuid.Nil
+	if profile != nil {
+		profileID = profile.ID
+	}
+	return s.diffResumeMerge(ctx, resumeID, profileID, data)
+}
+
+// diffResumeMerge matches the resume's experiences by company, title and dates, its
+// education by institution, degree and dates and its skills through the skill taxonomy to
+// the profile's items. Unmatched resume entries are proposed as additions, matched ones
+// whose fields differ as updates, and profile items imported from this resume that it no
+// longer lists, for example after it was extracted again, as removals. Items imported from
+// other resumes, manually entered and letter-discovered items are never proposed for removal.
+func (s *MaterializationService) diffResumeMerge(ctx context.Context, resumeID, profileID uuid.UUID, data *domain.ResumeExtractedData) (*ResumeMergeDiff, error) {
+	current, err := loadProfileItems(ctx, s.profileExpRepo, s.profileEduRepo, s.profileSkillRepo, profileID)
+	if err != nil {
+		return nil, err
+	}
+	matcher, err := s.companyMatcher(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	tax, err := s.skillTaxonomy(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &ResumeMergeDiff{ResumeID: resumeID, ProfileID: profileID}
+	now := time.Now()
+
+	matchedExps := make(map[uuid.UUID]bool)
+	for i, entry := range data.Experience {
+		extracted, buildErr := newResumeExperience(resumeID, profileID, entry)
+		if buildErr != nil {
+			return nil, buildErr
+		}
+		label := extracted.Title + " at " + extracted.Company
+		exp := matchExperience(matcher, current.Experiences, matchedExps, extracted, now)
+		if exp == nil {
+			diff.Changes = append(diff.Changes, &ResumeMergeChange{
+				ID:                  mergeChangeID(domain.ProfileChangeEntityExperience, MergeActionAdd, strconv.Itoa(i)),
+				Action:              MergeActionAdd,
+				EntityType:          domain.ProfileChangeEntityExperience,
+				ExtractedIndex:      &i,
+				Label:               label,
+				extractedExperience: extracted,
+			})
+			continue
+		}
+		matchedExps[exp.ID] = true
+		provenance, provErr := ExperienceProvenance(exp)
+		if provErr != nil {
+			return nil, provErr
+		}
+		fields := mergeFieldChanges(experienceMergeFields, experienceFieldsOf(exp), experienceFieldsOf(extracted), provenance)
+		if len(fields) == 0 {
+			diff.Unchanged++
+			continue
+		}
+		diff.Changes = append(diff.Changes, &ResumeMergeChange{
+			ID:                  mergeChangeID(domain.ProfileChangeEntityExperience, MergeActionUpdate, exp.ID.String()),
+			Action:              MergeActionUpdate,
+			EntityType:          domain.ProfileChangeEntityExperience,
+			EntityID:            &exp.ID,
+			ExtractedIndex:      &i,
+			Label:               label,
+			Fields:              fields,
+			experience:          exp,
+			extractedExperience: extracted,
+		})
+	}
+	for _, exp := range current.Experiences {
+		if !matchedExps[exp.ID] && importedFrom(exp.Source, exp.SourceResumeID, resumeID) {
+			diff.Changes = append(diff.Changes, &ResumeMergeChange{
+				ID:         mergeChangeID(domain.ProfileChangeEntityExperience, MergeActionRemove, exp.ID.String()),
+				Action:     MergeActionRemove,
+				EntityType: domain.ProfileChangeEntityExperience,
+				EntityID:   &exp.ID,
+				Label:      exp.Title + " at " + exp.Company,
+				experience: exp,
+			})
+		}
+	}
+
+	matchedEdus := make(map[uuid.UUID]bool)
+	for i, entry := range data.Education {
+		extracted, buildErr := newResumeEducation(resumeID, profileID, entry)
+		if buildErr != nil {
+			return nil, buildErr
+		}
+		label := extracted.Degree + ", " + extracted.Institution
+		edu := matchEducation(current.Educations, matchedEdus, extracted)
+		if edu == nil {
+			diff.Changes = append(diff.Changes, &ResumeMergeChange{
+				ID:                 mergeChangeID(domain.ProfileChangeEntityEducation, MergeActionAdd, strconv.Itoa(i)),
+				Action:             MergeActionAdd,
+				EntityType:         domain.ProfileChangeEntityEducation,
+				ExtractedIndex:     &i,
+				Label:              label,
+				extractedEducation: extracted,
+			})
+			continue
+		}
+		matchedEdus[edu.ID] = true
+		provenance, provErr := EducationProvenance(edu)
+		if provErr != nil {
+			return nil, provErr
+		}
+		extractedFields := educationFieldsOf(extracted)
+		if entry.Degree == nil || *entry.Degree == "" {
+			// The placeholder degree never replaces a known one
+			extractedFields.Degree = ""
+		}
+		fields := mergeFieldChanges(educationMergeFields, educationFieldsOf(edu), extractedFields, provenance)
+		if len(fields) == 0 {
+			diff.Unchanged++
+			continue
+		}
+		diff.Changes = append(diff.Changes, &ResumeMergeChange{
+			ID:                 mergeChangeID(domain.ProfileChangeEntityEducation, MergeActionUpdate, edu.ID.String()),
+			Action:             MergeActionUpdate,
+			EntityType:         domain.ProfileChangeEntityEducation,
+			EntityID:           &edu.ID,
+			ExtractedIndex:     &i,
+			Label:              label,
+			Fields:             fields,
+			education:          edu,
+			extractedEducation: extracted,
+		})
+	}
+	for _, edu := range current.Educations {
+		if !matchedEdus[edu.ID] && importedFrom(edu.Source, edu.SourceResumeID, resumeID) {
+			diff.Changes = append(diff.Changes, &ResumeMergeChange{
+				ID:         mergeChangeID(domain.ProfileChangeEntityEducation, MergeActionRemove, edu.ID.String()),
+				Action:     MergeActionRemove,
+				EntityType: domain.ProfileChangeEntityEducation,
+				EntityID:   &edu.ID,
+				Label:      edu.Degree + ", " + edu.Institution,
+				education:  edu,
+			})
+		}
+	}
+
+	// Skills have no fields a resume updates, so they are only added or removed.
+	matchedSkills := make(map[uuid.UUID]bool)
+	var added []*domain.ProfileSkill
+	for i, name := range data.Skills {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if sk := tax.FindProfileSkill(current.Skills, name); sk != nil {
+			if !matchedSkills[sk.ID] {
+				matchedSkills[sk.ID] = true
+				diff.Unchanged++
+			}
+			continue
+		}
+		if tax.FindProfileSkill(added, name) != nil {
+			continue
+		}
+		added = append(added, &domain.ProfileSkill{Name: name})
+		diff.Changes = append(diff.Changes, &ResumeMergeChange{
+			ID:             mergeChangeID(domain.ProfileChangeEntitySkill, MergeActionAdd, strconv.Itoa(i)),
+			Action:         MergeActionAdd,
+			EntityType:     domain.ProfileChangeEntitySkill,
+			ExtractedIndex: &i,
+			Label:          name,
+			extractedSkill: name,
+		})
+	}
+	for _, sk := range current.Skills {
+		if !matchedSkills[sk.ID] && importedFrom(sk.Source, sk.SourceResumeID, resumeID) {
+			diff.Changes = append(diff.Changes, &ResumeMergeChange{
+				ID:         mergeChangeID(domain.ProfileChangeEntitySkill, MergeActionRemove, sk.ID.String()),
+				Action:     MergeActionRemove,
+				EntityType: domain.ProfileChangeEntitySkill,
+				EntityID:   &sk.ID,
+				Label:      sk.Name,
+			})
+		}
+	}
+
+	return diff, nil
+}
+
+// importedFrom reports whether an item was imported from the given resume.
+func importedFrom(source domain.ExperienceSource, sourceResumeID *uuid.UUID, resumeID uuid.UUID) bool {
+	return source == domain.ExperienceSourceResumeExtracted && sourceResumeID != nil && *sourceResumeID == resumeID
+}
+
+// MergeResumeData merges a resume's extracted data into the user's profile by applying the
+// accepted changes of its merge diff, or only its additions when accepted is nil; the other
+// changes are rejected. Updates and removals, which can overwrite or delete what the user
+// entered, are only applied when accepted by ID. Unlike MaterializeResumeData it leaves the items the resume does
+// not change alone, so manual edits survive a re-import. The diff is recomputed, so an
+// accepted ID it no longer contains fails with ErrUnknownMergeChange. Updated items
+// imported from a resume are attributed to this resume from then on. Links, certifications,
+// languages, projects, publications and awards the profile does not have yet are added.
+// The changes are recorded in the profile's change log as an import by the user.
+func (s *MaterializationService) MergeResumeData(
+	ctx context.Context,
+	resumeID uuid.UUID,
+	userID uuid.UUID,
+	data *domain.ResumeExtractedData,
+	accepted []string,
+) (*MaterializationResult, error) {
+	profile, err := s.profileRepo.GetOrCreateByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get or create profile: %w", err)
+	}
+
+	if err := s.populateProfileHeader(ctx, profile, data); err != nil {
+		return nil, err
+	}
+
+	diff, err := s.diffResumeMerge(ctx, resumeID, profile.ID, data)
+	if err != nil {
+		return nil, err
+	}
+	var changes []*ResumeMergeChange
+	if accepted == nil {
+		for _, change := range diff.Changes {
+			if change.Action == MergeActionAdd {
+				changes = append(changes, change)
+			}
+		}
+	} else {
+		byID := make(map[string]*ResumeMergeChange, len(diff.Changes))
+		for _, change := range diff.Changes {
+			byID[change.ID] = change
+		}
+		changes = make([]*ResumeMergeChange, 0, len(accepted))
+		for _, id := range accepted {
+			change, ok := byID[id]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownMergeChange, id)
+			}
+			changes = append(changes, change)
+		}
+	}
+
+	before, err := loadProfileItems(ctx, s.profileExpRepo, s.profileEduRepo, s.profileSkillRepo, profile.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MaterializationResult{}
+
+	// If db is nil (testing with mocks), fall back to non-transactional behavior
+	if s.db == nil {
+		if err := s.applyResumeMergeWithRepos(ctx, s.profileExpRepo, s.profileEduRepo, s.profileSkillRepo, s.companyRepo, resumeID, profile.ID, changes, result); err != nil {
+			return result, err
+		}
+		if err := materializeSectionsWithRepos(ctx, s.sections, resumeID, profile.ID, data, result); err != nil {
+			return result, err
+		}
+		if err := s.recordImport(ctx, s.changeRepo, s.profileExpRepo, s.profileEduRepo, s.profileSkillRepo, userID, profile.ID, before); err != nil {
+			return result, err
+		}
+		return result, s.RecomputeSkillStats(ctx, profile.ID)
+	}
+
+	txErr := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		txExpRepo := postgres.NewProfileExperienceRepository(tx)
+		txEduRepo := postgres.NewProfileEducationRepository(tx)
+		txSkillRepo := postgres.NewProfileSkillRepository(tx)
+
+		if err := s.applyResumeMergeWithRepos(ctx, txExpRepo, txEduRepo, txSkillRepo, postgres.NewCompanyRepository(tx), resumeID, profile.ID, changes, result); err != nil {
+			return err
+		}
+		if err := materializeSectionsWithRepos(ctx, newTxSectionRepos(tx), resumeID, profile.ID, data, result); err != nil {
+			return err
+		}
+		return s.recordImport(ctx, postgres.NewProfileChangeRepository(tx), txExpRepo, txEduRepo, txSkillRepo, userID, profile.ID, before)
+	})
+	if txErr != nil {
+		return result, txErr
+	}
+
+	return result, s.RecomputeSkillStats(ctx, profile.ID)
+}
+
+// applyResumeMergeWithRepos applies merge changes, counting the experiences, education
+// entries and skills added or updated and the items removed. New entries follow the
+// profile's current ones, most recent first.
+func (s *MaterializationService) applyResumeMergeWithRepos(
+	ctx context.Context,
+	expRepo domain.ProfileExperienceRepository,
+	eduRepo domain.ProfileEducationRepository,
+	skillRepo domain.ProfileSkillRepository,
+	companyRepo domain.CompanyRepository,
+	resumeID uuid.UUID,
+	profileID uuid.UUID,
+	changes []*ResumeMergeChange,
+	result *MaterializationResult,
+) error {
+	matcher, err := s.companyMatcher(ctx, profileID)
+	if err != nil {
+		return err
+	}
+	tax, err := s.skillTaxonomy(ctx, profileID)
+	if err != nil {
+		return err
+	}
+
+	var newExps []*domain.ProfileExperience
+	var newEdus []*domain.ProfileEducation
+	var newSkills []string
+	for _, change := range changes {
+		switch change.Action {
+		case MergeActionAdd:
+			switch change.EntityType {
+			case domain.ProfileChangeEntityExperience:
+				newExps = append(newExps, change.extractedExperience)
+			case domain.ProfileChangeEntityEducation:
+				newEdus = append(newEdus, change.extractedEducation)
+			case domain.ProfileChangeEntitySkill:
+				newSkills = append(newSkills, change.extractedSkill)
+			}
+
+		case MergeActionUpdate:
+			switch change.EntityType {
+			case domain.ProfileChangeEntityExperience:
+				if err := s.updateMergedExperience(ctx, expRepo, companyRepo, matcher, resumeID, change); err != nil {
+					return err
+				}
+				result.Experiences++
+			case domain.ProfileChangeEntityEducation:
+				if err := updateMergedEducation(ctx, eduRepo, resumeID, change); err != nil {
+					return err
+				}
+				result.Educations++
+			}
+
+		case MergeActionRemove:
+			var delErr error
+			switch change.EntityType {
+			case domain.ProfileChangeEntityExperience:
+				delErr = expRepo.Delete(ctx, *change.EntityID)
+			case domain.ProfileChangeEntityEducation:
+				delErr = eduRepo.Delete(ctx, *change.EntityID)
+			case domain.ProfileChangeEntitySkill:
+				delErr = skillRepo.Delete(ctx, *change.EntityID)
+			}
+			if delErr != nil {
+				return fmt.Errorf("failed to remove %s %q: %w", change.EntityType, change.Label, delErr)
+			}
+			result.Removed++
+		}
+	}
+
+	if len(newExps) > 0 {
+		if err := PlaceExperiences(ctx, expRepo, newExps); err != nil {
+			return err
+		}
+		for _, exp := range newExps {
+			if exp.CompanyID, err = LinkCompany(ctx, companyRepo, matcher, profileID, exp.Company); err != nil {
+				return err
+			}
+			if err := expRepo.Create(ctx, exp); err != nil {
+				return fmt.Errorf("failed to create experience for %s at %s: %w", exp.Title, exp.Company, err)
+			}
+			result.Experiences++
+		}
+	}
+
+	if len(newEdus) > 0 {
+		if err := PlaceEducations(ctx, eduRepo, newEdus); err != nil {
+			return err
+		}
+		for _, edu := range newEdus {
+			if err := eduRepo.Create(ctx, edu); err != nil {
+				return fmt.Errorf("failed to create education for %s: %w", edu.Institution, err)
+			}
+			result.Educations++
+		}
+	}
+
+	if len(newSkills) > 0 {
+		count, err := addMergedSkills(ctx, skillRepo, tax, resumeID, profileID, newSkills)
+		result.Skills += count
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateMergedExperience sets the changed fields of an experience to the resume's values.
+func (s *MaterializationService) updateMergedExperience(
+	ctx context.Context,
+	repo domain.ProfileExperienceRepository,
+	companyRepo domain.CompanyRepository,
+	matcher *normalize.CompanyMatcher,
+	resumeID uuid.UUID,
+	change *ResumeMergeChange,
+) error {
+	exp := change.experience
+	company := exp.Company
+	fields, err := withMergedFields(experienceFieldsOf(exp), change.Fields)
+	if err != nil {
+		return err
+	}
+	fields.applyTo(exp)
+	if exp.Source == domain.ExperienceSourceResumeExtracted {
+		exp.SourceResumeID = &resumeID
+		exp.OriginalData = change.extractedExperience.OriginalData
+	}
+	_ = SetExperienceDates(exp)
+	if exp.Company != company {
+		if exp.CompanyID, err = LinkCompany(ctx, companyRepo, matcher, exp.ProfileID, exp.Company); err != nil {
+			return err
+		}
+	}
+	if err := repo.Update(ctx, exp); err != nil {
+		return fmt.Errorf("failed to update experience %s at %s: %w", exp.Title, exp.Company, err)
+	}
+	return nil
+}
+
+// updateMergedEducation sets the changed fields of an education entry to the resume's values.
+func updateMergedEducation(ctx context.Context, repo domain.ProfileEducationRepository, resumeID uuid.UUID, change *ResumeMergeChange) error {
+	edu := change.education
+	fields, err := withMergedFields(educationFieldsOf(edu), change.Fields)
+	if err != nil {
+		return err
+	}
+	fields.applyTo(edu)
+	if edu.Source == domain.ExperienceSourceResumeExtracted {
+		edu.SourceResumeID = &resumeID
+		edu.OriginalData = change.extractedEducation.OriginalData
+	}
+	_ = SetEducationDates(edu)
+	if err := repo.Update(ctx, edu); err != nil {
+		return fmt.Errorf("failed to update education for %s: %w", edu.Institution, err)
+	}
+	return nil
+}
+
+// withMergedFields returns the fields with the changed ones set to the resume's values.
+func withMergedFields[F any](fields F, changes []MergeFieldChange) (F, error) {
+	var err error
+	for _, change := range changes {
+		if fields, err = withField(fields, change.Field, change.ExtractedValue); err != nil {
+			return fields, err
+		}
+	}
+	return fields, nil
+}
+
+// addMergedSkills creates the skills a merge adds after the profile's current ones.
+func addMergedSkills(ctx context.Context, repo domain.ProfileSkillRepository, tax *taxonomy.Taxonomy, resumeID, profileID uuid.UUID, names []string) (int, error) {
+	displayOrder, err := repo.GetNextDisplayOrder(ctx, profileID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get next skill display order: %w", err)
+	}
+	for i, name := range names {
+		skill, buildErr := newResumeSkill(tax, resumeID, profileID, name)
+		if buildErr != nil {
+			return i, buildErr
+		}
+		skill.DisplayOrder = displayOrder + i
+		if createErr := repo.CreateIgnoreDuplicate(ctx, skill); createErr != nil {
+			return i, fmt.Errorf("failed to create skill %q: %w", name, createErr)
+		}
+	}
+	return len(names), nil
+}
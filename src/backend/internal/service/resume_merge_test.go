@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+func mergeChangesByLabel(diff *ResumeMergeDiff) map[string]*ResumeMergeChange {
+	byLabel := make(map[string]*ResumeMergeChange, len(diff.Changes))
+	for _, change := range diff.Changes {
+		byLabel[string(change.Action)+" "+change.Label] = change
+	}
+	return byLabel
+}
+
+func TestResumeMergePreviewAndApply(t *testing.T) {
+	svc, profileRepo, expRepo, eduRepo, skillRepo := newTestService()
+	ctx := context.Background()
+	userID := uuid.New()
+	firstResumeID := uuid.New()
+
+	if _, err := svc.MaterializeResumeData(ctx, firstResumeID, userID, testExtractedData()); err != nil {
+		t.Fatalf("MaterializeResumeData failed: %v", err)
+	}
+	profile, _ := profileRepo.GetByUserID(ctx, userID)
+	var acme *domain.ProfileExperience
+	for _, exp := range expRepo.experiences {
+		acme = exp
+	}
+	acme.Description = stringPtr("Built the billing platform")
+	manual := &domain.ProfileExperience{ID: uuid.New(), ProfileID: profile.ID, Company: "Side Project", Title: "Founder", Source: domain.ExperienceSourceManual}
+	expRepo.experiences[manual.ID] = manual
+
+	secondResumeID := uuid.New()
+	data := &domain.ResumeExtractedData{
+		Experience: []domain.WorkExperience{
+			{Company: "Acme Corporation", Title: "Sr. Software Engineer", StartDate: stringPtr("2020-01"), IsCurrent: true, Description: stringPtr("Built and ran things")},
+			{Company: "Globex", Title: "Staff Engineer", StartDate: stringPtr("2023-07")},
+		},
+		Education: []domain.Education{
+			{Institution: "MIT", Degree: stringPtr("Bachelor of Science"), EndDate: stringPtr("2020-05")},
+		},
+		Skills: []string{"go", "Kubernetes", "k8s"},
+	}
+
+	diff, err := svc.PreviewResumeMerge(ctx, secondResumeID, userID, data)
+	if err != nil {
+		t.Fatalf("PreviewResumeMerge failed: %v", err)
+	}
+	if diff.Unchanged != 2 {
+		t.Errorf("expected the education and Go to be unchanged, got %d", diff.Unchanged)
+	}
+	byLabel := mergeChangesByLabel(diff)
+	if len(byLabel) != 3 {
+		t.Fatalf("expected 3 changes, got %+v", byLabel)
+	}
+
+	update := byLabel["update Sr. Software Engineer at Acme Corporation"]
+	if update == nil || update.EntityID == nil || *update.EntityID != acme.ID {
+		t.Fatalf("expected an update of the Acme experience, got %+v", update)
+	}
+	fields := make(map[string]MergeFieldChange)
+	for _, f := range update.Fields {
+		fields[f.Field] = f
+	}
+	if len(fields) != 4 || fields["endDate"].Field != "" {
+		t.Errorf("expected company, title, isCurrent and description to change but not the omitted end date, got %+v", update.Fields)
+	}
+	if !fields["description"].ManuallyEdited || fields["title"].ManuallyEdited {
+		t.Errorf("expected only the description to be flagged as edited, got %+v", update.Fields)
+	}
+	for _, label := range []string{"add Staff Engineer at Globex", "add Kubernetes"} {
+		if byLabel[label] == nil {
+			t.Errorf("expected change %q", label)
+		}
+	}
+	// PostgreSQL and GraphQL came from the first resume, which this one does not replace
+
+	accepted := []string{update.ID, byLabel["add Kubernetes"].ID}
+	if _, err := svc.MergeResumeData(ctx, secondResumeID, userID, data, append(accepted, "experience:add:7")); !errors.Is(err, ErrUnknownMergeChange) {
+		t.Fatalf("expected ErrUnknownMergeChange, got %v", err)
+	}
+	result, err := svc.MergeResumeData(ctx, secondResumeID, userID, data, accepted)
+	if err != nil {
+		t.Fatalf("MergeResumeData failed: %v", err)
+	}
+	if result.Experiences != 1 || result.Skills != 1 || result.Removed != 0 {
+		t.Errorf("expected 1 experience and 1 skill, got %+v", result)
+	}
+
+	if len(expRepo.experiences) != 2 || len(eduRepo.educations) != 1 {
+		t.Fatalf("expected the rejected addition to be skipped, got %d experiences and %d educations", len(expRepo.experiences), len(eduRepo.educations))
+	}
+	if acme.Title != "Sr. Software Engineer" || *acme.Description != "Built and ran things" || !acme.IsCurrent || acme.EndDate == nil {
+		t.Errorf("expected the accepted fields to be updated and the end date kept, got %+v", acme)
+	}
+	if *acme.SourceResumeID != secondResumeID {
+		t.Errorf("expected the experience to be attributed to the merged resume")
+	}
+	names := make(map[string]bool)
+	for _, sk := range skillRepo.skills {
+		names[sk.Name] = true
+	}
+	if len(names) != 4 || !names["Go"] || !names["PostgreSQL"] || !names["GraphQL"] || !names["Kubernetes"] {
+		t.Errorf("expected Go, PostgreSQL, GraphQL and Kubernetes, got %v", names)
+	}
+
+	changes := svc.changeRepo.(*mockProfileChangeRepository).changes
+	if last := changes[len(changes)-1]; last.Source != domain.ProfileChangeSourceImport {
+		t.Errorf("expected the merge to be logged as an import, got %+v", last)
+	}
+}
+
+func TestResumeMergeKeepsDistinctRolesApart(t *testing.T) {
+	svc, _, expRepo, _, _ := newTestService()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	first := &domain.ResumeExtractedData{
+		Experience: []domain.WorkExperience{
+			{Company: "Acme", Title: "Software Engineer", StartDate: stringPtr("2015-01"), EndDate: stringPtr("2017-12")},
+		},
+	}
+	if _, err := svc.MaterializeResumeData(ctx, uuid.New(), userID, first); err != nil {
+		t.Fatalf("MaterializeResumeData failed: %v", err)
+	}
+
+	second := &domain.ResumeExtractedData{
+		Experience: []domain.WorkExperience{
+			{Company: "Acme Inc.", Title: "Software Engineer", StartDate: stringPtr("Jan 2015"), EndDate: stringPtr("Dec 2017")},
+			{Company: "Acme", Title: "Engineering Manager", StartDate: stringPtr("2021-03"), IsCurrent: true},
+		},
+	}
+	diff, err := svc.PreviewResumeMerge(ctx, uuid.New(), userID, second)
+	if err != nil {
+		t.Fatalf("PreviewResumeMerge failed: %v", err)
+	}
+	byLabel := mergeChangesByLabel(diff)
+	if byLabel["add Engineering Manager at Acme"] == nil || byLabel["update Software Engineer at Acme Inc."] == nil || len(byLabel) != 2 {
+		t.Fatalf("expected the later role to be added and the first one updated, got %+v", byLabel)
+	}
+
+	if _, err := svc.MergeResumeData(ctx, uuid.New(), userID, second, nil); err != nil {
+		t.Fatalf("MergeResumeData failed: %v", err)
+	}
+	if len(expRepo.experiences) != 2 {
+		t.Errorf("expected 2 experiences after applying the additions, got %d", len(expRepo.experiences))
+	}
+}
+
+func TestResumeMergeWithoutAcceptedIDsOnlyAdds(t *testing.T) {
+	svc, _, expRepo, _, skillRepo := newTestService()
+	ctx := context.Background()
+	userID := uuid.New()
+	resumeID := uuid.New()
+
+	if _, err := svc.MaterializeResumeData(ctx, resumeID, userID, testExtractedData()); err != nil {
+		t.Fatalf("MaterializeResumeData failed: %v", err)
+	}
+
+	// The same resume, extracted again with a different title and fewer skills
+	data := testExtractedData()
+	data.Experience[0].Title = "Senior Software Engineer"
+	data.Skills = []string{"Go", "Kubernetes"}
+	diff, err := svc.PreviewResumeMerge(ctx, resumeID, userID, data)
+	if err != nil {
+		t.Fatalf("PreviewResumeMerge failed: %v", err)
+	}
+	byLabel := mergeChangesByLabel(diff)
+	for _, label := range []string{"update Senior Software Engineer at Acme Corp", "add Kubernetes", "remove PostgreSQL", "remove GraphQL"} {
+		if byLabel[label] == nil {
+			t.Errorf("expected change %q, got %+v", label, byLabel)
+		}
+	}
+
+	result, err := svc.MergeResumeData(ctx, resumeID, userID, data, nil)
+	if err != nil {
+		t.Fatalf("MergeResumeData failed: %v", err)
+	}
+	if result.Skills != 1 || result.Experiences != 0 || result.Removed != 0 {
+		t.Errorf("expected only the skill to be added, got %+v", result)
+	}
+	for _, exp := range expRepo.experiences {
+		if exp.Title != "Software Engineer" {
+			t.Errorf("expected the title to be kept without an accepted update, got %q", exp.Title)
+		}
+	}
+	if len(skillRepo.skills) != 4 {
+		t.Errorf("expected no skill to be removed without an accepted removal, got %d skills", len(skillRepo.skills))
+	}
+
+	if _, err := svc.MergeResumeData(ctx, resumeID, userID, data, []string{byLabel["remove PostgreSQL"].ID}); err != nil {
+		t.Fatalf("MergeResumeData failed: %v", err)
+	}
+	if len(skillRepo.skills) != 3 {
+		t.Errorf("expected the accepted removal to be applied, got %d skills", len(skillRepo.skills))
+	}
+}
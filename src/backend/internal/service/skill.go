@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/taxonomy"
+)
+
+// LoadSkillTaxonomy returns the bundled skill taxonomy extended with the profile's own skills.
+func LoadSkillTaxonomy(ctx context.Context, skillRepo domain.SkillRepository, profileID uuid.UUID) (*taxonomy.Taxonomy, error) {
+	custom, err := skillRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile skill taxonomy: %w", err)
+	}
+	return taxonomy.Default().With(custom...), nil
+}
+
+// CanonicalSkillID returns the ID of the canonical skill a skill name resolves to, or nil
+// when the taxonomy does not know it.
+func CanonicalSkillID(tax *taxonomy.Taxonomy, name string) *uuid.UUID {
+	if skill := tax.Resolve(name); skill != nil {
+		id := skill.ID
+		return &id
+	}
+	return nil
+}
+
+// RelinkProfileSkills points each of the profile's skills at the canonical skill its name
+// resolves to. Call it after the profile's taxonomy extensions change.
+func RelinkProfileSkills(ctx context.Context, profileSkillRepo domain.ProfileSkillRepository, tax *taxonomy.Taxonomy, profileID uuid.UUID) error {
+	skills, err := profileSkillRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("failed to get profile skills: %w", err)
+	}
+	for _, skill := range skills {
+		canonicalID := CanonicalSkillID(tax, skill.Name)
+		if sameSkillID(canonicalID, skill.CanonicalSkillID) {
+			continue
+		}
+		skill.CanonicalSkillID = canonicalID
+		if err := profileSkillRepo.Update(ctx, skill); err != nil {
+			return fmt.Errorf("failed to relink skill %q: %w", skill.Name, err)
+		}
+	}
+	return nil
+}
+
+func sameSkillID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/taxonomy"
+)
+
+// relationshipWeights rates how much a validation from each kind of author says about a
+// skill. Someone who managed the candidate has seen the skill applied over time; a mentor,
+// professor, peer or client has seen part of it, and a colleague from elsewhere in the
+// organization less still.
+var relationshipWeights = map[domain.TestimonialRelationship]float64{
+	domain.TestimonialRelationshipManager:           1.0,
+	domain.TestimonialRelationshipPerformanceReview: 0.9,
+	domain.TestimonialRelationshipClient:            0.8,
+	domain.TestimonialRelationshipMentor:            0.8,
+	domain.TestimonialRelationshipProfessor:         0.75,
+	domain.TestimonialRelationshipPeer:              0.7,
+	domain.TestimonialRelationshipColleague:         0.65,
+	domain.TestimonialRelationshipDirectReport:      0.6,
+	domain.TestimonialRelationshipOther:             0.5,
+}
+
+// relationshipWeight returns the weight of a relationship; unknown values count as other.
+func relationshipWeight(rel domain.TestimonialRelationship) float64 {
+	if w, ok := relationshipWeights[rel]; ok {
+		return w
+	}
+	return relationshipWeights[domain.TestimonialRelationshipOther]
+}
+
+// SkillStatsService derives the computed fields of profile skills: years of experience and
+// last used from the experiences that mention a skill, and evidence strength from the
+// reference letters that validate it.
+type SkillStatsService struct {
+	profileExpRepo   domain.ProfileExperienceRepository
+	profileSkillRepo domain.ProfileSkillRepository
+	testimonialRepo  domain.TestimonialRepository
+	authorRepo       domain.AuthorRepository
+	skillValRepo     domain.SkillValidationRepository
+	skillRepo        domain.SkillRepository
+	now              func() time.Time
+}
+
+// NewSkillStatsService creates a new SkillStatsService.
+func NewSkillStatsService(
+	profileExpRepo domain.ProfileExperienceRepository,
+	profileSkillRepo domain.ProfileSkillRepository,
+	testimonialRepo domain.TestimonialRepository,
+	authorRepo domain.AuthorRepository,
+	skillValRepo domain.SkillValidationRepository,
+	skillRepo domain.SkillRepository,
+) *SkillStatsService {
+	return &SkillStatsService{
+		profileExpRepo:   profileExpRepo,
+		profileSkillRepo: profileSkillRepo,
+		testimonialRepo:  testimonialRepo,
+		authorRepo:       authorRepo,
+		skillValRepo:     skillValRepo,
+		skillRepo:        skillRepo,
+		now:              time.Now,
+	}
+}
+
+// skillEvidence is everything on a profile that the computed skill fields draw on.
+type skillEvidence struct {
+	experiences  []*domain.ProfileExperience
+	testimonials []*domain.Testimonial
+	authors      []*domain.Author
+	validations  map[uuid.UUID][]*domain.SkillValidation // keyed by profile skill ID
+
+	// texts holds, per experience ID, the passages that show which skills it used.
+	texts map[uuid.UUID][]*taxonomy.Text
+}
+
+// prepare collects the passages of each experience: its title, description and highlights,
+// and the testimonials by authors at the experience's company.
+func (e *skillEvidence) prepare(tax *taxonomy.Taxonomy) {
+	authorCompanies := make(map[uuid.UUID]uuid.UUID, len(e.authors))
+	for _, a := range e.authors {
+		if a.CompanyID != nil {
+			authorCompanies[a.ID] = *a.CompanyID
+		}
+	}
+
+	e.texts = make(map[uuid.UUID][]*taxonomy.Text, len(e.experiences))
+	for _, exp := range e.experiences {
+		// Names and labels are prepared as they are; prose needs a technical context for
+		// skills that are also everyday words, so "Spring 2018" does not credit Spring.
+		texts := []*taxonomy.Text{tax.Prepare(exp.Title)}
+		if exp.Description != nil {
+			texts = append(texts, tax.PrepareProse(*exp.Description))
+		}
+		for _, h := range exp.Highlights {
+			texts = append(texts, tax.PrepareProse(h))
+		}
+		if exp.CompanyID != nil {
+			for _, t := range e.testimonials {
+				if t.AuthorID == nil || authorCompanies[*t.AuthorID] != *exp.CompanyID {
+					continue
+				}
+				texts = append(texts, tax.PrepareProse(t.Quote))
+				for _, name := range t.SkillsMentioned {
+					texts = append(texts, tax.Prepare(name))
+				}
+			}
+		}
+		e.texts[exp.ID] = texts
+	}
+}
+
+// Recompute refreshes the computed fields of every skill on the profile and returns the
+// profile's skills with their new values. Only skills whose values changed are written.
+func (s *SkillStatsService) Recompute(ctx context.Context, profileID uuid.UUID) ([]*domain.ProfileSkill, error) {
+	skills, err := s.profileSkillRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile skills: %w", err)
+	}
+	if len(skills) == 0 {
+		return skills, nil
+	}
+
+	evidence := &skillEvidence{validations: make(map[uuid.UUID][]*domain.SkillValidation, len(skills))}
+	if evidence.experiences, err = s.profileExpRepo.GetByProfileID(ctx, profileID); err != nil {
+		return nil, fmt.Errorf("failed to get profile experiences: %w", err)
+	}
+	if evidence.testimonials, err = s.testimonialRepo.GetByProfileID(ctx, profileID); err != nil {
+		return nil, fmt.Errorf("failed to get testimonials: %w", err)
+	}
+	if evidence.authors, err = s.authorRepo.GetByProfileID(ctx, profileID); err != nil {
+		return nil, fmt.Errorf("failed to get authors: %w", err)
+	}
+	for _, skill := range skills {
+		validations, valErr := s.skillValRepo.GetByProfileSkillID(ctx, skill.ID)
+		if valErr != nil {
+			return nil, fmt.Errorf("failed to get validations for skill %s: %w", skill.ID, valErr)
+		}
+		evidence.validations[skill.ID] = validations
+	}
+
+	tax, err := LoadSkillTaxonomy(ctx, s.skillRepo, profileID)
+	if err != nil {
+		return nil, err
+	}
+	evidence.prepare(tax)
+
+	var changed []*domain.ProfileSkill
+	for _, skill := range skills {
+		years, lastUsed, strength := computeSkillStats(tax, skill, evidence, s.now())
+		if years == skill.YearsOfExperience && strength == skill.EvidenceStrength && sameDate(lastUsed, skill.LastUsed) {
+			continue
+		}
+		skill.YearsOfExperience = years
+		skill.LastUsed = lastUsed
+		skill.EvidenceStrength = strength
+		changed = append(changed, skill)
+	}
+	if len(changed) == 0 {
+		return skills, nil
+	}
+	if err := s.profileSkillRepo.UpdateStats(ctx, changed); err != nil {
+		return nil, fmt.Errorf("failed to update skill stats: %w", err)
+	}
+	return skills, nil
+}
+
+// computeSkillStats returns the years of experience, last used month and evidence strength
+// of a skill as of now. The evidence must have been prepared with the same taxonomy.
+func computeSkillStats(tax *taxonomy.Taxonomy, skill *domain.ProfileSkill, evidence *skillEvidence, now time.Time) (float64, *time.Time, float64) {
+	years, lastUsed := skillUsage(tax, skill, evidence, now)
+	return years, lastUsed, evidenceStrength(evidence.validations[skill.ID], evidence.testimonials)
+}
+
+// skillUsage finds the experiences that used a skill, either because the experience
+// mentions it or because a testimonial from someone at the same company does, and returns
+// the total years they span, counting overlapping roles once, and the month of the latest.
+func skillUsage(tax *taxonomy.Taxonomy, skill *domain.ProfileSkill, evidence *skillEvidence, now time.Time) (float64, *time.Time) {
+	var intervals [][2]int
+	last := -1
+	for _, exp := range evidence.experiences {
+		if !mentionsSkill(tax, evidence.texts[exp.ID], skill) {
+			continue
+		}
+		dates := exp.Dates()
+		if end, ok := dates.LastMonth(now); ok && end > last {
+			last = end
+		}
+		if first, end, ok := dates.Months(now); ok && end >= first {
+			intervals = append(intervals, [2]int{first, end})
+		}
+	}
+
+	var lastUsed *time.Time
+	if last >= 0 {
+		t := domain.MonthStart(last)
+		lastUsed = &t
+	}
+	return math.Round(float64(coveredMonths(intervals))/12*10) / 10, lastUsed
+}
+
+// mentionsSkill reports whether any of the texts mentions the skill.
+func mentionsSkill(tax *taxonomy.Taxonomy, texts []*taxonomy.Text, skill *domain.ProfileSkill) bool {
+	for _, text := range texts {
+		if tax.MentionsProfileSkill(text, skill) {
+			return true
+		}
+	}
+	return false
+}
+
+// coveredMonths returns the number of months covered by inclusive month intervals,
+// counting overlaps once.
+func coveredMonths(intervals [][2]int) int {
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i][0] < intervals[j][0] })
+	total := 0
+	end := math.MinInt
+	for _, iv := range intervals {
+		start := iv[0]
+		if start <= end {
+			start = end + 1
+		}
+		if iv[1] >= start {
+			total += iv[1] - start + 1
+		}
+		if iv[1] > end {
+			end = iv[1]
+		}
+	}
+	return total
+}
+
+// evidenceStrength scores how well reference letters back a skill, from 0 to 1. Each
+// letter counts once, at the weight of its strongest relationship, and letters combine
+// with diminishing returns. Validations from several kinds of relationship, such as a
+// manager and a client, earn a bonus over the same number from one kind.
+func evidenceStrength(validations []*domain.SkillValidation, testimonials []*domain.Testimonial) float64 {
+	if len(validations) == 0 {
+		return 0
+	}
+	byID := make(map[uuid.UUID]*domain.Testimonial, len(testimonials))
+	for _, t := range testimonials {
+		byID[t.ID] = t
+	}
+
+	letterWeights := map[uuid.UUID]float64{}
+	relationships := map[domain.TestimonialRelationship]bool{}
+	for _, v := range validations {
+		rel := validationRelationship(v, byID, testimonials)
+		relationships[rel] = true
+		if w := relationshipWeight(rel); w > letterWeights[v.ReferenceLetterID] {
+			letterWeights[v.ReferenceLetterID] = w
+		}
+	}
+
+	missing := 1.0
+	for _, w := range letterWeights {
+		missing *= 1 - 0.5*w
+	}
+	strength := 1 - missing
+	strength += (1 - strength) * 0.1 * float64(len(relationships)-1)
+	return math.Round(strength*100) / 100
+}
+
+// validationRelationship returns the relationship of the author behind a validation: that
+// of its linked testimonial, else the strongest among testimonials from the same letter.
+func validationRelationship(v *domain.SkillValidation, byID map[uuid.UUID]*domain.Testimonial, testimonials []*domain.Testimonial) domain.TestimonialRelationship {
+	if v.TestimonialID != nil {
+		if t := byID[*v.TestimonialID]; t != nil {
+			return t.Relationship
+		}
+	}
+	best := domain.TestimonialRelationshipOther
+	for _, t := range testimonials {
+		if t.ReferenceLetterID == v.ReferenceLetterID && relationshipWeight(t.Relationship) > relationshipWeight(best) {
+			best = t.Relationship
+		}
+	}
+	return best
+}
+
+func sameDate(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
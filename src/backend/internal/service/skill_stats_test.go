@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/taxonomy"
+)
+
+func TestCoveredMonthsCountsOverlapsOnce(t *testing.T) {
+	intervals := [][2]int{
+		{domain.MonthIndex(2018, 1), domain.MonthIndex(2019, 12)}, // 24 months
+		{domain.MonthIndex(2019, 7), domain.MonthIndex(2020, 6)},  // overlaps by 6, adds 6
+		{domain.MonthIndex(2018, 3), domain.MonthIndex(2018, 8)},  // inside the first
+		{domain.MonthIndex(2022, 1), domain.MonthIndex(2022, 1)},  // one month
+	}
+	if got := coveredMonths(intervals); got != 31 {
+		t.Errorf("coveredMonths = %d, want 31", got)
+	}
+}
+
+func TestSkillUsage(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	acme := uuid.New()
+	authorID := uuid.New()
+	evidence := &skillEvidence{
+		experiences: []*domain.ProfileExperience{
+			// Mentions Go directly: 2016-01..2019-12, 48 months
+			{ID: uuid.New(), Title: "Backend Engineer", Description: stringPtr("Wrote Go services"), StartDate: stringPtr("2016"), EndDate: stringPtr("Dec 2019")},
+			// Overlapping side role: adds 2020-01..2020-06
+			{ID: uuid.New(), Title: "Golang contractor", StartDate: stringPtr("2019-06"), EndDate: stringPtr("2020-06")},
+			// Only a colleague's testimonial mentions Go: 2025-01..now, 22 months
+			{ID: uuid.New(), Title: "Staff Engineer", CompanyID: &acme, StartDate: stringPtr("2025-01"), IsCurrent: true},
+			// Never mentions Go
+			{ID: uuid.New(), Title: "Java Developer", StartDate: stringPtr("2010"), EndDate: stringPtr("2015")},
+		},
+		authors:      []*domain.Author{{ID: authorID, CompanyID: &acme}},
+		testimonials: []*domain.Testimonial{{ID: uuid.New(), AuthorID: &authorID, Quote: "Owns our platform.", SkillsMentioned: []string{"Golang"}}},
+	}
+	for _, exp := range evidence.experiences {
+		if err := SetExperienceDates(exp); err != nil {
+			t.Fatalf("invalid dates for %s: %v", exp.Title, err)
+		}
+	}
+	tax := taxonomy.Default()
+	evidence.prepare(tax)
+
+	years, lastUsed := skillUsage(tax, &domain.ProfileSkill{Name: "Go"}, evidence, now)
+	if years != 6.3 {
+		t.Errorf("expected 6.3 years of Go (76 months), got %v", years)
+	}
+	if lastUsed == nil || lastUsed.Format("2006-01") != "2026-10" {
+		t.Errorf("expected Go last used in the current month, got %v", lastUsed)
+	}
+
+	years, lastUsed = skillUsage(tax, &domain.ProfileSkill{Name: "Rust"}, evidence, now)
+	if years != 0 || lastUsed != nil {
+		t.Errorf("expected no usage of an unmentioned skill, got %v years, last used %v", years, lastUsed)
+	}
+}
+
+func TestSkillUsageIgnoresEverydayWords(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	evidence := &skillEvidence{
+		experiences: []*domain.ProfileExperience{
+			{ID: uuid.New(), Title: "Account Manager", Description: stringPtr("Go the extra mile for every client; always willing to go the extra mile."), StartDate: stringPtr("2016"), EndDate: stringPtr("2019")},
+			{ID: uuid.New(), Title: "Sales Associate", Highlights: []string{"Joined in Spring 2018 and doubled regional revenue"}, StartDate: stringPtr("2018-03"), EndDate: stringPtr("2020-06")},
+		},
+	}
+	for _, exp := range evidence.experiences {
+		if err := SetExperienceDates(exp); err != nil {
+			t.Fatalf("invalid dates for %s: %v", exp.Title, err)
+		}
+	}
+	tax := taxonomy.Default()
+	evidence.prepare(tax)
+
+	for _, name := range []string{"Go", "Spring"} {
+		years, lastUsed := skillUsage(tax, &domain.ProfileSkill{Name: name}, evidence, now)
+		if years != 0 || lastUsed != nil {
+			t.Errorf("expected no usage of %s, got %v years, last used %v", name, years, lastUsed)
+		}
+	}
+}
+
+func TestEvidenceStrength(t *testing.T) {
+	letterA, letterB := uuid.New(), uuid.New()
+	manager := &domain.Testimonial{ID: uuid.New(), ReferenceLetterID: letterA, Relationship: domain.TestimonialRelationshipManager}
+	client := &domain.Testimonial{ID: uuid.New(), ReferenceLetterID: letterB, Relationship: domain.TestimonialRelationshipClient}
+	peer := &domain.Testimonial{ID: uuid.New(), ReferenceLetterID: letterB, Relationship: domain.TestimonialRelationshipPeer}
+	testimonials := []*domain.Testimonial{manager, client, peer}
+
+	if got := evidenceStrength(nil, testimonials); got != 0 {
+		t.Errorf("expected no strength without validations, got %v", got)
+	}
+
+	single := []*domain.SkillValidation{{ReferenceLetterID: letterA, TestimonialID: &manager.ID}}
+	if got := evidenceStrength(single, testimonials); got != 0.5 {
+		t.Errorf("expected one manager letter to score 0.5, got %v", got)
+	}
+
+	// Without a linked testimonial the letter's strongest relationship is used, and the
+	// same letter counts once.
+	unlinked := []*domain.SkillValidation{{ReferenceLetterID: letterB}, {ReferenceLetterID: letterB}}
+	if got := evidenceStrength(unlinked, testimonials); got != 0.4 {
+		t.Errorf("expected one client letter to score 0.4, got %v", got)
+	}
+
+	// manager + client: 1 - 0.5*0.6 = 0.7, plus a mix bonus of 0.3*0.1
+	mixed := []*domain.SkillValidation{{ReferenceLetterID: letterA, TestimonialID: &manager.ID}, {ReferenceLetterID: letterB}}
+	if got := evidenceStrength(mixed, testimonials); got != 0.73 {
+		t.Errorf("expected manager and client letters to score 0.73, got %v", got)
+	}
+
+	unknown := []*domain.SkillValidation{{ReferenceLetterID: uuid.New()}}
+	if got := evidenceStrength(unknown, testimonials); got != 0.25 {
+		t.Errorf("expected a letter without testimonials to count as other, got %v", got)
+	}
+}
+
+func TestRecomputeSkillStats(t *testing.T) {
+	expRepo := newMockProfileExperienceRepository()
+	skillRepo := newMockProfileSkillRepository()
+	testimonialRepo := newMockTestimonialRepository()
+	validationRepo := newMockSkillValidationRepository()
+	svc := NewSkillStatsService(expRepo, skillRepo, testimonialRepo, newMockAuthorRepository(), validationRepo, newMockSkillRepository())
+	svc.now = func() time.Time { return time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC) }
+	ctx := context.Background()
+	profileID := uuid.New()
+
+	kubernetes := &domain.ProfileSkill{ID: uuid.New(), ProfileID: profileID, Name: "Kubernetes", NormalizedName: "kubernetes"}
+	if err := skillRepo.Create(ctx, kubernetes); err != nil {
+		t.Fatalf("failed to create skill: %v", err)
+	}
+	exp := &domain.ProfileExperience{ID: uuid.New(), ProfileID: profileID, Title: "SRE", Highlights: []string{"Migrated to k8s"}, StartDate: stringPtr("2020-01"), EndDate: stringPtr("2022-12")}
+	if err := SetExperienceDates(exp); err != nil {
+		t.Fatalf("invalid experience dates: %v", err)
+	}
+	if err := expRepo.Create(ctx, exp); err != nil {
+		t.Fatalf("failed to create experience: %v", err)
+	}
+	letterID := uuid.New()
+	if err := validationRepo.Create(ctx, &domain.SkillValidation{ID: uuid.New(), ProfileSkillID: kubernetes.ID, ReferenceLetterID: letterID}); err != nil {
+		t.Fatalf("failed to create validation: %v", err)
+	}
+	if err := testimonialRepo.Create(ctx, &domain.Testimonial{ID: uuid.New(), ProfileID: profileID, ReferenceLetterID: letterID, Relationship: domain.TestimonialRelationshipManager}); err != nil {
+		t.Fatalf("failed to create testimonial: %v", err)
+	}
+
+	skills, err := svc.Recompute(ctx, profileID)
+	if err != nil {
+		t.Fatalf("Recompute failed: %v", err)
+	}
+	if len(skills) != 1 {
+		t.Fatalf("expected 1 skill, got %d", len(skills))
+	}
+	stored, _ := skillRepo.GetByID(ctx, kubernetes.ID)
+	if stored.YearsOfExperience != 3 {
+		t.Errorf("expected 3 years of Kubernetes, got %v", stored.YearsOfExperience)
+	}
+	if stored.LastUsed == nil || stored.LastUsed.Format("2006-01") != "2022-12" {
+		t.Errorf("expected Kubernetes last used in 2022-12, got %v", stored.LastUsed)
+	}
+	if stored.EvidenceStrength != 0.5 {
+		t.Errorf("expected evidence strength 0.5, got %v", stored.EvidenceStrength)
+	}
+}
+
+func TestMaterializeResumeDataComputesSkillStats(t *testing.T) {
+	svc, profileRepo, _, _, skillRepo := newTestService()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	data := &domain.ResumeExtractedData{
+		Name: "Test User",
+		Experience: []domain.WorkExperience{
+			{Company: "Acme", Title: "Engineer", StartDate: stringPtr("2018-01"), EndDate: stringPtr("2019-12"), Description: stringPtr("Python data pipelines")},
+		},
+		Skills: []string{"Python"},
+	}
+	if _, err := svc.MaterializeResumeData(ctx, uuid.New(), userID, data); err != nil {
+		t.Fatalf("MaterializeResumeData failed: %v", err)
+	}
+
+	profile, _ := profileRepo.GetByUserID(ctx, userID)
+	skills, _ := skillRepo.GetByProfileID(ctx, profile.ID)
+	if len(skills) != 1 {
+		t.Fatalf("expected 1 skill, got %d", len(skills))
+	}
+	if skills[0].YearsOfExperience != 2 {
+		t.Errorf("expected 2 years of Python, got %v", skills[0].YearsOfExperience)
+	}
+}
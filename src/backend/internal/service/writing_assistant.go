@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+)
+
+// WritingAssistantService drafts a profile's summary, headline and experience highlights
+// with a ProfileWriter and keeps the drafts as pending suggestions; nothing on the profile
+// changes until the user accepts one. Drafts may only draw on the profile's experiences,
+// skills and testimonials, and a draft stating a number or name those facts do not
+// contain is discarded.
+type WritingAssistantService struct {
+	writer           domain.ProfileWriter
+	profileExpRepo   domain.ProfileExperienceRepository
+	profileSkillRepo domain.ProfileSkillRepository
+	testimonialRepo  domain.TestimonialRepository
+	suggestionRepo   domain.WritingSuggestionRepository
+}
+
+// NewWritingAssistantService creates a new WritingAssistantService.
+func NewWritingAssistantService(
+	writer domain.ProfileWriter,
+	profileExpRepo domain.ProfileExperienceRepository,
+	profileSkillRepo domain.ProfileSkillRepository,
+	testimonialRepo domain.TestimonialRepository,
+	suggestionRepo domain.WritingSuggestionRepository,
+) *WritingAssistantService {
+	return &WritingAssistantService{
+		writer:           writer,
+		profileExpRepo:   profileExpRepo,
+		profileSkillRepo: profileSkillRepo,
+		testimonialRepo:  testimonialRepo,
+		suggestionRepo:   suggestionRepo,
+	}
+}
+
+// DraftSummary drafts a professional summary of the profile.
+func (s *WritingAssistantService) DraftSummary(ctx context.Context, profileID uuid.UUID) ([]*domain.WritingSuggestion, error) {
+	facts, err := s.facts(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	drafts, err := s.writer.DraftSummary(ctx, facts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to draft summary: %w", err)
+	}
+	return s.suggest(ctx, profileID, nil, domain.WritingSuggestionSummary, drafts, facts, false)
+}
+
+// SuggestHeadlines drafts alternative headlines for the profile, one suggestion each.
+func (s *WritingAssistantService) SuggestHeadlines(ctx context.Context, profileID uuid.UUID) ([]*domain.WritingSuggestion, error) {
+	facts, err := s.facts(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	drafts, err := s.writer.SuggestHeadlines(ctx, facts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest headlines: %w", err)
+	}
+	return s.suggest(ctx, profileID, nil, domain.WritingSuggestionHeadline, drafts, facts, true)
+}
+
+// RewriteHighlights rewrites the highlights of an experience in achievement-oriented form.
+// The rewritten highlights make up one suggestion.
+func (s *WritingAssistantService) RewriteHighlights(ctx context.Context, exp *domain.ProfileExperience) ([]*domain.WritingSuggestion, error) {
+	facts, err := s.facts(ctx, exp.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+	drafts, err := s.writer.RewriteHighlights(ctx, experienceFact(exp), facts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rewrite highlights: %w", err)
+	}
+	return s.suggest(ctx, exp.ProfileID, &exp.ID, domain.WritingSuggestionHighlights, drafts, facts, false)
+}
+
+// suggest drops the drafts with unsupported claims and saves the rest as pending
+// suggestions: one per draft if each is an alternative, otherwise one holding all.
+func (s *WritingAssistantService) suggest(
+	ctx context.Context,
+	profileID uuid.UUID,
+	experienceID *uuid.UUID,
+	kind domain.WritingSuggestionKind,
+	drafts *domain.WritingDrafts,
+	facts []domain.WritingFact,
+	alternatives bool,
+) ([]*domain.WritingSuggestion, error) {
+	grounded := groundedDrafts(drafts.Drafts, facts)
+	if len(grounded) == 0 {
+		return nil, &domain.ValidationError{
+			Field:   "drafts",
+			Message: "every draft made claims the profile does not support",
+			Err:     domain.ErrUnsupportedClaim,
+		}
+	}
+
+	groups := [][]domain.WritingDraft{grounded}
+	if alternatives {
+		groups = make([][]domain.WritingDraft, len(grounded))
+		for i, draft := range grounded {
+			groups[i] = []domain.WritingDraft{draft}
+		}
+	}
+	suggestions := make([]*domain.WritingSuggestion, 0, len(groups))
+	for _, group := range groups {
+		suggestion := &domain.WritingSuggestion{
+			ID:           uuid.New(),
+			ProfileID:    profileID,
+			ExperienceID: experienceID,
+			Kind:         kind,
+			Drafts:       group,
+			Status:       domain.WritingSuggestionPending,
+			ModelVersion: drafts.Metadata.ModelVersion,
+		}
+		if err := s.suggestionRepo.Create(ctx, suggestion); err != nil {
+			return nil, fmt.Errorf("failed to save writing suggestion: %w", err)
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	return suggestions, nil
+}
+
+// facts renders the profile's experiences, skills and testimonials as writing facts.
+func (s *WritingAssistantService) facts(ctx context.Context, profileID uuid.UUID) ([]domain.WritingFact, error) {
+	return writingFacts(ctx, s.profileExpRepo, s.profileSkillRepo, s.testimonialRepo, profileID)
+}
+
+// writingFacts renders a profile's experiences, skills and testimonials as writing facts.
+func writingFacts(
+	ctx context.Context,
+	profileExpRepo domain.ProfileExperienceRepository,
+	profileSkillRepo domain.ProfileSkillRepository,
+	testimonialRepo domain.TestimonialRepository,
+	profileID uuid.UUID,
+) ([]domain.WritingFact, error) {
+	experiences, err := profileExpRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile experiences: %w", err)
+	}
+	skills, err := profileSkillRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile skills: %w", err)
+	}
+	testimonials, err := testimonialRepo.GetByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get testimonials: %w", err)
+	}
+
+	facts := make([]domain.WritingFact, 0, len(experiences)+len(skills)+len(testimonials))
+	for _, exp := range experiences {
+		facts = append(facts, experienceFact(exp))
+	}
+	for _, skill := range skills {
+		facts = append(facts, skillFact(skill))
+	}
+	for _, t := range testimonials {
+		facts = append(facts, testimonialFact(t))
+	}
+	if len(facts) == 0 {
+		return nil, &domain.ValidationError{
+			Field:   "profileId",
+			Message: "the profile has no experiences, skills or testimonials to write from",
+			Err:     domain.ErrEmptyRequired,
+		}
+	}
+	return facts, nil
+}
+
+func experienceFact(exp *domain.ProfileExperience) domain.WritingFact {
+	var b strings.Builder
+	b.WriteString(exp.Title + " at " + exp.Company)
+	if exp.Location != nil && *exp.Location != "" {
+		b.WriteString(", " + *exp.Location)
+	}
+	switch {
+	case exp.StartDate != nil && exp.IsCurrent:
+		b.WriteString(" (" + *exp.StartDate + " to present)")
+	case exp.StartDate != nil && exp.EndDate != nil:
+		b.WriteString(" (" + *exp.StartDate + " to " + *exp.EndDate + ")")
+	}
+	b.WriteString(".")
+	if exp.Description != nil && *exp.Description != "" {
+		b.WriteString(" " + *exp.Description)
+	}
+	if len(exp.Highlights) > 0 {
+		b.WriteString(" Highlights: " + strings.Join(exp.Highlights, "; "))
+	}
+	return domain.WritingFact{ID: exp.ID, Kind: domain.WritingFactExperience, Text: b.String()}
+}
+
+func skillFact(skill *domain.ProfileSkill) domain.WritingFact {
+	text := skill.Name
+	if skill.YearsOfExperience > 0 {
+		text += " (" + strconv.FormatFloat(skill.YearsOfExperience, 'f', -1, 64) + " years of experience)"
+	}
+	return domain.WritingFact{ID: skill.ID, Kind: domain.WritingFactSkill, Text: text}
+}
+
+func testimonialFact(t *domain.Testimonial) domain.WritingFact {
+	var author []string
+	for _, part := range []*string{t.AuthorName, t.AuthorTitle, t.AuthorCompany} {
+		if part != nil && *part != "" {
+			author = append(author, *part)
+		}
+	}
+	text := "“" + t.Quote + "”"
+	if len(author) > 0 {
+		text += " by " + strings.Join(author, ", ")
+	}
+	text += " (" + strings.ReplaceAll(string(t.Relationship), "_", " ") + ")"
+	return domain.WritingFact{ID: t.ID, Kind: domain.WritingFactTestimonial, Text: text}
+}
+
+var (
+	claimNumber = regexp.MustCompile(`\d+(?:[.,]\d+)*`)
+	claimWord   = regexp.MustCompile(`[\p{L}\p{N}][\p{L}\p{N}'’.+#&]*`)
+)
+
+// groundedDrafts returns the drafts without unsupported claims.
+func groundedDrafts(drafts []domain.WritingDraft, facts []domain.WritingFact) []domain.WritingDraft {
+	numbers, words := claimCorpus(facts)
+	var grounded []domain.WritingDraft
+	for _, draft := range drafts {
+		if len(unsupportedClaims(draft.Text, numbers, words)) == 0 {
+			grounded = append(grounded, draft)
+		}
+	}
+	return grounded
+}
+
+// claimCorpus collects the numbers and words that the facts and any extra texts support.
+func claimCorpus(facts []domain.WritingFact, extra ...string) (numbers, words map[string]bool) {
+	texts := make([]string, 0, len(facts)+len(extra))
+	for _, fact := range facts {
+		texts = append(texts, fact.Text)
+	}
+	corpus := strings.Join(append(texts, extra...), "\n")
+	numbers = map[string]bool{}
+	for _, n := range claimNumber.FindAllString(corpus, -1) {
+		numbers[numberKey(n)] = true
+	}
+	words = map[string]bool{}
+	for _, token := range normalize.Tokens(corpus) {
+		words[token] = true
+	}
+	return numbers, words
+}
+
+// unsupportedClaims returns the numbers and names in text that the facts do not contain.
+// Names are capitalized words other than the first of a sentence, so "Led the Payments
+// team at Stripe" must find "payments" and "stripe" in the facts. Numbers must appear as
+// written, ignoring thousands separators, so "40%" needs a 40 and "$1,200" a 1200.
+func unsupportedClaims(text string, numbers, words map[string]bool) []string {
+	var claims []string
+	for _, n := range claimNumber.FindAllString(text, -1) {
+		if !numbers[numberKey(n)] {
+			claims = append(claims, n)
+		}
+	}
+	for _, loc := range claimWord.FindAllStringIndex(text, -1) {
+		word := text[loc[0]:loc[1]]
+		first, _ := utf8.DecodeRuneInString(word)
+		if !unicode.IsUpper(first) || startsSentence(text[:loc[0]]) {
+			continue
+		}
+		for _, token := range normalize.Tokens(word) {
+			if !words[token] {
+				claims = append(claims, word)
+				break
+			}
+		}
+	}
+	return claims
+}
+
+// startsSentence reports whether a word preceded by before starts a sentence, a clause
+// after a colon or dash, or the text.
+func startsSentence(before string) bool {
+	before = strings.TrimRightFunc(before, unicode.IsSpace)
+	if before == "" {
+		return true
+	}
+	last, _ := utf8.DecodeLastRuneInString(before)
+	return strings.ContainsRune(".!?:;|•–—-(\"“", last)
+}
+
+// numberKey strips thousands separators from a number, so "1,200" and "1200" compare equal.
+func numberKey(n string) string {
+	return strings.ReplaceAll(n, ",", "")
+}
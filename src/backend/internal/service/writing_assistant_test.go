@@ -0,0 +1,50 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+func TestGroundedDrafts(t *testing.T) {
+	exp := &domain.ProfileExperience{
+		ID:          uuid.New(),
+		Company:     "Stripe",
+		Title:       "Backend Engineer",
+		StartDate:   stringPtr("2019-03"),
+		EndDate:     stringPtr("2023-06"),
+		Description: stringPtr("Built Go services for payments"),
+		Highlights:  []string{"Cut checkout latency by 40%", "Grew the team to 1,200 merchants onboarded"},
+	}
+	skill := &domain.ProfileSkill{ID: uuid.New(), Name: "Kubernetes", YearsOfExperience: 4.5}
+	quote := &domain.Testimonial{
+		ID:           uuid.New(),
+		Quote:        "The most reliable engineer on the team.",
+		AuthorName:   stringPtr("Jane Doe"),
+		Relationship: domain.TestimonialRelationshipManager,
+	}
+	facts := []domain.WritingFact{experienceFact(exp), skillFact(skill), testimonialFact(quote)}
+
+	tests := []struct {
+		name     string
+		text     string
+		grounded bool
+	}{
+		{"restates the facts", "Backend engineer at Stripe who cut checkout latency by 40% with Go and Kubernetes.", true},
+		{"ignores thousands separators", "Onboarded 1200 merchants. Described by Jane Doe as the most reliable engineer on the team.", true},
+		{"capitalizes a sentence start", "Reliable engineer: Built payment services for 4.5 years.", true},
+		{"invents a number", "Cut checkout latency by 45% at Stripe.", false},
+		{"invents an employer", "Backend engineer at Stripe and Google.", false},
+		{"invents a technology", "Runs Kubernetes clusters on AWS.", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grounded := groundedDrafts([]domain.WritingDraft{{Text: tt.text}}, facts)
+			if (len(grounded) == 1) != tt.grounded {
+				t.Errorf("expected grounded = %v for %q", tt.grounded, tt.text)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package taxonomy
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+//go:embed data/skills.json
+var datasetJSON []byte
+
+// globalNamespace derives the IDs of bundled skills from their slugs, so a bundled skill
+// has the same ID in every database and in Default without a lookup.
+var globalNamespace = uuid.MustParse("5b7f3c1e-2a4d-4e8b-9c61-0f3d2e7a9b14")
+
+// datasetEntry is one skill in the bundled dataset. Parent refers to another entry's slug.
+// Ambiguous marks skills whose names are also common English words.
+type datasetEntry struct {
+	Slug      string   `json:"slug"`
+	Name      string   `json:"name"`
+	Category  string   `json:"category"`
+	Parent    string   `json:"parent"`
+	Synonyms  []string `json:"synonyms"`
+	Ambiguous bool     `json:"ambiguous"`
+}
+
+var (
+	defaultOnce     sync.Once
+	defaultTaxonomy *Taxonomy
+	defaultErr      error
+)
+
+// GlobalID returns the ID of the bundled skill with the given slug.
+func GlobalID(slug string) uuid.UUID {
+	return uuid.NewSHA1(globalNamespace, []byte(slug))
+}
+
+// Default returns the taxonomy built from the bundled dataset. It panics if the embedded
+// dataset is invalid, which the package tests guard against.
+func Default() *Taxonomy {
+	defaultOnce.Do(func() {
+		var skills []*domain.Skill
+		skills, defaultErr = loadDataset(datasetJSON)
+		if defaultErr == nil {
+			defaultTaxonomy = New(skills)
+		}
+	})
+	if defaultErr != nil {
+		panic(fmt.Sprintf("taxonomy: invalid bundled dataset: %v", defaultErr))
+	}
+	return defaultTaxonomy
+}
+
+// loadDataset parses dataset JSON into skills with IDs derived from their slugs.
+func loadDataset(data []byte) ([]*domain.Skill, error) {
+	var entries []datasetEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse skill dataset: %w", err)
+	}
+
+	slugs := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		if e.Slug == "" || e.Name == "" {
+			return nil, fmt.Errorf("skill %q is missing a slug or name", e.Slug+e.Name)
+		}
+		if slugs[e.Slug] {
+			return nil, fmt.Errorf("duplicate skill slug %q", e.Slug)
+		}
+		slugs[e.Slug] = true
+	}
+
+	skills := make([]*domain.Skill, 0, len(entries))
+	for _, e := range entries {
+		skill := &domain.Skill{
+			ID:        GlobalID(e.Slug),
+			Slug:      e.Slug,
+			Name:      e.Name,
+			Category:  e.Category,
+			Synonyms:  e.Synonyms,
+			Ambiguous: e.Ambiguous,
+		}
+		if skill.Category == "" {
+			skill.Category = "TECHNICAL"
+		}
+		if skill.Synonyms == nil {
+			skill.Synonyms = []string{}
+		}
+		if e.Parent != "" {
+			if !slugs[e.Parent] {
+				return nil, fmt.Errorf("skill %q has unknown parent %q", e.Slug, e.Parent)
+			}
+			parentID := GlobalID(e.Parent)
+			skill.ParentID = &parentID
+		}
+		skills = append(skills, skill)
+	}
+	return skills, nil
+}
+
+// Seed upserts the bundled taxonomy into the repository so profile skills can reference it.
+func Seed(ctx context.Context, repo domain.SkillRepository) error {
+	bundled := Default().Skills()
+	skills := make([]*domain.Skill, len(bundled))
+	for i, s := range bundled {
+		seeded := *s
+		skills[i] = &seeded
+	}
+	if err := repo.SeedGlobal(ctx, skills); err != nil {
+		return fmt.Errorf("failed to seed skill taxonomy: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package taxonomy
+
+import (
+	"regexp"
+	"unicode"
+)
+
+// sentenceBoundary splits prose into sentences. A period must be followed by a space or
+// the end of the text, so "Node.js" stays whole.
+var sentenceBoundary = regexp.MustCompile(`[.!?;]+(\s+|$)|\n+`)
+
+// technicalCues are words that show a sentence is about software, so an ambiguous skill
+// in it is meant as the skill: "services in Go" rather than "go the extra mile".
+var technicalCues = map[string]bool{
+	"api": true, "apis": true, "app": true, "apps": true, "application": true,
+	"applications": true, "backend": true, "cli": true, "code": true, "codebase": true,
+	"coding": true, "compiler": true, "framework": true, "frameworks": true,
+	"frontend": true, "language": true, "languages": true, "library": true,
+	"libraries": true, "microservice": true, "microservices": true, "module": true,
+	"modules": true, "package": true, "packages": true, "programming": true,
+	"runtime": true, "sdk": true, "server": true, "servers": true, "service": true,
+	"services": true, "stack": true, "tooling": true,
+}
+
+// PrepareProse is Prepare for prose such as a description or a testimonial. An ambiguous
+// skill only counts where its sentence is technical: it also names another skill or a
+// technical word such as "services" or "framework". A season before a year, as in
+// "Spring 2018", never counts.
+func (t *Taxonomy) PrepareProse(text string) *Text {
+	prepared := &Text{tokens: Tokens(text)}
+	for _, sentence := range sentenceBoundary.Split(text, -1) {
+		raw, spans := t.mentionSpans(sentence)
+		tokens := Tokens(sentence)
+		for _, m := range spans {
+			if m.skill.Ambiguous && !technicalContext(tokens, raw, spans, m) {
+				continue
+			}
+			if !containsSkill(prepared.mentions, m.skill) {
+				prepared.mentions = append(prepared.mentions, m.skill)
+			}
+		}
+	}
+	return prepared
+}
+
+// technicalContext reports whether the sentence an ambiguous skill is mentioned in shows it
+// is meant as the skill. Names no one writes as an everyday word, such as "Spring Boot" or
+// "REST", are technical on their own.
+func technicalContext(tokens, raw []string, spans []mention, m mention) bool {
+	if m.end-m.start > 1 || hasInnerCapital(raw[m.start]) {
+		return true
+	}
+	if (m.start > 0 && isYear(tokens[m.start-1])) || (m.end < len(tokens) && isYear(tokens[m.end])) {
+		return false
+	}
+	for _, other := range spans {
+		if other.skill.ID != m.skill.ID && !other.skill.Ambiguous {
+			return true
+		}
+	}
+	for _, token := range tokens {
+		if technicalCues[token] {
+			return true
+		}
+	}
+	return false
+}
+
+// hasInnerCapital reports whether a word has a capital letter after its first, as in
+// "REST" or "SpringBoot".
+func hasInnerCapital(word string) bool {
+	for i, r := range []rune(word) {
+		if i > 0 && unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// isYear reports whether a token is a four-digit year such as "2018".
+func isYear(token string) bool {
+	if len(token) != 4 || (token[:2] != "19" && token[:2] != "20") {
+		return false
+	}
+	for _, r := range token {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,426 @@
+// Package taxonomy maps free-text skill names to canonical skills. A canonical skill has
+// synonyms and abbreviations ("k8s" for Kubernetes, "ECMAScript" for JavaScript) and an
+// optional parent (React -> JavaScript -> Frontend Development). The bundled taxonomy is
+// embedded in the binary and can be extended per profile.
+package taxonomy
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+	"backend/internal/normalize"
+)
+
+// parenthetical matches a parenthesized part of a skill name, as in "Kubernetes (K8s)".
+var parenthetical = regexp.MustCompile(`\(([^)]*)\)`)
+
+// Tokens folds a skill name into words. Unlike normalize.Tokens it keeps "+" and "#", so
+// "C++" and "C#" stay distinct from "C", and it splits on periods, so "Node.js" yields
+// ["node", "js"].
+func Tokens(name string) []string {
+	return strings.FieldsFunc(normalize.FoldText(name), isSeparator)
+}
+
+// caseTokens splits text into the same words as Tokens without folding them, so "Go" and
+// "go" stay distinct.
+func caseTokens(text string) []string {
+	return strings.FieldsFunc(text, isSeparator)
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '+' && r != '#'
+}
+
+// Key returns the lookup key for a skill name: its tokens joined without separators, so
+// "Node.js", "NodeJS" and "node js" share a key.
+func Key(name string) string {
+	return strings.Join(Tokens(name), "")
+}
+
+// Slug returns a URL-safe identifier for a skill name, e.g. "design-systems".
+func Slug(name string) string {
+	return strings.Join(Tokens(name), "-")
+}
+
+// Taxonomy indexes canonical skills by the keys of their names and synonyms.
+// A Taxonomy is immutable; With returns an extended copy.
+type Taxonomy struct {
+	skills []*domain.Skill
+	byID   map[uuid.UUID]*domain.Skill
+	byKey  map[string]*domain.Skill
+	// spellings holds the names of ambiguous skills as written, joined like keys but with
+	// their case kept, e.g. "Go" and "Golang".
+	spellings map[uuid.UUID]map[string]bool
+}
+
+// New builds a taxonomy from skills. When two skills share a name or synonym, the later
+// one wins.
+func New(skills []*domain.Skill) *Taxonomy {
+	t := &Taxonomy{
+		byID:      make(map[uuid.UUID]*domain.Skill, len(skills)),
+		byKey:     make(map[string]*domain.Skill, len(skills)*3),
+		spellings: map[uuid.UUID]map[string]bool{},
+	}
+	t.add(skills)
+	return t
+}
+
+// With returns a copy of the taxonomy extended with skills, typically a profile's own
+// skills. The added skills take precedence over existing ones with the same name or synonym.
+func (t *Taxonomy) With(skills ...*domain.Skill) *Taxonomy {
+	ext := &Taxonomy{
+		skills:    append([]*domain.Skill(nil), t.skills...),
+		byID:      make(map[uuid.UUID]*domain.Skill, len(t.byID)+len(skills)),
+		byKey:     make(map[string]*domain.Skill, len(t.byKey)+len(skills)*3),
+		spellings: make(map[uuid.UUID]map[string]bool, len(t.spellings)),
+	}
+	for id, s := range t.byID {
+		ext.byID[id] = s
+	}
+	for key, s := range t.byKey {
+		ext.byKey[key] = s
+	}
+	for id, spellings := range t.spellings {
+		ext.spellings[id] = spellings
+	}
+	ext.add(skills)
+	return ext
+}
+
+func (t *Taxonomy) add(skills []*domain.Skill) {
+	for _, s := range skills {
+		t.skills = append(t.skills, s)
+		t.byID[s.ID] = s
+		delete(t.spellings, s.ID)
+		for _, name := range append([]string{s.Name}, s.Synonyms...) {
+			if key := Key(name); key != "" {
+				t.byKey[key] = s
+			}
+			if s.Ambiguous {
+				if t.spellings[s.ID] == nil {
+					t.spellings[s.ID] = map[string]bool{}
+				}
+				t.spellings[s.ID][strings.Join(caseTokens(name), "")] = true
+			}
+		}
+	}
+}
+
+// Skills returns every skill in the taxonomy. The slice is shared and must not be modified.
+func (t *Taxonomy) Skills() []*domain.Skill {
+	return t.skills
+}
+
+// Get returns the skill with the given ID, or nil.
+func (t *Taxonomy) Get(id uuid.UUID) *domain.Skill {
+	return t.byID[id]
+}
+
+// Parent returns the skill's parent, or nil for a root skill.
+func (t *Taxonomy) Parent(s *domain.Skill) *domain.Skill {
+	if s == nil || s.ParentID == nil {
+		return nil
+	}
+	return t.byID[*s.ParentID]
+}
+
+// Ancestors returns the skill's parent chain, nearest first: JavaScript then Frontend
+// Development for React.
+func (t *Taxonomy) Ancestors(s *domain.Skill) []*domain.Skill {
+	var ancestors []*domain.Skill
+	seen := map[uuid.UUID]bool{}
+	if s != nil {
+		seen[s.ID] = true
+	}
+	for p := t.Parent(s); p != nil && !seen[p.ID]; p = t.Parent(p) {
+		seen[p.ID] = true
+		ancestors = append(ancestors, p)
+	}
+	return ancestors
+}
+
+// Resolve returns the canonical skill a name refers to, or nil if it is not in the taxonomy.
+// Besides exact names and synonyms it accepts a trailing version ("Java 8") and a
+// parenthesized alternative ("Search Engine Optimization (SEO)").
+func (t *Taxonomy) Resolve(name string) *domain.Skill {
+	if s := t.resolveTokens(Tokens(name)); s != nil {
+		return s
+	}
+	if !parenthetical.MatchString(name) {
+		return nil
+	}
+	if s := t.resolveTokens(Tokens(parenthetical.ReplaceAllString(name, " "))); s != nil {
+		return s
+	}
+	for _, m := range parenthetical.FindAllStringSubmatch(name, -1) {
+		if s := t.resolveTokens(Tokens(m[1])); s != nil {
+			return s
+		}
+	}
+	return nil
+}
+
+func (t *Taxonomy) resolveTokens(tokens []string) *domain.Skill {
+	for len(tokens) > 0 {
+		if s, ok := t.byKey[strings.Join(tokens, "")]; ok {
+			return s
+		}
+		if !isVersion(tokens[len(tokens)-1]) {
+			return nil
+		}
+		tokens = tokens[:len(tokens)-1]
+	}
+	return nil
+}
+
+// isVersion reports whether a token is a version number such as "8" or "2+".
+func isVersion(token string) bool {
+	trimmed := strings.TrimSuffix(token, "+")
+	if trimmed == "" {
+		return false
+	}
+	for _, r := range trimmed {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Mentions returns the canonical skills named within a longer phrase, such as Kubernetes
+// and Go in "deploying Go services on k8s". Longer spans win, so "go-to-market plan"
+// mentions Go-to-Market Strategy rather than Go. Single-letter spans are ignored to avoid
+// reading "R&D" as the R language, and ambiguous skills must be written exactly as one of
+// their names, so "go the extra mile" does not mention Go.
+func (t *Taxonomy) Mentions(phrase string) []*domain.Skill {
+	_, spans := t.mentionSpans(phrase)
+	var found []*domain.Skill
+	for _, m := range spans {
+		if !containsSkill(found, m.skill) {
+			found = append(found, m.skill)
+		}
+	}
+	return found
+}
+
+// mention is a skill named by the tokens from start up to end of a phrase.
+type mention struct {
+	skill      *domain.Skill
+	start, end int
+}
+
+// mentionSpans returns the phrase's words as written, or nil when folding changed the word
+// boundaries, and the spans of it that name skills, as described for Mentions.
+func (t *Taxonomy) mentionSpans(phrase string) ([]string, []mention) {
+	tokens := Tokens(phrase)
+	raw := caseTokens(phrase)
+	if len(raw) != len(tokens) {
+		// Folding changed the word boundaries, so spans cannot be compared as written.
+		raw = nil
+	}
+	var spans []mention
+	for i := 0; i < len(tokens); {
+		matched := false
+		for j := len(tokens); j > i; j-- {
+			key := strings.Join(tokens[i:j], "")
+			s, ok := t.byKey[key]
+			if !ok || len([]rune(key)) < 2 {
+				continue
+			}
+			if s.Ambiguous && (raw == nil || !t.spellings[s.ID][strings.Join(raw[i:j], "")]) {
+				continue
+			}
+			spans = append(spans, mention{skill: s, start: i, end: j})
+			i = j
+			matched = true
+			break
+		}
+		if !matched {
+			i++
+		}
+	}
+	return raw, spans
+}
+
+// Normalize returns the normalized name stored with a profile skill: the lowercased
+// canonical name when the taxonomy knows the skill, so "JS" and "JavaScript" normalize
+// alike, and the lowercased, trimmed name otherwise.
+func (t *Taxonomy) Normalize(name string) string {
+	if s := t.Resolve(name); s != nil {
+		return strings.ToLower(s.Name)
+	}
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Deduplicate removes skill names that refer to the same skill, preserving the first
+// occurrence as written. Blank names are dropped.
+func (t *Taxonomy) Deduplicate(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		trimmed := strings.TrimSpace(name)
+		if trimmed == "" {
+			continue
+		}
+		key := "name:" + strings.ToLower(trimmed)
+		if s := t.Resolve(trimmed); s != nil {
+			key = "skill:" + s.ID.String()
+		} else if k := Key(trimmed); k != "" {
+			key = "key:" + k
+		}
+		if !seen[key] {
+			seen[key] = true
+			result = append(result, trimmed)
+		}
+	}
+	return result
+}
+
+// Match reports whether two skill names refer to the same skill. Names the taxonomy knows
+// match only when they resolve to the same canonical skill, so "Java" never matches
+// "JavaScript". A known skill matches a longer phrase that mentions it ("Kubernetes" and
+// "running k8s clusters"). Unknown names match when one contains the other as whole words.
+func (t *Taxonomy) Match(a, b string) bool {
+	return t.match(t.Resolve(a), a, b)
+}
+
+// MatchProfileSkill is Match for a profile skill, preferring its linked canonical skill.
+func (t *Taxonomy) MatchProfileSkill(skill *domain.ProfileSkill, name string) bool {
+	return t.match(t.resolveProfileSkill(skill), skill.Name, name)
+}
+
+// FindProfileSkill returns the profile skill a name refers to, or nil. Profile skills that
+// resolve to the same canonical skill or share the name's key, directly or through an alias
+// left by a merge, are preferred over looser matches.
+func (t *Taxonomy) FindProfileSkill(skills []*domain.ProfileSkill, name string) *domain.ProfileSkill {
+	if sk := t.FindProfileSkillExact(skills, name); sk != nil {
+		return sk
+	}
+	if Key(name) == "" {
+		return nil
+	}
+	for _, sk := range skills {
+		if t.MatchProfileSkill(sk, name) {
+			return sk
+		}
+	}
+	return nil
+}
+
+// FindProfileSkillExact returns the profile skill that resolves to the same canonical
+// skill as a name or shares its key, directly or through an alias left by a merge, or nil.
+// Unlike FindProfileSkill it does not fall back to matching whole-word phrases.
+func (t *Taxonomy) FindProfileSkillExact(skills []*domain.ProfileSkill, name string) *domain.ProfileSkill {
+	key := Key(name)
+	if key == "" {
+		return nil
+	}
+	resolved := t.Resolve(name)
+	for _, sk := range skills {
+		if canonical := t.resolveProfileSkill(sk); resolved != nil && canonical != nil && canonical.ID == resolved.ID {
+			return sk
+		}
+		if Key(sk.Name) == key {
+			return sk
+		}
+		for _, alias := range sk.Aliases {
+			if Key(alias) == key {
+				return sk
+			}
+		}
+	}
+	return nil
+}
+
+// Text is a passage, such as an experience description, prepared for matching many
+// skills against it. Preparing scans the passage once instead of once per skill.
+type Text struct {
+	tokens   []string
+	mentions []*domain.Skill
+}
+
+// Prepare returns text prepared for MentionsProfileSkill. Use PrepareProse for sentences
+// rather than names and short labels.
+func (t *Taxonomy) Prepare(text string) *Text {
+	return &Text{tokens: Tokens(text), mentions: t.Mentions(text)}
+}
+
+// MentionsProfileSkill reports whether text mentions a profile skill under its name, its
+// linked canonical skill or an alias left by a merge. Names the taxonomy knows are found
+// through any of their synonyms; unknown names must appear as whole words.
+func (t *Taxonomy) MentionsProfileSkill(text *Text, skill *domain.ProfileSkill) bool {
+	if s := t.resolveProfileSkill(skill); s != nil && containsSkill(text.mentions, s) {
+		return true
+	}
+	for _, name := range append([]string{skill.Name}, skill.Aliases...) {
+		if s := t.Resolve(name); s != nil {
+			if containsSkill(text.mentions, s) {
+				return true
+			}
+			continue
+		}
+		if containsPhrase(text.tokens, Tokens(name)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Taxonomy) resolveProfileSkill(skill *domain.ProfileSkill) *domain.Skill {
+	if skill.CanonicalSkillID != nil {
+		if s := t.byID[*skill.CanonicalSkillID]; s != nil {
+			return s
+		}
+	}
+	return t.Resolve(skill.Name)
+}
+
+func (t *Taxonomy) match(resolvedA *domain.Skill, a, b string) bool {
+	if Key(a) == "" || Key(b) == "" {
+		return false
+	}
+	resolvedB := t.Resolve(b)
+	switch {
+	case resolvedA != nil && resolvedB != nil:
+		return resolvedA.ID == resolvedB.ID
+	case resolvedA != nil:
+		return containsSkill(t.Mentions(b), resolvedA)
+	case resolvedB != nil:
+		return containsSkill(t.Mentions(a), resolvedB)
+	}
+	ta, tb := Tokens(a), Tokens(b)
+	return strings.Join(ta, "") == strings.Join(tb, "") || containsPhrase(ta, tb) || containsPhrase(tb, ta)
+}
+
+func containsSkill(skills []*domain.Skill, s *domain.Skill) bool {
+	for _, candidate := range skills {
+		if candidate.ID == s.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// containsPhrase reports whether needle occurs in haystack as a run of whole words.
+func containsPhrase(haystack, needle []string) bool {
+	if len(needle) == 0 || len(needle) > len(haystack) {
+		return false
+	}
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		matched := true
+		for j, word := range needle {
+			if haystack[i+j] != word {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
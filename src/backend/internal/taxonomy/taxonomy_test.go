@@ -0,0 +1,308 @@
+package taxonomy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"backend/internal/domain"
+)
+
+func TestDefaultDatasetIsConsistent(t *testing.T) {
+	skills, err := loadDataset(datasetJSON)
+	if err != nil {
+		t.Fatalf("loadDataset failed: %v", err)
+	}
+
+	owner := make(map[string]string)
+	for _, s := range skills {
+		switch s.Category {
+		case "TECHNICAL", "SOFT", "DOMAIN":
+		default:
+			t.Errorf("skill %q has invalid category %q", s.Slug, s.Category)
+		}
+		for _, name := range append([]string{s.Name}, s.Synonyms...) {
+			key := Key(name)
+			if key == "" {
+				t.Errorf("skill %q has a name or synonym %q with an empty key", s.Slug, name)
+				continue
+			}
+			if other, ok := owner[key]; ok && other != s.Slug {
+				t.Errorf("key %q of skill %q is already used by %q", key, s.Slug, other)
+			}
+			owner[key] = s.Slug
+		}
+	}
+
+	tax := New(skills)
+	for _, s := range skills {
+		chain := tax.Ancestors(s)
+		if len(chain) > 0 && tax.Parent(chain[len(chain)-1]) != nil {
+			t.Errorf("skill %q has a parent cycle", s.Slug)
+		}
+	}
+}
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Node.js", "nodejs"},
+		{"NodeJS", "nodejs"},
+		{"C++", "c++"},
+		{"C#", "c#"},
+		{"CI/CD", "cicd"},
+		{"  Kubernetes  ", "kubernetes"},
+		{"Diátaxis", "diataxis"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			if got := Key(tc.input); got != tc.expected {
+				t.Errorf("Key(%q) = %q, want %q", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"k8s", "kubernetes"},
+		{"K8S", "kubernetes"},
+		{"JS", "javascript"},
+		{"ECMAScript", "javascript"},
+		{"Golang", "go"},
+		{"React.js", "react"},
+		{"C++", "cpp"},
+		{"C#", "csharp"},
+		{"C", "c"},
+		{"Java 8", "java"},
+		{"Angular 2+", "angular"},
+		{"Kubernetes (K8s)", "kubernetes"},
+		{"search engine optimization (SEO)", "seo"},
+		{"technical documentation (Diátaxis framework)", "technical-writing"},
+		{"Underwater Basket Weaving", ""},
+		{"", ""},
+	}
+
+	tax := Default()
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			got := tax.Resolve(tc.input)
+			switch {
+			case tc.expected == "" && got != nil:
+				t.Errorf("Resolve(%q) = %q, want nil", tc.input, got.Slug)
+			case tc.expected != "" && (got == nil || got.Slug != tc.expected):
+				t.Errorf("Resolve(%q) = %v, want %q", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestAncestors(t *testing.T) {
+	tax := Default()
+	react := tax.Resolve("React")
+	if react == nil {
+		t.Fatal("expected React in the bundled taxonomy")
+	}
+
+	var slugs []string
+	for _, s := range tax.Ancestors(react) {
+		slugs = append(slugs, s.Slug)
+	}
+	if want := []string{"javascript", "frontend"}; !reflect.DeepEqual(slugs, want) {
+		t.Errorf("Ancestors(React) = %v, want %v", slugs, want)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{"abbreviation", "Kubernetes", "k8s", true},
+		{"synonym", "JavaScript", "ECMAScript", true},
+		{"different skills sharing a prefix", "Java", "JavaScript", false},
+		{"parent is not the same skill", "React", "JavaScript", false},
+		{"mention in a phrase", "Kubernetes", "running k8s clusters in production", true},
+		{"longer span wins", "Go", "go-to-market plan", false},
+		{"single letter ignored in phrase", "R", "R&D leadership", false},
+		{"ambiguous skill as a common word", "Go", "willing to go the extra mile", false},
+		{"ambiguous skill as written", "Go", "deploying Go services", true},
+		{"parenthetical", "SEO", "search engine optimization (SEO)", true},
+		{"synonym in a phrase", "Team Leadership", "interim team leadership", true},
+		{"unknown skills as whole words", "incident triage", "incident triage automation", true},
+		{"unknown skills need whole words", "triage", "triaged", false},
+		{"empty", "", "Go", false},
+	}
+
+	tax := Default()
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tax.Match(tc.a, tc.b); got != tc.expected {
+				t.Errorf("Match(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.expected)
+			}
+			if got := tax.Match(tc.b, tc.a); got != tc.expected {
+				t.Errorf("Match(%q, %q) = %v, want %v", tc.b, tc.a, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestMentionsAmbiguousSkills(t *testing.T) {
+	tests := []struct {
+		phrase   string
+		expected []string
+	}{
+		{"Always willing to go the extra mile for the team", nil},
+		{"Joined in Spring 2018 as an intern", []string{"Spring"}},
+		{"Rebuilt the billing service in Go and Rust", []string{"Go", "Rust"}},
+		{"Migrated golang and Golang services", []string{"Go"}},
+		{"Took a well-earned rest after the launch, watching the rust spread", nil},
+		{"Designed the REST API and a Spring Boot gateway", []string{"REST APIs", "Spring"}},
+		{"Her swift response helped us express our concerns", nil},
+	}
+
+	tax := Default()
+	for _, tc := range tests {
+		t.Run(tc.phrase, func(t *testing.T) {
+			var got []string
+			for _, s := range tax.Mentions(tc.phrase) {
+				got = append(got, s.Name)
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Mentions(%q) = %v, want %v", tc.phrase, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestPrepareProse(t *testing.T) {
+	tests := []struct {
+		text     string
+		skill    string
+		expected bool
+	}{
+		{"Go the extra mile for every client.", "Go", false},
+		{"Joined in Spring 2018 as an intern.", "Spring", false},
+		{"Rebuilt the billing services in Go.", "Go", true},
+		{"Moved batch jobs from Python to Go. Won the Spring hackathon.", "Go", true},
+		{"Moved batch jobs from Python to Go. Won the Spring hackathon.", "Spring", false},
+		{"Introduced Spring Boot across teams", "Spring", true},
+		{"Designed and documented our REST endpoints", "REST APIs", true},
+	}
+
+	tax := Default()
+	for _, tc := range tests {
+		t.Run(tc.text+"/"+tc.skill, func(t *testing.T) {
+			text := tax.PrepareProse(tc.text)
+			if got := tax.MentionsProfileSkill(text, &domain.ProfileSkill{Name: tc.skill}); got != tc.expected {
+				t.Errorf("MentionsProfileSkill(%q) = %v, want %v", tc.skill, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestDeduplicate(t *testing.T) {
+	got := Default().Deduplicate([]string{"Kubernetes", "k8s", "JS", "JavaScript", "Java", "  ", "Node.js", "NodeJS", "Basket Weaving", "basket-weaving"})
+	want := []string{"Kubernetes", "JS", "Java", "Node.js", "Basket Weaving"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Deduplicate = %v, want %v", got, want)
+	}
+}
+
+func TestWithProfileExtension(t *testing.T) {
+	profileID := uuid.New()
+	react := Default().Resolve("React")
+	custom := &domain.Skill{
+		ID:        uuid.New(),
+		ProfileID: &profileID,
+		Slug:      "design-systems",
+		Name:      "Design Systems",
+		ParentID:  &react.ID,
+		Synonyms:  []string{"Component Libraries"},
+	}
+
+	tax := Default().With(custom)
+	if got := tax.Resolve("component libraries"); got == nil || got.ID != custom.ID {
+		t.Errorf("expected extension synonym to resolve to the custom skill, got %v", got)
+	}
+	if ancestors := tax.Ancestors(custom); len(ancestors) != 3 || ancestors[0].ID != react.ID {
+		t.Errorf("expected custom skill to inherit React's ancestors, got %d ancestors", len(ancestors))
+	}
+	if Default().Resolve("Design Systems") != nil {
+		t.Error("expected the default taxonomy to be unchanged by With")
+	}
+}
+
+func TestFindProfileSkill(t *testing.T) {
+	javaScript := &domain.ProfileSkill{ID: uuid.New(), Name: "JavaScript"}
+	java := &domain.ProfileSkill{ID: uuid.New(), Name: "Java"}
+	skills := []*domain.ProfileSkill{java, javaScript}
+
+	tax := Default()
+	if got := tax.FindProfileSkill(skills, "JS"); got != javaScript {
+		t.Errorf("expected JS to find JavaScript, got %v", got)
+	}
+	if got := tax.FindProfileSkill(skills, "Java"); got != java {
+		t.Errorf("expected Java to find Java, got %v", got)
+	}
+	if got := tax.FindProfileSkill(skills, "Python"); got != nil {
+		t.Errorf("expected no match for Python, got %q", got.Name)
+	}
+
+	// A linked canonical skill is used even when the name itself does not resolve.
+	k8sID := tax.Resolve("Kubernetes").ID
+	platform := &domain.ProfileSkill{ID: uuid.New(), Name: "Container platform", CanonicalSkillID: &k8sID}
+	if got := tax.FindProfileSkill([]*domain.ProfileSkill{platform}, "k8s"); got != platform {
+		t.Errorf("expected k8s to find the skill linked to Kubernetes, got %v", got)
+	}
+}
+
+func TestFindProfileSkillExact(t *testing.T) {
+	triage := &domain.ProfileSkill{ID: uuid.New(), Name: "Incident Triage"}
+	skills := []*domain.ProfileSkill{triage}
+
+	tax := Default()
+	if got := tax.FindProfileSkillExact(skills, "incident triage"); got != triage {
+		t.Errorf("expected the same name to find the skill, got %v", got)
+	}
+	// The whole-word phrase match FindProfileSkill falls back to is not exact.
+	if got := tax.FindProfileSkill(skills, "Triage"); got != triage {
+		t.Errorf("expected FindProfileSkill to match the phrase, got %v", got)
+	}
+	if got := tax.FindProfileSkillExact(skills, "Triage"); got != nil {
+		t.Errorf("expected no exact match for Triage, got %q", got.Name)
+	}
+}
+
+func TestMentionsProfileSkill(t *testing.T) {
+	tax := Default()
+	text := tax.Prepare("Ran k8s clusters and wrote incident triage tooling; led the go-to-market plan.")
+
+	tests := []struct {
+		name     string
+		skill    *domain.ProfileSkill
+		expected bool
+	}{
+		{"synonym", &domain.ProfileSkill{Name: "Kubernetes"}, true},
+		{"unknown skill as whole words", &domain.ProfileSkill{Name: "Incident Triage"}, true},
+		{"longer span wins", &domain.ProfileSkill{Name: "Go"}, false},
+		{"alias", &domain.ProfileSkill{Name: "Container Orchestration", Aliases: []string{"Kubernetes"}}, true},
+		{"not mentioned", &domain.ProfileSkill{Name: "Python"}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tax.MentionsProfileSkill(text, tc.skill); got != tc.expected {
+				t.Errorf("MentionsProfileSkill(%q) = %v, want %v", tc.skill.Name, got, tc.expected)
+			}
+		})
+	}
+}
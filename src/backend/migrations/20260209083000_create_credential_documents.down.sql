@@ -0,0 +1,14 @@
+-- Drop credential_documents table and the columns referencing it
+
+ALTER TABLE profile_education DROP COLUMN IF EXISTS verified_by_document_id;
+
+DROP INDEX IF EXISTS idx_testimonials_credential_document_id;
+ALTER TABLE testimonials DROP CONSTRAINT IF EXISTS testimonials_source_document_check;
+ALTER TABLE testimonials DROP COLUMN IF EXISTS credential_document_id;
+DELETE FROM testimonials WHERE reference_letter_id IS NULL;
+ALTER TABLE testimonials ALTER COLUMN reference_letter_id SET NOT NULL;
+
+DROP TRIGGER IF EXISTS update_credential_documents_updated_at ON credential_documents;
+DROP INDEX IF EXISTS idx_credential_documents_file_id;
+DROP INDEX IF EXISTS idx_credential_documents_user_id;
+DROP TABLE IF EXISTS credential_documents;
@@ -0,0 +1,36 @@
+-- Create credential_documents table for certificates/licenses, academic transcripts/diplomas
+-- and performance reviews. Each type has a dedicated extractor; extracted data is stored as JSONB.
+
+CREATE TABLE credential_documents (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    file_id UUID NOT NULL REFERENCES files(id) ON DELETE CASCADE,
+    document_type VARCHAR(50) NOT NULL CHECK (document_type IN ('certificate', 'transcript', 'performance_review')),
+    status VARCHAR(50) NOT NULL DEFAULT 'pending',
+    extracted_data JSONB,
+    error_message TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_credential_documents_user_id ON credential_documents(user_id);
+CREATE INDEX idx_credential_documents_file_id ON credential_documents(file_id);
+
+CREATE TRIGGER update_credential_documents_updated_at
+    BEFORE UPDATE ON credential_documents
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Performance reviews produce testimonials without a reference letter
+ALTER TABLE testimonials ALTER COLUMN reference_letter_id DROP NOT NULL;
+ALTER TABLE testimonials
+ADD COLUMN credential_document_id UUID REFERENCES credential_documents(id) ON DELETE CASCADE;
+ALTER TABLE testimonials
+ADD CONSTRAINT testimonials_source_document_check
+CHECK (reference_letter_id IS NOT NULL OR credential_document_id IS NOT NULL);
+
+CREATE INDEX idx_testimonials_credential_document_id ON testimonials(credential_document_id) WHERE credential_document_id IS NOT NULL;
+
+-- Transcripts verify education entries
+ALTER TABLE profile_education
+ADD COLUMN verified_by_document_id UUID REFERENCES credential_documents(id) ON DELETE SET NULL;
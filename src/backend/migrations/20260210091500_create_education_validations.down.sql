@@ -0,0 +1 @@
+DROP TABLE IF EXISTS education_validations;
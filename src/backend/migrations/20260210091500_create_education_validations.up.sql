@@ -0,0 +1,13 @@
+-- Education validations table: links profile education entries to reference letters that validate them
+CREATE TABLE education_validations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_education_id UUID NOT NULL REFERENCES profile_education(id) ON DELETE CASCADE,
+    reference_letter_id UUID NOT NULL REFERENCES reference_letters(id) ON DELETE CASCADE,
+    quote_snippet TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (profile_education_id, reference_letter_id)
+);
+
+-- Indexes for education_validations
+CREATE INDEX idx_education_validations_profile_education_id ON education_validations(profile_education_id);
+CREATE INDEX idx_education_validations_reference_letter_id ON education_validations(reference_letter_id);
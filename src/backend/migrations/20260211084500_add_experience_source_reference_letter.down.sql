@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_profile_experiences_source_reference_letter_id;
+
+ALTER TABLE profile_experiences DROP COLUMN IF EXISTS source_reference_letter_id;
@@ -0,0 +1,5 @@
+-- Add source_reference_letter_id to profile_experiences for experiences discovered in reference letters
+-- Mirrors profile_skills.source_reference_letter_id so a letter-only profile can trace its entries back
+ALTER TABLE profile_experiences ADD COLUMN IF NOT EXISTS source_reference_letter_id UUID REFERENCES reference_letters(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_profile_experiences_source_reference_letter_id ON profile_experiences(source_reference_letter_id);
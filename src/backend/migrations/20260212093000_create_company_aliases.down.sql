@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS update_company_aliases_updated_at ON company_aliases;
+DROP INDEX IF EXISTS idx_company_aliases_profile_alias;
+DROP INDEX IF EXISTS idx_company_aliases_profile_id;
+DROP TABLE IF EXISTS company_aliases;
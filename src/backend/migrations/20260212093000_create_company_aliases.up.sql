@@ -0,0 +1,23 @@
+-- Create company_aliases table for user-maintained company name equivalences
+-- Company matching treats an alias and its canonical name as the same company
+-- (e.g. "Facebook" -> "Meta Platforms") when validating experiences and deduplicating authors
+
+CREATE TABLE company_aliases (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    alias TEXT NOT NULL,
+    canonical_name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for loading all aliases of a profile
+CREATE INDEX idx_company_aliases_profile_id ON company_aliases(profile_id);
+
+-- An alias can only point at one canonical name per profile
+CREATE UNIQUE INDEX idx_company_aliases_profile_alias ON company_aliases(profile_id, LOWER(alias));
+
+CREATE TRIGGER update_company_aliases_updated_at
+    BEFORE UPDATE ON company_aliases
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
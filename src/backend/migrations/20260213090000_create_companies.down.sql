@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_authors_company_id;
+ALTER TABLE authors DROP COLUMN IF EXISTS company_id;
+
+DROP INDEX IF EXISTS idx_profile_experiences_company_id;
+ALTER TABLE profile_experiences DROP COLUMN IF EXISTS company_id;
+
+DROP TRIGGER IF EXISTS update_companies_updated_at ON companies;
+DROP INDEX IF EXISTS idx_companies_profile_id;
+DROP TABLE IF EXISTS companies;
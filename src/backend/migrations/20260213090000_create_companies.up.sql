@@ -0,0 +1,61 @@
+-- Create companies table so experiences and authors at the same company share one entity
+-- Until now companies existed only as free-text on profile_experiences.company and authors.company
+
+CREATE TABLE companies (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    aliases TEXT[] NOT NULL DEFAULT '{}',
+    website TEXT,
+    logo_id UUID REFERENCES files(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for efficient lookup by profile
+CREATE INDEX idx_companies_profile_id ON companies(profile_id);
+
+CREATE TRIGGER update_companies_updated_at
+    BEFORE UPDATE ON companies
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Link experiences and authors to their company
+-- The free-text company columns stay as the name shown on the source document
+ALTER TABLE profile_experiences
+    ADD COLUMN company_id UUID REFERENCES companies(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_profile_experiences_company_id ON profile_experiences(company_id);
+
+ALTER TABLE authors
+    ADD COLUMN company_id UUID REFERENCES companies(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_authors_company_id ON authors(company_id);
+
+-- Migrate existing company names to companies
+-- For each distinct (profile_id, company) ignoring case and surrounding whitespace, keep the earliest spelling;
+-- new rows are linked by the application, which also folds legal suffixes and aliases
+INSERT INTO companies (profile_id, name)
+SELECT DISTINCT ON (n.profile_id, LOWER(TRIM(n.company)))
+    n.profile_id,
+    TRIM(n.company)
+FROM (
+    SELECT profile_id, company, created_at FROM profile_experiences
+    UNION ALL
+    SELECT profile_id, company, created_at FROM authors WHERE company IS NOT NULL
+) n
+WHERE TRIM(n.company) <> ''
+ORDER BY n.profile_id, LOWER(TRIM(n.company)), n.created_at;
+
+-- Link existing experiences and authors to their newly created companies
+UPDATE profile_experiences pe
+SET company_id = c.id
+FROM companies c
+WHERE pe.profile_id = c.profile_id
+  AND LOWER(TRIM(pe.company)) = LOWER(c.name);
+
+UPDATE authors a
+SET company_id = c.id
+FROM companies c
+WHERE a.profile_id = c.profile_id
+  AND LOWER(TRIM(a.company)) = LOWER(c.name);
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_profile_skills_canonical_skill_id;
+ALTER TABLE profile_skills DROP COLUMN IF EXISTS canonical_skill_id;
+
+DROP TRIGGER IF EXISTS update_skills_updated_at ON skills;
+DROP INDEX IF EXISTS idx_skills_profile_slug;
+DROP INDEX IF EXISTS idx_skills_global_slug;
+DROP INDEX IF EXISTS idx_skills_parent_id;
+DROP INDEX IF EXISTS idx_skills_profile_id;
+DROP TABLE IF EXISTS skills;
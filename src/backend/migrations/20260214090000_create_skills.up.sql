@@ -0,0 +1,37 @@
+-- Create skills table for the canonical skill taxonomy
+-- Rows with a NULL profile_id are the bundled taxonomy, upserted by the server at startup;
+-- rows with a profile_id are extensions a user defined for their own profile
+
+CREATE TABLE skills (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID REFERENCES profiles(id) ON DELETE CASCADE,
+    slug TEXT NOT NULL,
+    name TEXT NOT NULL,
+    category VARCHAR(20) NOT NULL DEFAULT 'TECHNICAL',
+    parent_id UUID REFERENCES skills(id) ON DELETE SET NULL,
+    synonyms TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for loading a profile's extensions
+CREATE INDEX idx_skills_profile_id ON skills(profile_id);
+
+-- Index for walking the hierarchy downwards
+CREATE INDEX idx_skills_parent_id ON skills(parent_id);
+
+-- Slugs are unique within the bundled taxonomy and within each profile
+CREATE UNIQUE INDEX idx_skills_global_slug ON skills(slug) WHERE profile_id IS NULL;
+CREATE UNIQUE INDEX idx_skills_profile_slug ON skills(profile_id, slug) WHERE profile_id IS NOT NULL;
+
+CREATE TRIGGER update_skills_updated_at
+    BEFORE UPDATE ON skills
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Link profile skills to their canonical skill
+-- Existing rows are linked when the server seeds the bundled taxonomy
+ALTER TABLE profile_skills
+    ADD COLUMN canonical_skill_id UUID REFERENCES skills(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_profile_skills_canonical_skill_id ON profile_skills(canonical_skill_id);
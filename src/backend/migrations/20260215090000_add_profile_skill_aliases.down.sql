@@ -0,0 +1 @@
+ALTER TABLE profile_skills DROP COLUMN IF EXISTS aliases;
@@ -0,0 +1,3 @@
+-- Names of profile skills merged into this one, so the merged skill keeps where it came from
+-- and later imports of those names are recognized as the same skill
+ALTER TABLE profile_skills ADD COLUMN IF NOT EXISTS aliases TEXT[] NOT NULL DEFAULT '{}';
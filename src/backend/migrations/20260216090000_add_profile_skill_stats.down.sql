@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_profile_skills_years_of_experience;
+ALTER TABLE profile_skills DROP COLUMN IF EXISTS evidence_strength;
+ALTER TABLE profile_skills DROP COLUMN IF EXISTS last_used;
+ALTER TABLE profile_skills DROP COLUMN IF EXISTS years_of_experience;
@@ -0,0 +1,8 @@
+-- Derived skill fields, recomputed from experiences, testimonials and validations
+-- whenever the profile changes
+ALTER TABLE profile_skills ADD COLUMN IF NOT EXISTS years_of_experience NUMERIC(4, 1) NOT NULL DEFAULT 0;
+ALTER TABLE profile_skills ADD COLUMN IF NOT EXISTS last_used DATE;
+ALTER TABLE profile_skills ADD COLUMN IF NOT EXISTS evidence_strength NUMERIC(3, 2) NOT NULL DEFAULT 0;
+
+-- Supports filtering skills by experience, e.g. "5+ years of Go"
+CREATE INDEX IF NOT EXISTS idx_profile_skills_years_of_experience ON profile_skills(normalized_name, years_of_experience);
@@ -0,0 +1,13 @@
+ALTER TABLE profile_education DROP COLUMN IF EXISTS end_precision;
+ALTER TABLE profile_education DROP COLUMN IF EXISTS end_month;
+ALTER TABLE profile_education DROP COLUMN IF EXISTS end_year;
+ALTER TABLE profile_education DROP COLUMN IF EXISTS start_precision;
+ALTER TABLE profile_education DROP COLUMN IF EXISTS start_month;
+ALTER TABLE profile_education DROP COLUMN IF EXISTS start_year;
+
+ALTER TABLE profile_experiences DROP COLUMN IF EXISTS end_precision;
+ALTER TABLE profile_experiences DROP COLUMN IF EXISTS end_month;
+ALTER TABLE profile_experiences DROP COLUMN IF EXISTS end_year;
+ALTER TABLE profile_experiences DROP COLUMN IF EXISTS start_precision;
+ALTER TABLE profile_experiences DROP COLUMN IF EXISTS start_month;
+ALTER TABLE profile_experiences DROP COLUMN IF EXISTS start_year;
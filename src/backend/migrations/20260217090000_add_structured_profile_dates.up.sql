@@ -0,0 +1,17 @@
+-- Parsed start and end dates of experiences and education, kept next to the dates as
+-- written. Month is the first month of a season and NULL for a bare year; a present
+-- date has neither year nor month. Existing rows are parsed by the server at startup
+-- (service.BackfillProfileDates), with the same parser as new rows.
+ALTER TABLE profile_experiences ADD COLUMN IF NOT EXISTS start_year SMALLINT;
+ALTER TABLE profile_experiences ADD COLUMN IF NOT EXISTS start_month SMALLINT CHECK (start_month BETWEEN 1 AND 12);
+ALTER TABLE profile_experiences ADD COLUMN IF NOT EXISTS start_precision VARCHAR(10) CHECK (start_precision IN ('year', 'season', 'month', 'present'));
+ALTER TABLE profile_experiences ADD COLUMN IF NOT EXISTS end_year SMALLINT;
+ALTER TABLE profile_experiences ADD COLUMN IF NOT EXISTS end_month SMALLINT CHECK (end_month BETWEEN 1 AND 12);
+ALTER TABLE profile_experiences ADD COLUMN IF NOT EXISTS end_precision VARCHAR(10) CHECK (end_precision IN ('year', 'season', 'month', 'present'));
+
+ALTER TABLE profile_education ADD COLUMN IF NOT EXISTS start_year SMALLINT;
+ALTER TABLE profile_education ADD COLUMN IF NOT EXISTS start_month SMALLINT CHECK (start_month BETWEEN 1 AND 12);
+ALTER TABLE profile_education ADD COLUMN IF NOT EXISTS start_precision VARCHAR(10) CHECK (start_precision IN ('year', 'season', 'month', 'present'));
+ALTER TABLE profile_education ADD COLUMN IF NOT EXISTS end_year SMALLINT;
+ALTER TABLE profile_education ADD COLUMN IF NOT EXISTS end_month SMALLINT CHECK (end_month BETWEEN 1 AND 12);
+ALTER TABLE profile_education ADD COLUMN IF NOT EXISTS end_precision VARCHAR(10) CHECK (end_precision IN ('year', 'season', 'month', 'present'));
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS update_profile_inconsistencies_updated_at ON profile_inconsistencies;
+DROP INDEX IF EXISTS idx_profile_inconsistencies_letter_experience_field;
+DROP INDEX IF EXISTS idx_profile_inconsistencies_profile_id;
+DROP TABLE IF EXISTS profile_inconsistencies;
@@ -0,0 +1,31 @@
+-- Create profile_inconsistencies table for discrepancies between profile experiences and
+-- what reference letters say about the same roles (title, start or end date)
+
+CREATE TABLE profile_inconsistencies (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    profile_experience_id UUID NOT NULL REFERENCES profile_experiences(id) ON DELETE CASCADE,
+    reference_letter_id UUID NOT NULL REFERENCES reference_letters(id) ON DELETE CASCADE,
+    field VARCHAR(20) NOT NULL CHECK (field IN ('title', 'start_date', 'end_date')),
+    resume_value TEXT NOT NULL,
+    letter_value TEXT NOT NULL,
+    quote TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'resolved', 'dismissed')),
+    resolution_note TEXT,
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for listing a profile's inconsistencies
+CREATE INDEX idx_profile_inconsistencies_profile_id ON profile_inconsistencies(profile_id);
+
+-- A letter contradicts each field of an experience once, so re-running the check keeps
+-- the candidate's resolutions and dismissals
+CREATE UNIQUE INDEX idx_profile_inconsistencies_letter_experience_field
+    ON profile_inconsistencies(reference_letter_id, profile_experience_id, field);
+
+CREATE TRIGGER update_profile_inconsistencies_updated_at
+    BEFORE UPDATE ON profile_inconsistencies
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
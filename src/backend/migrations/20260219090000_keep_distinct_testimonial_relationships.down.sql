@@ -0,0 +1,2 @@
+UPDATE testimonials SET relationship = 'other' WHERE relationship IN ('mentor', 'professor');
+UPDATE testimonials SET relationship = 'peer' WHERE relationship = 'colleague';
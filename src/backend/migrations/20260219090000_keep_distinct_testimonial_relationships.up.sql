@@ -0,0 +1,10 @@
+-- Keep mentor, professor and colleague as testimonial relationships of their own
+-- Testimonials used to store them as 'other' (mentor, professor) or 'peer' (colleague);
+-- restore them from the author relationship extracted from the reference letter.
+
+UPDATE testimonials t
+SET relationship = rl.extracted_data->'author'->>'relationship'
+FROM reference_letters rl
+WHERE t.reference_letter_id = rl.id
+  AND rl.extracted_data->'author'->>'relationship' IN ('mentor', 'professor', 'colleague')
+  AND t.relationship IN ('other', 'peer');
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_profile_changes_reverts_change_id;
+DROP INDEX IF EXISTS idx_profile_changes_profile_id_created_at;
+DROP TABLE IF EXISTS profile_changes;
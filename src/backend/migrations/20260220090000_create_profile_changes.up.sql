@@ -0,0 +1,25 @@
+-- Create profile_changes table, an append-only log of edits to profiles and their
+-- experiences, education and skills, made by the user or by document imports
+
+CREATE TABLE profile_changes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    entity_type VARCHAR(20) NOT NULL CHECK (entity_type IN ('profile', 'experience', 'education', 'skill')),
+    entity_id UUID NOT NULL,
+    action VARCHAR(20) NOT NULL CHECK (action IN ('create', 'update', 'delete')),
+    field VARCHAR(50),
+    old_value JSONB,
+    new_value JSONB,
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    source VARCHAR(20) NOT NULL CHECK (source IN ('manual', 'import')),
+    reverts_change_id UUID REFERENCES profile_changes(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- Updates record one field; creates and deletes record the whole item
+    CHECK ((action = 'update') = (field IS NOT NULL))
+);
+
+-- Index for reading a profile's history, newest first
+CREATE INDEX idx_profile_changes_profile_id_created_at ON profile_changes(profile_id, created_at DESC);
+
+-- A change is undone at most once
+CREATE UNIQUE INDEX idx_profile_changes_reverts_change_id ON profile_changes(reverts_change_id);
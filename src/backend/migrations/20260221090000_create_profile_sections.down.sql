@@ -0,0 +1,8 @@
+-- Rollback: Drop the profile section tables
+
+DROP TABLE IF EXISTS profile_awards;
+DROP TABLE IF EXISTS profile_publications;
+DROP TABLE IF EXISTS profile_projects;
+DROP TABLE IF EXISTS profile_languages;
+DROP TABLE IF EXISTS profile_certifications;
+DROP TABLE IF EXISTS profile_links;
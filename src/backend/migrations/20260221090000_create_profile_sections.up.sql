@@ -0,0 +1,129 @@
+-- Create tables for the resume sections beyond experience, education and skills: links,
+-- certifications, spoken languages, projects, publications and awards. Like experiences
+-- they are ordered per profile and remember the resume they were imported from.
+
+CREATE TABLE profile_links (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL DEFAULT 'other' CHECK (kind IN ('github', 'linkedin', 'portfolio', 'website', 'other')),
+    url TEXT NOT NULL,
+    label VARCHAR(255),
+    display_order INT NOT NULL DEFAULT 0,
+    source VARCHAR(50) NOT NULL DEFAULT 'manual',
+    source_resume_id UUID REFERENCES resumes(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE profile_certifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    issuer VARCHAR(255),
+    credential_id VARCHAR(255),
+    verification_url TEXT,
+    issue_date VARCHAR(50),
+    expiry_date VARCHAR(50),
+    display_order INT NOT NULL DEFAULT 0,
+    source VARCHAR(50) NOT NULL DEFAULT 'manual',
+    source_resume_id UUID REFERENCES resumes(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE profile_languages (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    language VARCHAR(100) NOT NULL,
+    -- CEFR level, or native; NULL when the resume does not say
+    proficiency VARCHAR(10) CHECK (proficiency IN ('a1', 'a2', 'b1', 'b2', 'c1', 'c2', 'native')),
+    display_order INT NOT NULL DEFAULT 0,
+    source VARCHAR(50) NOT NULL DEFAULT 'manual',
+    source_resume_id UUID REFERENCES resumes(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE profile_projects (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    role VARCHAR(255),
+    description TEXT,
+    url TEXT,
+    start_date VARCHAR(50),
+    end_date VARCHAR(50),
+    technologies TEXT[],
+    display_order INT NOT NULL DEFAULT 0,
+    source VARCHAR(50) NOT NULL DEFAULT 'manual',
+    source_resume_id UUID REFERENCES resumes(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE profile_publications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    title VARCHAR(500) NOT NULL,
+    publisher VARCHAR(255),
+    date VARCHAR(50),
+    url TEXT,
+    description TEXT,
+    display_order INT NOT NULL DEFAULT 0,
+    source VARCHAR(50) NOT NULL DEFAULT 'manual',
+    source_resume_id UUID REFERENCES resumes(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE profile_awards (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    issuer VARCHAR(255),
+    date VARCHAR(50),
+    description TEXT,
+    display_order INT NOT NULL DEFAULT 0,
+    source VARCHAR(50) NOT NULL DEFAULT 'manual',
+    source_resume_id UUID REFERENCES resumes(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Indexes for listing a profile's entries in display order
+CREATE INDEX idx_profile_links_display_order ON profile_links(profile_id, display_order);
+CREATE INDEX idx_profile_certifications_display_order ON profile_certifications(profile_id, display_order);
+CREATE INDEX idx_profile_languages_display_order ON profile_languages(profile_id, display_order);
+CREATE INDEX idx_profile_projects_display_order ON profile_projects(profile_id, display_order);
+CREATE INDEX idx_profile_publications_display_order ON profile_publications(profile_id, display_order);
+CREATE INDEX idx_profile_awards_display_order ON profile_awards(profile_id, display_order);
+
+CREATE TRIGGER update_profile_links_updated_at
+    BEFORE UPDATE ON profile_links
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_profile_certifications_updated_at
+    BEFORE UPDATE ON profile_certifications
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_profile_languages_updated_at
+    BEFORE UPDATE ON profile_languages
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_profile_projects_updated_at
+    BEFORE UPDATE ON profile_projects
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_profile_publications_updated_at
+    BEFORE UPDATE ON profile_publications
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_profile_awards_updated_at
+    BEFORE UPDATE ON profile_awards
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_profile_certifications_expiring;
+DROP INDEX IF EXISTS idx_profile_certifications_source_credential_document_id;
+
+ALTER TABLE profile_certifications DROP COLUMN IF EXISTS expiring_flagged_at;
+ALTER TABLE profile_certifications DROP COLUMN IF EXISTS expiry_precision;
+ALTER TABLE profile_certifications DROP COLUMN IF EXISTS expiry_month;
+ALTER TABLE profile_certifications DROP COLUMN IF EXISTS expiry_year;
+ALTER TABLE profile_certifications DROP COLUMN IF EXISTS source_credential_document_id;
+ALTER TABLE profile_certifications DROP COLUMN IF EXISTS source_file_id;
@@ -0,0 +1,15 @@
+-- Certifications can come from an uploaded certificate, whose file is kept as evidence.
+-- The expiry date is kept parsed next to the date as written (see profile dates) and
+-- expiring_flagged_at is set by the periodic expiry check while a certification expires
+-- within the configured window.
+ALTER TABLE profile_certifications ADD COLUMN IF NOT EXISTS source_file_id UUID REFERENCES files(id) ON DELETE SET NULL;
+ALTER TABLE profile_certifications ADD COLUMN IF NOT EXISTS source_credential_document_id UUID REFERENCES credential_documents(id) ON DELETE SET NULL;
+ALTER TABLE profile_certifications ADD COLUMN IF NOT EXISTS expiry_year SMALLINT;
+ALTER TABLE profile_certifications ADD COLUMN IF NOT EXISTS expiry_month SMALLINT CHECK (expiry_month BETWEEN 1 AND 12);
+ALTER TABLE profile_certifications ADD COLUMN IF NOT EXISTS expiry_precision VARCHAR(10) CHECK (expiry_precision IN ('year', 'season', 'month', 'present'));
+ALTER TABLE profile_certifications ADD COLUMN IF NOT EXISTS expiring_flagged_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_profile_certifications_source_credential_document_id
+    ON profile_certifications(source_credential_document_id);
+CREATE INDEX IF NOT EXISTS idx_profile_certifications_expiring
+    ON profile_certifications(profile_id) WHERE expiring_flagged_at IS NOT NULL;
@@ -0,0 +1,3 @@
+-- Highlights split out of descriptions are kept: they cannot be told apart from
+-- highlights entered by hand, and both forms show the same content.
+SELECT 1;
@@ -0,0 +1,60 @@
+-- Split the bullet points out of experience descriptions into highlights, for experiences
+-- materialized before resume extraction returned highlights separately. Experiences that
+-- already have highlights are left alone.
+
+-- Backfill helper following normalize.SplitHighlights: lines starting with a bullet or list
+-- number become highlights, lines continuing a bullet without a capital letter are joined to
+-- it, and the other lines stay in the description.
+CREATE OR REPLACE FUNCTION pg_temp.split_highlights(input TEXT, OUT prose TEXT, OUT highlights TEXT[])
+AS $$
+DECLARE
+    bullet CONSTANT TEXT := '^\s*([•◦▪▫‣●○■□►▸➢➤✓✔·]\s*|[*–—-]\s+|\d{1,2}[.)]\s+)';
+    line TEXT;
+    trimmed TEXT;
+    part TEXT;
+    in_bullet BOOLEAN := FALSE;
+    prose_lines TEXT[] := '{}';
+BEGIN
+    highlights := '{}';
+    FOREACH line IN ARRAY string_to_array(coalesce(input, ''), E'\n') LOOP
+        trimmed := btrim(line, E' \t\r');
+        IF trimmed = '' THEN
+            in_bullet := FALSE;
+            prose_lines := prose_lines || ''::TEXT;
+        ELSIF line ~ bullet THEN
+            FOREACH part IN ARRAY regexp_split_to_array(regexp_replace(line, bullet, ''), '\s+[•◦▪▫‣●○■□►▸➢➤]\s+') LOOP
+                part := btrim(part, E' \t\r');
+                IF part <> '' THEN
+                    highlights := highlights || part;
+                END IF;
+            END LOOP;
+            in_bullet := cardinality(highlights) > 0;
+        ELSIF in_bullet AND trimmed !~ '^[[:upper:]]' THEN
+            highlights[cardinality(highlights)] := highlights[cardinality(highlights)] || ' ' || trimmed;
+        ELSE
+            in_bullet := FALSE;
+            prose_lines := prose_lines || trimmed;
+        END IF;
+    END LOOP;
+
+    IF cardinality(highlights) = 0 THEN
+        prose := input;
+        RETURN;
+    END IF;
+    prose := btrim(regexp_replace(array_to_string(prose_lines, E'\n'), E'\n{3,}', E'\n\n', 'g'), E'\n');
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+-- Backfilling is not an edit, so leave updated_at alone
+ALTER TABLE profile_experiences DISABLE TRIGGER update_profile_experiences_updated_at;
+
+UPDATE profile_experiences
+SET (description, highlights) = (
+    SELECT nullif(s.prose, ''), s.highlights FROM pg_temp.split_highlights(description) s
+)
+WHERE coalesce(cardinality(highlights), 0) = 0
+  AND description ~ '(^|\n)\s*([•◦▪▫‣●○■□►▸➢➤✓✔·]|[*–—-]\s|\d{1,2}[.)]\s)';
+
+ALTER TABLE profile_experiences ENABLE TRIGGER update_profile_experiences_updated_at;
+
+DROP FUNCTION pg_temp.split_highlights(TEXT);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_job_descriptions_updated_at ON job_descriptions;
+DROP INDEX IF EXISTS idx_job_descriptions_user_id;
+DROP TABLE IF EXISTS job_descriptions;
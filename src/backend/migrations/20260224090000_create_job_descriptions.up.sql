@@ -0,0 +1,20 @@
+-- Job descriptions a user matched their profile against, with the requirements extracted
+-- from them so they can be matched again without another extraction.
+
+CREATE TABLE job_descriptions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title VARCHAR(255),
+    company VARCHAR(255),
+    text TEXT NOT NULL,
+    extracted_data JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_job_descriptions_user_id ON job_descriptions(user_id, created_at DESC);
+
+CREATE TRIGGER update_job_descriptions_updated_at
+    BEFORE UPDATE ON job_descriptions
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
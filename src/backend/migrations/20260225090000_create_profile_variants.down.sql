@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_profile_variants_updated_at ON profile_variants;
+DROP INDEX IF EXISTS idx_profile_variants_profile_id;
+DROP TABLE IF EXISTS profile_variants;
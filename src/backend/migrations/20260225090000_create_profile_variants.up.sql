@@ -0,0 +1,21 @@
+-- Tailored versions of a profile. A variant stores inclusion, ordering and override rules
+-- applied to the profile when it is read, not copies of its entries.
+
+CREATE TABLE profile_variants (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    target_role VARCHAR(255),
+    summary TEXT,
+    rules JSONB NOT NULL DEFAULT '{}',
+    share_token VARCHAR(64) UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_profile_variants_profile_id ON profile_variants(profile_id);
+
+CREATE TRIGGER update_profile_variants_updated_at
+    BEFORE UPDATE ON profile_variants
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS update_writing_suggestions_updated_at ON writing_suggestions;
+DROP INDEX IF EXISTS idx_writing_suggestions_profile_id;
+DROP TABLE IF EXISTS writing_suggestions;
+
+ALTER TABLE profiles DROP COLUMN IF EXISTS headline;
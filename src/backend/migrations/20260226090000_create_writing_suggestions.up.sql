@@ -0,0 +1,29 @@
+-- Profiles get a one-line headline shown under the name, e.g. "Backend engineer for
+-- payments platforms"
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS headline TEXT;
+
+-- Create writing_suggestions table for drafted summaries, headlines and experience
+-- highlights awaiting the user's decision. Each draft cites the experiences, skills and
+-- testimonials it is grounded in; nothing changes on the profile until a suggestion is
+-- accepted
+
+CREATE TABLE writing_suggestions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    experience_id UUID REFERENCES profile_experiences(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('summary', 'highlights', 'headline')),
+    drafts JSONB NOT NULL DEFAULT '[]',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'dismissed')),
+    model_version VARCHAR(100) NOT NULL DEFAULT '',
+    decided_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for listing a profile's suggestions
+CREATE INDEX idx_writing_suggestions_profile_id ON writing_suggestions(profile_id);
+
+CREATE TRIGGER update_writing_suggestions_updated_at
+    BEFORE UPDATE ON writing_suggestions
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
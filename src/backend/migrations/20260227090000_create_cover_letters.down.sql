@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS cover_letter_versions;
+
+DROP TRIGGER IF EXISTS update_cover_letters_updated_at ON cover_letters;
+DROP INDEX IF EXISTS idx_cover_letters_profile_id;
+DROP TABLE IF EXISTS cover_letters;
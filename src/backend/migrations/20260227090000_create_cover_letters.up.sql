@@ -0,0 +1,36 @@
+-- Create cover_letters table for cover letters written from a profile for a job
+-- description. The text is kept in cover_letter_versions: the generated draft is version 1
+-- and each edit by the user adds the next version
+
+CREATE TABLE cover_letters (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    job_description_id UUID REFERENCES job_descriptions(id) ON DELETE SET NULL,
+    title VARCHAR(500) NOT NULL,
+    latest_version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for listing a profile's cover letters
+CREATE INDEX idx_cover_letters_profile_id ON cover_letters(profile_id);
+
+CREATE TRIGGER update_cover_letters_updated_at
+    BEFORE UPDATE ON cover_letters
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Versions are immutable; quotes holds the testimonial passages the body quotes, each
+-- verified against the stored testimonial when the version was saved
+CREATE TABLE cover_letter_versions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    cover_letter_id UUID NOT NULL REFERENCES cover_letters(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    body TEXT NOT NULL,
+    citations JSONB NOT NULL DEFAULT '[]',
+    quotes JSONB NOT NULL DEFAULT '[]',
+    source VARCHAR(20) NOT NULL CHECK (source IN ('generated', 'edited')),
+    model_version VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (cover_letter_id, version)
+);
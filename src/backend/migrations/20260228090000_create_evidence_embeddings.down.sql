@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_evidence_embeddings_updated_at ON evidence_embeddings;
+DROP INDEX IF EXISTS idx_evidence_embeddings_profile_model;
+DROP TABLE IF EXISTS evidence_embeddings;
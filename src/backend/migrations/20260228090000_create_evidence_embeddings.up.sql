@@ -0,0 +1,30 @@
+-- Create evidence_embeddings table for semantic search over a profile's evidence:
+-- testimonials, the quotes validating its skills and its experience descriptions.
+-- Embeddings are computed in a background job after materialization
+
+CREATE EXTENSION IF NOT EXISTS vector;
+
+-- The embedding column has no fixed dimension because embedding models differ in vector
+-- length; rows are only ever compared with rows made by the same model. Searches are
+-- scoped to a single profile, so they scan its rows instead of using an ANN index
+CREATE TABLE evidence_embeddings (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    source_kind VARCHAR(30) NOT NULL CHECK (source_kind IN ('testimonial', 'skill_validation', 'experience')),
+    source_id UUID NOT NULL,
+    content TEXT NOT NULL,
+    content_hash VARCHAR(64) NOT NULL,
+    model VARCHAR(100) NOT NULL,
+    embedding vector NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (source_kind, source_id)
+);
+
+-- Index for searching a profile's embeddings made by one model
+CREATE INDEX idx_evidence_embeddings_profile_model ON evidence_embeddings(profile_id, model);
+
+CREATE TRIGGER update_evidence_embeddings_updated_at
+    BEFORE UPDATE ON evidence_embeddings
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
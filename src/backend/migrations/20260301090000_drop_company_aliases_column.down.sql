@@ -0,0 +1,10 @@
+ALTER TABLE companies ADD COLUMN aliases TEXT[] NOT NULL DEFAULT '{}';
+
+UPDATE companies c
+SET aliases = ARRAY(
+    SELECT ca.alias
+    FROM company_aliases ca
+    WHERE ca.profile_id = c.profile_id
+      AND LOWER(TRIM(ca.canonical_name)) = LOWER(TRIM(c.name))
+    ORDER BY ca.alias
+);
@@ -0,0 +1,16 @@
+-- Company aliases live in company_aliases, which company matching reads
+-- Move names kept on companies.aliases there and drop the column so there is one alias store
+
+INSERT INTO company_aliases (profile_id, alias, canonical_name)
+SELECT DISTINCT ON (c.profile_id, LOWER(TRIM(a.alias)))
+    c.profile_id,
+    TRIM(a.alias),
+    c.name
+FROM companies c
+CROSS JOIN LATERAL UNNEST(c.aliases) AS a(alias)
+WHERE TRIM(a.alias) <> ''
+  AND LOWER(TRIM(a.alias)) <> LOWER(TRIM(c.name))
+ORDER BY c.profile_id, LOWER(TRIM(a.alias)), c.updated_at DESC
+ON CONFLICT (profile_id, LOWER(alias)) DO NOTHING;
+
+ALTER TABLE companies DROP COLUMN aliases;
@@ -0,0 +1,3 @@
+-- Skills whose names are also common English words, such as Go or Spring
+-- Prose mentions them only when written exactly as one of their names
+ALTER TABLE skills ADD COLUMN IF NOT EXISTS ambiguous BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,3 @@
+DELETE FROM profile_changes WHERE entity_type NOT IN ('profile', 'experience', 'education', 'skill');
+ALTER TABLE profile_changes DROP CONSTRAINT IF EXISTS profile_changes_entity_type_check;
+ALTER TABLE profile_changes ADD CONSTRAINT profile_changes_entity_type_check CHECK (entity_type IN ('profile', 'experience', 'education', 'skill'));
@@ -0,0 +1,7 @@
+-- Record edits to links, certifications, languages, projects, publications and awards in
+-- the profile change log
+ALTER TABLE profile_changes DROP CONSTRAINT IF EXISTS profile_changes_entity_type_check;
+ALTER TABLE profile_changes ADD CONSTRAINT profile_changes_entity_type_check CHECK (entity_type IN (
+    'profile', 'experience', 'education', 'skill',
+    'link', 'certification', 'language', 'project', 'publication', 'award'
+));
@@ -0,0 +1 @@
+ALTER TABLE profile_experiences DROP COLUMN IF EXISTS gap_note;
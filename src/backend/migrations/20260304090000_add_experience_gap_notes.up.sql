@@ -0,0 +1,3 @@
+-- Candidates can explain the break after a role on their career timeline, e.g. "Parental
+-- leave" or "Travelled for a year". The note belongs to the role that ended before the gap
+ALTER TABLE profile_experiences ADD COLUMN IF NOT EXISTS gap_note TEXT;
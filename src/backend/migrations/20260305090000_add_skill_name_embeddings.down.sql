@@ -0,0 +1,3 @@
+DELETE FROM evidence_embeddings WHERE source_kind = 'skill_name';
+ALTER TABLE evidence_embeddings DROP CONSTRAINT IF EXISTS evidence_embeddings_source_kind_check;
+ALTER TABLE evidence_embeddings ADD CONSTRAINT evidence_embeddings_source_kind_check CHECK (source_kind IN ('testimonial', 'skill_validation', 'experience'));
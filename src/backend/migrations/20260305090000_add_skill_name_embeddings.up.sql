@@ -0,0 +1,6 @@
+-- Keep embeddings of profile skill names, so that matching a reference letter's skill
+-- mentions by meaning does not embed every skill name again
+ALTER TABLE evidence_embeddings DROP CONSTRAINT IF EXISTS evidence_embeddings_source_kind_check;
+ALTER TABLE evidence_embeddings ADD CONSTRAINT evidence_embeddings_source_kind_check CHECK (source_kind IN (
+    'testimonial', 'skill_validation', 'experience', 'skill_name'
+));